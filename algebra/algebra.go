@@ -0,0 +1,249 @@
+/*
+ Package algebra translates a parsed SPARQL query into the SPARQL algebra, as
+ defined in section 18 of the SPARQL 1.1 specification.
+
+ The algebra expression is printed as an S-expression, in the same syntax as
+ the one of Jena's "qparse --print=op". For example, the query
+
+    PREFIX : <http://example.org/>
+    SELECT ?s { ?s :p ?o FILTER(?o > 1) }
+
+ is printed as
+
+    (prefix ((: <http://example.org/>))
+      (project (?s)
+        (filter (> ?o 1)
+          (bgp (triple ?s :p ?o)))))
+
+ Terms are kept in their SPARQL syntax. IRIs are always absolute and enclosed
+ in angle brackets, and blank nodes of the query are replaced with variables
+ prefixed with "??".
+*/
+package algebra
+
+// Op is an operator of the SPARQL algebra
+type Op interface {
+    // Children returns the operators this one applies to
+    Children() []Op
+}
+
+// Triple is a triple pattern
+type Triple struct {
+    S, P, O string
+}
+
+// BGP is a basic graph pattern
+type BGP struct {
+    Triples []Triple
+}
+
+// PathOp matches the property path between the subject S and the object O
+type PathOp struct {
+    S string
+    Path Path
+    O string
+}
+
+// Sequence joins operators which are evaluated one after the other, e.g., the
+// basic graph patterns and the property paths of a block of triples
+type Sequence struct {
+    Ops []Op
+}
+
+// Join is the join of two patterns
+type Join struct {
+    Left, Right Op
+}
+
+// LeftJoin is an OPTIONAL pattern. The filters of the optional part, if any,
+// are the condition of the join.
+type LeftJoin struct {
+    Left, Right Op
+    Exprs []Expr
+}
+
+// Filter keeps the solutions for which all the expressions are true
+type Filter struct {
+    Exprs []Expr
+    Op Op
+}
+
+// Union is the union of two patterns
+type Union struct {
+    Left, Right Op
+}
+
+// Minus removes the solutions of Left that are compatible with one of Right
+type Minus struct {
+    Left, Right Op
+}
+
+// Graph evaluates the pattern over the named graph, an IRI or a variable
+type Graph struct {
+    Name string
+    Op Op
+}
+
+// Service evaluates the pattern at the remote SPARQL endpoint
+type Service struct {
+    Name string
+    Silent bool
+    Op Op
+}
+
+// Binding assigns the value of an expression to a variable
+type Binding struct {
+    Var string
+    Expr Expr
+}
+
+// Extend assigns a variable in each solution, e.g., with BIND or a SELECT
+// expression
+type Extend struct {
+    Bindings []Binding
+    Op Op
+}
+
+// Table is a sequence of solutions, e.g., VALUES inline data. An UNDEF value
+// is the empty string.
+// The table with no variable and a single solution is the identity of Join.
+type Table struct {
+    Vars []string
+    Rows [][]string
+}
+
+// Aggregation binds a variable to the value of an aggregate over a group
+type Aggregation struct {
+    Var string
+    Aggregate *Aggregate
+}
+
+// Group partitions the solutions by the value of the keys, and computes the
+// aggregations of each group. A key with an empty Var is not assigned.
+type Group struct {
+    Keys []Binding
+    Aggregations []Aggregation
+    Op Op
+}
+
+// OrderCondition is a condition of an OrderBy
+type OrderCondition struct {
+    Expr Expr
+    // Either "asc", "desc", or empty if not given
+    Direction string
+}
+
+// OrderBy sorts the solutions
+type OrderBy struct {
+    Conditions []OrderCondition
+    Op Op
+}
+
+// Project keeps the variables of the solutions
+type Project struct {
+    Vars []string
+    Op Op
+}
+
+// Distinct removes the duplicate solutions
+type Distinct struct {
+    Op Op
+}
+
+// Reduced allows the removal of duplicate solutions
+type Reduced struct {
+    Op Op
+}
+
+// Slice keeps a range of the solutions. A negative Offset or Limit is not set.
+type Slice struct {
+    Offset, Limit int64
+    Op Op
+}
+
+func (op *BGP) Children() []Op { return nil }
+func (op *PathOp) Children() []Op { return nil }
+func (op *Table) Children() []Op { return nil }
+func (op *Sequence) Children() []Op { return op.Ops }
+func (op *Join) Children() []Op { return []Op{ op.Left, op.Right } }
+func (op *LeftJoin) Children() []Op { return []Op{ op.Left, op.Right } }
+func (op *Union) Children() []Op { return []Op{ op.Left, op.Right } }
+func (op *Minus) Children() []Op { return []Op{ op.Left, op.Right } }
+func (op *Filter) Children() []Op { return []Op{ op.Op } }
+func (op *Graph) Children() []Op { return []Op{ op.Op } }
+func (op *Service) Children() []Op { return []Op{ op.Op } }
+func (op *Extend) Children() []Op { return []Op{ op.Op } }
+func (op *Group) Children() []Op { return []Op{ op.Op } }
+func (op *OrderBy) Children() []Op { return []Op{ op.Op } }
+func (op *Project) Children() []Op { return []Op{ op.Op } }
+func (op *Distinct) Children() []Op { return []Op{ op.Op } }
+func (op *Reduced) Children() []Op { return []Op{ op.Op } }
+func (op *Slice) Children() []Op { return []Op{ op.Op } }
+
+// Unit returns the table with a single solution that binds no variable
+func Unit() *Table {
+    return &Table{ Rows : [][]string{ {} } }
+}
+
+// IsUnit returns true if the table is the identity of Join
+func (op *Table) IsUnit() bool {
+    return len(op.Vars) == 0 && len(op.Rows) == 1
+}
+
+// Walk calls the function on the operator and its descendants in depth-first
+// order, including the patterns of EXISTS expressions. The descendants of an
+// operator are skipped if the function returns false.
+func Walk(op Op, f func(Op) bool) {
+    if !f(op) {
+        return
+    }
+    for _,expr := range exprsOf(op) {
+        walkExpr(expr, f)
+    }
+    for _,child := range op.Children() {
+        Walk(child, f)
+    }
+}
+
+// walkExpr walks the patterns of the EXISTS expressions within expr
+func walkExpr(expr Expr, f func(Op) bool) {
+    switch e := expr.(type) {
+    case *Exists:
+        Walk(e.Op, f)
+    case *Call:
+        for _,arg := range e.Args {
+            walkExpr(arg, f)
+        }
+    case *Aggregate:
+        if e.Expr != nil {
+            walkExpr(e.Expr, f)
+        }
+    }
+}
+
+// exprsOf returns the expressions of the operator
+func exprsOf(op Op) []Expr {
+    var exprs []Expr
+    switch o := op.(type) {
+    case *Filter:
+        exprs = o.Exprs
+    case *LeftJoin:
+        exprs = o.Exprs
+    case *Extend:
+        for _,b := range o.Bindings {
+            exprs = append(exprs, b.Expr)
+        }
+    case *Group:
+        for _,b := range o.Keys {
+            exprs = append(exprs, b.Expr)
+        }
+        for _,a := range o.Aggregations {
+            exprs = append(exprs, a.Aggregate)
+        }
+    case *OrderBy:
+        for _,c := range o.Conditions {
+            exprs = append(exprs, c.Expr)
+        }
+    }
+    return exprs
+}
@@ -0,0 +1,270 @@
+package algebra
+
+import (
+    "io/ioutil"
+    "path/filepath"
+    "strings"
+    "testing"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// Translates the query and compares its S-expression against the expected one
+func translate(t *testing.T, query string, expected string) {
+    a, err := Parse(query)
+    if err != nil {
+        t.Fatalf("Failed to translate query\n%v", err)
+    }
+    expected = strings.TrimSpace(expected)
+    if actual := a.String(); actual != expected {
+        t.Errorf("Expected\n%v\nbut got\n%v\n", expected, actual)
+    }
+}
+
+func TestBGP(t *testing.T) {
+    translate(t, `SELECT * { ?s ?p ?o }`, `(bgp (triple ?s ?p ?o))`)
+    translate(t, `
+        PREFIX : <http://example.org/>
+        SELECT * { ?s a :Person ; :name ?name, "John" }
+        `, `
+(prefix ((: <http://example.org/>))
+  (bgp
+    (triple ?s <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> :Person)
+    (triple ?s :name ?name)
+    (triple ?s :name "John")
+  ))
+        `)
+}
+
+func TestEmptyGroup(t *testing.T) {
+    translate(t, `ASK {}`, `(table unit)`)
+    translate(t, `SELECT * { OPTIONAL { ?s ?p ?o } }`, `
+(leftjoin
+  (table unit)
+  (bgp (triple ?s ?p ?o)))
+        `)
+}
+
+func TestFilter(t *testing.T) {
+    translate(t, `SELECT * { ?s ?p ?o FILTER(?o <= 1) ?s ?q ?v FILTER regex(?v, "a", "i") }`, `
+(filter (exprlist (<= ?o 1) (regex ?v "a" "i"))
+  (bgp
+    (triple ?s ?p ?o)
+    (triple ?s ?q ?v)
+  ))
+        `)
+    translate(t, `SELECT * { ?s ?p ?o FILTER(?o - 1 * 2 > -3 && !bound(?s) || ?o IN (1, 2)) }`, `
+(filter (|| (&& (> (- ?o (* 1 2)) -3) (! (bound ?s))) (in ?o 1 2))
+  (bgp (triple ?s ?p ?o)))
+        `)
+}
+
+func TestOptional(t *testing.T) {
+    translate(t, `SELECT * { ?s ?p ?o OPTIONAL { ?o ?q ?v FILTER(?v != "a") } }`, `
+(leftjoin
+  (bgp (triple ?s ?p ?o))
+  (bgp (triple ?o ?q ?v))
+  (!= ?v "a"))
+        `)
+}
+
+func TestUnionMinusGraph(t *testing.T) {
+    translate(t, `SELECT * { { ?s ?p ?o } UNION { ?s ?q ?o } UNION { ?o ?p ?s } MINUS { ?s a ?t } GRAPH ?g { ?s ?p ?x } }`, `
+(join
+  (minus
+    (union
+      (union
+        (bgp (triple ?s ?p ?o))
+        (bgp (triple ?s ?q ?o)))
+      (bgp (triple ?o ?p ?s)))
+    (bgp (triple ?s <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> ?t)))
+  (graph ?g
+    (bgp (triple ?s ?p ?x))))
+        `)
+}
+
+func TestBindValues(t *testing.T) {
+    translate(t, `SELECT * { ?s ?p ?x BIND(?x + 1 AS ?y) VALUES (?a ?b) { (1 UNDEF) (UNDEF "b") } }`, `
+(join
+  (extend ((?y (+ ?x 1)))
+    (bgp (triple ?s ?p ?x)))
+  (table (vars ?a ?b)
+    (row [?a 1])
+    (row [?b "b"])
+  ))
+        `)
+}
+
+func TestExists(t *testing.T) {
+    translate(t, `SELECT * { ?s ?p ?o FILTER NOT EXISTS { ?s ?p ?s } }`, `
+(filter (notexists (bgp (triple ?s ?p ?s)))
+  (bgp (triple ?s ?p ?o)))
+        `)
+}
+
+func TestSolutionModifiers(t *testing.T) {
+    translate(t, `
+        SELECT DISTINCT ?s (COUNT(*) AS ?c) (SUM(?o) AS ?sum) { ?s ?p ?o }
+        GROUP BY ?s
+        HAVING (COUNT(*) > 1)
+        ORDER BY DESC(?c) ?s
+        LIMIT 10 OFFSET 5
+        `, `
+(slice 5 10
+  (distinct
+    (project (?s ?c ?sum)
+      (order ((desc ?c) ?s)
+        (extend ((?c ?.0) (?sum ?.1))
+          (filter (> ?.0 1)
+            (group (?s) ((?.0 (count)) (?.1 (sum ?o)))
+              (bgp (triple ?s ?p ?o)))))))))
+        `)
+    translate(t, `SELECT (GROUP_CONCAT(DISTINCT ?o; SEPARATOR=";") AS ?c) { ?s ?p ?o } GROUP BY (str(?s) AS ?k)`, `
+(project (?c)
+  (extend ((?c ?.0))
+    (group ((?k (str ?s))) ((?.0 (group_concat distinct (separator ";") ?o)))
+      (bgp (triple ?s ?p ?o)))))
+        `)
+    translate(t, `SELECT ?s (str(?o) AS ?l) { ?s ?p ?o } VALUES ?o { "a" }`, `
+(project (?s ?l)
+  (extend ((?l (str ?o)))
+    (join
+      (bgp (triple ?s ?p ?o))
+      (table (vars ?o) (row [?o "a"])))))
+        `)
+}
+
+func TestSubQuery(t *testing.T) {
+    translate(t, `SELECT * { ?s ?p ?o { SELECT ?s { ?s a ?t } LIMIT 1 } }`, `
+(join
+  (bgp (triple ?s ?p ?o))
+  (slice _ 1
+    (project (?s)
+      (bgp (triple ?s <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> ?t)))))
+        `)
+}
+
+func TestPaths(t *testing.T) {
+    translate(t, `
+        PREFIX : <http://example.org/>
+        SELECT * { ?s :p/:q+/^:r ?o . ?o :p*|^:q ?v . ?v !(:a|^:b) ?w }
+        `, `
+(prefix ((: <http://example.org/>))
+  (sequence
+    (bgp (triple ?s :p ??P0))
+    (path ??P0 (path+ :q) ??P1)
+    (bgp (triple ?o :r ??P1))
+    (path ?o (alt (path* :p) (reverse :q)) ?v)
+    (path ?v (notoneof :a (reverse :b)) ?w)))
+        `)
+}
+
+func TestBlankNodes(t *testing.T) {
+    translate(t, `SELECT * { ?s ?p [ ?q _:b ] . _:b ?r ( 1 ?x ) }`, `
+(bgp
+  (triple ??0 ?q ??1)
+  (triple ?s ?p ??0)
+  (triple ??2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> 1)
+  (triple ??2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> ??3)
+  (triple ??3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> ?x)
+  (triple ??3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil>)
+  (triple ??1 ?r ??2)
+)
+        `)
+}
+
+func TestTerms(t *testing.T) {
+    translate(t, `
+        BASE <http://example.org/>
+        PREFIX ex: <ns#>
+        SELECT * { <s> ex:p 'a"b', """c""", "d"@en, "1"^^ex:int, true, 1.5e0, ex:a\.b }
+        `, `
+(base <http://example.org/>
+  (prefix ((ex: <http://example.org/ns#>))
+    (bgp
+      (triple <http://example.org/s> ex:p "a\"b")
+      (triple <http://example.org/s> ex:p "c")
+      (triple <http://example.org/s> ex:p "d"@en)
+      (triple <http://example.org/s> ex:p "1"^^ex:int)
+      (triple <http://example.org/s> ex:p true)
+      (triple <http://example.org/s> ex:p 1.5e0)
+      (triple <http://example.org/s> ex:p ex:a.b)
+    )))
+        `)
+}
+
+func TestQueryForms(t *testing.T) {
+    a, err := Parse(`PREFIX : <http://ex/> CONSTRUCT { ?s :q _:b . _:b :r ?o } FROM :g FROM NAMED :n WHERE { ?s :p _:b }`)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if a.Form != "construct" || len(a.From) != 1 || a.From[0] != "<http://ex/g>" || len(a.FromNamed) != 1 || a.FromNamed[0] != "<http://ex/n>" {
+        t.Errorf("Unexpected form %v from %v from named %v", a.Form, a.From, a.FromNamed)
+    }
+    expected := []Triple{ { "?s", "<http://ex/q>", "??1" }, { "??1", "<http://ex/r>", "?o" } }
+    if len(a.Template) != 2 || a.Template[0] != expected[0] || a.Template[1] != expected[1] {
+        t.Errorf("Expected the template %v but got %v", expected, a.Template)
+    }
+
+    a, err = Parse(`PREFIX : <http://ex/> DESCRIBE :x`)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if a.Form != "describe" || len(a.Describe) != 1 || a.Describe[0] != "<http://ex/x>" {
+        t.Errorf("Unexpected form %v describing %v", a.Form, a.Describe)
+    }
+    for query, form := range map[string]string{ `SELECT * {}` : "select", `ASK {}` : "ask", `DESCRIBE * {}` : "describe" } {
+        if a, err := Parse(query); err != nil || a.Form != form || len(a.Describe) != 0 {
+            t.Errorf("Expected the form %v of %v but got %v", form, query, a)
+        }
+    }
+}
+
+func TestUndeclaredPrefix(t *testing.T) {
+    if _, err := Parse(`SELECT * { ?s ex:p ?o }`); err == nil {
+        t.Error("Expected an error for the undeclared prefix")
+    }
+}
+
+func TestWalk(t *testing.T) {
+    a, err := Parse(`SELECT * { ?s ?p ?o OPTIONAL { ?o ?q ?v } FILTER EXISTS { ?s ?r ?x } }`)
+    if err != nil {
+        t.Fatal(err)
+    }
+    triples := 0
+    Walk(a.Op, func(op Op) bool {
+        if bgp, ok := op.(*BGP); ok {
+            triples += len(bgp.Triples)
+        }
+        return true
+    })
+    if triples != 3 {
+        t.Errorf("Expected 3 triple patterns but got %v", triples)
+    }
+}
+
+// Translates the valid queries of the syntax tests
+func TestSyntaxTests(t *testing.T) {
+    files, err := filepath.Glob("../sparql/testdata/syntax/*.rq")
+    if err != nil {
+        t.Fatal(err)
+    }
+    for _,file := range files {
+        query, err := ioutil.ReadFile(file)
+        if err != nil {
+            t.Fatal(err)
+        }
+        s := &sparql.Sparql{ Buffer : string(query) }
+        s.Init()
+        if s.Parse() != nil {
+            continue
+        }
+        a, err := Translate(s.Tree())
+        if err != nil {
+            t.Errorf("%v: %v", file, err)
+            continue
+        }
+        if a.String() == "" {
+            t.Errorf("%v: empty algebra expression", file)
+        }
+    }
+}
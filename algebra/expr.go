@@ -0,0 +1,241 @@
+package algebra
+
+import (
+    "strconv"
+    "strings"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// Expr is an expression, e.g., of a FILTER
+type Expr interface {
+    isExpr()
+}
+
+// Term is a variable, an IRI or a literal
+type Term string
+
+// Call is an operator or a function call. The name of an operator is its
+// symbol, e.g., "+" or "&&", the name of a built-in function is in lower case,
+// e.g., "regex", and the name of any other function is its IRI.
+type Call struct {
+    Name string
+    Args []Expr
+}
+
+// Exists is the EXISTS or NOT EXISTS of a pattern
+type Exists struct {
+    Not bool
+    Op Op
+}
+
+// Aggregate is a function over a group of solutions, e.g., COUNT.
+// The Expr of COUNT(*) is nil.
+type Aggregate struct {
+    // The name of the aggregate in lower case, e.g., "count"
+    Name string
+    Distinct bool
+    Expr Expr
+    // The separator of GROUP_CONCAT, as a string literal. It is empty if
+    // not given.
+    Separator string
+}
+
+func (Term) isExpr() {}
+func (*Call) isExpr() {}
+func (*Exists) isExpr() {}
+func (*Aggregate) isExpr() {}
+
+// The names of the built-in functions that are not the lower case of the keyword
+var builtinNames = map[string]string{
+    "ENCODEFORURI" : "encode_for_uri",
+    "LANGMATCHES" : "langMatches",
+    "SAMETERM" : "sameTerm",
+    "ISIRI" : "isIRI",
+    "ISURI" : "isURI",
+    "ISBLANK" : "isBlank",
+    "ISLITERAL" : "isLiteral",
+    "ISNUMERIC" : "isNumeric",
+}
+
+// The operators of the binary expressions
+var operators = map[string]string{
+    "OR" : "||",
+    "AND" : "&&",
+    "EQ" : "=",
+    "NE" : "!=",
+    "LT" : "<",
+    "LE" : "<=",
+    "GT" : ">",
+    "GE" : ">=",
+    "PLUS" : "+",
+    "MINUS" : "-",
+    "STAR" : "*",
+    "SLASH" : "/",
+    "NOT" : "!",
+}
+
+// expr translates an expression. The aggregates are replaced with the variable
+// of their aggregation.
+func (t *translator) expr(node *sparql.Node) Expr {
+    switch node.Rule {
+    case "expression", "brackettedExpression", "constraint", "primaryExpression":
+        if e := node.Child("expression"); e != nil {
+            return t.expr(e)
+        }
+        for _,c := range node.Children {
+            if c.Rule != "LPAREN" && c.Rule != "skip" {
+                return t.expr(c)
+            }
+        }
+    case "conditionalOrExpression", "conditionalAndExpression":
+        // right-recursive in the grammar, but left-associative
+        var operands []Expr
+        op := ""
+        for n := node; n != nil; n = n.Child(node.Rule) {
+            operands = append(operands, t.expr(n.Children[0]))
+            if len(n.Children) > 1 {
+                op = operators[n.Children[1].Rule]
+            }
+        }
+        return fold(op, operands)
+    case "valueLogical":
+        left := t.expr(node.Children[0])
+        if len(node.Children) == 1 {
+            return left
+        }
+        switch c := node.Children[1]; c.Rule {
+        case "in", "notin":
+            return &Call{ Name : c.Rule, Args : append([]Expr{ left }, t.args(c.Child("argList"))...) }
+        default:
+            return &Call{ Name : operators[c.Rule], Args : []Expr{ left, t.expr(node.Children[2]) } }
+        }
+    case "numericExpression", "multiplicativeExpression":
+        acc := t.expr(node.Children[0])
+        for i := 1; i < len(node.Children); i++ {
+            c := node.Children[i]
+            if c.Rule == "signedNumericLiteral" {
+                value := c.Value()
+                acc = &Call{ Name : value[:1], Args : []Expr{ acc, Term(value[1:]) } }
+                continue
+            }
+            i++
+            acc = &Call{ Name : operators[c.Rule], Args : []Expr{ acc, t.expr(node.Children[i]) } }
+        }
+        return acc
+    case "unaryExpression":
+        if len(node.Children) == 1 {
+            return t.expr(node.Children[0])
+        }
+        op := operators[node.Children[0].Rule]
+        operand := t.expr(node.Children[1])
+        // a signed number is a literal
+        if num := node.Children[1].Child("numericLiteral"); num != nil && op != "!" && !strings.ContainsAny(num.Value()[:1], "+-") {
+            return Term(op + num.Value())
+        }
+        return &Call{ Name : op, Args : []Expr{ operand } }
+    case "builtinCall":
+        return t.builtinCall(node)
+    case "functionCall":
+        return &Call{ Name : t.iriref(node.Child("iriref")), Args : t.args(node.Child("argList")) }
+    case "aggregate":
+        return t.aggregate(node)
+    }
+    return Term(t.term(node))
+}
+
+// fold returns the left-associative application of the binary operator
+func fold(op string, operands []Expr) Expr {
+    acc := operands[0]
+    for _,e := range operands[1:] {
+        acc = &Call{ Name : op, Args : []Expr{ acc, e } }
+    }
+    return acc
+}
+
+// args translates the expressions of an argument list
+func (t *translator) args(node *sparql.Node) []Expr {
+    var args []Expr
+    for _,e := range node.ChildrenOf("expression") {
+        args = append(args, t.expr(e))
+    }
+    return args
+}
+
+// builtinCall translates a call to a built-in function
+func (t *translator) builtinCall(node *sparql.Node) Expr {
+    keyword := node.Children[0].Rule
+    switch keyword {
+    case "EXISTS", "NOTEXIST":
+        return &Exists{ Not : keyword == "NOTEXIST", Op : t.groupGraphPattern(node.Child("groupGraphPattern")) }
+    }
+    name, ok := builtinNames[keyword]
+    if !ok {
+        name = strings.ToLower(keyword)
+    }
+    call := &Call{ Name : name }
+    if v := node.Child("var"); v != nil {
+        call.Args = []Expr{ Term(variable(v)) }
+    } else if list := node.Child("argList"); list != nil {
+        call.Args = t.args(list)
+    } else {
+        call.Args = t.args(node)
+    }
+    return call
+}
+
+// aggregate translates the aggregate, and returns the variable of its aggregation
+func (t *translator) aggregate(node *sparql.Node) Expr {
+    agg := &Aggregate{}
+    n := node
+    if c := node.Child("count"); c != nil {
+        n = c
+    } else if c := node.Child("groupConcat"); c != nil {
+        n = c
+        if sep := c.Child("string"); sep != nil {
+            agg.Separator = "\"" + escape(unescape(sep.Children[0])) + "\""
+        }
+    }
+    agg.Name = strings.ToLower(n.Children[0].Rule)
+    if agg.Name == "groupconcat" {
+        agg.Name = "group_concat"
+    }
+    agg.Distinct = n.Child("DISTINCT") != nil
+    if e := n.Child("expression"); e != nil {
+        agg.Expr = t.expr(e)
+    }
+    key := PrintExpr(agg)
+    for _,a := range t.aggregations {
+        if PrintExpr(a.Aggregate) == key {
+            return Term(a.Var)
+        }
+    }
+    v := "?." + strconv.Itoa(len(t.aggregations))
+    t.aggregations = append(t.aggregations, Aggregation{ Var : v, Aggregate : agg })
+    return Term(v)
+}
+
+// orderCondition translates a condition of an ORDER BY
+func (t *translator) orderCondition(node *sparql.Node) OrderCondition {
+    cond := OrderCondition{}
+    for _,c := range node.Children {
+        switch c.Rule {
+        case "ASC", "DESC":
+            cond.Direction = strings.ToLower(c.Rule)
+        default:
+            cond.Expr = t.expr(c)
+        }
+    }
+    return cond
+}
+
+// groupCondition translates a condition of a GROUP BY
+func (t *translator) groupCondition(node *sparql.Node) Binding {
+    if e := node.Child("expression"); e != nil {
+        b := Binding{ Expr : t.expr(e) }
+        if v := node.Child("var"); v != nil {
+            b.Var = variable(v)
+        }
+        return b
+    }
+    return Binding{ Expr : t.expr(node.Children[0]) }
+}
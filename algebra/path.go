@@ -0,0 +1,43 @@
+package algebra
+
+// Path is a property path expression
+type Path interface {
+    isPath()
+}
+
+// Link is a path of a single property
+type Link string
+
+// Reverse is the inverse path, written with '^'
+type Reverse struct {
+    Path Path
+}
+
+// Seq is the sequence of two paths, written with '/'
+type Seq struct {
+    Left, Right Path
+}
+
+// Alt is the alternative between two paths, written with '|'
+type Alt struct {
+    Left, Right Path
+}
+
+// Mod is the path with a modifier, i.e., "*", "+" or "?"
+type Mod struct {
+    Path Path
+    Mod string
+}
+
+// NegatedSet is the path of a property which is not one of the set, written
+// with '!'. The Reverse properties are matched in the inverse direction.
+type NegatedSet struct {
+    Forward, Reverse []string
+}
+
+func (Link) isPath() {}
+func (*Reverse) isPath() {}
+func (*Seq) isPath() {}
+func (*Alt) isPath() {}
+func (*Mod) isPath() {}
+func (*NegatedSet) isPath() {}
@@ -0,0 +1,294 @@
+package algebra
+
+import (
+    "strconv"
+    "strings"
+
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// String returns the algebra expression as an S-expression. The IRIs are
+// written as prefixed names with the declared prefixes.
+func (a *Algebra) String() string {
+    p := &printer{ prefixes : a.Prefixes }
+    indent := 0
+    var out, closing string
+    if a.Base != "" {
+        out += "(base <" + a.Base + ">\n  "
+        closing += ")"
+        indent += 2
+    }
+    if len(a.Prefixes) != 0 {
+        decls := make([]string, len(a.Prefixes))
+        for i,prefix := range a.Prefixes {
+            decls[i] = "(" + prefix.Name + ": <" + prefix.IRI + ">)"
+        }
+        align := "\n" + strings.Repeat(" ", indent + len("(prefix ("))
+        out += "(prefix (" + strings.Join(decls, align) + ")\n" + strings.Repeat(" ", indent + 2)
+        closing += ")"
+        indent += 2
+    }
+    return out + p.op(a.Op, indent) + closing
+}
+
+// Print returns the operator as an S-expression
+func Print(op Op) string {
+    p := &printer{}
+    return p.op(op, 0)
+}
+
+// PrintExpr returns the expression as an S-expression
+func PrintExpr(expr Expr) string {
+    p := &printer{}
+    return p.expr(expr, 0)
+}
+
+// printer writes the S-expression of operators
+type printer struct {
+    // The prefixes used for writing the IRIs
+    prefixes []Prefix
+}
+
+// nested returns the S-expression of an operator with a header, i.e., its
+// name and arguments, applied to the sub-operators. Each sub-operator is on
+// its own line.
+func (p *printer) nested(header string, indent int, ops ...Op) string {
+    out := "(" + header
+    pad := "\n" + strings.Repeat(" ", indent + 2)
+    for _,op := range ops {
+        out += pad + p.op(op, indent + 2)
+    }
+    return out + ")"
+}
+
+// op returns the S-expression of the operator. The lines after the first one
+// are indented.
+func (p *printer) op(op Op, indent int) string {
+    switch o := op.(type) {
+    case *BGP:
+        triples := make([]string, len(o.Triples))
+        for i,t := range o.Triples {
+            triples[i] = "(triple " + p.term(t.S) + " " + p.term(t.P) + " " + p.term(t.O) + ")"
+        }
+        return p.list("bgp", triples, indent)
+    case *PathOp:
+        return "(path " + p.term(o.S) + " " + p.path(o.Path) + " " + p.term(o.O) + ")"
+    case *Table:
+        switch {
+        case o.IsUnit():
+            return "(table unit)"
+        case len(o.Rows) == 0 && len(o.Vars) == 0:
+            return "(table empty)"
+        }
+        rows := make([]string, len(o.Rows))
+        for i,row := range o.Rows {
+            rows[i] = "(row"
+            for j,value := range row {
+                if value != "" && j < len(o.Vars) {
+                    rows[i] += " [" + o.Vars[j] + " " + p.term(value) + "]"
+                }
+            }
+            rows[i] += ")"
+        }
+        return p.list("table (vars" + prefixAll(" ", o.Vars) + ")", rows, indent)
+    case *Sequence:
+        return p.nested("sequence", indent, o.Ops...)
+    case *Join:
+        return p.nested("join", indent, o.Left, o.Right)
+    case *LeftJoin:
+        out := p.nested("leftjoin", indent, o.Left, o.Right)
+        if len(o.Exprs) == 0 {
+            return out
+        }
+        pad := "\n" + strings.Repeat(" ", indent + 2)
+        return out[:len(out) - 1] + pad + p.exprList(o.Exprs, indent + 2) + ")"
+    case *Union:
+        return p.nested("union", indent, o.Left, o.Right)
+    case *Minus:
+        return p.nested("minus", indent, o.Left, o.Right)
+    case *Filter:
+        return p.nested("filter " + p.exprList(o.Exprs, indent), indent, o.Op)
+    case *Graph:
+        return p.nested("graph " + p.term(o.Name), indent, o.Op)
+    case *Service:
+        header := "service "
+        if o.Silent {
+            header += "silent "
+        }
+        return p.nested(header + p.term(o.Name), indent, o.Op)
+    case *Extend:
+        bindings := make([]string, len(o.Bindings))
+        for i,b := range o.Bindings {
+            bindings[i] = "(" + b.Var + " " + p.expr(b.Expr, indent) + ")"
+        }
+        return p.nested("extend (" + strings.Join(bindings, " ") + ")", indent, o.Op)
+    case *Group:
+        keys := make([]string, len(o.Keys))
+        for i,k := range o.Keys {
+            if k.Var == "" {
+                keys[i] = p.expr(k.Expr, indent)
+            } else {
+                keys[i] = "(" + k.Var + " " + p.expr(k.Expr, indent) + ")"
+            }
+        }
+        header := "group (" + strings.Join(keys, " ") + ")"
+        if len(o.Aggregations) != 0 {
+            aggs := make([]string, len(o.Aggregations))
+            for i,a := range o.Aggregations {
+                aggs[i] = "(" + a.Var + " " + p.expr(a.Aggregate, indent) + ")"
+            }
+            header += " (" + strings.Join(aggs, " ") + ")"
+        }
+        return p.nested(header, indent, o.Op)
+    case *OrderBy:
+        conds := make([]string, len(o.Conditions))
+        for i,c := range o.Conditions {
+            conds[i] = p.expr(c.Expr, indent)
+            if c.Direction != "" {
+                conds[i] = "(" + c.Direction + " " + conds[i] + ")"
+            }
+        }
+        return p.nested("order (" + strings.Join(conds, " ") + ")", indent, o.Op)
+    case *Project:
+        return p.nested("project (" + strings.Join(o.Vars, " ") + ")", indent, o.Op)
+    case *Distinct:
+        return p.nested("distinct", indent, o.Op)
+    case *Reduced:
+        return p.nested("reduced", indent, o.Op)
+    case *Slice:
+        return p.nested("slice " + bound(o.Offset) + " " + bound(o.Limit), indent, o.Op)
+    }
+    return ""
+}
+
+// list returns the S-expression with the items. A single item is written on
+// the same line, otherwise each item is on its own line and the closing
+// parenthesis on the last one.
+func (p *printer) list(header string, items []string, indent int) string {
+    switch len(items) {
+    case 0:
+        return "(" + header + ")"
+    case 1:
+        return "(" + header + " " + items[0] + ")"
+    }
+    pad := "\n" + strings.Repeat(" ", indent + 2)
+    return "(" + header + pad + strings.Join(items, pad) + "\n" + strings.Repeat(" ", indent) + ")"
+}
+
+// bound returns the offset or the limit of a Slice, or "_" if it is not set
+func bound(value int64) string {
+    if value < 0 {
+        return "_"
+    }
+    return strconv.FormatInt(value, 10)
+}
+
+// prefixAll returns the concatenation of the values, each preceded by the separator
+func prefixAll(sep string, values []string) string {
+    out := ""
+    for _,v := range values {
+        out += sep + v
+    }
+    return out
+}
+
+// exprList returns the S-expression of the conjunction of expressions
+func (p *printer) exprList(exprs []Expr, indent int) string {
+    if len(exprs) == 1 {
+        return p.expr(exprs[0], indent)
+    }
+    list := make([]string, len(exprs))
+    for i,e := range exprs {
+        list[i] = p.expr(e, indent)
+    }
+    return "(exprlist " + strings.Join(list, " ") + ")"
+}
+
+// expr returns the S-expression of the expression
+func (p *printer) expr(expr Expr, indent int) string {
+    switch e := expr.(type) {
+    case Term:
+        return p.term(string(e))
+    case *Call:
+        out := "(" + p.term(e.Name)
+        for _,arg := range e.Args {
+            out += " " + p.expr(arg, indent)
+        }
+        return out + ")"
+    case *Exists:
+        name := "exists"
+        if e.Not {
+            name = "notexists"
+        }
+        if pattern := p.op(e.Op, indent); !strings.Contains(pattern, "\n") {
+            return "(" + name + " " + pattern + ")"
+        }
+        return p.nested(name, indent + 2, e.Op)
+    case *Aggregate:
+        out := "(" + e.Name
+        if e.Distinct {
+            out += " distinct"
+        }
+        if e.Separator != "" {
+            out += " (separator " + e.Separator + ")"
+        }
+        if e.Expr != nil {
+            out += " " + p.expr(e.Expr, indent)
+        }
+        return out + ")"
+    }
+    return ""
+}
+
+// path returns the S-expression of the property path
+func (p *printer) path(path Path) string {
+    switch e := path.(type) {
+    case Link:
+        return p.term(string(e))
+    case *Reverse:
+        return "(reverse " + p.path(e.Path) + ")"
+    case *Seq:
+        return "(seq " + p.path(e.Left) + " " + p.path(e.Right) + ")"
+    case *Alt:
+        return "(alt " + p.path(e.Left) + " " + p.path(e.Right) + ")"
+    case *Mod:
+        return "(path" + e.Mod + " " + p.path(e.Path) + ")"
+    case *NegatedSet:
+        out := "(notoneof"
+        for _,iri := range e.Forward {
+            out += " " + p.term(iri)
+        }
+        for _,iri := range e.Reverse {
+            out += " (reverse " + p.term(iri) + ")"
+        }
+        return out + ")"
+    }
+    return ""
+}
+
+// term returns the term with its IRIs written as prefixed names, if possible
+func (p *printer) term(term string) string {
+    if strings.HasPrefix(term, "\"") {
+        if i := strings.LastIndex(term, "^^<"); i != -1 && strings.HasSuffix(term, ">") {
+            return term[:i + 2] + p.term(term[i + 2:])
+        }
+        return term
+    }
+    if !strings.HasPrefix(term, "<") || !strings.HasSuffix(term, ">") {
+        return term
+    }
+    iri := sparql.Unbracket(term)
+    found, name, ns := false, "", ""
+    for _,prefix := range p.prefixes {
+        if !strings.HasPrefix(iri, prefix.IRI) || !sparql.PlainLocalRe.MatchString(iri[len(prefix.IRI):]) {
+            continue
+        }
+        if !found || len(prefix.IRI) > len(ns) {
+            found, name, ns = true, prefix.Name, prefix.IRI
+        }
+    }
+    if !found {
+        return term
+    }
+    return name + ":" + iri[len(ns):]
+}
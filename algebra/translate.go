@@ -0,0 +1,673 @@
+package algebra
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// The namespace of the RDF vocabulary
+const rdfNS = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+
+// Prefix is a prefix declaration of the query
+type Prefix struct {
+    // The name of the prefix, without the colon
+    Name string
+    // The namespace IRI, without the angle brackets
+    IRI string
+}
+
+// Algebra is the algebra expression of a query, along with its prologue
+type Algebra struct {
+    // The base IRI, without the angle brackets. It is empty if not declared.
+    Base string
+    // The prefix declarations, in order
+    Prefixes []Prefix
+    // The form of the query, i.e., "select", "construct", "describe" or "ask"
+    Form string
+    // The IRIs of the FROM and of the FROM NAMED clauses, in order
+    From, FromNamed []string
+    // The triple patterns of the template of a CONSTRUCT
+    Template []Triple
+    // The variables and the IRIs of a DESCRIBE. It is empty for DESCRIBE *.
+    Describe []string
+    // The algebra expression
+    Op Op
+}
+
+// Parse parses the query and translates it into the algebra
+func Parse(query string) (*Algebra, error) {
+    s := &sparql.Sparql{ Buffer : query }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        return nil, err
+    }
+    return Translate(s.Tree())
+}
+
+// Translate translates the syntax tree of a parsed query into the algebra
+func Translate(tree *sparql.Node) (*Algebra, error) {
+    t := &translator{
+        algebra : &Algebra{},
+        prefixes : make(map[string]string),
+        labels : make(map[string]string),
+    }
+    var decls []*sparql.Node
+    if prolog := tree.Child("prolog"); prolog != nil {
+        decls = prolog.Children
+    }
+    for _,decl := range decls {
+        iri := t.resolve(sparql.Unbracket(decl.Child("iri").Value()))
+        if decl.Rule == "baseDecl" {
+            t.algebra.Base = iri
+            continue
+        }
+        name := ""
+        if prefix := decl.Child("pnPrefix"); prefix != nil {
+            name = prefix.Value()
+        }
+        t.prefixes[name] = iri
+        t.algebra.Prefixes = append(t.algebra.Prefixes, Prefix{ Name : name, IRI : iri })
+    }
+    query := tree.Child("query")
+    form := query.Children[0]
+    t.algebra.Form = strings.TrimSuffix(form.Rule, "Query")
+    for _,clause := range form.ChildrenOf("datasetClause") {
+        iri := t.iriref(clause.Child("iriref"))
+        if clause.Child("NAMED") != nil {
+            t.algebra.FromNamed = append(t.algebra.FromNamed, iri)
+        } else {
+            t.algebra.From = append(t.algebra.From, iri)
+        }
+    }
+    t.algebra.Op = t.query(form.Child("select"), form.Child("whereClause"), form.Child("solutionModifier"), query.Child("valuesClause"))
+    if describe := form.Child("describe"); describe != nil {
+        for _,c := range describe.Children {
+            if c.Rule == "var" || c.Rule == "iriref" {
+                t.algebra.Describe = append(t.algebra.Describe, t.term(c))
+            }
+        }
+    }
+    if template := form.Child("construct"); template != nil {
+        if block := template.Child("triplesBlock"); block != nil {
+            // the blank nodes of the template are distinct from those of the pattern
+            t.labels = make(map[string]string)
+            Walk(t.triplesBlock(block), func(op Op) bool {
+                if bgp, ok := op.(*BGP); ok {
+                    t.algebra.Template = append(t.algebra.Template, bgp.Triples...)
+                }
+                return true
+            })
+        }
+    }
+    if t.err != nil {
+        return nil, t.err
+    }
+    return t.algebra, nil
+}
+
+// translator holds the state of the translation of a query
+type translator struct {
+    algebra *Algebra
+    // The namespace of each prefix
+    prefixes map[string]string
+    // The variable of each labelled blank node
+    labels map[string]string
+    // The number of variables created for blank nodes and for paths
+    bnodes, pathVars int
+    // The aggregations of the query being translated
+    aggregations []Aggregation
+    // The first error of the translation
+    err error
+}
+
+// query translates a query or a sub-query. The select clause is nil if the
+// query is not a SELECT, and the where clause is nil for a DESCRIBE without
+// one.
+func (t *translator) query(selectClause, where, modifier, values *sparql.Node) Op {
+    var op Op = Unit()
+    if where != nil {
+        op = t.groupGraphPattern(where.Child("groupGraphPattern"))
+    }
+    outer := t.aggregations
+    t.aggregations = nil
+    defer func() { t.aggregations = outer }()
+
+    // the expressions are translated first so that the aggregates are collected
+    var project []string
+    var extend []Binding
+    star := true
+    if selectClause != nil && selectClause.Child("STAR") == nil {
+        star = false
+        for _,elem := range selectClause.ChildrenOf("projectionElem") {
+            v := variable(elem.Child("var"))
+            project = append(project, v)
+            if expr := elem.Child("expression"); expr != nil {
+                extend = append(extend, Binding{ Var : v, Expr : t.expr(expr) })
+            }
+        }
+    }
+    var having []Expr
+    var order []OrderCondition
+    var keys []Binding
+    group := false
+    if modifier != nil {
+        if clause := modifier.Child("havingClause"); clause != nil {
+            for _,c := range clause.ChildrenOf("constraint") {
+                having = append(having, t.expr(c))
+            }
+        }
+        if clause := modifier.Child("orderClause"); clause != nil {
+            for _,c := range clause.ChildrenOf("orderCondition") {
+                order = append(order, t.orderCondition(c))
+            }
+        }
+        if clause := modifier.Child("groupClause"); clause != nil {
+            group = true
+            for _,c := range clause.ChildrenOf("groupCondition") {
+                keys = append(keys, t.groupCondition(c))
+            }
+        }
+    }
+
+    if group || len(t.aggregations) != 0 {
+        op = &Group{ Keys : keys, Aggregations : t.aggregations, Op : op }
+    }
+    if len(having) != 0 {
+        op = &Filter{ Exprs : having, Op : op }
+    }
+    if values != nil {
+        op = &Join{ Left : op, Right : t.inlineData(values.Child("inlineData")) }
+    }
+    if len(extend) != 0 {
+        op = &Extend{ Bindings : extend, Op : op }
+    }
+    if len(order) != 0 {
+        op = &OrderBy{ Conditions : order, Op : op }
+    }
+    if !star {
+        op = &Project{ Vars : project, Op : op }
+    }
+    if selectClause != nil {
+        if selectClause.Child("DISTINCT") != nil {
+            op = &Distinct{ Op : op }
+        } else if selectClause.Child("REDUCED") != nil {
+            op = &Reduced{ Op : op }
+        }
+    }
+    if modifier != nil {
+        if clause := modifier.Child("limitOffsetClauses"); clause != nil {
+            slice := &Slice{ Offset : -1, Limit : -1, Op : op }
+            if limit := clause.Child("limit"); limit != nil {
+                slice.Limit, _ = strconv.ParseInt(limit.Child("INTEGER").Value(), 10, 64)
+            }
+            if offset := clause.Child("offset"); offset != nil {
+                slice.Offset, _ = strconv.ParseInt(offset.Child("INTEGER").Value(), 10, 64)
+            }
+            op = slice
+        }
+    }
+    return op
+}
+
+// groupGraphPattern translates a group of patterns, or a sub-query
+func (t *translator) groupGraphPattern(node *sparql.Node) Op {
+    if sub := node.Child("subSelect"); sub != nil {
+        return t.query(sub.Child("select"), sub.Child("whereClause"), sub.Child("solutionModifier"), sub.Child("valuesClause"))
+    }
+    return t.graphPattern(node.Child("graphPattern"))
+}
+
+// elements returns the elements of the group, i.e., the blocks of triples,
+// the filters and binds, and the other patterns, in order
+func elements(node *sparql.Node) []*sparql.Node {
+    var elems []*sparql.Node
+    for _,c := range node.Children {
+        switch c.Rule {
+        case "basicGraphPattern", "graphPattern":
+            elems = append(elems, elements(c)...)
+        case "graphPatternNotTriples":
+            elems = append(elems, c.Children[0])
+        case "triplesBlock", "filterOrBind":
+            elems = append(elems, c)
+        }
+    }
+    return elems
+}
+
+// graphPattern translates the patterns of a group. The node is nil if the
+// group is empty.
+func (t *translator) graphPattern(node *sparql.Node) Op {
+    if node == nil {
+        return Unit()
+    }
+    var op Op
+    var filters []Expr
+    for _,elem := range elements(node) {
+        switch elem.Rule {
+        case "triplesBlock":
+            op = join(op, t.triplesBlock(elem))
+        case "filterOrBind":
+            if elem.Child("FILTER") != nil {
+                filters = append(filters, t.expr(elem.Child("constraint")))
+            } else {
+                if op == nil {
+                    op = Unit()
+                }
+                b := Binding{ Var : variable(elem.Child("var")), Expr : t.expr(elem.Child("expression")) }
+                op = &Extend{ Bindings : []Binding{ b }, Op : op }
+            }
+        case "optionalGraphPattern":
+            if op == nil {
+                op = Unit()
+            }
+            var right Op
+            if sub := elem.Child("subSelect"); sub != nil {
+                right = t.query(sub.Child("select"), sub.Child("whereClause"), sub.Child("solutionModifier"), sub.Child("valuesClause"))
+            } else {
+                right = t.graphPattern(elem.Child("graphPattern"))
+            }
+            if filter, ok := right.(*Filter); ok {
+                op = &LeftJoin{ Left : op, Right : filter.Op, Exprs : filter.Exprs }
+            } else {
+                op = &LeftJoin{ Left : op, Right : right }
+            }
+        case "minusGraphPattern":
+            if op == nil {
+                op = Unit()
+            }
+            op = &Minus{ Left : op, Right : t.groupGraphPattern(elem.Child("groupGraphPattern")) }
+        case "groupOrUnionGraphPattern":
+            op = join(op, t.union(elem))
+        case "graphGraphPattern":
+            op = join(op, &Graph{ Name : t.varOrIRI(elem), Op : t.groupGraphPattern(elem.Child("groupGraphPattern")) })
+        case "serviceGraphPattern":
+            service := &Service{
+                Name : t.varOrIRI(elem),
+                Silent : elem.Child("SILENT") != nil,
+                Op : t.groupGraphPattern(elem.Child("groupGraphPattern")),
+            }
+            op = join(op, service)
+        case "inlineData":
+            op = join(op, t.inlineData(elem))
+        }
+    }
+    if op == nil {
+        op = Unit()
+    }
+    if len(filters) != 0 {
+        op = &Filter{ Exprs : filters, Op : op }
+    }
+    return op
+}
+
+// join returns the join of the operators, where a nil left operator is the
+// empty group. Adjacent basic graph patterns are merged.
+func join(left Op, right Op) Op {
+    if left == nil {
+        return right
+    }
+    if l, ok := left.(*BGP); ok {
+        if r, ok := right.(*BGP); ok {
+            return &BGP{ Triples : append(append([]Triple{}, l.Triples...), r.Triples...) }
+        }
+    }
+    return &Join{ Left : left, Right : right }
+}
+
+// union translates a group or a union of groups
+func (t *translator) union(node *sparql.Node) Op {
+    var op Op
+    for n := node; n != nil; n = n.Child("groupOrUnionGraphPattern") {
+        group := t.groupGraphPattern(n.Child("groupGraphPattern"))
+        if op == nil {
+            op = group
+        } else {
+            op = &Union{ Left : op, Right : group }
+        }
+    }
+    return op
+}
+
+// varOrIRI returns the variable or the IRI child of the node
+func (t *translator) varOrIRI(node *sparql.Node) string {
+    if v := node.Child("var"); v != nil {
+        return variable(v)
+    }
+    return t.iriref(node.Child("iriref"))
+}
+
+// inlineData translates a VALUES block into a table
+func (t *translator) inlineData(node *sparql.Node) Op {
+    block := node.Find("dataBlock").Children[0]
+    table := &Table{}
+    if block.Rule == "inlineDataOneVar" {
+        table.Vars = []string{ variable(block.Child("var")) }
+        for _,value := range block.ChildrenOf("dataBlockValue") {
+            table.Rows = append(table.Rows, []string{ t.dataBlockValue(value) })
+        }
+        return table
+    }
+    values := false
+    var row []string
+    for _,c := range block.Children {
+        switch c.Rule {
+        case "var":
+            table.Vars = append(table.Vars, variable(c))
+        case "LBRACE":
+            values = true
+        case "LPAREN":
+            row = []string{}
+        case "dataBlockValue":
+            row = append(row, t.dataBlockValue(c))
+        case "RPAREN":
+            if values {
+                table.Rows = append(table.Rows, row)
+            }
+        case "nil":
+            if values {
+                table.Rows = append(table.Rows, []string{})
+            }
+        }
+    }
+    return table
+}
+
+// dataBlockValue returns the term of the value, or the empty string for UNDEF
+func (t *translator) dataBlockValue(node *sparql.Node) string {
+    if node.Child("UNDEF") != nil {
+        return ""
+    }
+    return t.term(node.Children[0])
+}
+
+// block collects the operators of a block of triples
+type block struct {
+    ops []Op
+}
+
+// addTriple adds the triple pattern to the current basic graph pattern
+func (b *block) addTriple(s, p, o string) {
+    if len(b.ops) != 0 {
+        if bgp, ok := b.ops[len(b.ops) - 1].(*BGP); ok {
+            bgp.Triples = append(bgp.Triples, Triple{ s, p, o })
+            return
+        }
+    }
+    b.ops = append(b.ops, &BGP{ Triples : []Triple{ { s, p, o } } })
+}
+
+// op returns the basic graph pattern of the block, or the sequence of basic
+// graph patterns and paths
+func (b *block) op() Op {
+    if len(b.ops) == 1 {
+        return b.ops[0]
+    }
+    return &Sequence{ Ops : b.ops }
+}
+
+// triplesBlock translates a block of triples
+func (t *translator) triplesBlock(node *sparql.Node) Op {
+    b := &block{}
+    for _,tss := range node.ChildrenOf("triplesSameSubjectPath") {
+        var subject string
+        if n := tss.Child("varOrTerm"); n != nil {
+            subject = t.term(n)
+        } else {
+            subject = t.triplesNode(tss.Child("triplesNodePath"), b)
+        }
+        if pl := tss.Child("propertyListPath"); pl != nil {
+            t.propertyList(subject, pl, b)
+        }
+    }
+    return b.op()
+}
+
+// propertyList adds the triples of the property list of the subject
+func (t *translator) propertyList(subject string, node *sparql.Node, b *block) {
+    for pl := node; pl != nil; pl = pl.Child("propertyListPath") {
+        var verb Path
+        if v := pl.Child("var"); v != nil {
+            verb = Link(variable(v))
+        } else {
+            verb = t.path(pl.Find("pathAlternative"))
+        }
+        for _,object := range pl.Child("objectListPath").ChildrenOf("objectPath") {
+            t.addPath(subject, verb, t.graphNode(object.Child("graphNodePath"), b), b)
+        }
+    }
+}
+
+// graphNode returns the term of the node, after adding the triples of a
+// blank node property list or of a collection
+func (t *translator) graphNode(node *sparql.Node, b *block) string {
+    if n := node.Child("triplesNodePath"); n != nil {
+        return t.triplesNode(n, b)
+    }
+    return t.term(node.Child("varOrTerm"))
+}
+
+// triplesNode adds the triples of a blank node property list or of a
+// collection, and returns the term of the node
+func (t *translator) triplesNode(node *sparql.Node, b *block) string {
+    if list := node.Child("blankNodePropertyListPath"); list != nil {
+        v := t.newBlankNode()
+        t.propertyList(v, list.Child("propertyListPath"), b)
+        return v
+    }
+    var items []string
+    for _,item := range node.Child("collectionPath").ChildrenOf("graphNodePath") {
+        items = append(items, t.graphNode(item, b))
+    }
+    head := t.newBlankNode()
+    cur := head
+    for i,item := range items {
+        b.addTriple(cur, "<" + rdfNS + "first>", item)
+        next := "<" + rdfNS + "nil>"
+        if i < len(items) - 1 {
+            next = t.newBlankNode()
+        }
+        b.addTriple(cur, "<" + rdfNS + "rest>", next)
+        cur = next
+    }
+    return head
+}
+
+// addPath adds the path between the subject and the object. A path of links
+// is reduced to triple patterns.
+func (t *translator) addPath(s string, path Path, o string, b *block) {
+    switch p := path.(type) {
+    case Link:
+        b.addTriple(s, string(p), o)
+        return
+    case *Reverse:
+        if link, ok := p.Path.(Link); ok {
+            b.addTriple(o, string(link), s)
+            return
+        }
+    case *Seq:
+        steps := sequence(p)
+        for i,step := range steps {
+            next := o
+            if i < len(steps) - 1 {
+                next = "??P" + strconv.Itoa(t.pathVars)
+                t.pathVars++
+            }
+            t.addPath(s, step, next, b)
+            s = next
+        }
+        return
+    }
+    b.ops = append(b.ops, &PathOp{ S : s, Path : path, O : o })
+}
+
+// sequence returns the steps of the sequence path, in order
+func sequence(path Path) []Path {
+    if seq, ok := path.(*Seq); ok {
+        return append(sequence(seq.Left), sequence(seq.Right)...)
+    }
+    return []Path{ path }
+}
+
+// path translates a property path
+func (t *translator) path(node *sparql.Node) Path {
+    var alt Path
+    for _,seq := range node.ChildrenOf("pathSequence") {
+        var path Path
+        for _,elt := range seq.ChildrenOf("pathElt") {
+            p := t.pathPrimary(elt.Child("pathPrimary"))
+            if mod := elt.Child("pathMod"); mod != nil {
+                p = &Mod{ Path : p, Mod : mod.Value() }
+            }
+            if elt.Child("INVERSE") != nil {
+                p = &Reverse{ Path : p }
+            }
+            if path == nil {
+                path = p
+            } else {
+                path = &Seq{ Left : path, Right : p }
+            }
+        }
+        if alt == nil {
+            alt = path
+        } else {
+            alt = &Alt{ Left : alt, Right : path }
+        }
+    }
+    return alt
+}
+
+// pathPrimary translates a property, a negated property set, or a bracketted path
+func (t *translator) pathPrimary(node *sparql.Node) Path {
+    switch {
+    case node.Child("iriref") != nil:
+        return Link(t.iriref(node.Child("iriref")))
+    case node.Child("ISA") != nil:
+        return Link("<" + rdfNS + "type>")
+    case node.Child("pathNegatedPropertySet") != nil:
+        set := &NegatedSet{}
+        for _,one := range node.Find("pathNegatedPropertySet").FindAll("pathOneInPropertySet") {
+            iri := "<" + rdfNS + "type>"
+            if n := one.Child("iriref"); n != nil {
+                iri = t.iriref(n)
+            }
+            if one.Child("INVERSE") != nil {
+                set.Reverse = append(set.Reverse, iri)
+            } else {
+                set.Forward = append(set.Forward, iri)
+            }
+        }
+        return set
+    }
+    return t.path(node.Find("pathAlternative"))
+}
+
+// newBlankNode returns a new variable standing for a blank node
+func (t *translator) newBlankNode() string {
+    v := "??" + strconv.Itoa(t.bnodes)
+    t.bnodes++
+    return v
+}
+
+// term returns the term of the node, in its SPARQL syntax
+func (t *translator) term(node *sparql.Node) string {
+    for node.Rule == "varOrTerm" || node.Rule == "graphTerm" {
+        node = node.Children[0]
+    }
+    switch node.Rule {
+    case "var":
+        return variable(node)
+    case "iriref":
+        return t.iriref(node)
+    case "literal":
+        return t.literal(node)
+    case "booleanLiteral":
+        return strings.ToLower(node.Value())
+    case "blankNode":
+        if label := node.Child("blankNodeLabel"); label != nil {
+            v, ok := t.labels[label.Value()]
+            if !ok {
+                v = t.newBlankNode()
+                t.labels[label.Value()] = v
+            }
+            return v
+        }
+        return t.newBlankNode()
+    case "nil":
+        return "<" + rdfNS + "nil>"
+    }
+    return node.Value()
+}
+
+// variable returns the variable of the node, with the '?' prefix
+func variable(node *sparql.Node) string {
+    return "?" + node.Value()[1:]
+}
+
+// iriref returns the absolute IRI of an IRI reference or of a prefixed name
+func (t *translator) iriref(node *sparql.Node) string {
+    if iri := node.Child("iri"); iri != nil {
+        return "<" + t.resolve(sparql.Unbracket(iri.Value())) + ">"
+    }
+    name := node.Child("prefixedName")
+    prefix := ""
+    if n := name.Child("pnPrefix"); n != nil {
+        prefix = n.Value()
+    }
+    ns, ok := t.prefixes[prefix]
+    if !ok && t.err == nil {
+        t.err = fmt.Errorf("Undeclared prefix [%v:] at position %v", prefix, name.Begin)
+    }
+    local := ""
+    if n := name.Child("pnLocal"); n != nil {
+        local = n.Value()
+        for _,esc := range n.FindAll("pnLocalEsc") {
+            local = strings.Replace(local, esc.Value(), esc.Value()[1:], 1)
+        }
+    }
+    return "<" + ns + local + ">"
+}
+
+// resolve returns the IRI resolved against the base IRI
+func (t *translator) resolve(iri string) string {
+    return sparql.ResolveIRI(t.algebra.Base, iri)
+}
+
+// literal returns the literal as a double-quoted string, with its language
+// tag or datatype
+func (t *translator) literal(node *sparql.Node) string {
+    str := node.Child("string")
+    lexical := "\"" + escape(unescape(str.Children[0])) + "\""
+    if dt := node.Child("iriref"); dt != nil {
+        return lexical + "^^" + t.iriref(dt)
+    }
+    text := node.Value()
+    return lexical + text[len(str.Value()):]
+}
+
+// unescape returns the value of a string literal
+func unescape(node *sparql.Node) string {
+    value := node.Value()
+    quotes := 1
+    if node.Rule == "stringLiteralLongA" || node.Rule == "stringLiteralLongB" {
+        quotes = 3
+    }
+    return sparql.Unescape(value[quotes:len(value) - quotes])
+}
+
+// Escapes the characters of a double-quoted string literal
+var escaper = strings.NewReplacer(
+    "\\", "\\\\",
+    "\"", "\\\"",
+    "\n", "\\n",
+    "\r", "\\r",
+    "\t", "\\t",
+    "\b", "\\b",
+    "\f", "\\f",
+)
+
+// escape escapes the value of a double-quoted string literal
+func escape(value string) string {
+    return escaper.Replace(value)
+}
@@ -0,0 +1,318 @@
+/*
+ Package anonymize replaces the sensitive content of queries with pseudonyms,
+ e.g., before sharing a query log.
+
+ The variables, the blank node labels, the strings of the literals, the
+ numbers, the booleans, and the IRIs within some namespaces are replaced,
+ including those of the PREFIX and BASE declarations. A number is replaced with
+ a number of the same datatype, and a boolean with a literal of the boolean
+ datatype. An IRI equal to a namespace is kept, and the relative IRIs are
+ resolved against the BASE. The comments are removed. The keywords and the
+ other IRIs are kept, and so is the structure of the query.
+ A same term has the same pseudonym in all the queries anonymized with an
+ Anonymizer, so that the queries can still be compared. For example, with the
+ namespace "http://dbpedia.org/resource/", the query
+
+    PREFIX dbr: <http://dbpedia.org/resource/>
+    SELECT ?name { dbr:Paris <name> ?name ; <area> 105.4 FILTER(?name != "Paris") }
+
+ is anonymized into
+
+    PREFIX dbr: <http://dbpedia.org/resource/>
+    SELECT ?v0 { dbr:iri0 <name> ?v0 ; <area> 0.0 FILTER(?v0 != "literal0") }
+
+ The mapping of the pseudonyms to the original terms allows to restore the
+ queries, and so must be kept private. A restored query is equivalent to the
+ original one, but not always equal: a same term has a single pseudonym
+ whatever the way it is written, so that a prefixed name or a relative IRI may
+ be restored as a full IRI, e.g., after its prefix was replaced, and the comments
+ are lost.
+*/
+package anonymize
+
+import (
+    "encoding/json"
+    "fmt"
+    "io"
+    "regexp"
+    "sort"
+    "strconv"
+    "strings"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// Escaped characters of the local part of a prefixed name
+var localEscRe = regexp.MustCompile(`\\(.)`)
+
+// The IRI of the boolean datatype
+const xsdBoolean = "<http://www.w3.org/2001/XMLSchema#boolean>"
+
+// The pseudonyms of each kind of term, with their number as the first group
+var pseudonymRes = map[byte]*regexp.Regexp{
+    '?' : regexp.MustCompile(`^\?v([0-9]+)$`),
+    '_' : regexp.MustCompile(`^_:b([0-9]+)$`),
+    '"' : regexp.MustCompile(`^"literal([0-9]+)"$`),
+    '<' : regexp.MustCompile(`^<.*iri([0-9]+)>$`),
+    'b' : regexp.MustCompile(`^"boolean([0-9]+)"\^\^` + regexp.QuoteMeta(xsdBoolean) + `$`),
+    'i' : regexp.MustCompile(`^([0-9]+)$`),
+    'd' : regexp.MustCompile(`^([0-9]+)\.0$`),
+    'e' : regexp.MustCompile(`^([0-9]+)E0$`),
+}
+
+// Anonymizer replaces terms of queries with pseudonyms
+type Anonymizer struct {
+    // The namespaces of the IRIs that are replaced
+    Namespaces []string
+    // The original term of each pseudonym
+    Mapping map[string]string
+    // The pseudonym of each original term
+    pseudonyms map[string]string
+    // The number of the next pseudonym of each kind of term
+    counts map[byte]int
+}
+
+// NewAnonymizer returns an Anonymizer of the IRIs within the namespaces
+func NewAnonymizer(namespaces []string) *Anonymizer {
+    return &Anonymizer{
+        Namespaces : namespaces,
+        Mapping : make(map[string]string),
+        pseudonyms : make(map[string]string),
+        counts : make(map[byte]int),
+    }
+}
+
+// LoadMapping adds the pseudonyms of a mapping written by SaveMapping, so that
+// the same pseudonyms are used for the terms of the mapping. The new pseudonyms
+// are numbered after the greatest number of the mapping of their kind.
+func (a *Anonymizer) LoadMapping(r io.Reader) error {
+    var mapping map[string]string
+    if err := json.NewDecoder(r).Decode(&mapping); err != nil {
+        return err
+    }
+    for pseudonym,term := range mapping {
+        a.Mapping[pseudonym] = term
+        a.pseudonyms[term] = pseudonym
+        for kind,re := range pseudonymRes {
+            if m := re.FindStringSubmatch(pseudonym); m != nil {
+                if n, _ := strconv.Atoi(m[1]); n >= a.counts[kind] {
+                    a.counts[kind] = n + 1
+                }
+                break
+            }
+        }
+    }
+    return nil
+}
+
+// SaveMapping writes the mapping of the pseudonyms to the original terms, as a
+// JSON object
+func (a *Anonymizer) SaveMapping(w io.Writer) error {
+    out, err := json.MarshalIndent(a.Mapping, "", "  ")
+    if err != nil {
+        return err
+    }
+    _, err = w.Write(append(out, '\n'))
+    return err
+}
+
+// Anonymize returns the query with its terms replaced by their pseudonyms
+func (a *Anonymizer) Anonymize(query string) (string, error) {
+    return replace(query, a.pseudonym)
+}
+
+// Restore returns the anonymized query with the pseudonyms of the mapping
+// replaced by their original terms. The prefixed names are restored with their
+// prefix if possible, and the other IRIs as full IRIs.
+func (a *Anonymizer) Restore(query string) (string, error) {
+    return replace(query, func(term string) (string, bool) {
+        original, ok := a.Mapping[term]
+        return original, ok
+    })
+}
+
+// pseudonym returns the pseudonym of the term, which is created if it is the
+// first time the term is seen. False is returned if the term is kept.
+func (a *Anonymizer) pseudonym(term string) (string, bool) {
+    if pseudonym, ok := a.pseudonyms[term]; ok {
+        return pseudonym, true
+    }
+    kind := term[0]
+    switch {
+    case kind == '\'':
+        kind = '"'
+    case strings.EqualFold(term, "true") || strings.EqualFold(term, "false"):
+        kind = 'b'
+    case kind == '.' || kind >= '0' && kind <= '9':
+        kind = 'i'
+        if strings.ContainsAny(term, "eE") {
+            kind = 'e'
+        } else if strings.Contains(term, ".") {
+            kind = 'd'
+        }
+    }
+    var pseudonym string
+    n := strconv.Itoa(a.counts[kind])
+    switch kind {
+    case '?':
+        pseudonym = "?v" + n
+    case '_':
+        pseudonym = "_:b" + n
+    case '"':
+        // the string of a literal with a language tag or a datatype is
+        // replaced on its own
+        if term[len(term) - 1] != term[0] {
+            return "", false
+        }
+        pseudonym = `"literal` + n + `"`
+    case 'b':
+        pseudonym = `"boolean` + n + `"^^` + xsdBoolean
+    case 'i':
+        pseudonym = n
+    case 'd':
+        pseudonym = n + ".0"
+    case 'e':
+        pseudonym = n + "E0"
+    case '<':
+        // a namespace reveals nothing about the terms within it
+        ns := namespace(term[1:len(term) - 1], a.Namespaces)
+        if ns == "" || term == "<" + ns + ">" {
+            return "", false
+        }
+        pseudonym = "<" + ns + "iri" + n + ">"
+    }
+    a.counts[kind]++
+    a.pseudonyms[term] = pseudonym
+    a.Mapping[pseudonym] = term
+    return pseudonym, true
+}
+
+// namespace returns the longest namespace of the IRI, or the empty string if
+// it is within none of them
+func namespace(iri string, namespaces []string) string {
+    longest := ""
+    for _,ns := range namespaces {
+        if ns != "" && strings.HasPrefix(iri, ns) && len(ns) > len(longest) {
+            longest = ns
+        }
+    }
+    return longest
+}
+
+// An edit of the text of the query, i.e., the runes from begin to end are
+// replaced with the text
+type edit struct {
+    begin, end int
+    text string
+}
+
+// replace returns the query with its terms replaced with the function, which
+// returns false for a term that is kept, and without its comments. The terms
+// are the variables written with "?", the blank node labels, the literals with
+// a datatype, else their strings, the unsigned numbers, the booleans, and the
+// IRIs between angle brackets. A relative IRI is given resolved against the
+// BASE, and a prefixed name as its IRI, which is written back with its prefix
+// if possible.
+func replace(query string, f func(string) (string, bool)) (string, error) {
+    p := &sparql.Sparql{ Buffer : query }
+    p.Init()
+    if err := p.Parse(); err != nil {
+        return "", err
+    }
+    tree := p.Tree()
+    prefixes := p.Prefixes()
+    var edits []edit
+    for _,comment := range tree.FindAll("comment") {
+        text := strings.TrimRight(comment.Text, "\r\n")
+        edits = append(edits, edit{ comment.Begin, comment.Begin + len([]rune(text)), "" })
+    }
+    // the IRIs of the declarations are replaced as they are written
+    base := ""
+    if prolog := tree.Child("prolog"); prolog != nil {
+        for _,decl := range prolog.Children {
+            iri := decl.Child("iri")
+            text := iri.Value()
+            if decl.Rule == "baseDecl" {
+                base = resolve(base, text)
+            }
+            if r, ok := f(text); ok {
+                edits = append(edits, edit{ iri.Begin, iri.Begin + len([]rune(text)), r })
+            }
+        }
+    }
+    var err error
+    tree.Walk(func(n *sparql.Node) bool {
+        if err != nil {
+            return false
+        }
+        text := n.Value()
+        switch n.Rule {
+        case "prolog":
+            return false
+        case "var":
+            if r, ok := f("?" + text[1:]); ok {
+                edits = append(edits, edit{ n.Begin, n.Begin + len([]rune(text)), text[:1] + r[1:] })
+            }
+            return false
+        case "iri":
+            if r, ok := f(resolve(base, text)); ok {
+                edits = append(edits, edit{ n.Begin, n.Begin + len([]rune(text)), r })
+            }
+            return false
+        case "literal":
+            // a pseudonym of a boolean is a literal with the boolean datatype
+            if n.Child("iriref") != nil {
+                if r, ok := f(text); ok {
+                    edits = append(edits, edit{ n.Begin, n.Begin + len([]rune(text)), r })
+                    return false
+                }
+            }
+            return true
+        case "blankNodeLabel", "string", "unsignedNumericLiteral", "booleanLiteral":
+            if r, ok := f(text); ok {
+                edits = append(edits, edit{ n.Begin, n.Begin + len([]rune(text)), r })
+            }
+            return false
+        case "prefixedName":
+            prefix := ""
+            if pnPrefix := n.Child("pnPrefix"); pnPrefix != nil {
+                prefix = pnPrefix.Value()
+            }
+            ns, declared := prefixes[prefix]
+            if !declared {
+                err = fmt.Errorf("Undeclared prefix [%v:] at position %v", prefix, n.Begin)
+                return false
+            }
+            local := ""
+            if pnLocal := n.Child("pnLocal"); pnLocal != nil {
+                local = localEscRe.ReplaceAllString(pnLocal.Value(), "$1")
+            }
+            if r, ok := f("<" + ns + local + ">"); ok {
+                r = sparql.CompactIRI(r, map[string]string{ prefix : ns })
+                edits = append(edits, edit{ n.Begin, n.Begin + len([]rune(text)), r })
+            }
+            return false
+        }
+        return true
+    })
+    if err != nil {
+        return "", err
+    }
+    sort.Slice(edits, func(i, j int) bool { return edits[i].begin < edits[j].begin })
+    runes := []rune(query)
+    out := ""
+    last := 0
+    for _,e := range edits {
+        out += string(runes[last:e.begin]) + e.text
+        last = e.end
+    }
+    return out + string(runes[last:]), nil
+}
+
+// resolve returns the IRI, between angle brackets, resolved against the base
+// IRI. A prefixed name is returned unchanged.
+func resolve(base string, iri string) string {
+    if !strings.HasPrefix(iri, "<") {
+        return iri
+    }
+    return "<" + sparql.ResolveIRI(sparql.Unbracket(base), sparql.Unbracket(iri)) + ">"
+}
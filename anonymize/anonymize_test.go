@@ -0,0 +1,175 @@
+package anonymize
+
+import (
+    "bytes"
+    "strings"
+    "testing"
+    "github.com/scampi/gosparqled/shape"
+)
+
+const dbr = "http://dbpedia.org/resource/"
+
+func anonymize(t *testing.T, a *Anonymizer, query string) string {
+    anonymized, err := a.Anonymize(query)
+    if err != nil {
+        t.Fatalf("Failed to anonymize query\n%v\n%v", query, err)
+    }
+    return anonymized
+}
+
+func TestAnonymize(t *testing.T) {
+    tests := map[string]string{
+        `PREFIX dbr: <http://dbpedia.org/resource/>
+SELECT ?name { dbr:Paris <name> ?name FILTER(?name != "Paris") }` :
+        `PREFIX dbr: <http://dbpedia.org/resource/>
+SELECT ?v0 { dbr:iri0 <name> ?v0 FILTER(?v0 != "literal0") }`,
+        // the language tags and the datatypes are kept
+        `SELECT * { $s <age> 42 ; <label> 'x'@fr, "y"^^<http://www.w3.org/2001/XMLSchema#string> }` :
+        `SELECT * { $v0 <age> 0 ; <label> "literal0"@fr, "literal1"^^<http://www.w3.org/2001/XMLSchema#string> }`,
+        // the numbers keep their datatype, and the booleans are typed literals
+        `SELECT * { ?s <p> 7, -7, +1.5, 2e10, .5, true, FALSE FILTER(?s > 7 - 3) }` :
+        `SELECT * { ?v0 <p> 0, -0, +0.0, 0E0, 1.0, "boolean0"^^<http://www.w3.org/2001/XMLSchema#boolean>, "boolean1"^^<http://www.w3.org/2001/XMLSchema#boolean> FILTER(?v0 > 0 - 1) }`,
+        `SELECT * { _:b <p> <http://dbpedia.org/resource/R%C3%A9union> , <http://dbpedia.org/ontology/Place> }` :
+        `SELECT * { _:b0 <p> <http://dbpedia.org/resource/iri0> , <http://dbpedia.org/ontology/Place> }`,
+        // the pseudonym cannot be written with a prefix of a parent namespace
+        `PREFIX db: <http://dbpedia.org/> SELECT * { ?s ?p db:resource\/Paris }` :
+        `PREFIX db: <http://dbpedia.org/> SELECT * { ?v0 ?v1 <http://dbpedia.org/resource/iri0> }`,
+    }
+    for query, expected := range tests {
+        if actual := anonymize(t, NewAnonymizer([]string{ dbr }), query); actual != expected {
+            t.Errorf("Expected\n%v\nbut got\n%v", expected, actual)
+        }
+    }
+}
+
+func TestConsistentPseudonyms(t *testing.T) {
+    a := NewAnonymizer([]string{ dbr })
+    first := anonymize(t, a, `SELECT * { ?city <mayor> ?m . ?m <name> "Anne" }`)
+    second := anonymize(t, a, `SELECT * { ?m <name> "Anne" . ?other <name> "Bob" }`)
+    if expected := `SELECT * { ?v0 <mayor> ?v1 . ?v1 <name> "literal0" }`; first != expected {
+        t.Errorf("Expected\n%v\nbut got\n%v", expected, first)
+    }
+    if expected := `SELECT * { ?v1 <name> "literal0" . ?v2 <name> "literal1" }`; second != expected {
+        t.Errorf("Expected\n%v\nbut got\n%v", expected, second)
+    }
+}
+
+func TestRestore(t *testing.T) {
+    queries := []string{
+        `PREFIX dbr: <http://dbpedia.org/resource/>
+SELECT ?name { dbr:Paris <name> ?name ; <knows> [ <p> _:x ] FILTER(?name != 'Paris') }`,
+        `SELECT * { ?s <p> <http://dbpedia.org/resource/Lyon> ; <name> """long
+string""" }`,
+        `SELECT * { ?s <age> 42 ; <height> 1.85 ; <alive> true ; <date> "2000"^^<http://www.w3.org/2001/XMLSchema#gYear> } LIMIT 10`,
+    }
+    a := NewAnonymizer([]string{ dbr })
+    var anonymized []string
+    for _,query := range queries {
+        anonymized = append(anonymized, anonymize(t, a, query))
+    }
+    // the mapping is saved and loaded
+    var mapping bytes.Buffer
+    if err := a.SaveMapping(&mapping); err != nil {
+        t.Fatal(err)
+    }
+    b := NewAnonymizer([]string{ dbr })
+    if err := b.LoadMapping(&mapping); err != nil {
+        t.Fatal(err)
+    }
+    for i,query := range queries {
+        restored, err := b.Restore(anonymized[i])
+        if err != nil {
+            t.Fatal(err)
+        }
+        if restored != query {
+            t.Errorf("Expected\n%v\nbut got\n%v", query, restored)
+        }
+        // the same pseudonyms are used after loading the mapping
+        if again := anonymize(t, b, query); again != anonymized[i] {
+            t.Errorf("Expected\n%v\nbut got\n%v", anonymized[i], again)
+        }
+    }
+}
+
+// The new pseudonyms are numbered after those of the loaded mapping
+func TestLoadMappingCounters(t *testing.T) {
+    a := NewAnonymizer([]string{ dbr })
+    mapping := `{
+        "?v3" : "?x",
+        "?v0" : "?y",
+        "<http://dbpedia.org/resource/iri1>" : "<http://dbpedia.org/resource/Paris>",
+        "2.0" : "1.5",
+        "\"boolean0\"^^<http://www.w3.org/2001/XMLSchema#boolean>" : "true"
+    }`
+    if err := a.LoadMapping(strings.NewReader(mapping)); err != nil {
+        t.Fatal(err)
+    }
+    actual := anonymize(t, a, `SELECT * { ?x <p> <http://dbpedia.org/resource/Lyon>, 2.5, 1.5, false, 4 . ?z <q> ?y }`)
+    expected := `SELECT * { ?v3 <p> <http://dbpedia.org/resource/iri2>, 3.0, 2.0, "boolean1"^^<http://www.w3.org/2001/XMLSchema#boolean>, 0 . ?v4 <q> ?v0 }`
+    if actual != expected {
+        t.Errorf("Expected\n%v\nbut got\n%v", expected, actual)
+    }
+}
+
+func TestComments(t *testing.T) {
+    query := `# looking up John Doe's medical record
+SELECT * { ?s <p> <http://dbpedia.org/resource/John_Doe> # the patient
+}`
+    expected := `
+SELECT * { ?v0 <p> <http://dbpedia.org/resource/iri0> 
+}`
+    if actual := anonymize(t, NewAnonymizer([]string{ dbr }), query); actual != expected {
+        t.Errorf("Expected\n%v\nbut got\n%v", expected, actual)
+    }
+}
+
+func TestProlog(t *testing.T) {
+    tests := map[string]string{
+        // the namespace is kept, but not the IRIs within it
+        `PREFIX dbr: <http://dbpedia.org/resource/> PREFIX x: <http://dbpedia.org/resource/John_Doe_> SELECT * { x:record <p> dbr:Paris }` :
+        `PREFIX dbr: <http://dbpedia.org/resource/> PREFIX x: <http://dbpedia.org/resource/iri0> SELECT * { <http://dbpedia.org/resource/iri1> <p> dbr:iri2 }`,
+        // the relative IRIs are resolved against the BASE
+        `BASE <http://dbpedia.org/resource/John_Doe/> SELECT * { <record> <http://example.org/p> <../Paris> }` :
+        `BASE <http://dbpedia.org/resource/iri0> SELECT * { <http://dbpedia.org/resource/iri1> <http://example.org/p> <http://dbpedia.org/resource/iri2> }`,
+    }
+    for query, expected := range tests {
+        a := NewAnonymizer([]string{ dbr })
+        actual := anonymize(t, a, query)
+        if actual != expected {
+            t.Errorf("Expected\n%v\nbut got\n%v", expected, actual)
+        }
+        if strings.Contains(actual, "John_Doe") || strings.Contains(actual, "record") {
+            t.Errorf("Expected no sensitive term in\n%v", actual)
+        }
+        // the restored query is equivalent to the original one
+        restored, err := a.Restore(actual)
+        if err != nil {
+            t.Fatal(err)
+        }
+        if !strings.Contains(restored, "<http://dbpedia.org/resource/John_Doe") {
+            t.Errorf("Expected the original IRIs to be restored in\n%v", restored)
+        }
+    }
+}
+
+func TestShapeIsKept(t *testing.T) {
+    query := `SELECT * { ?a <p> ?b . ?b <q> <http://dbpedia.org/resource/X> . <http://dbpedia.org/resource/X> <r> "c" , ?d }`
+    anonymized := anonymize(t, NewAnonymizer([]string{ dbr }), query)
+    before, err := shape.Analyze(query)
+    if err != nil {
+        t.Fatal(err)
+    }
+    after, err := shape.Analyze(anonymized)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if before.Kind != after.Kind || before.Signature != after.Signature {
+        t.Errorf("Expected the shape %v %v but got %v %v for\n%v", before.Kind, before.Signature, after.Kind, after.Signature, anonymized)
+    }
+}
+
+func TestUndeclaredPrefix(t *testing.T) {
+    if _, err := NewAnonymizer(nil).Anonymize(`SELECT * { ?s a foaf:Person }`); err == nil {
+        t.Error("Expected an error for an undeclared prefix")
+    }
+}
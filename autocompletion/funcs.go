@@ -0,0 +1,108 @@
+package autocompletion
+
+import (
+    "regexp"
+    "strings"
+    "text/template"
+
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// funcMap returns the SPARQL-aware functions available in the template of the
+// recommendation query
+func (s *Scope) funcMap() template.FuncMap {
+    return template.FuncMap{
+        "escapeString" : escapeString,
+        "escapeRegex" : escapeRegex,
+        "iri" : iri,
+        "prefixed" : s.prefixed,
+        "sObjects" : s.SObjects,
+        "pofSubject" : s.PofSubject,
+        "valuesBlock" : valuesBlock,
+        "virtuosoPhrase" : virtuosoPhrase,
+    }
+}
+
+// Escapes the characters of a string literal
+var stringEscaper = strings.NewReplacer(
+    "\\", "\\\\",
+    "\"", "\\\"",
+    "'", "\\'",
+    "\n", "\\n",
+    "\r", "\\r",
+    "\t", "\\t",
+    "\b", "\\b",
+    "\f", "\\f",
+)
+
+// escapeString escapes the value so that it can be written within the quotes
+// of a SPARQL string literal
+func escapeString(value string) string {
+    return stringEscaper.Replace(value)
+}
+
+// escapeRegex escapes the metacharacters of the value so that a regular
+// expression matches it literally. The result still needs to be passed
+// through escapeString before being written in a string literal.
+func escapeRegex(value string) string {
+    return regexp.QuoteMeta(value)
+}
+
+// Percent-encodes the characters which are not allowed in an IRI reference
+var iriEscaper = strings.NewReplacer(
+    " ", "%20",
+    "<", "%3C",
+    ">", "%3E",
+    "\"", "%22",
+    "{", "%7B",
+    "}", "%7D",
+    "|", "%7C",
+    "^", "%5E",
+    "`", "%60",
+    "\\", "%5C",
+)
+
+// iri returns the value as a SPARQL IRI reference, i.e., enclosed in angle
+// brackets. The value is returned as is if it is already enclosed.
+func iri(value string) string {
+    if strings.HasPrefix(value, "<") && strings.HasSuffix(value, ">") {
+        return value
+    }
+    return "<" + iriEscaper.Replace(value) + ">"
+}
+
+// prefixed returns the IRI as a prefixed name, using the longest namespace
+// among the declared prefixes. If none applies, the IRI reference is returned.
+func (s *Scope) prefixed(value string) string {
+    uri := strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+    found, name, ns := false, "", ""
+    for prefix, namespace := range s.Prefixes {
+        if !strings.HasPrefix(uri, namespace) || !sparql.PlainLocalRe.MatchString(uri[len(namespace):]) {
+            continue
+        }
+        if !found || len(namespace) > len(ns) || len(namespace) == len(ns) && prefix < name {
+            found, name, ns = true, prefix, namespace
+        }
+    }
+    if !found {
+        return iri(uri)
+    }
+    return name + ":" + uri[len(ns):]
+}
+
+// valuesBlock returns an inline data block binding the variable to each of
+// the values, which are SPARQL terms
+func valuesBlock(variable string, values []string) string {
+    return "VALUES " + variable + " { " + strings.Join(values, " ") + " }"
+}
+
+// The words of a keyword, as indexed by the Virtuoso full-text index
+var wordRe = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// virtuosoPhrase returns the words of the keyword separated by a space, so
+// that it can be written within the single quotes of a phrase of the Virtuoso
+// free-text syntax. The other characters, e.g., a quote ending the phrase,
+// are left out since they are not indexed.
+func virtuosoPhrase(keyword string) string {
+    return strings.Join(wordRe.FindAllString(keyword, -1), " ")
+}
@@ -0,0 +1,142 @@
+package autocompletion
+
+import (
+    "strconv"
+    "strings"
+)
+
+// The variable bound to the label of the recommended item
+const labelVar = "?POFLabel"
+
+// The variable bound to the description of the recommended item
+const commentVar = "?POFComment"
+
+// LabelOptions configures how recommended items are matched and displayed
+// using their human-readable labels instead of their IRI only.
+type LabelOptions struct {
+    // The predicates linking an item to its label, e.g., rdfs:label
+    Predicates []string
+    // The predicates linking an item to its description, e.g., rdfs:comment
+    CommentPredicates []string
+    // The languages of the label, ordered by preference.
+    // The empty string stands for a literal without language tag.
+    Languages []string
+}
+
+// LabelOptions struct constructor with the rdfs:label and skos:prefLabel
+// predicates, and a preference for english labels
+func NewLabelOptions() *LabelOptions {
+    return &LabelOptions{
+        Predicates : []string{
+            "<http://www.w3.org/2000/01/rdf-schema#label>",
+            "<http://www.w3.org/2004/02/skos/core#prefLabel>",
+        },
+        CommentPredicates : []string{
+            "<http://www.w3.org/2000/01/rdf-schema#comment>",
+        },
+        Languages : []string{ "en", "" },
+    }
+}
+
+// Recommendation is a recommended item along with its label and description,
+// as returned by the recommendation query.
+type Recommendation struct {
+    // The recommended item
+    Item string
+    // The label of the item, empty if there is none
+    Label string
+    // The description of the item, empty if there is none
+    Comment string
+    // The number of occurrences of the item, 0 if the query is not ranked
+    Count int
+}
+
+// NewRecommendation creates a Recommendation from a solution of the
+// recommendation query. The solution maps a variable name, without
+// the leading '?', to its value.
+func NewRecommendation(solution map[string]string) Recommendation {
+    count, _ := strconv.Atoi(solution["count"])
+    return Recommendation{
+        Item : solution["POF"],
+        Label : solution[labelVar[1:]],
+        Comment : solution[commentVar[1:]],
+        Count : count,
+    }
+}
+
+// Display returns the text for presenting the recommended item to the user,
+// i.e., its label if there is one, otherwise the item itself.
+func (r Recommendation) Display() string {
+    if r.Label != "" {
+        return r.Label
+    }
+    return r.Item
+}
+
+// LabelSearch returns true if the labels of the recommended items are
+// retrieved and matched against the keyword.
+// Labels are not available for path recommendations.
+func (s *Scope) LabelSearch() bool {
+    return s.Labels != nil && s.pathLength == 0
+}
+
+// LabelPatterns returns the optional graph patterns that bind the label and
+// the description of the Point Of Focus. There is one OPTIONAL per language
+// and per predicate, so that the first one by order of preference is kept.
+// If an item has several labels with the same language and predicate, the
+// smallest one is kept so that the item is recommended once.
+func (s *Scope) LabelPatterns() string {
+    if !s.LabelSearch() {
+        return ""
+    }
+    patterns := optionalPatterns(s.Labels.Predicates, s.Labels.Languages, labelVar)
+    patterns += optionalPatterns(s.Labels.CommentPredicates, s.Labels.Languages, commentVar)
+    return patterns
+}
+
+// optionalPatterns returns the OPTIONAL graph patterns binding the variable v
+// to the object of the ?POF through any of the predicates.
+func optionalPatterns(predicates []string, languages []string, v string) string {
+    var patterns []string
+    if len(languages) == 0 {
+        for _,p := range predicates {
+            patterns = append(patterns, "OPTIONAL { ?POF " + p + " " + v + " . " + smallest(p, v, "") + " }")
+        }
+    }
+    for _,lang := range languages {
+        for _,p := range predicates {
+            patterns = append(patterns, "OPTIONAL { ?POF " + p + " " + v + " . FILTER (" + langFilter(v, lang) + ") " + smallest(p, v, langFilter(otherVar(v), lang)) + " }")
+        }
+    }
+    if len(patterns) == 0 {
+        return ""
+    }
+    return strings.Join(patterns, "\n") + "\n"
+}
+
+// langFilter returns the expression that is true if the literal bound to the
+// variable v has the language. The empty language stands for no tag.
+func langFilter(v string, lang string) string {
+    if lang == "" {
+        return "lang(" + v + ") = \"\""
+    }
+    return "langMatches(lang(" + v + "), \"" + lang + "\")"
+}
+
+// smallest returns the filter removing the value of the variable v if the ?POF
+// has a smaller one through the predicate that also matches the filter, if not
+// empty. The values are compared by their lexical form, then by their
+// language tag.
+func smallest(p string, v string, filter string) string {
+    other := otherVar(v)
+    if filter != "" {
+        filter += " && "
+    }
+    return "FILTER NOT EXISTS { ?POF " + p + " " + other + " . FILTER (" + filter +
+        "(str(" + other + ") < str(" + v + ") || str(" + other + ") = str(" + v + ") && lang(" + other + ") < lang(" + v + "))) }"
+}
+
+// otherVar returns the variable bound to another value than the variable v
+func otherVar(v string) string {
+    return v + "Other"
+}
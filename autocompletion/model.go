@@ -0,0 +1,336 @@
+package autocompletion
+
+import (
+    "encoding/json"
+    "io"
+    "sort"
+    "strings"
+    "github.com/scampi/gosparqled/algebra"
+)
+
+// Model is a model of the classes and predicates that co-occur in a query log,
+// from which the recommendations reflect what people actually query. The
+// entities of a query are the subjects of its triple patterns, with their
+// classes and their predicates. A variable predicate, or an IRI that is not a
+// class, is ignored.
+type Model struct {
+    // The number of queries the model is trained on
+    Queries int
+    // The number of entities with the class, or with the predicate
+    Classes map[string]int
+    Predicates map[string]int
+    // The number of entities with the class and the predicate
+    ClassPredicates map[string]map[string]int
+    // The number of entities with both predicates
+    PredicatePredicates map[string]map[string]int
+    // The number of queries with the predicate
+    PredicateQueries map[string]int
+    // The number of queries with the predicate whose object has the class
+    ObjectClasses map[string]map[string]int
+}
+
+// NewModel returns an empty model
+func NewModel() *Model {
+    return &Model{
+        Classes : make(map[string]int),
+        Predicates : make(map[string]int),
+        ClassPredicates : make(map[string]map[string]int),
+        PredicatePredicates : make(map[string]map[string]int),
+        PredicateQueries : make(map[string]int),
+        ObjectClasses : make(map[string]map[string]int),
+    }
+}
+
+// TrainModel returns the model of the queries. The queries that cannot be
+// parsed are skipped, and are not counted in Queries.
+func TrainModel(queries []string) *Model {
+    m := NewModel()
+    for _,query := range queries {
+        m.Learn(query)
+    }
+    return m
+}
+
+// increment increments the count of the pair
+func increment(counts map[string]map[string]int, a string, b string) {
+    if counts[a] == nil {
+        counts[a] = make(map[string]int)
+    }
+    counts[a][b]++
+}
+
+// entity is the classes and predicates of a subject of a query
+type entity struct {
+    classes, predicates map[string]bool
+}
+
+// Learn adds the co-occurrences of the query to the model. The triple patterns
+// of all the operators are considered together, e.g., those of both sides of a
+// UNION or of an OPTIONAL.
+func (m *Model) Learn(query string) error {
+    a, err := algebra.Parse(query)
+    if err != nil {
+        return err
+    }
+    var triples []algebra.Triple
+    algebra.Walk(a.Op, func(o algebra.Op) bool {
+        if bgp, ok := o.(*algebra.BGP); ok {
+            triples = append(triples, bgp.Triples...)
+        }
+        return true
+    })
+    iri := func(term string) (string, bool) {
+        if strings.HasPrefix(term, "<") && strings.HasSuffix(term, ">") {
+            return term[1:len(term) - 1], true
+        }
+        return "", false
+    }
+    entities := make(map[string]*entity)
+    get := func(term string) *entity {
+        if entities[term] == nil {
+            entities[term] = &entity{ make(map[string]bool), make(map[string]bool) }
+        }
+        return entities[term]
+    }
+    for _,tp := range triples {
+        p, ok := iri(tp.P)
+        if !ok {
+            continue
+        }
+        if p != rdfType {
+            get(tp.S).predicates[p] = true
+        } else if c, ok := iri(tp.O); ok {
+            get(tp.S).classes[c] = true
+        }
+    }
+    predicates := make(map[string]bool)
+    objects := make(map[[2]string]bool)
+    for _,tp := range triples {
+        p, ok := iri(tp.P)
+        if !ok || p == rdfType {
+            continue
+        }
+        predicates[p] = true
+        if entities[tp.O] == nil {
+            continue
+        }
+        for c := range entities[tp.O].classes {
+            objects[[2]string{ p, c }] = true
+        }
+    }
+    for _,e := range entities {
+        for c := range e.classes {
+            m.Classes[c]++
+            for p := range e.predicates {
+                increment(m.ClassPredicates, c, p)
+            }
+        }
+        for p := range e.predicates {
+            m.Predicates[p]++
+            for q := range e.predicates {
+                if p != q {
+                    increment(m.PredicatePredicates, p, q)
+                }
+            }
+        }
+    }
+    for p := range predicates {
+        m.PredicateQueries[p]++
+    }
+    for pair := range objects {
+        increment(m.ObjectClasses, pair[0], pair[1])
+    }
+    m.Queries++
+    return nil
+}
+
+// Save writes the model as JSON
+func (m *Model) Save(w io.Writer) error {
+    out, err := json.MarshalIndent(m, "", "  ")
+    if err != nil {
+        return err
+    }
+    _, err = w.Write(append(out, '\n'))
+    return err
+}
+
+// LoadModel reads a model written by Save
+func LoadModel(r io.Reader) (*Model, error) {
+    m := NewModel()
+    if err := json.NewDecoder(r).Decode(m); err != nil {
+        return nil, err
+    }
+    return m, nil
+}
+
+// context returns the classes of the term, and its predicates, given by the
+// triple patterns other than the one of the Point Of Focus. The predicates
+// whose object is the term are returned too.
+func (b *Scope) context(term string) (classes []string, predicates []string, incoming []string) {
+    for _,tp := range b.Tps {
+        if tp.P == b.Pof || tp.O == b.Pof {
+            continue
+        }
+        p, ok := b.expand(tp.P)
+        if !ok {
+            continue
+        }
+        if p == rdfType {
+            if c, ok := b.expand(tp.O); ok && tp.S == term {
+                classes = append(classes, c)
+            }
+            continue
+        }
+        switch {
+        case tp.S == term:
+            predicates = append(predicates, p)
+        case tp.O == term:
+            incoming = append(incoming, p)
+        }
+    }
+    return classes, predicates, incoming
+}
+
+// modelScores returns the items of the model that can be recommended at the
+// Point Of Focus, with their score. Each class or predicate connected to the
+// Point Of Focus adds the probability of the item given it, e.g., for a
+// predicate, the number of entities with both the class of the subject and the
+// predicate, over the number of entities with the class. The classes of the
+// objects of a predicate are counted in queries instead of entities. Without
+// such a context, the items are scored by their number of entities.
+func (b *Scope) modelScores(m *Model) map[string]float64 {
+    scores := make(map[string]float64)
+    var subject, object string
+    switch b.RecommendationType() {
+    case CLASS:
+        for _,tp := range b.Tps {
+            if tp.O == b.Pof {
+                subject = tp.S
+            }
+        }
+        _, predicates, incoming := b.context(subject)
+        for _,q := range predicates {
+            for c,counts := range m.ClassPredicates {
+                if n := counts[q]; n != 0 {
+                    scores[c] += float64(n) / float64(m.Predicates[q])
+                }
+            }
+        }
+        for _,r := range incoming {
+            if q := m.PredicateQueries[r]; q != 0 {
+                for c,n := range m.ObjectClasses[r] {
+                    scores[c] += float64(n) / float64(q)
+                }
+            }
+        }
+        if len(predicates) + len(incoming) == 0 {
+            for c,n := range m.Classes {
+                scores[c] = float64(n)
+            }
+        }
+    case PREDICATE:
+        for _,tp := range b.Tps {
+            if tp.P == b.Pof {
+                subject, object = tp.S, tp.O
+            }
+        }
+        classes, predicates, _ := b.context(subject)
+        objectClasses, _, _ := b.context(object)
+        for _,c := range classes {
+            for p,n := range m.ClassPredicates[c] {
+                scores[p] += float64(n) / float64(m.Classes[c])
+            }
+        }
+        for _,q := range predicates {
+            for p,n := range m.PredicatePredicates[q] {
+                scores[p] += float64(n) / float64(m.Predicates[q])
+            }
+        }
+        for _,c := range objectClasses {
+            for p,counts := range m.ObjectClasses {
+                if n, q := counts[c], m.PredicateQueries[p]; n != 0 && q != 0 {
+                    scores[p] += float64(n) / float64(q)
+                }
+            }
+        }
+        if len(classes) + len(predicates) + len(objectClasses) == 0 {
+            for p,n := range m.Predicates {
+                scores[p] = float64(n)
+            }
+        }
+    }
+    return scores
+}
+
+// ModelRecommendations returns the classes or the predicates of the model that
+// can be recommended at the Point Of Focus of the processed query, the most
+// likely first given the classes and predicates connected to it. They are
+// matched against the keyword and the prefix. Other kinds of recommendation
+// return nil.
+func (b *Scope) ModelRecommendations(m *Model) []Recommendation {
+    b.trimToScope()
+    items := b.page(b.matchItems(b.modelScores(m), func(string) string { return "" }))
+    recs := make([]Recommendation, len(items))
+    for i,item := range items {
+        recs[i] = Recommendation{ Item : item }
+    }
+    return recs
+}
+
+// BlendRecommendations ranks the recommendations retrieved from an endpoint
+// together with those of the model. The score of a recommendation from the
+// endpoint decreases with its rank, from 1 for the first to 0 for the last, and the score of the model is
+// relative to its best item. The weight of the model is between 0, i.e., the
+// order of the endpoint, and 1, i.e., the order of the model. The items of the
+// model are added if they match the keyword or the prefix, up to the limit:
+// since the recommendations of the endpoint are already paged, the offset is
+// not applied. The recommendations of the endpoint are kept whether or not
+// the model knows them.
+func (b *Scope) BlendRecommendations(m *Model, recs []Recommendation, weight float64) []Recommendation {
+    b.trimToScope()
+    model := b.modelScores(m)
+    best := 0.0
+    for _,score := range model {
+        if score > best {
+            best = score
+        }
+    }
+    scores := make(map[string]float64)
+    for _,item := range b.matchItems(model, func(string) string { return "" }) {
+        scores[item] = weight * model[item] / best
+    }
+    byItem := make(map[string]Recommendation)
+    position := make(map[string]int)
+    for i,r := range recs {
+        byItem[r.Item] = r
+        position[r.Item] = i
+        score := 1.0
+        if len(recs) > 1 {
+            score -= float64(i) / float64(len(recs) - 1)
+        }
+        scores[r.Item] += (1 - weight) * score
+    }
+    // the items with the same score are in the order of the endpoint, then
+    // by IRI
+    items := rank(scores)
+    sort.SliceStable(items, func(i, j int) bool {
+        if si, sj := scores[items[i]], scores[items[j]]; si != sj {
+            return si > sj
+        }
+        pi, oki := position[items[i]]
+        pj, okj := position[items[j]]
+        return oki && (!okj || pi < pj)
+    })
+    if b.Limit > 0 && len(items) > b.Limit {
+        items = items[:b.Limit]
+    }
+    blended := make([]Recommendation, len(items))
+    for i,item := range items {
+        if r, ok := byItem[item]; ok {
+            blended[i] = r
+        } else {
+            blended[i] = Recommendation{ Item : item }
+        }
+    }
+    return blended
+}
@@ -0,0 +1,172 @@
+package autocompletion
+
+import (
+    "bytes"
+    "io/ioutil"
+    "reflect"
+    "strings"
+    "testing"
+)
+
+var queryLog = []string{
+    "PREFIX : <http://ex.org/> SELECT * { ?s a :Person ; :name ?n ; :birthPlace ?c . ?c a :City }",
+    "PREFIX : <http://ex.org/> SELECT * { ?s a :Person ; :name ?n ; :knows ?f }",
+    "PREFIX : <http://ex.org/> SELECT * { ?s a :Person ; :birthPlace ?c . ?c :population ?p }",
+    "PREFIX : <http://ex.org/> SELECT * { ?c a :City ; :population ?p }",
+    "PREFIX : <http://ex.org/> SELECT * { ?c a :City ; :population ?p ; :mayor ?m }",
+    "PREFIX : <http://ex.org/> SELECT * { ?s ?p ?o }",
+    "not a query",
+}
+
+// modelRecommendations returns the items recommended from the model at the
+// Point Of Focus of the query
+func modelRecommendations(t *testing.T, m *Model, query string) []string {
+    s := &Sparql{ Buffer : "PREFIX : <http://ex.org/>\n" + query, Scope : NewScope() }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatalf("Failed to parse query\n%v", err)
+    }
+    s.Execute()
+    var items []string
+    for _,rec := range s.ModelRecommendations(m) {
+        items = append(items, strings.TrimPrefix(rec.Item, "http://ex.org/"))
+    }
+    return items
+}
+
+func TestTrainModel(t *testing.T) {
+    m := TrainModel(queryLog)
+    if m.Queries != 6 {
+        t.Errorf("Expected 6 queries but got %v", m.Queries)
+    }
+    if n := m.Classes["http://ex.org/Person"]; n != 3 {
+        t.Errorf("Expected 3 persons but got %v", n)
+    }
+    if n := m.ClassPredicates["http://ex.org/Person"]["http://ex.org/name"]; n != 2 {
+        t.Errorf("Expected 2 persons with a name but got %v", n)
+    }
+    if n := m.PredicatePredicates["http://ex.org/population"]["http://ex.org/mayor"]; n != 1 {
+        t.Errorf("Expected 1 city with a population and a mayor but got %v", n)
+    }
+    if n := m.ObjectClasses["http://ex.org/birthPlace"]["http://ex.org/City"]; n != 1 {
+        t.Errorf("Expected 1 birth place that is a city but got %v", n)
+    }
+    if n := m.PredicateQueries["http://ex.org/birthPlace"]; n != 2 {
+        t.Errorf("Expected 2 queries with a birth place but got %v", n)
+    }
+}
+
+func TestModelScoresObjectClasses(t *testing.T) {
+    // two entities with the predicate in the same query
+    m := TrainModel([]string{
+        "PREFIX : <http://ex.org/> SELECT * { ?a :birthPlace ?c . ?b :birthPlace ?c . ?c a :City }",
+        "PREFIX : <http://ex.org/> SELECT * { ?a :birthPlace ?c . ?c a :Country }",
+    })
+    for query,expected := range map[string]map[string]float64{
+        "SELECT * { ?s :birthPlace ?c . ?c a < }" : { "http://ex.org/City" : 0.5, "http://ex.org/Country" : 0.5 },
+        "SELECT * { ?s < ?c . ?c a :City }" : { "http://ex.org/birthPlace" : 0.5 },
+    } {
+        s := &Sparql{ Buffer : "PREFIX : <http://ex.org/>\n" + query, Scope : NewScope() }
+        s.Init()
+        if err := s.Parse(); err != nil {
+            t.Fatal(err)
+        }
+        s.Execute()
+        s.trimToScope()
+        if actual := s.modelScores(m); !reflect.DeepEqual(actual, expected) {
+            t.Errorf("Expected the scores %v for %v but got %v", expected, query, actual)
+        }
+    }
+}
+
+func TestModelRecommendations(t *testing.T) {
+    m := TrainModel(queryLog)
+    for query,expected := range map[string]string{
+        // the predicates of the class of the subject
+        "SELECT * { ?s a :Person ; < }" : "birthPlace name knows",
+        "SELECT * { ?s a :City ; < }" : "population mayor",
+        // the predicates co-occurring with those of the subject
+        "SELECT * { ?s :mayor ?m ; < }" : "population",
+        // the predicates whose object has the class
+        "SELECT * { ?s < ?o . ?o a :City }" : "birthPlace",
+        // the classes of the subjects with the predicate, or of its objects
+        "SELECT * { ?s :population ?p ; a < }" : "City",
+        "SELECT * { ?s :birthPlace ?c . ?c a < }" : "City",
+        // without context, by number of entities
+        "SELECT * { ?s a < }" : "City Person",
+        "SELECT * { ?s < }" : "population birthPlace name knows mayor",
+        // the keyword
+        "SELECT * { ?s a :Person ; na< }" : "name",
+        // other kinds of recommendation
+        "SELECT * { ?s :name < }" : "",
+    } {
+        if actual := strings.Join(modelRecommendations(t, m, query), " "); actual != expected {
+            t.Errorf("Expected [%v] for %v but got [%v]", expected, query, actual)
+        }
+    }
+}
+
+func TestBlendRecommendations(t *testing.T) {
+    m := TrainModel(queryLog)
+    blend := func(weight float64) string {
+        s := &Sparql{ Buffer : "PREFIX : <http://ex.org/>\nSELECT * { ?s a :Person ; < }", Scope : NewScope() }
+        s.Init()
+        if err := s.Parse(); err != nil {
+            t.Fatal(err)
+        }
+        s.Execute()
+        s.Limit = 4
+        endpoint := []Recommendation{
+            { Item : "http://ex.org/age", Label : "age" },
+            { Item : "http://ex.org/knows" },
+            { Item : "http://ex.org/name", Label : "name" },
+        }
+        var items []string
+        for _,r := range s.BlendRecommendations(m, endpoint, weight) {
+            items = append(items, strings.TrimPrefix(r.Item, "http://ex.org/") + "/" + r.Label)
+        }
+        return strings.Join(items, " ")
+    }
+    for weight,expected := range map[float64]string{
+        0 : "age/age knows/ name/name birthPlace/",
+        1 : "name/name birthPlace/ knows/ age/age",
+        // all the items have the same score
+        0.5 : "age/age knows/ name/name birthPlace/",
+        0.75 : "name/name birthPlace/ knows/ age/age",
+    } {
+        if actual := blend(weight); actual != expected {
+            t.Errorf("Expected [%v] with the weight %v but got [%v]", expected, weight, actual)
+        }
+    }
+}
+
+func TestSaveModel(t *testing.T) {
+    m := TrainModel(queryLog)
+    var out bytes.Buffer
+    if err := m.Save(&out); err != nil {
+        t.Fatal(err)
+    }
+    loaded, err := LoadModel(&out)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !reflect.DeepEqual(m, loaded) {
+        t.Errorf("Expected %v but got %v", m, loaded)
+    }
+}
+
+// The model is trained on the query logs of the evaluation dataset
+func TestTrainModelLog(t *testing.T) {
+    text, err := ioutil.ReadFile("../eval/data/dbpedia33/query_1-2")
+    if err != nil {
+        t.Fatal(err)
+    }
+    queries := strings.Split(strings.TrimSuffix(string(text), "###\n"), "###\n")
+    m := TrainModel(queries)
+    if m.Queries != len(queries) {
+        t.Errorf("Expected %v queries but got %v", len(queries), m.Queries)
+    }
+    if len(m.Predicates) == 0 || len(m.PredicatePredicates) == 0 {
+        t.Errorf("Expected co-occurring predicates but got %v", m.Predicates)
+    }
+}
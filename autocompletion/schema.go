@@ -0,0 +1,412 @@
+package autocompletion
+
+import (
+    "io"
+    "regexp"
+    "sort"
+    "strings"
+    "github.com/scampi/gosparqled/rdf"
+    "github.com/scampi/gosparqled/results"
+)
+
+// The namespaces of the RDF, RDFS and OWL vocabularies
+const (
+    rdfNS = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+    rdfsNS = "http://www.w3.org/2000/01/rdf-schema#"
+    owlNS = "http://www.w3.org/2002/07/owl#"
+)
+
+// The classes whose instances are classes, or properties
+var (
+    classTypes = map[string]bool{ rdfsNS + "Class" : true, owlNS + "Class" : true }
+    propertyTypes = map[string]bool{
+        rdfNS + "Property" : true,
+        owlNS + "ObjectProperty" : true,
+        owlNS + "DatatypeProperty" : true,
+        owlNS + "FunctionalProperty" : true,
+        owlNS + "InverseFunctionalProperty" : true,
+        owlNS + "TransitiveProperty" : true,
+        owlNS + "SymmetricProperty" : true,
+    }
+)
+
+// Schema is an RDFS or OWL ontology, from which classes and predicates are
+// recommended without an endpoint. Only the statements about IRIs are kept,
+// e.g., a domain that is a union of classes is ignored.
+type Schema struct {
+    classes map[string]bool
+    properties map[string]bool
+    // The direct super-classes and sub-classes of the classes
+    superClasses, subClasses map[string][]string
+    // The direct super-properties of the properties
+    superProperties map[string][]string
+    domains, ranges map[string][]string
+    // The inverse properties, in both directions
+    inverses map[string][]string
+    // The label and the comment of the terms, with the rank of their language
+    labels, comments map[string]schemaText
+}
+
+// schemaText is a label or a comment, with the rank of its language in the
+// preferences of NewLabelOptions
+type schemaText struct {
+    value string
+    rank int
+}
+
+// NewSchema returns an empty schema
+func NewSchema() *Schema {
+    return &Schema{
+        classes : make(map[string]bool),
+        properties : make(map[string]bool),
+        superClasses : make(map[string][]string),
+        subClasses : make(map[string][]string),
+        superProperties : make(map[string][]string),
+        domains : make(map[string][]string),
+        ranges : make(map[string][]string),
+        inverses : make(map[string][]string),
+        labels : make(map[string]schemaText),
+        comments : make(map[string]schemaText),
+    }
+}
+
+// LoadSchema returns the schema of the statements of the document
+func LoadSchema(r rdf.Reader) (*Schema, error) {
+    s := NewSchema()
+    for {
+        q, err := r.Read()
+        if err == io.EOF {
+            return s, nil
+        }
+        if err != nil {
+            return nil, err
+        }
+        s.Add(q)
+    }
+}
+
+// Add adds the statement to the schema, if it is about a class or a property
+func (s *Schema) Add(q rdf.Quad) {
+    if q.Subject.Kind != results.IRI {
+        return
+    }
+    subject, object := q.Subject.Value, q.Object.Value
+    if q.Object.Kind == results.LITERAL {
+        switch q.Predicate.Value {
+        case rdfsNS + "label":
+            addText(s.labels, subject, q.Object)
+        case rdfsNS + "comment":
+            addText(s.comments, subject, q.Object)
+        }
+        return
+    }
+    if q.Object.Kind != results.IRI {
+        return
+    }
+    switch q.Predicate.Value {
+    case rdfNS + "type":
+        switch {
+        case classTypes[object]:
+            s.classes[subject] = true
+        case propertyTypes[object]:
+            s.properties[subject] = true
+            if object == owlNS + "SymmetricProperty" {
+                s.inverses[subject] = append(s.inverses[subject], subject)
+            }
+        }
+    case rdfsNS + "subClassOf", owlNS + "equivalentClass":
+        s.classes[subject], s.classes[object] = true, true
+        s.superClasses[subject] = append(s.superClasses[subject], object)
+        s.subClasses[object] = append(s.subClasses[object], subject)
+        if q.Predicate.Value == owlNS + "equivalentClass" {
+            s.superClasses[object] = append(s.superClasses[object], subject)
+            s.subClasses[subject] = append(s.subClasses[subject], object)
+        }
+    case rdfsNS + "subPropertyOf":
+        s.properties[subject], s.properties[object] = true, true
+        s.superProperties[subject] = append(s.superProperties[subject], object)
+    case rdfsNS + "domain":
+        s.properties[subject], s.classes[object] = true, true
+        s.domains[subject] = append(s.domains[subject], object)
+    case rdfsNS + "range":
+        s.properties[subject] = true
+        s.ranges[subject] = append(s.ranges[subject], object)
+    case owlNS + "inverseOf":
+        s.properties[subject], s.properties[object] = true, true
+        s.inverses[subject] = append(s.inverses[subject], object)
+        s.inverses[object] = append(s.inverses[object], subject)
+    }
+}
+
+// addText keeps the literal as the text of the term if its language is
+// preferred to the one of the current text
+func addText(texts map[string]schemaText, term string, literal results.Term) {
+    languages := NewLabelOptions().Languages
+    rank := len(languages)
+    for i,lang := range languages {
+        if strings.EqualFold(literal.Lang, lang) {
+            rank = i
+            break
+        }
+    }
+    if current, ok := texts[term]; !ok || rank < current.rank {
+        texts[term] = schemaText{ value : literal.Value, rank : rank }
+    }
+}
+
+// closure returns the terms reachable from the given ones through the
+// relation, with their distance
+func closure(terms []string, relation map[string][]string) map[string]int {
+    distances := make(map[string]int)
+    for _,t := range terms {
+        distances[t] = 0
+    }
+    for queue := terms; len(queue) != 0; queue = queue[1:] {
+        for _,next := range relation[queue[0]] {
+            if _, ok := distances[next]; !ok {
+                distances[next] = distances[queue[0]] + 1
+                queue = append(queue, next)
+            }
+        }
+    }
+    return distances
+}
+
+// domainsOf returns the classes of the subjects of the property, which are
+// those of its super-properties, and the ranges of its inverses
+func (s *Schema) domainsOf(p string) []string {
+    var domains []string
+    for q := range closure([]string{ p }, s.superProperties) {
+        domains = append(domains, s.domains[q]...)
+        for _,inverse := range s.inverses[q] {
+            domains = append(domains, s.ranges[inverse]...)
+        }
+    }
+    return domains
+}
+
+// rangesOf returns the classes of the objects of the property, which are those
+// of its super-properties, and the domains of its inverses
+func (s *Schema) rangesOf(p string) []string {
+    var ranges []string
+    for q := range closure([]string{ p }, s.superProperties) {
+        ranges = append(ranges, s.ranges[q]...)
+        for _,inverse := range s.inverses[q] {
+            ranges = append(ranges, s.domains[inverse]...)
+        }
+    }
+    return ranges
+}
+
+// expand returns the IRI of the term of a triple pattern, or false if it is
+// not an IRI
+func (b *Scope) expand(term string) (string, bool) {
+    switch {
+    case term == "a":
+        return rdfType, true
+    case strings.HasPrefix(term, "<") && strings.HasSuffix(term, ">"):
+        return term[1:len(term) - 1], true
+    }
+    parts := strings.SplitN(term, ":", 2)
+    if ns, ok := b.Prefixes[parts[0]]; ok && len(parts) == 2 {
+        return ns + parts[1], true
+    }
+    return "", false
+}
+
+// classesOf returns the classes of the term, given by the triple patterns
+// other than the one of the Point Of Focus: its types, and the domains and
+// ranges of its predicates
+func (b *Scope) classesOf(term string, s *Schema) []string {
+    var classes []string
+    for _,tp := range b.Tps {
+        if tp.P == b.Pof || tp.O == b.Pof {
+            continue
+        }
+        p, ok := b.expand(tp.P)
+        if !ok {
+            continue
+        }
+        if p == rdfType {
+            if c, ok := b.expand(tp.O); ok && tp.S == term {
+                classes = append(classes, c)
+            }
+            continue
+        }
+        if tp.S == term {
+            classes = append(classes, s.domainsOf(p)...)
+        }
+        if tp.O == term {
+            classes = append(classes, s.rangesOf(p)...)
+        }
+    }
+    return classes
+}
+
+// SchemaRecommendations returns the classes or the predicates of the schema
+// that can be recommended at the Point Of Focus of the processed query, e.g.,
+// the predicates whose domain is a class of the subject or one of its
+// super-classes. The classes of a term are given by the connected triple
+// patterns: its types, and the domains and ranges of its predicates, also
+// through their inverses. The recommendations are ordered by the distance
+// between the classes, i.e., the most specific first, and are matched against
+// the keyword and the prefix. Other kinds of recommendation return nil.
+func (b *Scope) SchemaRecommendations(s *Schema) []Recommendation {
+    b.trimToScope()
+    var candidates map[string]int
+    switch b.RecommendationType() {
+    case CLASS:
+        candidates = b.schemaClasses(s)
+    case PREDICATE:
+        candidates = b.schemaPredicates(s)
+    default:
+        return nil
+    }
+    scores := make(map[string]float64, len(candidates))
+    for item,distance := range candidates {
+        scores[item] = -float64(distance)
+    }
+    items := b.page(b.matchItems(scores, func(item string) string { return s.labels[item].value }))
+    recs := make([]Recommendation, len(items))
+    for i,item := range items {
+        recs[i] = Recommendation{ Item : item, Label : s.labels[item].value, Comment : s.comments[item].value }
+    }
+    return recs
+}
+
+// matchItems returns the items matching the keyword, on their IRI or their
+// label, or else in the namespace of the prefix, by decreasing score. The
+// keyword is matched literally if it is not a valid regular expression.
+func (b *Scope) matchItems(scores map[string]float64, label func(string) string) []string {
+    keyword := regexp.MustCompile("(?i)" + regexp.QuoteMeta(b.Keyword))
+    if re, err := regexp.Compile("(?i)" + b.Keyword); err == nil {
+        keyword = re
+    }
+    var items []string
+    for item := range scores {
+        if b.Keyword != "" && !keyword.MatchString(item) && !keyword.MatchString(label(item)) {
+            continue
+        }
+        if b.Keyword == "" && !strings.HasPrefix(item, b.Prefix) {
+            continue
+        }
+        items = append(items, item)
+    }
+    return sortItems(items, scores)
+}
+
+// rank returns the items by decreasing score
+func rank(scores map[string]float64) []string {
+    items := make([]string, 0, len(scores))
+    for item := range scores {
+        items = append(items, item)
+    }
+    return sortItems(items, scores)
+}
+
+// sortItems sorts the items by decreasing score, then by IRI
+func sortItems(items []string, scores map[string]float64) []string {
+    sort.Slice(items, func(i, j int) bool {
+        if si, sj := scores[items[i]], scores[items[j]]; si != sj {
+            return si > sj
+        }
+        return items[i] < items[j]
+    })
+    return items
+}
+
+// page returns the items after the offset, up to the limit
+func (b *Scope) page(items []string) []string {
+    if b.Offset >= len(items) {
+        return nil
+    }
+    items = items[b.Offset:]
+    if b.Limit > 0 && len(items) > b.Limit {
+        items = items[:b.Limit]
+    }
+    return items
+}
+
+// schemaClasses returns the classes of the subject of the class Point Of
+// Focus, and their sub-classes, with their distance. All the classes are
+// returned if the subject has none.
+func (b *Scope) schemaClasses(s *Schema) map[string]int {
+    var subject string
+    for _,tp := range b.Tps {
+        if tp.O == b.Pof {
+            subject = tp.S
+        }
+    }
+    if classes := b.classesOf(subject, s); len(classes) != 0 {
+        return closure(classes, s.subClasses)
+    }
+    all := make(map[string]int)
+    for c := range s.classes {
+        all[c] = 0
+    }
+    return all
+}
+
+// universal returns true if the classes do not restrict the resources, i.e.,
+// if there is none or if they are all rdfs:Resource or owl:Thing
+func universal(classes []string) bool {
+    for _,c := range classes {
+        if c != rdfsNS + "Resource" && c != owlNS + "Thing" {
+            return false
+        }
+    }
+    return true
+}
+
+// schemaPredicates returns the properties whose domain is a class of the
+// subject of the predicate Point Of Focus or one of its super-classes, with
+// the distance to that class. A property without a domain, or whose domain is
+// rdfs:Resource or owl:Thing, applies to any subject and comes at the largest
+// distance. If the object has classes too, the range of a property must be
+// one of them or one of their super-classes, unless it is universal as well.
+// All the properties are returned if neither has a class.
+func (b *Scope) schemaPredicates(s *Schema) map[string]int {
+    var subject, object string
+    for _,tp := range b.Tps {
+        if tp.P == b.Pof {
+            subject, object = tp.S, tp.O
+        }
+    }
+    subjects := closure(b.classesOf(subject, s), s.superClasses)
+    objects := closure(b.classesOf(object, s), s.superClasses)
+    largest := 0
+    for _,dist := range subjects {
+        if dist + 1 > largest {
+            largest = dist + 1
+        }
+    }
+    candidates := make(map[string]int)
+    for p := range s.properties {
+        distance := 0
+        if domains := s.domainsOf(p); len(subjects) != 0 && universal(domains) {
+            distance = largest
+        } else if len(subjects) != 0 {
+            distance = -1
+            for _,d := range domains {
+                if dist, ok := subjects[d]; ok && (distance < 0 || dist < distance) {
+                    distance = dist
+                }
+            }
+            if distance < 0 {
+                continue
+            }
+        }
+        if ranges := s.rangesOf(p); len(objects) != 0 && !universal(ranges) {
+            matched := false
+            for _,r := range ranges {
+                _, ok := objects[r]
+                matched = matched || ok
+            }
+            if !matched {
+                continue
+            }
+        }
+        candidates[p] = distance
+    }
+    return candidates
+}
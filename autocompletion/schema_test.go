@@ -0,0 +1,136 @@
+package autocompletion
+
+import (
+    "strings"
+    "testing"
+    "github.com/scampi/gosparqled/rdf"
+)
+
+const ontology = `
+    @prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+    @prefix owl: <http://www.w3.org/2002/07/owl#> .
+    @prefix : <http://ex.org/> .
+
+    :Agent a owl:Class ; rdfs:label "Agent"@en .
+    :Person rdfs:subClassOf :Agent ; rdfs:label "Personne"@fr, "Person"@en ; rdfs:comment "A human" .
+    :Artist rdfs:subClassOf :Person .
+    :Place a owl:Class ; rdfs:label "Location" .
+    :City rdfs:subClassOf :Place .
+    :name a owl:DatatypeProperty ; rdfs:domain :Agent .
+    :birthPlace rdfs:domain :Person ; rdfs:range :Place .
+    :birthCity rdfs:subPropertyOf :birthPlace ; rdfs:range :City .
+    :born owl:inverseOf :birthPlace .
+    :artwork rdfs:domain :Artist .
+    :population rdfs:domain :Place .
+`
+
+// schemaRecommendations returns the items recommended from the ontology at the
+// Point Of Focus of the query, with the limit and the offset
+func schemaRecommendations(t *testing.T, query string, limit int, offset int) []string {
+    return schemaRecommendationsOf(t, ontology, query, limit, offset)
+}
+
+// schemaRecommendationsOf returns the items recommended from the document at
+// the Point Of Focus of the query, with the limit and the offset
+func schemaRecommendationsOf(t *testing.T, doc string, query string, limit int, offset int) []string {
+    r, err := rdf.NewReader(strings.NewReader(doc), rdf.TURTLE, "")
+    if err != nil {
+        t.Fatal(err)
+    }
+    schema, err := LoadSchema(r)
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := &Sparql{ Buffer : "PREFIX : <http://ex.org/>\n" + query, Scope : NewScope() }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatalf("Failed to parse query\n%v", err)
+    }
+    s.Execute()
+    s.Limit, s.Offset = limit, offset
+    var items []string
+    for _,rec := range s.SchemaRecommendations(schema) {
+        items = append(items, strings.TrimPrefix(rec.Item, "http://ex.org/"))
+    }
+    return items
+}
+
+func TestSchemaRecommendations(t *testing.T) {
+    for query,expected := range map[string]string{
+        // the predicates of the classes of the subject and of their super-classes
+        "SELECT * { ?s a :Person ; < }" : "birthCity birthPlace name",
+        "SELECT * { ?s a :Artist ; < }" : "artwork birthCity birthPlace name",
+        // the class of the subject is the range of the inverse property
+        "SELECT * { ?s :born ?c . ?s < }" : "born population",
+        // the class of the subject is the domain of a predicate
+        "SELECT * { ?s :artwork ?w . ?s < }" : "artwork birthCity birthPlace name",
+        // the range of the predicate must be a class of the object
+        "SELECT * { ?s a :Person ; < ?o . ?o :population ?p }" : "birthCity birthPlace name",
+        "SELECT * { ?s a :Person ; < ?o . ?o a :Agent }" : "name",
+        // the classes of the subject and their sub-classes
+        "SELECT * { ?s a < }" : "Agent Artist City Person Place",
+        "SELECT * { ?s :birthPlace ?o . ?o a < }" : "Place City",
+        "SELECT * { ?p :born ?s . ?s a < }" : "Person Artist",
+        // the keyword matches the IRI or the label
+        "SELECT * { ?s a :Person ; birth< }" : "birthCity birthPlace",
+        "SELECT * { ?s a Pers< }" : "Person",
+        "SELECT * { ?s a locat< }" : "Place",
+        // other kinds of recommendation
+        "SELECT * { ?s :name < }" : "",
+    } {
+        if actual := strings.Join(schemaRecommendations(t, query, 10, 0), " "); actual != expected {
+            t.Errorf("Expected [%v] for %v but got [%v]", expected, query, actual)
+        }
+    }
+}
+
+func TestSchemaUniversalDomain(t *testing.T) {
+    doc := ontology + `
+    @prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+    :homepage a rdf:Property .
+    :sameAs a owl:ObjectProperty ; rdfs:domain owl:Thing ; rdfs:range owl:Thing .
+    :seeAlso a rdf:Property ; rdfs:domain rdfs:Resource ; rdfs:range :Place .
+    `
+    for query,expected := range map[string]string{
+        // the properties that apply to any subject come last
+        "SELECT * { ?s a :Person ; < }" : "birthCity birthPlace name homepage sameAs seeAlso",
+        "SELECT * { ?s a :City ; < }" : "born population homepage sameAs seeAlso",
+        // their range must still be a class of the object, unless it is universal
+        "SELECT * { ?s a :Person ; < ?o . ?o a :Agent }" : "name homepage sameAs",
+    } {
+        if actual := strings.Join(schemaRecommendationsOf(t, doc, query, 10, 0), " "); actual != expected {
+            t.Errorf("Expected [%v] for %v but got [%v]", expected, query, actual)
+        }
+    }
+}
+
+func TestSchemaRecommendationsPaging(t *testing.T) {
+    if actual := strings.Join(schemaRecommendations(t, "SELECT * { ?s a < }", 2, 1), " "); actual != "Artist City" {
+        t.Errorf("Expected [Artist City] but got [%v]", actual)
+    }
+    if actual := schemaRecommendations(t, "SELECT * { ?s a < }", 10, 10); actual != nil {
+        t.Errorf("Expected no recommendation but got %v", actual)
+    }
+}
+
+func TestSchemaLabels(t *testing.T) {
+    r, _ := rdf.NewReader(strings.NewReader(ontology), rdf.TURTLE, "")
+    schema, err := LoadSchema(r)
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := &Sparql{ Buffer : "SELECT * { ?s a <http://ex.org/Artist> ; a < }", Scope : NewScope() }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    recs := s.SchemaRecommendations(schema)
+    if len(recs) != 1 || recs[0].Item != "http://ex.org/Artist" {
+        t.Fatalf("Expected Artist but got %v", recs)
+    }
+    person := schema.labels["http://ex.org/Person"]
+    if person.value != "Person" || schema.comments["http://ex.org/Person"].value != "A human" {
+        t.Errorf("Expected the english label and the comment of Person but got %v", person)
+    }
+}
@@ -22,6 +22,7 @@ import (
     "text/template"
     "bytes"
     "strconv"
+    "github.com/scampi/gosparqled/sparql"
 )
 
 // The kind of recommendation
@@ -40,6 +41,8 @@ const (
     SUBJECT
     // Object recommendation
     OBJECT
+    // Variable recommendation, e.g., in an ORDER BY clause
+    VARIABLE
 )
 
 // A SPARQL triple pattern
@@ -55,7 +58,6 @@ type triplePattern struct {
 type Scope struct {
     // The list of triple patterns
     Tps []triplePattern
-    scope map[string]bool
     // The template of the SPARQL query used for retrieving recommendations
     template *template.Template
     // A keyword that the recommended item must match
@@ -69,38 +71,68 @@ type Scope struct {
     Prefix string
     // The set of declared prefixes
     Prefixes map[string]string
+    // The named graph the recommended items are retrieved from, with the
+    // templates supporting it, e.g., gold. The dataset of the query is used
+    // if empty.
+    Graph string
+    // If not nil, the keyword is also matched against the label of the
+    // recommended items, and their label and description are projected
+    Labels *LabelOptions
+    // If true, the recommended items are ranked by their number of occurrences
+    Ranked bool
+    // If greater than 0, only that many solutions of the triple patterns are
+    // considered for retrieving the recommended items
+    Sample int
+    // The maximum number of recommended items. There is no limit if it is 0
+    // or less.
+    Limit int
+    // The number of recommended items to skip, for paging through them
+    Offset int
+    // The terms the recommended items are restricted to, e.g., with the
+    // valuesBlock template function
+    Values []string
+    // The variables of the query, in order of appearance
+    variables []string
+    // True if the Point Of Focus is where a variable is expected
+    variablePof bool
+    // True while the solution modifiers are parsed
+    modifiers bool
 }
 
-// Scope struct constructor
+// Scope struct constructor with the default template
 func NewScope() *Scope {
-    tmpl := `
-        {{range $prefix, $uri := .Prefixes}}
-        PREFIX {{$prefix}}: <{{$uri}}>
-        {{end}}
-
-        SELECT DISTINCT {{.Pof}}
-        WHERE {
-        {{range .Tps}}
-            {{.S}} {{.P}} {{.O}} .
-        {{end}}
-        {{if .Keyword}}
-            FILTER regex(?POF, "{{.Keyword}}", "i")
-        {{else if .Prefix}}
-            FILTER regex(?POF, "^{{.Prefix}}")
-        {{end}}
-        }
-        LIMIT 10
-    `
-    return NewScopeWithTemplate(tmpl)
+    scope, err := NewScopeWithTemplate(templates["default"].text)
+    if err != nil {
+        panic(err)
+    }
+    return scope
+}
+
+// Scope struct constructor with the given text template.
+// An error is returned if the template cannot be parsed, or if a SPARQL query
+// it renders is not valid.
+func NewScopeWithTemplate(tmpl string) (*Scope, error) {
+    scope, err := newScope(tmpl)
+    if err != nil {
+        return nil, err
+    }
+    if err := validateTemplate(tmpl); err != nil {
+        return nil, err
+    }
+    return scope, nil
 }
 
-// Scope struct constructor with the given text template
-func NewScopeWithTemplate(tmpl string) *Scope {
-    scope := &Scope{ Pof : "?POF" }
-    tp, _ := template.New("rec").Parse(tmpl)
+// newScope creates a Scope with the given text template, without checking
+// the SPARQL queries it renders
+func newScope(tmpl string) (*Scope, error) {
+    scope := &Scope{ Pof : "?POF", Limit : 10 }
+    tp, err := template.New("rec").Funcs(scope.funcMap()).Parse(tmpl)
+    if err != nil {
+        return nil, err
+    }
     scope.template = tp
     scope.Prefixes = make(map[string]string)
-    return scope
+    return scope, nil
 }
 
 // Reset re-initialises the internal structures in preparation for a new query
@@ -112,6 +144,9 @@ func Reset(s *Sparql) {
     s.pathLength = 0
     s.Pof = "?POF"
     s.Tps = s.Tps[:0]
+    s.variables = s.variables[:0]
+    s.variablePof = false
+    s.modifiers = false
 }
 
 // SObjects returns the set of variables at the subject and object position
@@ -131,6 +166,19 @@ func (s *Scope) SObjects() string {
     return so
 }
 
+// GroupBy returns the variables to group the solutions by when ranking the
+// recommended items. For a path, these are the variables of each property.
+func (s *Scope) GroupBy() string {
+    if s.pathLength == 0 {
+        return "?POF"
+    }
+    vars := make([]string, s.pathLength)
+    for i := range vars {
+        vars[i] = "?POF" + strconv.Itoa(i + 1)
+    }
+    return strings.Join(vars, " ")
+}
+
 // PofSubject returns the subject variable that is connected to the ?POF
 func (s *Scope) PofSubject() string {
     for _,tp := range s.Tps {
@@ -176,6 +224,35 @@ func (b *Sparql) addTriplePattern() {
     b.Scope.Tps = append(b.Scope.Tps, tp)
 }
 
+// Adds a variable of the query, given without its leading '?' or '$'
+func (b *Scope) addVariable(name string) {
+    v := "?" + name
+    for _,known := range b.variables {
+        if known == v {
+            return
+        }
+    }
+    b.variables = append(b.variables, v)
+}
+
+// Sets the Point Of Focus as the position of a variable
+func (b *Scope) setVariablePof() {
+    b.variablePof = true
+}
+
+// Variables returns the variables of the query that can be recommended at the
+// Point Of Focus. If there is a keyword, only the variables with a name
+// starting with it are returned.
+func (b *Scope) Variables() []string {
+    var vars []string
+    for _,v := range b.variables {
+        if strings.HasPrefix(strings.ToLower(v[1:]), strings.ToLower(b.Keyword)) {
+            vars = append(vars, v)
+        }
+    }
+    return vars
+}
+
 // Sets the length of the path to be recommended
 func (b *Scope) setPathLength(lenght string) {
     b.pathLength, _ = strconv.Atoi(lenght)
@@ -184,40 +261,19 @@ func (b *Scope) setPathLength(lenght string) {
 // Removes triple patterns from the Scope that are not within the connected
 // component that contains the Point Of Focus
 func (b *Scope) trimToScope() {
-    b.scope = map[string]bool{ "?POF" : true }
-    size := 0
-    for size != len(b.scope) {
-        size = len(b.scope)
-        for _, tp := range b.Tps {
-            if (tp.in(b.scope)) {
-                tp.addToScope(b.scope)
-            }
-        }
+    sets := make([][]string, len(b.Tps))
+    for i,tp := range b.Tps {
+        sets[i] = []string{ tp.S, tp.P, tp.O }
     }
     var scoped []triplePattern
-    for _,tp := range b.Tps {
-        if (tp.in(b.scope)) {
-            scoped = append(scoped, tp)
+    for i,in := range sparql.Connected([]string{ "?POF" }, sets) {
+        if in {
+            scoped = append(scoped, b.Tps[i])
         }
     }
     b.Tps = scoped
 }
 
-// Returns true id the triple pattern is within the scope
-func (tp *triplePattern) in(scope map[string]bool) bool {
-    if scope[tp.S] || scope[tp.P] || scope[tp.O] {
-        return true
-    }
-    return false
-}
-
-// Adds the triple pattern to the scope
-func (tp *triplePattern) addToScope(scope map[string]bool) {
-    scope[tp.S] = true
-    scope[tp.P] = true
-    scope[tp.O] = true
-}
-
 // Update the Leaf attribute of the triplePattern
 func (b *Scope) setLeaves() {
     for i,tp := range b.Tps {
@@ -273,6 +329,7 @@ func pathPof(pathLength int) string {
 
 // RecommendationType returns the kind of recommendation for the processed SPARQL query
 func (b *Scope) RecommendationType() Type {
+    if b.variablePof { return VARIABLE }
     if b.pathLength != 0 { return PATH }
     for _,tp := range b.Tps {
         if tp.P == "?POF" {
@@ -293,13 +350,40 @@ func (b *Scope) RecommendationType() Type {
 
 // Returns the SPARQL query that can be used for retrieving recommendations
 // about the Point Of Focus. The recommended items are bound to the variable
-// labelled "?POF"
-func (b *Scope) RecommendationQuery() string {
+// labelled "?POF".
+// Variables are recommended without a query, the empty string is returned
+// instead; see Variables. An error is returned if the template cannot be
+// executed, e.g., if it refers to a missing field.
+func (b *Scope) RecommendationQuery() (string, error) {
+    if b.variablePof {
+        return "", nil
+    }
+    return b.render()
+}
+
+// BaseQuery returns the recommendation query as if there was neither a keyword
+// nor a prefix, i.e., the query of all the items that they may then select.
+// The Scope is left unchanged, so that RecommendationQuery can still be called.
+func (b *Scope) BaseQuery() (string, error) {
+    if b.variablePof {
+        return "", nil
+    }
+    tps := append([]triplePattern(nil), b.Tps...)
+    pof, keyword, prefix := b.Pof, b.Keyword, b.Prefix
+    b.Keyword, b.Prefix = "", ""
+    query, err := b.render()
+    b.Tps, b.Pof, b.Keyword, b.Prefix = tps, pof, keyword, prefix
+    return query, err
+}
+
+// render executes the template with the triple patterns within the scope of
+// the Point Of Focus
+func (b *Scope) render() (string, error) {
     b.trimToScope()
     b.addIntermediatePath()
     b.setLeaves()
     var out bytes.Buffer
-    b.template.Execute(&out, b)
-    return out.String()
+    err := b.template.Execute(&out, b)
+    return out.String(), err
 }
 
@@ -3,6 +3,9 @@ package autocompletion
 import (
     "testing"
     "bytes"
+    "strings"
+    "fmt"
+    "github.com/scampi/gosparqled/sparql"
 )
 
 // Add a triple pattern to the recommendation query
@@ -23,9 +26,24 @@ func parse(t *testing.T, query string, expected *Scope, rType Type) *Sparql {
     return s
 }
 
-// Like parse but pass a custom query recommendation template
+// recommendationQuery returns the recommendation query of the scope, or of
+// the processed query
+func recommendationQuery(t *testing.T, s interface{ RecommendationQuery() (string, error) }) string {
+    query, err := s.RecommendationQuery()
+    if err != nil {
+        t.Fatal(err)
+    }
+    return query
+}
+
+// Like parse but pass a custom query recommendation template.
+// The template may render only a fragment of the recommendation query.
 func parseWithTemplate(t *testing.T, query string, tmpl string, expected *Scope, rType Type) *Sparql {
-    s := &Sparql{ Buffer : query, Scope : NewScopeWithTemplate(tmpl) }
+    scope, err := newScope(tmpl)
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := &Sparql{ Buffer : query, Scope : scope }
     s.Init()
     parseWithSparql(t, s, expected, rType)
     return s
@@ -46,7 +64,7 @@ func parseWithSparql(t *testing.T, s *Sparql, expected *Scope, rType Type) {
         t.Errorf("Failed to parse query\n%v", err)
     }
     s.Execute()
-    actual := s.RecommendationQuery()
+    actual := recommendationQuery(t, s)
     var out bytes.Buffer
     s.template.Execute(&out, expected)
     expectedString := out.String()
@@ -318,6 +336,112 @@ func TestLimitOffset(t *testing.T) {
         `, td, CLASS)
 }
 
+func TestSolutionModifiers(t *testing.T) {
+    td := NewScope()
+    td.add("?s", "a", "?POF")
+    parse(t, `
+        SELECT ?s (count(*) AS ?c) WHERE {
+          ?s a <
+        }
+        GROUP BY ?s
+        HAVING (count(*) > 1)
+        ORDER BY DESC(?c) ?s
+        LIMIT 10
+        OFFSET 20
+        `, td, CLASS)
+}
+
+// Parses the query with the Point Of Focus where a variable is expected,
+// and compares the recommended variables against the expected ones
+func parseVariables(t *testing.T, query string, expected ...string) {
+    s := &Sparql{ Buffer : query, Scope : NewScope() }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatalf("Failed to parse query\n%v", err)
+    }
+    s.Execute()
+    if rType := s.RecommendationType(); rType != VARIABLE {
+        t.Errorf("Expected Recommendation type to be [%v] but got [%v]\n", VARIABLE, rType)
+    }
+    if query := recommendationQuery(t, s); query != "" {
+        t.Errorf("Expected no recommendation query but got %v\n", query)
+    }
+    if actual := s.Variables(); strings.Join(actual, " ") != strings.Join(expected, " ") {
+        t.Errorf("Expected variables %v but got %v\n", expected, actual)
+    }
+}
+
+func TestVariableOrderBy(t *testing.T) {
+    parseVariables(t, `
+        SELECT ?s (count(*) AS ?c) WHERE {
+          ?s ?p $o
+        }
+        ORDER BY < 
+        `, "?s", "?c", "?p", "?o")
+    parseVariables(t, `
+        SELECT * WHERE {
+          ?s ?p ?o
+        }
+        ORDER BY ?s DESC(< )
+        `, "?s", "?p", "?o")
+}
+
+func TestVariableHaving(t *testing.T) {
+    parseVariables(t, `
+        SELECT ?s WHERE {
+          ?s ?p ?o
+        }
+        GROUP BY ?s
+        HAVING (count(< ) > 1)
+        `, "?s", "?p", "?o")
+}
+
+func TestVariableOutsideModifiers(t *testing.T) {
+    for _,query := range []string{
+        "SELECT * WHERE { ?s ?p ?o . < ?p ?o }",
+        // the modifiers of a sub-query are closed
+        "SELECT * WHERE { { SELECT ?s WHERE { ?s ?p ?o } ORDER BY ?s } ?s < ?o }",
+        "SELECT * WHERE { { SELECT ?s WHERE { ?s ?p ?o } GROUP BY ?s } ?s ?p < }",
+    } {
+        s := &Sparql{ Buffer : query, Scope : NewScope() }
+        s.Init()
+        if err := s.Parse(); err != nil {
+            t.Fatalf("Failed to parse the query\n%v\n%v", query, err)
+        }
+        s.Execute()
+        if rType := s.RecommendationType(); rType == VARIABLE {
+            t.Errorf("Expected no variable recommendation outside the solution modifiers\n%v", query)
+        }
+    }
+}
+
+func TestVariableKeyword(t *testing.T) {
+    parseVariables(t, `
+        SELECT ?name ?age ?nickname WHERE {
+          ?s <name> ?name; <nick> ?nickname; <age> ?age
+        }
+        ORDER BY N< 
+        `, "?name", "?nickname")
+}
+
+func TestVariableReset(t *testing.T) {
+    s := &Sparql{ Buffer : "SELECT * { ?s ?p ?o } ORDER BY < ", Scope : NewScope() }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    Reset(s)
+    td := NewScope()
+    td.add("?s", "?POF", "?FillVar")
+    s.Buffer = "SELECT * { ?s < }"
+    s.Init()
+    parseWithSparql(t, s, td, PREDICATE)
+    if vars := s.Variables(); len(vars) != 1 || vars[0] != "?s" {
+        t.Errorf("Expected the variables [?s] but got %v\n", vars)
+    }
+}
+
 func TestPath1(t *testing.T) {
     td := NewScope()
     td.add("?sFillVar2", "?POF3", "?FillVar")
@@ -536,3 +660,409 @@ func TestOptional3(t *testing.T) {
     `, td, PREDICATE)
 }
 
+
+func TestLabelSearch(t *testing.T) {
+    query := `
+        SELECT * WHERE {
+          ?s a Birth< 
+        }
+    `
+    scope := NewScope()
+    scope.Labels = NewLabelOptions()
+    s := &Sparql{ Buffer : query, Scope : scope }
+    s.Init()
+
+    td := NewScope()
+    td.Labels = NewLabelOptions()
+    td.add("?s", "a", "?POF")
+    td.Keyword = "Birth"
+    parseWithSparql(t, s, td, CLASS)
+    if !strings.Contains(recommendationQuery(t, s), `regex(?POFLabel, "Birth", "i")`) {
+        t.Errorf("Expected the keyword to be matched against the label\n%v", recommendationQuery(t, s))
+    }
+}
+
+func TestLabelPatterns(t *testing.T) {
+    tmpl := "{{.LabelPatterns}}"
+    labels := &LabelOptions{
+        Predicates : []string{ "<label>" },
+        CommentPredicates : []string{ "<comment>" },
+        Languages : []string{ "fr", "" },
+    }
+    scope, _ := newScope(tmpl)
+    s := &Sparql{ Buffer : "SELECT * { ?s < }", Scope : scope }
+    s.Labels = labels
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    expected := `OPTIONAL { ?POF <label> ?POFLabel . FILTER (langMatches(lang(?POFLabel), "fr")) FILTER NOT EXISTS { ?POF <label> ?POFLabelOther . FILTER (langMatches(lang(?POFLabelOther), "fr") && (str(?POFLabelOther) < str(?POFLabel) || str(?POFLabelOther) = str(?POFLabel) && lang(?POFLabelOther) < lang(?POFLabel))) } }
+OPTIONAL { ?POF <label> ?POFLabel . FILTER (lang(?POFLabel) = "") FILTER NOT EXISTS { ?POF <label> ?POFLabelOther . FILTER (lang(?POFLabelOther) = "" && (str(?POFLabelOther) < str(?POFLabel) || str(?POFLabelOther) = str(?POFLabel) && lang(?POFLabelOther) < lang(?POFLabel))) } }
+OPTIONAL { ?POF <comment> ?POFComment . FILTER (langMatches(lang(?POFComment), "fr")) FILTER NOT EXISTS { ?POF <comment> ?POFCommentOther . FILTER (langMatches(lang(?POFCommentOther), "fr") && (str(?POFCommentOther) < str(?POFComment) || str(?POFCommentOther) = str(?POFComment) && lang(?POFCommentOther) < lang(?POFComment))) } }
+OPTIONAL { ?POF <comment> ?POFComment . FILTER (lang(?POFComment) = "") FILTER NOT EXISTS { ?POF <comment> ?POFCommentOther . FILTER (lang(?POFCommentOther) = "" && (str(?POFCommentOther) < str(?POFComment) || str(?POFCommentOther) = str(?POFComment) && lang(?POFCommentOther) < lang(?POFComment))) } }
+`
+    if actual := recommendationQuery(t, s); actual != expected {
+        t.Errorf("Expected %v\nbut got %v\n", expected, actual)
+    }
+}
+
+func TestLabelScopeQuery(t *testing.T) {
+    scope, _ := NewScopeByName("label")
+    s := &Sparql{ Buffer : "SELECT * { <a> < ?o }", Scope : scope }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    query := recommendationQuery(t, s)
+    labels := NewLabelOptions()
+    // one OPTIONAL per label predicate and language, which keeps the smallest label only
+    for _,predicate := range labels.Predicates {
+        for _,lang := range labels.Languages {
+            filter := fmt.Sprintf(`FILTER (langMatches(lang(?POFLabel), "%v"))`, lang)
+            other := fmt.Sprintf(`langMatches(lang(?POFLabelOther), "%v")`, lang)
+            if lang == "" {
+                filter, other = `FILTER (lang(?POFLabel) = "")`, `lang(?POFLabelOther) = ""`
+            }
+            optional := fmt.Sprintf("OPTIONAL { ?POF %v ?POFLabel . %v FILTER NOT EXISTS { ?POF %v ?POFLabelOther . " +
+                "FILTER (%v && (str(?POFLabelOther) < str(?POFLabel) || str(?POFLabelOther) = str(?POFLabel) && lang(?POFLabelOther) < lang(?POFLabel))) } }",
+                predicate, filter, predicate, other)
+            if n := strings.Count(query, optional); n != 1 {
+                t.Errorf("Expected once the OPTIONAL %v but got it %v times\n%v", optional, n, query)
+            }
+        }
+    }
+    if n, expected := strings.Count(query, "?POFLabel ."), len(labels.Predicates) * len(labels.Languages); n != expected {
+        t.Errorf("Expected %v label OPTIONALs but got %v\n%v", expected, n, query)
+    }
+}
+
+func TestLabelSearchPath(t *testing.T) {
+    tmpl := "{{.LabelSearch}}"
+    scope, _ := newScope(tmpl)
+    s := &Sparql{ Buffer : "SELECT * { ?s 2/< ?o }", Scope : scope }
+    s.Labels = NewLabelOptions()
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    if actual := recommendationQuery(t, s); actual != "false" {
+        t.Errorf("Expected no label search for a path recommendation, but got %v", actual)
+    }
+}
+
+func TestNewRecommendation(t *testing.T) {
+    rec := NewRecommendation(map[string]string{ "POF" : "http://dbpedia.org/ontology/P569", "POFLabel" : "Birth date" })
+    if rec.Item != "http://dbpedia.org/ontology/P569" || rec.Comment != "" {
+        t.Errorf("Unexpected recommendation %v", rec)
+    }
+    if rec.Display() != "Birth date" {
+        t.Errorf("Expected the label to be displayed but got %v", rec.Display())
+    }
+    rec = NewRecommendation(map[string]string{ "POF" : "http://dbpedia.org/ontology/P569" })
+    if rec.Display() != rec.Item {
+        t.Errorf("Expected the item to be displayed but got %v", rec.Display())
+    }
+    rec = NewRecommendation(map[string]string{ "POF" : "http://dbpedia.org/ontology/P569", "count" : "42" })
+    if rec.Count != 42 {
+        t.Errorf("Expected 42 occurrences but got %v", rec.Count)
+    }
+}
+
+func TestRanked(t *testing.T) {
+    query := `
+        SELECT * WHERE {
+          ?s a < 
+        }
+    `
+    scope := NewScope()
+    scope.Ranked = true
+    scope.Sample = 1000
+    scope.Limit = 20
+    scope.Offset = 40
+    s := &Sparql{ Buffer : query, Scope : scope }
+    s.Init()
+
+    td := NewScope()
+    td.Ranked = true
+    td.Sample = 1000
+    td.Limit = 20
+    td.Offset = 40
+    td.add("?s", "a", "?POF")
+    parseWithSparql(t, s, td, CLASS)
+    actual := recommendationQuery(t, s)
+    for _,expected := range []string{ "(COUNT(*) AS ?count)", "LIMIT 1000 }", "GROUP BY ?POF", "ORDER BY DESC(?count)", "LIMIT 20", "OFFSET 40" } {
+        if !strings.Contains(actual, expected) {
+            t.Errorf("Expected %v in the recommendation query\n%v", expected, actual)
+        }
+    }
+    if strings.Contains(actual, "DISTINCT") {
+        t.Errorf("Expected no DISTINCT in the ranked recommendation query\n%v", actual)
+    }
+}
+
+// A limit of 0 or less retrieves all the recommended items
+func TestNoLimit(t *testing.T) {
+    for _,limit := range []int{ 0, -1 } {
+        scope := NewScope()
+        scope.Limit = limit
+        s := &Sparql{ Buffer : "SELECT * WHERE { ?s a < }", Scope : scope }
+        s.Init()
+        if err := s.Parse(); err != nil {
+            t.Fatal(err)
+        }
+        s.Execute()
+        if actual := recommendationQuery(t, s); strings.Contains(actual, "LIMIT") {
+            t.Errorf("Expected no LIMIT with the limit %v\n%v", limit, actual)
+        }
+    }
+}
+
+func TestRenderError(t *testing.T) {
+    scope, err := newScope("SELECT ?POF WHERE { {{.Missing}} }")
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := &Sparql{ Buffer : "SELECT * WHERE { ?s a < }", Scope : scope }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    if _, err := s.RecommendationQuery(); err == nil {
+        t.Error("Expected the error of the template to be returned")
+    }
+    if _, err := s.BaseQuery(); err == nil {
+        t.Error("Expected the error of the template to be returned by BaseQuery")
+    }
+}
+
+func TestRankedPath(t *testing.T) {
+    tmpl := "{{.GroupBy}}"
+    td := NewScope()
+    td.pathLength = 2
+    td.add("?so1", "?POF2", "?o")
+    td.add("?s", "?POF1", "?so1")
+    s := parseWithTemplate(t, `
+        SELECT * WHERE {
+          ?s 2/< ?o
+        }
+    `, tmpl, td, PATH)
+    if actual := recommendationQuery(t, s); actual != "?POF1 ?POF2" {
+        t.Errorf("Expected to group by each property of the path, but got %v", actual)
+    }
+}
+
+func TestTemplateLibrary(t *testing.T) {
+    query := `
+        SELECT * WHERE {
+          ?s a Person< 
+        }
+    `
+    expected := map[string]string{
+        "default" : `FILTER regex(?POF, "Person", "i")`,
+        "ranked" : "ORDER BY DESC(?count)",
+        "sampled" : "LIMIT 1000 }",
+        "label" : `regex(?POFLabel, "Person", "i")`,
+        "count" : "SELECT (COUNT(*) AS ?count)",
+        "virtuoso" : `?POFMatch <bif:contains> "'Person*'"`,
+        "blazegraph" : `?POFMatch <http://www.bigdata.com/rdf/search#search> "Person"`,
+        "jena" : `?POF <http://jena.apache.org/text#query> "Person*"`,
+        "graphdb" : `<http://www.ontotext.com/connectors/lucene#query> "Person*"`,
+        "summary" : "<http://vocab.sindice.net/analytics#label> ?POF",
+    }
+    for _,name := range TemplateNames() {
+        scope, err := NewScopeByName(name)
+        if err != nil {
+            t.Fatal(err)
+        }
+        s := &Sparql{ Buffer : query, Scope : scope }
+        s.Init()
+        if err := s.Parse(); err != nil {
+            t.Fatal(err)
+        }
+        s.Execute()
+        if actual := recommendationQuery(t, s); !strings.Contains(actual, expected[name]) {
+            t.Errorf("Expected %v in the [%v] recommendation query\n%v", expected[name], name, actual)
+        }
+    }
+    if _, err := NewScopeByName("unknown"); err == nil {
+        t.Error("Expected an error for an unknown template")
+    }
+}
+
+// The keyword and the prefix are escaped in the string literals of the
+// templates of the library
+func TestTemplateEscaping(t *testing.T) {
+    for _,name := range TemplateNames() {
+        scope, err := NewScopeByName(name)
+        if err != nil {
+            t.Fatal(err)
+        }
+        scope.Tps = []triplePattern{ { S : "?s", P : "a", O : "?POF" } }
+        for _,c := range []struct{ keyword, prefix, escaped string }{
+            { `it's a "quote" \`, "", `it\'s a \"quote\" \\` },
+            { "", `http://example.org/"x`, `http://example\\.org/\"x` },
+        } {
+            scope.Keyword, scope.Prefix = c.keyword, c.prefix
+            query := recommendationQuery(t, scope)
+            escaped := c.escaped
+            if name == "virtuoso" && c.keyword != "" {
+                // only the words of the keyword are kept in the phrase
+                escaped = `"'it s a quote*'"`
+            }
+            if !strings.Contains(query, escaped) {
+                t.Errorf("Expected the [%v] recommendation query to contain %v\n%v", name, escaped, query)
+            }
+            p := &sparql.Sparql{ Buffer : query }
+            p.Init()
+            if err := p.Parse(); err != nil {
+                t.Errorf("Expected a valid [%v] recommendation query\n%v\n%v", name, query, err)
+            }
+        }
+    }
+}
+
+func TestKeywordRegexEscaping(t *testing.T) {
+    scope := NewScope()
+    scope.Tps = []triplePattern{ { S : "?s", P : "a", O : "?POF" } }
+    scope.Keyword = "c++ (x)"
+    escaped := `"c\\+\\+ \\(x\\)", "i")`
+    if query := recommendationQuery(t, scope); !strings.Contains(query, `regex(?POF, ` + escaped) {
+        t.Errorf("Expected the keyword to be matched literally\n%v", query)
+    }
+    scope.Labels = NewLabelOptions()
+    query := recommendationQuery(t, scope)
+    if !strings.Contains(query, `regex(?POF, ` + escaped) || !strings.Contains(query, `regex(?POFLabel, ` + escaped) {
+        t.Errorf("Expected the keyword to be matched literally against the item and its label\n%v", query)
+    }
+}
+
+func TestVirtuosoPhrase(t *testing.T) {
+    scope, err := NewScopeByName("virtuoso")
+    if err != nil {
+        t.Fatal(err)
+    }
+    scope.Tps = []triplePattern{ { S : "?s", P : "<http://xmlns.com/foaf/0.1/name>", O : "?POF" } }
+    scope.Keyword = "o'brien"
+    query := recommendationQuery(t, scope)
+    if !strings.Contains(query, `<bif:contains> "'o brien*'"`) {
+        t.Errorf("Expected the words of the keyword in the phrase\n%v", query)
+    }
+    p := &sparql.Sparql{ Buffer : query }
+    p.Init()
+    if err := p.Parse(); err != nil {
+        t.Errorf("Expected a valid recommendation query\n%v\n%v", query, err)
+    }
+    for keyword, expected := range map[string]string{ "o'brien" : "o brien", "Jean-Paul  Sartre" : "Jean Paul Sartre", "élan" : "élan", `'"` : "" } {
+        if actual := virtuosoPhrase(keyword); actual != expected {
+            t.Errorf("Expected the phrase %q of %q but got %q", expected, keyword, actual)
+        }
+    }
+    // a keyword with only punctuation has no phrase to search for
+    scope.Keyword = `'"!`
+    query = recommendationQuery(t, scope)
+    if strings.Contains(query, "bif:contains") || !strings.Contains(query, "?POFLabelPredicate IN") {
+        t.Errorf("Expected the labels not to be searched without a phrase\n%v", query)
+    }
+    p = &sparql.Sparql{ Buffer : query }
+    p.Init()
+    if err := p.Parse(); err != nil {
+        t.Errorf("Expected a valid recommendation query\n%v\n%v", query, err)
+    }
+}
+
+func TestTemplateFuncs(t *testing.T) {
+    tmpl := `{{escapeString "a\"b\n"}} {{escapeRegex "a.b*" | escapeString}} {{iri "http://a b"}} {{prefixed "<http://example.org/ns/Person>"}} {{prefixed "http://other.org/x"}} {{valuesBlock "?POF" .Values}} {{pofSubject}} {{sObjects}}`
+    td := NewScope()
+    td.add("?v1", "?POF", "?v2")
+    s := parseWithTemplate(t, `
+        PREFIX ex: <http://example.org/>
+        PREFIX ns: <http://example.org/ns/>
+        SELECT * WHERE {
+          ?v1 < ?v2
+        }
+    `, tmpl, td, PREDICATE)
+    s.Values = []string{ "<a>", "<b>" }
+    expected := `a\"b\n a\\.b\\* <http://a%20b> ns:Person <http://other.org/x> VALUES ?POF { <a> <b> } ?v1 ?v1 ?v2 `
+    if actual := recommendationQuery(t, s); actual != expected {
+        t.Errorf("Expected %v\nbut got %v\n", expected, actual)
+    }
+}
+
+func TestInvalidTemplate(t *testing.T) {
+    if _, err := NewScopeWithTemplate("SELECT * { {{.Tps}"); err == nil {
+        t.Error("Expected an error for a template that cannot be parsed")
+    }
+    if _, err := NewScopeWithTemplate("SELECT * { {{range .Tps}}{{.S}} {{.P}} {{.O}}{{end}}"); err == nil {
+        t.Error("Expected an error for a template rendering an invalid SPARQL query")
+    }
+    if _, err := NewScopeWithTemplate("SELECT * { {{.Unknown}} }"); err == nil {
+        t.Error("Expected an error for a template that cannot be executed")
+    }
+    if err := RegisterTemplate("invalid", "SELECT * {"); err == nil {
+        t.Error("Expected an invalid template not to be registered")
+    }
+    for _,name := range TemplateNames() {
+        if _, err := NewScopeByName(name); err != nil {
+            t.Errorf("Expected the [%v] template to be valid\n%v", name, err)
+        }
+    }
+}
+
+func TestPrefixedNamePof(t *testing.T) {
+    td := NewScope()
+    td.add("?s", "a", "ex:")
+    td.add("?s", "?POF", "?FillVar")
+    td.Prefixes["ex"] = "http://example.org/"
+    td.Prefix = "http://example.org/"
+    parse(t, `
+        PREFIX ex: <http://example.org/>
+        SELECT * WHERE {
+          ?s a ex: ; ex:<
+        }
+    `, td, PREDICATE)
+}
+
+func TestBaseQuery(t *testing.T) {
+    base := &Sparql{ Buffer : "SELECT * { ?s a <A> ; < }", Scope : NewScope() }
+    base.Init()
+    if err := base.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    base.Execute()
+    expected := recommendationQuery(t, base)
+
+    s := &Sparql{ Buffer : "SELECT * { ?s a <A> ; name< }", Scope : NewScope() }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    if actual, err := s.BaseQuery(); err != nil || actual != expected {
+        t.Errorf("Expected %v\nbut got %v\n", expected, actual)
+    }
+    // the scope is unchanged
+    if s.Keyword != "name" || !strings.Contains(recommendationQuery(t, s), `regex(?POF, "name", "i")`) {
+        t.Errorf("Expected the keyword to be kept\n%v", recommendationQuery(t, s))
+    }
+}
+
+func TestBaseQueryPath(t *testing.T) {
+    s := &Sparql{ Buffer : "SELECT * { ?s 2/< ?o }", Scope : NewScope() }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    // the intermediate properties of the path are added once
+    expected, err := s.BaseQuery()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if actual := recommendationQuery(t, s); actual != expected {
+        t.Errorf("Expected %v\nbut got %v\n", expected, actual)
+    }
+}
@@ -7,10 +7,10 @@ import (
 	"strconv"
 )
 
-const end_symbol rune = 4
+const endSymbol rune = 1114112
 
 /* The rule types inferred from the grammar are below. */
-type pegRule uint8
+type pegRule uint16
 
 const (
 	ruleUnknown pegRule = iota
@@ -67,11 +67,20 @@ const (
 	ruleobject
 	rulegraphNodePath
 	rulesolutionModifier
+	rulegroupClause
+	rulehavingClause
+	ruleorderClause
 	rulegroupCondition
 	ruleorderCondition
 	rulelimitOffsetClauses
 	rulelimit
 	ruleoffset
+	rulevaluesClause
+	ruleinlineData
+	ruledataBlock
+	ruleinlineDataOneVar
+	ruleinlineDataFull
+	ruledataBlockValue
 	ruleexpression
 	ruleconditionalOrExpression
 	ruleconditionalAndExpression
@@ -89,6 +98,7 @@ const (
 	rulecount
 	rulegroupConcat
 	rulebuiltinCall
+	rulepofVariable
 	rulepof
 	rulevar
 	ruleiriref
@@ -101,8 +111,14 @@ const (
 	rulestringLiteralLongA
 	rulestringLiteralLongB
 	ruleechar
+	ruleuchar
 	rulenumericLiteral
 	rulesignedNumericLiteral
+	ruleunsignedNumericLiteral
+	ruledouble
+	ruledecimal
+	ruleinteger
+	ruleexponent
 	rulebooleanLiteral
 	ruleblankNode
 	ruleblankNodeLabel
@@ -238,14 +254,16 @@ const (
 	ruleHAVING
 	ruleGRAPH
 	ruleMINUSSETOPER
+	ruleSERVICE
+	ruleSILENT
+	ruleVALUES
+	ruleUNDEF
 	ruleskip
 	rulews
 	rulecomment
 	ruleendOfLine
 	rulePegText
 	ruleAction0
-	ruleSERVICE
-	ruleSILENT
 	ruleAction1
 	ruleAction2
 	ruleAction3
@@ -259,10 +277,12 @@ const (
 	ruleAction11
 	ruleAction12
 	ruleAction13
+	ruleAction14
+	ruleAction15
 
-	rulePre_
-	rule_In_
-	rule_Suf
+	rulePre
+	ruleIn
+	ruleSuf
 )
 
 var rul3s = [...]string{
@@ -320,11 +340,20 @@ var rul3s = [...]string{
 	"object",
 	"graphNodePath",
 	"solutionModifier",
+	"groupClause",
+	"havingClause",
+	"orderClause",
 	"groupCondition",
 	"orderCondition",
 	"limitOffsetClauses",
 	"limit",
 	"offset",
+	"valuesClause",
+	"inlineData",
+	"dataBlock",
+	"inlineDataOneVar",
+	"inlineDataFull",
+	"dataBlockValue",
 	"expression",
 	"conditionalOrExpression",
 	"conditionalAndExpression",
@@ -342,6 +371,7 @@ var rul3s = [...]string{
 	"count",
 	"groupConcat",
 	"builtinCall",
+	"pofVariable",
 	"pof",
 	"var",
 	"iriref",
@@ -354,8 +384,14 @@ var rul3s = [...]string{
 	"stringLiteralLongA",
 	"stringLiteralLongB",
 	"echar",
+	"uchar",
 	"numericLiteral",
 	"signedNumericLiteral",
+	"unsignedNumericLiteral",
+	"double",
+	"decimal",
+	"integer",
+	"exponent",
 	"booleanLiteral",
 	"blankNode",
 	"blankNodeLabel",
@@ -491,14 +527,16 @@ var rul3s = [...]string{
 	"HAVING",
 	"GRAPH",
 	"MINUSSETOPER",
+	"SERVICE",
+	"SILENT",
+	"VALUES",
+	"UNDEF",
 	"skip",
 	"ws",
 	"comment",
 	"endOfLine",
 	"PegText",
 	"Action0",
-	"SERVICE",
-	"SILENT",
 	"Action1",
 	"Action2",
 	"Action3",
@@ -512,24 +550,14 @@ var rul3s = [...]string{
 	"Action11",
 	"Action12",
 	"Action13",
+	"Action14",
+	"Action15",
 
 	"Pre_",
 	"_In_",
 	"_Suf",
 }
 
-type tokenTree interface {
-	Print()
-	PrintSyntax()
-	PrintSyntaxTree(buffer string)
-	Add(rule pegRule, begin, end, next, depth int)
-	Expand(index int) tokenTree
-	Tokens() <-chan token32
-	AST() *node32
-	Error() []token32
-	trim(length int)
-}
-
 type node32 struct {
 	token32
 	up, next *node32
@@ -540,7 +568,7 @@ func (node *node32) print(depth int, buffer string) {
 		for c := 0; c < depth; c++ {
 			fmt.Printf(" ")
 		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[node.pegRule], strconv.Quote(buffer[node.begin:node.end]))
+		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[node.pegRule], strconv.Quote(string(([]rune(buffer)[node.begin:node.end]))))
 		if node.up != nil {
 			node.up.print(depth+1, buffer)
 		}
@@ -548,8 +576,8 @@ func (node *node32) print(depth int, buffer string) {
 	}
 }
 
-func (ast *node32) Print(buffer string) {
-	ast.print(0, buffer)
+func (node *node32) Print(buffer string) {
+	node.print(0, buffer)
 }
 
 type element struct {
@@ -557,258 +585,10 @@ type element struct {
 	down *element
 }
 
-/* ${@} bit structure for abstract syntax tree */
-type token16 struct {
-	pegRule
-	begin, end, next int16
-}
-
-func (t *token16) isZero() bool {
-	return t.pegRule == ruleUnknown && t.begin == 0 && t.end == 0 && t.next == 0
-}
-
-func (t *token16) isParentOf(u token16) bool {
-	return t.begin <= u.begin && t.end >= u.end && t.next > u.next
-}
-
-func (t *token16) getToken32() token32 {
-	return token32{pegRule: t.pegRule, begin: int32(t.begin), end: int32(t.end), next: int32(t.next)}
-}
-
-func (t *token16) String() string {
-	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v %v", rul3s[t.pegRule], t.begin, t.end, t.next)
-}
-
-type tokens16 struct {
-	tree    []token16
-	ordered [][]token16
-}
-
-func (t *tokens16) trim(length int) {
-	t.tree = t.tree[0:length]
-}
-
-func (t *tokens16) Print() {
-	for _, token := range t.tree {
-		fmt.Println(token.String())
-	}
-}
-
-func (t *tokens16) Order() [][]token16 {
-	if t.ordered != nil {
-		return t.ordered
-	}
-
-	depths := make([]int16, 1, math.MaxInt16)
-	for i, token := range t.tree {
-		if token.pegRule == ruleUnknown {
-			t.tree = t.tree[:i]
-			break
-		}
-		depth := int(token.next)
-		if length := len(depths); depth >= length {
-			depths = depths[:depth+1]
-		}
-		depths[depth]++
-	}
-	depths = append(depths, 0)
-
-	ordered, pool := make([][]token16, len(depths)), make([]token16, len(t.tree)+len(depths))
-	for i, depth := range depths {
-		depth++
-		ordered[i], pool, depths[i] = pool[:depth], pool[depth:], 0
-	}
-
-	for i, token := range t.tree {
-		depth := token.next
-		token.next = int16(i)
-		ordered[depth][depths[depth]] = token
-		depths[depth]++
-	}
-	t.ordered = ordered
-	return ordered
-}
-
-type state16 struct {
-	token16
-	depths []int16
-	leaf   bool
-}
-
-func (t *tokens16) AST() *node32 {
-	tokens := t.Tokens()
-	stack := &element{node: &node32{token32: <-tokens}}
-	for token := range tokens {
-		if token.begin == token.end {
-			continue
-		}
-		node := &node32{token32: token}
-		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
-			stack.node.next = node.up
-			node.up = stack.node
-			stack = stack.down
-		}
-		stack = &element{node: node, down: stack}
-	}
-	return stack.node
-}
-
-func (t *tokens16) PreOrder() (<-chan state16, [][]token16) {
-	s, ordered := make(chan state16, 6), t.Order()
-	go func() {
-		var states [8]state16
-		for i, _ := range states {
-			states[i].depths = make([]int16, len(ordered))
-		}
-		depths, state, depth := make([]int16, len(ordered)), 0, 1
-		write := func(t token16, leaf bool) {
-			S := states[state]
-			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, int16(depth), leaf
-			copy(S.depths, depths)
-			s <- S
-		}
-
-		states[state].token16 = ordered[0][0]
-		depths[0]++
-		state++
-		a, b := ordered[depth-1][depths[depth-1]-1], ordered[depth][depths[depth]]
-	depthFirstSearch:
-		for {
-			for {
-				if i := depths[depth]; i > 0 {
-					if c, j := ordered[depth][i-1], depths[depth-1]; a.isParentOf(c) &&
-						(j < 2 || !ordered[depth-1][j-2].isParentOf(c)) {
-						if c.end != b.begin {
-							write(token16{pegRule: rule_In_, begin: c.end, end: b.begin}, true)
-						}
-						break
-					}
-				}
-
-				if a.begin < b.begin {
-					write(token16{pegRule: rulePre_, begin: a.begin, end: b.begin}, true)
-				}
-				break
-			}
-
-			next := depth + 1
-			if c := ordered[next][depths[next]]; c.pegRule != ruleUnknown && b.isParentOf(c) {
-				write(b, false)
-				depths[depth]++
-				depth, a, b = next, b, c
-				continue
-			}
-
-			write(b, true)
-			depths[depth]++
-			c, parent := ordered[depth][depths[depth]], true
-			for {
-				if c.pegRule != ruleUnknown && a.isParentOf(c) {
-					b = c
-					continue depthFirstSearch
-				} else if parent && b.end != a.end {
-					write(token16{pegRule: rule_Suf, begin: b.end, end: a.end}, true)
-				}
-
-				depth--
-				if depth > 0 {
-					a, b, c = ordered[depth-1][depths[depth-1]-1], a, ordered[depth][depths[depth]]
-					parent = a.isParentOf(b)
-					continue
-				}
-
-				break depthFirstSearch
-			}
-		}
-
-		close(s)
-	}()
-	return s, ordered
-}
-
-func (t *tokens16) PrintSyntax() {
-	tokens, ordered := t.PreOrder()
-	max := -1
-	for token := range tokens {
-		if !token.leaf {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[36m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[36m%v\x1B[m\n", rul3s[token.pegRule])
-		} else if token.begin == token.end {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[31m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[31m%v\x1B[m\n", rul3s[token.pegRule])
-		} else {
-			for c, end := token.begin, token.end; c < end; c++ {
-				if i := int(c); max+1 < i {
-					for j := max; j < i; j++ {
-						fmt.Printf("skip %v %v\n", j, token.String())
-					}
-					max = i
-				} else if i := int(c); i <= max {
-					for j := i; j <= max; j++ {
-						fmt.Printf("dupe %v %v\n", j, token.String())
-					}
-				} else {
-					max = int(c)
-				}
-				fmt.Printf("%v", c)
-				for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-					fmt.Printf(" \x1B[34m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-				}
-				fmt.Printf(" \x1B[34m%v\x1B[m\n", rul3s[token.pegRule])
-			}
-			fmt.Printf("\n")
-		}
-	}
-}
-
-func (t *tokens16) PrintSyntaxTree(buffer string) {
-	tokens, _ := t.PreOrder()
-	for token := range tokens {
-		for c := 0; c < int(token.next); c++ {
-			fmt.Printf(" ")
-		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(buffer[token.begin:token.end]))
-	}
-}
-
-func (t *tokens16) Add(rule pegRule, begin, end, depth, index int) {
-	t.tree[index] = token16{pegRule: rule, begin: int16(begin), end: int16(end), next: int16(depth)}
-}
-
-func (t *tokens16) Tokens() <-chan token32 {
-	s := make(chan token32, 16)
-	go func() {
-		for _, v := range t.tree {
-			s <- v.getToken32()
-		}
-		close(s)
-	}()
-	return s
-}
-
-func (t *tokens16) Error() []token32 {
-	ordered := t.Order()
-	length := len(ordered)
-	tokens, length := make([]token32, length), length-1
-	for i, _ := range tokens {
-		o := ordered[length-i]
-		if len(o) > 1 {
-			tokens[i] = o[len(o)-2].getToken32()
-		}
-	}
-	return tokens
-}
-
 /* ${@} bit structure for abstract syntax tree */
 type token32 struct {
 	pegRule
-	begin, end, next int32
+	begin, end, next uint32
 }
 
 func (t *token32) isZero() bool {
@@ -820,7 +600,7 @@ func (t *token32) isParentOf(u token32) bool {
 }
 
 func (t *token32) getToken32() token32 {
-	return token32{pegRule: t.pegRule, begin: int32(t.begin), end: int32(t.end), next: int32(t.next)}
+	return token32{pegRule: t.pegRule, begin: uint32(t.begin), end: uint32(t.end), next: uint32(t.next)}
 }
 
 func (t *token32) String() string {
@@ -869,7 +649,7 @@ func (t *tokens32) Order() [][]token32 {
 
 	for i, token := range t.tree {
 		depth := token.next
-		token.next = int32(i)
+		token.next = uint32(i)
 		ordered[depth][depths[depth]] = token
 		depths[depth]++
 	}
@@ -905,13 +685,13 @@ func (t *tokens32) PreOrder() (<-chan state32, [][]token32) {
 	s, ordered := make(chan state32, 6), t.Order()
 	go func() {
 		var states [8]state32
-		for i, _ := range states {
+		for i := range states {
 			states[i].depths = make([]int32, len(ordered))
 		}
 		depths, state, depth := make([]int32, len(ordered)), 0, 1
 		write := func(t token32, leaf bool) {
 			S := states[state]
-			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, int32(depth), leaf
+			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, uint32(depth), leaf
 			copy(S.depths, depths)
 			s <- S
 		}
@@ -927,14 +707,14 @@ func (t *tokens32) PreOrder() (<-chan state32, [][]token32) {
 					if c, j := ordered[depth][i-1], depths[depth-1]; a.isParentOf(c) &&
 						(j < 2 || !ordered[depth-1][j-2].isParentOf(c)) {
 						if c.end != b.begin {
-							write(token32{pegRule: rule_In_, begin: c.end, end: b.begin}, true)
+							write(token32{pegRule: ruleIn, begin: c.end, end: b.begin}, true)
 						}
 						break
 					}
 				}
 
 				if a.begin < b.begin {
-					write(token32{pegRule: rulePre_, begin: a.begin, end: b.begin}, true)
+					write(token32{pegRule: rulePre, begin: a.begin, end: b.begin}, true)
 				}
 				break
 			}
@@ -955,7 +735,7 @@ func (t *tokens32) PreOrder() (<-chan state32, [][]token32) {
 					b = c
 					continue depthFirstSearch
 				} else if parent && b.end != a.end {
-					write(token32{pegRule: rule_Suf, begin: b.end, end: a.end}, true)
+					write(token32{pegRule: ruleSuf, begin: b.end, end: a.end}, true)
 				}
 
 				depth--
@@ -1021,12 +801,12 @@ func (t *tokens32) PrintSyntaxTree(buffer string) {
 		for c := 0; c < int(token.next); c++ {
 			fmt.Printf(" ")
 		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(buffer[token.begin:token.end]))
+		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(string(([]rune(buffer)[token.begin:token.end]))))
 	}
 }
 
-func (t *tokens32) Add(rule pegRule, begin, end, depth, index int) {
-	t.tree[index] = token32{pegRule: rule, begin: int32(begin), end: int32(end), next: int32(depth)}
+func (t *tokens32) Add(rule pegRule, begin, end, depth uint32, index int) {
+	t.tree[index] = token32{pegRule: rule, begin: uint32(begin), end: uint32(end), next: uint32(depth)}
 }
 
 func (t *tokens32) Tokens() <-chan token32 {
@@ -1044,7 +824,7 @@ func (t *tokens32) Error() []token32 {
 	ordered := t.Order()
 	length := len(ordered)
 	tokens, length := make([]token32, length), length-1
-	for i, _ := range tokens {
+	for i := range tokens {
 		o := ordered[length-i]
 		if len(o) > 1 {
 			tokens[i] = o[len(o)-2].getToken32()
@@ -1053,26 +833,13 @@ func (t *tokens32) Error() []token32 {
 	return tokens
 }
 
-func (t *tokens16) Expand(index int) tokenTree {
-	tree := t.tree
-	if index >= len(tree) {
-		expanded := make([]token32, 2*len(tree))
-		for i, v := range tree {
-			expanded[i] = v.getToken32()
-		}
-		return &tokens32{tree: expanded}
-	}
-	return nil
-}
-
-func (t *tokens32) Expand(index int) tokenTree {
+func (t *tokens32) Expand(index int) {
 	tree := t.tree
 	if index >= len(tree) {
 		expanded := make([]token32, 2*len(tree))
 		copy(expanded, tree)
 		t.tree = expanded
 	}
-	return nil
 }
 
 type Sparql struct {
@@ -1085,10 +852,11 @@ type Sparql struct {
 
 	Buffer string
 	buffer []rune
-	rules  [246]func() bool
+	rules  [266]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
-	tokenTree
+	Pretty bool
+	tokens32
 }
 
 type textPosition struct {
@@ -1097,12 +865,12 @@ type textPosition struct {
 
 type textPositionMap map[int]textPosition
 
-func translatePositions(buffer string, positions []int) textPositionMap {
+func translatePositions(buffer []rune, positions []int) textPositionMap {
 	length, translations, j, line, symbol := len(positions), make(textPositionMap, len(positions)), 0, 1, 0
 	sort.Ints(positions)
 
 search:
-	for i, c := range buffer[0:] {
+	for i, c := range buffer {
 		if c == '\n' {
 			line, symbol = line+1, 0
 		} else {
@@ -1123,43 +891,51 @@ search:
 }
 
 type parseError struct {
-	p *Sparql
+	p   *Sparql
+	max token32
 }
 
 func (e *parseError) Error() string {
-	tokens, error := e.p.tokenTree.Error(), "\n"
+	tokens, error := []token32{e.max}, "\n"
 	positions, p := make([]int, 2*len(tokens)), 0
 	for _, token := range tokens {
 		positions[p], p = int(token.begin), p+1
 		positions[p], p = int(token.end), p+1
 	}
-	translations := translatePositions(e.p.Buffer, positions)
+	translations := translatePositions(e.p.buffer, positions)
+	format := "parse error near %v (line %v symbol %v - line %v symbol %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (line %v symbol %v - line %v symbol %v):\n%v\n"
+	}
 	for _, token := range tokens {
 		begin, end := int(token.begin), int(token.end)
-		error += fmt.Sprintf("parse error near \x1B[34m%v\x1B[m (line %v symbol %v - line %v symbol %v):\n%v\n",
+		error += fmt.Sprintf(format,
 			rul3s[token.pegRule],
 			translations[begin].line, translations[begin].symbol,
 			translations[end].line, translations[end].symbol,
-			/*strconv.Quote(*/ e.p.Buffer[begin:end] /*)*/)
+			strconv.Quote(string(e.p.buffer[begin:end])))
 	}
 
 	return error
 }
 
 func (p *Sparql) PrintSyntaxTree() {
-	p.tokenTree.PrintSyntaxTree(p.Buffer)
+	p.tokens32.PrintSyntaxTree(p.Buffer)
 }
 
 func (p *Sparql) Highlighter() {
-	p.tokenTree.PrintSyntax()
+	p.PrintSyntax()
 }
 
 func (p *Sparql) Execute() {
-	buffer, begin, end := p.Buffer, 0, 0
-	for token := range p.tokenTree.Tokens() {
+	buffer, _buffer, text, begin, end := p.Buffer, p.buffer, "", 0, 0
+	for token := range p.Tokens() {
 		switch token.pegRule {
+
 		case rulePegText:
 			begin, end = int(token.begin), int(token.end)
+			text = string(_buffer[begin:end])
+
 		case ruleAction0:
 			p.addPrefix(p.skipped(buffer, begin, end))
 		case ruleAction1:
@@ -1184,26 +960,32 @@ func (p *Sparql) Execute() {
 			p.O = p.skipped(buffer, begin, end)
 			p.addTriplePattern()
 		case ruleAction10:
-			p.setPrefix(p.skipped(buffer, begin, end))
+			p.setVariablePof()
 		case ruleAction11:
-			p.setPathLength(p.skipped(buffer, begin, end))
+			p.setPrefix(p.skipped(buffer, begin, end))
 		case ruleAction12:
-			p.setKeyword(p.skipped(buffer, begin, end))
+			p.setPathLength(p.skipped(buffer, begin, end))
 		case ruleAction13:
+			p.setKeyword(p.skipped(buffer, begin, end))
+		case ruleAction14:
+			p.addVariable(buffer[begin:end])
+		case ruleAction15:
 			p.skipBegin = begin
 
 		}
 	}
+	_, _, _, _, _ = buffer, _buffer, text, begin, end
 }
 
 func (p *Sparql) Init() {
 	p.buffer = []rune(p.Buffer)
-	if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != end_symbol {
-		p.buffer = append(p.buffer, end_symbol)
+	if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+		p.buffer = append(p.buffer, endSymbol)
 	}
 
-	var tree tokenTree = &tokens16{tree: make([]token16, math.MaxInt16)}
-	position, depth, tokenIndex, buffer, rules := 0, 0, 0, p.buffer, p.rules
+	tree := tokens32{tree: make([]token32, math.MaxInt16)}
+	var max token32
+	position, depth, tokenIndex, buffer, _rules := uint32(0), uint32(0), 0, p.buffer, p.rules
 
 	p.Parse = func(rule ...int) error {
 		r := 1
@@ -1211,28 +993,29 @@ func (p *Sparql) Init() {
 			r = rule[0]
 		}
 		matches := p.rules[r]()
-		p.tokenTree = tree
+		p.tokens32 = tree
 		if matches {
-			p.tokenTree.trim(tokenIndex)
+			p.trim(tokenIndex)
 			return nil
 		}
-		return &parseError{p}
+		return &parseError{p, max}
 	}
 
 	p.Reset = func() {
 		position, tokenIndex, depth = 0, 0, 0
 	}
 
-	add := func(rule pegRule, begin int) {
-		if t := tree.Expand(tokenIndex); t != nil {
-			tree = t
-		}
+	add := func(rule pegRule, begin uint32) {
+		tree.Expand(tokenIndex)
 		tree.Add(rule, begin, position, depth, tokenIndex)
 		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position, depth}
+		}
 	}
 
 	matchDot := func() bool {
-		if buffer[position] != end_symbol {
+		if buffer[position] != endSymbol {
 			position++
 			return true
 		}
@@ -1255,7 +1038,7 @@ func (p *Sparql) Init() {
 		return false
 	}*/
 
-	rules = [...]func() bool{
+	_rules = [...]func() bool{
 		nil,
 		/* 0 queryContainer <- <(skip prolog query !.)> */
 		func() bool {
@@ -1263,7 +1046,7 @@ func (p *Sparql) Init() {
 			{
 				position1 := position
 				depth++
-				if !rules[ruleskip]() {
+				if !_rules[ruleskip]() {
 					goto l0
 				}
 				{
@@ -1370,7 +1153,7 @@ func (p *Sparql) Init() {
 										position++
 									}
 								l19:
-									if !rules[ruleskip]() {
+									if !_rules[ruleskip]() {
 										goto l6
 									}
 									depth--
@@ -1381,7 +1164,7 @@ func (p *Sparql) Init() {
 									depth++
 									{
 										position22, tokenIndex22, depth22 := position, tokenIndex, depth
-										if !rules[rulepnPrefix]() {
+										if !_rules[rulepnPrefix]() {
 											goto l22
 										}
 										goto l23
@@ -1396,13 +1179,13 @@ func (p *Sparql) Init() {
 											goto l6
 										}
 										position++
-										if !rules[ruleskip]() {
+										if !_rules[ruleskip]() {
 											goto l6
 										}
 										depth--
 										add(ruleCOLON, position24)
 									}
-									if !rules[ruleiri]() {
+									if !_rules[ruleiri]() {
 										goto l6
 									}
 									depth--
@@ -1483,13 +1266,13 @@ func (p *Sparql) Init() {
 										position++
 									}
 								l34:
-									if !rules[ruleskip]() {
+									if !_rules[ruleskip]() {
 										goto l4
 									}
 									depth--
 									add(ruleBASE, position27)
 								}
-								if !rules[ruleiri]() {
+								if !_rules[ruleiri]() {
 									goto l4
 								}
 								depth--
@@ -1508,499 +1291,508 @@ func (p *Sparql) Init() {
 					position36 := position
 					depth++
 					{
-						switch buffer[position] {
-						case 'A', 'a':
+						position37, tokenIndex37, depth37 := position, tokenIndex, depth
+						{
+							position39 := position
+							depth++
+							if !_rules[ruleselect]() {
+								goto l38
+							}
+						l40:
+							{
+								position41, tokenIndex41, depth41 := position, tokenIndex, depth
+								if !_rules[ruledatasetClause]() {
+									goto l41
+								}
+								goto l40
+							l41:
+								position, tokenIndex, depth = position41, tokenIndex41, depth41
+							}
+							if !_rules[rulewhereClause]() {
+								goto l38
+							}
+							if !_rules[rulesolutionModifier]() {
+								goto l38
+							}
+							depth--
+							add(ruleselectQuery, position39)
+						}
+						goto l37
+					l38:
+						position, tokenIndex, depth = position37, tokenIndex37, depth37
+						{
+							position43 := position
+							depth++
 							{
-								position38 := position
+								position44 := position
 								depth++
 								{
-									position39 := position
+									position45 := position
 									depth++
 									{
-										position40, tokenIndex40, depth40 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l41
+										position46, tokenIndex46, depth46 := position, tokenIndex, depth
+										if buffer[position] != rune('c') {
+											goto l47
 										}
 										position++
-										goto l40
-									l41:
-										position, tokenIndex, depth = position40, tokenIndex40, depth40
-										if buffer[position] != rune('A') {
-											goto l0
+										goto l46
+									l47:
+										position, tokenIndex, depth = position46, tokenIndex46, depth46
+										if buffer[position] != rune('C') {
+											goto l42
 										}
 										position++
 									}
-								l40:
+								l46:
 									{
-										position42, tokenIndex42, depth42 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l43
+										position48, tokenIndex48, depth48 := position, tokenIndex, depth
+										if buffer[position] != rune('o') {
+											goto l49
 										}
 										position++
-										goto l42
-									l43:
-										position, tokenIndex, depth = position42, tokenIndex42, depth42
-										if buffer[position] != rune('S') {
-											goto l0
+										goto l48
+									l49:
+										position, tokenIndex, depth = position48, tokenIndex48, depth48
+										if buffer[position] != rune('O') {
+											goto l42
 										}
 										position++
 									}
-								l42:
+								l48:
 									{
-										position44, tokenIndex44, depth44 := position, tokenIndex, depth
-										if buffer[position] != rune('k') {
-											goto l45
+										position50, tokenIndex50, depth50 := position, tokenIndex, depth
+										if buffer[position] != rune('n') {
+											goto l51
 										}
 										position++
-										goto l44
-									l45:
-										position, tokenIndex, depth = position44, tokenIndex44, depth44
-										if buffer[position] != rune('K') {
-											goto l0
+										goto l50
+									l51:
+										position, tokenIndex, depth = position50, tokenIndex50, depth50
+										if buffer[position] != rune('N') {
+											goto l42
 										}
 										position++
 									}
-								l44:
-									if !rules[ruleskip]() {
-										goto l0
-									}
-									depth--
-									add(ruleASK, position39)
-								}
-							l46:
-								{
-									position47, tokenIndex47, depth47 := position, tokenIndex, depth
-									if !rules[ruledatasetClause]() {
-										goto l47
-									}
-									goto l46
-								l47:
-									position, tokenIndex, depth = position47, tokenIndex47, depth47
-								}
-								if !rules[rulewhereClause]() {
-									goto l0
-								}
-								depth--
-								add(ruleaskQuery, position38)
-							}
-							break
-						case 'D', 'd':
-							{
-								position48 := position
-								depth++
-								{
-									position49 := position
-									depth++
+								l50:
 									{
-										position50 := position
-										depth++
-										{
-											position51, tokenIndex51, depth51 := position, tokenIndex, depth
-											if buffer[position] != rune('d') {
-												goto l52
-											}
-											position++
-											goto l51
-										l52:
-											position, tokenIndex, depth = position51, tokenIndex51, depth51
-											if buffer[position] != rune('D') {
-												goto l0
-											}
-											position++
-										}
-									l51:
-										{
-											position53, tokenIndex53, depth53 := position, tokenIndex, depth
-											if buffer[position] != rune('e') {
-												goto l54
-											}
-											position++
+										position52, tokenIndex52, depth52 := position, tokenIndex, depth
+										if buffer[position] != rune('s') {
 											goto l53
-										l54:
-											position, tokenIndex, depth = position53, tokenIndex53, depth53
-											if buffer[position] != rune('E') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l52
 									l53:
-										{
-											position55, tokenIndex55, depth55 := position, tokenIndex, depth
-											if buffer[position] != rune('s') {
-												goto l56
-											}
-											position++
+										position, tokenIndex, depth = position52, tokenIndex52, depth52
+										if buffer[position] != rune('S') {
+											goto l42
+										}
+										position++
+									}
+								l52:
+									{
+										position54, tokenIndex54, depth54 := position, tokenIndex, depth
+										if buffer[position] != rune('t') {
 											goto l55
-										l56:
-											position, tokenIndex, depth = position55, tokenIndex55, depth55
-											if buffer[position] != rune('S') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l54
 									l55:
-										{
-											position57, tokenIndex57, depth57 := position, tokenIndex, depth
-											if buffer[position] != rune('c') {
-												goto l58
-											}
-											position++
+										position, tokenIndex, depth = position54, tokenIndex54, depth54
+										if buffer[position] != rune('T') {
+											goto l42
+										}
+										position++
+									}
+								l54:
+									{
+										position56, tokenIndex56, depth56 := position, tokenIndex, depth
+										if buffer[position] != rune('r') {
 											goto l57
-										l58:
-											position, tokenIndex, depth = position57, tokenIndex57, depth57
-											if buffer[position] != rune('C') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l56
 									l57:
-										{
-											position59, tokenIndex59, depth59 := position, tokenIndex, depth
-											if buffer[position] != rune('r') {
-												goto l60
-											}
-											position++
+										position, tokenIndex, depth = position56, tokenIndex56, depth56
+										if buffer[position] != rune('R') {
+											goto l42
+										}
+										position++
+									}
+								l56:
+									{
+										position58, tokenIndex58, depth58 := position, tokenIndex, depth
+										if buffer[position] != rune('u') {
 											goto l59
-										l60:
-											position, tokenIndex, depth = position59, tokenIndex59, depth59
-											if buffer[position] != rune('R') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l58
 									l59:
-										{
-											position61, tokenIndex61, depth61 := position, tokenIndex, depth
-											if buffer[position] != rune('i') {
-												goto l62
-											}
-											position++
+										position, tokenIndex, depth = position58, tokenIndex58, depth58
+										if buffer[position] != rune('U') {
+											goto l42
+										}
+										position++
+									}
+								l58:
+									{
+										position60, tokenIndex60, depth60 := position, tokenIndex, depth
+										if buffer[position] != rune('c') {
 											goto l61
-										l62:
-											position, tokenIndex, depth = position61, tokenIndex61, depth61
-											if buffer[position] != rune('I') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l60
 									l61:
-										{
-											position63, tokenIndex63, depth63 := position, tokenIndex, depth
-											if buffer[position] != rune('b') {
-												goto l64
-											}
-											position++
+										position, tokenIndex, depth = position60, tokenIndex60, depth60
+										if buffer[position] != rune('C') {
+											goto l42
+										}
+										position++
+									}
+								l60:
+									{
+										position62, tokenIndex62, depth62 := position, tokenIndex, depth
+										if buffer[position] != rune('t') {
 											goto l63
-										l64:
-											position, tokenIndex, depth = position63, tokenIndex63, depth63
-											if buffer[position] != rune('B') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l62
 									l63:
-										{
-											position65, tokenIndex65, depth65 := position, tokenIndex, depth
-											if buffer[position] != rune('e') {
-												goto l66
-											}
-											position++
-											goto l65
-										l66:
-											position, tokenIndex, depth = position65, tokenIndex65, depth65
-											if buffer[position] != rune('E') {
-												goto l0
-											}
-											position++
-										}
-									l65:
-										if !rules[ruleskip]() {
-											goto l0
+										position, tokenIndex, depth = position62, tokenIndex62, depth62
+										if buffer[position] != rune('T') {
+											goto l42
 										}
-										depth--
-										add(ruleDESCRIBE, position50)
+										position++
 									}
-									{
-										switch buffer[position] {
-										case '*':
-											if !rules[ruleSTAR]() {
-												goto l0
-											}
-											break
-										case '$', '?':
-											if !rules[rulevar]() {
-												goto l0
-											}
-											break
-										default:
-											if !rules[ruleiriref]() {
-												goto l0
-											}
-											break
-										}
+								l62:
+									if !_rules[ruleskip]() {
+										goto l42
 									}
-
 									depth--
-									add(ruledescribe, position49)
+									add(ruleCONSTRUCT, position45)
 								}
-							l68:
-								{
-									position69, tokenIndex69, depth69 := position, tokenIndex, depth
-									if !rules[ruledatasetClause]() {
-										goto l69
-									}
-									goto l68
-								l69:
-									position, tokenIndex, depth = position69, tokenIndex69, depth69
+								if !_rules[ruleLBRACE]() {
+									goto l42
 								}
 								{
-									position70, tokenIndex70, depth70 := position, tokenIndex, depth
-									if !rules[rulewhereClause]() {
-										goto l70
+									position64, tokenIndex64, depth64 := position, tokenIndex, depth
+									if !_rules[ruletriplesBlock]() {
+										goto l64
 									}
-									goto l71
-								l70:
-									position, tokenIndex, depth = position70, tokenIndex70, depth70
+									goto l65
+								l64:
+									position, tokenIndex, depth = position64, tokenIndex64, depth64
 								}
-							l71:
-								if !rules[rulesolutionModifier]() {
-									goto l0
+							l65:
+								if !_rules[ruleRBRACE]() {
+									goto l42
 								}
 								depth--
-								add(ruledescribeQuery, position48)
+								add(ruleconstruct, position44)
+							}
+						l66:
+							{
+								position67, tokenIndex67, depth67 := position, tokenIndex, depth
+								if !_rules[ruledatasetClause]() {
+									goto l67
+								}
+								goto l66
+							l67:
+								position, tokenIndex, depth = position67, tokenIndex67, depth67
+							}
+							if !_rules[rulewhereClause]() {
+								goto l42
+							}
+							if !_rules[rulesolutionModifier]() {
+								goto l42
 							}
-							break
-						case 'C', 'c':
+							depth--
+							add(ruleconstructQuery, position43)
+						}
+						goto l37
+					l42:
+						position, tokenIndex, depth = position37, tokenIndex37, depth37
+						{
+							position69 := position
+							depth++
 							{
-								position72 := position
+								position70 := position
 								depth++
 								{
-									position73 := position
+									position71 := position
 									depth++
 									{
-										position74 := position
-										depth++
-										{
-											position75, tokenIndex75, depth75 := position, tokenIndex, depth
-											if buffer[position] != rune('c') {
-												goto l76
-											}
-											position++
+										position72, tokenIndex72, depth72 := position, tokenIndex, depth
+										if buffer[position] != rune('d') {
+											goto l73
+										}
+										position++
+										goto l72
+									l73:
+										position, tokenIndex, depth = position72, tokenIndex72, depth72
+										if buffer[position] != rune('D') {
+											goto l68
+										}
+										position++
+									}
+								l72:
+									{
+										position74, tokenIndex74, depth74 := position, tokenIndex, depth
+										if buffer[position] != rune('e') {
 											goto l75
-										l76:
-											position, tokenIndex, depth = position75, tokenIndex75, depth75
-											if buffer[position] != rune('C') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l74
 									l75:
-										{
-											position77, tokenIndex77, depth77 := position, tokenIndex, depth
-											if buffer[position] != rune('o') {
-												goto l78
-											}
-											position++
+										position, tokenIndex, depth = position74, tokenIndex74, depth74
+										if buffer[position] != rune('E') {
+											goto l68
+										}
+										position++
+									}
+								l74:
+									{
+										position76, tokenIndex76, depth76 := position, tokenIndex, depth
+										if buffer[position] != rune('s') {
 											goto l77
-										l78:
-											position, tokenIndex, depth = position77, tokenIndex77, depth77
-											if buffer[position] != rune('O') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l76
 									l77:
-										{
-											position79, tokenIndex79, depth79 := position, tokenIndex, depth
-											if buffer[position] != rune('n') {
-												goto l80
-											}
-											position++
+										position, tokenIndex, depth = position76, tokenIndex76, depth76
+										if buffer[position] != rune('S') {
+											goto l68
+										}
+										position++
+									}
+								l76:
+									{
+										position78, tokenIndex78, depth78 := position, tokenIndex, depth
+										if buffer[position] != rune('c') {
 											goto l79
-										l80:
-											position, tokenIndex, depth = position79, tokenIndex79, depth79
-											if buffer[position] != rune('N') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l78
 									l79:
-										{
-											position81, tokenIndex81, depth81 := position, tokenIndex, depth
-											if buffer[position] != rune('s') {
-												goto l82
-											}
-											position++
+										position, tokenIndex, depth = position78, tokenIndex78, depth78
+										if buffer[position] != rune('C') {
+											goto l68
+										}
+										position++
+									}
+								l78:
+									{
+										position80, tokenIndex80, depth80 := position, tokenIndex, depth
+										if buffer[position] != rune('r') {
 											goto l81
-										l82:
-											position, tokenIndex, depth = position81, tokenIndex81, depth81
-											if buffer[position] != rune('S') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l80
 									l81:
-										{
-											position83, tokenIndex83, depth83 := position, tokenIndex, depth
-											if buffer[position] != rune('t') {
-												goto l84
-											}
-											position++
+										position, tokenIndex, depth = position80, tokenIndex80, depth80
+										if buffer[position] != rune('R') {
+											goto l68
+										}
+										position++
+									}
+								l80:
+									{
+										position82, tokenIndex82, depth82 := position, tokenIndex, depth
+										if buffer[position] != rune('i') {
 											goto l83
-										l84:
-											position, tokenIndex, depth = position83, tokenIndex83, depth83
-											if buffer[position] != rune('T') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l82
 									l83:
-										{
-											position85, tokenIndex85, depth85 := position, tokenIndex, depth
-											if buffer[position] != rune('r') {
-												goto l86
-											}
-											position++
+										position, tokenIndex, depth = position82, tokenIndex82, depth82
+										if buffer[position] != rune('I') {
+											goto l68
+										}
+										position++
+									}
+								l82:
+									{
+										position84, tokenIndex84, depth84 := position, tokenIndex, depth
+										if buffer[position] != rune('b') {
 											goto l85
-										l86:
-											position, tokenIndex, depth = position85, tokenIndex85, depth85
-											if buffer[position] != rune('R') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l84
 									l85:
-										{
-											position87, tokenIndex87, depth87 := position, tokenIndex, depth
-											if buffer[position] != rune('u') {
-												goto l88
-											}
-											position++
+										position, tokenIndex, depth = position84, tokenIndex84, depth84
+										if buffer[position] != rune('B') {
+											goto l68
+										}
+										position++
+									}
+								l84:
+									{
+										position86, tokenIndex86, depth86 := position, tokenIndex, depth
+										if buffer[position] != rune('e') {
 											goto l87
-										l88:
-											position, tokenIndex, depth = position87, tokenIndex87, depth87
-											if buffer[position] != rune('U') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l86
 									l87:
-										{
-											position89, tokenIndex89, depth89 := position, tokenIndex, depth
-											if buffer[position] != rune('c') {
-												goto l90
-											}
-											position++
-											goto l89
-										l90:
-											position, tokenIndex, depth = position89, tokenIndex89, depth89
-											if buffer[position] != rune('C') {
-												goto l0
-											}
-											position++
-										}
-									l89:
-										{
-											position91, tokenIndex91, depth91 := position, tokenIndex, depth
-											if buffer[position] != rune('t') {
-												goto l92
-											}
-											position++
-											goto l91
-										l92:
-											position, tokenIndex, depth = position91, tokenIndex91, depth91
-											if buffer[position] != rune('T') {
-												goto l0
-											}
-											position++
-										}
-									l91:
-										if !rules[ruleskip]() {
-											goto l0
+										position, tokenIndex, depth = position86, tokenIndex86, depth86
+										if buffer[position] != rune('E') {
+											goto l68
 										}
-										depth--
-										add(ruleCONSTRUCT, position74)
-									}
-									if !rules[ruleLBRACE]() {
-										goto l0
-									}
-									{
-										position93, tokenIndex93, depth93 := position, tokenIndex, depth
-										if !rules[ruletriplesBlock]() {
-											goto l93
-										}
-										goto l94
-									l93:
-										position, tokenIndex, depth = position93, tokenIndex93, depth93
+										position++
 									}
-								l94:
-									if !rules[ruleRBRACE]() {
-										goto l0
+								l86:
+									if !_rules[ruleskip]() {
+										goto l68
 									}
 									depth--
-									add(ruleconstruct, position73)
+									add(ruleDESCRIBE, position71)
 								}
-							l95:
 								{
-									position96, tokenIndex96, depth96 := position, tokenIndex, depth
-									if !rules[ruledatasetClause]() {
-										goto l96
+									position88, tokenIndex88, depth88 := position, tokenIndex, depth
+									if !_rules[ruleSTAR]() {
+										goto l89
+									}
+									goto l88
+								l89:
+									position, tokenIndex, depth = position88, tokenIndex88, depth88
+									if !_rules[rulevar]() {
+										goto l90
+									}
+									goto l88
+								l90:
+									position, tokenIndex, depth = position88, tokenIndex88, depth88
+									if !_rules[ruleiriref]() {
+										goto l68
 									}
-									goto l95
-								l96:
-									position, tokenIndex, depth = position96, tokenIndex96, depth96
 								}
-								if !rules[rulewhereClause]() {
-									goto l0
+							l88:
+								depth--
+								add(ruledescribe, position70)
+							}
+						l91:
+							{
+								position92, tokenIndex92, depth92 := position, tokenIndex, depth
+								if !_rules[ruledatasetClause]() {
+									goto l92
 								}
-								if !rules[rulesolutionModifier]() {
-									goto l0
+								goto l91
+							l92:
+								position, tokenIndex, depth = position92, tokenIndex92, depth92
+							}
+							{
+								position93, tokenIndex93, depth93 := position, tokenIndex, depth
+								if !_rules[rulewhereClause]() {
+									goto l93
 								}
-								depth--
-								add(ruleconstructQuery, position72)
+								goto l94
+							l93:
+								position, tokenIndex, depth = position93, tokenIndex93, depth93
+							}
+						l94:
+							if !_rules[rulesolutionModifier]() {
+								goto l68
 							}
-							break
-						default:
+							depth--
+							add(ruledescribeQuery, position69)
+						}
+						goto l37
+					l68:
+						position, tokenIndex, depth = position37, tokenIndex37, depth37
+						{
+							position95 := position
+							depth++
 							{
-								position97 := position
+								position96 := position
 								depth++
-								if !rules[ruleselect]() {
-									goto l0
+								{
+									position97, tokenIndex97, depth97 := position, tokenIndex, depth
+									if buffer[position] != rune('a') {
+										goto l98
+									}
+									position++
+									goto l97
+								l98:
+									position, tokenIndex, depth = position97, tokenIndex97, depth97
+									if buffer[position] != rune('A') {
+										goto l0
+									}
+									position++
 								}
-							l98:
+							l97:
 								{
 									position99, tokenIndex99, depth99 := position, tokenIndex, depth
-									if !rules[ruledatasetClause]() {
-										goto l99
+									if buffer[position] != rune('s') {
+										goto l100
 									}
-									goto l98
-								l99:
+									position++
+									goto l99
+								l100:
 									position, tokenIndex, depth = position99, tokenIndex99, depth99
+									if buffer[position] != rune('S') {
+										goto l0
+									}
+									position++
 								}
-								if !rules[rulewhereClause]() {
-									goto l0
+							l99:
+								{
+									position101, tokenIndex101, depth101 := position, tokenIndex, depth
+									if buffer[position] != rune('k') {
+										goto l102
+									}
+									position++
+									goto l101
+								l102:
+									position, tokenIndex, depth = position101, tokenIndex101, depth101
+									if buffer[position] != rune('K') {
+										goto l0
+									}
+									position++
 								}
-								if !rules[rulesolutionModifier]() {
+							l101:
+								if !_rules[ruleskip]() {
 									goto l0
 								}
 								depth--
-								add(ruleselectQuery, position97)
+								add(ruleASK, position96)
+							}
+						l103:
+							{
+								position104, tokenIndex104, depth104 := position, tokenIndex, depth
+								if !_rules[ruledatasetClause]() {
+									goto l104
+								}
+								goto l103
+							l104:
+								position, tokenIndex, depth = position104, tokenIndex104, depth104
+							}
+							if !_rules[rulewhereClause]() {
+								goto l0
 							}
-							break
+							depth--
+							add(ruleaskQuery, position95)
 						}
 					}
-
+				l37:
+					{
+						position105, tokenIndex105, depth105 := position, tokenIndex, depth
+						if !_rules[rulevaluesClause]() {
+							goto l105
+						}
+						goto l106
+					l105:
+						position, tokenIndex, depth = position105, tokenIndex105, depth105
+					}
+				l106:
 					depth--
 					add(rulequery, position36)
 				}
 				{
-					position100, tokenIndex100, depth100 := position, tokenIndex, depth
+					position107, tokenIndex107, depth107 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l100
+						goto l107
 					}
 					goto l0
-				l100:
-					position, tokenIndex, depth = position100, tokenIndex100, depth100
+				l107:
+					position, tokenIndex, depth = position107, tokenIndex107, depth107
 				}
 				depth--
 				add(rulequeryContainer, position1)
@@ -2016,354 +1808,364 @@ func (p *Sparql) Init() {
 		nil,
 		/* 3 baseDecl <- <(BASE iri)> */
 		nil,
-		/* 4 query <- <((&('A' | 'a') askQuery) | (&('D' | 'd') describeQuery) | (&('C' | 'c') constructQuery) | (&('S' | 's') selectQuery))> */
+		/* 4 query <- <((selectQuery / constructQuery / describeQuery / askQuery) valuesClause?)> */
 		nil,
 		/* 5 selectQuery <- <(select datasetClause* whereClause solutionModifier)> */
 		nil,
 		/* 6 select <- <(SELECT (DISTINCT / REDUCED)? (STAR / projectionElem+))> */
 		func() bool {
-			position106, tokenIndex106, depth106 := position, tokenIndex, depth
+			position113, tokenIndex113, depth113 := position, tokenIndex, depth
 			{
-				position107 := position
+				position114 := position
 				depth++
 				{
-					position108 := position
+					position115 := position
 					depth++
 					{
-						position109, tokenIndex109, depth109 := position, tokenIndex, depth
+						position116, tokenIndex116, depth116 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l110
+							goto l117
 						}
 						position++
-						goto l109
-					l110:
-						position, tokenIndex, depth = position109, tokenIndex109, depth109
+						goto l116
+					l117:
+						position, tokenIndex, depth = position116, tokenIndex116, depth116
 						if buffer[position] != rune('S') {
-							goto l106
+							goto l113
 						}
 						position++
 					}
-				l109:
+				l116:
 					{
-						position111, tokenIndex111, depth111 := position, tokenIndex, depth
+						position118, tokenIndex118, depth118 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l112
+							goto l119
 						}
 						position++
-						goto l111
-					l112:
-						position, tokenIndex, depth = position111, tokenIndex111, depth111
+						goto l118
+					l119:
+						position, tokenIndex, depth = position118, tokenIndex118, depth118
 						if buffer[position] != rune('E') {
-							goto l106
+							goto l113
 						}
 						position++
 					}
-				l111:
+				l118:
 					{
-						position113, tokenIndex113, depth113 := position, tokenIndex, depth
+						position120, tokenIndex120, depth120 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l114
+							goto l121
 						}
 						position++
-						goto l113
-					l114:
-						position, tokenIndex, depth = position113, tokenIndex113, depth113
+						goto l120
+					l121:
+						position, tokenIndex, depth = position120, tokenIndex120, depth120
 						if buffer[position] != rune('L') {
-							goto l106
+							goto l113
 						}
 						position++
 					}
-				l113:
+				l120:
 					{
-						position115, tokenIndex115, depth115 := position, tokenIndex, depth
+						position122, tokenIndex122, depth122 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l116
+							goto l123
 						}
 						position++
-						goto l115
-					l116:
-						position, tokenIndex, depth = position115, tokenIndex115, depth115
+						goto l122
+					l123:
+						position, tokenIndex, depth = position122, tokenIndex122, depth122
 						if buffer[position] != rune('E') {
-							goto l106
+							goto l113
 						}
 						position++
 					}
-				l115:
+				l122:
 					{
-						position117, tokenIndex117, depth117 := position, tokenIndex, depth
+						position124, tokenIndex124, depth124 := position, tokenIndex, depth
 						if buffer[position] != rune('c') {
-							goto l118
+							goto l125
 						}
 						position++
-						goto l117
-					l118:
-						position, tokenIndex, depth = position117, tokenIndex117, depth117
+						goto l124
+					l125:
+						position, tokenIndex, depth = position124, tokenIndex124, depth124
 						if buffer[position] != rune('C') {
-							goto l106
+							goto l113
 						}
 						position++
 					}
-				l117:
+				l124:
 					{
-						position119, tokenIndex119, depth119 := position, tokenIndex, depth
+						position126, tokenIndex126, depth126 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l120
+							goto l127
 						}
 						position++
-						goto l119
-					l120:
-						position, tokenIndex, depth = position119, tokenIndex119, depth119
+						goto l126
+					l127:
+						position, tokenIndex, depth = position126, tokenIndex126, depth126
 						if buffer[position] != rune('T') {
-							goto l106
+							goto l113
 						}
 						position++
 					}
-				l119:
-					if !rules[ruleskip]() {
-						goto l106
+				l126:
+					if !_rules[ruleskip]() {
+						goto l113
 					}
 					depth--
-					add(ruleSELECT, position108)
+					add(ruleSELECT, position115)
 				}
 				{
-					position121, tokenIndex121, depth121 := position, tokenIndex, depth
+					position128, tokenIndex128, depth128 := position, tokenIndex, depth
 					{
-						position123, tokenIndex123, depth123 := position, tokenIndex, depth
-						if !rules[ruleDISTINCT]() {
-							goto l124
+						position130, tokenIndex130, depth130 := position, tokenIndex, depth
+						if !_rules[ruleDISTINCT]() {
+							goto l131
 						}
-						goto l123
-					l124:
-						position, tokenIndex, depth = position123, tokenIndex123, depth123
+						goto l130
+					l131:
+						position, tokenIndex, depth = position130, tokenIndex130, depth130
 						{
-							position125 := position
+							position132 := position
 							depth++
 							{
-								position126, tokenIndex126, depth126 := position, tokenIndex, depth
+								position133, tokenIndex133, depth133 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l127
+									goto l134
 								}
 								position++
-								goto l126
-							l127:
-								position, tokenIndex, depth = position126, tokenIndex126, depth126
+								goto l133
+							l134:
+								position, tokenIndex, depth = position133, tokenIndex133, depth133
 								if buffer[position] != rune('R') {
-									goto l121
+									goto l128
 								}
 								position++
 							}
-						l126:
+						l133:
 							{
-								position128, tokenIndex128, depth128 := position, tokenIndex, depth
+								position135, tokenIndex135, depth135 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l129
+									goto l136
 								}
 								position++
-								goto l128
-							l129:
-								position, tokenIndex, depth = position128, tokenIndex128, depth128
+								goto l135
+							l136:
+								position, tokenIndex, depth = position135, tokenIndex135, depth135
 								if buffer[position] != rune('E') {
-									goto l121
+									goto l128
 								}
 								position++
 							}
-						l128:
+						l135:
 							{
-								position130, tokenIndex130, depth130 := position, tokenIndex, depth
+								position137, tokenIndex137, depth137 := position, tokenIndex, depth
 								if buffer[position] != rune('d') {
-									goto l131
+									goto l138
 								}
 								position++
-								goto l130
-							l131:
-								position, tokenIndex, depth = position130, tokenIndex130, depth130
+								goto l137
+							l138:
+								position, tokenIndex, depth = position137, tokenIndex137, depth137
 								if buffer[position] != rune('D') {
-									goto l121
+									goto l128
 								}
 								position++
 							}
-						l130:
+						l137:
 							{
-								position132, tokenIndex132, depth132 := position, tokenIndex, depth
+								position139, tokenIndex139, depth139 := position, tokenIndex, depth
 								if buffer[position] != rune('u') {
-									goto l133
+									goto l140
 								}
 								position++
-								goto l132
-							l133:
-								position, tokenIndex, depth = position132, tokenIndex132, depth132
+								goto l139
+							l140:
+								position, tokenIndex, depth = position139, tokenIndex139, depth139
 								if buffer[position] != rune('U') {
-									goto l121
+									goto l128
 								}
 								position++
 							}
-						l132:
+						l139:
 							{
-								position134, tokenIndex134, depth134 := position, tokenIndex, depth
+								position141, tokenIndex141, depth141 := position, tokenIndex, depth
 								if buffer[position] != rune('c') {
-									goto l135
+									goto l142
 								}
 								position++
-								goto l134
-							l135:
-								position, tokenIndex, depth = position134, tokenIndex134, depth134
+								goto l141
+							l142:
+								position, tokenIndex, depth = position141, tokenIndex141, depth141
 								if buffer[position] != rune('C') {
-									goto l121
+									goto l128
 								}
 								position++
 							}
-						l134:
+						l141:
 							{
-								position136, tokenIndex136, depth136 := position, tokenIndex, depth
+								position143, tokenIndex143, depth143 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l137
+									goto l144
 								}
 								position++
-								goto l136
-							l137:
-								position, tokenIndex, depth = position136, tokenIndex136, depth136
+								goto l143
+							l144:
+								position, tokenIndex, depth = position143, tokenIndex143, depth143
 								if buffer[position] != rune('E') {
-									goto l121
+									goto l128
 								}
 								position++
 							}
-						l136:
+						l143:
 							{
-								position138, tokenIndex138, depth138 := position, tokenIndex, depth
+								position145, tokenIndex145, depth145 := position, tokenIndex, depth
 								if buffer[position] != rune('d') {
-									goto l139
+									goto l146
 								}
 								position++
-								goto l138
-							l139:
-								position, tokenIndex, depth = position138, tokenIndex138, depth138
+								goto l145
+							l146:
+								position, tokenIndex, depth = position145, tokenIndex145, depth145
 								if buffer[position] != rune('D') {
-									goto l121
+									goto l128
 								}
 								position++
 							}
-						l138:
-							if !rules[ruleskip]() {
-								goto l121
+						l145:
+							if !_rules[ruleskip]() {
+								goto l128
 							}
 							depth--
-							add(ruleREDUCED, position125)
+							add(ruleREDUCED, position132)
 						}
 					}
-				l123:
-					goto l122
-				l121:
-					position, tokenIndex, depth = position121, tokenIndex121, depth121
+				l130:
+					goto l129
+				l128:
+					position, tokenIndex, depth = position128, tokenIndex128, depth128
 				}
-			l122:
+			l129:
 				{
-					position140, tokenIndex140, depth140 := position, tokenIndex, depth
-					if !rules[ruleSTAR]() {
-						goto l141
+					position147, tokenIndex147, depth147 := position, tokenIndex, depth
+					if !_rules[ruleSTAR]() {
+						goto l148
 					}
-					goto l140
-				l141:
-					position, tokenIndex, depth = position140, tokenIndex140, depth140
+					goto l147
+				l148:
+					position, tokenIndex, depth = position147, tokenIndex147, depth147
 					{
-						position144 := position
+						position151 := position
 						depth++
 						{
-							position145, tokenIndex145, depth145 := position, tokenIndex, depth
-							if !rules[rulevar]() {
-								goto l146
+							position152, tokenIndex152, depth152 := position, tokenIndex, depth
+							if !_rules[rulevar]() {
+								goto l153
 							}
-							goto l145
-						l146:
-							position, tokenIndex, depth = position145, tokenIndex145, depth145
-							if !rules[ruleLPAREN]() {
-								goto l106
+							goto l152
+						l153:
+							position, tokenIndex, depth = position152, tokenIndex152, depth152
+							if !_rules[ruleLPAREN]() {
+								goto l113
 							}
-							if !rules[ruleexpression]() {
-								goto l106
+							if !_rules[ruleexpression]() {
+								goto l113
 							}
-							if !rules[ruleAS]() {
-								goto l106
+							if !_rules[ruleAS]() {
+								goto l113
 							}
-							if !rules[rulevar]() {
-								goto l106
+							if !_rules[rulevar]() {
+								goto l113
 							}
-							if !rules[ruleRPAREN]() {
-								goto l106
+							if !_rules[ruleRPAREN]() {
+								goto l113
 							}
 						}
-					l145:
+					l152:
 						depth--
-						add(ruleprojectionElem, position144)
+						add(ruleprojectionElem, position151)
 					}
-				l142:
+				l149:
 					{
-						position143, tokenIndex143, depth143 := position, tokenIndex, depth
+						position150, tokenIndex150, depth150 := position, tokenIndex, depth
 						{
-							position147 := position
+							position154 := position
 							depth++
 							{
-								position148, tokenIndex148, depth148 := position, tokenIndex, depth
-								if !rules[rulevar]() {
-									goto l149
+								position155, tokenIndex155, depth155 := position, tokenIndex, depth
+								if !_rules[rulevar]() {
+									goto l156
 								}
-								goto l148
-							l149:
-								position, tokenIndex, depth = position148, tokenIndex148, depth148
-								if !rules[ruleLPAREN]() {
-									goto l143
+								goto l155
+							l156:
+								position, tokenIndex, depth = position155, tokenIndex155, depth155
+								if !_rules[ruleLPAREN]() {
+									goto l150
 								}
-								if !rules[ruleexpression]() {
-									goto l143
+								if !_rules[ruleexpression]() {
+									goto l150
 								}
-								if !rules[ruleAS]() {
-									goto l143
+								if !_rules[ruleAS]() {
+									goto l150
 								}
-								if !rules[rulevar]() {
-									goto l143
+								if !_rules[rulevar]() {
+									goto l150
 								}
-								if !rules[ruleRPAREN]() {
-									goto l143
+								if !_rules[ruleRPAREN]() {
+									goto l150
 								}
 							}
-						l148:
+						l155:
 							depth--
-							add(ruleprojectionElem, position147)
+							add(ruleprojectionElem, position154)
 						}
-						goto l142
-					l143:
-						position, tokenIndex, depth = position143, tokenIndex143, depth143
+						goto l149
+					l150:
+						position, tokenIndex, depth = position150, tokenIndex150, depth150
 					}
 				}
-			l140:
+			l147:
 				depth--
-				add(ruleselect, position107)
+				add(ruleselect, position114)
 			}
 			return true
-		l106:
-			position, tokenIndex, depth = position106, tokenIndex106, depth106
+		l113:
+			position, tokenIndex, depth = position113, tokenIndex113, depth113
 			return false
 		},
-		/* 7 subSelect <- <(select whereClause solutionModifier)> */
+		/* 7 subSelect <- <(select whereClause solutionModifier valuesClause?)> */
 		func() bool {
-			position150, tokenIndex150, depth150 := position, tokenIndex, depth
+			position157, tokenIndex157, depth157 := position, tokenIndex, depth
 			{
-				position151 := position
+				position158 := position
 				depth++
-				if !rules[ruleselect]() {
-					goto l150
+				if !_rules[ruleselect]() {
+					goto l157
+				}
+				if !_rules[rulewhereClause]() {
+					goto l157
 				}
-				if !rules[rulewhereClause]() {
-					goto l150
+				if !_rules[rulesolutionModifier]() {
+					goto l157
 				}
-				if !rules[rulesolutionModifier]() {
-					goto l150
+				{
+					position159, tokenIndex159, depth159 := position, tokenIndex, depth
+					if !_rules[rulevaluesClause]() {
+						goto l159
+					}
+					goto l160
+				l159:
+					position, tokenIndex, depth = position159, tokenIndex159, depth159
 				}
+			l160:
 				depth--
-				add(rulesubSelect, position151)
+				add(rulesubSelect, position158)
 			}
 			return true
-		l150:
-			position, tokenIndex, depth = position150, tokenIndex150, depth150
+		l157:
+			position, tokenIndex, depth = position157, tokenIndex157, depth157
 			return false
 		},
 		/* 8 constructQuery <- <(construct datasetClause* whereClause solutionModifier)> */
@@ -2372,7 +2174,7 @@ func (p *Sparql) Init() {
 		nil,
 		/* 10 describeQuery <- <(describe datasetClause* whereClause? solutionModifier)> */
 		nil,
-		/* 11 describe <- <(DESCRIBE ((&('*') STAR) | (&('$' | '?') var) | (&(':' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | 'Ã' | 'Ä' | 'Å' | 'Æ' | 'Ç' | 'È' | 'É' | 'Ê' | 'Ë' | 'Í' | 'Î' | 'Ï' | 'Ð' | 'Ñ' | 'Ò' | 'Ó' | 'Ô' | 'Õ' | 'Ö' | '×' | 'Ø' | 'Ù' | 'Ú' | 'Û' | 'Ü' | 'Ý' | 'Þ' | 'ß' | 'à' | 'á' | 'â' | 'ã' | 'ä' | 'å' | 'æ' | 'ç' | 'è' | 'é' | 'ê' | 'ë' | 'ì' | 'í' | 'ï' | 'ð' | 'ñ' | 'ò' | 'ó') iriref)))> */
+		/* 11 describe <- <(DESCRIBE (STAR / var / iriref))> */
 		nil,
 		/* 12 askQuery <- <(ASK datasetClause* whereClause)> */
 		nil,
@@ -2380,992 +2182,1199 @@ func (p *Sparql) Init() {
 		nil,
 		/* 14 datasetClause <- <(FROM NAMED? iriref)> */
 		func() bool {
-			position158, tokenIndex158, depth158 := position, tokenIndex, depth
+			position167, tokenIndex167, depth167 := position, tokenIndex, depth
 			{
-				position159 := position
+				position168 := position
 				depth++
 				{
-					position160 := position
+					position169 := position
 					depth++
 					{
-						position161, tokenIndex161, depth161 := position, tokenIndex, depth
+						position170, tokenIndex170, depth170 := position, tokenIndex, depth
 						if buffer[position] != rune('f') {
-							goto l162
+							goto l171
 						}
 						position++
-						goto l161
-					l162:
-						position, tokenIndex, depth = position161, tokenIndex161, depth161
+						goto l170
+					l171:
+						position, tokenIndex, depth = position170, tokenIndex170, depth170
 						if buffer[position] != rune('F') {
-							goto l158
+							goto l167
 						}
 						position++
 					}
-				l161:
+				l170:
 					{
-						position163, tokenIndex163, depth163 := position, tokenIndex, depth
+						position172, tokenIndex172, depth172 := position, tokenIndex, depth
 						if buffer[position] != rune('r') {
-							goto l164
+							goto l173
 						}
 						position++
-						goto l163
-					l164:
-						position, tokenIndex, depth = position163, tokenIndex163, depth163
+						goto l172
+					l173:
+						position, tokenIndex, depth = position172, tokenIndex172, depth172
 						if buffer[position] != rune('R') {
-							goto l158
+							goto l167
 						}
 						position++
 					}
-				l163:
+				l172:
 					{
-						position165, tokenIndex165, depth165 := position, tokenIndex, depth
+						position174, tokenIndex174, depth174 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l166
+							goto l175
 						}
 						position++
-						goto l165
-					l166:
-						position, tokenIndex, depth = position165, tokenIndex165, depth165
+						goto l174
+					l175:
+						position, tokenIndex, depth = position174, tokenIndex174, depth174
 						if buffer[position] != rune('O') {
-							goto l158
+							goto l167
 						}
 						position++
 					}
-				l165:
+				l174:
 					{
-						position167, tokenIndex167, depth167 := position, tokenIndex, depth
+						position176, tokenIndex176, depth176 := position, tokenIndex, depth
 						if buffer[position] != rune('m') {
-							goto l168
+							goto l177
 						}
 						position++
-						goto l167
-					l168:
-						position, tokenIndex, depth = position167, tokenIndex167, depth167
+						goto l176
+					l177:
+						position, tokenIndex, depth = position176, tokenIndex176, depth176
 						if buffer[position] != rune('M') {
-							goto l158
+							goto l167
 						}
 						position++
 					}
-				l167:
-					if !rules[ruleskip]() {
-						goto l158
+				l176:
+					if !_rules[ruleskip]() {
+						goto l167
 					}
 					depth--
-					add(ruleFROM, position160)
+					add(ruleFROM, position169)
 				}
 				{
-					position169, tokenIndex169, depth169 := position, tokenIndex, depth
+					position178, tokenIndex178, depth178 := position, tokenIndex, depth
 					{
-						position171 := position
+						position180 := position
 						depth++
 						{
-							position172, tokenIndex172, depth172 := position, tokenIndex, depth
+							position181, tokenIndex181, depth181 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l173
+								goto l182
 							}
 							position++
-							goto l172
-						l173:
-							position, tokenIndex, depth = position172, tokenIndex172, depth172
+							goto l181
+						l182:
+							position, tokenIndex, depth = position181, tokenIndex181, depth181
 							if buffer[position] != rune('N') {
-								goto l169
+								goto l178
 							}
 							position++
 						}
-					l172:
+					l181:
 						{
-							position174, tokenIndex174, depth174 := position, tokenIndex, depth
+							position183, tokenIndex183, depth183 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l175
+								goto l184
 							}
 							position++
-							goto l174
-						l175:
-							position, tokenIndex, depth = position174, tokenIndex174, depth174
+							goto l183
+						l184:
+							position, tokenIndex, depth = position183, tokenIndex183, depth183
 							if buffer[position] != rune('A') {
-								goto l169
+								goto l178
 							}
 							position++
 						}
-					l174:
+					l183:
 						{
-							position176, tokenIndex176, depth176 := position, tokenIndex, depth
+							position185, tokenIndex185, depth185 := position, tokenIndex, depth
 							if buffer[position] != rune('m') {
-								goto l177
+								goto l186
 							}
 							position++
-							goto l176
-						l177:
-							position, tokenIndex, depth = position176, tokenIndex176, depth176
+							goto l185
+						l186:
+							position, tokenIndex, depth = position185, tokenIndex185, depth185
 							if buffer[position] != rune('M') {
-								goto l169
+								goto l178
 							}
 							position++
 						}
-					l176:
+					l185:
 						{
-							position178, tokenIndex178, depth178 := position, tokenIndex, depth
+							position187, tokenIndex187, depth187 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l179
+								goto l188
 							}
 							position++
-							goto l178
-						l179:
-							position, tokenIndex, depth = position178, tokenIndex178, depth178
+							goto l187
+						l188:
+							position, tokenIndex, depth = position187, tokenIndex187, depth187
 							if buffer[position] != rune('E') {
-								goto l169
+								goto l178
 							}
 							position++
 						}
-					l178:
+					l187:
 						{
-							position180, tokenIndex180, depth180 := position, tokenIndex, depth
+							position189, tokenIndex189, depth189 := position, tokenIndex, depth
 							if buffer[position] != rune('d') {
-								goto l181
+								goto l190
 							}
 							position++
-							goto l180
-						l181:
-							position, tokenIndex, depth = position180, tokenIndex180, depth180
+							goto l189
+						l190:
+							position, tokenIndex, depth = position189, tokenIndex189, depth189
 							if buffer[position] != rune('D') {
-								goto l169
+								goto l178
 							}
 							position++
 						}
-					l180:
-						if !rules[ruleskip]() {
-							goto l169
+					l189:
+						if !_rules[ruleskip]() {
+							goto l178
 						}
 						depth--
-						add(ruleNAMED, position171)
+						add(ruleNAMED, position180)
 					}
-					goto l170
-				l169:
-					position, tokenIndex, depth = position169, tokenIndex169, depth169
+					goto l179
+				l178:
+					position, tokenIndex, depth = position178, tokenIndex178, depth178
 				}
-			l170:
-				if !rules[ruleiriref]() {
-					goto l158
+			l179:
+				if !_rules[ruleiriref]() {
+					goto l167
 				}
 				depth--
-				add(ruledatasetClause, position159)
+				add(ruledatasetClause, position168)
 			}
 			return true
-		l158:
-			position, tokenIndex, depth = position158, tokenIndex158, depth158
+		l167:
+			position, tokenIndex, depth = position167, tokenIndex167, depth167
 			return false
 		},
 		/* 15 whereClause <- <(WHERE? groupGraphPattern)> */
 		func() bool {
-			position182, tokenIndex182, depth182 := position, tokenIndex, depth
+			position191, tokenIndex191, depth191 := position, tokenIndex, depth
 			{
-				position183 := position
+				position192 := position
 				depth++
 				{
-					position184, tokenIndex184, depth184 := position, tokenIndex, depth
+					position193, tokenIndex193, depth193 := position, tokenIndex, depth
 					{
-						position186 := position
+						position195 := position
 						depth++
 						{
-							position187, tokenIndex187, depth187 := position, tokenIndex, depth
+							position196, tokenIndex196, depth196 := position, tokenIndex, depth
 							if buffer[position] != rune('w') {
-								goto l188
+								goto l197
 							}
 							position++
-							goto l187
-						l188:
-							position, tokenIndex, depth = position187, tokenIndex187, depth187
+							goto l196
+						l197:
+							position, tokenIndex, depth = position196, tokenIndex196, depth196
 							if buffer[position] != rune('W') {
-								goto l184
+								goto l193
 							}
 							position++
 						}
-					l187:
+					l196:
 						{
-							position189, tokenIndex189, depth189 := position, tokenIndex, depth
+							position198, tokenIndex198, depth198 := position, tokenIndex, depth
 							if buffer[position] != rune('h') {
-								goto l190
+								goto l199
 							}
 							position++
-							goto l189
-						l190:
-							position, tokenIndex, depth = position189, tokenIndex189, depth189
+							goto l198
+						l199:
+							position, tokenIndex, depth = position198, tokenIndex198, depth198
 							if buffer[position] != rune('H') {
-								goto l184
+								goto l193
 							}
 							position++
 						}
-					l189:
+					l198:
 						{
-							position191, tokenIndex191, depth191 := position, tokenIndex, depth
+							position200, tokenIndex200, depth200 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l192
+								goto l201
 							}
 							position++
-							goto l191
-						l192:
-							position, tokenIndex, depth = position191, tokenIndex191, depth191
+							goto l200
+						l201:
+							position, tokenIndex, depth = position200, tokenIndex200, depth200
 							if buffer[position] != rune('E') {
-								goto l184
+								goto l193
 							}
 							position++
 						}
-					l191:
+					l200:
 						{
-							position193, tokenIndex193, depth193 := position, tokenIndex, depth
+							position202, tokenIndex202, depth202 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l194
+								goto l203
 							}
 							position++
-							goto l193
-						l194:
-							position, tokenIndex, depth = position193, tokenIndex193, depth193
+							goto l202
+						l203:
+							position, tokenIndex, depth = position202, tokenIndex202, depth202
 							if buffer[position] != rune('R') {
-								goto l184
+								goto l193
 							}
 							position++
 						}
-					l193:
+					l202:
 						{
-							position195, tokenIndex195, depth195 := position, tokenIndex, depth
+							position204, tokenIndex204, depth204 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l196
+								goto l205
 							}
 							position++
-							goto l195
-						l196:
-							position, tokenIndex, depth = position195, tokenIndex195, depth195
+							goto l204
+						l205:
+							position, tokenIndex, depth = position204, tokenIndex204, depth204
 							if buffer[position] != rune('E') {
-								goto l184
+								goto l193
 							}
 							position++
 						}
-					l195:
-						if !rules[ruleskip]() {
-							goto l184
+					l204:
+						if !_rules[ruleskip]() {
+							goto l193
 						}
 						depth--
-						add(ruleWHERE, position186)
+						add(ruleWHERE, position195)
 					}
-					goto l185
-				l184:
-					position, tokenIndex, depth = position184, tokenIndex184, depth184
+					goto l194
+				l193:
+					position, tokenIndex, depth = position193, tokenIndex193, depth193
 				}
-			l185:
-				if !rules[rulegroupGraphPattern]() {
-					goto l182
+			l194:
+				if !_rules[rulegroupGraphPattern]() {
+					goto l191
 				}
 				depth--
-				add(rulewhereClause, position183)
+				add(rulewhereClause, position192)
 			}
 			return true
-		l182:
-			position, tokenIndex, depth = position182, tokenIndex182, depth182
+		l191:
+			position, tokenIndex, depth = position191, tokenIndex191, depth191
 			return false
 		},
 		/* 16 groupGraphPattern <- <(LBRACE (subSelect / graphPattern) RBRACE)> */
 		func() bool {
-			position197, tokenIndex197, depth197 := position, tokenIndex, depth
+			position206, tokenIndex206, depth206 := position, tokenIndex, depth
 			{
-				position198 := position
+				position207 := position
 				depth++
-				if !rules[ruleLBRACE]() {
-					goto l197
+				if !_rules[ruleLBRACE]() {
+					goto l206
 				}
 				{
-					position199, tokenIndex199, depth199 := position, tokenIndex, depth
-					if !rules[rulesubSelect]() {
-						goto l200
+					position208, tokenIndex208, depth208 := position, tokenIndex, depth
+					if !_rules[rulesubSelect]() {
+						goto l209
 					}
-					goto l199
-				l200:
-					position, tokenIndex, depth = position199, tokenIndex199, depth199
-					if !rules[rulegraphPattern]() {
-						goto l197
+					goto l208
+				l209:
+					position, tokenIndex, depth = position208, tokenIndex208, depth208
+					if !_rules[rulegraphPattern]() {
+						goto l206
 					}
 				}
-			l199:
-				if !rules[ruleRBRACE]() {
-					goto l197
+			l208:
+				if !_rules[ruleRBRACE]() {
+					goto l206
 				}
 				depth--
-				add(rulegroupGraphPattern, position198)
+				add(rulegroupGraphPattern, position207)
 			}
 			return true
-		l197:
-			position, tokenIndex, depth = position197, tokenIndex197, depth197
+		l206:
+			position, tokenIndex, depth = position206, tokenIndex206, depth206
 			return false
 		},
 		/* 17 graphPattern <- <(basicGraphPattern? (graphPatternNotTriples DOT? graphPattern)?)> */
 		func() bool {
 			{
-				position202 := position
+				position211 := position
 				depth++
 				{
-					position203, tokenIndex203, depth203 := position, tokenIndex, depth
+					position212, tokenIndex212, depth212 := position, tokenIndex, depth
 					{
-						position205 := position
+						position214 := position
 						depth++
 						{
-							position206, tokenIndex206, depth206 := position, tokenIndex, depth
-							if !rules[ruletriplesBlock]() {
-								goto l207
+							position215, tokenIndex215, depth215 := position, tokenIndex, depth
+							if !_rules[ruletriplesBlock]() {
+								goto l216
 							}
-						l208:
+						l217:
 							{
-								position209, tokenIndex209, depth209 := position, tokenIndex, depth
-								if !rules[rulefilterOrBind]() {
-									goto l209
+								position218, tokenIndex218, depth218 := position, tokenIndex, depth
+								if !_rules[rulefilterOrBind]() {
+									goto l218
 								}
 								{
-									position210, tokenIndex210, depth210 := position, tokenIndex, depth
-									if !rules[ruleDOT]() {
-										goto l210
+									position219, tokenIndex219, depth219 := position, tokenIndex, depth
+									if !_rules[ruleDOT]() {
+										goto l219
 									}
-									goto l211
-								l210:
-									position, tokenIndex, depth = position210, tokenIndex210, depth210
+									goto l220
+								l219:
+									position, tokenIndex, depth = position219, tokenIndex219, depth219
 								}
-							l211:
+							l220:
 								{
-									position212, tokenIndex212, depth212 := position, tokenIndex, depth
-									if !rules[ruletriplesBlock]() {
-										goto l212
-									}
-									goto l213
-								l212:
-									position, tokenIndex, depth = position212, tokenIndex212, depth212
-								}
-							l213:
-								goto l208
-							l209:
-								position, tokenIndex, depth = position209, tokenIndex209, depth209
-							}
-							goto l206
-						l207:
-							position, tokenIndex, depth = position206, tokenIndex206, depth206
-							if !rules[rulefilterOrBind]() {
-								goto l203
+									position221, tokenIndex221, depth221 := position, tokenIndex, depth
+									if !_rules[ruletriplesBlock]() {
+										goto l221
+									}
+									goto l222
+								l221:
+									position, tokenIndex, depth = position221, tokenIndex221, depth221
+								}
+							l222:
+								goto l217
+							l218:
+								position, tokenIndex, depth = position218, tokenIndex218, depth218
+							}
+							goto l215
+						l216:
+							position, tokenIndex, depth = position215, tokenIndex215, depth215
+							if !_rules[rulefilterOrBind]() {
+								goto l212
 							}
 							{
-								position216, tokenIndex216, depth216 := position, tokenIndex, depth
-								if !rules[ruleDOT]() {
-									goto l216
+								position225, tokenIndex225, depth225 := position, tokenIndex, depth
+								if !_rules[ruleDOT]() {
+									goto l225
 								}
-								goto l217
-							l216:
-								position, tokenIndex, depth = position216, tokenIndex216, depth216
+								goto l226
+							l225:
+								position, tokenIndex, depth = position225, tokenIndex225, depth225
 							}
-						l217:
+						l226:
 							{
-								position218, tokenIndex218, depth218 := position, tokenIndex, depth
-								if !rules[ruletriplesBlock]() {
-									goto l218
+								position227, tokenIndex227, depth227 := position, tokenIndex, depth
+								if !_rules[ruletriplesBlock]() {
+									goto l227
 								}
-								goto l219
-							l218:
-								position, tokenIndex, depth = position218, tokenIndex218, depth218
+								goto l228
+							l227:
+								position, tokenIndex, depth = position227, tokenIndex227, depth227
 							}
-						l219:
-						l214:
+						l228:
+						l223:
 							{
-								position215, tokenIndex215, depth215 := position, tokenIndex, depth
-								if !rules[rulefilterOrBind]() {
-									goto l215
+								position224, tokenIndex224, depth224 := position, tokenIndex, depth
+								if !_rules[rulefilterOrBind]() {
+									goto l224
 								}
 								{
-									position220, tokenIndex220, depth220 := position, tokenIndex, depth
-									if !rules[ruleDOT]() {
-										goto l220
+									position229, tokenIndex229, depth229 := position, tokenIndex, depth
+									if !_rules[ruleDOT]() {
+										goto l229
 									}
-									goto l221
-								l220:
-									position, tokenIndex, depth = position220, tokenIndex220, depth220
+									goto l230
+								l229:
+									position, tokenIndex, depth = position229, tokenIndex229, depth229
 								}
-							l221:
+							l230:
 								{
-									position222, tokenIndex222, depth222 := position, tokenIndex, depth
-									if !rules[ruletriplesBlock]() {
-										goto l222
+									position231, tokenIndex231, depth231 := position, tokenIndex, depth
+									if !_rules[ruletriplesBlock]() {
+										goto l231
 									}
-									goto l223
-								l222:
-									position, tokenIndex, depth = position222, tokenIndex222, depth222
+									goto l232
+								l231:
+									position, tokenIndex, depth = position231, tokenIndex231, depth231
 								}
-							l223:
-								goto l214
-							l215:
-								position, tokenIndex, depth = position215, tokenIndex215, depth215
+							l232:
+								goto l223
+							l224:
+								position, tokenIndex, depth = position224, tokenIndex224, depth224
 							}
 						}
-					l206:
+					l215:
 						depth--
-						add(rulebasicGraphPattern, position205)
+						add(rulebasicGraphPattern, position214)
 					}
-					goto l204
-				l203:
-					position, tokenIndex, depth = position203, tokenIndex203, depth203
+					goto l213
+				l212:
+					position, tokenIndex, depth = position212, tokenIndex212, depth212
 				}
-			l204:
+			l213:
 				{
-					position224, tokenIndex224, depth224 := position, tokenIndex, depth
+					position233, tokenIndex233, depth233 := position, tokenIndex, depth
 					{
-						position226 := position
+						position235 := position
 						depth++
 						{
-							position227, tokenIndex227, depth227 := position, tokenIndex, depth
+							position236, tokenIndex236, depth236 := position, tokenIndex, depth
 							{
-								position229 := position
+								position238 := position
 								depth++
 								{
-									position230 := position
+									position239 := position
 									depth++
 									{
-										position231, tokenIndex231, depth231 := position, tokenIndex, depth
+										position240, tokenIndex240, depth240 := position, tokenIndex, depth
 										if buffer[position] != rune('o') {
-											goto l232
+											goto l241
 										}
 										position++
-										goto l231
-									l232:
-										position, tokenIndex, depth = position231, tokenIndex231, depth231
+										goto l240
+									l241:
+										position, tokenIndex, depth = position240, tokenIndex240, depth240
 										if buffer[position] != rune('O') {
-											goto l228
+											goto l237
 										}
 										position++
 									}
-								l231:
+								l240:
 									{
-										position233, tokenIndex233, depth233 := position, tokenIndex, depth
+										position242, tokenIndex242, depth242 := position, tokenIndex, depth
 										if buffer[position] != rune('p') {
-											goto l234
+											goto l243
 										}
 										position++
-										goto l233
-									l234:
-										position, tokenIndex, depth = position233, tokenIndex233, depth233
+										goto l242
+									l243:
+										position, tokenIndex, depth = position242, tokenIndex242, depth242
 										if buffer[position] != rune('P') {
-											goto l228
+											goto l237
 										}
 										position++
 									}
-								l233:
+								l242:
 									{
-										position235, tokenIndex235, depth235 := position, tokenIndex, depth
+										position244, tokenIndex244, depth244 := position, tokenIndex, depth
 										if buffer[position] != rune('t') {
-											goto l236
+											goto l245
 										}
 										position++
-										goto l235
-									l236:
-										position, tokenIndex, depth = position235, tokenIndex235, depth235
+										goto l244
+									l245:
+										position, tokenIndex, depth = position244, tokenIndex244, depth244
 										if buffer[position] != rune('T') {
-											goto l228
+											goto l237
 										}
 										position++
 									}
-								l235:
+								l244:
 									{
-										position237, tokenIndex237, depth237 := position, tokenIndex, depth
+										position246, tokenIndex246, depth246 := position, tokenIndex, depth
 										if buffer[position] != rune('i') {
-											goto l238
+											goto l247
 										}
 										position++
-										goto l237
-									l238:
-										position, tokenIndex, depth = position237, tokenIndex237, depth237
+										goto l246
+									l247:
+										position, tokenIndex, depth = position246, tokenIndex246, depth246
 										if buffer[position] != rune('I') {
-											goto l228
+											goto l237
 										}
 										position++
 									}
-								l237:
+								l246:
 									{
-										position239, tokenIndex239, depth239 := position, tokenIndex, depth
+										position248, tokenIndex248, depth248 := position, tokenIndex, depth
 										if buffer[position] != rune('o') {
-											goto l240
+											goto l249
 										}
 										position++
-										goto l239
-									l240:
-										position, tokenIndex, depth = position239, tokenIndex239, depth239
+										goto l248
+									l249:
+										position, tokenIndex, depth = position248, tokenIndex248, depth248
 										if buffer[position] != rune('O') {
-											goto l228
+											goto l237
 										}
 										position++
 									}
-								l239:
+								l248:
 									{
-										position241, tokenIndex241, depth241 := position, tokenIndex, depth
+										position250, tokenIndex250, depth250 := position, tokenIndex, depth
 										if buffer[position] != rune('n') {
-											goto l242
+											goto l251
 										}
 										position++
-										goto l241
-									l242:
-										position, tokenIndex, depth = position241, tokenIndex241, depth241
+										goto l250
+									l251:
+										position, tokenIndex, depth = position250, tokenIndex250, depth250
 										if buffer[position] != rune('N') {
-											goto l228
+											goto l237
 										}
 										position++
 									}
-								l241:
+								l250:
 									{
-										position243, tokenIndex243, depth243 := position, tokenIndex, depth
+										position252, tokenIndex252, depth252 := position, tokenIndex, depth
 										if buffer[position] != rune('a') {
-											goto l244
+											goto l253
 										}
 										position++
-										goto l243
-									l244:
-										position, tokenIndex, depth = position243, tokenIndex243, depth243
+										goto l252
+									l253:
+										position, tokenIndex, depth = position252, tokenIndex252, depth252
 										if buffer[position] != rune('A') {
-											goto l228
+											goto l237
 										}
 										position++
 									}
-								l243:
+								l252:
 									{
-										position245, tokenIndex245, depth245 := position, tokenIndex, depth
+										position254, tokenIndex254, depth254 := position, tokenIndex, depth
 										if buffer[position] != rune('l') {
-											goto l246
+											goto l255
 										}
 										position++
-										goto l245
-									l246:
-										position, tokenIndex, depth = position245, tokenIndex245, depth245
+										goto l254
+									l255:
+										position, tokenIndex, depth = position254, tokenIndex254, depth254
 										if buffer[position] != rune('L') {
-											goto l228
+											goto l237
 										}
 										position++
 									}
-								l245:
-									if !rules[ruleskip]() {
-										goto l228
+								l254:
+									if !_rules[ruleskip]() {
+										goto l237
 									}
 									depth--
-									add(ruleOPTIONAL, position230)
+									add(ruleOPTIONAL, position239)
 								}
-								if !rules[ruleLBRACE]() {
-									goto l228
+								if !_rules[ruleLBRACE]() {
+									goto l237
 								}
 								{
-									position247, tokenIndex247, depth247 := position, tokenIndex, depth
-									if !rules[rulesubSelect]() {
-										goto l248
+									position256, tokenIndex256, depth256 := position, tokenIndex, depth
+									if !_rules[rulesubSelect]() {
+										goto l257
 									}
-									goto l247
-								l248:
-									position, tokenIndex, depth = position247, tokenIndex247, depth247
-									if !rules[rulegraphPattern]() {
-										goto l228
+									goto l256
+								l257:
+									position, tokenIndex, depth = position256, tokenIndex256, depth256
+									if !_rules[rulegraphPattern]() {
+										goto l237
 									}
 								}
-							l247:
-								if !rules[ruleRBRACE]() {
-									goto l228
+							l256:
+								if !_rules[ruleRBRACE]() {
+									goto l237
 								}
 								depth--
-								add(ruleoptionalGraphPattern, position229)
+								add(ruleoptionalGraphPattern, position238)
 							}
-							goto l227
-						l228:
-							position, tokenIndex, depth = position227, tokenIndex227, depth227
-							if !rules[rulegroupOrUnionGraphPattern]() {
-								goto l249
+							goto l236
+						l237:
+							position, tokenIndex, depth = position236, tokenIndex236, depth236
+							if !_rules[rulegroupOrUnionGraphPattern]() {
+								goto l258
 							}
-							goto l227
-						l249:
-							position, tokenIndex, depth = position227, tokenIndex227, depth227
+							goto l236
+						l258:
+							position, tokenIndex, depth = position236, tokenIndex236, depth236
 							{
-								position251 := position
+								position260 := position
 								depth++
 								{
-									position252 := position
+									position261 := position
 									depth++
 									{
-										position253, tokenIndex253, depth253 := position, tokenIndex, depth
+										position262, tokenIndex262, depth262 := position, tokenIndex, depth
 										if buffer[position] != rune('g') {
-											goto l254
+											goto l263
 										}
 										position++
-										goto l253
-									l254:
-										position, tokenIndex, depth = position253, tokenIndex253, depth253
+										goto l262
+									l263:
+										position, tokenIndex, depth = position262, tokenIndex262, depth262
 										if buffer[position] != rune('G') {
-											goto l250
+											goto l259
 										}
 										position++
 									}
-								l253:
+								l262:
 									{
-										position255, tokenIndex255, depth255 := position, tokenIndex, depth
+										position264, tokenIndex264, depth264 := position, tokenIndex, depth
 										if buffer[position] != rune('r') {
-											goto l256
+											goto l265
 										}
 										position++
-										goto l255
-									l256:
-										position, tokenIndex, depth = position255, tokenIndex255, depth255
+										goto l264
+									l265:
+										position, tokenIndex, depth = position264, tokenIndex264, depth264
 										if buffer[position] != rune('R') {
-											goto l250
+											goto l259
 										}
 										position++
 									}
-								l255:
+								l264:
 									{
-										position257, tokenIndex257, depth257 := position, tokenIndex, depth
+										position266, tokenIndex266, depth266 := position, tokenIndex, depth
 										if buffer[position] != rune('a') {
-											goto l258
+											goto l267
 										}
 										position++
-										goto l257
-									l258:
-										position, tokenIndex, depth = position257, tokenIndex257, depth257
+										goto l266
+									l267:
+										position, tokenIndex, depth = position266, tokenIndex266, depth266
 										if buffer[position] != rune('A') {
-											goto l250
+											goto l259
 										}
 										position++
 									}
-								l257:
+								l266:
 									{
-										position259, tokenIndex259, depth259 := position, tokenIndex, depth
+										position268, tokenIndex268, depth268 := position, tokenIndex, depth
 										if buffer[position] != rune('p') {
-											goto l260
+											goto l269
 										}
 										position++
-										goto l259
-									l260:
-										position, tokenIndex, depth = position259, tokenIndex259, depth259
+										goto l268
+									l269:
+										position, tokenIndex, depth = position268, tokenIndex268, depth268
 										if buffer[position] != rune('P') {
-											goto l250
+											goto l259
 										}
 										position++
 									}
-								l259:
+								l268:
 									{
-										position261, tokenIndex261, depth261 := position, tokenIndex, depth
+										position270, tokenIndex270, depth270 := position, tokenIndex, depth
 										if buffer[position] != rune('h') {
-											goto l262
+											goto l271
 										}
 										position++
-										goto l261
-									l262:
-										position, tokenIndex, depth = position261, tokenIndex261, depth261
+										goto l270
+									l271:
+										position, tokenIndex, depth = position270, tokenIndex270, depth270
 										if buffer[position] != rune('H') {
-											goto l250
+											goto l259
 										}
 										position++
 									}
-								l261:
-									if !rules[ruleskip]() {
-										goto l250
+								l270:
+									if !_rules[ruleskip]() {
+										goto l259
 									}
 									depth--
-									add(ruleGRAPH, position252)
+									add(ruleGRAPH, position261)
 								}
 								{
-									position263, tokenIndex263, depth263 := position, tokenIndex, depth
-									if !rules[rulevar]() {
-										goto l264
-									}
-									goto l263
-								l264:
-									position, tokenIndex, depth = position263, tokenIndex263, depth263
-									if !rules[ruleiriref]() {
-										goto l250
+									position272, tokenIndex272, depth272 := position, tokenIndex, depth
+									if !_rules[rulevar]() {
+										goto l273
+									}
+									goto l272
+								l273:
+									position, tokenIndex, depth = position272, tokenIndex272, depth272
+									if !_rules[ruleiriref]() {
+										goto l259
 									}
 								}
-							l263:
-								if !rules[rulegroupGraphPattern]() {
-									goto l250
+							l272:
+								if !_rules[rulegroupGraphPattern]() {
+									goto l259
 								}
 								depth--
-								add(rulegraphGraphPattern, position251)
+								add(rulegraphGraphPattern, position260)
 							}
-							goto l227
-						l250:
-							position, tokenIndex, depth = position227, tokenIndex227, depth227
+							goto l236
+						l259:
+							position, tokenIndex, depth = position236, tokenIndex236, depth236
 							{
-								position266 := position
+								position275 := position
 								depth++
 								{
-									position267 := position
+									position276 := position
 									depth++
 									{
-										position268, tokenIndex268, depth268 := position, tokenIndex, depth
+										position277, tokenIndex277, depth277 := position, tokenIndex, depth
 										if buffer[position] != rune('m') {
-											goto l269
+											goto l278
 										}
 										position++
-										goto l268
-									l269:
-										position, tokenIndex, depth = position268, tokenIndex268, depth268
+										goto l277
+									l278:
+										position, tokenIndex, depth = position277, tokenIndex277, depth277
 										if buffer[position] != rune('M') {
-											goto l265
+											goto l274
 										}
 										position++
 									}
-								l268:
+								l277:
 									{
-										position270, tokenIndex270, depth270 := position, tokenIndex, depth
+										position279, tokenIndex279, depth279 := position, tokenIndex, depth
 										if buffer[position] != rune('i') {
-											goto l271
+											goto l280
 										}
 										position++
-										goto l270
-									l271:
-										position, tokenIndex, depth = position270, tokenIndex270, depth270
+										goto l279
+									l280:
+										position, tokenIndex, depth = position279, tokenIndex279, depth279
 										if buffer[position] != rune('I') {
-											goto l265
+											goto l274
 										}
 										position++
 									}
-								l270:
+								l279:
 									{
-										position272, tokenIndex272, depth272 := position, tokenIndex, depth
+										position281, tokenIndex281, depth281 := position, tokenIndex, depth
 										if buffer[position] != rune('n') {
-											goto l273
+											goto l282
 										}
 										position++
-										goto l272
-									l273:
-										position, tokenIndex, depth = position272, tokenIndex272, depth272
+										goto l281
+									l282:
+										position, tokenIndex, depth = position281, tokenIndex281, depth281
 										if buffer[position] != rune('N') {
-											goto l265
+											goto l274
 										}
 										position++
 									}
-								l272:
+								l281:
 									{
-										position274, tokenIndex274, depth274 := position, tokenIndex, depth
+										position283, tokenIndex283, depth283 := position, tokenIndex, depth
 										if buffer[position] != rune('u') {
-											goto l275
+											goto l284
 										}
 										position++
-										goto l274
-									l275:
-										position, tokenIndex, depth = position274, tokenIndex274, depth274
+										goto l283
+									l284:
+										position, tokenIndex, depth = position283, tokenIndex283, depth283
 										if buffer[position] != rune('U') {
-											goto l265
+											goto l274
 										}
 										position++
 									}
-								l274:
+								l283:
 									{
-										position276, tokenIndex276, depth276 := position, tokenIndex, depth
+										position285, tokenIndex285, depth285 := position, tokenIndex, depth
 										if buffer[position] != rune('s') {
-											goto l277
+											goto l286
 										}
 										position++
-										goto l276
-									l277:
-										position, tokenIndex, depth = position276, tokenIndex276, depth276
+										goto l285
+									l286:
+										position, tokenIndex, depth = position285, tokenIndex285, depth285
 										if buffer[position] != rune('S') {
-											goto l265
+											goto l274
 										}
 										position++
 									}
-								l276:
-									if !rules[ruleskip]() {
-										goto l265
+								l285:
+									if !_rules[ruleskip]() {
+										goto l274
 									}
 									depth--
-									add(ruleMINUSSETOPER, position267)
+									add(ruleMINUSSETOPER, position276)
 								}
-								if !rules[rulegroupGraphPattern]() {
-									goto l265
+								if !_rules[rulegroupGraphPattern]() {
+									goto l274
 								}
 								depth--
-								add(ruleminusGraphPattern, position266)
+								add(ruleminusGraphPattern, position275)
 							}
-							goto l227
-						l265:
-							position, tokenIndex, depth = position227, tokenIndex227, depth227
+							goto l236
+						l274:
+							position, tokenIndex, depth = position236, tokenIndex236, depth236
 							{
-								position278 := position
+								position288 := position
 								depth++
 								{
-									position279 := position
+									position289 := position
 									depth++
-									depth--
-									add(ruleSERVICE, position279)
-								}
-								{
-									position280, tokenIndex280, depth280 := position, tokenIndex, depth
 									{
-										position282 := position
-										depth++
-										depth--
-										add(ruleSILENT, position282)
+										position290, tokenIndex290, depth290 := position, tokenIndex, depth
+										if buffer[position] != rune('s') {
+											goto l291
+										}
+										position++
+										goto l290
+									l291:
+										position, tokenIndex, depth = position290, tokenIndex290, depth290
+										if buffer[position] != rune('S') {
+											goto l287
+										}
+										position++
 									}
-									goto l281
-
-									position, tokenIndex, depth = position280, tokenIndex280, depth280
-								}
-							l281:
-								{
-									position283, tokenIndex283, depth283 := position, tokenIndex, depth
-									if !rules[rulevar]() {
-										goto l284
+								l290:
+									{
+										position292, tokenIndex292, depth292 := position, tokenIndex, depth
+										if buffer[position] != rune('e') {
+											goto l293
+										}
+										position++
+										goto l292
+									l293:
+										position, tokenIndex, depth = position292, tokenIndex292, depth292
+										if buffer[position] != rune('E') {
+											goto l287
+										}
+										position++
 									}
-									goto l283
-								l284:
-									position, tokenIndex, depth = position283, tokenIndex283, depth283
-									if !rules[ruleiriref]() {
-										goto l224
+								l292:
+									{
+										position294, tokenIndex294, depth294 := position, tokenIndex, depth
+										if buffer[position] != rune('r') {
+											goto l295
+										}
+										position++
+										goto l294
+									l295:
+										position, tokenIndex, depth = position294, tokenIndex294, depth294
+										if buffer[position] != rune('R') {
+											goto l287
+										}
+										position++
 									}
+								l294:
+									{
+										position296, tokenIndex296, depth296 := position, tokenIndex, depth
+										if buffer[position] != rune('v') {
+											goto l297
+										}
+										position++
+										goto l296
+									l297:
+										position, tokenIndex, depth = position296, tokenIndex296, depth296
+										if buffer[position] != rune('V') {
+											goto l287
+										}
+										position++
+									}
+								l296:
+									{
+										position298, tokenIndex298, depth298 := position, tokenIndex, depth
+										if buffer[position] != rune('i') {
+											goto l299
+										}
+										position++
+										goto l298
+									l299:
+										position, tokenIndex, depth = position298, tokenIndex298, depth298
+										if buffer[position] != rune('I') {
+											goto l287
+										}
+										position++
+									}
+								l298:
+									{
+										position300, tokenIndex300, depth300 := position, tokenIndex, depth
+										if buffer[position] != rune('c') {
+											goto l301
+										}
+										position++
+										goto l300
+									l301:
+										position, tokenIndex, depth = position300, tokenIndex300, depth300
+										if buffer[position] != rune('C') {
+											goto l287
+										}
+										position++
+									}
+								l300:
+									{
+										position302, tokenIndex302, depth302 := position, tokenIndex, depth
+										if buffer[position] != rune('e') {
+											goto l303
+										}
+										position++
+										goto l302
+									l303:
+										position, tokenIndex, depth = position302, tokenIndex302, depth302
+										if buffer[position] != rune('E') {
+											goto l287
+										}
+										position++
+									}
+								l302:
+									if !_rules[ruleskip]() {
+										goto l287
+									}
+									depth--
+									add(ruleSERVICE, position289)
 								}
-							l283:
-								if !rules[rulegroupGraphPattern]() {
-									goto l224
-								}
-								depth--
-								add(ruleserviceGraphPattern, position278)
-							}
-						}
-					l227:
-						depth--
-						add(rulegraphPatternNotTriples, position226)
-					}
-					{
-						position285, tokenIndex285, depth285 := position, tokenIndex, depth
-						if !rules[ruleDOT]() {
-							goto l285
-						}
-						goto l286
-					l285:
-						position, tokenIndex, depth = position285, tokenIndex285, depth285
-					}
-				l286:
-					if !rules[rulegraphPattern]() {
-						goto l224
-					}
-					goto l225
-				l224:
-					position, tokenIndex, depth = position224, tokenIndex224, depth224
-				}
-			l225:
-				depth--
-				add(rulegraphPattern, position202)
-			}
-			return true
-		},
-		/* 18 graphPatternNotTriples <- <(optionalGraphPattern / groupOrUnionGraphPattern / graphGraphPattern / minusGraphPattern / serviceGraphPattern)> */
-		nil,
-		/* 19 serviceGraphPattern <- <(SERVICE SILENT? (var / iriref) groupGraphPattern)> */
+								{
+									position304, tokenIndex304, depth304 := position, tokenIndex, depth
+									{
+										position306 := position
+										depth++
+										{
+											position307, tokenIndex307, depth307 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l308
+											}
+											position++
+											goto l307
+										l308:
+											position, tokenIndex, depth = position307, tokenIndex307, depth307
+											if buffer[position] != rune('S') {
+												goto l304
+											}
+											position++
+										}
+									l307:
+										{
+											position309, tokenIndex309, depth309 := position, tokenIndex, depth
+											if buffer[position] != rune('i') {
+												goto l310
+											}
+											position++
+											goto l309
+										l310:
+											position, tokenIndex, depth = position309, tokenIndex309, depth309
+											if buffer[position] != rune('I') {
+												goto l304
+											}
+											position++
+										}
+									l309:
+										{
+											position311, tokenIndex311, depth311 := position, tokenIndex, depth
+											if buffer[position] != rune('l') {
+												goto l312
+											}
+											position++
+											goto l311
+										l312:
+											position, tokenIndex, depth = position311, tokenIndex311, depth311
+											if buffer[position] != rune('L') {
+												goto l304
+											}
+											position++
+										}
+									l311:
+										{
+											position313, tokenIndex313, depth313 := position, tokenIndex, depth
+											if buffer[position] != rune('e') {
+												goto l314
+											}
+											position++
+											goto l313
+										l314:
+											position, tokenIndex, depth = position313, tokenIndex313, depth313
+											if buffer[position] != rune('E') {
+												goto l304
+											}
+											position++
+										}
+									l313:
+										{
+											position315, tokenIndex315, depth315 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l316
+											}
+											position++
+											goto l315
+										l316:
+											position, tokenIndex, depth = position315, tokenIndex315, depth315
+											if buffer[position] != rune('N') {
+												goto l304
+											}
+											position++
+										}
+									l315:
+										{
+											position317, tokenIndex317, depth317 := position, tokenIndex, depth
+											if buffer[position] != rune('t') {
+												goto l318
+											}
+											position++
+											goto l317
+										l318:
+											position, tokenIndex, depth = position317, tokenIndex317, depth317
+											if buffer[position] != rune('T') {
+												goto l304
+											}
+											position++
+										}
+									l317:
+										if !_rules[ruleskip]() {
+											goto l304
+										}
+										depth--
+										add(ruleSILENT, position306)
+									}
+									goto l305
+								l304:
+									position, tokenIndex, depth = position304, tokenIndex304, depth304
+								}
+							l305:
+								{
+									position319, tokenIndex319, depth319 := position, tokenIndex, depth
+									if !_rules[rulevar]() {
+										goto l320
+									}
+									goto l319
+								l320:
+									position, tokenIndex, depth = position319, tokenIndex319, depth319
+									if !_rules[ruleiriref]() {
+										goto l287
+									}
+								}
+							l319:
+								if !_rules[rulegroupGraphPattern]() {
+									goto l287
+								}
+								depth--
+								add(ruleserviceGraphPattern, position288)
+							}
+							goto l236
+						l287:
+							position, tokenIndex, depth = position236, tokenIndex236, depth236
+							if !_rules[ruleinlineData]() {
+								goto l233
+							}
+						}
+					l236:
+						depth--
+						add(rulegraphPatternNotTriples, position235)
+					}
+					{
+						position321, tokenIndex321, depth321 := position, tokenIndex, depth
+						if !_rules[ruleDOT]() {
+							goto l321
+						}
+						goto l322
+					l321:
+						position, tokenIndex, depth = position321, tokenIndex321, depth321
+					}
+				l322:
+					if !_rules[rulegraphPattern]() {
+						goto l233
+					}
+					goto l234
+				l233:
+					position, tokenIndex, depth = position233, tokenIndex233, depth233
+				}
+			l234:
+				depth--
+				add(rulegraphPattern, position211)
+			}
+			return true
+		},
+		/* 18 graphPatternNotTriples <- <(optionalGraphPattern / groupOrUnionGraphPattern / graphGraphPattern / minusGraphPattern / serviceGraphPattern / inlineData)> */
+		nil,
+		/* 19 serviceGraphPattern <- <(SERVICE SILENT? (var / iriref) groupGraphPattern)> */
 		nil,
 		/* 20 optionalGraphPattern <- <(OPTIONAL LBRACE (subSelect / graphPattern) RBRACE)> */
 		nil,
 		/* 21 groupOrUnionGraphPattern <- <(groupGraphPattern (UNION groupOrUnionGraphPattern)?)> */
 		func() bool {
-			position290, tokenIndex290, depth290 := position, tokenIndex, depth
+			position326, tokenIndex326, depth326 := position, tokenIndex, depth
 			{
-				position291 := position
+				position327 := position
 				depth++
-				if !rules[rulegroupGraphPattern]() {
-					goto l290
+				if !_rules[rulegroupGraphPattern]() {
+					goto l326
 				}
 				{
-					position292, tokenIndex292, depth292 := position, tokenIndex, depth
+					position328, tokenIndex328, depth328 := position, tokenIndex, depth
 					{
-						position294 := position
+						position330 := position
 						depth++
 						{
-							position295, tokenIndex295, depth295 := position, tokenIndex, depth
+							position331, tokenIndex331, depth331 := position, tokenIndex, depth
 							if buffer[position] != rune('u') {
-								goto l296
+								goto l332
 							}
 							position++
-							goto l295
-						l296:
-							position, tokenIndex, depth = position295, tokenIndex295, depth295
+							goto l331
+						l332:
+							position, tokenIndex, depth = position331, tokenIndex331, depth331
 							if buffer[position] != rune('U') {
-								goto l292
+								goto l328
 							}
 							position++
 						}
-					l295:
+					l331:
 						{
-							position297, tokenIndex297, depth297 := position, tokenIndex, depth
+							position333, tokenIndex333, depth333 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l298
+								goto l334
 							}
 							position++
-							goto l297
-						l298:
-							position, tokenIndex, depth = position297, tokenIndex297, depth297
+							goto l333
+						l334:
+							position, tokenIndex, depth = position333, tokenIndex333, depth333
 							if buffer[position] != rune('N') {
-								goto l292
+								goto l328
 							}
 							position++
 						}
-					l297:
+					l333:
 						{
-							position299, tokenIndex299, depth299 := position, tokenIndex, depth
+							position335, tokenIndex335, depth335 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l300
+								goto l336
 							}
 							position++
-							goto l299
-						l300:
-							position, tokenIndex, depth = position299, tokenIndex299, depth299
+							goto l335
+						l336:
+							position, tokenIndex, depth = position335, tokenIndex335, depth335
 							if buffer[position] != rune('I') {
-								goto l292
+								goto l328
 							}
 							position++
 						}
-					l299:
+					l335:
 						{
-							position301, tokenIndex301, depth301 := position, tokenIndex, depth
+							position337, tokenIndex337, depth337 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l302
+								goto l338
 							}
 							position++
-							goto l301
-						l302:
-							position, tokenIndex, depth = position301, tokenIndex301, depth301
+							goto l337
+						l338:
+							position, tokenIndex, depth = position337, tokenIndex337, depth337
 							if buffer[position] != rune('O') {
-								goto l292
+								goto l328
 							}
 							position++
 						}
-					l301:
+					l337:
 						{
-							position303, tokenIndex303, depth303 := position, tokenIndex, depth
+							position339, tokenIndex339, depth339 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l304
+								goto l340
 							}
 							position++
-							goto l303
-						l304:
-							position, tokenIndex, depth = position303, tokenIndex303, depth303
+							goto l339
+						l340:
+							position, tokenIndex, depth = position339, tokenIndex339, depth339
 							if buffer[position] != rune('N') {
-								goto l292
+								goto l328
 							}
 							position++
 						}
-					l303:
-						if !rules[ruleskip]() {
-							goto l292
+					l339:
+						if !_rules[ruleskip]() {
+							goto l328
 						}
 						depth--
-						add(ruleUNION, position294)
+						add(ruleUNION, position330)
 					}
-					if !rules[rulegroupOrUnionGraphPattern]() {
-						goto l292
+					if !_rules[rulegroupOrUnionGraphPattern]() {
+						goto l328
 					}
-					goto l293
-				l292:
-					position, tokenIndex, depth = position292, tokenIndex292, depth292
+					goto l329
+				l328:
+					position, tokenIndex, depth = position328, tokenIndex328, depth328
 				}
-			l293:
+			l329:
 				depth--
-				add(rulegroupOrUnionGraphPattern, position291)
+				add(rulegroupOrUnionGraphPattern, position327)
 			}
 			return true
-		l290:
-			position, tokenIndex, depth = position290, tokenIndex290, depth290
+		l326:
+			position, tokenIndex, depth = position326, tokenIndex326, depth326
 			return false
 		},
 		/* 22 graphGraphPattern <- <(GRAPH (var / iriref) groupGraphPattern)> */
@@ -3376,777 +3385,733 @@ func (p *Sparql) Init() {
 		nil,
 		/* 25 filterOrBind <- <((FILTER constraint) / (BIND LPAREN expression AS var RPAREN))> */
 		func() bool {
-			position308, tokenIndex308, depth308 := position, tokenIndex, depth
+			position344, tokenIndex344, depth344 := position, tokenIndex, depth
 			{
-				position309 := position
+				position345 := position
 				depth++
 				{
-					position310, tokenIndex310, depth310 := position, tokenIndex, depth
+					position346, tokenIndex346, depth346 := position, tokenIndex, depth
 					{
-						position312 := position
+						position348 := position
 						depth++
 						{
-							position313, tokenIndex313, depth313 := position, tokenIndex, depth
+							position349, tokenIndex349, depth349 := position, tokenIndex, depth
 							if buffer[position] != rune('f') {
-								goto l314
+								goto l350
 							}
 							position++
-							goto l313
-						l314:
-							position, tokenIndex, depth = position313, tokenIndex313, depth313
+							goto l349
+						l350:
+							position, tokenIndex, depth = position349, tokenIndex349, depth349
 							if buffer[position] != rune('F') {
-								goto l311
+								goto l347
 							}
 							position++
 						}
-					l313:
+					l349:
 						{
-							position315, tokenIndex315, depth315 := position, tokenIndex, depth
+							position351, tokenIndex351, depth351 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l316
+								goto l352
 							}
 							position++
-							goto l315
-						l316:
-							position, tokenIndex, depth = position315, tokenIndex315, depth315
+							goto l351
+						l352:
+							position, tokenIndex, depth = position351, tokenIndex351, depth351
 							if buffer[position] != rune('I') {
-								goto l311
+								goto l347
 							}
 							position++
 						}
-					l315:
+					l351:
 						{
-							position317, tokenIndex317, depth317 := position, tokenIndex, depth
+							position353, tokenIndex353, depth353 := position, tokenIndex, depth
 							if buffer[position] != rune('l') {
-								goto l318
+								goto l354
 							}
 							position++
-							goto l317
-						l318:
-							position, tokenIndex, depth = position317, tokenIndex317, depth317
+							goto l353
+						l354:
+							position, tokenIndex, depth = position353, tokenIndex353, depth353
 							if buffer[position] != rune('L') {
-								goto l311
+								goto l347
 							}
 							position++
 						}
-					l317:
+					l353:
 						{
-							position319, tokenIndex319, depth319 := position, tokenIndex, depth
+							position355, tokenIndex355, depth355 := position, tokenIndex, depth
 							if buffer[position] != rune('t') {
-								goto l320
+								goto l356
 							}
 							position++
-							goto l319
-						l320:
-							position, tokenIndex, depth = position319, tokenIndex319, depth319
+							goto l355
+						l356:
+							position, tokenIndex, depth = position355, tokenIndex355, depth355
 							if buffer[position] != rune('T') {
-								goto l311
+								goto l347
 							}
 							position++
 						}
-					l319:
+					l355:
 						{
-							position321, tokenIndex321, depth321 := position, tokenIndex, depth
+							position357, tokenIndex357, depth357 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l322
+								goto l358
 							}
 							position++
-							goto l321
-						l322:
-							position, tokenIndex, depth = position321, tokenIndex321, depth321
+							goto l357
+						l358:
+							position, tokenIndex, depth = position357, tokenIndex357, depth357
 							if buffer[position] != rune('E') {
-								goto l311
+								goto l347
 							}
 							position++
 						}
-					l321:
+					l357:
 						{
-							position323, tokenIndex323, depth323 := position, tokenIndex, depth
+							position359, tokenIndex359, depth359 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l324
+								goto l360
 							}
 							position++
-							goto l323
-						l324:
-							position, tokenIndex, depth = position323, tokenIndex323, depth323
+							goto l359
+						l360:
+							position, tokenIndex, depth = position359, tokenIndex359, depth359
 							if buffer[position] != rune('R') {
-								goto l311
+								goto l347
 							}
 							position++
 						}
-					l323:
-						if !rules[ruleskip]() {
-							goto l311
+					l359:
+						if !_rules[ruleskip]() {
+							goto l347
 						}
 						depth--
-						add(ruleFILTER, position312)
+						add(ruleFILTER, position348)
 					}
-					if !rules[ruleconstraint]() {
-						goto l311
+					if !_rules[ruleconstraint]() {
+						goto l347
 					}
-					goto l310
-				l311:
-					position, tokenIndex, depth = position310, tokenIndex310, depth310
+					goto l346
+				l347:
+					position, tokenIndex, depth = position346, tokenIndex346, depth346
 					{
-						position325 := position
+						position361 := position
 						depth++
 						{
-							position326, tokenIndex326, depth326 := position, tokenIndex, depth
+							position362, tokenIndex362, depth362 := position, tokenIndex, depth
 							if buffer[position] != rune('b') {
-								goto l327
+								goto l363
 							}
 							position++
-							goto l326
-						l327:
-							position, tokenIndex, depth = position326, tokenIndex326, depth326
+							goto l362
+						l363:
+							position, tokenIndex, depth = position362, tokenIndex362, depth362
 							if buffer[position] != rune('B') {
-								goto l308
+								goto l344
 							}
 							position++
 						}
-					l326:
+					l362:
 						{
-							position328, tokenIndex328, depth328 := position, tokenIndex, depth
+							position364, tokenIndex364, depth364 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l329
+								goto l365
 							}
 							position++
-							goto l328
-						l329:
-							position, tokenIndex, depth = position328, tokenIndex328, depth328
+							goto l364
+						l365:
+							position, tokenIndex, depth = position364, tokenIndex364, depth364
 							if buffer[position] != rune('I') {
-								goto l308
+								goto l344
 							}
 							position++
 						}
-					l328:
+					l364:
 						{
-							position330, tokenIndex330, depth330 := position, tokenIndex, depth
+							position366, tokenIndex366, depth366 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l331
+								goto l367
 							}
 							position++
-							goto l330
-						l331:
-							position, tokenIndex, depth = position330, tokenIndex330, depth330
+							goto l366
+						l367:
+							position, tokenIndex, depth = position366, tokenIndex366, depth366
 							if buffer[position] != rune('N') {
-								goto l308
+								goto l344
 							}
 							position++
 						}
-					l330:
+					l366:
 						{
-							position332, tokenIndex332, depth332 := position, tokenIndex, depth
+							position368, tokenIndex368, depth368 := position, tokenIndex, depth
 							if buffer[position] != rune('d') {
-								goto l333
+								goto l369
 							}
 							position++
-							goto l332
-						l333:
-							position, tokenIndex, depth = position332, tokenIndex332, depth332
+							goto l368
+						l369:
+							position, tokenIndex, depth = position368, tokenIndex368, depth368
 							if buffer[position] != rune('D') {
-								goto l308
+								goto l344
 							}
 							position++
 						}
-					l332:
-						if !rules[ruleskip]() {
-							goto l308
+					l368:
+						if !_rules[ruleskip]() {
+							goto l344
 						}
 						depth--
-						add(ruleBIND, position325)
+						add(ruleBIND, position361)
 					}
-					if !rules[ruleLPAREN]() {
-						goto l308
+					if !_rules[ruleLPAREN]() {
+						goto l344
 					}
-					if !rules[ruleexpression]() {
-						goto l308
+					if !_rules[ruleexpression]() {
+						goto l344
 					}
-					if !rules[ruleAS]() {
-						goto l308
+					if !_rules[ruleAS]() {
+						goto l344
 					}
-					if !rules[rulevar]() {
-						goto l308
+					if !_rules[rulevar]() {
+						goto l344
 					}
-					if !rules[ruleRPAREN]() {
-						goto l308
+					if !_rules[ruleRPAREN]() {
+						goto l344
 					}
 				}
-			l310:
+			l346:
 				depth--
-				add(rulefilterOrBind, position309)
+				add(rulefilterOrBind, position345)
 			}
 			return true
-		l308:
-			position, tokenIndex, depth = position308, tokenIndex308, depth308
+		l344:
+			position, tokenIndex, depth = position344, tokenIndex344, depth344
 			return false
 		},
 		/* 26 constraint <- <(brackettedExpression / builtinCall / functionCall)> */
 		func() bool {
-			position334, tokenIndex334, depth334 := position, tokenIndex, depth
+			position370, tokenIndex370, depth370 := position, tokenIndex, depth
 			{
-				position335 := position
+				position371 := position
 				depth++
 				{
-					position336, tokenIndex336, depth336 := position, tokenIndex, depth
-					if !rules[rulebrackettedExpression]() {
-						goto l337
+					position372, tokenIndex372, depth372 := position, tokenIndex, depth
+					if !_rules[rulebrackettedExpression]() {
+						goto l373
 					}
-					goto l336
-				l337:
-					position, tokenIndex, depth = position336, tokenIndex336, depth336
-					if !rules[rulebuiltinCall]() {
-						goto l338
+					goto l372
+				l373:
+					position, tokenIndex, depth = position372, tokenIndex372, depth372
+					if !_rules[rulebuiltinCall]() {
+						goto l374
 					}
-					goto l336
-				l338:
-					position, tokenIndex, depth = position336, tokenIndex336, depth336
-					if !rules[rulefunctionCall]() {
-						goto l334
+					goto l372
+				l374:
+					position, tokenIndex, depth = position372, tokenIndex372, depth372
+					if !_rules[rulefunctionCall]() {
+						goto l370
 					}
 				}
-			l336:
+			l372:
 				depth--
-				add(ruleconstraint, position335)
+				add(ruleconstraint, position371)
 			}
 			return true
-		l334:
-			position, tokenIndex, depth = position334, tokenIndex334, depth334
+		l370:
+			position, tokenIndex, depth = position370, tokenIndex370, depth370
 			return false
 		},
 		/* 27 triplesBlock <- <(triplesSameSubjectPath (DOT triplesSameSubjectPath)* DOT?)> */
 		func() bool {
-			position339, tokenIndex339, depth339 := position, tokenIndex, depth
+			position375, tokenIndex375, depth375 := position, tokenIndex, depth
 			{
-				position340 := position
+				position376 := position
 				depth++
-				if !rules[ruletriplesSameSubjectPath]() {
-					goto l339
+				if !_rules[ruletriplesSameSubjectPath]() {
+					goto l375
 				}
-			l341:
+			l377:
 				{
-					position342, tokenIndex342, depth342 := position, tokenIndex, depth
-					if !rules[ruleDOT]() {
-						goto l342
+					position378, tokenIndex378, depth378 := position, tokenIndex, depth
+					if !_rules[ruleDOT]() {
+						goto l378
 					}
-					if !rules[ruletriplesSameSubjectPath]() {
-						goto l342
+					if !_rules[ruletriplesSameSubjectPath]() {
+						goto l378
 					}
-					goto l341
-				l342:
-					position, tokenIndex, depth = position342, tokenIndex342, depth342
+					goto l377
+				l378:
+					position, tokenIndex, depth = position378, tokenIndex378, depth378
 				}
 				{
-					position343, tokenIndex343, depth343 := position, tokenIndex, depth
-					if !rules[ruleDOT]() {
-						goto l343
+					position379, tokenIndex379, depth379 := position, tokenIndex, depth
+					if !_rules[ruleDOT]() {
+						goto l379
 					}
-					goto l344
-				l343:
-					position, tokenIndex, depth = position343, tokenIndex343, depth343
+					goto l380
+				l379:
+					position, tokenIndex, depth = position379, tokenIndex379, depth379
 				}
-			l344:
+			l380:
 				depth--
-				add(ruletriplesBlock, position340)
+				add(ruletriplesBlock, position376)
 			}
 			return true
-		l339:
-			position, tokenIndex, depth = position339, tokenIndex339, depth339
+		l375:
+			position, tokenIndex, depth = position375, tokenIndex375, depth375
 			return false
 		},
 		/* 28 triplesSameSubjectPath <- <((varOrTerm propertyListPath) / (triplesNodePath propertyListPath?))> */
 		func() bool {
-			position345, tokenIndex345, depth345 := position, tokenIndex, depth
+			position381, tokenIndex381, depth381 := position, tokenIndex, depth
 			{
-				position346 := position
+				position382 := position
 				depth++
 				{
-					position347, tokenIndex347, depth347 := position, tokenIndex, depth
+					position383, tokenIndex383, depth383 := position, tokenIndex, depth
 					{
-						position349 := position
+						position385 := position
 						depth++
 						{
-							position350, tokenIndex350, depth350 := position, tokenIndex, depth
+							position386, tokenIndex386, depth386 := position, tokenIndex, depth
 							{
-								position352 := position
+								position388 := position
 								depth++
-								if !rules[rulevar]() {
-									goto l351
+								if !_rules[rulevar]() {
+									goto l387
 								}
 								depth--
-								add(rulePegText, position352)
+								add(rulePegText, position388)
 							}
 							{
 								add(ruleAction1, position)
 							}
-							goto l350
-						l351:
-							position, tokenIndex, depth = position350, tokenIndex350, depth350
+							goto l386
+						l387:
+							position, tokenIndex, depth = position386, tokenIndex386, depth386
 							{
-								position355 := position
+								position391 := position
 								depth++
-								if !rules[rulegraphTerm]() {
-									goto l354
+								if !_rules[rulegraphTerm]() {
+									goto l390
 								}
 								depth--
-								add(rulePegText, position355)
+								add(rulePegText, position391)
 							}
 							{
 								add(ruleAction2, position)
 							}
-							goto l350
-						l354:
-							position, tokenIndex, depth = position350, tokenIndex350, depth350
-							if !rules[rulepof]() {
-								goto l348
+							goto l386
+						l390:
+							position, tokenIndex, depth = position386, tokenIndex386, depth386
+							if !_rules[rulepof]() {
+								goto l384
 							}
 							{
 								add(ruleAction3, position)
 							}
 						}
-					l350:
+					l386:
 						depth--
-						add(rulevarOrTerm, position349)
+						add(rulevarOrTerm, position385)
 					}
-					if !rules[rulepropertyListPath]() {
-						goto l348
+					if !_rules[rulepropertyListPath]() {
+						goto l384
 					}
-					goto l347
-				l348:
-					position, tokenIndex, depth = position347, tokenIndex347, depth347
-					if !rules[ruletriplesNodePath]() {
-						goto l345
+					goto l383
+				l384:
+					position, tokenIndex, depth = position383, tokenIndex383, depth383
+					if !_rules[ruletriplesNodePath]() {
+						goto l381
 					}
 					{
-						position358, tokenIndex358, depth358 := position, tokenIndex, depth
-						if !rules[rulepropertyListPath]() {
-							goto l358
+						position394, tokenIndex394, depth394 := position, tokenIndex, depth
+						if !_rules[rulepropertyListPath]() {
+							goto l394
 						}
-						goto l359
-					l358:
-						position, tokenIndex, depth = position358, tokenIndex358, depth358
+						goto l395
+					l394:
+						position, tokenIndex, depth = position394, tokenIndex394, depth394
 					}
-				l359:
+				l395:
 				}
-			l347:
+			l383:
 				depth--
-				add(ruletriplesSameSubjectPath, position346)
+				add(ruletriplesSameSubjectPath, position382)
 			}
 			return true
-		l345:
-			position, tokenIndex, depth = position345, tokenIndex345, depth345
+		l381:
+			position, tokenIndex, depth = position381, tokenIndex381, depth381
 			return false
 		},
 		/* 29 varOrTerm <- <((<var> Action1) / (<graphTerm> Action2) / (pof Action3))> */
 		nil,
-		/* 30 graphTerm <- <(iriref / ((&('(') nil) | (&('[' | '_') blankNode) | (&('F' | 'T' | 'f' | 't') booleanLiteral) | (&('"' | '\'') literal) | (&('+' | '-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') numericLiteral)))> */
+		/* 30 graphTerm <- <(iriref / literal / numericLiteral / booleanLiteral / blankNode / nil)> */
 		func() bool {
-			position361, tokenIndex361, depth361 := position, tokenIndex, depth
+			position397, tokenIndex397, depth397 := position, tokenIndex, depth
 			{
-				position362 := position
+				position398 := position
 				depth++
 				{
-					position363, tokenIndex363, depth363 := position, tokenIndex, depth
-					if !rules[ruleiriref]() {
-						goto l364
+					position399, tokenIndex399, depth399 := position, tokenIndex, depth
+					if !_rules[ruleiriref]() {
+						goto l400
+					}
+					goto l399
+				l400:
+					position, tokenIndex, depth = position399, tokenIndex399, depth399
+					if !_rules[ruleliteral]() {
+						goto l401
 					}
-					goto l363
-				l364:
-					position, tokenIndex, depth = position363, tokenIndex363, depth363
+					goto l399
+				l401:
+					position, tokenIndex, depth = position399, tokenIndex399, depth399
+					if !_rules[rulenumericLiteral]() {
+						goto l402
+					}
+					goto l399
+				l402:
+					position, tokenIndex, depth = position399, tokenIndex399, depth399
+					if !_rules[rulebooleanLiteral]() {
+						goto l403
+					}
+					goto l399
+				l403:
+					position, tokenIndex, depth = position399, tokenIndex399, depth399
 					{
-						switch buffer[position] {
-						case '(':
-							if !rules[rulenil]() {
-								goto l361
-							}
-							break
-						case '[', '_':
+						position405 := position
+						depth++
+						{
+							position406, tokenIndex406, depth406 := position, tokenIndex, depth
 							{
-								position366 := position
+								position408 := position
 								depth++
+								if buffer[position] != rune('_') {
+									goto l407
+								}
+								position++
+								if buffer[position] != rune(':') {
+									goto l407
+								}
+								position++
 								{
-									position367, tokenIndex367, depth367 := position, tokenIndex, depth
-									{
-										position369 := position
-										depth++
-										if buffer[position] != rune('_') {
-											goto l368
-										}
-										position++
-										if buffer[position] != rune(':') {
-											goto l368
-										}
-										position++
-										{
-											position370, tokenIndex370, depth370 := position, tokenIndex, depth
-											if !rules[rulepnCharsU]() {
-												goto l371
-											}
-											goto l370
-										l371:
-											position, tokenIndex, depth = position370, tokenIndex370, depth370
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l368
-											}
-											position++
-										}
-									l370:
-										{
-											position372, tokenIndex372, depth372 := position, tokenIndex, depth
-											{
-												position374, tokenIndex374, depth374 := position, tokenIndex, depth
-											l376:
-												{
-													position377, tokenIndex377, depth377 := position, tokenIndex, depth
-													{
-														position378, tokenIndex378, depth378 := position, tokenIndex, depth
-														if !rules[rulepnCharsU]() {
-															goto l379
-														}
-														goto l378
-													l379:
-														position, tokenIndex, depth = position378, tokenIndex378, depth378
-														{
-															switch buffer[position] {
-															case '.':
-																if buffer[position] != rune('.') {
-																	goto l377
-																}
-																position++
-																break
-															case '-':
-																if buffer[position] != rune('-') {
-																	goto l377
-																}
-																position++
-																break
-															default:
-																if c := buffer[position]; c < rune('0') || c > rune('9') {
-																	goto l377
-																}
-																position++
-																break
-															}
-														}
-
-													}
-												l378:
-													goto l376
-												l377:
-													position, tokenIndex, depth = position377, tokenIndex377, depth377
-												}
-												if !rules[rulepnCharsU]() {
-													goto l375
-												}
-												goto l374
-											l375:
-												position, tokenIndex, depth = position374, tokenIndex374, depth374
-												{
-													position381, tokenIndex381, depth381 := position, tokenIndex, depth
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l382
-													}
-													position++
-													goto l381
-												l382:
-													position, tokenIndex, depth = position381, tokenIndex381, depth381
-													if buffer[position] != rune('-') {
-														goto l372
-													}
-													position++
-												}
-											l381:
-											}
-										l374:
-											goto l373
-										l372:
-											position, tokenIndex, depth = position372, tokenIndex372, depth372
-										}
-									l373:
-										if !rules[ruleskip]() {
-											goto l368
-										}
-										depth--
-										add(ruleblankNodeLabel, position369)
+									position409, tokenIndex409, depth409 := position, tokenIndex, depth
+									if !_rules[rulepnCharsU]() {
+										goto l410
 									}
-									goto l367
-								l368:
-									position, tokenIndex, depth = position367, tokenIndex367, depth367
+									goto l409
+								l410:
+									position, tokenIndex, depth = position409, tokenIndex409, depth409
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l407
+									}
+									position++
+								}
+							l409:
+							l411:
+								{
+									position412, tokenIndex412, depth412 := position, tokenIndex, depth
+								l413:
 									{
-										position383 := position
-										depth++
-										if buffer[position] != rune('[') {
-											goto l361
-										}
-										position++
-									l384:
-										{
-											position385, tokenIndex385, depth385 := position, tokenIndex, depth
-											if !rules[rulews]() {
-												goto l385
-											}
-											goto l384
-										l385:
-											position, tokenIndex, depth = position385, tokenIndex385, depth385
-										}
-										if buffer[position] != rune(']') {
-											goto l361
+										position414, tokenIndex414, depth414 := position, tokenIndex, depth
+										if buffer[position] != rune('.') {
+											goto l414
 										}
 										position++
-										if !rules[ruleskip]() {
-											goto l361
-										}
-										depth--
-										add(ruleanon, position383)
+										goto l413
+									l414:
+										position, tokenIndex, depth = position414, tokenIndex414, depth414
 									}
+									if !_rules[rulepnChars]() {
+										goto l412
+									}
+									goto l411
+								l412:
+									position, tokenIndex, depth = position412, tokenIndex412, depth412
+								}
+								if !_rules[ruleskip]() {
+									goto l407
 								}
-							l367:
 								depth--
-								add(ruleblankNode, position366)
+								add(ruleblankNodeLabel, position408)
 							}
-							break
-						case 'F', 'T', 'f', 't':
-							if !rules[rulebooleanLiteral]() {
-								goto l361
-							}
-							break
-						case '"', '\'':
-							if !rules[ruleliteral]() {
-								goto l361
-							}
-							break
-						default:
-							if !rules[rulenumericLiteral]() {
-								goto l361
+							goto l406
+						l407:
+							position, tokenIndex, depth = position406, tokenIndex406, depth406
+							{
+								position415 := position
+								depth++
+								if buffer[position] != rune('[') {
+									goto l404
+								}
+								position++
+							l416:
+								{
+									position417, tokenIndex417, depth417 := position, tokenIndex, depth
+									if !_rules[rulews]() {
+										goto l417
+									}
+									goto l416
+								l417:
+									position, tokenIndex, depth = position417, tokenIndex417, depth417
+								}
+								if buffer[position] != rune(']') {
+									goto l404
+								}
+								position++
+								if !_rules[ruleskip]() {
+									goto l404
+								}
+								depth--
+								add(ruleanon, position415)
 							}
-							break
 						}
+					l406:
+						depth--
+						add(ruleblankNode, position405)
+					}
+					goto l399
+				l404:
+					position, tokenIndex, depth = position399, tokenIndex399, depth399
+					if !_rules[rulenil]() {
+						goto l397
 					}
-
 				}
-			l363:
+			l399:
 				depth--
-				add(rulegraphTerm, position362)
+				add(rulegraphTerm, position398)
 			}
 			return true
-		l361:
-			position, tokenIndex, depth = position361, tokenIndex361, depth361
+		l397:
+			position, tokenIndex, depth = position397, tokenIndex397, depth397
 			return false
 		},
 		/* 31 triplesNodePath <- <(collectionPath / blankNodePropertyListPath)> */
 		func() bool {
-			position386, tokenIndex386, depth386 := position, tokenIndex, depth
+			position418, tokenIndex418, depth418 := position, tokenIndex, depth
 			{
-				position387 := position
+				position419 := position
 				depth++
 				{
-					position388, tokenIndex388, depth388 := position, tokenIndex, depth
+					position420, tokenIndex420, depth420 := position, tokenIndex, depth
 					{
-						position390 := position
+						position422 := position
 						depth++
-						if !rules[ruleLPAREN]() {
-							goto l389
+						if !_rules[ruleLPAREN]() {
+							goto l421
 						}
-						if !rules[rulegraphNodePath]() {
-							goto l389
+						if !_rules[rulegraphNodePath]() {
+							goto l421
 						}
-					l391:
+					l423:
 						{
-							position392, tokenIndex392, depth392 := position, tokenIndex, depth
-							if !rules[rulegraphNodePath]() {
-								goto l392
+							position424, tokenIndex424, depth424 := position, tokenIndex, depth
+							if !_rules[rulegraphNodePath]() {
+								goto l424
 							}
-							goto l391
-						l392:
-							position, tokenIndex, depth = position392, tokenIndex392, depth392
+							goto l423
+						l424:
+							position, tokenIndex, depth = position424, tokenIndex424, depth424
 						}
-						if !rules[ruleRPAREN]() {
-							goto l389
+						if !_rules[ruleRPAREN]() {
+							goto l421
 						}
 						depth--
-						add(rulecollectionPath, position390)
+						add(rulecollectionPath, position422)
 					}
-					goto l388
-				l389:
-					position, tokenIndex, depth = position388, tokenIndex388, depth388
+					goto l420
+				l421:
+					position, tokenIndex, depth = position420, tokenIndex420, depth420
 					{
-						position393 := position
+						position425 := position
 						depth++
 						{
-							position394 := position
+							position426 := position
 							depth++
 							if buffer[position] != rune('[') {
-								goto l386
+								goto l418
 							}
 							position++
-							if !rules[ruleskip]() {
-								goto l386
+							if !_rules[ruleskip]() {
+								goto l418
 							}
 							depth--
-							add(ruleLBRACK, position394)
+							add(ruleLBRACK, position426)
 						}
-						if !rules[rulepropertyListPath]() {
-							goto l386
+						if !_rules[rulepropertyListPath]() {
+							goto l418
 						}
 						{
-							position395 := position
+							position427 := position
 							depth++
 							if buffer[position] != rune(']') {
-								goto l386
+								goto l418
 							}
 							position++
-							if !rules[ruleskip]() {
-								goto l386
+							if !_rules[ruleskip]() {
+								goto l418
 							}
 							depth--
-							add(ruleRBRACK, position395)
+							add(ruleRBRACK, position427)
 						}
 						depth--
-						add(ruleblankNodePropertyListPath, position393)
+						add(ruleblankNodePropertyListPath, position425)
 					}
 				}
-			l388:
+			l420:
 				depth--
-				add(ruletriplesNodePath, position387)
+				add(ruletriplesNodePath, position419)
 			}
 			return true
-		l386:
-			position, tokenIndex, depth = position386, tokenIndex386, depth386
+		l418:
+			position, tokenIndex, depth = position418, tokenIndex418, depth418
 			return false
 		},
 		/* 32 collectionPath <- <(LPAREN graphNodePath+ RPAREN)> */
 		nil,
 		/* 33 blankNodePropertyListPath <- <(LBRACK propertyListPath RBRACK)> */
 		nil,
-		/* 34 propertyListPath <- <((pofPropertyListPath / noPofPropertyListPath) (SEMICOLON propertyListPath?)?)> */
+		/* 34 propertyListPath <- <((pofPropertyListPath / noPofPropertyListPath) (SEMICOLON+ propertyListPath?)?)> */
 		func() bool {
-			position398, tokenIndex398, depth398 := position, tokenIndex, depth
+			position430, tokenIndex430, depth430 := position, tokenIndex, depth
 			{
-				position399 := position
+				position431 := position
 				depth++
 				{
-					position400, tokenIndex400, depth400 := position, tokenIndex, depth
+					position432, tokenIndex432, depth432 := position, tokenIndex, depth
 					{
-						position402 := position
+						position434 := position
 						depth++
-						if !rules[rulepof]() {
-							goto l401
+						if !_rules[rulepof]() {
+							goto l433
 						}
 						{
 							add(ruleAction5, position)
 						}
 						{
-							position404 := position
+							position436 := position
 							depth++
-							if !rules[rulefillObjectPath]() {
-								goto l401
+							if !_rules[rulefillObjectPath]() {
+								goto l433
 							}
-						l405:
+						l437:
 							{
-								position406, tokenIndex406, depth406 := position, tokenIndex, depth
-								if !rules[ruleCOMMA]() {
-									goto l406
+								position438, tokenIndex438, depth438 := position, tokenIndex, depth
+								if !_rules[ruleCOMMA]() {
+									goto l438
 								}
-								if !rules[rulefillObjectPath]() {
-									goto l406
+								if !_rules[rulefillObjectPath]() {
+									goto l438
 								}
-								goto l405
-							l406:
-								position, tokenIndex, depth = position406, tokenIndex406, depth406
+								goto l437
+							l438:
+								position, tokenIndex, depth = position438, tokenIndex438, depth438
 							}
 							depth--
-							add(rulefillObjectListPath, position404)
+							add(rulefillObjectListPath, position436)
 						}
 						depth--
-						add(rulepofPropertyListPath, position402)
+						add(rulepofPropertyListPath, position434)
 					}
-					goto l400
-				l401:
-					position, tokenIndex, depth = position400, tokenIndex400, depth400
+					goto l432
+				l433:
+					position, tokenIndex, depth = position432, tokenIndex432, depth432
 					{
-						position407 := position
+						position439 := position
 						depth++
 						{
-							position408, tokenIndex408, depth408 := position, tokenIndex, depth
+							position440, tokenIndex440, depth440 := position, tokenIndex, depth
 							{
-								position410 := position
+								position442 := position
 								depth++
-								if !rules[rulevar]() {
-									goto l409
+								if !_rules[rulevar]() {
+									goto l441
 								}
 								depth--
-								add(rulePegText, position410)
+								add(rulePegText, position442)
 							}
 							{
 								add(ruleAction4, position)
 							}
-							goto l408
-						l409:
-							position, tokenIndex, depth = position408, tokenIndex408, depth408
+							goto l440
+						l441:
+							position, tokenIndex, depth = position440, tokenIndex440, depth440
 							{
-								position412 := position
+								position444 := position
 								depth++
-								if !rules[rulepath]() {
-									goto l398
+								if !_rules[rulepath]() {
+									goto l430
 								}
 								depth--
-								add(ruleverbPath, position412)
+								add(ruleverbPath, position444)
 							}
 						}
-					l408:
+					l440:
 						{
-							position413 := position
+							position445 := position
 							depth++
-							if !rules[ruleobjectPath]() {
-								goto l398
+							if !_rules[ruleobjectPath]() {
+								goto l430
 							}
-						l414:
+						l446:
 							{
-								position415, tokenIndex415, depth415 := position, tokenIndex, depth
-								if !rules[ruleCOMMA]() {
-									goto l415
+								position447, tokenIndex447, depth447 := position, tokenIndex, depth
+								if !_rules[ruleCOMMA]() {
+									goto l447
 								}
-								if !rules[ruleobjectPath]() {
-									goto l415
+								if !_rules[ruleobjectPath]() {
+									goto l447
 								}
-								goto l414
-							l415:
-								position, tokenIndex, depth = position415, tokenIndex415, depth415
+								goto l446
+							l447:
+								position, tokenIndex, depth = position447, tokenIndex447, depth447
 							}
 							depth--
-							add(ruleobjectListPath, position413)
+							add(ruleobjectListPath, position445)
 						}
 						depth--
-						add(rulenoPofPropertyListPath, position407)
+						add(rulenoPofPropertyListPath, position439)
 					}
 				}
-			l400:
+			l432:
 				{
-					position416, tokenIndex416, depth416 := position, tokenIndex, depth
-					if !rules[ruleSEMICOLON]() {
-						goto l416
+					position448, tokenIndex448, depth448 := position, tokenIndex, depth
+					if !_rules[ruleSEMICOLON]() {
+						goto l448
 					}
+				l450:
 					{
-						position418, tokenIndex418, depth418 := position, tokenIndex, depth
-						if !rules[rulepropertyListPath]() {
-							goto l418
+						position451, tokenIndex451, depth451 := position, tokenIndex, depth
+						if !_rules[ruleSEMICOLON]() {
+							goto l451
+						}
+						goto l450
+					l451:
+						position, tokenIndex, depth = position451, tokenIndex451, depth451
+					}
+					{
+						position452, tokenIndex452, depth452 := position, tokenIndex, depth
+						if !_rules[rulepropertyListPath]() {
+							goto l452
 						}
-						goto l419
-					l418:
-						position, tokenIndex, depth = position418, tokenIndex418, depth418
+						goto l453
+					l452:
+						position, tokenIndex, depth = position452, tokenIndex452, depth452
 					}
-				l419:
-					goto l417
-				l416:
-					position, tokenIndex, depth = position416, tokenIndex416, depth416
+				l453:
+					goto l449
+				l448:
+					position, tokenIndex, depth = position448, tokenIndex448, depth448
 				}
-			l417:
+			l449:
 				depth--
-				add(rulepropertyListPath, position399)
+				add(rulepropertyListPath, position431)
 			}
 			return true
-		l398:
-			position, tokenIndex, depth = position398, tokenIndex398, depth398
+		l430:
+			position, tokenIndex, depth = position430, tokenIndex430, depth430
 			return false
 		},
 		/* 35 noPofPropertyListPath <- <(((<var> Action4) / verbPath) objectListPath)> */
@@ -4157,309 +4122,303 @@ func (p *Sparql) Init() {
 		nil,
 		/* 38 path <- <pathAlternative> */
 		func() bool {
-			position423, tokenIndex423, depth423 := position, tokenIndex, depth
+			position457, tokenIndex457, depth457 := position, tokenIndex, depth
 			{
-				position424 := position
+				position458 := position
 				depth++
 				{
-					position425 := position
+					position459 := position
 					depth++
-					if !rules[rulepathSequence]() {
-						goto l423
+					if !_rules[rulepathSequence]() {
+						goto l457
 					}
-				l426:
+				l460:
 					{
-						position427, tokenIndex427, depth427 := position, tokenIndex, depth
-						if !rules[rulePIPE]() {
-							goto l427
+						position461, tokenIndex461, depth461 := position, tokenIndex, depth
+						if !_rules[rulePIPE]() {
+							goto l461
 						}
-						if !rules[rulepathSequence]() {
-							goto l427
+						if !_rules[rulepathSequence]() {
+							goto l461
 						}
-						goto l426
-					l427:
-						position, tokenIndex, depth = position427, tokenIndex427, depth427
+						goto l460
+					l461:
+						position, tokenIndex, depth = position461, tokenIndex461, depth461
 					}
 					depth--
-					add(rulepathAlternative, position425)
+					add(rulepathAlternative, position459)
 				}
 				depth--
-				add(rulepath, position424)
+				add(rulepath, position458)
 			}
 			return true
-		l423:
-			position, tokenIndex, depth = position423, tokenIndex423, depth423
+		l457:
+			position, tokenIndex, depth = position457, tokenIndex457, depth457
 			return false
 		},
 		/* 39 pathAlternative <- <(pathSequence (PIPE pathSequence)*)> */
 		nil,
 		/* 40 pathSequence <- <(<pathElt> Action6 (SLASH pathSequence)*)> */
 		func() bool {
-			position429, tokenIndex429, depth429 := position, tokenIndex, depth
+			position463, tokenIndex463, depth463 := position, tokenIndex, depth
 			{
-				position430 := position
+				position464 := position
 				depth++
 				{
-					position431 := position
+					position465 := position
 					depth++
 					{
-						position432 := position
+						position466 := position
 						depth++
 						{
-							position433, tokenIndex433, depth433 := position, tokenIndex, depth
-							if !rules[ruleINVERSE]() {
-								goto l433
+							position467, tokenIndex467, depth467 := position, tokenIndex, depth
+							if !_rules[ruleINVERSE]() {
+								goto l467
 							}
-							goto l434
-						l433:
-							position, tokenIndex, depth = position433, tokenIndex433, depth433
+							goto l468
+						l467:
+							position, tokenIndex, depth = position467, tokenIndex467, depth467
 						}
-					l434:
+					l468:
 						{
-							position435 := position
+							position469 := position
 							depth++
 							{
-								position436, tokenIndex436, depth436 := position, tokenIndex, depth
-								if !rules[ruleiriref]() {
-									goto l437
+								position470, tokenIndex470, depth470 := position, tokenIndex, depth
+								if !_rules[ruleiriref]() {
+									goto l471
+								}
+								goto l470
+							l471:
+								position, tokenIndex, depth = position470, tokenIndex470, depth470
+								if !_rules[ruleISA]() {
+									goto l472
+								}
+								goto l470
+							l472:
+								position, tokenIndex, depth = position470, tokenIndex470, depth470
+								if !_rules[ruleNOT]() {
+									goto l473
 								}
-								goto l436
-							l437:
-								position, tokenIndex, depth = position436, tokenIndex436, depth436
 								{
-									switch buffer[position] {
-									case '(':
-										if !rules[ruleLPAREN]() {
-											goto l429
-										}
-										if !rules[rulepath]() {
-											goto l429
-										}
-										if !rules[ruleRPAREN]() {
-											goto l429
+									position474 := position
+									depth++
+									{
+										position475, tokenIndex475, depth475 := position, tokenIndex, depth
+										if !_rules[rulepathOneInPropertySet]() {
+											goto l476
 										}
-										break
-									case '!':
-										if !rules[ruleNOT]() {
-											goto l429
+										goto l475
+									l476:
+										position, tokenIndex, depth = position475, tokenIndex475, depth475
+										if !_rules[ruleLPAREN]() {
+											goto l473
 										}
 										{
-											position439 := position
-											depth++
+											position477, tokenIndex477, depth477 := position, tokenIndex, depth
+											if !_rules[rulepathOneInPropertySet]() {
+												goto l477
+											}
+										l479:
 											{
-												position440, tokenIndex440, depth440 := position, tokenIndex, depth
-												if !rules[rulepathOneInPropertySet]() {
-													goto l441
-												}
-												goto l440
-											l441:
-												position, tokenIndex, depth = position440, tokenIndex440, depth440
-												if !rules[ruleLPAREN]() {
-													goto l429
+												position480, tokenIndex480, depth480 := position, tokenIndex, depth
+												if !_rules[rulePIPE]() {
+													goto l480
 												}
-												{
-													position442, tokenIndex442, depth442 := position, tokenIndex, depth
-													if !rules[rulepathOneInPropertySet]() {
-														goto l442
-													}
-												l444:
-													{
-														position445, tokenIndex445, depth445 := position, tokenIndex, depth
-														if !rules[rulePIPE]() {
-															goto l445
-														}
-														if !rules[rulepathOneInPropertySet]() {
-															goto l445
-														}
-														goto l444
-													l445:
-														position, tokenIndex, depth = position445, tokenIndex445, depth445
-													}
-													goto l443
-												l442:
-													position, tokenIndex, depth = position442, tokenIndex442, depth442
-												}
-											l443:
-												if !rules[ruleRPAREN]() {
-													goto l429
+												if !_rules[rulepathOneInPropertySet]() {
+													goto l480
 												}
+												goto l479
+											l480:
+												position, tokenIndex, depth = position480, tokenIndex480, depth480
 											}
-										l440:
-											depth--
-											add(rulepathNegatedPropertySet, position439)
+											goto l478
+										l477:
+											position, tokenIndex, depth = position477, tokenIndex477, depth477
 										}
-										break
-									default:
-										if !rules[ruleISA]() {
-											goto l429
+									l478:
+										if !_rules[ruleRPAREN]() {
+											goto l473
 										}
-										break
 									}
+								l475:
+									depth--
+									add(rulepathNegatedPropertySet, position474)
+								}
+								goto l470
+							l473:
+								position, tokenIndex, depth = position470, tokenIndex470, depth470
+								if !_rules[ruleLPAREN]() {
+									goto l463
+								}
+								if !_rules[rulepath]() {
+									goto l463
+								}
+								if !_rules[ruleRPAREN]() {
+									goto l463
 								}
-
 							}
-						l436:
+						l470:
 							depth--
-							add(rulepathPrimary, position435)
+							add(rulepathPrimary, position469)
 						}
 						{
-							position446, tokenIndex446, depth446 := position, tokenIndex, depth
+							position481, tokenIndex481, depth481 := position, tokenIndex, depth
 							{
-								position448 := position
+								position483 := position
 								depth++
 								{
-									switch buffer[position] {
-									case '+':
-										if !rules[rulePLUS]() {
-											goto l446
+									position484, tokenIndex484, depth484 := position, tokenIndex, depth
+									if !_rules[ruleSTAR]() {
+										goto l485
+									}
+									goto l484
+								l485:
+									position, tokenIndex, depth = position484, tokenIndex484, depth484
+									{
+										position487, tokenIndex487, depth487 := position, tokenIndex, depth
+										if !_rules[rulevar]() {
+											goto l487
 										}
-										break
-									case '?':
-										{
-											position450 := position
-											depth++
-											if buffer[position] != rune('?') {
-												goto l446
-											}
-											position++
-											if !rules[ruleskip]() {
-												goto l446
-											}
-											depth--
-											add(ruleQUESTION, position450)
+										goto l486
+									l487:
+										position, tokenIndex, depth = position487, tokenIndex487, depth487
+									}
+									{
+										position488 := position
+										depth++
+										if buffer[position] != rune('?') {
+											goto l486
 										}
-										break
-									default:
-										if !rules[ruleSTAR]() {
-											goto l446
+										position++
+										if !_rules[ruleskip]() {
+											goto l486
 										}
-										break
+										depth--
+										add(ruleQUESTION, position488)
 									}
-								}
-
-								{
-									position451, tokenIndex451, depth451 := position, tokenIndex, depth
-									if !matchDot() {
-										goto l451
+									goto l484
+								l486:
+									position, tokenIndex, depth = position484, tokenIndex484, depth484
+									if !_rules[rulePLUS]() {
+										goto l481
 									}
-									goto l446
-								l451:
-									position, tokenIndex, depth = position451, tokenIndex451, depth451
 								}
+							l484:
 								depth--
-								add(rulepathMod, position448)
+								add(rulepathMod, position483)
 							}
-							goto l447
-						l446:
-							position, tokenIndex, depth = position446, tokenIndex446, depth446
+							goto l482
+						l481:
+							position, tokenIndex, depth = position481, tokenIndex481, depth481
 						}
-					l447:
+					l482:
 						depth--
-						add(rulepathElt, position432)
+						add(rulepathElt, position466)
 					}
 					depth--
-					add(rulePegText, position431)
+					add(rulePegText, position465)
 				}
 				{
 					add(ruleAction6, position)
 				}
-			l453:
+			l490:
 				{
-					position454, tokenIndex454, depth454 := position, tokenIndex, depth
-					if !rules[ruleSLASH]() {
-						goto l454
+					position491, tokenIndex491, depth491 := position, tokenIndex, depth
+					if !_rules[ruleSLASH]() {
+						goto l491
 					}
-					if !rules[rulepathSequence]() {
-						goto l454
+					if !_rules[rulepathSequence]() {
+						goto l491
 					}
-					goto l453
-				l454:
-					position, tokenIndex, depth = position454, tokenIndex454, depth454
+					goto l490
+				l491:
+					position, tokenIndex, depth = position491, tokenIndex491, depth491
 				}
 				depth--
-				add(rulepathSequence, position430)
+				add(rulepathSequence, position464)
 			}
 			return true
-		l429:
-			position, tokenIndex, depth = position429, tokenIndex429, depth429
+		l463:
+			position, tokenIndex, depth = position463, tokenIndex463, depth463
 			return false
 		},
 		/* 41 pathElt <- <(INVERSE? pathPrimary pathMod?)> */
 		nil,
-		/* 42 pathPrimary <- <(iriref / ((&('(') (LPAREN path RPAREN)) | (&('!') (NOT pathNegatedPropertySet)) | (&('a') ISA)))> */
+		/* 42 pathPrimary <- <(iriref / ISA / (NOT pathNegatedPropertySet) / (LPAREN path RPAREN))> */
 		nil,
 		/* 43 pathNegatedPropertySet <- <(pathOneInPropertySet / (LPAREN (pathOneInPropertySet (PIPE pathOneInPropertySet)*)? RPAREN))> */
 		nil,
 		/* 44 pathOneInPropertySet <- <(iriref / ISA / (INVERSE (iriref / ISA)))> */
 		func() bool {
-			position458, tokenIndex458, depth458 := position, tokenIndex, depth
+			position495, tokenIndex495, depth495 := position, tokenIndex, depth
 			{
-				position459 := position
+				position496 := position
 				depth++
 				{
-					position460, tokenIndex460, depth460 := position, tokenIndex, depth
-					if !rules[ruleiriref]() {
-						goto l461
-					}
-					goto l460
-				l461:
-					position, tokenIndex, depth = position460, tokenIndex460, depth460
-					if !rules[ruleISA]() {
-						goto l462
-					}
-					goto l460
-				l462:
-					position, tokenIndex, depth = position460, tokenIndex460, depth460
-					if !rules[ruleINVERSE]() {
-						goto l458
+					position497, tokenIndex497, depth497 := position, tokenIndex, depth
+					if !_rules[ruleiriref]() {
+						goto l498
+					}
+					goto l497
+				l498:
+					position, tokenIndex, depth = position497, tokenIndex497, depth497
+					if !_rules[ruleISA]() {
+						goto l499
+					}
+					goto l497
+				l499:
+					position, tokenIndex, depth = position497, tokenIndex497, depth497
+					if !_rules[ruleINVERSE]() {
+						goto l495
 					}
 					{
-						position463, tokenIndex463, depth463 := position, tokenIndex, depth
-						if !rules[ruleiriref]() {
-							goto l464
+						position500, tokenIndex500, depth500 := position, tokenIndex, depth
+						if !_rules[ruleiriref]() {
+							goto l501
 						}
-						goto l463
-					l464:
-						position, tokenIndex, depth = position463, tokenIndex463, depth463
-						if !rules[ruleISA]() {
-							goto l458
+						goto l500
+					l501:
+						position, tokenIndex, depth = position500, tokenIndex500, depth500
+						if !_rules[ruleISA]() {
+							goto l495
 						}
 					}
-				l463:
+				l500:
 				}
-			l460:
+			l497:
 				depth--
-				add(rulepathOneInPropertySet, position459)
+				add(rulepathOneInPropertySet, position496)
 			}
 			return true
-		l458:
-			position, tokenIndex, depth = position458, tokenIndex458, depth458
+		l495:
+			position, tokenIndex, depth = position495, tokenIndex495, depth495
 			return false
 		},
-		/* 45 pathMod <- <(((&('+') PLUS) | (&('?') QUESTION) | (&('*') STAR)) !.)> */
+		/* 45 pathMod <- <(STAR / (!var QUESTION) / PLUS)> */
 		nil,
 		/* 46 fillObjectListPath <- <(fillObjectPath (COMMA fillObjectPath)*)> */
 		nil,
 		/* 47 fillObjectPath <- <(object / Action7)> */
 		func() bool {
 			{
-				position468 := position
+				position505 := position
 				depth++
 				{
-					position469, tokenIndex469, depth469 := position, tokenIndex, depth
-					if !rules[ruleobject]() {
-						goto l470
+					position506, tokenIndex506, depth506 := position, tokenIndex, depth
+					if !_rules[ruleobject]() {
+						goto l507
 					}
-					goto l469
-				l470:
-					position, tokenIndex, depth = position469, tokenIndex469, depth469
+					goto l506
+				l507:
+					position, tokenIndex, depth = position506, tokenIndex506, depth506
 					{
 						add(ruleAction7, position)
 					}
 				}
-			l469:
+			l506:
 				depth--
-				add(rulefillObjectPath, position468)
+				add(rulefillObjectPath, position505)
 			}
 			return true
 		},
@@ -4467,10992 +4426,11728 @@ func (p *Sparql) Init() {
 		nil,
 		/* 49 objectPath <- <((pof Action8) / object)> */
 		func() bool {
-			position473, tokenIndex473, depth473 := position, tokenIndex, depth
+			position510, tokenIndex510, depth510 := position, tokenIndex, depth
 			{
-				position474 := position
+				position511 := position
 				depth++
 				{
-					position475, tokenIndex475, depth475 := position, tokenIndex, depth
-					if !rules[rulepof]() {
-						goto l476
+					position512, tokenIndex512, depth512 := position, tokenIndex, depth
+					if !_rules[rulepof]() {
+						goto l513
 					}
 					{
 						add(ruleAction8, position)
 					}
-					goto l475
-				l476:
-					position, tokenIndex, depth = position475, tokenIndex475, depth475
-					if !rules[ruleobject]() {
-						goto l473
+					goto l512
+				l513:
+					position, tokenIndex, depth = position512, tokenIndex512, depth512
+					if !_rules[ruleobject]() {
+						goto l510
 					}
 				}
-			l475:
+			l512:
 				depth--
-				add(ruleobjectPath, position474)
+				add(ruleobjectPath, position511)
 			}
 			return true
-		l473:
-			position, tokenIndex, depth = position473, tokenIndex473, depth473
+		l510:
+			position, tokenIndex, depth = position510, tokenIndex510, depth510
 			return false
 		},
 		/* 50 object <- <(<graphNodePath> Action9)> */
 		func() bool {
-			position478, tokenIndex478, depth478 := position, tokenIndex, depth
+			position515, tokenIndex515, depth515 := position, tokenIndex, depth
 			{
-				position479 := position
+				position516 := position
 				depth++
 				{
-					position480 := position
+					position517 := position
 					depth++
-					if !rules[rulegraphNodePath]() {
-						goto l478
+					if !_rules[rulegraphNodePath]() {
+						goto l515
 					}
 					depth--
-					add(rulePegText, position480)
+					add(rulePegText, position517)
 				}
 				{
 					add(ruleAction9, position)
 				}
 				depth--
-				add(ruleobject, position479)
+				add(ruleobject, position516)
 			}
 			return true
-		l478:
-			position, tokenIndex, depth = position478, tokenIndex478, depth478
+		l515:
+			position, tokenIndex, depth = position515, tokenIndex515, depth515
 			return false
 		},
 		/* 51 graphNodePath <- <(var / graphTerm / triplesNodePath)> */
 		func() bool {
-			position482, tokenIndex482, depth482 := position, tokenIndex, depth
+			position519, tokenIndex519, depth519 := position, tokenIndex, depth
 			{
-				position483 := position
+				position520 := position
 				depth++
 				{
-					position484, tokenIndex484, depth484 := position, tokenIndex, depth
-					if !rules[rulevar]() {
-						goto l485
+					position521, tokenIndex521, depth521 := position, tokenIndex, depth
+					if !_rules[rulevar]() {
+						goto l522
 					}
-					goto l484
-				l485:
-					position, tokenIndex, depth = position484, tokenIndex484, depth484
-					if !rules[rulegraphTerm]() {
-						goto l486
+					goto l521
+				l522:
+					position, tokenIndex, depth = position521, tokenIndex521, depth521
+					if !_rules[rulegraphTerm]() {
+						goto l523
 					}
-					goto l484
-				l486:
-					position, tokenIndex, depth = position484, tokenIndex484, depth484
-					if !rules[ruletriplesNodePath]() {
-						goto l482
+					goto l521
+				l523:
+					position, tokenIndex, depth = position521, tokenIndex521, depth521
+					if !_rules[ruletriplesNodePath]() {
+						goto l519
 					}
 				}
-			l484:
+			l521:
 				depth--
-				add(rulegraphNodePath, position483)
+				add(rulegraphNodePath, position520)
 			}
 			return true
-		l482:
-			position, tokenIndex, depth = position482, tokenIndex482, depth482
+		l519:
+			position, tokenIndex, depth = position519, tokenIndex519, depth519
 			return false
 		},
-		/* 52 solutionModifier <- <((ORDER BY orderCondition+) / ((&('H' | 'h') (HAVING constraint)) | (&('G' | 'g') (GROUP BY groupCondition+)) | (&('L' | 'O' | 'l' | 'o') limitOffsetClauses)))?> */
+		/* 52 solutionModifier <- <(!{ p.modifiers = true } groupClause? havingClause? orderClause? limitOffsetClauses? !{ p.modifiers = false })> */
 		func() bool {
 			{
-				position488 := position
+				position525 := position
 				depth++
+				p.modifiers = true
 				{
-					position489, tokenIndex489, depth489 := position, tokenIndex, depth
+					position526, tokenIndex526, depth526 := position, tokenIndex, depth
 					{
-						position491, tokenIndex491, depth491 := position, tokenIndex, depth
+						position528 := position
+						depth++
 						{
-							position493 := position
+							position529 := position
 							depth++
 							{
-								position494, tokenIndex494, depth494 := position, tokenIndex, depth
-								if buffer[position] != rune('o') {
-									goto l495
+								position530, tokenIndex530, depth530 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l531
 								}
 								position++
-								goto l494
-							l495:
-								position, tokenIndex, depth = position494, tokenIndex494, depth494
-								if buffer[position] != rune('O') {
-									goto l492
+								goto l530
+							l531:
+								position, tokenIndex, depth = position530, tokenIndex530, depth530
+								if buffer[position] != rune('G') {
+									goto l526
 								}
 								position++
 							}
-						l494:
+						l530:
 							{
-								position496, tokenIndex496, depth496 := position, tokenIndex, depth
+								position532, tokenIndex532, depth532 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l497
+									goto l533
 								}
 								position++
-								goto l496
-							l497:
-								position, tokenIndex, depth = position496, tokenIndex496, depth496
+								goto l532
+							l533:
+								position, tokenIndex, depth = position532, tokenIndex532, depth532
 								if buffer[position] != rune('R') {
-									goto l492
+									goto l526
 								}
 								position++
 							}
-						l496:
+						l532:
 							{
-								position498, tokenIndex498, depth498 := position, tokenIndex, depth
-								if buffer[position] != rune('d') {
-									goto l499
+								position534, tokenIndex534, depth534 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l535
 								}
 								position++
-								goto l498
-							l499:
-								position, tokenIndex, depth = position498, tokenIndex498, depth498
-								if buffer[position] != rune('D') {
-									goto l492
+								goto l534
+							l535:
+								position, tokenIndex, depth = position534, tokenIndex534, depth534
+								if buffer[position] != rune('O') {
+									goto l526
 								}
 								position++
 							}
-						l498:
+						l534:
 							{
-								position500, tokenIndex500, depth500 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l501
+								position536, tokenIndex536, depth536 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l537
 								}
 								position++
-								goto l500
-							l501:
-								position, tokenIndex, depth = position500, tokenIndex500, depth500
-								if buffer[position] != rune('E') {
-									goto l492
+								goto l536
+							l537:
+								position, tokenIndex, depth = position536, tokenIndex536, depth536
+								if buffer[position] != rune('U') {
+									goto l526
 								}
 								position++
 							}
-						l500:
+						l536:
 							{
-								position502, tokenIndex502, depth502 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l503
+								position538, tokenIndex538, depth538 := position, tokenIndex, depth
+								if buffer[position] != rune('p') {
+									goto l539
 								}
 								position++
-								goto l502
-							l503:
-								position, tokenIndex, depth = position502, tokenIndex502, depth502
-								if buffer[position] != rune('R') {
-									goto l492
+								goto l538
+							l539:
+								position, tokenIndex, depth = position538, tokenIndex538, depth538
+								if buffer[position] != rune('P') {
+									goto l526
 								}
 								position++
 							}
-						l502:
-							if !rules[ruleskip]() {
-								goto l492
+						l538:
+							if !_rules[ruleskip]() {
+								goto l526
 							}
 							depth--
-							add(ruleORDER, position493)
+							add(ruleGROUP, position529)
 						}
-						if !rules[ruleBY]() {
-							goto l492
+						if !_rules[ruleBY]() {
+							goto l526
 						}
 						{
-							position506 := position
+							position542 := position
 							depth++
 							{
-								position507, tokenIndex507, depth507 := position, tokenIndex, depth
+								position543, tokenIndex543, depth543 := position, tokenIndex, depth
+								if !_rules[rulefunctionCall]() {
+									goto l544
+								}
+								goto l543
+							l544:
+								position, tokenIndex, depth = position543, tokenIndex543, depth543
+								if !_rules[rulebuiltinCall]() {
+									goto l545
+								}
+								goto l543
+							l545:
+								position, tokenIndex, depth = position543, tokenIndex543, depth543
+								if !_rules[ruleLPAREN]() {
+									goto l546
+								}
+								if !_rules[ruleexpression]() {
+									goto l546
+								}
+								{
+									position547, tokenIndex547, depth547 := position, tokenIndex, depth
+									if !_rules[ruleAS]() {
+										goto l547
+									}
+									if !_rules[rulevar]() {
+										goto l547
+									}
+									goto l548
+								l547:
+									position, tokenIndex, depth = position547, tokenIndex547, depth547
+								}
+							l548:
+								if !_rules[ruleRPAREN]() {
+									goto l546
+								}
+								goto l543
+							l546:
+								position, tokenIndex, depth = position543, tokenIndex543, depth543
+								if !_rules[rulevar]() {
+									goto l549
+								}
+								goto l543
+							l549:
+								position, tokenIndex, depth = position543, tokenIndex543, depth543
+								if !_rules[rulepofVariable]() {
+									goto l526
+								}
+							}
+						l543:
+							depth--
+							add(rulegroupCondition, position542)
+						}
+					l540:
+						{
+							position541, tokenIndex541, depth541 := position, tokenIndex, depth
+							{
+								position550 := position
+								depth++
 								{
-									position509, tokenIndex509, depth509 := position, tokenIndex, depth
+									position551, tokenIndex551, depth551 := position, tokenIndex, depth
+									if !_rules[rulefunctionCall]() {
+										goto l552
+									}
+									goto l551
+								l552:
+									position, tokenIndex, depth = position551, tokenIndex551, depth551
+									if !_rules[rulebuiltinCall]() {
+										goto l553
+									}
+									goto l551
+								l553:
+									position, tokenIndex, depth = position551, tokenIndex551, depth551
+									if !_rules[ruleLPAREN]() {
+										goto l554
+									}
+									if !_rules[ruleexpression]() {
+										goto l554
+									}
 									{
-										position511, tokenIndex511, depth511 := position, tokenIndex, depth
-										{
-											position513 := position
-											depth++
-											{
-												position514, tokenIndex514, depth514 := position, tokenIndex, depth
-												if buffer[position] != rune('a') {
-													goto l515
-												}
-												position++
-												goto l514
-											l515:
-												position, tokenIndex, depth = position514, tokenIndex514, depth514
-												if buffer[position] != rune('A') {
-													goto l512
-												}
-												position++
+										position555, tokenIndex555, depth555 := position, tokenIndex, depth
+										if !_rules[ruleAS]() {
+											goto l555
+										}
+										if !_rules[rulevar]() {
+											goto l555
+										}
+										goto l556
+									l555:
+										position, tokenIndex, depth = position555, tokenIndex555, depth555
+									}
+								l556:
+									if !_rules[ruleRPAREN]() {
+										goto l554
+									}
+									goto l551
+								l554:
+									position, tokenIndex, depth = position551, tokenIndex551, depth551
+									if !_rules[rulevar]() {
+										goto l557
+									}
+									goto l551
+								l557:
+									position, tokenIndex, depth = position551, tokenIndex551, depth551
+									if !_rules[rulepofVariable]() {
+										goto l541
+									}
+								}
+							l551:
+								depth--
+								add(rulegroupCondition, position550)
+							}
+							goto l540
+						l541:
+							position, tokenIndex, depth = position541, tokenIndex541, depth541
+						}
+						depth--
+						add(rulegroupClause, position528)
+					}
+					goto l527
+				l526:
+					position, tokenIndex, depth = position526, tokenIndex526, depth526
+				}
+			l527:
+				{
+					position558, tokenIndex558, depth558 := position, tokenIndex, depth
+					{
+						position560 := position
+						depth++
+						{
+							position561 := position
+							depth++
+							{
+								position562, tokenIndex562, depth562 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l563
+								}
+								position++
+								goto l562
+							l563:
+								position, tokenIndex, depth = position562, tokenIndex562, depth562
+								if buffer[position] != rune('H') {
+									goto l558
+								}
+								position++
+							}
+						l562:
+							{
+								position564, tokenIndex564, depth564 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l565
+								}
+								position++
+								goto l564
+							l565:
+								position, tokenIndex, depth = position564, tokenIndex564, depth564
+								if buffer[position] != rune('A') {
+									goto l558
+								}
+								position++
+							}
+						l564:
+							{
+								position566, tokenIndex566, depth566 := position, tokenIndex, depth
+								if buffer[position] != rune('v') {
+									goto l567
+								}
+								position++
+								goto l566
+							l567:
+								position, tokenIndex, depth = position566, tokenIndex566, depth566
+								if buffer[position] != rune('V') {
+									goto l558
+								}
+								position++
+							}
+						l566:
+							{
+								position568, tokenIndex568, depth568 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l569
+								}
+								position++
+								goto l568
+							l569:
+								position, tokenIndex, depth = position568, tokenIndex568, depth568
+								if buffer[position] != rune('I') {
+									goto l558
+								}
+								position++
+							}
+						l568:
+							{
+								position570, tokenIndex570, depth570 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l571
+								}
+								position++
+								goto l570
+							l571:
+								position, tokenIndex, depth = position570, tokenIndex570, depth570
+								if buffer[position] != rune('N') {
+									goto l558
+								}
+								position++
+							}
+						l570:
+							{
+								position572, tokenIndex572, depth572 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l573
+								}
+								position++
+								goto l572
+							l573:
+								position, tokenIndex, depth = position572, tokenIndex572, depth572
+								if buffer[position] != rune('G') {
+									goto l558
+								}
+								position++
+							}
+						l572:
+							if !_rules[ruleskip]() {
+								goto l558
+							}
+							depth--
+							add(ruleHAVING, position561)
+						}
+						if !_rules[ruleconstraint]() {
+							goto l558
+						}
+					l574:
+						{
+							position575, tokenIndex575, depth575 := position, tokenIndex, depth
+							if !_rules[ruleconstraint]() {
+								goto l575
+							}
+							goto l574
+						l575:
+							position, tokenIndex, depth = position575, tokenIndex575, depth575
+						}
+						depth--
+						add(rulehavingClause, position560)
+					}
+					goto l559
+				l558:
+					position, tokenIndex, depth = position558, tokenIndex558, depth558
+				}
+			l559:
+				{
+					position576, tokenIndex576, depth576 := position, tokenIndex, depth
+					{
+						position578 := position
+						depth++
+						{
+							position579 := position
+							depth++
+							{
+								position580, tokenIndex580, depth580 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l581
+								}
+								position++
+								goto l580
+							l581:
+								position, tokenIndex, depth = position580, tokenIndex580, depth580
+								if buffer[position] != rune('O') {
+									goto l576
+								}
+								position++
+							}
+						l580:
+							{
+								position582, tokenIndex582, depth582 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l583
+								}
+								position++
+								goto l582
+							l583:
+								position, tokenIndex, depth = position582, tokenIndex582, depth582
+								if buffer[position] != rune('R') {
+									goto l576
+								}
+								position++
+							}
+						l582:
+							{
+								position584, tokenIndex584, depth584 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l585
+								}
+								position++
+								goto l584
+							l585:
+								position, tokenIndex, depth = position584, tokenIndex584, depth584
+								if buffer[position] != rune('D') {
+									goto l576
+								}
+								position++
+							}
+						l584:
+							{
+								position586, tokenIndex586, depth586 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l587
+								}
+								position++
+								goto l586
+							l587:
+								position, tokenIndex, depth = position586, tokenIndex586, depth586
+								if buffer[position] != rune('E') {
+									goto l576
+								}
+								position++
+							}
+						l586:
+							{
+								position588, tokenIndex588, depth588 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l589
+								}
+								position++
+								goto l588
+							l589:
+								position, tokenIndex, depth = position588, tokenIndex588, depth588
+								if buffer[position] != rune('R') {
+									goto l576
+								}
+								position++
+							}
+						l588:
+							if !_rules[ruleskip]() {
+								goto l576
+							}
+							depth--
+							add(ruleORDER, position579)
+						}
+						if !_rules[ruleBY]() {
+							goto l576
+						}
+						{
+							position592 := position
+							depth++
+							{
+								position593, tokenIndex593, depth593 := position, tokenIndex, depth
+								{
+									position595, tokenIndex595, depth595 := position, tokenIndex, depth
+									{
+										position597, tokenIndex597, depth597 := position, tokenIndex, depth
+										{
+											position599 := position
+											depth++
+											{
+												position600, tokenIndex600, depth600 := position, tokenIndex, depth
+												if buffer[position] != rune('a') {
+													goto l601
+												}
+												position++
+												goto l600
+											l601:
+												position, tokenIndex, depth = position600, tokenIndex600, depth600
+												if buffer[position] != rune('A') {
+													goto l598
+												}
+												position++
 											}
-										l514:
+										l600:
 											{
-												position516, tokenIndex516, depth516 := position, tokenIndex, depth
+												position602, tokenIndex602, depth602 := position, tokenIndex, depth
 												if buffer[position] != rune('s') {
-													goto l517
+													goto l603
 												}
 												position++
-												goto l516
-											l517:
-												position, tokenIndex, depth = position516, tokenIndex516, depth516
+												goto l602
+											l603:
+												position, tokenIndex, depth = position602, tokenIndex602, depth602
 												if buffer[position] != rune('S') {
-													goto l512
+													goto l598
 												}
 												position++
 											}
-										l516:
+										l602:
 											{
-												position518, tokenIndex518, depth518 := position, tokenIndex, depth
+												position604, tokenIndex604, depth604 := position, tokenIndex, depth
 												if buffer[position] != rune('c') {
-													goto l519
+													goto l605
 												}
 												position++
-												goto l518
-											l519:
-												position, tokenIndex, depth = position518, tokenIndex518, depth518
+												goto l604
+											l605:
+												position, tokenIndex, depth = position604, tokenIndex604, depth604
 												if buffer[position] != rune('C') {
-													goto l512
+													goto l598
 												}
 												position++
 											}
-										l518:
-											if !rules[ruleskip]() {
-												goto l512
+										l604:
+											if !_rules[ruleskip]() {
+												goto l598
 											}
 											depth--
-											add(ruleASC, position513)
+											add(ruleASC, position599)
 										}
-										goto l511
-									l512:
-										position, tokenIndex, depth = position511, tokenIndex511, depth511
+										goto l597
+									l598:
+										position, tokenIndex, depth = position597, tokenIndex597, depth597
 										{
-											position520 := position
+											position606 := position
 											depth++
 											{
-												position521, tokenIndex521, depth521 := position, tokenIndex, depth
+												position607, tokenIndex607, depth607 := position, tokenIndex, depth
 												if buffer[position] != rune('d') {
-													goto l522
+													goto l608
 												}
 												position++
-												goto l521
-											l522:
-												position, tokenIndex, depth = position521, tokenIndex521, depth521
+												goto l607
+											l608:
+												position, tokenIndex, depth = position607, tokenIndex607, depth607
 												if buffer[position] != rune('D') {
-													goto l509
+													goto l595
 												}
 												position++
 											}
-										l521:
+										l607:
 											{
-												position523, tokenIndex523, depth523 := position, tokenIndex, depth
+												position609, tokenIndex609, depth609 := position, tokenIndex, depth
 												if buffer[position] != rune('e') {
-													goto l524
+													goto l610
 												}
 												position++
-												goto l523
-											l524:
-												position, tokenIndex, depth = position523, tokenIndex523, depth523
+												goto l609
+											l610:
+												position, tokenIndex, depth = position609, tokenIndex609, depth609
 												if buffer[position] != rune('E') {
-													goto l509
+													goto l595
 												}
 												position++
 											}
-										l523:
+										l609:
 											{
-												position525, tokenIndex525, depth525 := position, tokenIndex, depth
+												position611, tokenIndex611, depth611 := position, tokenIndex, depth
 												if buffer[position] != rune('s') {
-													goto l526
+													goto l612
 												}
 												position++
-												goto l525
-											l526:
-												position, tokenIndex, depth = position525, tokenIndex525, depth525
+												goto l611
+											l612:
+												position, tokenIndex, depth = position611, tokenIndex611, depth611
 												if buffer[position] != rune('S') {
-													goto l509
+													goto l595
 												}
 												position++
 											}
-										l525:
+										l611:
 											{
-												position527, tokenIndex527, depth527 := position, tokenIndex, depth
+												position613, tokenIndex613, depth613 := position, tokenIndex, depth
 												if buffer[position] != rune('c') {
-													goto l528
+													goto l614
 												}
 												position++
-												goto l527
-											l528:
-												position, tokenIndex, depth = position527, tokenIndex527, depth527
+												goto l613
+											l614:
+												position, tokenIndex, depth = position613, tokenIndex613, depth613
 												if buffer[position] != rune('C') {
-													goto l509
+													goto l595
 												}
 												position++
 											}
-										l527:
-											if !rules[ruleskip]() {
-												goto l509
+										l613:
+											if !_rules[ruleskip]() {
+												goto l595
 											}
 											depth--
-											add(ruleDESC, position520)
+											add(ruleDESC, position606)
 										}
 									}
-								l511:
-									goto l510
-								l509:
-									position, tokenIndex, depth = position509, tokenIndex509, depth509
+								l597:
+									goto l596
+								l595:
+									position, tokenIndex, depth = position595, tokenIndex595, depth595
 								}
-							l510:
-								if !rules[rulebrackettedExpression]() {
-									goto l508
+							l596:
+								if !_rules[rulebrackettedExpression]() {
+									goto l594
 								}
-								goto l507
-							l508:
-								position, tokenIndex, depth = position507, tokenIndex507, depth507
-								if !rules[rulefunctionCall]() {
-									goto l529
+								goto l593
+							l594:
+								position, tokenIndex, depth = position593, tokenIndex593, depth593
+								if !_rules[rulefunctionCall]() {
+									goto l615
 								}
-								goto l507
-							l529:
-								position, tokenIndex, depth = position507, tokenIndex507, depth507
-								if !rules[rulebuiltinCall]() {
-									goto l530
+								goto l593
+							l615:
+								position, tokenIndex, depth = position593, tokenIndex593, depth593
+								if !_rules[rulebuiltinCall]() {
+									goto l616
 								}
-								goto l507
-							l530:
-								position, tokenIndex, depth = position507, tokenIndex507, depth507
-								if !rules[rulevar]() {
-									goto l492
+								goto l593
+							l616:
+								position, tokenIndex, depth = position593, tokenIndex593, depth593
+								if !_rules[rulevar]() {
+									goto l617
+								}
+								goto l593
+							l617:
+								position, tokenIndex, depth = position593, tokenIndex593, depth593
+								if !_rules[rulepofVariable]() {
+									goto l576
 								}
 							}
-						l507:
+						l593:
 							depth--
-							add(ruleorderCondition, position506)
+							add(ruleorderCondition, position592)
 						}
-					l504:
+					l590:
 						{
-							position505, tokenIndex505, depth505 := position, tokenIndex, depth
+							position591, tokenIndex591, depth591 := position, tokenIndex, depth
 							{
-								position531 := position
+								position618 := position
 								depth++
 								{
-									position532, tokenIndex532, depth532 := position, tokenIndex, depth
+									position619, tokenIndex619, depth619 := position, tokenIndex, depth
 									{
-										position534, tokenIndex534, depth534 := position, tokenIndex, depth
+										position621, tokenIndex621, depth621 := position, tokenIndex, depth
 										{
-											position536, tokenIndex536, depth536 := position, tokenIndex, depth
+											position623, tokenIndex623, depth623 := position, tokenIndex, depth
 											{
-												position538 := position
+												position625 := position
 												depth++
 												{
-													position539, tokenIndex539, depth539 := position, tokenIndex, depth
+													position626, tokenIndex626, depth626 := position, tokenIndex, depth
 													if buffer[position] != rune('a') {
-														goto l540
+														goto l627
 													}
 													position++
-													goto l539
-												l540:
-													position, tokenIndex, depth = position539, tokenIndex539, depth539
+													goto l626
+												l627:
+													position, tokenIndex, depth = position626, tokenIndex626, depth626
 													if buffer[position] != rune('A') {
-														goto l537
+														goto l624
 													}
 													position++
 												}
-											l539:
+											l626:
 												{
-													position541, tokenIndex541, depth541 := position, tokenIndex, depth
+													position628, tokenIndex628, depth628 := position, tokenIndex, depth
 													if buffer[position] != rune('s') {
-														goto l542
+														goto l629
 													}
 													position++
-													goto l541
-												l542:
-													position, tokenIndex, depth = position541, tokenIndex541, depth541
+													goto l628
+												l629:
+													position, tokenIndex, depth = position628, tokenIndex628, depth628
 													if buffer[position] != rune('S') {
-														goto l537
+														goto l624
 													}
 													position++
 												}
-											l541:
+											l628:
 												{
-													position543, tokenIndex543, depth543 := position, tokenIndex, depth
+													position630, tokenIndex630, depth630 := position, tokenIndex, depth
 													if buffer[position] != rune('c') {
-														goto l544
+														goto l631
 													}
 													position++
-													goto l543
-												l544:
-													position, tokenIndex, depth = position543, tokenIndex543, depth543
+													goto l630
+												l631:
+													position, tokenIndex, depth = position630, tokenIndex630, depth630
 													if buffer[position] != rune('C') {
-														goto l537
+														goto l624
 													}
 													position++
 												}
-											l543:
-												if !rules[ruleskip]() {
-													goto l537
+											l630:
+												if !_rules[ruleskip]() {
+													goto l624
 												}
 												depth--
-												add(ruleASC, position538)
+												add(ruleASC, position625)
 											}
-											goto l536
-										l537:
-											position, tokenIndex, depth = position536, tokenIndex536, depth536
+											goto l623
+										l624:
+											position, tokenIndex, depth = position623, tokenIndex623, depth623
 											{
-												position545 := position
+												position632 := position
 												depth++
 												{
-													position546, tokenIndex546, depth546 := position, tokenIndex, depth
+													position633, tokenIndex633, depth633 := position, tokenIndex, depth
 													if buffer[position] != rune('d') {
-														goto l547
+														goto l634
 													}
 													position++
-													goto l546
-												l547:
-													position, tokenIndex, depth = position546, tokenIndex546, depth546
+													goto l633
+												l634:
+													position, tokenIndex, depth = position633, tokenIndex633, depth633
 													if buffer[position] != rune('D') {
-														goto l534
+														goto l621
 													}
 													position++
 												}
-											l546:
+											l633:
 												{
-													position548, tokenIndex548, depth548 := position, tokenIndex, depth
+													position635, tokenIndex635, depth635 := position, tokenIndex, depth
 													if buffer[position] != rune('e') {
-														goto l549
+														goto l636
 													}
 													position++
-													goto l548
-												l549:
-													position, tokenIndex, depth = position548, tokenIndex548, depth548
+													goto l635
+												l636:
+													position, tokenIndex, depth = position635, tokenIndex635, depth635
 													if buffer[position] != rune('E') {
-														goto l534
+														goto l621
 													}
 													position++
 												}
-											l548:
+											l635:
 												{
-													position550, tokenIndex550, depth550 := position, tokenIndex, depth
+													position637, tokenIndex637, depth637 := position, tokenIndex, depth
 													if buffer[position] != rune('s') {
-														goto l551
+														goto l638
 													}
 													position++
-													goto l550
-												l551:
-													position, tokenIndex, depth = position550, tokenIndex550, depth550
+													goto l637
+												l638:
+													position, tokenIndex, depth = position637, tokenIndex637, depth637
 													if buffer[position] != rune('S') {
-														goto l534
+														goto l621
 													}
 													position++
 												}
-											l550:
+											l637:
 												{
-													position552, tokenIndex552, depth552 := position, tokenIndex, depth
+													position639, tokenIndex639, depth639 := position, tokenIndex, depth
 													if buffer[position] != rune('c') {
-														goto l553
+														goto l640
 													}
 													position++
-													goto l552
-												l553:
-													position, tokenIndex, depth = position552, tokenIndex552, depth552
+													goto l639
+												l640:
+													position, tokenIndex, depth = position639, tokenIndex639, depth639
 													if buffer[position] != rune('C') {
-														goto l534
+														goto l621
 													}
 													position++
 												}
-											l552:
-												if !rules[ruleskip]() {
-													goto l534
+											l639:
+												if !_rules[ruleskip]() {
+													goto l621
 												}
 												depth--
-												add(ruleDESC, position545)
+												add(ruleDESC, position632)
 											}
 										}
-									l536:
-										goto l535
-									l534:
-										position, tokenIndex, depth = position534, tokenIndex534, depth534
-									}
-								l535:
-									if !rules[rulebrackettedExpression]() {
-										goto l533
-									}
-									goto l532
-								l533:
-									position, tokenIndex, depth = position532, tokenIndex532, depth532
-									if !rules[rulefunctionCall]() {
-										goto l554
-									}
-									goto l532
-								l554:
-									position, tokenIndex, depth = position532, tokenIndex532, depth532
-									if !rules[rulebuiltinCall]() {
-										goto l555
+									l623:
+										goto l622
+									l621:
+										position, tokenIndex, depth = position621, tokenIndex621, depth621
+									}
+								l622:
+									if !_rules[rulebrackettedExpression]() {
+										goto l620
+									}
+									goto l619
+								l620:
+									position, tokenIndex, depth = position619, tokenIndex619, depth619
+									if !_rules[rulefunctionCall]() {
+										goto l641
+									}
+									goto l619
+								l641:
+									position, tokenIndex, depth = position619, tokenIndex619, depth619
+									if !_rules[rulebuiltinCall]() {
+										goto l642
+									}
+									goto l619
+								l642:
+									position, tokenIndex, depth = position619, tokenIndex619, depth619
+									if !_rules[rulevar]() {
+										goto l643
 									}
-									goto l532
-								l555:
-									position, tokenIndex, depth = position532, tokenIndex532, depth532
-									if !rules[rulevar]() {
-										goto l505
+									goto l619
+								l643:
+									position, tokenIndex, depth = position619, tokenIndex619, depth619
+									if !_rules[rulepofVariable]() {
+										goto l591
 									}
 								}
-							l532:
+							l619:
 								depth--
-								add(ruleorderCondition, position531)
+								add(ruleorderCondition, position618)
 							}
-							goto l504
-						l505:
-							position, tokenIndex, depth = position505, tokenIndex505, depth505
+							goto l590
+						l591:
+							position, tokenIndex, depth = position591, tokenIndex591, depth591
 						}
-						goto l491
-					l492:
-						position, tokenIndex, depth = position491, tokenIndex491, depth491
+						depth--
+						add(ruleorderClause, position578)
+					}
+					goto l577
+				l576:
+					position, tokenIndex, depth = position576, tokenIndex576, depth576
+				}
+			l577:
+				{
+					position644, tokenIndex644, depth644 := position, tokenIndex, depth
+					{
+						position646 := position
+						depth++
 						{
-							switch buffer[position] {
-							case 'H', 'h':
-								{
-									position557 := position
-									depth++
-									{
-										position558, tokenIndex558, depth558 := position, tokenIndex, depth
-										if buffer[position] != rune('h') {
-											goto l559
-										}
-										position++
-										goto l558
-									l559:
-										position, tokenIndex, depth = position558, tokenIndex558, depth558
-										if buffer[position] != rune('H') {
-											goto l489
-										}
-										position++
-									}
-								l558:
-									{
-										position560, tokenIndex560, depth560 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l561
-										}
-										position++
-										goto l560
-									l561:
-										position, tokenIndex, depth = position560, tokenIndex560, depth560
-										if buffer[position] != rune('A') {
-											goto l489
-										}
-										position++
-									}
-								l560:
-									{
-										position562, tokenIndex562, depth562 := position, tokenIndex, depth
-										if buffer[position] != rune('v') {
-											goto l563
-										}
-										position++
-										goto l562
-									l563:
-										position, tokenIndex, depth = position562, tokenIndex562, depth562
-										if buffer[position] != rune('V') {
-											goto l489
-										}
-										position++
-									}
-								l562:
-									{
-										position564, tokenIndex564, depth564 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l565
-										}
-										position++
-										goto l564
-									l565:
-										position, tokenIndex, depth = position564, tokenIndex564, depth564
-										if buffer[position] != rune('I') {
-											goto l489
-										}
-										position++
-									}
-								l564:
-									{
-										position566, tokenIndex566, depth566 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l567
-										}
-										position++
-										goto l566
-									l567:
-										position, tokenIndex, depth = position566, tokenIndex566, depth566
-										if buffer[position] != rune('N') {
-											goto l489
-										}
-										position++
-									}
-								l566:
-									{
-										position568, tokenIndex568, depth568 := position, tokenIndex, depth
-										if buffer[position] != rune('g') {
-											goto l569
-										}
-										position++
-										goto l568
-									l569:
-										position, tokenIndex, depth = position568, tokenIndex568, depth568
-										if buffer[position] != rune('G') {
-											goto l489
-										}
-										position++
-									}
-								l568:
-									if !rules[ruleskip]() {
-										goto l489
-									}
-									depth--
-									add(ruleHAVING, position557)
+							position647, tokenIndex647, depth647 := position, tokenIndex, depth
+							if !_rules[rulelimit]() {
+								goto l648
+							}
+							{
+								position649, tokenIndex649, depth649 := position, tokenIndex, depth
+								if !_rules[ruleoffset]() {
+									goto l649
 								}
-								if !rules[ruleconstraint]() {
-									goto l489
+								goto l650
+							l649:
+								position, tokenIndex, depth = position649, tokenIndex649, depth649
+							}
+						l650:
+							goto l647
+						l648:
+							position, tokenIndex, depth = position647, tokenIndex647, depth647
+							if !_rules[ruleoffset]() {
+								goto l644
+							}
+							{
+								position651, tokenIndex651, depth651 := position, tokenIndex, depth
+								if !_rules[rulelimit]() {
+									goto l651
 								}
-								break
-							case 'G', 'g':
-								{
-									position570 := position
-									depth++
-									{
-										position571, tokenIndex571, depth571 := position, tokenIndex, depth
-										if buffer[position] != rune('g') {
-											goto l572
-										}
-										position++
-										goto l571
-									l572:
-										position, tokenIndex, depth = position571, tokenIndex571, depth571
-										if buffer[position] != rune('G') {
-											goto l489
-										}
-										position++
-									}
-								l571:
-									{
-										position573, tokenIndex573, depth573 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l574
-										}
-										position++
-										goto l573
-									l574:
-										position, tokenIndex, depth = position573, tokenIndex573, depth573
-										if buffer[position] != rune('R') {
-											goto l489
-										}
-										position++
-									}
-								l573:
-									{
-										position575, tokenIndex575, depth575 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l576
-										}
-										position++
-										goto l575
-									l576:
-										position, tokenIndex, depth = position575, tokenIndex575, depth575
-										if buffer[position] != rune('O') {
-											goto l489
-										}
-										position++
-									}
-								l575:
-									{
-										position577, tokenIndex577, depth577 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l578
-										}
-										position++
-										goto l577
-									l578:
-										position, tokenIndex, depth = position577, tokenIndex577, depth577
-										if buffer[position] != rune('U') {
-											goto l489
-										}
-										position++
-									}
-								l577:
-									{
-										position579, tokenIndex579, depth579 := position, tokenIndex, depth
-										if buffer[position] != rune('p') {
-											goto l580
-										}
-										position++
-										goto l579
-									l580:
-										position, tokenIndex, depth = position579, tokenIndex579, depth579
-										if buffer[position] != rune('P') {
-											goto l489
-										}
-										position++
-									}
-								l579:
-									if !rules[ruleskip]() {
-										goto l489
-									}
-									depth--
-									add(ruleGROUP, position570)
-								}
-								if !rules[ruleBY]() {
-									goto l489
-								}
-								{
-									position583 := position
-									depth++
-									{
-										position584, tokenIndex584, depth584 := position, tokenIndex, depth
-										if !rules[rulefunctionCall]() {
-											goto l585
-										}
-										goto l584
-									l585:
-										position, tokenIndex, depth = position584, tokenIndex584, depth584
-										{
-											switch buffer[position] {
-											case '$', '?':
-												if !rules[rulevar]() {
-													goto l489
-												}
-												break
-											case '(':
-												if !rules[ruleLPAREN]() {
-													goto l489
-												}
-												if !rules[ruleexpression]() {
-													goto l489
-												}
-												{
-													position587, tokenIndex587, depth587 := position, tokenIndex, depth
-													if !rules[ruleAS]() {
-														goto l587
-													}
-													if !rules[rulevar]() {
-														goto l587
-													}
-													goto l588
-												l587:
-													position, tokenIndex, depth = position587, tokenIndex587, depth587
-												}
-											l588:
-												if !rules[ruleRPAREN]() {
-													goto l489
-												}
-												break
-											default:
-												if !rules[rulebuiltinCall]() {
-													goto l489
-												}
-												break
-											}
-										}
-
-									}
-								l584:
-									depth--
-									add(rulegroupCondition, position583)
-								}
-							l581:
-								{
-									position582, tokenIndex582, depth582 := position, tokenIndex, depth
-									{
-										position589 := position
-										depth++
-										{
-											position590, tokenIndex590, depth590 := position, tokenIndex, depth
-											if !rules[rulefunctionCall]() {
-												goto l591
-											}
-											goto l590
-										l591:
-											position, tokenIndex, depth = position590, tokenIndex590, depth590
-											{
-												switch buffer[position] {
-												case '$', '?':
-													if !rules[rulevar]() {
-														goto l582
-													}
-													break
-												case '(':
-													if !rules[ruleLPAREN]() {
-														goto l582
-													}
-													if !rules[ruleexpression]() {
-														goto l582
-													}
-													{
-														position593, tokenIndex593, depth593 := position, tokenIndex, depth
-														if !rules[ruleAS]() {
-															goto l593
-														}
-														if !rules[rulevar]() {
-															goto l593
-														}
-														goto l594
-													l593:
-														position, tokenIndex, depth = position593, tokenIndex593, depth593
-													}
-												l594:
-													if !rules[ruleRPAREN]() {
-														goto l582
-													}
-													break
-												default:
-													if !rules[rulebuiltinCall]() {
-														goto l582
-													}
-													break
-												}
-											}
-
-										}
-									l590:
-										depth--
-										add(rulegroupCondition, position589)
-									}
-									goto l581
-								l582:
-									position, tokenIndex, depth = position582, tokenIndex582, depth582
-								}
-								break
-							default:
-								{
-									position595 := position
-									depth++
-									{
-										position596, tokenIndex596, depth596 := position, tokenIndex, depth
-										if !rules[rulelimit]() {
-											goto l597
-										}
-										{
-											position598, tokenIndex598, depth598 := position, tokenIndex, depth
-											if !rules[ruleoffset]() {
-												goto l598
-											}
-											goto l599
-										l598:
-											position, tokenIndex, depth = position598, tokenIndex598, depth598
-										}
-									l599:
-										goto l596
-									l597:
-										position, tokenIndex, depth = position596, tokenIndex596, depth596
-										if !rules[ruleoffset]() {
-											goto l489
-										}
-										{
-											position600, tokenIndex600, depth600 := position, tokenIndex, depth
-											if !rules[rulelimit]() {
-												goto l600
-											}
-											goto l601
-										l600:
-											position, tokenIndex, depth = position600, tokenIndex600, depth600
-										}
-									l601:
-									}
-								l596:
-									depth--
-									add(rulelimitOffsetClauses, position595)
-								}
-								break
+								goto l652
+							l651:
+								position, tokenIndex, depth = position651, tokenIndex651, depth651
 							}
+						l652:
 						}
-
+					l647:
+						depth--
+						add(rulelimitOffsetClauses, position646)
 					}
-				l491:
-					goto l490
-				l489:
-					position, tokenIndex, depth = position489, tokenIndex489, depth489
+					goto l645
+				l644:
+					position, tokenIndex, depth = position644, tokenIndex644, depth644
 				}
-			l490:
+			l645:
+				p.modifiers = false
 				depth--
-				add(rulesolutionModifier, position488)
+				add(rulesolutionModifier, position525)
 			}
 			return true
 		},
-		/* 53 groupCondition <- <(functionCall / ((&('$' | '?') var) | (&('(') (LPAREN expression (AS var)? RPAREN)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'H' | 'I' | 'L' | 'M' | 'N' | 'R' | 'S' | 'T' | 'U' | 'Y' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'h' | 'i' | 'l' | 'm' | 'n' | 'r' | 's' | 't' | 'u' | 'y') builtinCall)))> */
+		/* 53 groupClause <- <(GROUP BY groupCondition+)> */
+		nil,
+		/* 54 havingClause <- <(HAVING constraint+)> */
+		nil,
+		/* 55 orderClause <- <(ORDER BY orderCondition+)> */
+		nil,
+		/* 56 groupCondition <- <(functionCall / builtinCall / (LPAREN expression (AS var)? RPAREN) / var / pofVariable)> */
 		nil,
-		/* 54 orderCondition <- <(((ASC / DESC)? brackettedExpression) / functionCall / builtinCall / var)> */
+		/* 57 orderCondition <- <(((ASC / DESC)? brackettedExpression) / functionCall / builtinCall / var / pofVariable)> */
 		nil,
-		/* 55 limitOffsetClauses <- <((limit offset?) / (offset limit?))> */
+		/* 58 limitOffsetClauses <- <((limit offset?) / (offset limit?))> */
 		nil,
-		/* 56 limit <- <(LIMIT INTEGER)> */
+		/* 59 limit <- <(LIMIT INTEGER)> */
 		func() bool {
-			position605, tokenIndex605, depth605 := position, tokenIndex, depth
+			position659, tokenIndex659, depth659 := position, tokenIndex, depth
 			{
-				position606 := position
+				position660 := position
 				depth++
 				{
-					position607 := position
+					position661 := position
 					depth++
 					{
-						position608, tokenIndex608, depth608 := position, tokenIndex, depth
+						position662, tokenIndex662, depth662 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l609
+							goto l663
 						}
 						position++
-						goto l608
-					l609:
-						position, tokenIndex, depth = position608, tokenIndex608, depth608
+						goto l662
+					l663:
+						position, tokenIndex, depth = position662, tokenIndex662, depth662
 						if buffer[position] != rune('L') {
-							goto l605
+							goto l659
 						}
 						position++
 					}
-				l608:
+				l662:
 					{
-						position610, tokenIndex610, depth610 := position, tokenIndex, depth
+						position664, tokenIndex664, depth664 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l611
+							goto l665
 						}
 						position++
-						goto l610
-					l611:
-						position, tokenIndex, depth = position610, tokenIndex610, depth610
+						goto l664
+					l665:
+						position, tokenIndex, depth = position664, tokenIndex664, depth664
 						if buffer[position] != rune('I') {
-							goto l605
+							goto l659
 						}
 						position++
 					}
-				l610:
+				l664:
 					{
-						position612, tokenIndex612, depth612 := position, tokenIndex, depth
+						position666, tokenIndex666, depth666 := position, tokenIndex, depth
 						if buffer[position] != rune('m') {
-							goto l613
+							goto l667
 						}
 						position++
-						goto l612
-					l613:
-						position, tokenIndex, depth = position612, tokenIndex612, depth612
+						goto l666
+					l667:
+						position, tokenIndex, depth = position666, tokenIndex666, depth666
 						if buffer[position] != rune('M') {
-							goto l605
+							goto l659
 						}
 						position++
 					}
-				l612:
+				l666:
 					{
-						position614, tokenIndex614, depth614 := position, tokenIndex, depth
+						position668, tokenIndex668, depth668 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l615
+							goto l669
 						}
 						position++
-						goto l614
-					l615:
-						position, tokenIndex, depth = position614, tokenIndex614, depth614
+						goto l668
+					l669:
+						position, tokenIndex, depth = position668, tokenIndex668, depth668
 						if buffer[position] != rune('I') {
-							goto l605
+							goto l659
 						}
 						position++
 					}
-				l614:
+				l668:
 					{
-						position616, tokenIndex616, depth616 := position, tokenIndex, depth
+						position670, tokenIndex670, depth670 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l617
+							goto l671
 						}
 						position++
-						goto l616
-					l617:
-						position, tokenIndex, depth = position616, tokenIndex616, depth616
+						goto l670
+					l671:
+						position, tokenIndex, depth = position670, tokenIndex670, depth670
 						if buffer[position] != rune('T') {
-							goto l605
+							goto l659
 						}
 						position++
 					}
-				l616:
-					if !rules[ruleskip]() {
-						goto l605
+				l670:
+					if !_rules[ruleskip]() {
+						goto l659
 					}
 					depth--
-					add(ruleLIMIT, position607)
+					add(ruleLIMIT, position661)
 				}
-				if !rules[ruleINTEGER]() {
-					goto l605
+				if !_rules[ruleINTEGER]() {
+					goto l659
 				}
 				depth--
-				add(rulelimit, position606)
+				add(rulelimit, position660)
 			}
 			return true
-		l605:
-			position, tokenIndex, depth = position605, tokenIndex605, depth605
+		l659:
+			position, tokenIndex, depth = position659, tokenIndex659, depth659
 			return false
 		},
-		/* 57 offset <- <(OFFSET INTEGER)> */
+		/* 60 offset <- <(OFFSET INTEGER)> */
 		func() bool {
-			position618, tokenIndex618, depth618 := position, tokenIndex, depth
+			position672, tokenIndex672, depth672 := position, tokenIndex, depth
 			{
-				position619 := position
+				position673 := position
 				depth++
 				{
-					position620 := position
+					position674 := position
 					depth++
 					{
-						position621, tokenIndex621, depth621 := position, tokenIndex, depth
+						position675, tokenIndex675, depth675 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l622
+							goto l676
 						}
 						position++
-						goto l621
-					l622:
-						position, tokenIndex, depth = position621, tokenIndex621, depth621
+						goto l675
+					l676:
+						position, tokenIndex, depth = position675, tokenIndex675, depth675
 						if buffer[position] != rune('O') {
-							goto l618
+							goto l672
 						}
 						position++
 					}
-				l621:
+				l675:
 					{
-						position623, tokenIndex623, depth623 := position, tokenIndex, depth
+						position677, tokenIndex677, depth677 := position, tokenIndex, depth
 						if buffer[position] != rune('f') {
-							goto l624
+							goto l678
 						}
 						position++
-						goto l623
-					l624:
-						position, tokenIndex, depth = position623, tokenIndex623, depth623
+						goto l677
+					l678:
+						position, tokenIndex, depth = position677, tokenIndex677, depth677
 						if buffer[position] != rune('F') {
-							goto l618
+							goto l672
 						}
 						position++
 					}
-				l623:
+				l677:
 					{
-						position625, tokenIndex625, depth625 := position, tokenIndex, depth
+						position679, tokenIndex679, depth679 := position, tokenIndex, depth
 						if buffer[position] != rune('f') {
-							goto l626
+							goto l680
 						}
 						position++
-						goto l625
-					l626:
-						position, tokenIndex, depth = position625, tokenIndex625, depth625
+						goto l679
+					l680:
+						position, tokenIndex, depth = position679, tokenIndex679, depth679
 						if buffer[position] != rune('F') {
-							goto l618
+							goto l672
 						}
 						position++
 					}
-				l625:
+				l679:
 					{
-						position627, tokenIndex627, depth627 := position, tokenIndex, depth
+						position681, tokenIndex681, depth681 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l628
+							goto l682
 						}
 						position++
-						goto l627
-					l628:
-						position, tokenIndex, depth = position627, tokenIndex627, depth627
+						goto l681
+					l682:
+						position, tokenIndex, depth = position681, tokenIndex681, depth681
 						if buffer[position] != rune('S') {
-							goto l618
+							goto l672
 						}
 						position++
 					}
-				l627:
+				l681:
 					{
-						position629, tokenIndex629, depth629 := position, tokenIndex, depth
+						position683, tokenIndex683, depth683 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l630
+							goto l684
 						}
 						position++
-						goto l629
-					l630:
-						position, tokenIndex, depth = position629, tokenIndex629, depth629
+						goto l683
+					l684:
+						position, tokenIndex, depth = position683, tokenIndex683, depth683
 						if buffer[position] != rune('E') {
-							goto l618
+							goto l672
 						}
 						position++
 					}
-				l629:
+				l683:
 					{
-						position631, tokenIndex631, depth631 := position, tokenIndex, depth
+						position685, tokenIndex685, depth685 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l632
+							goto l686
 						}
 						position++
-						goto l631
-					l632:
-						position, tokenIndex, depth = position631, tokenIndex631, depth631
+						goto l685
+					l686:
+						position, tokenIndex, depth = position685, tokenIndex685, depth685
 						if buffer[position] != rune('T') {
-							goto l618
+							goto l672
+						}
+						position++
+					}
+				l685:
+					if !_rules[ruleskip]() {
+						goto l672
+					}
+					depth--
+					add(ruleOFFSET, position674)
+				}
+				if !_rules[ruleINTEGER]() {
+					goto l672
+				}
+				depth--
+				add(ruleoffset, position673)
+			}
+			return true
+		l672:
+			position, tokenIndex, depth = position672, tokenIndex672, depth672
+			return false
+		},
+		/* 61 valuesClause <- <inlineData> */
+		func() bool {
+			position687, tokenIndex687, depth687 := position, tokenIndex, depth
+			{
+				position688 := position
+				depth++
+				if !_rules[ruleinlineData]() {
+					goto l687
+				}
+				depth--
+				add(rulevaluesClause, position688)
+			}
+			return true
+		l687:
+			position, tokenIndex, depth = position687, tokenIndex687, depth687
+			return false
+		},
+		/* 62 inlineData <- <(VALUES dataBlock)> */
+		func() bool {
+			position689, tokenIndex689, depth689 := position, tokenIndex, depth
+			{
+				position690 := position
+				depth++
+				{
+					position691 := position
+					depth++
+					{
+						position692, tokenIndex692, depth692 := position, tokenIndex, depth
+						if buffer[position] != rune('v') {
+							goto l693
+						}
+						position++
+						goto l692
+					l693:
+						position, tokenIndex, depth = position692, tokenIndex692, depth692
+						if buffer[position] != rune('V') {
+							goto l689
+						}
+						position++
+					}
+				l692:
+					{
+						position694, tokenIndex694, depth694 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l695
+						}
+						position++
+						goto l694
+					l695:
+						position, tokenIndex, depth = position694, tokenIndex694, depth694
+						if buffer[position] != rune('A') {
+							goto l689
+						}
+						position++
+					}
+				l694:
+					{
+						position696, tokenIndex696, depth696 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l697
+						}
+						position++
+						goto l696
+					l697:
+						position, tokenIndex, depth = position696, tokenIndex696, depth696
+						if buffer[position] != rune('L') {
+							goto l689
+						}
+						position++
+					}
+				l696:
+					{
+						position698, tokenIndex698, depth698 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l699
 						}
 						position++
+						goto l698
+					l699:
+						position, tokenIndex, depth = position698, tokenIndex698, depth698
+						if buffer[position] != rune('U') {
+							goto l689
+						}
+						position++
+					}
+				l698:
+					{
+						position700, tokenIndex700, depth700 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l701
+						}
+						position++
+						goto l700
+					l701:
+						position, tokenIndex, depth = position700, tokenIndex700, depth700
+						if buffer[position] != rune('E') {
+							goto l689
+						}
+						position++
+					}
+				l700:
+					{
+						position702, tokenIndex702, depth702 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l703
+						}
+						position++
+						goto l702
+					l703:
+						position, tokenIndex, depth = position702, tokenIndex702, depth702
+						if buffer[position] != rune('S') {
+							goto l689
+						}
+						position++
+					}
+				l702:
+					if !_rules[ruleskip]() {
+						goto l689
 					}
-				l631:
-					if !rules[ruleskip]() {
-						goto l618
+					depth--
+					add(ruleVALUES, position691)
+				}
+				{
+					position704 := position
+					depth++
+					{
+						position705, tokenIndex705, depth705 := position, tokenIndex, depth
+						{
+							position707 := position
+							depth++
+							if !_rules[rulevar]() {
+								goto l706
+							}
+							if !_rules[ruleLBRACE]() {
+								goto l706
+							}
+						l708:
+							{
+								position709, tokenIndex709, depth709 := position, tokenIndex, depth
+								if !_rules[ruledataBlockValue]() {
+									goto l709
+								}
+								goto l708
+							l709:
+								position, tokenIndex, depth = position709, tokenIndex709, depth709
+							}
+							if !_rules[ruleRBRACE]() {
+								goto l706
+							}
+							depth--
+							add(ruleinlineDataOneVar, position707)
+						}
+						goto l705
+					l706:
+						position, tokenIndex, depth = position705, tokenIndex705, depth705
+						{
+							position710 := position
+							depth++
+							{
+								position711, tokenIndex711, depth711 := position, tokenIndex, depth
+								if !_rules[rulenil]() {
+									goto l712
+								}
+								goto l711
+							l712:
+								position, tokenIndex, depth = position711, tokenIndex711, depth711
+								if !_rules[ruleLPAREN]() {
+									goto l689
+								}
+							l713:
+								{
+									position714, tokenIndex714, depth714 := position, tokenIndex, depth
+									if !_rules[rulevar]() {
+										goto l714
+									}
+									goto l713
+								l714:
+									position, tokenIndex, depth = position714, tokenIndex714, depth714
+								}
+								if !_rules[ruleRPAREN]() {
+									goto l689
+								}
+							}
+						l711:
+							if !_rules[ruleLBRACE]() {
+								goto l689
+							}
+						l715:
+							{
+								position716, tokenIndex716, depth716 := position, tokenIndex, depth
+								{
+									position717, tokenIndex717, depth717 := position, tokenIndex, depth
+									if !_rules[ruleLPAREN]() {
+										goto l718
+									}
+								l719:
+									{
+										position720, tokenIndex720, depth720 := position, tokenIndex, depth
+										if !_rules[ruledataBlockValue]() {
+											goto l720
+										}
+										goto l719
+									l720:
+										position, tokenIndex, depth = position720, tokenIndex720, depth720
+									}
+									if !_rules[ruleRPAREN]() {
+										goto l718
+									}
+									goto l717
+								l718:
+									position, tokenIndex, depth = position717, tokenIndex717, depth717
+									if !_rules[rulenil]() {
+										goto l716
+									}
+								}
+							l717:
+								goto l715
+							l716:
+								position, tokenIndex, depth = position716, tokenIndex716, depth716
+							}
+							if !_rules[ruleRBRACE]() {
+								goto l689
+							}
+							depth--
+							add(ruleinlineDataFull, position710)
+						}
 					}
+				l705:
 					depth--
-					add(ruleOFFSET, position620)
+					add(ruledataBlock, position704)
 				}
-				if !rules[ruleINTEGER]() {
-					goto l618
+				depth--
+				add(ruleinlineData, position690)
+			}
+			return true
+		l689:
+			position, tokenIndex, depth = position689, tokenIndex689, depth689
+			return false
+		},
+		/* 63 dataBlock <- <(inlineDataOneVar / inlineDataFull)> */
+		nil,
+		/* 64 inlineDataOneVar <- <(var LBRACE dataBlockValue* RBRACE)> */
+		nil,
+		/* 65 inlineDataFull <- <((nil / (LPAREN var* RPAREN)) LBRACE ((LPAREN dataBlockValue* RPAREN) / nil)* RBRACE)> */
+		nil,
+		/* 66 dataBlockValue <- <(iriref / literal / numericLiteral / booleanLiteral / UNDEF)> */
+		func() bool {
+			position724, tokenIndex724, depth724 := position, tokenIndex, depth
+			{
+				position725 := position
+				depth++
+				{
+					position726, tokenIndex726, depth726 := position, tokenIndex, depth
+					if !_rules[ruleiriref]() {
+						goto l727
+					}
+					goto l726
+				l727:
+					position, tokenIndex, depth = position726, tokenIndex726, depth726
+					if !_rules[ruleliteral]() {
+						goto l728
+					}
+					goto l726
+				l728:
+					position, tokenIndex, depth = position726, tokenIndex726, depth726
+					if !_rules[rulenumericLiteral]() {
+						goto l729
+					}
+					goto l726
+				l729:
+					position, tokenIndex, depth = position726, tokenIndex726, depth726
+					if !_rules[rulebooleanLiteral]() {
+						goto l730
+					}
+					goto l726
+				l730:
+					position, tokenIndex, depth = position726, tokenIndex726, depth726
+					{
+						position731 := position
+						depth++
+						{
+							position732, tokenIndex732, depth732 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l733
+							}
+							position++
+							goto l732
+						l733:
+							position, tokenIndex, depth = position732, tokenIndex732, depth732
+							if buffer[position] != rune('U') {
+								goto l724
+							}
+							position++
+						}
+					l732:
+						{
+							position734, tokenIndex734, depth734 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l735
+							}
+							position++
+							goto l734
+						l735:
+							position, tokenIndex, depth = position734, tokenIndex734, depth734
+							if buffer[position] != rune('N') {
+								goto l724
+							}
+							position++
+						}
+					l734:
+						{
+							position736, tokenIndex736, depth736 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l737
+							}
+							position++
+							goto l736
+						l737:
+							position, tokenIndex, depth = position736, tokenIndex736, depth736
+							if buffer[position] != rune('D') {
+								goto l724
+							}
+							position++
+						}
+					l736:
+						{
+							position738, tokenIndex738, depth738 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l739
+							}
+							position++
+							goto l738
+						l739:
+							position, tokenIndex, depth = position738, tokenIndex738, depth738
+							if buffer[position] != rune('E') {
+								goto l724
+							}
+							position++
+						}
+					l738:
+						{
+							position740, tokenIndex740, depth740 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l741
+							}
+							position++
+							goto l740
+						l741:
+							position, tokenIndex, depth = position740, tokenIndex740, depth740
+							if buffer[position] != rune('F') {
+								goto l724
+							}
+							position++
+						}
+					l740:
+						if !_rules[ruleskip]() {
+							goto l724
+						}
+						depth--
+						add(ruleUNDEF, position731)
+					}
 				}
+			l726:
 				depth--
-				add(ruleoffset, position619)
+				add(ruledataBlockValue, position725)
 			}
 			return true
-		l618:
-			position, tokenIndex, depth = position618, tokenIndex618, depth618
+		l724:
+			position, tokenIndex, depth = position724, tokenIndex724, depth724
 			return false
 		},
-		/* 58 expression <- <conditionalOrExpression> */
+		/* 67 expression <- <conditionalOrExpression> */
 		func() bool {
-			position633, tokenIndex633, depth633 := position, tokenIndex, depth
+			position742, tokenIndex742, depth742 := position, tokenIndex, depth
 			{
-				position634 := position
+				position743 := position
 				depth++
-				if !rules[ruleconditionalOrExpression]() {
-					goto l633
+				if !_rules[ruleconditionalOrExpression]() {
+					goto l742
 				}
 				depth--
-				add(ruleexpression, position634)
+				add(ruleexpression, position743)
 			}
 			return true
-		l633:
-			position, tokenIndex, depth = position633, tokenIndex633, depth633
+		l742:
+			position, tokenIndex, depth = position742, tokenIndex742, depth742
 			return false
 		},
-		/* 59 conditionalOrExpression <- <(conditionalAndExpression (OR conditionalOrExpression)?)> */
+		/* 68 conditionalOrExpression <- <(conditionalAndExpression (OR conditionalOrExpression)?)> */
 		func() bool {
-			position635, tokenIndex635, depth635 := position, tokenIndex, depth
+			position744, tokenIndex744, depth744 := position, tokenIndex, depth
 			{
-				position636 := position
+				position745 := position
 				depth++
-				if !rules[ruleconditionalAndExpression]() {
-					goto l635
+				if !_rules[ruleconditionalAndExpression]() {
+					goto l744
 				}
 				{
-					position637, tokenIndex637, depth637 := position, tokenIndex, depth
+					position746, tokenIndex746, depth746 := position, tokenIndex, depth
 					{
-						position639 := position
+						position748 := position
 						depth++
 						if buffer[position] != rune('|') {
-							goto l637
+							goto l746
 						}
 						position++
 						if buffer[position] != rune('|') {
-							goto l637
+							goto l746
 						}
 						position++
-						if !rules[ruleskip]() {
-							goto l637
+						if !_rules[ruleskip]() {
+							goto l746
 						}
 						depth--
-						add(ruleOR, position639)
+						add(ruleOR, position748)
 					}
-					if !rules[ruleconditionalOrExpression]() {
-						goto l637
+					if !_rules[ruleconditionalOrExpression]() {
+						goto l746
 					}
-					goto l638
-				l637:
-					position, tokenIndex, depth = position637, tokenIndex637, depth637
+					goto l747
+				l746:
+					position, tokenIndex, depth = position746, tokenIndex746, depth746
 				}
-			l638:
+			l747:
 				depth--
-				add(ruleconditionalOrExpression, position636)
+				add(ruleconditionalOrExpression, position745)
 			}
 			return true
-		l635:
-			position, tokenIndex, depth = position635, tokenIndex635, depth635
+		l744:
+			position, tokenIndex, depth = position744, tokenIndex744, depth744
 			return false
 		},
-		/* 60 conditionalAndExpression <- <(valueLogical (AND conditionalAndExpression)?)> */
+		/* 69 conditionalAndExpression <- <(valueLogical (AND conditionalAndExpression)?)> */
 		func() bool {
-			position640, tokenIndex640, depth640 := position, tokenIndex, depth
+			position749, tokenIndex749, depth749 := position, tokenIndex, depth
 			{
-				position641 := position
+				position750 := position
 				depth++
 				{
-					position642 := position
+					position751 := position
 					depth++
-					if !rules[rulenumericExpression]() {
-						goto l640
+					if !_rules[rulenumericExpression]() {
+						goto l749
 					}
 					{
-						position643, tokenIndex643, depth643 := position, tokenIndex, depth
+						position752, tokenIndex752, depth752 := position, tokenIndex, depth
 						{
-							switch buffer[position] {
-							case 'N', 'n':
+							position754, tokenIndex754, depth754 := position, tokenIndex, depth
+							{
+								position756, tokenIndex756, depth756 := position, tokenIndex, depth
+								if !_rules[ruleEQ]() {
+									goto l757
+								}
+								goto l756
+							l757:
+								position, tokenIndex, depth = position756, tokenIndex756, depth756
 								{
-									position646 := position
+									position759 := position
 									depth++
-									{
-										position647 := position
-										depth++
-										{
-											position648, tokenIndex648, depth648 := position, tokenIndex, depth
-											if buffer[position] != rune('n') {
-												goto l649
-											}
-											position++
-											goto l648
-										l649:
-											position, tokenIndex, depth = position648, tokenIndex648, depth648
-											if buffer[position] != rune('N') {
-												goto l643
-											}
-											position++
-										}
-									l648:
-										{
-											position650, tokenIndex650, depth650 := position, tokenIndex, depth
-											if buffer[position] != rune('o') {
-												goto l651
-											}
-											position++
-											goto l650
-										l651:
-											position, tokenIndex, depth = position650, tokenIndex650, depth650
-											if buffer[position] != rune('O') {
-												goto l643
-											}
-											position++
-										}
-									l650:
-										{
-											position652, tokenIndex652, depth652 := position, tokenIndex, depth
-											if buffer[position] != rune('t') {
-												goto l653
-											}
-											position++
-											goto l652
-										l653:
-											position, tokenIndex, depth = position652, tokenIndex652, depth652
-											if buffer[position] != rune('T') {
-												goto l643
-											}
-											position++
-										}
-									l652:
-										if buffer[position] != rune(' ') {
-											goto l643
-										}
-										position++
-										{
-											position654, tokenIndex654, depth654 := position, tokenIndex, depth
-											if buffer[position] != rune('i') {
-												goto l655
-											}
-											position++
-											goto l654
-										l655:
-											position, tokenIndex, depth = position654, tokenIndex654, depth654
-											if buffer[position] != rune('I') {
-												goto l643
-											}
-											position++
-										}
-									l654:
-										{
-											position656, tokenIndex656, depth656 := position, tokenIndex, depth
-											if buffer[position] != rune('n') {
-												goto l657
-											}
-											position++
-											goto l656
-										l657:
-											position, tokenIndex, depth = position656, tokenIndex656, depth656
-											if buffer[position] != rune('N') {
-												goto l643
-											}
-											position++
-										}
-									l656:
-										if !rules[ruleskip]() {
-											goto l643
-										}
-										depth--
-										add(ruleNOTIN, position647)
+									if buffer[position] != rune('!') {
+										goto l758
 									}
-									if !rules[ruleargList]() {
-										goto l643
+									position++
+									if buffer[position] != rune('=') {
+										goto l758
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l758
+									}
+									depth--
+									add(ruleNE, position759)
+								}
+								goto l756
+							l758:
+								position, tokenIndex, depth = position756, tokenIndex756, depth756
+								{
+									position761 := position
+									depth++
+									if buffer[position] != rune('<') {
+										goto l760
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l760
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l760
+									}
+									depth--
+									add(ruleLE, position761)
+								}
+								goto l756
+							l760:
+								position, tokenIndex, depth = position756, tokenIndex756, depth756
+								{
+									position763 := position
+									depth++
+									if buffer[position] != rune('>') {
+										goto l762
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l762
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l762
 									}
 									depth--
-									add(rulenotin, position646)
+									add(ruleGE, position763)
 								}
-								break
-							case 'I', 'i':
+								goto l756
+							l762:
+								position, tokenIndex, depth = position756, tokenIndex756, depth756
 								{
-									position658 := position
+									position765 := position
+									depth++
+									if buffer[position] != rune('<') {
+										goto l764
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l764
+									}
+									depth--
+									add(ruleLT, position765)
+								}
+								goto l756
+							l764:
+								position, tokenIndex, depth = position756, tokenIndex756, depth756
+								{
+									position766 := position
+									depth++
+									if buffer[position] != rune('>') {
+										goto l755
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l755
+									}
+									depth--
+									add(ruleGT, position766)
+								}
+							}
+						l756:
+							if !_rules[rulenumericExpression]() {
+								goto l755
+							}
+							goto l754
+						l755:
+							position, tokenIndex, depth = position754, tokenIndex754, depth754
+							{
+								position768 := position
+								depth++
+								{
+									position769 := position
 									depth++
 									{
-										position659 := position
-										depth++
-										{
-											position660, tokenIndex660, depth660 := position, tokenIndex, depth
-											if buffer[position] != rune('i') {
-												goto l661
-											}
-											position++
-											goto l660
-										l661:
-											position, tokenIndex, depth = position660, tokenIndex660, depth660
-											if buffer[position] != rune('I') {
-												goto l643
-											}
-											position++
+										position770, tokenIndex770, depth770 := position, tokenIndex, depth
+										if buffer[position] != rune('i') {
+											goto l771
 										}
-									l660:
-										{
-											position662, tokenIndex662, depth662 := position, tokenIndex, depth
-											if buffer[position] != rune('n') {
-												goto l663
-											}
-											position++
-											goto l662
-										l663:
-											position, tokenIndex, depth = position662, tokenIndex662, depth662
-											if buffer[position] != rune('N') {
-												goto l643
-											}
-											position++
+										position++
+										goto l770
+									l771:
+										position, tokenIndex, depth = position770, tokenIndex770, depth770
+										if buffer[position] != rune('I') {
+											goto l767
+										}
+										position++
+									}
+								l770:
+									{
+										position772, tokenIndex772, depth772 := position, tokenIndex, depth
+										if buffer[position] != rune('n') {
+											goto l773
 										}
-									l662:
-										if !rules[ruleskip]() {
-											goto l643
+										position++
+										goto l772
+									l773:
+										position, tokenIndex, depth = position772, tokenIndex772, depth772
+										if buffer[position] != rune('N') {
+											goto l767
 										}
-										depth--
-										add(ruleIN, position659)
+										position++
 									}
-									if !rules[ruleargList]() {
-										goto l643
+								l772:
+									if !_rules[ruleskip]() {
+										goto l767
 									}
 									depth--
-									add(rulein, position658)
+									add(ruleIN, position769)
 								}
-								break
-							default:
+								if !_rules[ruleargList]() {
+									goto l767
+								}
+								depth--
+								add(rulein, position768)
+							}
+							goto l754
+						l767:
+							position, tokenIndex, depth = position754, tokenIndex754, depth754
+							{
+								position774 := position
+								depth++
 								{
-									position664, tokenIndex664, depth664 := position, tokenIndex, depth
+									position775 := position
+									depth++
 									{
-										position666 := position
-										depth++
-										if buffer[position] != rune('<') {
-											goto l665
+										position776, tokenIndex776, depth776 := position, tokenIndex, depth
+										if buffer[position] != rune('n') {
+											goto l777
 										}
 										position++
-										if !rules[ruleskip]() {
-											goto l665
+										goto l776
+									l777:
+										position, tokenIndex, depth = position776, tokenIndex776, depth776
+										if buffer[position] != rune('N') {
+											goto l752
 										}
-										depth--
-										add(ruleLT, position666)
+										position++
 									}
-									goto l664
-								l665:
-									position, tokenIndex, depth = position664, tokenIndex664, depth664
+								l776:
 									{
-										position668 := position
-										depth++
-										if buffer[position] != rune('>') {
-											goto l667
+										position778, tokenIndex778, depth778 := position, tokenIndex, depth
+										if buffer[position] != rune('o') {
+											goto l779
 										}
 										position++
-										if buffer[position] != rune('=') {
-											goto l667
+										goto l778
+									l779:
+										position, tokenIndex, depth = position778, tokenIndex778, depth778
+										if buffer[position] != rune('O') {
+											goto l752
 										}
 										position++
-										if !rules[ruleskip]() {
-											goto l667
+									}
+								l778:
+									{
+										position780, tokenIndex780, depth780 := position, tokenIndex, depth
+										if buffer[position] != rune('t') {
+											goto l781
 										}
-										depth--
-										add(ruleGE, position668)
+										position++
+										goto l780
+									l781:
+										position, tokenIndex, depth = position780, tokenIndex780, depth780
+										if buffer[position] != rune('T') {
+											goto l752
+										}
+										position++
 									}
-									goto l664
-								l667:
-									position, tokenIndex, depth = position664, tokenIndex664, depth664
+								l780:
+									if buffer[position] != rune(' ') {
+										goto l752
+									}
+									position++
 									{
-										switch buffer[position] {
-										case '>':
-											{
-												position670 := position
-												depth++
-												if buffer[position] != rune('>') {
-													goto l643
-												}
-												position++
-												if !rules[ruleskip]() {
-													goto l643
-												}
-												depth--
-												add(ruleGT, position670)
-											}
-											break
-										case '<':
-											{
-												position671 := position
-												depth++
-												if buffer[position] != rune('<') {
-													goto l643
-												}
-												position++
-												if buffer[position] != rune('=') {
-													goto l643
-												}
-												position++
-												if !rules[ruleskip]() {
-													goto l643
-												}
-												depth--
-												add(ruleLE, position671)
-											}
-											break
-										case '!':
-											{
-												position672 := position
-												depth++
-												if buffer[position] != rune('!') {
-													goto l643
-												}
-												position++
-												if buffer[position] != rune('=') {
-													goto l643
-												}
-												position++
-												if !rules[ruleskip]() {
-													goto l643
-												}
-												depth--
-												add(ruleNE, position672)
-											}
-											break
-										default:
-											if !rules[ruleEQ]() {
-												goto l643
-											}
-											break
+										position782, tokenIndex782, depth782 := position, tokenIndex, depth
+										if buffer[position] != rune('i') {
+											goto l783
+										}
+										position++
+										goto l782
+									l783:
+										position, tokenIndex, depth = position782, tokenIndex782, depth782
+										if buffer[position] != rune('I') {
+											goto l752
 										}
+										position++
 									}
-
+								l782:
+									{
+										position784, tokenIndex784, depth784 := position, tokenIndex, depth
+										if buffer[position] != rune('n') {
+											goto l785
+										}
+										position++
+										goto l784
+									l785:
+										position, tokenIndex, depth = position784, tokenIndex784, depth784
+										if buffer[position] != rune('N') {
+											goto l752
+										}
+										position++
+									}
+								l784:
+									if !_rules[ruleskip]() {
+										goto l752
+									}
+									depth--
+									add(ruleNOTIN, position775)
 								}
-							l664:
-								if !rules[rulenumericExpression]() {
-									goto l643
+								if !_rules[ruleargList]() {
+									goto l752
 								}
-								break
+								depth--
+								add(rulenotin, position774)
 							}
 						}
-
-						goto l644
-					l643:
-						position, tokenIndex, depth = position643, tokenIndex643, depth643
+					l754:
+						goto l753
+					l752:
+						position, tokenIndex, depth = position752, tokenIndex752, depth752
 					}
-				l644:
+				l753:
 					depth--
-					add(rulevalueLogical, position642)
+					add(rulevalueLogical, position751)
 				}
 				{
-					position673, tokenIndex673, depth673 := position, tokenIndex, depth
+					position786, tokenIndex786, depth786 := position, tokenIndex, depth
 					{
-						position675 := position
+						position788 := position
 						depth++
 						if buffer[position] != rune('&') {
-							goto l673
+							goto l786
 						}
 						position++
 						if buffer[position] != rune('&') {
-							goto l673
+							goto l786
 						}
 						position++
-						if !rules[ruleskip]() {
-							goto l673
+						if !_rules[ruleskip]() {
+							goto l786
 						}
 						depth--
-						add(ruleAND, position675)
+						add(ruleAND, position788)
 					}
-					if !rules[ruleconditionalAndExpression]() {
-						goto l673
+					if !_rules[ruleconditionalAndExpression]() {
+						goto l786
 					}
-					goto l674
-				l673:
-					position, tokenIndex, depth = position673, tokenIndex673, depth673
+					goto l787
+				l786:
+					position, tokenIndex, depth = position786, tokenIndex786, depth786
 				}
-			l674:
+			l787:
 				depth--
-				add(ruleconditionalAndExpression, position641)
+				add(ruleconditionalAndExpression, position750)
 			}
 			return true
-		l640:
-			position, tokenIndex, depth = position640, tokenIndex640, depth640
+		l749:
+			position, tokenIndex, depth = position749, tokenIndex749, depth749
 			return false
 		},
-		/* 61 valueLogical <- <(numericExpression ((&('N' | 'n') notin) | (&('I' | 'i') in) | (&('!' | '<' | '=' | '>') ((LT / GE / ((&('>') GT) | (&('<') LE) | (&('!') NE) | (&('=') EQ))) numericExpression)))?)> */
+		/* 70 valueLogical <- <(numericExpression (((EQ / NE / LE / GE / LT / GT) numericExpression) / in / notin)?)> */
 		nil,
-		/* 62 numericExpression <- <(multiplicativeExpression (((PLUS / MINUS) multiplicativeExpression) / signedNumericLiteral)*)> */
+		/* 71 numericExpression <- <(multiplicativeExpression (((PLUS / MINUS) multiplicativeExpression) / signedNumericLiteral)*)> */
 		func() bool {
-			position677, tokenIndex677, depth677 := position, tokenIndex, depth
+			position790, tokenIndex790, depth790 := position, tokenIndex, depth
 			{
-				position678 := position
+				position791 := position
 				depth++
-				if !rules[rulemultiplicativeExpression]() {
-					goto l677
+				if !_rules[rulemultiplicativeExpression]() {
+					goto l790
 				}
-			l679:
+			l792:
 				{
-					position680, tokenIndex680, depth680 := position, tokenIndex, depth
+					position793, tokenIndex793, depth793 := position, tokenIndex, depth
 					{
-						position681, tokenIndex681, depth681 := position, tokenIndex, depth
+						position794, tokenIndex794, depth794 := position, tokenIndex, depth
 						{
-							position683, tokenIndex683, depth683 := position, tokenIndex, depth
-							if !rules[rulePLUS]() {
-								goto l684
+							position796, tokenIndex796, depth796 := position, tokenIndex, depth
+							if !_rules[rulePLUS]() {
+								goto l797
 							}
-							goto l683
-						l684:
-							position, tokenIndex, depth = position683, tokenIndex683, depth683
-							if !rules[ruleMINUS]() {
-								goto l682
+							goto l796
+						l797:
+							position, tokenIndex, depth = position796, tokenIndex796, depth796
+							if !_rules[ruleMINUS]() {
+								goto l795
 							}
 						}
-					l683:
-						if !rules[rulemultiplicativeExpression]() {
-							goto l682
+					l796:
+						if !_rules[rulemultiplicativeExpression]() {
+							goto l795
 						}
-						goto l681
-					l682:
-						position, tokenIndex, depth = position681, tokenIndex681, depth681
+						goto l794
+					l795:
+						position, tokenIndex, depth = position794, tokenIndex794, depth794
 						{
-							position685 := position
+							position798 := position
 							depth++
 							{
-								position686, tokenIndex686, depth686 := position, tokenIndex, depth
+								position799, tokenIndex799, depth799 := position, tokenIndex, depth
 								if buffer[position] != rune('+') {
-									goto l687
+									goto l800
 								}
 								position++
-								goto l686
-							l687:
-								position, tokenIndex, depth = position686, tokenIndex686, depth686
+								goto l799
+							l800:
+								position, tokenIndex, depth = position799, tokenIndex799, depth799
 								if buffer[position] != rune('-') {
-									goto l680
-								}
-								position++
-							}
-						l686:
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l680
-							}
-							position++
-						l688:
-							{
-								position689, tokenIndex689, depth689 := position, tokenIndex, depth
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l689
+									goto l793
 								}
 								position++
-								goto l688
-							l689:
-								position, tokenIndex, depth = position689, tokenIndex689, depth689
 							}
-							{
-								position690, tokenIndex690, depth690 := position, tokenIndex, depth
-								if buffer[position] != rune('.') {
-									goto l690
-								}
-								position++
-							l692:
-								{
-									position693, tokenIndex693, depth693 := position, tokenIndex, depth
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l693
-									}
-									position++
-									goto l692
-								l693:
-									position, tokenIndex, depth = position693, tokenIndex693, depth693
-								}
-								goto l691
-							l690:
-								position, tokenIndex, depth = position690, tokenIndex690, depth690
+						l799:
+							if !_rules[ruleunsignedNumericLiteral]() {
+								goto l793
 							}
-						l691:
-							if !rules[ruleskip]() {
-								goto l680
+							if !_rules[ruleskip]() {
+								goto l793
 							}
 							depth--
-							add(rulesignedNumericLiteral, position685)
+							add(rulesignedNumericLiteral, position798)
 						}
 					}
-				l681:
-					goto l679
-				l680:
-					position, tokenIndex, depth = position680, tokenIndex680, depth680
+				l794:
+					goto l792
+				l793:
+					position, tokenIndex, depth = position793, tokenIndex793, depth793
 				}
 				depth--
-				add(rulenumericExpression, position678)
+				add(rulenumericExpression, position791)
 			}
 			return true
-		l677:
-			position, tokenIndex, depth = position677, tokenIndex677, depth677
+		l790:
+			position, tokenIndex, depth = position790, tokenIndex790, depth790
 			return false
 		},
-		/* 63 multiplicativeExpression <- <(unaryExpression ((STAR / SLASH) unaryExpression)*)> */
+		/* 72 multiplicativeExpression <- <(unaryExpression ((STAR / SLASH) unaryExpression)*)> */
 		func() bool {
-			position694, tokenIndex694, depth694 := position, tokenIndex, depth
+			position801, tokenIndex801, depth801 := position, tokenIndex, depth
 			{
-				position695 := position
+				position802 := position
 				depth++
-				if !rules[ruleunaryExpression]() {
-					goto l694
+				if !_rules[ruleunaryExpression]() {
+					goto l801
 				}
-			l696:
+			l803:
 				{
-					position697, tokenIndex697, depth697 := position, tokenIndex, depth
+					position804, tokenIndex804, depth804 := position, tokenIndex, depth
 					{
-						position698, tokenIndex698, depth698 := position, tokenIndex, depth
-						if !rules[ruleSTAR]() {
-							goto l699
+						position805, tokenIndex805, depth805 := position, tokenIndex, depth
+						if !_rules[ruleSTAR]() {
+							goto l806
 						}
-						goto l698
-					l699:
-						position, tokenIndex, depth = position698, tokenIndex698, depth698
-						if !rules[ruleSLASH]() {
-							goto l697
+						goto l805
+					l806:
+						position, tokenIndex, depth = position805, tokenIndex805, depth805
+						if !_rules[ruleSLASH]() {
+							goto l804
 						}
 					}
-				l698:
-					if !rules[ruleunaryExpression]() {
-						goto l697
+				l805:
+					if !_rules[ruleunaryExpression]() {
+						goto l804
 					}
-					goto l696
-				l697:
-					position, tokenIndex, depth = position697, tokenIndex697, depth697
+					goto l803
+				l804:
+					position, tokenIndex, depth = position804, tokenIndex804, depth804
 				}
 				depth--
-				add(rulemultiplicativeExpression, position695)
+				add(rulemultiplicativeExpression, position802)
 			}
 			return true
-		l694:
-			position, tokenIndex, depth = position694, tokenIndex694, depth694
+		l801:
+			position, tokenIndex, depth = position801, tokenIndex801, depth801
 			return false
 		},
-		/* 64 unaryExpression <- <(((&('+') PLUS) | (&('-') MINUS) | (&('!') NOT))? primaryExpression)> */
+		/* 73 unaryExpression <- <((NOT / MINUS / PLUS)? primaryExpression)> */
 		func() bool {
-			position700, tokenIndex700, depth700 := position, tokenIndex, depth
+			position807, tokenIndex807, depth807 := position, tokenIndex, depth
 			{
-				position701 := position
+				position808 := position
 				depth++
 				{
-					position702, tokenIndex702, depth702 := position, tokenIndex, depth
+					position809, tokenIndex809, depth809 := position, tokenIndex, depth
 					{
-						switch buffer[position] {
-						case '+':
-							if !rules[rulePLUS]() {
-								goto l702
-							}
-							break
-						case '-':
-							if !rules[ruleMINUS]() {
-								goto l702
-							}
-							break
-						default:
-							if !rules[ruleNOT]() {
-								goto l702
-							}
-							break
+						position811, tokenIndex811, depth811 := position, tokenIndex, depth
+						if !_rules[ruleNOT]() {
+							goto l812
+						}
+						goto l811
+					l812:
+						position, tokenIndex, depth = position811, tokenIndex811, depth811
+						if !_rules[ruleMINUS]() {
+							goto l813
+						}
+						goto l811
+					l813:
+						position, tokenIndex, depth = position811, tokenIndex811, depth811
+						if !_rules[rulePLUS]() {
+							goto l809
 						}
 					}
-
-					goto l703
-				l702:
-					position, tokenIndex, depth = position702, tokenIndex702, depth702
+				l811:
+					goto l810
+				l809:
+					position, tokenIndex, depth = position809, tokenIndex809, depth809
 				}
-			l703:
+			l810:
 				{
-					position705 := position
+					position814 := position
 					depth++
 					{
-						position706, tokenIndex706, depth706 := position, tokenIndex, depth
-						if !rules[rulebuiltinCall]() {
-							goto l707
-						}
-						goto l706
-					l707:
-						position, tokenIndex, depth = position706, tokenIndex706, depth706
-						if !rules[rulefunctionCall]() {
-							goto l708
-						}
-						goto l706
-					l708:
-						position, tokenIndex, depth = position706, tokenIndex706, depth706
-						if !rules[ruleiriref]() {
-							goto l709
-						}
-						goto l706
-					l709:
-						position, tokenIndex, depth = position706, tokenIndex706, depth706
-						{
-							switch buffer[position] {
-							case 'A', 'C', 'G', 'M', 'S', 'a', 'c', 'g', 'm', 's':
+						position815, tokenIndex815, depth815 := position, tokenIndex, depth
+						if !_rules[rulebrackettedExpression]() {
+							goto l816
+						}
+						goto l815
+					l816:
+						position, tokenIndex, depth = position815, tokenIndex815, depth815
+						if !_rules[rulebuiltinCall]() {
+							goto l817
+						}
+						goto l815
+					l817:
+						position, tokenIndex, depth = position815, tokenIndex815, depth815
+						if !_rules[rulefunctionCall]() {
+							goto l818
+						}
+						goto l815
+					l818:
+						position, tokenIndex, depth = position815, tokenIndex815, depth815
+						if !_rules[ruleiriref]() {
+							goto l819
+						}
+						goto l815
+					l819:
+						position, tokenIndex, depth = position815, tokenIndex815, depth815
+						if !_rules[ruleliteral]() {
+							goto l820
+						}
+						goto l815
+					l820:
+						position, tokenIndex, depth = position815, tokenIndex815, depth815
+						if !_rules[rulenumericLiteral]() {
+							goto l821
+						}
+						goto l815
+					l821:
+						position, tokenIndex, depth = position815, tokenIndex815, depth815
+						if !_rules[rulebooleanLiteral]() {
+							goto l822
+						}
+						goto l815
+					l822:
+						position, tokenIndex, depth = position815, tokenIndex815, depth815
+						if !_rules[rulevar]() {
+							goto l823
+						}
+						goto l815
+					l823:
+						position, tokenIndex, depth = position815, tokenIndex815, depth815
+						{
+							position825 := position
+							depth++
+							{
+								position826, tokenIndex826, depth826 := position, tokenIndex, depth
 								{
-									position711 := position
+									position828 := position
 									depth++
 									{
-										switch buffer[position] {
-										case 'G', 'g':
-											{
-												position713 := position
-												depth++
-												{
-													position714 := position
-													depth++
-													{
-														position715, tokenIndex715, depth715 := position, tokenIndex, depth
-														if buffer[position] != rune('g') {
-															goto l716
-														}
-														position++
-														goto l715
-													l716:
-														position, tokenIndex, depth = position715, tokenIndex715, depth715
-														if buffer[position] != rune('G') {
-															goto l700
-														}
-														position++
-													}
-												l715:
-													{
-														position717, tokenIndex717, depth717 := position, tokenIndex, depth
-														if buffer[position] != rune('r') {
-															goto l718
-														}
-														position++
-														goto l717
-													l718:
-														position, tokenIndex, depth = position717, tokenIndex717, depth717
-														if buffer[position] != rune('R') {
-															goto l700
-														}
-														position++
-													}
-												l717:
-													{
-														position719, tokenIndex719, depth719 := position, tokenIndex, depth
-														if buffer[position] != rune('o') {
-															goto l720
-														}
-														position++
-														goto l719
-													l720:
-														position, tokenIndex, depth = position719, tokenIndex719, depth719
-														if buffer[position] != rune('O') {
-															goto l700
-														}
-														position++
-													}
-												l719:
-													{
-														position721, tokenIndex721, depth721 := position, tokenIndex, depth
-														if buffer[position] != rune('u') {
-															goto l722
-														}
-														position++
-														goto l721
-													l722:
-														position, tokenIndex, depth = position721, tokenIndex721, depth721
-														if buffer[position] != rune('U') {
-															goto l700
-														}
-														position++
-													}
-												l721:
-													{
-														position723, tokenIndex723, depth723 := position, tokenIndex, depth
-														if buffer[position] != rune('p') {
-															goto l724
-														}
-														position++
-														goto l723
-													l724:
-														position, tokenIndex, depth = position723, tokenIndex723, depth723
-														if buffer[position] != rune('P') {
-															goto l700
-														}
-														position++
-													}
-												l723:
-													if buffer[position] != rune('_') {
-														goto l700
-													}
-													position++
-													{
-														position725, tokenIndex725, depth725 := position, tokenIndex, depth
-														if buffer[position] != rune('c') {
-															goto l726
-														}
-														position++
-														goto l725
-													l726:
-														position, tokenIndex, depth = position725, tokenIndex725, depth725
-														if buffer[position] != rune('C') {
-															goto l700
-														}
-														position++
-													}
-												l725:
-													{
-														position727, tokenIndex727, depth727 := position, tokenIndex, depth
-														if buffer[position] != rune('o') {
-															goto l728
-														}
-														position++
-														goto l727
-													l728:
-														position, tokenIndex, depth = position727, tokenIndex727, depth727
-														if buffer[position] != rune('O') {
-															goto l700
-														}
-														position++
-													}
-												l727:
-													{
-														position729, tokenIndex729, depth729 := position, tokenIndex, depth
-														if buffer[position] != rune('n') {
-															goto l730
-														}
-														position++
-														goto l729
-													l730:
-														position, tokenIndex, depth = position729, tokenIndex729, depth729
-														if buffer[position] != rune('N') {
-															goto l700
-														}
-														position++
-													}
-												l729:
-													{
-														position731, tokenIndex731, depth731 := position, tokenIndex, depth
-														if buffer[position] != rune('c') {
-															goto l732
-														}
-														position++
-														goto l731
-													l732:
-														position, tokenIndex, depth = position731, tokenIndex731, depth731
-														if buffer[position] != rune('C') {
-															goto l700
-														}
-														position++
-													}
-												l731:
-													{
-														position733, tokenIndex733, depth733 := position, tokenIndex, depth
-														if buffer[position] != rune('a') {
-															goto l734
-														}
-														position++
-														goto l733
-													l734:
-														position, tokenIndex, depth = position733, tokenIndex733, depth733
-														if buffer[position] != rune('A') {
-															goto l700
-														}
-														position++
-													}
-												l733:
-													{
-														position735, tokenIndex735, depth735 := position, tokenIndex, depth
-														if buffer[position] != rune('t') {
-															goto l736
-														}
-														position++
-														goto l735
-													l736:
-														position, tokenIndex, depth = position735, tokenIndex735, depth735
-														if buffer[position] != rune('T') {
-															goto l700
-														}
-														position++
-													}
-												l735:
-													if !rules[ruleskip]() {
-														goto l700
-													}
-													depth--
-													add(ruleGROUPCONCAT, position714)
-												}
-												if !rules[ruleLPAREN]() {
-													goto l700
-												}
-												{
-													position737, tokenIndex737, depth737 := position, tokenIndex, depth
-													if !rules[ruleDISTINCT]() {
-														goto l737
-													}
-													goto l738
-												l737:
-													position, tokenIndex, depth = position737, tokenIndex737, depth737
-												}
-											l738:
-												if !rules[ruleexpression]() {
-													goto l700
-												}
-												{
-													position739, tokenIndex739, depth739 := position, tokenIndex, depth
-													if !rules[ruleSEMICOLON]() {
-														goto l739
-													}
-													{
-														position741 := position
-														depth++
-														{
-															position742, tokenIndex742, depth742 := position, tokenIndex, depth
-															if buffer[position] != rune('s') {
-																goto l743
-															}
-															position++
-															goto l742
-														l743:
-															position, tokenIndex, depth = position742, tokenIndex742, depth742
-															if buffer[position] != rune('S') {
-																goto l739
-															}
-															position++
-														}
-													l742:
-														{
-															position744, tokenIndex744, depth744 := position, tokenIndex, depth
-															if buffer[position] != rune('e') {
-																goto l745
-															}
-															position++
-															goto l744
-														l745:
-															position, tokenIndex, depth = position744, tokenIndex744, depth744
-															if buffer[position] != rune('E') {
-																goto l739
-															}
-															position++
-														}
-													l744:
-														{
-															position746, tokenIndex746, depth746 := position, tokenIndex, depth
-															if buffer[position] != rune('p') {
-																goto l747
-															}
-															position++
-															goto l746
-														l747:
-															position, tokenIndex, depth = position746, tokenIndex746, depth746
-															if buffer[position] != rune('P') {
-																goto l739
-															}
-															position++
-														}
-													l746:
-														{
-															position748, tokenIndex748, depth748 := position, tokenIndex, depth
-															if buffer[position] != rune('a') {
-																goto l749
-															}
-															position++
-															goto l748
-														l749:
-															position, tokenIndex, depth = position748, tokenIndex748, depth748
-															if buffer[position] != rune('A') {
-																goto l739
-															}
-															position++
-														}
-													l748:
-														{
-															position750, tokenIndex750, depth750 := position, tokenIndex, depth
-															if buffer[position] != rune('r') {
-																goto l751
-															}
-															position++
-															goto l750
-														l751:
-															position, tokenIndex, depth = position750, tokenIndex750, depth750
-															if buffer[position] != rune('R') {
-																goto l739
-															}
-															position++
-														}
-													l750:
-														{
-															position752, tokenIndex752, depth752 := position, tokenIndex, depth
-															if buffer[position] != rune('a') {
-																goto l753
-															}
-															position++
-															goto l752
-														l753:
-															position, tokenIndex, depth = position752, tokenIndex752, depth752
-															if buffer[position] != rune('A') {
-																goto l739
-															}
-															position++
-														}
-													l752:
-														{
-															position754, tokenIndex754, depth754 := position, tokenIndex, depth
-															if buffer[position] != rune('t') {
-																goto l755
-															}
-															position++
-															goto l754
-														l755:
-															position, tokenIndex, depth = position754, tokenIndex754, depth754
-															if buffer[position] != rune('T') {
-																goto l739
-															}
-															position++
-														}
-													l754:
-														{
-															position756, tokenIndex756, depth756 := position, tokenIndex, depth
-															if buffer[position] != rune('o') {
-																goto l757
-															}
-															position++
-															goto l756
-														l757:
-															position, tokenIndex, depth = position756, tokenIndex756, depth756
-															if buffer[position] != rune('O') {
-																goto l739
-															}
-															position++
-														}
-													l756:
-														{
-															position758, tokenIndex758, depth758 := position, tokenIndex, depth
-															if buffer[position] != rune('r') {
-																goto l759
-															}
-															position++
-															goto l758
-														l759:
-															position, tokenIndex, depth = position758, tokenIndex758, depth758
-															if buffer[position] != rune('R') {
-																goto l739
-															}
-															position++
-														}
-													l758:
-														if !rules[ruleskip]() {
-															goto l739
-														}
-														depth--
-														add(ruleSEPARATOR, position741)
-													}
-													if !rules[ruleEQ]() {
-														goto l739
-													}
-													if !rules[rulestring]() {
-														goto l739
-													}
-													goto l740
-												l739:
-													position, tokenIndex, depth = position739, tokenIndex739, depth739
-												}
-											l740:
-												if !rules[ruleRPAREN]() {
-													goto l700
-												}
-												depth--
-												add(rulegroupConcat, position713)
+										position829 := position
+										depth++
+										{
+											position830, tokenIndex830, depth830 := position, tokenIndex, depth
+											if buffer[position] != rune('c') {
+												goto l831
 											}
-											break
-										case 'C', 'c':
-											{
-												position760 := position
-												depth++
-												{
-													position761 := position
-													depth++
-													{
-														position762, tokenIndex762, depth762 := position, tokenIndex, depth
-														if buffer[position] != rune('c') {
-															goto l763
-														}
-														position++
-														goto l762
-													l763:
-														position, tokenIndex, depth = position762, tokenIndex762, depth762
-														if buffer[position] != rune('C') {
-															goto l700
-														}
-														position++
-													}
-												l762:
-													{
-														position764, tokenIndex764, depth764 := position, tokenIndex, depth
-														if buffer[position] != rune('o') {
-															goto l765
-														}
-														position++
-														goto l764
-													l765:
-														position, tokenIndex, depth = position764, tokenIndex764, depth764
-														if buffer[position] != rune('O') {
-															goto l700
-														}
-														position++
-													}
-												l764:
-													{
-														position766, tokenIndex766, depth766 := position, tokenIndex, depth
-														if buffer[position] != rune('u') {
-															goto l767
-														}
-														position++
-														goto l766
-													l767:
-														position, tokenIndex, depth = position766, tokenIndex766, depth766
-														if buffer[position] != rune('U') {
-															goto l700
-														}
-														position++
-													}
-												l766:
-													{
-														position768, tokenIndex768, depth768 := position, tokenIndex, depth
-														if buffer[position] != rune('n') {
-															goto l769
-														}
-														position++
-														goto l768
-													l769:
-														position, tokenIndex, depth = position768, tokenIndex768, depth768
-														if buffer[position] != rune('N') {
-															goto l700
-														}
-														position++
-													}
-												l768:
-													{
-														position770, tokenIndex770, depth770 := position, tokenIndex, depth
-														if buffer[position] != rune('t') {
-															goto l771
-														}
-														position++
-														goto l770
-													l771:
-														position, tokenIndex, depth = position770, tokenIndex770, depth770
-														if buffer[position] != rune('T') {
-															goto l700
-														}
-														position++
-													}
-												l770:
-													if !rules[ruleskip]() {
-														goto l700
-													}
-													depth--
-													add(ruleCOUNT, position761)
+											position++
+											goto l830
+										l831:
+											position, tokenIndex, depth = position830, tokenIndex830, depth830
+											if buffer[position] != rune('C') {
+												goto l827
+											}
+											position++
+										}
+									l830:
+										{
+											position832, tokenIndex832, depth832 := position, tokenIndex, depth
+											if buffer[position] != rune('o') {
+												goto l833
+											}
+											position++
+											goto l832
+										l833:
+											position, tokenIndex, depth = position832, tokenIndex832, depth832
+											if buffer[position] != rune('O') {
+												goto l827
+											}
+											position++
+										}
+									l832:
+										{
+											position834, tokenIndex834, depth834 := position, tokenIndex, depth
+											if buffer[position] != rune('u') {
+												goto l835
+											}
+											position++
+											goto l834
+										l835:
+											position, tokenIndex, depth = position834, tokenIndex834, depth834
+											if buffer[position] != rune('U') {
+												goto l827
+											}
+											position++
+										}
+									l834:
+										{
+											position836, tokenIndex836, depth836 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l837
+											}
+											position++
+											goto l836
+										l837:
+											position, tokenIndex, depth = position836, tokenIndex836, depth836
+											if buffer[position] != rune('N') {
+												goto l827
+											}
+											position++
+										}
+									l836:
+										{
+											position838, tokenIndex838, depth838 := position, tokenIndex, depth
+											if buffer[position] != rune('t') {
+												goto l839
+											}
+											position++
+											goto l838
+										l839:
+											position, tokenIndex, depth = position838, tokenIndex838, depth838
+											if buffer[position] != rune('T') {
+												goto l827
+											}
+											position++
+										}
+									l838:
+										if !_rules[ruleskip]() {
+											goto l827
+										}
+										depth--
+										add(ruleCOUNT, position829)
+									}
+									if !_rules[ruleLPAREN]() {
+										goto l827
+									}
+									{
+										position840, tokenIndex840, depth840 := position, tokenIndex, depth
+										if !_rules[ruleDISTINCT]() {
+											goto l840
+										}
+										goto l841
+									l840:
+										position, tokenIndex, depth = position840, tokenIndex840, depth840
+									}
+								l841:
+									{
+										position842, tokenIndex842, depth842 := position, tokenIndex, depth
+										if !_rules[ruleSTAR]() {
+											goto l843
+										}
+										goto l842
+									l843:
+										position, tokenIndex, depth = position842, tokenIndex842, depth842
+										if !_rules[ruleexpression]() {
+											goto l827
+										}
+									}
+								l842:
+									if !_rules[ruleRPAREN]() {
+										goto l827
+									}
+									depth--
+									add(rulecount, position828)
+								}
+								goto l826
+							l827:
+								position, tokenIndex, depth = position826, tokenIndex826, depth826
+								{
+									position845 := position
+									depth++
+									{
+										position846 := position
+										depth++
+										{
+											position847, tokenIndex847, depth847 := position, tokenIndex, depth
+											if buffer[position] != rune('g') {
+												goto l848
+											}
+											position++
+											goto l847
+										l848:
+											position, tokenIndex, depth = position847, tokenIndex847, depth847
+											if buffer[position] != rune('G') {
+												goto l844
+											}
+											position++
+										}
+									l847:
+										{
+											position849, tokenIndex849, depth849 := position, tokenIndex, depth
+											if buffer[position] != rune('r') {
+												goto l850
+											}
+											position++
+											goto l849
+										l850:
+											position, tokenIndex, depth = position849, tokenIndex849, depth849
+											if buffer[position] != rune('R') {
+												goto l844
+											}
+											position++
+										}
+									l849:
+										{
+											position851, tokenIndex851, depth851 := position, tokenIndex, depth
+											if buffer[position] != rune('o') {
+												goto l852
+											}
+											position++
+											goto l851
+										l852:
+											position, tokenIndex, depth = position851, tokenIndex851, depth851
+											if buffer[position] != rune('O') {
+												goto l844
+											}
+											position++
+										}
+									l851:
+										{
+											position853, tokenIndex853, depth853 := position, tokenIndex, depth
+											if buffer[position] != rune('u') {
+												goto l854
+											}
+											position++
+											goto l853
+										l854:
+											position, tokenIndex, depth = position853, tokenIndex853, depth853
+											if buffer[position] != rune('U') {
+												goto l844
+											}
+											position++
+										}
+									l853:
+										{
+											position855, tokenIndex855, depth855 := position, tokenIndex, depth
+											if buffer[position] != rune('p') {
+												goto l856
+											}
+											position++
+											goto l855
+										l856:
+											position, tokenIndex, depth = position855, tokenIndex855, depth855
+											if buffer[position] != rune('P') {
+												goto l844
+											}
+											position++
+										}
+									l855:
+										if buffer[position] != rune('_') {
+											goto l844
+										}
+										position++
+										{
+											position857, tokenIndex857, depth857 := position, tokenIndex, depth
+											if buffer[position] != rune('c') {
+												goto l858
+											}
+											position++
+											goto l857
+										l858:
+											position, tokenIndex, depth = position857, tokenIndex857, depth857
+											if buffer[position] != rune('C') {
+												goto l844
+											}
+											position++
+										}
+									l857:
+										{
+											position859, tokenIndex859, depth859 := position, tokenIndex, depth
+											if buffer[position] != rune('o') {
+												goto l860
+											}
+											position++
+											goto l859
+										l860:
+											position, tokenIndex, depth = position859, tokenIndex859, depth859
+											if buffer[position] != rune('O') {
+												goto l844
+											}
+											position++
+										}
+									l859:
+										{
+											position861, tokenIndex861, depth861 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l862
+											}
+											position++
+											goto l861
+										l862:
+											position, tokenIndex, depth = position861, tokenIndex861, depth861
+											if buffer[position] != rune('N') {
+												goto l844
+											}
+											position++
+										}
+									l861:
+										{
+											position863, tokenIndex863, depth863 := position, tokenIndex, depth
+											if buffer[position] != rune('c') {
+												goto l864
+											}
+											position++
+											goto l863
+										l864:
+											position, tokenIndex, depth = position863, tokenIndex863, depth863
+											if buffer[position] != rune('C') {
+												goto l844
+											}
+											position++
+										}
+									l863:
+										{
+											position865, tokenIndex865, depth865 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l866
+											}
+											position++
+											goto l865
+										l866:
+											position, tokenIndex, depth = position865, tokenIndex865, depth865
+											if buffer[position] != rune('A') {
+												goto l844
+											}
+											position++
+										}
+									l865:
+										{
+											position867, tokenIndex867, depth867 := position, tokenIndex, depth
+											if buffer[position] != rune('t') {
+												goto l868
+											}
+											position++
+											goto l867
+										l868:
+											position, tokenIndex, depth = position867, tokenIndex867, depth867
+											if buffer[position] != rune('T') {
+												goto l844
+											}
+											position++
+										}
+									l867:
+										if !_rules[ruleskip]() {
+											goto l844
+										}
+										depth--
+										add(ruleGROUPCONCAT, position846)
+									}
+									if !_rules[ruleLPAREN]() {
+										goto l844
+									}
+									{
+										position869, tokenIndex869, depth869 := position, tokenIndex, depth
+										if !_rules[ruleDISTINCT]() {
+											goto l869
+										}
+										goto l870
+									l869:
+										position, tokenIndex, depth = position869, tokenIndex869, depth869
+									}
+								l870:
+									if !_rules[ruleexpression]() {
+										goto l844
+									}
+									{
+										position871, tokenIndex871, depth871 := position, tokenIndex, depth
+										if !_rules[ruleSEMICOLON]() {
+											goto l871
+										}
+										{
+											position873 := position
+											depth++
+											{
+												position874, tokenIndex874, depth874 := position, tokenIndex, depth
+												if buffer[position] != rune('s') {
+													goto l875
 												}
-												if !rules[ruleLPAREN]() {
-													goto l700
+												position++
+												goto l874
+											l875:
+												position, tokenIndex, depth = position874, tokenIndex874, depth874
+												if buffer[position] != rune('S') {
+													goto l871
 												}
-												{
-													position772, tokenIndex772, depth772 := position, tokenIndex, depth
-													if !rules[ruleDISTINCT]() {
-														goto l772
-													}
-													goto l773
-												l772:
-													position, tokenIndex, depth = position772, tokenIndex772, depth772
+												position++
+											}
+										l874:
+											{
+												position876, tokenIndex876, depth876 := position, tokenIndex, depth
+												if buffer[position] != rune('e') {
+													goto l877
 												}
-											l773:
-												{
-													position774, tokenIndex774, depth774 := position, tokenIndex, depth
-													if !rules[ruleSTAR]() {
-														goto l775
-													}
-													goto l774
-												l775:
-													position, tokenIndex, depth = position774, tokenIndex774, depth774
-													if !rules[ruleexpression]() {
-														goto l700
-													}
+												position++
+												goto l876
+											l877:
+												position, tokenIndex, depth = position876, tokenIndex876, depth876
+												if buffer[position] != rune('E') {
+													goto l871
 												}
-											l774:
-												if !rules[ruleRPAREN]() {
-													goto l700
+												position++
+											}
+										l876:
+											{
+												position878, tokenIndex878, depth878 := position, tokenIndex, depth
+												if buffer[position] != rune('p') {
+													goto l879
 												}
-												depth--
-												add(rulecount, position760)
+												position++
+												goto l878
+											l879:
+												position, tokenIndex, depth = position878, tokenIndex878, depth878
+												if buffer[position] != rune('P') {
+													goto l871
+												}
+												position++
 											}
-											break
-										default:
+										l878:
 											{
-												position776, tokenIndex776, depth776 := position, tokenIndex, depth
-												{
-													position778 := position
-													depth++
-													{
-														position779, tokenIndex779, depth779 := position, tokenIndex, depth
-														if buffer[position] != rune('s') {
-															goto l780
-														}
-														position++
-														goto l779
-													l780:
-														position, tokenIndex, depth = position779, tokenIndex779, depth779
-														if buffer[position] != rune('S') {
-															goto l777
-														}
-														position++
-													}
-												l779:
-													{
-														position781, tokenIndex781, depth781 := position, tokenIndex, depth
-														if buffer[position] != rune('u') {
-															goto l782
-														}
-														position++
-														goto l781
-													l782:
-														position, tokenIndex, depth = position781, tokenIndex781, depth781
-														if buffer[position] != rune('U') {
-															goto l777
-														}
-														position++
-													}
-												l781:
-													{
-														position783, tokenIndex783, depth783 := position, tokenIndex, depth
-														if buffer[position] != rune('m') {
-															goto l784
-														}
-														position++
-														goto l783
-													l784:
-														position, tokenIndex, depth = position783, tokenIndex783, depth783
-														if buffer[position] != rune('M') {
-															goto l777
-														}
-														position++
-													}
-												l783:
-													if !rules[ruleskip]() {
-														goto l777
-													}
-													depth--
-													add(ruleSUM, position778)
+												position880, tokenIndex880, depth880 := position, tokenIndex, depth
+												if buffer[position] != rune('a') {
+													goto l881
 												}
-												goto l776
-											l777:
-												position, tokenIndex, depth = position776, tokenIndex776, depth776
-												{
-													position786 := position
-													depth++
-													{
-														position787, tokenIndex787, depth787 := position, tokenIndex, depth
-														if buffer[position] != rune('m') {
-															goto l788
-														}
-														position++
-														goto l787
-													l788:
-														position, tokenIndex, depth = position787, tokenIndex787, depth787
-														if buffer[position] != rune('M') {
-															goto l785
-														}
-														position++
-													}
-												l787:
-													{
-														position789, tokenIndex789, depth789 := position, tokenIndex, depth
-														if buffer[position] != rune('i') {
-															goto l790
-														}
-														position++
-														goto l789
-													l790:
-														position, tokenIndex, depth = position789, tokenIndex789, depth789
-														if buffer[position] != rune('I') {
-															goto l785
-														}
-														position++
-													}
-												l789:
-													{
-														position791, tokenIndex791, depth791 := position, tokenIndex, depth
-														if buffer[position] != rune('n') {
-															goto l792
-														}
-														position++
-														goto l791
-													l792:
-														position, tokenIndex, depth = position791, tokenIndex791, depth791
-														if buffer[position] != rune('N') {
-															goto l785
-														}
-														position++
-													}
-												l791:
-													if !rules[ruleskip]() {
-														goto l785
-													}
-													depth--
-													add(ruleMIN, position786)
+												position++
+												goto l880
+											l881:
+												position, tokenIndex, depth = position880, tokenIndex880, depth880
+												if buffer[position] != rune('A') {
+													goto l871
 												}
-												goto l776
-											l785:
-												position, tokenIndex, depth = position776, tokenIndex776, depth776
-												{
-													switch buffer[position] {
-													case 'S', 's':
-														{
-															position794 := position
-															depth++
-															{
-																position795, tokenIndex795, depth795 := position, tokenIndex, depth
-																if buffer[position] != rune('s') {
-																	goto l796
-																}
-																position++
-																goto l795
-															l796:
-																position, tokenIndex, depth = position795, tokenIndex795, depth795
-																if buffer[position] != rune('S') {
-																	goto l700
-																}
-																position++
-															}
-														l795:
-															{
-																position797, tokenIndex797, depth797 := position, tokenIndex, depth
-																if buffer[position] != rune('a') {
-																	goto l798
-																}
-																position++
-																goto l797
-															l798:
-																position, tokenIndex, depth = position797, tokenIndex797, depth797
-																if buffer[position] != rune('A') {
-																	goto l700
-																}
-																position++
-															}
-														l797:
-															{
-																position799, tokenIndex799, depth799 := position, tokenIndex, depth
-																if buffer[position] != rune('m') {
-																	goto l800
-																}
-																position++
-																goto l799
-															l800:
-																position, tokenIndex, depth = position799, tokenIndex799, depth799
-																if buffer[position] != rune('M') {
-																	goto l700
-																}
-																position++
-															}
-														l799:
-															{
-																position801, tokenIndex801, depth801 := position, tokenIndex, depth
-																if buffer[position] != rune('p') {
-																	goto l802
-																}
-																position++
-																goto l801
-															l802:
-																position, tokenIndex, depth = position801, tokenIndex801, depth801
-																if buffer[position] != rune('P') {
-																	goto l700
-																}
-																position++
-															}
-														l801:
-															{
-																position803, tokenIndex803, depth803 := position, tokenIndex, depth
-																if buffer[position] != rune('l') {
-																	goto l804
-																}
-																position++
-																goto l803
-															l804:
-																position, tokenIndex, depth = position803, tokenIndex803, depth803
-																if buffer[position] != rune('L') {
-																	goto l700
-																}
-																position++
-															}
-														l803:
-															{
-																position805, tokenIndex805, depth805 := position, tokenIndex, depth
-																if buffer[position] != rune('e') {
-																	goto l806
-																}
-																position++
-																goto l805
-															l806:
-																position, tokenIndex, depth = position805, tokenIndex805, depth805
-																if buffer[position] != rune('E') {
-																	goto l700
-																}
-																position++
-															}
-														l805:
-															if !rules[ruleskip]() {
-																goto l700
-															}
-															depth--
-															add(ruleSAMPLE, position794)
-														}
-														break
-													case 'A', 'a':
-														{
-															position807 := position
-															depth++
-															{
-																position808, tokenIndex808, depth808 := position, tokenIndex, depth
-																if buffer[position] != rune('a') {
-																	goto l809
-																}
-																position++
-																goto l808
-															l809:
-																position, tokenIndex, depth = position808, tokenIndex808, depth808
-																if buffer[position] != rune('A') {
-																	goto l700
-																}
-																position++
-															}
-														l808:
-															{
-																position810, tokenIndex810, depth810 := position, tokenIndex, depth
-																if buffer[position] != rune('v') {
-																	goto l811
-																}
-																position++
-																goto l810
-															l811:
-																position, tokenIndex, depth = position810, tokenIndex810, depth810
-																if buffer[position] != rune('V') {
-																	goto l700
-																}
-																position++
-															}
-														l810:
-															{
-																position812, tokenIndex812, depth812 := position, tokenIndex, depth
-																if buffer[position] != rune('g') {
-																	goto l813
-																}
-																position++
-																goto l812
-															l813:
-																position, tokenIndex, depth = position812, tokenIndex812, depth812
-																if buffer[position] != rune('G') {
-																	goto l700
-																}
-																position++
-															}
-														l812:
-															if !rules[ruleskip]() {
-																goto l700
-															}
-															depth--
-															add(ruleAVG, position807)
-														}
-														break
-													default:
-														{
-															position814 := position
-															depth++
-															{
-																position815, tokenIndex815, depth815 := position, tokenIndex, depth
-																if buffer[position] != rune('m') {
-																	goto l816
-																}
-																position++
-																goto l815
-															l816:
-																position, tokenIndex, depth = position815, tokenIndex815, depth815
-																if buffer[position] != rune('M') {
-																	goto l700
-																}
-																position++
-															}
-														l815:
-															{
-																position817, tokenIndex817, depth817 := position, tokenIndex, depth
-																if buffer[position] != rune('a') {
-																	goto l818
-																}
-																position++
-																goto l817
-															l818:
-																position, tokenIndex, depth = position817, tokenIndex817, depth817
-																if buffer[position] != rune('A') {
-																	goto l700
-																}
-																position++
-															}
-														l817:
-															{
-																position819, tokenIndex819, depth819 := position, tokenIndex, depth
-																if buffer[position] != rune('x') {
-																	goto l820
-																}
-																position++
-																goto l819
-															l820:
-																position, tokenIndex, depth = position819, tokenIndex819, depth819
-																if buffer[position] != rune('X') {
-																	goto l700
-																}
-																position++
-															}
-														l819:
-															if !rules[ruleskip]() {
-																goto l700
-															}
-															depth--
-															add(ruleMAX, position814)
-														}
-														break
-													}
+												position++
+											}
+										l880:
+											{
+												position882, tokenIndex882, depth882 := position, tokenIndex, depth
+												if buffer[position] != rune('r') {
+													goto l883
 												}
-
+												position++
+												goto l882
+											l883:
+												position, tokenIndex, depth = position882, tokenIndex882, depth882
+												if buffer[position] != rune('R') {
+													goto l871
+												}
+												position++
+											}
+										l882:
+											{
+												position884, tokenIndex884, depth884 := position, tokenIndex, depth
+												if buffer[position] != rune('a') {
+													goto l885
+												}
+												position++
+												goto l884
+											l885:
+												position, tokenIndex, depth = position884, tokenIndex884, depth884
+												if buffer[position] != rune('A') {
+													goto l871
+												}
+												position++
 											}
-										l776:
-											if !rules[ruleLPAREN]() {
-												goto l700
+										l884:
+											{
+												position886, tokenIndex886, depth886 := position, tokenIndex, depth
+												if buffer[position] != rune('t') {
+													goto l887
+												}
+												position++
+												goto l886
+											l887:
+												position, tokenIndex, depth = position886, tokenIndex886, depth886
+												if buffer[position] != rune('T') {
+													goto l871
+												}
+												position++
 											}
+										l886:
 											{
-												position821, tokenIndex821, depth821 := position, tokenIndex, depth
-												if !rules[ruleDISTINCT]() {
-													goto l821
+												position888, tokenIndex888, depth888 := position, tokenIndex, depth
+												if buffer[position] != rune('o') {
+													goto l889
+												}
+												position++
+												goto l888
+											l889:
+												position, tokenIndex, depth = position888, tokenIndex888, depth888
+												if buffer[position] != rune('O') {
+													goto l871
 												}
-												goto l822
-											l821:
-												position, tokenIndex, depth = position821, tokenIndex821, depth821
+												position++
 											}
-										l822:
-											if !rules[ruleexpression]() {
-												goto l700
+										l888:
+											{
+												position890, tokenIndex890, depth890 := position, tokenIndex, depth
+												if buffer[position] != rune('r') {
+													goto l891
+												}
+												position++
+												goto l890
+											l891:
+												position, tokenIndex, depth = position890, tokenIndex890, depth890
+												if buffer[position] != rune('R') {
+													goto l871
+												}
+												position++
 											}
-											if !rules[ruleRPAREN]() {
-												goto l700
+										l890:
+											if !_rules[ruleskip]() {
+												goto l871
 											}
-											break
+											depth--
+											add(ruleSEPARATOR, position873)
+										}
+										if !_rules[ruleEQ]() {
+											goto l871
 										}
+										if !_rules[rulestring]() {
+											goto l871
+										}
+										goto l872
+									l871:
+										position, tokenIndex, depth = position871, tokenIndex871, depth871
+									}
+								l872:
+									if !_rules[ruleRPAREN]() {
+										goto l844
 									}
-
 									depth--
-									add(ruleaggregate, position711)
-								}
-								break
-							case '$', '?':
-								if !rules[rulevar]() {
-									goto l700
+									add(rulegroupConcat, position845)
 								}
-								break
-							case 'F', 'T', 'f', 't':
-								if !rules[rulebooleanLiteral]() {
-									goto l700
-								}
-								break
-							case '(':
-								if !rules[rulebrackettedExpression]() {
-									goto l700
-								}
-								break
-							case '"', '\'':
-								if !rules[ruleliteral]() {
-									goto l700
-								}
-								break
-							default:
-								if !rules[rulenumericLiteral]() {
-									goto l700
-								}
-								break
-							}
-						}
-
-					}
-				l706:
-					depth--
-					add(ruleprimaryExpression, position705)
-				}
-				depth--
-				add(ruleunaryExpression, position701)
-			}
-			return true
-		l700:
-			position, tokenIndex, depth = position700, tokenIndex700, depth700
-			return false
-		},
-		/* 65 primaryExpression <- <(builtinCall / functionCall / iriref / ((&('A' | 'C' | 'G' | 'M' | 'S' | 'a' | 'c' | 'g' | 'm' | 's') aggregate) | (&('$' | '?') var) | (&('F' | 'T' | 'f' | 't') booleanLiteral) | (&('(') brackettedExpression) | (&('"' | '\'') literal) | (&('+' | '-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') numericLiteral)))> */
-		nil,
-		/* 66 brackettedExpression <- <(LPAREN expression RPAREN)> */
-		func() bool {
-			position824, tokenIndex824, depth824 := position, tokenIndex, depth
-			{
-				position825 := position
-				depth++
-				if !rules[ruleLPAREN]() {
-					goto l824
-				}
-				if !rules[ruleexpression]() {
-					goto l824
-				}
-				if !rules[ruleRPAREN]() {
-					goto l824
-				}
-				depth--
-				add(rulebrackettedExpression, position825)
-			}
+								goto l826
+							l844:
+								position, tokenIndex, depth = position826, tokenIndex826, depth826
+								{
+									position892, tokenIndex892, depth892 := position, tokenIndex, depth
+									{
+										position894 := position
+										depth++
+										{
+											position895, tokenIndex895, depth895 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l896
+											}
+											position++
+											goto l895
+										l896:
+											position, tokenIndex, depth = position895, tokenIndex895, depth895
+											if buffer[position] != rune('S') {
+												goto l893
+											}
+											position++
+										}
+									l895:
+										{
+											position897, tokenIndex897, depth897 := position, tokenIndex, depth
+											if buffer[position] != rune('u') {
+												goto l898
+											}
+											position++
+											goto l897
+										l898:
+											position, tokenIndex, depth = position897, tokenIndex897, depth897
+											if buffer[position] != rune('U') {
+												goto l893
+											}
+											position++
+										}
+									l897:
+										{
+											position899, tokenIndex899, depth899 := position, tokenIndex, depth
+											if buffer[position] != rune('m') {
+												goto l900
+											}
+											position++
+											goto l899
+										l900:
+											position, tokenIndex, depth = position899, tokenIndex899, depth899
+											if buffer[position] != rune('M') {
+												goto l893
+											}
+											position++
+										}
+									l899:
+										if !_rules[ruleskip]() {
+											goto l893
+										}
+										depth--
+										add(ruleSUM, position894)
+									}
+									goto l892
+								l893:
+									position, tokenIndex, depth = position892, tokenIndex892, depth892
+									{
+										position902 := position
+										depth++
+										{
+											position903, tokenIndex903, depth903 := position, tokenIndex, depth
+											if buffer[position] != rune('m') {
+												goto l904
+											}
+											position++
+											goto l903
+										l904:
+											position, tokenIndex, depth = position903, tokenIndex903, depth903
+											if buffer[position] != rune('M') {
+												goto l901
+											}
+											position++
+										}
+									l903:
+										{
+											position905, tokenIndex905, depth905 := position, tokenIndex, depth
+											if buffer[position] != rune('i') {
+												goto l906
+											}
+											position++
+											goto l905
+										l906:
+											position, tokenIndex, depth = position905, tokenIndex905, depth905
+											if buffer[position] != rune('I') {
+												goto l901
+											}
+											position++
+										}
+									l905:
+										{
+											position907, tokenIndex907, depth907 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l908
+											}
+											position++
+											goto l907
+										l908:
+											position, tokenIndex, depth = position907, tokenIndex907, depth907
+											if buffer[position] != rune('N') {
+												goto l901
+											}
+											position++
+										}
+									l907:
+										if !_rules[ruleskip]() {
+											goto l901
+										}
+										depth--
+										add(ruleMIN, position902)
+									}
+									goto l892
+								l901:
+									position, tokenIndex, depth = position892, tokenIndex892, depth892
+									{
+										position910 := position
+										depth++
+										{
+											position911, tokenIndex911, depth911 := position, tokenIndex, depth
+											if buffer[position] != rune('m') {
+												goto l912
+											}
+											position++
+											goto l911
+										l912:
+											position, tokenIndex, depth = position911, tokenIndex911, depth911
+											if buffer[position] != rune('M') {
+												goto l909
+											}
+											position++
+										}
+									l911:
+										{
+											position913, tokenIndex913, depth913 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l914
+											}
+											position++
+											goto l913
+										l914:
+											position, tokenIndex, depth = position913, tokenIndex913, depth913
+											if buffer[position] != rune('A') {
+												goto l909
+											}
+											position++
+										}
+									l913:
+										{
+											position915, tokenIndex915, depth915 := position, tokenIndex, depth
+											if buffer[position] != rune('x') {
+												goto l916
+											}
+											position++
+											goto l915
+										l916:
+											position, tokenIndex, depth = position915, tokenIndex915, depth915
+											if buffer[position] != rune('X') {
+												goto l909
+											}
+											position++
+										}
+									l915:
+										if !_rules[ruleskip]() {
+											goto l909
+										}
+										depth--
+										add(ruleMAX, position910)
+									}
+									goto l892
+								l909:
+									position, tokenIndex, depth = position892, tokenIndex892, depth892
+									{
+										position918 := position
+										depth++
+										{
+											position919, tokenIndex919, depth919 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l920
+											}
+											position++
+											goto l919
+										l920:
+											position, tokenIndex, depth = position919, tokenIndex919, depth919
+											if buffer[position] != rune('A') {
+												goto l917
+											}
+											position++
+										}
+									l919:
+										{
+											position921, tokenIndex921, depth921 := position, tokenIndex, depth
+											if buffer[position] != rune('v') {
+												goto l922
+											}
+											position++
+											goto l921
+										l922:
+											position, tokenIndex, depth = position921, tokenIndex921, depth921
+											if buffer[position] != rune('V') {
+												goto l917
+											}
+											position++
+										}
+									l921:
+										{
+											position923, tokenIndex923, depth923 := position, tokenIndex, depth
+											if buffer[position] != rune('g') {
+												goto l924
+											}
+											position++
+											goto l923
+										l924:
+											position, tokenIndex, depth = position923, tokenIndex923, depth923
+											if buffer[position] != rune('G') {
+												goto l917
+											}
+											position++
+										}
+									l923:
+										if !_rules[ruleskip]() {
+											goto l917
+										}
+										depth--
+										add(ruleAVG, position918)
+									}
+									goto l892
+								l917:
+									position, tokenIndex, depth = position892, tokenIndex892, depth892
+									{
+										position925 := position
+										depth++
+										{
+											position926, tokenIndex926, depth926 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l927
+											}
+											position++
+											goto l926
+										l927:
+											position, tokenIndex, depth = position926, tokenIndex926, depth926
+											if buffer[position] != rune('S') {
+												goto l824
+											}
+											position++
+										}
+									l926:
+										{
+											position928, tokenIndex928, depth928 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l929
+											}
+											position++
+											goto l928
+										l929:
+											position, tokenIndex, depth = position928, tokenIndex928, depth928
+											if buffer[position] != rune('A') {
+												goto l824
+											}
+											position++
+										}
+									l928:
+										{
+											position930, tokenIndex930, depth930 := position, tokenIndex, depth
+											if buffer[position] != rune('m') {
+												goto l931
+											}
+											position++
+											goto l930
+										l931:
+											position, tokenIndex, depth = position930, tokenIndex930, depth930
+											if buffer[position] != rune('M') {
+												goto l824
+											}
+											position++
+										}
+									l930:
+										{
+											position932, tokenIndex932, depth932 := position, tokenIndex, depth
+											if buffer[position] != rune('p') {
+												goto l933
+											}
+											position++
+											goto l932
+										l933:
+											position, tokenIndex, depth = position932, tokenIndex932, depth932
+											if buffer[position] != rune('P') {
+												goto l824
+											}
+											position++
+										}
+									l932:
+										{
+											position934, tokenIndex934, depth934 := position, tokenIndex, depth
+											if buffer[position] != rune('l') {
+												goto l935
+											}
+											position++
+											goto l934
+										l935:
+											position, tokenIndex, depth = position934, tokenIndex934, depth934
+											if buffer[position] != rune('L') {
+												goto l824
+											}
+											position++
+										}
+									l934:
+										{
+											position936, tokenIndex936, depth936 := position, tokenIndex, depth
+											if buffer[position] != rune('e') {
+												goto l937
+											}
+											position++
+											goto l936
+										l937:
+											position, tokenIndex, depth = position936, tokenIndex936, depth936
+											if buffer[position] != rune('E') {
+												goto l824
+											}
+											position++
+										}
+									l936:
+										if !_rules[ruleskip]() {
+											goto l824
+										}
+										depth--
+										add(ruleSAMPLE, position925)
+									}
+								}
+							l892:
+								if !_rules[ruleLPAREN]() {
+									goto l824
+								}
+								{
+									position938, tokenIndex938, depth938 := position, tokenIndex, depth
+									if !_rules[ruleDISTINCT]() {
+										goto l938
+									}
+									goto l939
+								l938:
+									position, tokenIndex, depth = position938, tokenIndex938, depth938
+								}
+							l939:
+								if !_rules[ruleexpression]() {
+									goto l824
+								}
+								if !_rules[ruleRPAREN]() {
+									goto l824
+								}
+							}
+						l826:
+							depth--
+							add(ruleaggregate, position825)
+						}
+						goto l815
+					l824:
+						position, tokenIndex, depth = position815, tokenIndex815, depth815
+						if !(p.modifiers) {
+							goto l807
+						}
+						if !_rules[rulepofVariable]() {
+							goto l807
+						}
+					}
+				l815:
+					depth--
+					add(ruleprimaryExpression, position814)
+				}
+				depth--
+				add(ruleunaryExpression, position808)
+			}
+			return true
+		l807:
+			position, tokenIndex, depth = position807, tokenIndex807, depth807
+			return false
+		},
+		/* 74 primaryExpression <- <(brackettedExpression / builtinCall / functionCall / iriref / literal / numericLiteral / booleanLiteral / var / aggregate / (&{ p.modifiers } pofVariable))> */
+		nil,
+		/* 75 brackettedExpression <- <(LPAREN expression RPAREN)> */
+		func() bool {
+			position941, tokenIndex941, depth941 := position, tokenIndex, depth
+			{
+				position942 := position
+				depth++
+				if !_rules[ruleLPAREN]() {
+					goto l941
+				}
+				if !_rules[ruleexpression]() {
+					goto l941
+				}
+				if !_rules[ruleRPAREN]() {
+					goto l941
+				}
+				depth--
+				add(rulebrackettedExpression, position942)
+			}
 			return true
-		l824:
-			position, tokenIndex, depth = position824, tokenIndex824, depth824
+		l941:
+			position, tokenIndex, depth = position941, tokenIndex941, depth941
 			return false
 		},
-		/* 67 functionCall <- <(iriref argList)> */
+		/* 76 functionCall <- <(iriref argList)> */
 		func() bool {
-			position826, tokenIndex826, depth826 := position, tokenIndex, depth
+			position943, tokenIndex943, depth943 := position, tokenIndex, depth
 			{
-				position827 := position
+				position944 := position
 				depth++
-				if !rules[ruleiriref]() {
-					goto l826
+				if !_rules[ruleiriref]() {
+					goto l943
 				}
-				if !rules[ruleargList]() {
-					goto l826
+				if !_rules[ruleargList]() {
+					goto l943
 				}
 				depth--
-				add(rulefunctionCall, position827)
+				add(rulefunctionCall, position944)
 			}
 			return true
-		l826:
-			position, tokenIndex, depth = position826, tokenIndex826, depth826
+		l943:
+			position, tokenIndex, depth = position943, tokenIndex943, depth943
 			return false
 		},
-		/* 68 in <- <(IN argList)> */
+		/* 77 in <- <(IN argList)> */
 		nil,
-		/* 69 notin <- <(NOTIN argList)> */
+		/* 78 notin <- <(NOTIN argList)> */
 		nil,
-		/* 70 argList <- <(nil / (LPAREN expression (COMMA expression)* RPAREN))> */
+		/* 79 argList <- <(nil / (LPAREN expression (COMMA expression)* RPAREN))> */
 		func() bool {
-			position830, tokenIndex830, depth830 := position, tokenIndex, depth
+			position947, tokenIndex947, depth947 := position, tokenIndex, depth
 			{
-				position831 := position
+				position948 := position
 				depth++
 				{
-					position832, tokenIndex832, depth832 := position, tokenIndex, depth
-					if !rules[rulenil]() {
-						goto l833
+					position949, tokenIndex949, depth949 := position, tokenIndex, depth
+					if !_rules[rulenil]() {
+						goto l950
 					}
-					goto l832
-				l833:
-					position, tokenIndex, depth = position832, tokenIndex832, depth832
-					if !rules[ruleLPAREN]() {
-						goto l830
+					goto l949
+				l950:
+					position, tokenIndex, depth = position949, tokenIndex949, depth949
+					if !_rules[ruleLPAREN]() {
+						goto l947
 					}
-					if !rules[ruleexpression]() {
-						goto l830
+					if !_rules[ruleexpression]() {
+						goto l947
 					}
-				l834:
+				l951:
 					{
-						position835, tokenIndex835, depth835 := position, tokenIndex, depth
-						if !rules[ruleCOMMA]() {
-							goto l835
+						position952, tokenIndex952, depth952 := position, tokenIndex, depth
+						if !_rules[ruleCOMMA]() {
+							goto l952
 						}
-						if !rules[ruleexpression]() {
-							goto l835
+						if !_rules[ruleexpression]() {
+							goto l952
 						}
-						goto l834
-					l835:
-						position, tokenIndex, depth = position835, tokenIndex835, depth835
+						goto l951
+					l952:
+						position, tokenIndex, depth = position952, tokenIndex952, depth952
 					}
-					if !rules[ruleRPAREN]() {
-						goto l830
+					if !_rules[ruleRPAREN]() {
+						goto l947
 					}
 				}
-			l832:
+			l949:
 				depth--
-				add(ruleargList, position831)
+				add(ruleargList, position948)
 			}
 			return true
-		l830:
-			position, tokenIndex, depth = position830, tokenIndex830, depth830
+		l947:
+			position, tokenIndex, depth = position947, tokenIndex947, depth947
 			return false
 		},
-		/* 71 aggregate <- <((&('G' | 'g') groupConcat) | (&('C' | 'c') count) | (&('A' | 'M' | 'S' | 'a' | 'm' | 's') ((SUM / MIN / ((&('S' | 's') SAMPLE) | (&('A' | 'a') AVG) | (&('M' | 'm') MAX))) LPAREN DISTINCT? expression RPAREN)))> */
+		/* 80 aggregate <- <(count / groupConcat / ((SUM / MIN / MAX / AVG / SAMPLE) LPAREN DISTINCT? expression RPAREN))> */
 		nil,
-		/* 72 count <- <(COUNT LPAREN DISTINCT? (STAR / expression) RPAREN)> */
+		/* 81 count <- <(COUNT LPAREN DISTINCT? (STAR / expression) RPAREN)> */
 		nil,
-		/* 73 groupConcat <- <(GROUPCONCAT LPAREN DISTINCT? expression (SEMICOLON SEPARATOR EQ string)? RPAREN)> */
+		/* 82 groupConcat <- <(GROUPCONCAT LPAREN DISTINCT? expression (SEMICOLON SEPARATOR EQ string)? RPAREN)> */
 		nil,
-		/* 74 builtinCall <- <(((STR / LANG / DATATYPE / IRI / URI / STRLEN / MONTH / MINUTES / SECONDS / TIMEZONE / SHA1 / SHA256 / SHA384 / ISIRI / ISURI / ISBLANK / ISLITERAL / ((&('I' | 'i') ISNUMERIC) | (&('S' | 's') SHA512) | (&('M' | 'm') MD5) | (&('T' | 't') TZ) | (&('H' | 'h') HOURS) | (&('D' | 'd') DAY) | (&('Y' | 'y') YEAR) | (&('E' | 'e') ENCODEFORURI) | (&('L' | 'l') LCASE) | (&('U' | 'u') UCASE) | (&('F' | 'f') FLOOR) | (&('R' | 'r') ROUND) | (&('C' | 'c') CEIL) | (&('A' | 'a') ABS))) LPAREN expression RPAREN) / ((STRSTARTS / STRENDS / STRBEFORE / STRAFTER / STRLANG / STRDT / ((&('S' | 's') SAMETERM) | (&('C' | 'c') CONTAINS) | (&('L' | 'l') LANGMATCHES))) LPAREN expression COMMA expression RPAREN) / (BOUND LPAREN var RPAREN) / (((&('S' | 's') STRUUID) | (&('U' | 'u') UUID) | (&('N' | 'n') NOW) | (&('R' | 'r') RAND)) nil) / ((&('E' | 'N' | 'e' | 'n') ((EXISTS / NOTEXIST) groupGraphPattern)) | (&('I' | 'i') (IF LPAREN expression COMMA expression COMMA expression RPAREN)) | (&('C' | 'c') ((CONCAT / COALESCE) argList)) | (&('B' | 'b') (BNODE ((LPAREN expression RPAREN) / nil))) | (&('R' | 'S' | 'r' | 's') ((SUBSTR / REPLACE / REGEX) LPAREN expression COMMA expression (COMMA expression)? RPAREN))))> */
+		/* 83 builtinCall <- <(((STRLEN / STR / LANG / DATATYPE / IRI / URI / ABS / CEIL / ROUND / FLOOR / UCASE / LCASE / ENCODEFORURI / YEAR / MONTH / DAY / HOURS / MINUTES / SECONDS / TIMEZONE / TZ / MD5 / SHA1 / SHA256 / SHA384 / SHA512 / ISIRI / ISURI / ISBLANK / ISLITERAL / ISNUMERIC) LPAREN expression RPAREN) / ((LANGMATCHES / CONTAINS / STRSTARTS / STRENDS / STRBEFORE / STRAFTER / STRLANG / STRDT / SAMETERM) LPAREN expression COMMA expression RPAREN) / (BOUND LPAREN var RPAREN) / (BNODE ((LPAREN expression RPAREN) / nil)) / ((RAND / NOW / UUID / STRUUID) nil) / ((CONCAT / COALESCE) argList) / ((SUBSTR / REPLACE / REGEX) LPAREN expression COMMA expression (COMMA expression)? RPAREN) / (IF LPAREN expression COMMA expression COMMA expression RPAREN) / ((EXISTS / NOTEXIST) groupGraphPattern))> */
 		func() bool {
-			position839, tokenIndex839, depth839 := position, tokenIndex, depth
+			position956, tokenIndex956, depth956 := position, tokenIndex, depth
 			{
-				position840 := position
+				position957 := position
 				depth++
 				{
-					position841, tokenIndex841, depth841 := position, tokenIndex, depth
+					position958, tokenIndex958, depth958 := position, tokenIndex, depth
 					{
-						position843, tokenIndex843, depth843 := position, tokenIndex, depth
+						position960, tokenIndex960, depth960 := position, tokenIndex, depth
+						{
+							position962 := position
+							depth++
+							{
+								position963, tokenIndex963, depth963 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l964
+								}
+								position++
+								goto l963
+							l964:
+								position, tokenIndex, depth = position963, tokenIndex963, depth963
+								if buffer[position] != rune('S') {
+									goto l961
+								}
+								position++
+							}
+						l963:
+							{
+								position965, tokenIndex965, depth965 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l966
+								}
+								position++
+								goto l965
+							l966:
+								position, tokenIndex, depth = position965, tokenIndex965, depth965
+								if buffer[position] != rune('T') {
+									goto l961
+								}
+								position++
+							}
+						l965:
+							{
+								position967, tokenIndex967, depth967 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l968
+								}
+								position++
+								goto l967
+							l968:
+								position, tokenIndex, depth = position967, tokenIndex967, depth967
+								if buffer[position] != rune('R') {
+									goto l961
+								}
+								position++
+							}
+						l967:
+							{
+								position969, tokenIndex969, depth969 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l970
+								}
+								position++
+								goto l969
+							l970:
+								position, tokenIndex, depth = position969, tokenIndex969, depth969
+								if buffer[position] != rune('L') {
+									goto l961
+								}
+								position++
+							}
+						l969:
+							{
+								position971, tokenIndex971, depth971 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l972
+								}
+								position++
+								goto l971
+							l972:
+								position, tokenIndex, depth = position971, tokenIndex971, depth971
+								if buffer[position] != rune('E') {
+									goto l961
+								}
+								position++
+							}
+						l971:
+							{
+								position973, tokenIndex973, depth973 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l974
+								}
+								position++
+								goto l973
+							l974:
+								position, tokenIndex, depth = position973, tokenIndex973, depth973
+								if buffer[position] != rune('N') {
+									goto l961
+								}
+								position++
+							}
+						l973:
+							if !_rules[ruleskip]() {
+								goto l961
+							}
+							depth--
+							add(ruleSTRLEN, position962)
+						}
+						goto l960
+					l961:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position845 := position
+							position976 := position
 							depth++
 							{
-								position846, tokenIndex846, depth846 := position, tokenIndex, depth
+								position977, tokenIndex977, depth977 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l847
+									goto l978
 								}
 								position++
-								goto l846
-							l847:
-								position, tokenIndex, depth = position846, tokenIndex846, depth846
+								goto l977
+							l978:
+								position, tokenIndex, depth = position977, tokenIndex977, depth977
 								if buffer[position] != rune('S') {
-									goto l844
+									goto l975
 								}
 								position++
 							}
-						l846:
+						l977:
 							{
-								position848, tokenIndex848, depth848 := position, tokenIndex, depth
+								position979, tokenIndex979, depth979 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l849
+									goto l980
 								}
 								position++
-								goto l848
-							l849:
-								position, tokenIndex, depth = position848, tokenIndex848, depth848
+								goto l979
+							l980:
+								position, tokenIndex, depth = position979, tokenIndex979, depth979
 								if buffer[position] != rune('T') {
-									goto l844
+									goto l975
 								}
 								position++
 							}
-						l848:
+						l979:
 							{
-								position850, tokenIndex850, depth850 := position, tokenIndex, depth
+								position981, tokenIndex981, depth981 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l851
+									goto l982
 								}
 								position++
-								goto l850
-							l851:
-								position, tokenIndex, depth = position850, tokenIndex850, depth850
+								goto l981
+							l982:
+								position, tokenIndex, depth = position981, tokenIndex981, depth981
 								if buffer[position] != rune('R') {
-									goto l844
+									goto l975
 								}
 								position++
 							}
-						l850:
-							if !rules[ruleskip]() {
-								goto l844
+						l981:
+							if !_rules[ruleskip]() {
+								goto l975
 							}
 							depth--
-							add(ruleSTR, position845)
+							add(ruleSTR, position976)
 						}
-						goto l843
-					l844:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l975:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position853 := position
+							position984 := position
 							depth++
 							{
-								position854, tokenIndex854, depth854 := position, tokenIndex, depth
+								position985, tokenIndex985, depth985 := position, tokenIndex, depth
 								if buffer[position] != rune('l') {
-									goto l855
+									goto l986
 								}
 								position++
-								goto l854
-							l855:
-								position, tokenIndex, depth = position854, tokenIndex854, depth854
+								goto l985
+							l986:
+								position, tokenIndex, depth = position985, tokenIndex985, depth985
 								if buffer[position] != rune('L') {
-									goto l852
+									goto l983
 								}
 								position++
 							}
-						l854:
+						l985:
 							{
-								position856, tokenIndex856, depth856 := position, tokenIndex, depth
+								position987, tokenIndex987, depth987 := position, tokenIndex, depth
 								if buffer[position] != rune('a') {
-									goto l857
+									goto l988
 								}
 								position++
-								goto l856
-							l857:
-								position, tokenIndex, depth = position856, tokenIndex856, depth856
+								goto l987
+							l988:
+								position, tokenIndex, depth = position987, tokenIndex987, depth987
 								if buffer[position] != rune('A') {
-									goto l852
+									goto l983
 								}
 								position++
 							}
-						l856:
+						l987:
 							{
-								position858, tokenIndex858, depth858 := position, tokenIndex, depth
+								position989, tokenIndex989, depth989 := position, tokenIndex, depth
 								if buffer[position] != rune('n') {
-									goto l859
+									goto l990
 								}
 								position++
-								goto l858
-							l859:
-								position, tokenIndex, depth = position858, tokenIndex858, depth858
+								goto l989
+							l990:
+								position, tokenIndex, depth = position989, tokenIndex989, depth989
 								if buffer[position] != rune('N') {
-									goto l852
+									goto l983
 								}
 								position++
 							}
-						l858:
+						l989:
 							{
-								position860, tokenIndex860, depth860 := position, tokenIndex, depth
+								position991, tokenIndex991, depth991 := position, tokenIndex, depth
 								if buffer[position] != rune('g') {
-									goto l861
+									goto l992
 								}
 								position++
-								goto l860
-							l861:
-								position, tokenIndex, depth = position860, tokenIndex860, depth860
+								goto l991
+							l992:
+								position, tokenIndex, depth = position991, tokenIndex991, depth991
 								if buffer[position] != rune('G') {
-									goto l852
+									goto l983
 								}
 								position++
 							}
-						l860:
-							if !rules[ruleskip]() {
-								goto l852
+						l991:
+							if !_rules[ruleskip]() {
+								goto l983
 							}
 							depth--
-							add(ruleLANG, position853)
+							add(ruleLANG, position984)
 						}
-						goto l843
-					l852:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l983:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position863 := position
+							position994 := position
 							depth++
 							{
-								position864, tokenIndex864, depth864 := position, tokenIndex, depth
+								position995, tokenIndex995, depth995 := position, tokenIndex, depth
 								if buffer[position] != rune('d') {
-									goto l865
+									goto l996
 								}
 								position++
-								goto l864
-							l865:
-								position, tokenIndex, depth = position864, tokenIndex864, depth864
+								goto l995
+							l996:
+								position, tokenIndex, depth = position995, tokenIndex995, depth995
 								if buffer[position] != rune('D') {
-									goto l862
+									goto l993
 								}
 								position++
 							}
-						l864:
+						l995:
 							{
-								position866, tokenIndex866, depth866 := position, tokenIndex, depth
+								position997, tokenIndex997, depth997 := position, tokenIndex, depth
 								if buffer[position] != rune('a') {
-									goto l867
+									goto l998
 								}
 								position++
-								goto l866
-							l867:
-								position, tokenIndex, depth = position866, tokenIndex866, depth866
+								goto l997
+							l998:
+								position, tokenIndex, depth = position997, tokenIndex997, depth997
 								if buffer[position] != rune('A') {
-									goto l862
+									goto l993
 								}
 								position++
 							}
-						l866:
+						l997:
 							{
-								position868, tokenIndex868, depth868 := position, tokenIndex, depth
+								position999, tokenIndex999, depth999 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l869
+									goto l1000
 								}
 								position++
-								goto l868
-							l869:
-								position, tokenIndex, depth = position868, tokenIndex868, depth868
+								goto l999
+							l1000:
+								position, tokenIndex, depth = position999, tokenIndex999, depth999
 								if buffer[position] != rune('T') {
-									goto l862
+									goto l993
 								}
 								position++
 							}
-						l868:
+						l999:
 							{
-								position870, tokenIndex870, depth870 := position, tokenIndex, depth
+								position1001, tokenIndex1001, depth1001 := position, tokenIndex, depth
 								if buffer[position] != rune('a') {
-									goto l871
+									goto l1002
 								}
 								position++
-								goto l870
-							l871:
-								position, tokenIndex, depth = position870, tokenIndex870, depth870
+								goto l1001
+							l1002:
+								position, tokenIndex, depth = position1001, tokenIndex1001, depth1001
 								if buffer[position] != rune('A') {
-									goto l862
+									goto l993
 								}
 								position++
 							}
-						l870:
+						l1001:
 							{
-								position872, tokenIndex872, depth872 := position, tokenIndex, depth
+								position1003, tokenIndex1003, depth1003 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l873
+									goto l1004
 								}
 								position++
-								goto l872
-							l873:
-								position, tokenIndex, depth = position872, tokenIndex872, depth872
+								goto l1003
+							l1004:
+								position, tokenIndex, depth = position1003, tokenIndex1003, depth1003
 								if buffer[position] != rune('T') {
-									goto l862
+									goto l993
 								}
 								position++
 							}
-						l872:
+						l1003:
 							{
-								position874, tokenIndex874, depth874 := position, tokenIndex, depth
+								position1005, tokenIndex1005, depth1005 := position, tokenIndex, depth
 								if buffer[position] != rune('y') {
-									goto l875
+									goto l1006
 								}
 								position++
-								goto l874
-							l875:
-								position, tokenIndex, depth = position874, tokenIndex874, depth874
+								goto l1005
+							l1006:
+								position, tokenIndex, depth = position1005, tokenIndex1005, depth1005
 								if buffer[position] != rune('Y') {
-									goto l862
+									goto l993
 								}
 								position++
 							}
-						l874:
+						l1005:
 							{
-								position876, tokenIndex876, depth876 := position, tokenIndex, depth
+								position1007, tokenIndex1007, depth1007 := position, tokenIndex, depth
 								if buffer[position] != rune('p') {
-									goto l877
+									goto l1008
 								}
 								position++
-								goto l876
-							l877:
-								position, tokenIndex, depth = position876, tokenIndex876, depth876
+								goto l1007
+							l1008:
+								position, tokenIndex, depth = position1007, tokenIndex1007, depth1007
 								if buffer[position] != rune('P') {
-									goto l862
+									goto l993
 								}
 								position++
 							}
-						l876:
+						l1007:
 							{
-								position878, tokenIndex878, depth878 := position, tokenIndex, depth
+								position1009, tokenIndex1009, depth1009 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l879
+									goto l1010
 								}
 								position++
-								goto l878
-							l879:
-								position, tokenIndex, depth = position878, tokenIndex878, depth878
+								goto l1009
+							l1010:
+								position, tokenIndex, depth = position1009, tokenIndex1009, depth1009
 								if buffer[position] != rune('E') {
-									goto l862
+									goto l993
 								}
 								position++
 							}
-						l878:
-							if !rules[ruleskip]() {
-								goto l862
+						l1009:
+							if !_rules[ruleskip]() {
+								goto l993
 							}
 							depth--
-							add(ruleDATATYPE, position863)
+							add(ruleDATATYPE, position994)
 						}
-						goto l843
-					l862:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l993:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position881 := position
+							position1012 := position
 							depth++
 							{
-								position882, tokenIndex882, depth882 := position, tokenIndex, depth
+								position1013, tokenIndex1013, depth1013 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l883
+									goto l1014
 								}
 								position++
-								goto l882
-							l883:
-								position, tokenIndex, depth = position882, tokenIndex882, depth882
+								goto l1013
+							l1014:
+								position, tokenIndex, depth = position1013, tokenIndex1013, depth1013
 								if buffer[position] != rune('I') {
-									goto l880
+									goto l1011
 								}
 								position++
 							}
-						l882:
+						l1013:
 							{
-								position884, tokenIndex884, depth884 := position, tokenIndex, depth
+								position1015, tokenIndex1015, depth1015 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l885
+									goto l1016
 								}
 								position++
-								goto l884
-							l885:
-								position, tokenIndex, depth = position884, tokenIndex884, depth884
+								goto l1015
+							l1016:
+								position, tokenIndex, depth = position1015, tokenIndex1015, depth1015
 								if buffer[position] != rune('R') {
-									goto l880
+									goto l1011
 								}
 								position++
 							}
-						l884:
+						l1015:
 							{
-								position886, tokenIndex886, depth886 := position, tokenIndex, depth
+								position1017, tokenIndex1017, depth1017 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l887
+									goto l1018
 								}
 								position++
-								goto l886
-							l887:
-								position, tokenIndex, depth = position886, tokenIndex886, depth886
+								goto l1017
+							l1018:
+								position, tokenIndex, depth = position1017, tokenIndex1017, depth1017
 								if buffer[position] != rune('I') {
-									goto l880
+									goto l1011
 								}
 								position++
 							}
-						l886:
-							if !rules[ruleskip]() {
-								goto l880
+						l1017:
+							if !_rules[ruleskip]() {
+								goto l1011
 							}
 							depth--
-							add(ruleIRI, position881)
+							add(ruleIRI, position1012)
 						}
-						goto l843
-					l880:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1011:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position889 := position
+							position1020 := position
 							depth++
 							{
-								position890, tokenIndex890, depth890 := position, tokenIndex, depth
+								position1021, tokenIndex1021, depth1021 := position, tokenIndex, depth
 								if buffer[position] != rune('u') {
-									goto l891
+									goto l1022
 								}
 								position++
-								goto l890
-							l891:
-								position, tokenIndex, depth = position890, tokenIndex890, depth890
+								goto l1021
+							l1022:
+								position, tokenIndex, depth = position1021, tokenIndex1021, depth1021
 								if buffer[position] != rune('U') {
-									goto l888
+									goto l1019
 								}
 								position++
 							}
-						l890:
+						l1021:
 							{
-								position892, tokenIndex892, depth892 := position, tokenIndex, depth
+								position1023, tokenIndex1023, depth1023 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l893
+									goto l1024
 								}
 								position++
-								goto l892
-							l893:
-								position, tokenIndex, depth = position892, tokenIndex892, depth892
+								goto l1023
+							l1024:
+								position, tokenIndex, depth = position1023, tokenIndex1023, depth1023
 								if buffer[position] != rune('R') {
-									goto l888
+									goto l1019
 								}
 								position++
 							}
-						l892:
+						l1023:
 							{
-								position894, tokenIndex894, depth894 := position, tokenIndex, depth
+								position1025, tokenIndex1025, depth1025 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l895
+									goto l1026
 								}
 								position++
-								goto l894
-							l895:
-								position, tokenIndex, depth = position894, tokenIndex894, depth894
+								goto l1025
+							l1026:
+								position, tokenIndex, depth = position1025, tokenIndex1025, depth1025
 								if buffer[position] != rune('I') {
-									goto l888
+									goto l1019
 								}
 								position++
 							}
-						l894:
-							if !rules[ruleskip]() {
-								goto l888
+						l1025:
+							if !_rules[ruleskip]() {
+								goto l1019
 							}
 							depth--
-							add(ruleURI, position889)
+							add(ruleURI, position1020)
 						}
-						goto l843
-					l888:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1019:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position897 := position
+							position1028 := position
 							depth++
 							{
-								position898, tokenIndex898, depth898 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l899
+								position1029, tokenIndex1029, depth1029 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1030
 								}
 								position++
-								goto l898
-							l899:
-								position, tokenIndex, depth = position898, tokenIndex898, depth898
-								if buffer[position] != rune('S') {
-									goto l896
+								goto l1029
+							l1030:
+								position, tokenIndex, depth = position1029, tokenIndex1029, depth1029
+								if buffer[position] != rune('A') {
+									goto l1027
 								}
 								position++
 							}
-						l898:
+						l1029:
 							{
-								position900, tokenIndex900, depth900 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l901
+								position1031, tokenIndex1031, depth1031 := position, tokenIndex, depth
+								if buffer[position] != rune('b') {
+									goto l1032
 								}
 								position++
-								goto l900
-							l901:
-								position, tokenIndex, depth = position900, tokenIndex900, depth900
-								if buffer[position] != rune('T') {
-									goto l896
+								goto l1031
+							l1032:
+								position, tokenIndex, depth = position1031, tokenIndex1031, depth1031
+								if buffer[position] != rune('B') {
+									goto l1027
 								}
 								position++
 							}
-						l900:
+						l1031:
 							{
-								position902, tokenIndex902, depth902 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l903
+								position1033, tokenIndex1033, depth1033 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1034
 								}
 								position++
-								goto l902
-							l903:
-								position, tokenIndex, depth = position902, tokenIndex902, depth902
-								if buffer[position] != rune('R') {
-									goto l896
+								goto l1033
+							l1034:
+								position, tokenIndex, depth = position1033, tokenIndex1033, depth1033
+								if buffer[position] != rune('S') {
+									goto l1027
 								}
 								position++
 							}
-						l902:
+						l1033:
+							if !_rules[ruleskip]() {
+								goto l1027
+							}
+							depth--
+							add(ruleABS, position1028)
+						}
+						goto l960
+					l1027:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1036 := position
+							depth++
 							{
-								position904, tokenIndex904, depth904 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l905
+								position1037, tokenIndex1037, depth1037 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1038
 								}
 								position++
-								goto l904
-							l905:
-								position, tokenIndex, depth = position904, tokenIndex904, depth904
-								if buffer[position] != rune('L') {
-									goto l896
+								goto l1037
+							l1038:
+								position, tokenIndex, depth = position1037, tokenIndex1037, depth1037
+								if buffer[position] != rune('C') {
+									goto l1035
 								}
 								position++
 							}
-						l904:
+						l1037:
 							{
-								position906, tokenIndex906, depth906 := position, tokenIndex, depth
+								position1039, tokenIndex1039, depth1039 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l907
+									goto l1040
 								}
 								position++
-								goto l906
-							l907:
-								position, tokenIndex, depth = position906, tokenIndex906, depth906
+								goto l1039
+							l1040:
+								position, tokenIndex, depth = position1039, tokenIndex1039, depth1039
 								if buffer[position] != rune('E') {
-									goto l896
+									goto l1035
 								}
 								position++
 							}
-						l906:
+						l1039:
 							{
-								position908, tokenIndex908, depth908 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l909
+								position1041, tokenIndex1041, depth1041 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1042
 								}
 								position++
-								goto l908
-							l909:
-								position, tokenIndex, depth = position908, tokenIndex908, depth908
-								if buffer[position] != rune('N') {
-									goto l896
+								goto l1041
+							l1042:
+								position, tokenIndex, depth = position1041, tokenIndex1041, depth1041
+								if buffer[position] != rune('I') {
+									goto l1035
+								}
+								position++
+							}
+						l1041:
+							{
+								position1043, tokenIndex1043, depth1043 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1044
+								}
+								position++
+								goto l1043
+							l1044:
+								position, tokenIndex, depth = position1043, tokenIndex1043, depth1043
+								if buffer[position] != rune('L') {
+									goto l1035
 								}
 								position++
 							}
-						l908:
-							if !rules[ruleskip]() {
-								goto l896
+						l1043:
+							if !_rules[ruleskip]() {
+								goto l1035
 							}
 							depth--
-							add(ruleSTRLEN, position897)
+							add(ruleCEIL, position1036)
 						}
-						goto l843
-					l896:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1035:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position911 := position
+							position1046 := position
 							depth++
 							{
-								position912, tokenIndex912, depth912 := position, tokenIndex, depth
-								if buffer[position] != rune('m') {
-									goto l913
+								position1047, tokenIndex1047, depth1047 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1048
 								}
 								position++
-								goto l912
-							l913:
-								position, tokenIndex, depth = position912, tokenIndex912, depth912
-								if buffer[position] != rune('M') {
-									goto l910
+								goto l1047
+							l1048:
+								position, tokenIndex, depth = position1047, tokenIndex1047, depth1047
+								if buffer[position] != rune('R') {
+									goto l1045
 								}
 								position++
 							}
-						l912:
+						l1047:
 							{
-								position914, tokenIndex914, depth914 := position, tokenIndex, depth
+								position1049, tokenIndex1049, depth1049 := position, tokenIndex, depth
 								if buffer[position] != rune('o') {
-									goto l915
+									goto l1050
 								}
 								position++
-								goto l914
-							l915:
-								position, tokenIndex, depth = position914, tokenIndex914, depth914
+								goto l1049
+							l1050:
+								position, tokenIndex, depth = position1049, tokenIndex1049, depth1049
 								if buffer[position] != rune('O') {
-									goto l910
+									goto l1045
 								}
 								position++
 							}
-						l914:
+						l1049:
 							{
-								position916, tokenIndex916, depth916 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l917
+								position1051, tokenIndex1051, depth1051 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1052
 								}
 								position++
-								goto l916
-							l917:
-								position, tokenIndex, depth = position916, tokenIndex916, depth916
-								if buffer[position] != rune('N') {
-									goto l910
+								goto l1051
+							l1052:
+								position, tokenIndex, depth = position1051, tokenIndex1051, depth1051
+								if buffer[position] != rune('U') {
+									goto l1045
 								}
 								position++
 							}
-						l916:
+						l1051:
 							{
-								position918, tokenIndex918, depth918 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l919
+								position1053, tokenIndex1053, depth1053 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1054
 								}
 								position++
-								goto l918
-							l919:
-								position, tokenIndex, depth = position918, tokenIndex918, depth918
-								if buffer[position] != rune('T') {
-									goto l910
+								goto l1053
+							l1054:
+								position, tokenIndex, depth = position1053, tokenIndex1053, depth1053
+								if buffer[position] != rune('N') {
+									goto l1045
 								}
 								position++
 							}
-						l918:
+						l1053:
 							{
-								position920, tokenIndex920, depth920 := position, tokenIndex, depth
-								if buffer[position] != rune('h') {
-									goto l921
+								position1055, tokenIndex1055, depth1055 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1056
 								}
 								position++
-								goto l920
-							l921:
-								position, tokenIndex, depth = position920, tokenIndex920, depth920
-								if buffer[position] != rune('H') {
-									goto l910
+								goto l1055
+							l1056:
+								position, tokenIndex, depth = position1055, tokenIndex1055, depth1055
+								if buffer[position] != rune('D') {
+									goto l1045
 								}
 								position++
 							}
-						l920:
-							if !rules[ruleskip]() {
-								goto l910
+						l1055:
+							if !_rules[ruleskip]() {
+								goto l1045
 							}
 							depth--
-							add(ruleMONTH, position911)
+							add(ruleROUND, position1046)
 						}
-						goto l843
-					l910:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1045:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position923 := position
+							position1058 := position
 							depth++
 							{
-								position924, tokenIndex924, depth924 := position, tokenIndex, depth
-								if buffer[position] != rune('m') {
-									goto l925
+								position1059, tokenIndex1059, depth1059 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1060
 								}
 								position++
-								goto l924
-							l925:
-								position, tokenIndex, depth = position924, tokenIndex924, depth924
-								if buffer[position] != rune('M') {
-									goto l922
+								goto l1059
+							l1060:
+								position, tokenIndex, depth = position1059, tokenIndex1059, depth1059
+								if buffer[position] != rune('F') {
+									goto l1057
 								}
 								position++
 							}
-						l924:
+						l1059:
 							{
-								position926, tokenIndex926, depth926 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l927
+								position1061, tokenIndex1061, depth1061 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1062
 								}
 								position++
-								goto l926
-							l927:
-								position, tokenIndex, depth = position926, tokenIndex926, depth926
-								if buffer[position] != rune('I') {
-									goto l922
+								goto l1061
+							l1062:
+								position, tokenIndex, depth = position1061, tokenIndex1061, depth1061
+								if buffer[position] != rune('L') {
+									goto l1057
 								}
 								position++
 							}
-						l926:
+						l1061:
 							{
-								position928, tokenIndex928, depth928 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l929
+								position1063, tokenIndex1063, depth1063 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1064
 								}
 								position++
-								goto l928
-							l929:
-								position, tokenIndex, depth = position928, tokenIndex928, depth928
-								if buffer[position] != rune('N') {
-									goto l922
+								goto l1063
+							l1064:
+								position, tokenIndex, depth = position1063, tokenIndex1063, depth1063
+								if buffer[position] != rune('O') {
+									goto l1057
 								}
 								position++
 							}
-						l928:
+						l1063:
 							{
-								position930, tokenIndex930, depth930 := position, tokenIndex, depth
-								if buffer[position] != rune('u') {
-									goto l931
+								position1065, tokenIndex1065, depth1065 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1066
 								}
 								position++
-								goto l930
-							l931:
-								position, tokenIndex, depth = position930, tokenIndex930, depth930
-								if buffer[position] != rune('U') {
-									goto l922
+								goto l1065
+							l1066:
+								position, tokenIndex, depth = position1065, tokenIndex1065, depth1065
+								if buffer[position] != rune('O') {
+									goto l1057
 								}
 								position++
 							}
-						l930:
+						l1065:
 							{
-								position932, tokenIndex932, depth932 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l933
+								position1067, tokenIndex1067, depth1067 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1068
 								}
 								position++
-								goto l932
-							l933:
-								position, tokenIndex, depth = position932, tokenIndex932, depth932
-								if buffer[position] != rune('T') {
-									goto l922
+								goto l1067
+							l1068:
+								position, tokenIndex, depth = position1067, tokenIndex1067, depth1067
+								if buffer[position] != rune('R') {
+									goto l1057
 								}
 								position++
 							}
-						l932:
+						l1067:
+							if !_rules[ruleskip]() {
+								goto l1057
+							}
+							depth--
+							add(ruleFLOOR, position1058)
+						}
+						goto l960
+					l1057:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1070 := position
+							depth++
 							{
-								position934, tokenIndex934, depth934 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l935
+								position1071, tokenIndex1071, depth1071 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1072
 								}
 								position++
-								goto l934
-							l935:
-								position, tokenIndex, depth = position934, tokenIndex934, depth934
-								if buffer[position] != rune('E') {
-									goto l922
+								goto l1071
+							l1072:
+								position, tokenIndex, depth = position1071, tokenIndex1071, depth1071
+								if buffer[position] != rune('U') {
+									goto l1069
 								}
 								position++
 							}
-						l934:
+						l1071:
 							{
-								position936, tokenIndex936, depth936 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l937
+								position1073, tokenIndex1073, depth1073 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1074
 								}
 								position++
-								goto l936
-							l937:
-								position, tokenIndex, depth = position936, tokenIndex936, depth936
-								if buffer[position] != rune('S') {
-									goto l922
+								goto l1073
+							l1074:
+								position, tokenIndex, depth = position1073, tokenIndex1073, depth1073
+								if buffer[position] != rune('C') {
+									goto l1069
 								}
 								position++
 							}
-						l936:
-							if !rules[ruleskip]() {
-								goto l922
+						l1073:
+							{
+								position1075, tokenIndex1075, depth1075 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1076
+								}
+								position++
+								goto l1075
+							l1076:
+								position, tokenIndex, depth = position1075, tokenIndex1075, depth1075
+								if buffer[position] != rune('A') {
+									goto l1069
+								}
+								position++
 							}
-							depth--
-							add(ruleMINUTES, position923)
-						}
-						goto l843
-					l922:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
-						{
-							position939 := position
-							depth++
+						l1075:
 							{
-								position940, tokenIndex940, depth940 := position, tokenIndex, depth
+								position1077, tokenIndex1077, depth1077 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l941
+									goto l1078
 								}
 								position++
-								goto l940
-							l941:
-								position, tokenIndex, depth = position940, tokenIndex940, depth940
+								goto l1077
+							l1078:
+								position, tokenIndex, depth = position1077, tokenIndex1077, depth1077
 								if buffer[position] != rune('S') {
-									goto l938
+									goto l1069
 								}
 								position++
 							}
-						l940:
+						l1077:
 							{
-								position942, tokenIndex942, depth942 := position, tokenIndex, depth
+								position1079, tokenIndex1079, depth1079 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l943
+									goto l1080
 								}
 								position++
-								goto l942
-							l943:
-								position, tokenIndex, depth = position942, tokenIndex942, depth942
+								goto l1079
+							l1080:
+								position, tokenIndex, depth = position1079, tokenIndex1079, depth1079
 								if buffer[position] != rune('E') {
-									goto l938
+									goto l1069
 								}
 								position++
 							}
-						l942:
+						l1079:
+							if !_rules[ruleskip]() {
+								goto l1069
+							}
+							depth--
+							add(ruleUCASE, position1070)
+						}
+						goto l960
+					l1069:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1082 := position
+							depth++
 							{
-								position944, tokenIndex944, depth944 := position, tokenIndex, depth
-								if buffer[position] != rune('c') {
-									goto l945
+								position1083, tokenIndex1083, depth1083 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1084
 								}
 								position++
-								goto l944
-							l945:
-								position, tokenIndex, depth = position944, tokenIndex944, depth944
-								if buffer[position] != rune('C') {
-									goto l938
+								goto l1083
+							l1084:
+								position, tokenIndex, depth = position1083, tokenIndex1083, depth1083
+								if buffer[position] != rune('L') {
+									goto l1081
 								}
 								position++
 							}
-						l944:
+						l1083:
 							{
-								position946, tokenIndex946, depth946 := position, tokenIndex, depth
-								if buffer[position] != rune('o') {
-									goto l947
+								position1085, tokenIndex1085, depth1085 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1086
 								}
 								position++
-								goto l946
-							l947:
-								position, tokenIndex, depth = position946, tokenIndex946, depth946
-								if buffer[position] != rune('O') {
-									goto l938
+								goto l1085
+							l1086:
+								position, tokenIndex, depth = position1085, tokenIndex1085, depth1085
+								if buffer[position] != rune('C') {
+									goto l1081
 								}
 								position++
 							}
-						l946:
+						l1085:
 							{
-								position948, tokenIndex948, depth948 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l949
+								position1087, tokenIndex1087, depth1087 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1088
 								}
 								position++
-								goto l948
-							l949:
-								position, tokenIndex, depth = position948, tokenIndex948, depth948
-								if buffer[position] != rune('N') {
-									goto l938
+								goto l1087
+							l1088:
+								position, tokenIndex, depth = position1087, tokenIndex1087, depth1087
+								if buffer[position] != rune('A') {
+									goto l1081
 								}
 								position++
 							}
-						l948:
+						l1087:
 							{
-								position950, tokenIndex950, depth950 := position, tokenIndex, depth
-								if buffer[position] != rune('d') {
-									goto l951
+								position1089, tokenIndex1089, depth1089 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1090
 								}
 								position++
-								goto l950
-							l951:
-								position, tokenIndex, depth = position950, tokenIndex950, depth950
-								if buffer[position] != rune('D') {
-									goto l938
+								goto l1089
+							l1090:
+								position, tokenIndex, depth = position1089, tokenIndex1089, depth1089
+								if buffer[position] != rune('S') {
+									goto l1081
 								}
 								position++
 							}
-						l950:
+						l1089:
 							{
-								position952, tokenIndex952, depth952 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l953
+								position1091, tokenIndex1091, depth1091 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1092
 								}
 								position++
-								goto l952
-							l953:
-								position, tokenIndex, depth = position952, tokenIndex952, depth952
-								if buffer[position] != rune('S') {
-									goto l938
+								goto l1091
+							l1092:
+								position, tokenIndex, depth = position1091, tokenIndex1091, depth1091
+								if buffer[position] != rune('E') {
+									goto l1081
 								}
 								position++
 							}
-						l952:
-							if !rules[ruleskip]() {
-								goto l938
+						l1091:
+							if !_rules[ruleskip]() {
+								goto l1081
 							}
 							depth--
-							add(ruleSECONDS, position939)
+							add(ruleLCASE, position1082)
 						}
-						goto l843
-					l938:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1081:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position955 := position
+							position1094 := position
 							depth++
 							{
-								position956, tokenIndex956, depth956 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l957
-								}
-								position++
-								goto l956
-							l957:
-								position, tokenIndex, depth = position956, tokenIndex956, depth956
-								if buffer[position] != rune('T') {
-									goto l954
-								}
-								position++
-							}
-						l956:
-							{
-								position958, tokenIndex958, depth958 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l959
-								}
-								position++
-								goto l958
-							l959:
-								position, tokenIndex, depth = position958, tokenIndex958, depth958
-								if buffer[position] != rune('I') {
-									goto l954
-								}
-								position++
-							}
-						l958:
-							{
-								position960, tokenIndex960, depth960 := position, tokenIndex, depth
-								if buffer[position] != rune('m') {
-									goto l961
+								position1095, tokenIndex1095, depth1095 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1096
 								}
 								position++
-								goto l960
-							l961:
-								position, tokenIndex, depth = position960, tokenIndex960, depth960
-								if buffer[position] != rune('M') {
-									goto l954
+								goto l1095
+							l1096:
+								position, tokenIndex, depth = position1095, tokenIndex1095, depth1095
+								if buffer[position] != rune('E') {
+									goto l1093
 								}
 								position++
 							}
-						l960:
+						l1095:
 							{
-								position962, tokenIndex962, depth962 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l963
+								position1097, tokenIndex1097, depth1097 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1098
 								}
 								position++
-								goto l962
-							l963:
-								position, tokenIndex, depth = position962, tokenIndex962, depth962
-								if buffer[position] != rune('E') {
-									goto l954
+								goto l1097
+							l1098:
+								position, tokenIndex, depth = position1097, tokenIndex1097, depth1097
+								if buffer[position] != rune('N') {
+									goto l1093
 								}
 								position++
 							}
-						l962:
+						l1097:
 							{
-								position964, tokenIndex964, depth964 := position, tokenIndex, depth
-								if buffer[position] != rune('z') {
-									goto l965
+								position1099, tokenIndex1099, depth1099 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1100
 								}
 								position++
-								goto l964
-							l965:
-								position, tokenIndex, depth = position964, tokenIndex964, depth964
-								if buffer[position] != rune('Z') {
-									goto l954
+								goto l1099
+							l1100:
+								position, tokenIndex, depth = position1099, tokenIndex1099, depth1099
+								if buffer[position] != rune('C') {
+									goto l1093
 								}
 								position++
 							}
-						l964:
+						l1099:
 							{
-								position966, tokenIndex966, depth966 := position, tokenIndex, depth
+								position1101, tokenIndex1101, depth1101 := position, tokenIndex, depth
 								if buffer[position] != rune('o') {
-									goto l967
+									goto l1102
 								}
 								position++
-								goto l966
-							l967:
-								position, tokenIndex, depth = position966, tokenIndex966, depth966
+								goto l1101
+							l1102:
+								position, tokenIndex, depth = position1101, tokenIndex1101, depth1101
 								if buffer[position] != rune('O') {
-									goto l954
+									goto l1093
 								}
 								position++
 							}
-						l966:
+						l1101:
 							{
-								position968, tokenIndex968, depth968 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l969
+								position1103, tokenIndex1103, depth1103 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1104
 								}
 								position++
-								goto l968
-							l969:
-								position, tokenIndex, depth = position968, tokenIndex968, depth968
-								if buffer[position] != rune('N') {
-									goto l954
+								goto l1103
+							l1104:
+								position, tokenIndex, depth = position1103, tokenIndex1103, depth1103
+								if buffer[position] != rune('D') {
+									goto l1093
 								}
 								position++
 							}
-						l968:
+						l1103:
 							{
-								position970, tokenIndex970, depth970 := position, tokenIndex, depth
+								position1105, tokenIndex1105, depth1105 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l971
+									goto l1106
 								}
 								position++
-								goto l970
-							l971:
-								position, tokenIndex, depth = position970, tokenIndex970, depth970
+								goto l1105
+							l1106:
+								position, tokenIndex, depth = position1105, tokenIndex1105, depth1105
 								if buffer[position] != rune('E') {
-									goto l954
+									goto l1093
 								}
 								position++
 							}
-						l970:
-							if !rules[ruleskip]() {
-								goto l954
+						l1105:
+							if buffer[position] != rune('_') {
+								goto l1093
 							}
-							depth--
-							add(ruleTIMEZONE, position955)
-						}
-						goto l843
-					l954:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
-						{
-							position973 := position
-							depth++
+							position++
 							{
-								position974, tokenIndex974, depth974 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l975
+								position1107, tokenIndex1107, depth1107 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1108
 								}
 								position++
-								goto l974
-							l975:
-								position, tokenIndex, depth = position974, tokenIndex974, depth974
-								if buffer[position] != rune('S') {
-									goto l972
+								goto l1107
+							l1108:
+								position, tokenIndex, depth = position1107, tokenIndex1107, depth1107
+								if buffer[position] != rune('F') {
+									goto l1093
 								}
 								position++
 							}
-						l974:
+						l1107:
 							{
-								position976, tokenIndex976, depth976 := position, tokenIndex, depth
-								if buffer[position] != rune('h') {
-									goto l977
+								position1109, tokenIndex1109, depth1109 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1110
 								}
 								position++
-								goto l976
-							l977:
-								position, tokenIndex, depth = position976, tokenIndex976, depth976
-								if buffer[position] != rune('H') {
-									goto l972
+								goto l1109
+							l1110:
+								position, tokenIndex, depth = position1109, tokenIndex1109, depth1109
+								if buffer[position] != rune('O') {
+									goto l1093
 								}
 								position++
 							}
-						l976:
+						l1109:
 							{
-								position978, tokenIndex978, depth978 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l979
+								position1111, tokenIndex1111, depth1111 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1112
 								}
 								position++
-								goto l978
-							l979:
-								position, tokenIndex, depth = position978, tokenIndex978, depth978
-								if buffer[position] != rune('A') {
-									goto l972
+								goto l1111
+							l1112:
+								position, tokenIndex, depth = position1111, tokenIndex1111, depth1111
+								if buffer[position] != rune('R') {
+									goto l1093
 								}
 								position++
 							}
-						l978:
-							if buffer[position] != rune('1') {
-								goto l972
+						l1111:
+							if buffer[position] != rune('_') {
+								goto l1093
 							}
 							position++
-							if !rules[ruleskip]() {
-								goto l972
-							}
-							depth--
-							add(ruleSHA1, position973)
-						}
-						goto l843
-					l972:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
-						{
-							position981 := position
-							depth++
 							{
-								position982, tokenIndex982, depth982 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l983
+								position1113, tokenIndex1113, depth1113 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1114
 								}
 								position++
-								goto l982
-							l983:
-								position, tokenIndex, depth = position982, tokenIndex982, depth982
-								if buffer[position] != rune('S') {
-									goto l980
+								goto l1113
+							l1114:
+								position, tokenIndex, depth = position1113, tokenIndex1113, depth1113
+								if buffer[position] != rune('U') {
+									goto l1093
 								}
 								position++
 							}
-						l982:
+						l1113:
 							{
-								position984, tokenIndex984, depth984 := position, tokenIndex, depth
-								if buffer[position] != rune('h') {
-									goto l985
+								position1115, tokenIndex1115, depth1115 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1116
 								}
 								position++
-								goto l984
-							l985:
-								position, tokenIndex, depth = position984, tokenIndex984, depth984
-								if buffer[position] != rune('H') {
-									goto l980
+								goto l1115
+							l1116:
+								position, tokenIndex, depth = position1115, tokenIndex1115, depth1115
+								if buffer[position] != rune('R') {
+									goto l1093
 								}
 								position++
 							}
-						l984:
+						l1115:
 							{
-								position986, tokenIndex986, depth986 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l987
+								position1117, tokenIndex1117, depth1117 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1118
 								}
 								position++
-								goto l986
-							l987:
-								position, tokenIndex, depth = position986, tokenIndex986, depth986
-								if buffer[position] != rune('A') {
-									goto l980
+								goto l1117
+							l1118:
+								position, tokenIndex, depth = position1117, tokenIndex1117, depth1117
+								if buffer[position] != rune('I') {
+									goto l1093
 								}
 								position++
 							}
-						l986:
-							if buffer[position] != rune('2') {
-								goto l980
-							}
-							position++
-							if buffer[position] != rune('5') {
-								goto l980
-							}
-							position++
-							if buffer[position] != rune('6') {
-								goto l980
-							}
-							position++
-							if !rules[ruleskip]() {
-								goto l980
+						l1117:
+							if !_rules[ruleskip]() {
+								goto l1093
 							}
 							depth--
-							add(ruleSHA256, position981)
+							add(ruleENCODEFORURI, position1094)
 						}
-						goto l843
-					l980:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1093:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position989 := position
+							position1120 := position
 							depth++
 							{
-								position990, tokenIndex990, depth990 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l991
+								position1121, tokenIndex1121, depth1121 := position, tokenIndex, depth
+								if buffer[position] != rune('y') {
+									goto l1122
 								}
 								position++
-								goto l990
-							l991:
-								position, tokenIndex, depth = position990, tokenIndex990, depth990
-								if buffer[position] != rune('S') {
-									goto l988
+								goto l1121
+							l1122:
+								position, tokenIndex, depth = position1121, tokenIndex1121, depth1121
+								if buffer[position] != rune('Y') {
+									goto l1119
 								}
 								position++
 							}
-						l990:
+						l1121:
 							{
-								position992, tokenIndex992, depth992 := position, tokenIndex, depth
-								if buffer[position] != rune('h') {
-									goto l993
+								position1123, tokenIndex1123, depth1123 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1124
 								}
 								position++
-								goto l992
-							l993:
-								position, tokenIndex, depth = position992, tokenIndex992, depth992
-								if buffer[position] != rune('H') {
-									goto l988
+								goto l1123
+							l1124:
+								position, tokenIndex, depth = position1123, tokenIndex1123, depth1123
+								if buffer[position] != rune('E') {
+									goto l1119
 								}
 								position++
 							}
-						l992:
+						l1123:
 							{
-								position994, tokenIndex994, depth994 := position, tokenIndex, depth
+								position1125, tokenIndex1125, depth1125 := position, tokenIndex, depth
 								if buffer[position] != rune('a') {
-									goto l995
+									goto l1126
 								}
 								position++
-								goto l994
-							l995:
-								position, tokenIndex, depth = position994, tokenIndex994, depth994
+								goto l1125
+							l1126:
+								position, tokenIndex, depth = position1125, tokenIndex1125, depth1125
 								if buffer[position] != rune('A') {
-									goto l988
+									goto l1119
 								}
 								position++
 							}
-						l994:
-							if buffer[position] != rune('3') {
-								goto l988
-							}
-							position++
-							if buffer[position] != rune('8') {
-								goto l988
-							}
-							position++
-							if buffer[position] != rune('4') {
-								goto l988
+						l1125:
+							{
+								position1127, tokenIndex1127, depth1127 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1128
+								}
+								position++
+								goto l1127
+							l1128:
+								position, tokenIndex, depth = position1127, tokenIndex1127, depth1127
+								if buffer[position] != rune('R') {
+									goto l1119
+								}
+								position++
 							}
-							position++
-							if !rules[ruleskip]() {
-								goto l988
+						l1127:
+							if !_rules[ruleskip]() {
+								goto l1119
 							}
 							depth--
-							add(ruleSHA384, position989)
+							add(ruleYEAR, position1120)
 						}
-						goto l843
-					l988:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1119:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position997 := position
+							position1130 := position
 							depth++
 							{
-								position998, tokenIndex998, depth998 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l999
+								position1131, tokenIndex1131, depth1131 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1132
 								}
 								position++
-								goto l998
-							l999:
-								position, tokenIndex, depth = position998, tokenIndex998, depth998
-								if buffer[position] != rune('I') {
-									goto l996
+								goto l1131
+							l1132:
+								position, tokenIndex, depth = position1131, tokenIndex1131, depth1131
+								if buffer[position] != rune('M') {
+									goto l1129
 								}
 								position++
 							}
-						l998:
+						l1131:
 							{
-								position1000, tokenIndex1000, depth1000 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1001
+								position1133, tokenIndex1133, depth1133 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1134
 								}
 								position++
-								goto l1000
-							l1001:
-								position, tokenIndex, depth = position1000, tokenIndex1000, depth1000
-								if buffer[position] != rune('S') {
-									goto l996
+								goto l1133
+							l1134:
+								position, tokenIndex, depth = position1133, tokenIndex1133, depth1133
+								if buffer[position] != rune('O') {
+									goto l1129
 								}
 								position++
 							}
-						l1000:
+						l1133:
 							{
-								position1002, tokenIndex1002, depth1002 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l1003
+								position1135, tokenIndex1135, depth1135 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1136
 								}
 								position++
-								goto l1002
-							l1003:
-								position, tokenIndex, depth = position1002, tokenIndex1002, depth1002
-								if buffer[position] != rune('I') {
-									goto l996
+								goto l1135
+							l1136:
+								position, tokenIndex, depth = position1135, tokenIndex1135, depth1135
+								if buffer[position] != rune('N') {
+									goto l1129
 								}
 								position++
 							}
-						l1002:
+						l1135:
 							{
-								position1004, tokenIndex1004, depth1004 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1005
+								position1137, tokenIndex1137, depth1137 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1138
 								}
 								position++
-								goto l1004
-							l1005:
-								position, tokenIndex, depth = position1004, tokenIndex1004, depth1004
-								if buffer[position] != rune('R') {
-									goto l996
+								goto l1137
+							l1138:
+								position, tokenIndex, depth = position1137, tokenIndex1137, depth1137
+								if buffer[position] != rune('T') {
+									goto l1129
 								}
 								position++
 							}
-						l1004:
+						l1137:
 							{
-								position1006, tokenIndex1006, depth1006 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l1007
+								position1139, tokenIndex1139, depth1139 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1140
 								}
 								position++
-								goto l1006
-							l1007:
-								position, tokenIndex, depth = position1006, tokenIndex1006, depth1006
-								if buffer[position] != rune('I') {
-									goto l996
+								goto l1139
+							l1140:
+								position, tokenIndex, depth = position1139, tokenIndex1139, depth1139
+								if buffer[position] != rune('H') {
+									goto l1129
 								}
 								position++
 							}
-						l1006:
-							if !rules[ruleskip]() {
-								goto l996
+						l1139:
+							if !_rules[ruleskip]() {
+								goto l1129
 							}
 							depth--
-							add(ruleISIRI, position997)
+							add(ruleMONTH, position1130)
 						}
-						goto l843
-					l996:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1129:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position1009 := position
+							position1142 := position
 							depth++
 							{
-								position1010, tokenIndex1010, depth1010 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l1011
+								position1143, tokenIndex1143, depth1143 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1144
 								}
 								position++
-								goto l1010
-							l1011:
-								position, tokenIndex, depth = position1010, tokenIndex1010, depth1010
-								if buffer[position] != rune('I') {
-									goto l1008
+								goto l1143
+							l1144:
+								position, tokenIndex, depth = position1143, tokenIndex1143, depth1143
+								if buffer[position] != rune('D') {
+									goto l1141
 								}
 								position++
 							}
-						l1010:
+						l1143:
 							{
-								position1012, tokenIndex1012, depth1012 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1013
+								position1145, tokenIndex1145, depth1145 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1146
 								}
 								position++
-								goto l1012
-							l1013:
-								position, tokenIndex, depth = position1012, tokenIndex1012, depth1012
-								if buffer[position] != rune('S') {
-									goto l1008
+								goto l1145
+							l1146:
+								position, tokenIndex, depth = position1145, tokenIndex1145, depth1145
+								if buffer[position] != rune('A') {
+									goto l1141
+								}
+								position++
+							}
+						l1145:
+							{
+								position1147, tokenIndex1147, depth1147 := position, tokenIndex, depth
+								if buffer[position] != rune('y') {
+									goto l1148
+								}
+								position++
+								goto l1147
+							l1148:
+								position, tokenIndex, depth = position1147, tokenIndex1147, depth1147
+								if buffer[position] != rune('Y') {
+									goto l1141
+								}
+								position++
+							}
+						l1147:
+							if !_rules[ruleskip]() {
+								goto l1141
+							}
+							depth--
+							add(ruleDAY, position1142)
+						}
+						goto l960
+					l1141:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1150 := position
+							depth++
+							{
+								position1151, tokenIndex1151, depth1151 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1152
+								}
+								position++
+								goto l1151
+							l1152:
+								position, tokenIndex, depth = position1151, tokenIndex1151, depth1151
+								if buffer[position] != rune('H') {
+									goto l1149
+								}
+								position++
+							}
+						l1151:
+							{
+								position1153, tokenIndex1153, depth1153 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1154
+								}
+								position++
+								goto l1153
+							l1154:
+								position, tokenIndex, depth = position1153, tokenIndex1153, depth1153
+								if buffer[position] != rune('O') {
+									goto l1149
 								}
 								position++
 							}
-						l1012:
+						l1153:
 							{
-								position1014, tokenIndex1014, depth1014 := position, tokenIndex, depth
+								position1155, tokenIndex1155, depth1155 := position, tokenIndex, depth
 								if buffer[position] != rune('u') {
-									goto l1015
+									goto l1156
 								}
 								position++
-								goto l1014
-							l1015:
-								position, tokenIndex, depth = position1014, tokenIndex1014, depth1014
+								goto l1155
+							l1156:
+								position, tokenIndex, depth = position1155, tokenIndex1155, depth1155
 								if buffer[position] != rune('U') {
-									goto l1008
+									goto l1149
 								}
 								position++
 							}
-						l1014:
+						l1155:
 							{
-								position1016, tokenIndex1016, depth1016 := position, tokenIndex, depth
+								position1157, tokenIndex1157, depth1157 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1017
+									goto l1158
 								}
 								position++
-								goto l1016
-							l1017:
-								position, tokenIndex, depth = position1016, tokenIndex1016, depth1016
+								goto l1157
+							l1158:
+								position, tokenIndex, depth = position1157, tokenIndex1157, depth1157
 								if buffer[position] != rune('R') {
-									goto l1008
+									goto l1149
 								}
 								position++
 							}
-						l1016:
+						l1157:
 							{
-								position1018, tokenIndex1018, depth1018 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l1019
+								position1159, tokenIndex1159, depth1159 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1160
 								}
 								position++
-								goto l1018
-							l1019:
-								position, tokenIndex, depth = position1018, tokenIndex1018, depth1018
-								if buffer[position] != rune('I') {
-									goto l1008
+								goto l1159
+							l1160:
+								position, tokenIndex, depth = position1159, tokenIndex1159, depth1159
+								if buffer[position] != rune('S') {
+									goto l1149
 								}
 								position++
 							}
-						l1018:
-							if !rules[ruleskip]() {
-								goto l1008
+						l1159:
+							if !_rules[ruleskip]() {
+								goto l1149
 							}
 							depth--
-							add(ruleISURI, position1009)
+							add(ruleHOURS, position1150)
 						}
-						goto l843
-					l1008:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1149:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position1021 := position
+							position1162 := position
 							depth++
 							{
-								position1022, tokenIndex1022, depth1022 := position, tokenIndex, depth
+								position1163, tokenIndex1163, depth1163 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1164
+								}
+								position++
+								goto l1163
+							l1164:
+								position, tokenIndex, depth = position1163, tokenIndex1163, depth1163
+								if buffer[position] != rune('M') {
+									goto l1161
+								}
+								position++
+							}
+						l1163:
+							{
+								position1165, tokenIndex1165, depth1165 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l1023
+									goto l1166
 								}
 								position++
-								goto l1022
-							l1023:
-								position, tokenIndex, depth = position1022, tokenIndex1022, depth1022
+								goto l1165
+							l1166:
+								position, tokenIndex, depth = position1165, tokenIndex1165, depth1165
 								if buffer[position] != rune('I') {
-									goto l1020
+									goto l1161
+								}
+								position++
+							}
+						l1165:
+							{
+								position1167, tokenIndex1167, depth1167 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1168
+								}
+								position++
+								goto l1167
+							l1168:
+								position, tokenIndex, depth = position1167, tokenIndex1167, depth1167
+								if buffer[position] != rune('N') {
+									goto l1161
+								}
+								position++
+							}
+						l1167:
+							{
+								position1169, tokenIndex1169, depth1169 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1170
+								}
+								position++
+								goto l1169
+							l1170:
+								position, tokenIndex, depth = position1169, tokenIndex1169, depth1169
+								if buffer[position] != rune('U') {
+									goto l1161
+								}
+								position++
+							}
+						l1169:
+							{
+								position1171, tokenIndex1171, depth1171 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1172
+								}
+								position++
+								goto l1171
+							l1172:
+								position, tokenIndex, depth = position1171, tokenIndex1171, depth1171
+								if buffer[position] != rune('T') {
+									goto l1161
+								}
+								position++
+							}
+						l1171:
+							{
+								position1173, tokenIndex1173, depth1173 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1174
+								}
+								position++
+								goto l1173
+							l1174:
+								position, tokenIndex, depth = position1173, tokenIndex1173, depth1173
+								if buffer[position] != rune('E') {
+									goto l1161
 								}
 								position++
 							}
-						l1022:
+						l1173:
 							{
-								position1024, tokenIndex1024, depth1024 := position, tokenIndex, depth
+								position1175, tokenIndex1175, depth1175 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1025
+									goto l1176
 								}
 								position++
-								goto l1024
-							l1025:
-								position, tokenIndex, depth = position1024, tokenIndex1024, depth1024
+								goto l1175
+							l1176:
+								position, tokenIndex, depth = position1175, tokenIndex1175, depth1175
 								if buffer[position] != rune('S') {
-									goto l1020
+									goto l1161
 								}
 								position++
 							}
-						l1024:
+						l1175:
+							if !_rules[ruleskip]() {
+								goto l1161
+							}
+							depth--
+							add(ruleMINUTES, position1162)
+						}
+						goto l960
+					l1161:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1178 := position
+							depth++
 							{
-								position1026, tokenIndex1026, depth1026 := position, tokenIndex, depth
-								if buffer[position] != rune('b') {
-									goto l1027
+								position1179, tokenIndex1179, depth1179 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1180
 								}
 								position++
-								goto l1026
-							l1027:
-								position, tokenIndex, depth = position1026, tokenIndex1026, depth1026
-								if buffer[position] != rune('B') {
-									goto l1020
+								goto l1179
+							l1180:
+								position, tokenIndex, depth = position1179, tokenIndex1179, depth1179
+								if buffer[position] != rune('S') {
+									goto l1177
 								}
 								position++
 							}
-						l1026:
+						l1179:
 							{
-								position1028, tokenIndex1028, depth1028 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l1029
+								position1181, tokenIndex1181, depth1181 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1182
 								}
 								position++
-								goto l1028
-							l1029:
-								position, tokenIndex, depth = position1028, tokenIndex1028, depth1028
-								if buffer[position] != rune('L') {
-									goto l1020
+								goto l1181
+							l1182:
+								position, tokenIndex, depth = position1181, tokenIndex1181, depth1181
+								if buffer[position] != rune('E') {
+									goto l1177
 								}
 								position++
 							}
-						l1028:
+						l1181:
 							{
-								position1030, tokenIndex1030, depth1030 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l1031
+								position1183, tokenIndex1183, depth1183 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1184
 								}
 								position++
-								goto l1030
-							l1031:
-								position, tokenIndex, depth = position1030, tokenIndex1030, depth1030
-								if buffer[position] != rune('A') {
-									goto l1020
+								goto l1183
+							l1184:
+								position, tokenIndex, depth = position1183, tokenIndex1183, depth1183
+								if buffer[position] != rune('C') {
+									goto l1177
+								}
+								position++
+							}
+						l1183:
+							{
+								position1185, tokenIndex1185, depth1185 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1186
+								}
+								position++
+								goto l1185
+							l1186:
+								position, tokenIndex, depth = position1185, tokenIndex1185, depth1185
+								if buffer[position] != rune('O') {
+									goto l1177
 								}
 								position++
 							}
-						l1030:
+						l1185:
 							{
-								position1032, tokenIndex1032, depth1032 := position, tokenIndex, depth
+								position1187, tokenIndex1187, depth1187 := position, tokenIndex, depth
 								if buffer[position] != rune('n') {
-									goto l1033
+									goto l1188
 								}
 								position++
-								goto l1032
-							l1033:
-								position, tokenIndex, depth = position1032, tokenIndex1032, depth1032
+								goto l1187
+							l1188:
+								position, tokenIndex, depth = position1187, tokenIndex1187, depth1187
 								if buffer[position] != rune('N') {
-									goto l1020
+									goto l1177
 								}
 								position++
 							}
-						l1032:
+						l1187:
 							{
-								position1034, tokenIndex1034, depth1034 := position, tokenIndex, depth
-								if buffer[position] != rune('k') {
-									goto l1035
+								position1189, tokenIndex1189, depth1189 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1190
 								}
 								position++
-								goto l1034
-							l1035:
-								position, tokenIndex, depth = position1034, tokenIndex1034, depth1034
-								if buffer[position] != rune('K') {
-									goto l1020
+								goto l1189
+							l1190:
+								position, tokenIndex, depth = position1189, tokenIndex1189, depth1189
+								if buffer[position] != rune('D') {
+									goto l1177
+								}
+								position++
+							}
+						l1189:
+							{
+								position1191, tokenIndex1191, depth1191 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1192
+								}
+								position++
+								goto l1191
+							l1192:
+								position, tokenIndex, depth = position1191, tokenIndex1191, depth1191
+								if buffer[position] != rune('S') {
+									goto l1177
 								}
 								position++
 							}
-						l1034:
-							if !rules[ruleskip]() {
-								goto l1020
+						l1191:
+							if !_rules[ruleskip]() {
+								goto l1177
 							}
 							depth--
-							add(ruleISBLANK, position1021)
+							add(ruleSECONDS, position1178)
 						}
-						goto l843
-					l1020:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1177:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							position1037 := position
+							position1194 := position
 							depth++
 							{
-								position1038, tokenIndex1038, depth1038 := position, tokenIndex, depth
+								position1195, tokenIndex1195, depth1195 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1196
+								}
+								position++
+								goto l1195
+							l1196:
+								position, tokenIndex, depth = position1195, tokenIndex1195, depth1195
+								if buffer[position] != rune('T') {
+									goto l1193
+								}
+								position++
+							}
+						l1195:
+							{
+								position1197, tokenIndex1197, depth1197 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l1039
+									goto l1198
 								}
 								position++
-								goto l1038
-							l1039:
-								position, tokenIndex, depth = position1038, tokenIndex1038, depth1038
+								goto l1197
+							l1198:
+								position, tokenIndex, depth = position1197, tokenIndex1197, depth1197
 								if buffer[position] != rune('I') {
-									goto l1036
+									goto l1193
 								}
 								position++
 							}
-						l1038:
+						l1197:
 							{
-								position1040, tokenIndex1040, depth1040 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1041
+								position1199, tokenIndex1199, depth1199 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1200
 								}
 								position++
-								goto l1040
-							l1041:
-								position, tokenIndex, depth = position1040, tokenIndex1040, depth1040
-								if buffer[position] != rune('S') {
-									goto l1036
+								goto l1199
+							l1200:
+								position, tokenIndex, depth = position1199, tokenIndex1199, depth1199
+								if buffer[position] != rune('M') {
+									goto l1193
 								}
 								position++
 							}
-						l1040:
+						l1199:
 							{
-								position1042, tokenIndex1042, depth1042 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l1043
+								position1201, tokenIndex1201, depth1201 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1202
 								}
 								position++
-								goto l1042
-							l1043:
-								position, tokenIndex, depth = position1042, tokenIndex1042, depth1042
-								if buffer[position] != rune('L') {
-									goto l1036
+								goto l1201
+							l1202:
+								position, tokenIndex, depth = position1201, tokenIndex1201, depth1201
+								if buffer[position] != rune('E') {
+									goto l1193
 								}
 								position++
 							}
-						l1042:
+						l1201:
 							{
-								position1044, tokenIndex1044, depth1044 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l1045
+								position1203, tokenIndex1203, depth1203 := position, tokenIndex, depth
+								if buffer[position] != rune('z') {
+									goto l1204
 								}
 								position++
-								goto l1044
-							l1045:
-								position, tokenIndex, depth = position1044, tokenIndex1044, depth1044
-								if buffer[position] != rune('I') {
-									goto l1036
+								goto l1203
+							l1204:
+								position, tokenIndex, depth = position1203, tokenIndex1203, depth1203
+								if buffer[position] != rune('Z') {
+									goto l1193
 								}
 								position++
 							}
-						l1044:
+						l1203:
 							{
-								position1046, tokenIndex1046, depth1046 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1047
+								position1205, tokenIndex1205, depth1205 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1206
 								}
 								position++
-								goto l1046
-							l1047:
-								position, tokenIndex, depth = position1046, tokenIndex1046, depth1046
-								if buffer[position] != rune('T') {
-									goto l1036
+								goto l1205
+							l1206:
+								position, tokenIndex, depth = position1205, tokenIndex1205, depth1205
+								if buffer[position] != rune('O') {
+									goto l1193
+								}
+								position++
+							}
+						l1205:
+							{
+								position1207, tokenIndex1207, depth1207 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1208
+								}
+								position++
+								goto l1207
+							l1208:
+								position, tokenIndex, depth = position1207, tokenIndex1207, depth1207
+								if buffer[position] != rune('N') {
+									goto l1193
 								}
 								position++
 							}
-						l1046:
+						l1207:
 							{
-								position1048, tokenIndex1048, depth1048 := position, tokenIndex, depth
+								position1209, tokenIndex1209, depth1209 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l1049
+									goto l1210
 								}
 								position++
-								goto l1048
-							l1049:
-								position, tokenIndex, depth = position1048, tokenIndex1048, depth1048
+								goto l1209
+							l1210:
+								position, tokenIndex, depth = position1209, tokenIndex1209, depth1209
 								if buffer[position] != rune('E') {
-									goto l1036
+									goto l1193
 								}
 								position++
 							}
-						l1048:
+						l1209:
+							if !_rules[ruleskip]() {
+								goto l1193
+							}
+							depth--
+							add(ruleTIMEZONE, position1194)
+						}
+						goto l960
+					l1193:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1212 := position
+							depth++
 							{
-								position1050, tokenIndex1050, depth1050 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1051
+								position1213, tokenIndex1213, depth1213 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1214
 								}
 								position++
-								goto l1050
-							l1051:
-								position, tokenIndex, depth = position1050, tokenIndex1050, depth1050
-								if buffer[position] != rune('R') {
-									goto l1036
+								goto l1213
+							l1214:
+								position, tokenIndex, depth = position1213, tokenIndex1213, depth1213
+								if buffer[position] != rune('T') {
+									goto l1211
 								}
 								position++
 							}
-						l1050:
+						l1213:
 							{
-								position1052, tokenIndex1052, depth1052 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l1053
+								position1215, tokenIndex1215, depth1215 := position, tokenIndex, depth
+								if buffer[position] != rune('z') {
+									goto l1216
 								}
 								position++
-								goto l1052
-							l1053:
-								position, tokenIndex, depth = position1052, tokenIndex1052, depth1052
-								if buffer[position] != rune('A') {
-									goto l1036
+								goto l1215
+							l1216:
+								position, tokenIndex, depth = position1215, tokenIndex1215, depth1215
+								if buffer[position] != rune('Z') {
+									goto l1211
 								}
 								position++
 							}
-						l1052:
+						l1215:
+							if !_rules[ruleskip]() {
+								goto l1211
+							}
+							depth--
+							add(ruleTZ, position1212)
+						}
+						goto l960
+					l1211:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1218 := position
+							depth++
 							{
-								position1054, tokenIndex1054, depth1054 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l1055
+								position1219, tokenIndex1219, depth1219 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1220
 								}
 								position++
-								goto l1054
-							l1055:
-								position, tokenIndex, depth = position1054, tokenIndex1054, depth1054
-								if buffer[position] != rune('L') {
-									goto l1036
+								goto l1219
+							l1220:
+								position, tokenIndex, depth = position1219, tokenIndex1219, depth1219
+								if buffer[position] != rune('M') {
+									goto l1217
 								}
 								position++
 							}
-						l1054:
-							if !rules[ruleskip]() {
-								goto l1036
+						l1219:
+							{
+								position1221, tokenIndex1221, depth1221 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1222
+								}
+								position++
+								goto l1221
+							l1222:
+								position, tokenIndex, depth = position1221, tokenIndex1221, depth1221
+								if buffer[position] != rune('D') {
+									goto l1217
+								}
+								position++
+							}
+						l1221:
+							if buffer[position] != rune('5') {
+								goto l1217
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1217
 							}
 							depth--
-							add(ruleISLITERAL, position1037)
+							add(ruleMD5, position1218)
 						}
-						goto l843
-					l1036:
-						position, tokenIndex, depth = position843, tokenIndex843, depth843
+						goto l960
+					l1217:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
 						{
-							switch buffer[position] {
-							case 'I', 'i':
-								{
-									position1057 := position
-									depth++
-									{
-										position1058, tokenIndex1058, depth1058 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1059
-										}
-										position++
-										goto l1058
-									l1059:
-										position, tokenIndex, depth = position1058, tokenIndex1058, depth1058
-										if buffer[position] != rune('I') {
-											goto l842
-										}
-										position++
-									}
-								l1058:
-									{
-										position1060, tokenIndex1060, depth1060 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1061
-										}
-										position++
-										goto l1060
-									l1061:
-										position, tokenIndex, depth = position1060, tokenIndex1060, depth1060
-										if buffer[position] != rune('S') {
-											goto l842
-										}
-										position++
-									}
-								l1060:
-									{
-										position1062, tokenIndex1062, depth1062 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1063
-										}
-										position++
-										goto l1062
-									l1063:
-										position, tokenIndex, depth = position1062, tokenIndex1062, depth1062
-										if buffer[position] != rune('N') {
-											goto l842
-										}
-										position++
-									}
-								l1062:
-									{
-										position1064, tokenIndex1064, depth1064 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1065
-										}
-										position++
-										goto l1064
-									l1065:
-										position, tokenIndex, depth = position1064, tokenIndex1064, depth1064
-										if buffer[position] != rune('U') {
-											goto l842
-										}
-										position++
-									}
-								l1064:
-									{
-										position1066, tokenIndex1066, depth1066 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1067
-										}
-										position++
-										goto l1066
-									l1067:
-										position, tokenIndex, depth = position1066, tokenIndex1066, depth1066
-										if buffer[position] != rune('M') {
-											goto l842
-										}
-										position++
-									}
-								l1066:
-									{
-										position1068, tokenIndex1068, depth1068 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1069
-										}
-										position++
-										goto l1068
-									l1069:
-										position, tokenIndex, depth = position1068, tokenIndex1068, depth1068
-										if buffer[position] != rune('E') {
-											goto l842
-										}
-										position++
-									}
-								l1068:
-									{
-										position1070, tokenIndex1070, depth1070 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1071
-										}
-										position++
-										goto l1070
-									l1071:
-										position, tokenIndex, depth = position1070, tokenIndex1070, depth1070
-										if buffer[position] != rune('R') {
-											goto l842
-										}
-										position++
-									}
-								l1070:
-									{
-										position1072, tokenIndex1072, depth1072 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1073
-										}
-										position++
-										goto l1072
-									l1073:
-										position, tokenIndex, depth = position1072, tokenIndex1072, depth1072
-										if buffer[position] != rune('I') {
-											goto l842
-										}
-										position++
-									}
-								l1072:
-									{
-										position1074, tokenIndex1074, depth1074 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1075
-										}
-										position++
-										goto l1074
-									l1075:
-										position, tokenIndex, depth = position1074, tokenIndex1074, depth1074
-										if buffer[position] != rune('C') {
-											goto l842
-										}
-										position++
-									}
-								l1074:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleISNUMERIC, position1057)
+							position1224 := position
+							depth++
+							{
+								position1225, tokenIndex1225, depth1225 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1226
 								}
-								break
-							case 'S', 's':
-								{
-									position1076 := position
-									depth++
-									{
-										position1077, tokenIndex1077, depth1077 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1078
-										}
-										position++
-										goto l1077
-									l1078:
-										position, tokenIndex, depth = position1077, tokenIndex1077, depth1077
-										if buffer[position] != rune('S') {
-											goto l842
-										}
-										position++
-									}
-								l1077:
-									{
-										position1079, tokenIndex1079, depth1079 := position, tokenIndex, depth
-										if buffer[position] != rune('h') {
-											goto l1080
-										}
-										position++
-										goto l1079
-									l1080:
-										position, tokenIndex, depth = position1079, tokenIndex1079, depth1079
-										if buffer[position] != rune('H') {
-											goto l842
-										}
-										position++
-									}
-								l1079:
-									{
-										position1081, tokenIndex1081, depth1081 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1082
-										}
-										position++
-										goto l1081
-									l1082:
-										position, tokenIndex, depth = position1081, tokenIndex1081, depth1081
-										if buffer[position] != rune('A') {
-											goto l842
-										}
-										position++
-									}
-								l1081:
-									if buffer[position] != rune('5') {
-										goto l842
-									}
-									position++
-									if buffer[position] != rune('1') {
-										goto l842
-									}
-									position++
-									if buffer[position] != rune('2') {
-										goto l842
-									}
-									position++
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleSHA512, position1076)
+								position++
+								goto l1225
+							l1226:
+								position, tokenIndex, depth = position1225, tokenIndex1225, depth1225
+								if buffer[position] != rune('S') {
+									goto l1223
 								}
-								break
-							case 'M', 'm':
-								{
-									position1083 := position
-									depth++
-									{
-										position1084, tokenIndex1084, depth1084 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1085
-										}
-										position++
-										goto l1084
-									l1085:
-										position, tokenIndex, depth = position1084, tokenIndex1084, depth1084
-										if buffer[position] != rune('M') {
-											goto l842
-										}
-										position++
-									}
-								l1084:
-									{
-										position1086, tokenIndex1086, depth1086 := position, tokenIndex, depth
-										if buffer[position] != rune('d') {
-											goto l1087
-										}
-										position++
-										goto l1086
-									l1087:
-										position, tokenIndex, depth = position1086, tokenIndex1086, depth1086
-										if buffer[position] != rune('D') {
-											goto l842
-										}
-										position++
-									}
-								l1086:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleMD5, position1083)
+								position++
+							}
+						l1225:
+							{
+								position1227, tokenIndex1227, depth1227 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1228
 								}
-								break
-							case 'T', 't':
-								{
-									position1088 := position
-									depth++
-									{
-										position1089, tokenIndex1089, depth1089 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1090
-										}
-										position++
-										goto l1089
-									l1090:
-										position, tokenIndex, depth = position1089, tokenIndex1089, depth1089
-										if buffer[position] != rune('T') {
-											goto l842
-										}
-										position++
-									}
-								l1089:
-									{
-										position1091, tokenIndex1091, depth1091 := position, tokenIndex, depth
-										if buffer[position] != rune('z') {
-											goto l1092
-										}
-										position++
-										goto l1091
-									l1092:
-										position, tokenIndex, depth = position1091, tokenIndex1091, depth1091
-										if buffer[position] != rune('Z') {
-											goto l842
-										}
-										position++
-									}
-								l1091:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleTZ, position1088)
+								position++
+								goto l1227
+							l1228:
+								position, tokenIndex, depth = position1227, tokenIndex1227, depth1227
+								if buffer[position] != rune('H') {
+									goto l1223
 								}
-								break
-							case 'H', 'h':
-								{
-									position1093 := position
-									depth++
-									{
-										position1094, tokenIndex1094, depth1094 := position, tokenIndex, depth
-										if buffer[position] != rune('h') {
-											goto l1095
-										}
-										position++
-										goto l1094
-									l1095:
-										position, tokenIndex, depth = position1094, tokenIndex1094, depth1094
-										if buffer[position] != rune('H') {
-											goto l842
-										}
-										position++
-									}
-								l1094:
-									{
-										position1096, tokenIndex1096, depth1096 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1097
-										}
-										position++
-										goto l1096
-									l1097:
-										position, tokenIndex, depth = position1096, tokenIndex1096, depth1096
-										if buffer[position] != rune('O') {
-											goto l842
-										}
-										position++
-									}
-								l1096:
-									{
-										position1098, tokenIndex1098, depth1098 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1099
-										}
-										position++
-										goto l1098
-									l1099:
-										position, tokenIndex, depth = position1098, tokenIndex1098, depth1098
-										if buffer[position] != rune('U') {
-											goto l842
-										}
-										position++
-									}
-								l1098:
-									{
-										position1100, tokenIndex1100, depth1100 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1101
-										}
-										position++
-										goto l1100
-									l1101:
-										position, tokenIndex, depth = position1100, tokenIndex1100, depth1100
-										if buffer[position] != rune('R') {
-											goto l842
-										}
-										position++
-									}
-								l1100:
-									{
-										position1102, tokenIndex1102, depth1102 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1103
-										}
-										position++
-										goto l1102
-									l1103:
-										position, tokenIndex, depth = position1102, tokenIndex1102, depth1102
-										if buffer[position] != rune('S') {
-											goto l842
-										}
-										position++
-									}
-								l1102:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleHOURS, position1093)
+								position++
+							}
+						l1227:
+							{
+								position1229, tokenIndex1229, depth1229 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1230
 								}
-								break
-							case 'D', 'd':
-								{
-									position1104 := position
-									depth++
-									{
-										position1105, tokenIndex1105, depth1105 := position, tokenIndex, depth
-										if buffer[position] != rune('d') {
-											goto l1106
-										}
-										position++
-										goto l1105
-									l1106:
-										position, tokenIndex, depth = position1105, tokenIndex1105, depth1105
-										if buffer[position] != rune('D') {
-											goto l842
-										}
-										position++
-									}
-								l1105:
-									{
-										position1107, tokenIndex1107, depth1107 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1108
-										}
-										position++
-										goto l1107
-									l1108:
-										position, tokenIndex, depth = position1107, tokenIndex1107, depth1107
-										if buffer[position] != rune('A') {
-											goto l842
-										}
-										position++
-									}
-								l1107:
-									{
-										position1109, tokenIndex1109, depth1109 := position, tokenIndex, depth
-										if buffer[position] != rune('y') {
-											goto l1110
-										}
-										position++
-										goto l1109
-									l1110:
-										position, tokenIndex, depth = position1109, tokenIndex1109, depth1109
-										if buffer[position] != rune('Y') {
-											goto l842
-										}
-										position++
-									}
-								l1109:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleDAY, position1104)
+								position++
+								goto l1229
+							l1230:
+								position, tokenIndex, depth = position1229, tokenIndex1229, depth1229
+								if buffer[position] != rune('A') {
+									goto l1223
 								}
-								break
-							case 'Y', 'y':
-								{
-									position1111 := position
-									depth++
-									{
-										position1112, tokenIndex1112, depth1112 := position, tokenIndex, depth
-										if buffer[position] != rune('y') {
-											goto l1113
-										}
-										position++
-										goto l1112
-									l1113:
-										position, tokenIndex, depth = position1112, tokenIndex1112, depth1112
-										if buffer[position] != rune('Y') {
-											goto l842
-										}
-										position++
-									}
-								l1112:
-									{
-										position1114, tokenIndex1114, depth1114 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1115
-										}
-										position++
-										goto l1114
-									l1115:
-										position, tokenIndex, depth = position1114, tokenIndex1114, depth1114
-										if buffer[position] != rune('E') {
-											goto l842
-										}
-										position++
-									}
-								l1114:
-									{
-										position1116, tokenIndex1116, depth1116 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1117
-										}
-										position++
-										goto l1116
-									l1117:
-										position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-										if buffer[position] != rune('A') {
-											goto l842
-										}
-										position++
-									}
-								l1116:
-									{
-										position1118, tokenIndex1118, depth1118 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1119
-										}
-										position++
-										goto l1118
-									l1119:
-										position, tokenIndex, depth = position1118, tokenIndex1118, depth1118
-										if buffer[position] != rune('R') {
-											goto l842
-										}
-										position++
-									}
-								l1118:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleYEAR, position1111)
+								position++
+							}
+						l1229:
+							if buffer[position] != rune('1') {
+								goto l1223
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1223
+							}
+							depth--
+							add(ruleSHA1, position1224)
+						}
+						goto l960
+					l1223:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1232 := position
+							depth++
+							{
+								position1233, tokenIndex1233, depth1233 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1234
+								}
+								position++
+								goto l1233
+							l1234:
+								position, tokenIndex, depth = position1233, tokenIndex1233, depth1233
+								if buffer[position] != rune('S') {
+									goto l1231
+								}
+								position++
+							}
+						l1233:
+							{
+								position1235, tokenIndex1235, depth1235 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1236
+								}
+								position++
+								goto l1235
+							l1236:
+								position, tokenIndex, depth = position1235, tokenIndex1235, depth1235
+								if buffer[position] != rune('H') {
+									goto l1231
+								}
+								position++
+							}
+						l1235:
+							{
+								position1237, tokenIndex1237, depth1237 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1238
+								}
+								position++
+								goto l1237
+							l1238:
+								position, tokenIndex, depth = position1237, tokenIndex1237, depth1237
+								if buffer[position] != rune('A') {
+									goto l1231
+								}
+								position++
+							}
+						l1237:
+							if buffer[position] != rune('2') {
+								goto l1231
+							}
+							position++
+							if buffer[position] != rune('5') {
+								goto l1231
+							}
+							position++
+							if buffer[position] != rune('6') {
+								goto l1231
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1231
+							}
+							depth--
+							add(ruleSHA256, position1232)
+						}
+						goto l960
+					l1231:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1240 := position
+							depth++
+							{
+								position1241, tokenIndex1241, depth1241 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1242
+								}
+								position++
+								goto l1241
+							l1242:
+								position, tokenIndex, depth = position1241, tokenIndex1241, depth1241
+								if buffer[position] != rune('S') {
+									goto l1239
+								}
+								position++
+							}
+						l1241:
+							{
+								position1243, tokenIndex1243, depth1243 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1244
+								}
+								position++
+								goto l1243
+							l1244:
+								position, tokenIndex, depth = position1243, tokenIndex1243, depth1243
+								if buffer[position] != rune('H') {
+									goto l1239
+								}
+								position++
+							}
+						l1243:
+							{
+								position1245, tokenIndex1245, depth1245 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1246
+								}
+								position++
+								goto l1245
+							l1246:
+								position, tokenIndex, depth = position1245, tokenIndex1245, depth1245
+								if buffer[position] != rune('A') {
+									goto l1239
+								}
+								position++
+							}
+						l1245:
+							if buffer[position] != rune('3') {
+								goto l1239
+							}
+							position++
+							if buffer[position] != rune('8') {
+								goto l1239
+							}
+							position++
+							if buffer[position] != rune('4') {
+								goto l1239
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1239
+							}
+							depth--
+							add(ruleSHA384, position1240)
+						}
+						goto l960
+					l1239:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1248 := position
+							depth++
+							{
+								position1249, tokenIndex1249, depth1249 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1250
+								}
+								position++
+								goto l1249
+							l1250:
+								position, tokenIndex, depth = position1249, tokenIndex1249, depth1249
+								if buffer[position] != rune('S') {
+									goto l1247
+								}
+								position++
+							}
+						l1249:
+							{
+								position1251, tokenIndex1251, depth1251 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1252
+								}
+								position++
+								goto l1251
+							l1252:
+								position, tokenIndex, depth = position1251, tokenIndex1251, depth1251
+								if buffer[position] != rune('H') {
+									goto l1247
+								}
+								position++
+							}
+						l1251:
+							{
+								position1253, tokenIndex1253, depth1253 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1254
+								}
+								position++
+								goto l1253
+							l1254:
+								position, tokenIndex, depth = position1253, tokenIndex1253, depth1253
+								if buffer[position] != rune('A') {
+									goto l1247
+								}
+								position++
+							}
+						l1253:
+							if buffer[position] != rune('5') {
+								goto l1247
+							}
+							position++
+							if buffer[position] != rune('1') {
+								goto l1247
+							}
+							position++
+							if buffer[position] != rune('2') {
+								goto l1247
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1247
+							}
+							depth--
+							add(ruleSHA512, position1248)
+						}
+						goto l960
+					l1247:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1256 := position
+							depth++
+							{
+								position1257, tokenIndex1257, depth1257 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1258
+								}
+								position++
+								goto l1257
+							l1258:
+								position, tokenIndex, depth = position1257, tokenIndex1257, depth1257
+								if buffer[position] != rune('I') {
+									goto l1255
+								}
+								position++
+							}
+						l1257:
+							{
+								position1259, tokenIndex1259, depth1259 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1260
+								}
+								position++
+								goto l1259
+							l1260:
+								position, tokenIndex, depth = position1259, tokenIndex1259, depth1259
+								if buffer[position] != rune('S') {
+									goto l1255
+								}
+								position++
+							}
+						l1259:
+							{
+								position1261, tokenIndex1261, depth1261 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1262
+								}
+								position++
+								goto l1261
+							l1262:
+								position, tokenIndex, depth = position1261, tokenIndex1261, depth1261
+								if buffer[position] != rune('I') {
+									goto l1255
+								}
+								position++
+							}
+						l1261:
+							{
+								position1263, tokenIndex1263, depth1263 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1264
+								}
+								position++
+								goto l1263
+							l1264:
+								position, tokenIndex, depth = position1263, tokenIndex1263, depth1263
+								if buffer[position] != rune('R') {
+									goto l1255
+								}
+								position++
+							}
+						l1263:
+							{
+								position1265, tokenIndex1265, depth1265 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1266
+								}
+								position++
+								goto l1265
+							l1266:
+								position, tokenIndex, depth = position1265, tokenIndex1265, depth1265
+								if buffer[position] != rune('I') {
+									goto l1255
+								}
+								position++
+							}
+						l1265:
+							if !_rules[ruleskip]() {
+								goto l1255
+							}
+							depth--
+							add(ruleISIRI, position1256)
+						}
+						goto l960
+					l1255:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1268 := position
+							depth++
+							{
+								position1269, tokenIndex1269, depth1269 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1270
+								}
+								position++
+								goto l1269
+							l1270:
+								position, tokenIndex, depth = position1269, tokenIndex1269, depth1269
+								if buffer[position] != rune('I') {
+									goto l1267
+								}
+								position++
+							}
+						l1269:
+							{
+								position1271, tokenIndex1271, depth1271 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1272
+								}
+								position++
+								goto l1271
+							l1272:
+								position, tokenIndex, depth = position1271, tokenIndex1271, depth1271
+								if buffer[position] != rune('S') {
+									goto l1267
+								}
+								position++
+							}
+						l1271:
+							{
+								position1273, tokenIndex1273, depth1273 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1274
+								}
+								position++
+								goto l1273
+							l1274:
+								position, tokenIndex, depth = position1273, tokenIndex1273, depth1273
+								if buffer[position] != rune('U') {
+									goto l1267
+								}
+								position++
+							}
+						l1273:
+							{
+								position1275, tokenIndex1275, depth1275 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1276
+								}
+								position++
+								goto l1275
+							l1276:
+								position, tokenIndex, depth = position1275, tokenIndex1275, depth1275
+								if buffer[position] != rune('R') {
+									goto l1267
+								}
+								position++
+							}
+						l1275:
+							{
+								position1277, tokenIndex1277, depth1277 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1278
+								}
+								position++
+								goto l1277
+							l1278:
+								position, tokenIndex, depth = position1277, tokenIndex1277, depth1277
+								if buffer[position] != rune('I') {
+									goto l1267
+								}
+								position++
+							}
+						l1277:
+							if !_rules[ruleskip]() {
+								goto l1267
+							}
+							depth--
+							add(ruleISURI, position1268)
+						}
+						goto l960
+					l1267:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1280 := position
+							depth++
+							{
+								position1281, tokenIndex1281, depth1281 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1282
+								}
+								position++
+								goto l1281
+							l1282:
+								position, tokenIndex, depth = position1281, tokenIndex1281, depth1281
+								if buffer[position] != rune('I') {
+									goto l1279
+								}
+								position++
+							}
+						l1281:
+							{
+								position1283, tokenIndex1283, depth1283 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1284
+								}
+								position++
+								goto l1283
+							l1284:
+								position, tokenIndex, depth = position1283, tokenIndex1283, depth1283
+								if buffer[position] != rune('S') {
+									goto l1279
+								}
+								position++
+							}
+						l1283:
+							{
+								position1285, tokenIndex1285, depth1285 := position, tokenIndex, depth
+								if buffer[position] != rune('b') {
+									goto l1286
+								}
+								position++
+								goto l1285
+							l1286:
+								position, tokenIndex, depth = position1285, tokenIndex1285, depth1285
+								if buffer[position] != rune('B') {
+									goto l1279
+								}
+								position++
+							}
+						l1285:
+							{
+								position1287, tokenIndex1287, depth1287 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1288
+								}
+								position++
+								goto l1287
+							l1288:
+								position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
+								if buffer[position] != rune('L') {
+									goto l1279
+								}
+								position++
+							}
+						l1287:
+							{
+								position1289, tokenIndex1289, depth1289 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1290
+								}
+								position++
+								goto l1289
+							l1290:
+								position, tokenIndex, depth = position1289, tokenIndex1289, depth1289
+								if buffer[position] != rune('A') {
+									goto l1279
+								}
+								position++
+							}
+						l1289:
+							{
+								position1291, tokenIndex1291, depth1291 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1292
+								}
+								position++
+								goto l1291
+							l1292:
+								position, tokenIndex, depth = position1291, tokenIndex1291, depth1291
+								if buffer[position] != rune('N') {
+									goto l1279
+								}
+								position++
+							}
+						l1291:
+							{
+								position1293, tokenIndex1293, depth1293 := position, tokenIndex, depth
+								if buffer[position] != rune('k') {
+									goto l1294
+								}
+								position++
+								goto l1293
+							l1294:
+								position, tokenIndex, depth = position1293, tokenIndex1293, depth1293
+								if buffer[position] != rune('K') {
+									goto l1279
+								}
+								position++
+							}
+						l1293:
+							if !_rules[ruleskip]() {
+								goto l1279
+							}
+							depth--
+							add(ruleISBLANK, position1280)
+						}
+						goto l960
+					l1279:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1296 := position
+							depth++
+							{
+								position1297, tokenIndex1297, depth1297 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1298
+								}
+								position++
+								goto l1297
+							l1298:
+								position, tokenIndex, depth = position1297, tokenIndex1297, depth1297
+								if buffer[position] != rune('I') {
+									goto l1295
+								}
+								position++
+							}
+						l1297:
+							{
+								position1299, tokenIndex1299, depth1299 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1300
+								}
+								position++
+								goto l1299
+							l1300:
+								position, tokenIndex, depth = position1299, tokenIndex1299, depth1299
+								if buffer[position] != rune('S') {
+									goto l1295
+								}
+								position++
+							}
+						l1299:
+							{
+								position1301, tokenIndex1301, depth1301 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1302
+								}
+								position++
+								goto l1301
+							l1302:
+								position, tokenIndex, depth = position1301, tokenIndex1301, depth1301
+								if buffer[position] != rune('L') {
+									goto l1295
+								}
+								position++
+							}
+						l1301:
+							{
+								position1303, tokenIndex1303, depth1303 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1304
+								}
+								position++
+								goto l1303
+							l1304:
+								position, tokenIndex, depth = position1303, tokenIndex1303, depth1303
+								if buffer[position] != rune('I') {
+									goto l1295
+								}
+								position++
+							}
+						l1303:
+							{
+								position1305, tokenIndex1305, depth1305 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1306
+								}
+								position++
+								goto l1305
+							l1306:
+								position, tokenIndex, depth = position1305, tokenIndex1305, depth1305
+								if buffer[position] != rune('T') {
+									goto l1295
+								}
+								position++
+							}
+						l1305:
+							{
+								position1307, tokenIndex1307, depth1307 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1308
+								}
+								position++
+								goto l1307
+							l1308:
+								position, tokenIndex, depth = position1307, tokenIndex1307, depth1307
+								if buffer[position] != rune('E') {
+									goto l1295
+								}
+								position++
+							}
+						l1307:
+							{
+								position1309, tokenIndex1309, depth1309 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1310
+								}
+								position++
+								goto l1309
+							l1310:
+								position, tokenIndex, depth = position1309, tokenIndex1309, depth1309
+								if buffer[position] != rune('R') {
+									goto l1295
+								}
+								position++
+							}
+						l1309:
+							{
+								position1311, tokenIndex1311, depth1311 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1312
+								}
+								position++
+								goto l1311
+							l1312:
+								position, tokenIndex, depth = position1311, tokenIndex1311, depth1311
+								if buffer[position] != rune('A') {
+									goto l1295
+								}
+								position++
+							}
+						l1311:
+							{
+								position1313, tokenIndex1313, depth1313 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1314
+								}
+								position++
+								goto l1313
+							l1314:
+								position, tokenIndex, depth = position1313, tokenIndex1313, depth1313
+								if buffer[position] != rune('L') {
+									goto l1295
+								}
+								position++
+							}
+						l1313:
+							if !_rules[ruleskip]() {
+								goto l1295
+							}
+							depth--
+							add(ruleISLITERAL, position1296)
+						}
+						goto l960
+					l1295:
+						position, tokenIndex, depth = position960, tokenIndex960, depth960
+						{
+							position1315 := position
+							depth++
+							{
+								position1316, tokenIndex1316, depth1316 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1317
+								}
+								position++
+								goto l1316
+							l1317:
+								position, tokenIndex, depth = position1316, tokenIndex1316, depth1316
+								if buffer[position] != rune('I') {
+									goto l959
+								}
+								position++
+							}
+						l1316:
+							{
+								position1318, tokenIndex1318, depth1318 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1319
+								}
+								position++
+								goto l1318
+							l1319:
+								position, tokenIndex, depth = position1318, tokenIndex1318, depth1318
+								if buffer[position] != rune('S') {
+									goto l959
+								}
+								position++
+							}
+						l1318:
+							{
+								position1320, tokenIndex1320, depth1320 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1321
+								}
+								position++
+								goto l1320
+							l1321:
+								position, tokenIndex, depth = position1320, tokenIndex1320, depth1320
+								if buffer[position] != rune('N') {
+									goto l959
+								}
+								position++
+							}
+						l1320:
+							{
+								position1322, tokenIndex1322, depth1322 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1323
+								}
+								position++
+								goto l1322
+							l1323:
+								position, tokenIndex, depth = position1322, tokenIndex1322, depth1322
+								if buffer[position] != rune('U') {
+									goto l959
+								}
+								position++
+							}
+						l1322:
+							{
+								position1324, tokenIndex1324, depth1324 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1325
+								}
+								position++
+								goto l1324
+							l1325:
+								position, tokenIndex, depth = position1324, tokenIndex1324, depth1324
+								if buffer[position] != rune('M') {
+									goto l959
+								}
+								position++
+							}
+						l1324:
+							{
+								position1326, tokenIndex1326, depth1326 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1327
+								}
+								position++
+								goto l1326
+							l1327:
+								position, tokenIndex, depth = position1326, tokenIndex1326, depth1326
+								if buffer[position] != rune('E') {
+									goto l959
+								}
+								position++
+							}
+						l1326:
+							{
+								position1328, tokenIndex1328, depth1328 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1329
+								}
+								position++
+								goto l1328
+							l1329:
+								position, tokenIndex, depth = position1328, tokenIndex1328, depth1328
+								if buffer[position] != rune('R') {
+									goto l959
+								}
+								position++
+							}
+						l1328:
+							{
+								position1330, tokenIndex1330, depth1330 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1331
+								}
+								position++
+								goto l1330
+							l1331:
+								position, tokenIndex, depth = position1330, tokenIndex1330, depth1330
+								if buffer[position] != rune('I') {
+									goto l959
+								}
+								position++
+							}
+						l1330:
+							{
+								position1332, tokenIndex1332, depth1332 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1333
+								}
+								position++
+								goto l1332
+							l1333:
+								position, tokenIndex, depth = position1332, tokenIndex1332, depth1332
+								if buffer[position] != rune('C') {
+									goto l959
+								}
+								position++
+							}
+						l1332:
+							if !_rules[ruleskip]() {
+								goto l959
+							}
+							depth--
+							add(ruleISNUMERIC, position1315)
+						}
+					}
+				l960:
+					if !_rules[ruleLPAREN]() {
+						goto l959
+					}
+					if !_rules[ruleexpression]() {
+						goto l959
+					}
+					if !_rules[ruleRPAREN]() {
+						goto l959
+					}
+					goto l958
+				l959:
+					position, tokenIndex, depth = position958, tokenIndex958, depth958
+					{
+						position1335, tokenIndex1335, depth1335 := position, tokenIndex, depth
+						{
+							position1337 := position
+							depth++
+							{
+								position1338, tokenIndex1338, depth1338 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1339
+								}
+								position++
+								goto l1338
+							l1339:
+								position, tokenIndex, depth = position1338, tokenIndex1338, depth1338
+								if buffer[position] != rune('L') {
+									goto l1336
+								}
+								position++
+							}
+						l1338:
+							{
+								position1340, tokenIndex1340, depth1340 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1341
+								}
+								position++
+								goto l1340
+							l1341:
+								position, tokenIndex, depth = position1340, tokenIndex1340, depth1340
+								if buffer[position] != rune('A') {
+									goto l1336
+								}
+								position++
+							}
+						l1340:
+							{
+								position1342, tokenIndex1342, depth1342 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1343
+								}
+								position++
+								goto l1342
+							l1343:
+								position, tokenIndex, depth = position1342, tokenIndex1342, depth1342
+								if buffer[position] != rune('N') {
+									goto l1336
+								}
+								position++
+							}
+						l1342:
+							{
+								position1344, tokenIndex1344, depth1344 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l1345
+								}
+								position++
+								goto l1344
+							l1345:
+								position, tokenIndex, depth = position1344, tokenIndex1344, depth1344
+								if buffer[position] != rune('G') {
+									goto l1336
+								}
+								position++
+							}
+						l1344:
+							{
+								position1346, tokenIndex1346, depth1346 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1347
+								}
+								position++
+								goto l1346
+							l1347:
+								position, tokenIndex, depth = position1346, tokenIndex1346, depth1346
+								if buffer[position] != rune('M') {
+									goto l1336
+								}
+								position++
+							}
+						l1346:
+							{
+								position1348, tokenIndex1348, depth1348 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1349
+								}
+								position++
+								goto l1348
+							l1349:
+								position, tokenIndex, depth = position1348, tokenIndex1348, depth1348
+								if buffer[position] != rune('A') {
+									goto l1336
+								}
+								position++
+							}
+						l1348:
+							{
+								position1350, tokenIndex1350, depth1350 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1351
+								}
+								position++
+								goto l1350
+							l1351:
+								position, tokenIndex, depth = position1350, tokenIndex1350, depth1350
+								if buffer[position] != rune('T') {
+									goto l1336
+								}
+								position++
+							}
+						l1350:
+							{
+								position1352, tokenIndex1352, depth1352 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1353
+								}
+								position++
+								goto l1352
+							l1353:
+								position, tokenIndex, depth = position1352, tokenIndex1352, depth1352
+								if buffer[position] != rune('C') {
+									goto l1336
+								}
+								position++
+							}
+						l1352:
+							{
+								position1354, tokenIndex1354, depth1354 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1355
+								}
+								position++
+								goto l1354
+							l1355:
+								position, tokenIndex, depth = position1354, tokenIndex1354, depth1354
+								if buffer[position] != rune('H') {
+									goto l1336
+								}
+								position++
+							}
+						l1354:
+							{
+								position1356, tokenIndex1356, depth1356 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1357
+								}
+								position++
+								goto l1356
+							l1357:
+								position, tokenIndex, depth = position1356, tokenIndex1356, depth1356
+								if buffer[position] != rune('E') {
+									goto l1336
+								}
+								position++
+							}
+						l1356:
+							{
+								position1358, tokenIndex1358, depth1358 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1359
+								}
+								position++
+								goto l1358
+							l1359:
+								position, tokenIndex, depth = position1358, tokenIndex1358, depth1358
+								if buffer[position] != rune('S') {
+									goto l1336
+								}
+								position++
+							}
+						l1358:
+							if !_rules[ruleskip]() {
+								goto l1336
+							}
+							depth--
+							add(ruleLANGMATCHES, position1337)
+						}
+						goto l1335
+					l1336:
+						position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
+						{
+							position1361 := position
+							depth++
+							{
+								position1362, tokenIndex1362, depth1362 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1363
+								}
+								position++
+								goto l1362
+							l1363:
+								position, tokenIndex, depth = position1362, tokenIndex1362, depth1362
+								if buffer[position] != rune('C') {
+									goto l1360
+								}
+								position++
+							}
+						l1362:
+							{
+								position1364, tokenIndex1364, depth1364 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1365
+								}
+								position++
+								goto l1364
+							l1365:
+								position, tokenIndex, depth = position1364, tokenIndex1364, depth1364
+								if buffer[position] != rune('O') {
+									goto l1360
+								}
+								position++
+							}
+						l1364:
+							{
+								position1366, tokenIndex1366, depth1366 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1367
+								}
+								position++
+								goto l1366
+							l1367:
+								position, tokenIndex, depth = position1366, tokenIndex1366, depth1366
+								if buffer[position] != rune('N') {
+									goto l1360
+								}
+								position++
+							}
+						l1366:
+							{
+								position1368, tokenIndex1368, depth1368 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1369
+								}
+								position++
+								goto l1368
+							l1369:
+								position, tokenIndex, depth = position1368, tokenIndex1368, depth1368
+								if buffer[position] != rune('T') {
+									goto l1360
+								}
+								position++
+							}
+						l1368:
+							{
+								position1370, tokenIndex1370, depth1370 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1371
+								}
+								position++
+								goto l1370
+							l1371:
+								position, tokenIndex, depth = position1370, tokenIndex1370, depth1370
+								if buffer[position] != rune('A') {
+									goto l1360
+								}
+								position++
+							}
+						l1370:
+							{
+								position1372, tokenIndex1372, depth1372 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1373
+								}
+								position++
+								goto l1372
+							l1373:
+								position, tokenIndex, depth = position1372, tokenIndex1372, depth1372
+								if buffer[position] != rune('I') {
+									goto l1360
+								}
+								position++
+							}
+						l1372:
+							{
+								position1374, tokenIndex1374, depth1374 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1375
+								}
+								position++
+								goto l1374
+							l1375:
+								position, tokenIndex, depth = position1374, tokenIndex1374, depth1374
+								if buffer[position] != rune('N') {
+									goto l1360
+								}
+								position++
+							}
+						l1374:
+							{
+								position1376, tokenIndex1376, depth1376 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1377
+								}
+								position++
+								goto l1376
+							l1377:
+								position, tokenIndex, depth = position1376, tokenIndex1376, depth1376
+								if buffer[position] != rune('S') {
+									goto l1360
+								}
+								position++
+							}
+						l1376:
+							if !_rules[ruleskip]() {
+								goto l1360
+							}
+							depth--
+							add(ruleCONTAINS, position1361)
+						}
+						goto l1335
+					l1360:
+						position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
+						{
+							position1379 := position
+							depth++
+							{
+								position1380, tokenIndex1380, depth1380 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1381
+								}
+								position++
+								goto l1380
+							l1381:
+								position, tokenIndex, depth = position1380, tokenIndex1380, depth1380
+								if buffer[position] != rune('S') {
+									goto l1378
+								}
+								position++
+							}
+						l1380:
+							{
+								position1382, tokenIndex1382, depth1382 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1383
+								}
+								position++
+								goto l1382
+							l1383:
+								position, tokenIndex, depth = position1382, tokenIndex1382, depth1382
+								if buffer[position] != rune('T') {
+									goto l1378
+								}
+								position++
+							}
+						l1382:
+							{
+								position1384, tokenIndex1384, depth1384 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1385
+								}
+								position++
+								goto l1384
+							l1385:
+								position, tokenIndex, depth = position1384, tokenIndex1384, depth1384
+								if buffer[position] != rune('R') {
+									goto l1378
+								}
+								position++
+							}
+						l1384:
+							{
+								position1386, tokenIndex1386, depth1386 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1387
+								}
+								position++
+								goto l1386
+							l1387:
+								position, tokenIndex, depth = position1386, tokenIndex1386, depth1386
+								if buffer[position] != rune('S') {
+									goto l1378
+								}
+								position++
+							}
+						l1386:
+							{
+								position1388, tokenIndex1388, depth1388 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1389
+								}
+								position++
+								goto l1388
+							l1389:
+								position, tokenIndex, depth = position1388, tokenIndex1388, depth1388
+								if buffer[position] != rune('T') {
+									goto l1378
+								}
+								position++
+							}
+						l1388:
+							{
+								position1390, tokenIndex1390, depth1390 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1391
+								}
+								position++
+								goto l1390
+							l1391:
+								position, tokenIndex, depth = position1390, tokenIndex1390, depth1390
+								if buffer[position] != rune('A') {
+									goto l1378
+								}
+								position++
+							}
+						l1390:
+							{
+								position1392, tokenIndex1392, depth1392 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1393
+								}
+								position++
+								goto l1392
+							l1393:
+								position, tokenIndex, depth = position1392, tokenIndex1392, depth1392
+								if buffer[position] != rune('R') {
+									goto l1378
+								}
+								position++
+							}
+						l1392:
+							{
+								position1394, tokenIndex1394, depth1394 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1395
+								}
+								position++
+								goto l1394
+							l1395:
+								position, tokenIndex, depth = position1394, tokenIndex1394, depth1394
+								if buffer[position] != rune('T') {
+									goto l1378
+								}
+								position++
+							}
+						l1394:
+							{
+								position1396, tokenIndex1396, depth1396 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1397
+								}
+								position++
+								goto l1396
+							l1397:
+								position, tokenIndex, depth = position1396, tokenIndex1396, depth1396
+								if buffer[position] != rune('S') {
+									goto l1378
+								}
+								position++
+							}
+						l1396:
+							if !_rules[ruleskip]() {
+								goto l1378
+							}
+							depth--
+							add(ruleSTRSTARTS, position1379)
+						}
+						goto l1335
+					l1378:
+						position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
+						{
+							position1399 := position
+							depth++
+							{
+								position1400, tokenIndex1400, depth1400 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1401
+								}
+								position++
+								goto l1400
+							l1401:
+								position, tokenIndex, depth = position1400, tokenIndex1400, depth1400
+								if buffer[position] != rune('S') {
+									goto l1398
+								}
+								position++
+							}
+						l1400:
+							{
+								position1402, tokenIndex1402, depth1402 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1403
+								}
+								position++
+								goto l1402
+							l1403:
+								position, tokenIndex, depth = position1402, tokenIndex1402, depth1402
+								if buffer[position] != rune('T') {
+									goto l1398
+								}
+								position++
+							}
+						l1402:
+							{
+								position1404, tokenIndex1404, depth1404 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1405
+								}
+								position++
+								goto l1404
+							l1405:
+								position, tokenIndex, depth = position1404, tokenIndex1404, depth1404
+								if buffer[position] != rune('R') {
+									goto l1398
+								}
+								position++
+							}
+						l1404:
+							{
+								position1406, tokenIndex1406, depth1406 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1407
+								}
+								position++
+								goto l1406
+							l1407:
+								position, tokenIndex, depth = position1406, tokenIndex1406, depth1406
+								if buffer[position] != rune('E') {
+									goto l1398
+								}
+								position++
+							}
+						l1406:
+							{
+								position1408, tokenIndex1408, depth1408 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1409
+								}
+								position++
+								goto l1408
+							l1409:
+								position, tokenIndex, depth = position1408, tokenIndex1408, depth1408
+								if buffer[position] != rune('N') {
+									goto l1398
+								}
+								position++
+							}
+						l1408:
+							{
+								position1410, tokenIndex1410, depth1410 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1411
+								}
+								position++
+								goto l1410
+							l1411:
+								position, tokenIndex, depth = position1410, tokenIndex1410, depth1410
+								if buffer[position] != rune('D') {
+									goto l1398
 								}
-								break
-							case 'E', 'e':
-								{
-									position1120 := position
-									depth++
-									{
-										position1121, tokenIndex1121, depth1121 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1122
-										}
-										position++
-										goto l1121
-									l1122:
-										position, tokenIndex, depth = position1121, tokenIndex1121, depth1121
-										if buffer[position] != rune('E') {
-											goto l842
-										}
-										position++
-									}
-								l1121:
-									{
-										position1123, tokenIndex1123, depth1123 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1124
-										}
-										position++
-										goto l1123
-									l1124:
-										position, tokenIndex, depth = position1123, tokenIndex1123, depth1123
-										if buffer[position] != rune('N') {
-											goto l842
-										}
-										position++
-									}
-								l1123:
-									{
-										position1125, tokenIndex1125, depth1125 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1126
-										}
-										position++
-										goto l1125
-									l1126:
-										position, tokenIndex, depth = position1125, tokenIndex1125, depth1125
-										if buffer[position] != rune('C') {
-											goto l842
-										}
-										position++
-									}
-								l1125:
-									{
-										position1127, tokenIndex1127, depth1127 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1128
-										}
-										position++
-										goto l1127
-									l1128:
-										position, tokenIndex, depth = position1127, tokenIndex1127, depth1127
-										if buffer[position] != rune('O') {
-											goto l842
-										}
-										position++
-									}
-								l1127:
-									{
-										position1129, tokenIndex1129, depth1129 := position, tokenIndex, depth
-										if buffer[position] != rune('d') {
-											goto l1130
-										}
-										position++
-										goto l1129
-									l1130:
-										position, tokenIndex, depth = position1129, tokenIndex1129, depth1129
-										if buffer[position] != rune('D') {
-											goto l842
-										}
-										position++
-									}
-								l1129:
-									{
-										position1131, tokenIndex1131, depth1131 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1132
-										}
-										position++
-										goto l1131
-									l1132:
-										position, tokenIndex, depth = position1131, tokenIndex1131, depth1131
-										if buffer[position] != rune('E') {
-											goto l842
-										}
-										position++
-									}
-								l1131:
-									if buffer[position] != rune('_') {
-										goto l842
-									}
-									position++
-									{
-										position1133, tokenIndex1133, depth1133 := position, tokenIndex, depth
-										if buffer[position] != rune('f') {
-											goto l1134
-										}
-										position++
-										goto l1133
-									l1134:
-										position, tokenIndex, depth = position1133, tokenIndex1133, depth1133
-										if buffer[position] != rune('F') {
-											goto l842
-										}
-										position++
-									}
-								l1133:
-									{
-										position1135, tokenIndex1135, depth1135 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1136
-										}
-										position++
-										goto l1135
-									l1136:
-										position, tokenIndex, depth = position1135, tokenIndex1135, depth1135
-										if buffer[position] != rune('O') {
-											goto l842
-										}
-										position++
-									}
-								l1135:
-									{
-										position1137, tokenIndex1137, depth1137 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1138
-										}
-										position++
-										goto l1137
-									l1138:
-										position, tokenIndex, depth = position1137, tokenIndex1137, depth1137
-										if buffer[position] != rune('R') {
-											goto l842
-										}
-										position++
-									}
-								l1137:
-									if buffer[position] != rune('_') {
-										goto l842
-									}
-									position++
-									{
-										position1139, tokenIndex1139, depth1139 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1140
-										}
-										position++
-										goto l1139
-									l1140:
-										position, tokenIndex, depth = position1139, tokenIndex1139, depth1139
-										if buffer[position] != rune('U') {
-											goto l842
-										}
-										position++
-									}
-								l1139:
-									{
-										position1141, tokenIndex1141, depth1141 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1142
-										}
-										position++
-										goto l1141
-									l1142:
-										position, tokenIndex, depth = position1141, tokenIndex1141, depth1141
-										if buffer[position] != rune('R') {
-											goto l842
-										}
-										position++
-									}
-								l1141:
-									{
-										position1143, tokenIndex1143, depth1143 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1144
-										}
-										position++
-										goto l1143
-									l1144:
-										position, tokenIndex, depth = position1143, tokenIndex1143, depth1143
-										if buffer[position] != rune('I') {
-											goto l842
-										}
-										position++
-									}
-								l1143:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleENCODEFORURI, position1120)
+								position++
+							}
+						l1410:
+							{
+								position1412, tokenIndex1412, depth1412 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1413
 								}
-								break
-							case 'L', 'l':
-								{
-									position1145 := position
-									depth++
-									{
-										position1146, tokenIndex1146, depth1146 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1147
-										}
-										position++
-										goto l1146
-									l1147:
-										position, tokenIndex, depth = position1146, tokenIndex1146, depth1146
-										if buffer[position] != rune('L') {
-											goto l842
-										}
-										position++
-									}
-								l1146:
-									{
-										position1148, tokenIndex1148, depth1148 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1149
-										}
-										position++
-										goto l1148
-									l1149:
-										position, tokenIndex, depth = position1148, tokenIndex1148, depth1148
-										if buffer[position] != rune('C') {
-											goto l842
-										}
-										position++
-									}
-								l1148:
-									{
-										position1150, tokenIndex1150, depth1150 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1151
-										}
-										position++
-										goto l1150
-									l1151:
-										position, tokenIndex, depth = position1150, tokenIndex1150, depth1150
-										if buffer[position] != rune('A') {
-											goto l842
-										}
-										position++
-									}
-								l1150:
-									{
-										position1152, tokenIndex1152, depth1152 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1153
-										}
-										position++
-										goto l1152
-									l1153:
-										position, tokenIndex, depth = position1152, tokenIndex1152, depth1152
-										if buffer[position] != rune('S') {
-											goto l842
-										}
-										position++
-									}
-								l1152:
-									{
-										position1154, tokenIndex1154, depth1154 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1155
-										}
-										position++
-										goto l1154
-									l1155:
-										position, tokenIndex, depth = position1154, tokenIndex1154, depth1154
-										if buffer[position] != rune('E') {
-											goto l842
-										}
-										position++
-									}
-								l1154:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleLCASE, position1145)
+								position++
+								goto l1412
+							l1413:
+								position, tokenIndex, depth = position1412, tokenIndex1412, depth1412
+								if buffer[position] != rune('S') {
+									goto l1398
 								}
-								break
-							case 'U', 'u':
-								{
-									position1156 := position
-									depth++
-									{
-										position1157, tokenIndex1157, depth1157 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1158
-										}
-										position++
-										goto l1157
-									l1158:
-										position, tokenIndex, depth = position1157, tokenIndex1157, depth1157
-										if buffer[position] != rune('U') {
-											goto l842
-										}
-										position++
-									}
-								l1157:
-									{
-										position1159, tokenIndex1159, depth1159 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1160
-										}
-										position++
-										goto l1159
-									l1160:
-										position, tokenIndex, depth = position1159, tokenIndex1159, depth1159
-										if buffer[position] != rune('C') {
-											goto l842
-										}
-										position++
-									}
-								l1159:
-									{
-										position1161, tokenIndex1161, depth1161 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1162
-										}
-										position++
-										goto l1161
-									l1162:
-										position, tokenIndex, depth = position1161, tokenIndex1161, depth1161
-										if buffer[position] != rune('A') {
-											goto l842
-										}
-										position++
-									}
-								l1161:
-									{
-										position1163, tokenIndex1163, depth1163 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1164
-										}
-										position++
-										goto l1163
-									l1164:
-										position, tokenIndex, depth = position1163, tokenIndex1163, depth1163
-										if buffer[position] != rune('S') {
-											goto l842
-										}
-										position++
-									}
-								l1163:
-									{
-										position1165, tokenIndex1165, depth1165 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1166
-										}
-										position++
-										goto l1165
-									l1166:
-										position, tokenIndex, depth = position1165, tokenIndex1165, depth1165
-										if buffer[position] != rune('E') {
-											goto l842
-										}
-										position++
-									}
-								l1165:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleUCASE, position1156)
+								position++
+							}
+						l1412:
+							if !_rules[ruleskip]() {
+								goto l1398
+							}
+							depth--
+							add(ruleSTRENDS, position1399)
+						}
+						goto l1335
+					l1398:
+						position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
+						{
+							position1415 := position
+							depth++
+							{
+								position1416, tokenIndex1416, depth1416 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1417
+								}
+								position++
+								goto l1416
+							l1417:
+								position, tokenIndex, depth = position1416, tokenIndex1416, depth1416
+								if buffer[position] != rune('S') {
+									goto l1414
+								}
+								position++
+							}
+						l1416:
+							{
+								position1418, tokenIndex1418, depth1418 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1419
+								}
+								position++
+								goto l1418
+							l1419:
+								position, tokenIndex, depth = position1418, tokenIndex1418, depth1418
+								if buffer[position] != rune('T') {
+									goto l1414
+								}
+								position++
+							}
+						l1418:
+							{
+								position1420, tokenIndex1420, depth1420 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1421
+								}
+								position++
+								goto l1420
+							l1421:
+								position, tokenIndex, depth = position1420, tokenIndex1420, depth1420
+								if buffer[position] != rune('R') {
+									goto l1414
+								}
+								position++
+							}
+						l1420:
+							{
+								position1422, tokenIndex1422, depth1422 := position, tokenIndex, depth
+								if buffer[position] != rune('b') {
+									goto l1423
+								}
+								position++
+								goto l1422
+							l1423:
+								position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+								if buffer[position] != rune('B') {
+									goto l1414
+								}
+								position++
+							}
+						l1422:
+							{
+								position1424, tokenIndex1424, depth1424 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1425
 								}
-								break
-							case 'F', 'f':
-								{
-									position1167 := position
-									depth++
-									{
-										position1168, tokenIndex1168, depth1168 := position, tokenIndex, depth
-										if buffer[position] != rune('f') {
-											goto l1169
-										}
-										position++
-										goto l1168
-									l1169:
-										position, tokenIndex, depth = position1168, tokenIndex1168, depth1168
-										if buffer[position] != rune('F') {
-											goto l842
-										}
-										position++
-									}
-								l1168:
-									{
-										position1170, tokenIndex1170, depth1170 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1171
-										}
-										position++
-										goto l1170
-									l1171:
-										position, tokenIndex, depth = position1170, tokenIndex1170, depth1170
-										if buffer[position] != rune('L') {
-											goto l842
-										}
-										position++
-									}
-								l1170:
-									{
-										position1172, tokenIndex1172, depth1172 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1173
-										}
-										position++
-										goto l1172
-									l1173:
-										position, tokenIndex, depth = position1172, tokenIndex1172, depth1172
-										if buffer[position] != rune('O') {
-											goto l842
-										}
-										position++
-									}
-								l1172:
-									{
-										position1174, tokenIndex1174, depth1174 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1175
-										}
-										position++
-										goto l1174
-									l1175:
-										position, tokenIndex, depth = position1174, tokenIndex1174, depth1174
-										if buffer[position] != rune('O') {
-											goto l842
-										}
-										position++
-									}
-								l1174:
-									{
-										position1176, tokenIndex1176, depth1176 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1177
-										}
-										position++
-										goto l1176
-									l1177:
-										position, tokenIndex, depth = position1176, tokenIndex1176, depth1176
-										if buffer[position] != rune('R') {
-											goto l842
-										}
-										position++
-									}
-								l1176:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleFLOOR, position1167)
+								position++
+								goto l1424
+							l1425:
+								position, tokenIndex, depth = position1424, tokenIndex1424, depth1424
+								if buffer[position] != rune('E') {
+									goto l1414
 								}
-								break
-							case 'R', 'r':
-								{
-									position1178 := position
-									depth++
-									{
-										position1179, tokenIndex1179, depth1179 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1180
-										}
-										position++
-										goto l1179
-									l1180:
-										position, tokenIndex, depth = position1179, tokenIndex1179, depth1179
-										if buffer[position] != rune('R') {
-											goto l842
-										}
-										position++
-									}
-								l1179:
-									{
-										position1181, tokenIndex1181, depth1181 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1182
-										}
-										position++
-										goto l1181
-									l1182:
-										position, tokenIndex, depth = position1181, tokenIndex1181, depth1181
-										if buffer[position] != rune('O') {
-											goto l842
-										}
-										position++
-									}
-								l1181:
-									{
-										position1183, tokenIndex1183, depth1183 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1184
-										}
-										position++
-										goto l1183
-									l1184:
-										position, tokenIndex, depth = position1183, tokenIndex1183, depth1183
-										if buffer[position] != rune('U') {
-											goto l842
-										}
-										position++
-									}
-								l1183:
-									{
-										position1185, tokenIndex1185, depth1185 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1186
-										}
-										position++
-										goto l1185
-									l1186:
-										position, tokenIndex, depth = position1185, tokenIndex1185, depth1185
-										if buffer[position] != rune('N') {
-											goto l842
-										}
-										position++
-									}
-								l1185:
-									{
-										position1187, tokenIndex1187, depth1187 := position, tokenIndex, depth
-										if buffer[position] != rune('d') {
-											goto l1188
-										}
-										position++
-										goto l1187
-									l1188:
-										position, tokenIndex, depth = position1187, tokenIndex1187, depth1187
-										if buffer[position] != rune('D') {
-											goto l842
-										}
-										position++
-									}
-								l1187:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleROUND, position1178)
+								position++
+							}
+						l1424:
+							{
+								position1426, tokenIndex1426, depth1426 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1427
 								}
-								break
-							case 'C', 'c':
-								{
-									position1189 := position
-									depth++
-									{
-										position1190, tokenIndex1190, depth1190 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1191
-										}
-										position++
-										goto l1190
-									l1191:
-										position, tokenIndex, depth = position1190, tokenIndex1190, depth1190
-										if buffer[position] != rune('C') {
-											goto l842
-										}
-										position++
-									}
-								l1190:
-									{
-										position1192, tokenIndex1192, depth1192 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1193
-										}
-										position++
-										goto l1192
-									l1193:
-										position, tokenIndex, depth = position1192, tokenIndex1192, depth1192
-										if buffer[position] != rune('E') {
-											goto l842
-										}
-										position++
-									}
-								l1192:
-									{
-										position1194, tokenIndex1194, depth1194 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1195
-										}
-										position++
-										goto l1194
-									l1195:
-										position, tokenIndex, depth = position1194, tokenIndex1194, depth1194
-										if buffer[position] != rune('I') {
-											goto l842
-										}
-										position++
-									}
-								l1194:
-									{
-										position1196, tokenIndex1196, depth1196 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1197
-										}
-										position++
-										goto l1196
-									l1197:
-										position, tokenIndex, depth = position1196, tokenIndex1196, depth1196
-										if buffer[position] != rune('L') {
-											goto l842
-										}
-										position++
-									}
-								l1196:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleCEIL, position1189)
+								position++
+								goto l1426
+							l1427:
+								position, tokenIndex, depth = position1426, tokenIndex1426, depth1426
+								if buffer[position] != rune('F') {
+									goto l1414
 								}
-								break
-							default:
-								{
-									position1198 := position
-									depth++
-									{
-										position1199, tokenIndex1199, depth1199 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1200
-										}
-										position++
-										goto l1199
-									l1200:
-										position, tokenIndex, depth = position1199, tokenIndex1199, depth1199
-										if buffer[position] != rune('A') {
-											goto l842
-										}
-										position++
-									}
-								l1199:
-									{
-										position1201, tokenIndex1201, depth1201 := position, tokenIndex, depth
-										if buffer[position] != rune('b') {
-											goto l1202
-										}
-										position++
-										goto l1201
-									l1202:
-										position, tokenIndex, depth = position1201, tokenIndex1201, depth1201
-										if buffer[position] != rune('B') {
-											goto l842
-										}
-										position++
-									}
-								l1201:
-									{
-										position1203, tokenIndex1203, depth1203 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1204
-										}
-										position++
-										goto l1203
-									l1204:
-										position, tokenIndex, depth = position1203, tokenIndex1203, depth1203
-										if buffer[position] != rune('S') {
-											goto l842
-										}
-										position++
-									}
-								l1203:
-									if !rules[ruleskip]() {
-										goto l842
-									}
-									depth--
-									add(ruleABS, position1198)
+								position++
+							}
+						l1426:
+							{
+								position1428, tokenIndex1428, depth1428 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1429
+								}
+								position++
+								goto l1428
+							l1429:
+								position, tokenIndex, depth = position1428, tokenIndex1428, depth1428
+								if buffer[position] != rune('O') {
+									goto l1414
+								}
+								position++
+							}
+						l1428:
+							{
+								position1430, tokenIndex1430, depth1430 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1431
 								}
-								break
+								position++
+								goto l1430
+							l1431:
+								position, tokenIndex, depth = position1430, tokenIndex1430, depth1430
+								if buffer[position] != rune('R') {
+									goto l1414
+								}
+								position++
+							}
+						l1430:
+							{
+								position1432, tokenIndex1432, depth1432 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1433
+								}
+								position++
+								goto l1432
+							l1433:
+								position, tokenIndex, depth = position1432, tokenIndex1432, depth1432
+								if buffer[position] != rune('E') {
+									goto l1414
+								}
+								position++
 							}
+						l1432:
+							if !_rules[ruleskip]() {
+								goto l1414
+							}
+							depth--
+							add(ruleSTRBEFORE, position1415)
 						}
-
-					}
-				l843:
-					if !rules[ruleLPAREN]() {
-						goto l842
-					}
-					if !rules[ruleexpression]() {
-						goto l842
-					}
-					if !rules[ruleRPAREN]() {
-						goto l842
-					}
-					goto l841
-				l842:
-					position, tokenIndex, depth = position841, tokenIndex841, depth841
-					{
-						position1206, tokenIndex1206, depth1206 := position, tokenIndex, depth
+						goto l1335
+					l1414:
+						position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
 						{
-							position1208 := position
+							position1435 := position
 							depth++
 							{
-								position1209, tokenIndex1209, depth1209 := position, tokenIndex, depth
+								position1436, tokenIndex1436, depth1436 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1210
+									goto l1437
 								}
 								position++
-								goto l1209
-							l1210:
-								position, tokenIndex, depth = position1209, tokenIndex1209, depth1209
+								goto l1436
+							l1437:
+								position, tokenIndex, depth = position1436, tokenIndex1436, depth1436
 								if buffer[position] != rune('S') {
-									goto l1207
+									goto l1434
 								}
 								position++
 							}
-						l1209:
+						l1436:
 							{
-								position1211, tokenIndex1211, depth1211 := position, tokenIndex, depth
+								position1438, tokenIndex1438, depth1438 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1212
+									goto l1439
 								}
 								position++
-								goto l1211
-							l1212:
-								position, tokenIndex, depth = position1211, tokenIndex1211, depth1211
+								goto l1438
+							l1439:
+								position, tokenIndex, depth = position1438, tokenIndex1438, depth1438
 								if buffer[position] != rune('T') {
-									goto l1207
+									goto l1434
 								}
 								position++
 							}
-						l1211:
+						l1438:
 							{
-								position1213, tokenIndex1213, depth1213 := position, tokenIndex, depth
+								position1440, tokenIndex1440, depth1440 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1214
+									goto l1441
 								}
 								position++
-								goto l1213
-							l1214:
-								position, tokenIndex, depth = position1213, tokenIndex1213, depth1213
+								goto l1440
+							l1441:
+								position, tokenIndex, depth = position1440, tokenIndex1440, depth1440
 								if buffer[position] != rune('R') {
-									goto l1207
+									goto l1434
 								}
 								position++
 							}
-						l1213:
+						l1440:
 							{
-								position1215, tokenIndex1215, depth1215 := position, tokenIndex, depth
+								position1442, tokenIndex1442, depth1442 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1443
+								}
+								position++
+								goto l1442
+							l1443:
+								position, tokenIndex, depth = position1442, tokenIndex1442, depth1442
+								if buffer[position] != rune('A') {
+									goto l1434
+								}
+								position++
+							}
+						l1442:
+							{
+								position1444, tokenIndex1444, depth1444 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1445
+								}
+								position++
+								goto l1444
+							l1445:
+								position, tokenIndex, depth = position1444, tokenIndex1444, depth1444
+								if buffer[position] != rune('F') {
+									goto l1434
+								}
+								position++
+							}
+						l1444:
+							{
+								position1446, tokenIndex1446, depth1446 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1447
+								}
+								position++
+								goto l1446
+							l1447:
+								position, tokenIndex, depth = position1446, tokenIndex1446, depth1446
+								if buffer[position] != rune('T') {
+									goto l1434
+								}
+								position++
+							}
+						l1446:
+							{
+								position1448, tokenIndex1448, depth1448 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1449
+								}
+								position++
+								goto l1448
+							l1449:
+								position, tokenIndex, depth = position1448, tokenIndex1448, depth1448
+								if buffer[position] != rune('E') {
+									goto l1434
+								}
+								position++
+							}
+						l1448:
+							{
+								position1450, tokenIndex1450, depth1450 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1451
+								}
+								position++
+								goto l1450
+							l1451:
+								position, tokenIndex, depth = position1450, tokenIndex1450, depth1450
+								if buffer[position] != rune('R') {
+									goto l1434
+								}
+								position++
+							}
+						l1450:
+							if !_rules[ruleskip]() {
+								goto l1434
+							}
+							depth--
+							add(ruleSTRAFTER, position1435)
+						}
+						goto l1335
+					l1434:
+						position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
+						{
+							position1453 := position
+							depth++
+							{
+								position1454, tokenIndex1454, depth1454 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1216
+									goto l1455
 								}
 								position++
-								goto l1215
-							l1216:
-								position, tokenIndex, depth = position1215, tokenIndex1215, depth1215
+								goto l1454
+							l1455:
+								position, tokenIndex, depth = position1454, tokenIndex1454, depth1454
 								if buffer[position] != rune('S') {
-									goto l1207
+									goto l1452
 								}
 								position++
 							}
-						l1215:
+						l1454:
 							{
-								position1217, tokenIndex1217, depth1217 := position, tokenIndex, depth
+								position1456, tokenIndex1456, depth1456 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1218
+									goto l1457
 								}
 								position++
-								goto l1217
-							l1218:
-								position, tokenIndex, depth = position1217, tokenIndex1217, depth1217
+								goto l1456
+							l1457:
+								position, tokenIndex, depth = position1456, tokenIndex1456, depth1456
 								if buffer[position] != rune('T') {
-									goto l1207
+									goto l1452
 								}
 								position++
 							}
-						l1217:
+						l1456:
 							{
-								position1219, tokenIndex1219, depth1219 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l1220
+								position1458, tokenIndex1458, depth1458 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1459
 								}
 								position++
-								goto l1219
-							l1220:
-								position, tokenIndex, depth = position1219, tokenIndex1219, depth1219
-								if buffer[position] != rune('A') {
-									goto l1207
+								goto l1458
+							l1459:
+								position, tokenIndex, depth = position1458, tokenIndex1458, depth1458
+								if buffer[position] != rune('R') {
+									goto l1452
 								}
 								position++
 							}
-						l1219:
+						l1458:
 							{
-								position1221, tokenIndex1221, depth1221 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1222
+								position1460, tokenIndex1460, depth1460 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1461
 								}
 								position++
-								goto l1221
-							l1222:
-								position, tokenIndex, depth = position1221, tokenIndex1221, depth1221
-								if buffer[position] != rune('R') {
-									goto l1207
+								goto l1460
+							l1461:
+								position, tokenIndex, depth = position1460, tokenIndex1460, depth1460
+								if buffer[position] != rune('L') {
+									goto l1452
 								}
 								position++
 							}
-						l1221:
+						l1460:
 							{
-								position1223, tokenIndex1223, depth1223 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1224
+								position1462, tokenIndex1462, depth1462 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1463
 								}
 								position++
-								goto l1223
-							l1224:
-								position, tokenIndex, depth = position1223, tokenIndex1223, depth1223
-								if buffer[position] != rune('T') {
-									goto l1207
+								goto l1462
+							l1463:
+								position, tokenIndex, depth = position1462, tokenIndex1462, depth1462
+								if buffer[position] != rune('A') {
+									goto l1452
 								}
 								position++
 							}
-						l1223:
+						l1462:
 							{
-								position1225, tokenIndex1225, depth1225 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1226
+								position1464, tokenIndex1464, depth1464 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1465
 								}
 								position++
-								goto l1225
-							l1226:
-								position, tokenIndex, depth = position1225, tokenIndex1225, depth1225
-								if buffer[position] != rune('S') {
-									goto l1207
+								goto l1464
+							l1465:
+								position, tokenIndex, depth = position1464, tokenIndex1464, depth1464
+								if buffer[position] != rune('N') {
+									goto l1452
 								}
 								position++
 							}
-						l1225:
-							if !rules[ruleskip]() {
-								goto l1207
+						l1464:
+							{
+								position1466, tokenIndex1466, depth1466 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l1467
+								}
+								position++
+								goto l1466
+							l1467:
+								position, tokenIndex, depth = position1466, tokenIndex1466, depth1466
+								if buffer[position] != rune('G') {
+									goto l1452
+								}
+								position++
+							}
+						l1466:
+							if !_rules[ruleskip]() {
+								goto l1452
 							}
 							depth--
-							add(ruleSTRSTARTS, position1208)
+							add(ruleSTRLANG, position1453)
 						}
-						goto l1206
-					l1207:
-						position, tokenIndex, depth = position1206, tokenIndex1206, depth1206
+						goto l1335
+					l1452:
+						position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
 						{
-							position1228 := position
+							position1469 := position
 							depth++
 							{
-								position1229, tokenIndex1229, depth1229 := position, tokenIndex, depth
+								position1470, tokenIndex1470, depth1470 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1230
+									goto l1471
 								}
 								position++
-								goto l1229
-							l1230:
-								position, tokenIndex, depth = position1229, tokenIndex1229, depth1229
+								goto l1470
+							l1471:
+								position, tokenIndex, depth = position1470, tokenIndex1470, depth1470
 								if buffer[position] != rune('S') {
-									goto l1227
+									goto l1468
 								}
 								position++
 							}
-						l1229:
+						l1470:
 							{
-								position1231, tokenIndex1231, depth1231 := position, tokenIndex, depth
+								position1472, tokenIndex1472, depth1472 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1232
+									goto l1473
 								}
 								position++
-								goto l1231
-							l1232:
-								position, tokenIndex, depth = position1231, tokenIndex1231, depth1231
+								goto l1472
+							l1473:
+								position, tokenIndex, depth = position1472, tokenIndex1472, depth1472
 								if buffer[position] != rune('T') {
-									goto l1227
+									goto l1468
 								}
 								position++
 							}
-						l1231:
+						l1472:
 							{
-								position1233, tokenIndex1233, depth1233 := position, tokenIndex, depth
+								position1474, tokenIndex1474, depth1474 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1234
+									goto l1475
 								}
 								position++
-								goto l1233
-							l1234:
-								position, tokenIndex, depth = position1233, tokenIndex1233, depth1233
+								goto l1474
+							l1475:
+								position, tokenIndex, depth = position1474, tokenIndex1474, depth1474
 								if buffer[position] != rune('R') {
-									goto l1227
+									goto l1468
 								}
 								position++
 							}
-						l1233:
+						l1474:
 							{
-								position1235, tokenIndex1235, depth1235 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l1236
+								position1476, tokenIndex1476, depth1476 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1477
 								}
 								position++
-								goto l1235
-							l1236:
-								position, tokenIndex, depth = position1235, tokenIndex1235, depth1235
-								if buffer[position] != rune('E') {
-									goto l1227
+								goto l1476
+							l1477:
+								position, tokenIndex, depth = position1476, tokenIndex1476, depth1476
+								if buffer[position] != rune('D') {
+									goto l1468
 								}
 								position++
 							}
-						l1235:
+						l1476:
 							{
-								position1237, tokenIndex1237, depth1237 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l1238
+								position1478, tokenIndex1478, depth1478 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1479
 								}
 								position++
-								goto l1237
-							l1238:
-								position, tokenIndex, depth = position1237, tokenIndex1237, depth1237
-								if buffer[position] != rune('N') {
-									goto l1227
+								goto l1478
+							l1479:
+								position, tokenIndex, depth = position1478, tokenIndex1478, depth1478
+								if buffer[position] != rune('T') {
+									goto l1468
 								}
 								position++
 							}
-						l1237:
+						l1478:
+							if !_rules[ruleskip]() {
+								goto l1468
+							}
+							depth--
+							add(ruleSTRDT, position1469)
+						}
+						goto l1335
+					l1468:
+						position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
+						{
+							position1480 := position
+							depth++
 							{
-								position1239, tokenIndex1239, depth1239 := position, tokenIndex, depth
-								if buffer[position] != rune('d') {
-									goto l1240
+								position1481, tokenIndex1481, depth1481 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1482
 								}
 								position++
-								goto l1239
-							l1240:
-								position, tokenIndex, depth = position1239, tokenIndex1239, depth1239
-								if buffer[position] != rune('D') {
-									goto l1227
+								goto l1481
+							l1482:
+								position, tokenIndex, depth = position1481, tokenIndex1481, depth1481
+								if buffer[position] != rune('S') {
+									goto l1334
 								}
 								position++
 							}
-						l1239:
+						l1481:
 							{
-								position1241, tokenIndex1241, depth1241 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1242
+								position1483, tokenIndex1483, depth1483 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1484
 								}
 								position++
-								goto l1241
-							l1242:
-								position, tokenIndex, depth = position1241, tokenIndex1241, depth1241
-								if buffer[position] != rune('S') {
-									goto l1227
+								goto l1483
+							l1484:
+								position, tokenIndex, depth = position1483, tokenIndex1483, depth1483
+								if buffer[position] != rune('A') {
+									goto l1334
 								}
 								position++
 							}
-						l1241:
-							if !rules[ruleskip]() {
-								goto l1227
+						l1483:
+							{
+								position1485, tokenIndex1485, depth1485 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1486
+								}
+								position++
+								goto l1485
+							l1486:
+								position, tokenIndex, depth = position1485, tokenIndex1485, depth1485
+								if buffer[position] != rune('M') {
+									goto l1334
+								}
+								position++
 							}
-							depth--
-							add(ruleSTRENDS, position1228)
-						}
-						goto l1206
-					l1227:
-						position, tokenIndex, depth = position1206, tokenIndex1206, depth1206
-						{
-							position1244 := position
-							depth++
+						l1485:
 							{
-								position1245, tokenIndex1245, depth1245 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1246
+								position1487, tokenIndex1487, depth1487 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1488
 								}
 								position++
-								goto l1245
-							l1246:
-								position, tokenIndex, depth = position1245, tokenIndex1245, depth1245
-								if buffer[position] != rune('S') {
-									goto l1243
+								goto l1487
+							l1488:
+								position, tokenIndex, depth = position1487, tokenIndex1487, depth1487
+								if buffer[position] != rune('E') {
+									goto l1334
 								}
 								position++
 							}
-						l1245:
+						l1487:
 							{
-								position1247, tokenIndex1247, depth1247 := position, tokenIndex, depth
+								position1489, tokenIndex1489, depth1489 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1248
+									goto l1490
 								}
 								position++
-								goto l1247
-							l1248:
-								position, tokenIndex, depth = position1247, tokenIndex1247, depth1247
+								goto l1489
+							l1490:
+								position, tokenIndex, depth = position1489, tokenIndex1489, depth1489
 								if buffer[position] != rune('T') {
-									goto l1243
+									goto l1334
 								}
 								position++
 							}
-						l1247:
+						l1489:
 							{
-								position1249, tokenIndex1249, depth1249 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1250
+								position1491, tokenIndex1491, depth1491 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1492
 								}
 								position++
-								goto l1249
-							l1250:
-								position, tokenIndex, depth = position1249, tokenIndex1249, depth1249
-								if buffer[position] != rune('R') {
-									goto l1243
+								goto l1491
+							l1492:
+								position, tokenIndex, depth = position1491, tokenIndex1491, depth1491
+								if buffer[position] != rune('E') {
+									goto l1334
 								}
 								position++
 							}
-						l1249:
+						l1491:
 							{
-								position1251, tokenIndex1251, depth1251 := position, tokenIndex, depth
-								if buffer[position] != rune('b') {
-									goto l1252
+								position1493, tokenIndex1493, depth1493 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1494
 								}
 								position++
-								goto l1251
-							l1252:
-								position, tokenIndex, depth = position1251, tokenIndex1251, depth1251
-								if buffer[position] != rune('B') {
-									goto l1243
+								goto l1493
+							l1494:
+								position, tokenIndex, depth = position1493, tokenIndex1493, depth1493
+								if buffer[position] != rune('R') {
+									goto l1334
 								}
 								position++
 							}
-						l1251:
+						l1493:
 							{
-								position1253, tokenIndex1253, depth1253 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l1254
+								position1495, tokenIndex1495, depth1495 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1496
 								}
 								position++
-								goto l1253
-							l1254:
-								position, tokenIndex, depth = position1253, tokenIndex1253, depth1253
-								if buffer[position] != rune('E') {
-									goto l1243
+								goto l1495
+							l1496:
+								position, tokenIndex, depth = position1495, tokenIndex1495, depth1495
+								if buffer[position] != rune('M') {
+									goto l1334
 								}
 								position++
 							}
-						l1253:
+						l1495:
+							if !_rules[ruleskip]() {
+								goto l1334
+							}
+							depth--
+							add(ruleSAMETERM, position1480)
+						}
+					}
+				l1335:
+					if !_rules[ruleLPAREN]() {
+						goto l1334
+					}
+					if !_rules[ruleexpression]() {
+						goto l1334
+					}
+					if !_rules[ruleCOMMA]() {
+						goto l1334
+					}
+					if !_rules[ruleexpression]() {
+						goto l1334
+					}
+					if !_rules[ruleRPAREN]() {
+						goto l1334
+					}
+					goto l958
+				l1334:
+					position, tokenIndex, depth = position958, tokenIndex958, depth958
+					{
+						position1498 := position
+						depth++
+						{
+							position1499, tokenIndex1499, depth1499 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1500
+							}
+							position++
+							goto l1499
+						l1500:
+							position, tokenIndex, depth = position1499, tokenIndex1499, depth1499
+							if buffer[position] != rune('B') {
+								goto l1497
+							}
+							position++
+						}
+					l1499:
+						{
+							position1501, tokenIndex1501, depth1501 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1502
+							}
+							position++
+							goto l1501
+						l1502:
+							position, tokenIndex, depth = position1501, tokenIndex1501, depth1501
+							if buffer[position] != rune('O') {
+								goto l1497
+							}
+							position++
+						}
+					l1501:
+						{
+							position1503, tokenIndex1503, depth1503 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1504
+							}
+							position++
+							goto l1503
+						l1504:
+							position, tokenIndex, depth = position1503, tokenIndex1503, depth1503
+							if buffer[position] != rune('U') {
+								goto l1497
+							}
+							position++
+						}
+					l1503:
+						{
+							position1505, tokenIndex1505, depth1505 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1506
+							}
+							position++
+							goto l1505
+						l1506:
+							position, tokenIndex, depth = position1505, tokenIndex1505, depth1505
+							if buffer[position] != rune('N') {
+								goto l1497
+							}
+							position++
+						}
+					l1505:
+						{
+							position1507, tokenIndex1507, depth1507 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1508
+							}
+							position++
+							goto l1507
+						l1508:
+							position, tokenIndex, depth = position1507, tokenIndex1507, depth1507
+							if buffer[position] != rune('D') {
+								goto l1497
+							}
+							position++
+						}
+					l1507:
+						if !_rules[ruleskip]() {
+							goto l1497
+						}
+						depth--
+						add(ruleBOUND, position1498)
+					}
+					if !_rules[ruleLPAREN]() {
+						goto l1497
+					}
+					if !_rules[rulevar]() {
+						goto l1497
+					}
+					if !_rules[ruleRPAREN]() {
+						goto l1497
+					}
+					goto l958
+				l1497:
+					position, tokenIndex, depth = position958, tokenIndex958, depth958
+					{
+						position1510 := position
+						depth++
+						{
+							position1511, tokenIndex1511, depth1511 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1512
+							}
+							position++
+							goto l1511
+						l1512:
+							position, tokenIndex, depth = position1511, tokenIndex1511, depth1511
+							if buffer[position] != rune('B') {
+								goto l1509
+							}
+							position++
+						}
+					l1511:
+						{
+							position1513, tokenIndex1513, depth1513 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1514
+							}
+							position++
+							goto l1513
+						l1514:
+							position, tokenIndex, depth = position1513, tokenIndex1513, depth1513
+							if buffer[position] != rune('N') {
+								goto l1509
+							}
+							position++
+						}
+					l1513:
+						{
+							position1515, tokenIndex1515, depth1515 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1516
+							}
+							position++
+							goto l1515
+						l1516:
+							position, tokenIndex, depth = position1515, tokenIndex1515, depth1515
+							if buffer[position] != rune('O') {
+								goto l1509
+							}
+							position++
+						}
+					l1515:
+						{
+							position1517, tokenIndex1517, depth1517 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1518
+							}
+							position++
+							goto l1517
+						l1518:
+							position, tokenIndex, depth = position1517, tokenIndex1517, depth1517
+							if buffer[position] != rune('D') {
+								goto l1509
+							}
+							position++
+						}
+					l1517:
+						{
+							position1519, tokenIndex1519, depth1519 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1520
+							}
+							position++
+							goto l1519
+						l1520:
+							position, tokenIndex, depth = position1519, tokenIndex1519, depth1519
+							if buffer[position] != rune('E') {
+								goto l1509
+							}
+							position++
+						}
+					l1519:
+						if !_rules[ruleskip]() {
+							goto l1509
+						}
+						depth--
+						add(ruleBNODE, position1510)
+					}
+					{
+						position1521, tokenIndex1521, depth1521 := position, tokenIndex, depth
+						if !_rules[ruleLPAREN]() {
+							goto l1522
+						}
+						if !_rules[ruleexpression]() {
+							goto l1522
+						}
+						if !_rules[ruleRPAREN]() {
+							goto l1522
+						}
+						goto l1521
+					l1522:
+						position, tokenIndex, depth = position1521, tokenIndex1521, depth1521
+						if !_rules[rulenil]() {
+							goto l1509
+						}
+					}
+				l1521:
+					goto l958
+				l1509:
+					position, tokenIndex, depth = position958, tokenIndex958, depth958
+					{
+						position1524, tokenIndex1524, depth1524 := position, tokenIndex, depth
+						{
+							position1526 := position
+							depth++
 							{
-								position1255, tokenIndex1255, depth1255 := position, tokenIndex, depth
-								if buffer[position] != rune('f') {
-									goto l1256
+								position1527, tokenIndex1527, depth1527 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1528
 								}
 								position++
-								goto l1255
-							l1256:
-								position, tokenIndex, depth = position1255, tokenIndex1255, depth1255
-								if buffer[position] != rune('F') {
-									goto l1243
+								goto l1527
+							l1528:
+								position, tokenIndex, depth = position1527, tokenIndex1527, depth1527
+								if buffer[position] != rune('R') {
+									goto l1525
 								}
 								position++
 							}
-						l1255:
+						l1527:
 							{
-								position1257, tokenIndex1257, depth1257 := position, tokenIndex, depth
-								if buffer[position] != rune('o') {
-									goto l1258
+								position1529, tokenIndex1529, depth1529 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1530
 								}
 								position++
-								goto l1257
-							l1258:
-								position, tokenIndex, depth = position1257, tokenIndex1257, depth1257
-								if buffer[position] != rune('O') {
-									goto l1243
+								goto l1529
+							l1530:
+								position, tokenIndex, depth = position1529, tokenIndex1529, depth1529
+								if buffer[position] != rune('A') {
+									goto l1525
 								}
 								position++
 							}
-						l1257:
+						l1529:
 							{
-								position1259, tokenIndex1259, depth1259 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1260
+								position1531, tokenIndex1531, depth1531 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1532
 								}
 								position++
-								goto l1259
-							l1260:
-								position, tokenIndex, depth = position1259, tokenIndex1259, depth1259
-								if buffer[position] != rune('R') {
-									goto l1243
+								goto l1531
+							l1532:
+								position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
+								if buffer[position] != rune('N') {
+									goto l1525
 								}
 								position++
 							}
-						l1259:
+						l1531:
 							{
-								position1261, tokenIndex1261, depth1261 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l1262
+								position1533, tokenIndex1533, depth1533 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1534
 								}
 								position++
-								goto l1261
-							l1262:
-								position, tokenIndex, depth = position1261, tokenIndex1261, depth1261
-								if buffer[position] != rune('E') {
-									goto l1243
+								goto l1533
+							l1534:
+								position, tokenIndex, depth = position1533, tokenIndex1533, depth1533
+								if buffer[position] != rune('D') {
+									goto l1525
 								}
 								position++
 							}
-						l1261:
-							if !rules[ruleskip]() {
-								goto l1243
+						l1533:
+							if !_rules[ruleskip]() {
+								goto l1525
 							}
 							depth--
-							add(ruleSTRBEFORE, position1244)
+							add(ruleRAND, position1526)
 						}
-						goto l1206
-					l1243:
-						position, tokenIndex, depth = position1206, tokenIndex1206, depth1206
+						goto l1524
+					l1525:
+						position, tokenIndex, depth = position1524, tokenIndex1524, depth1524
 						{
-							position1264 := position
+							position1536 := position
 							depth++
 							{
-								position1265, tokenIndex1265, depth1265 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1266
+								position1537, tokenIndex1537, depth1537 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1538
 								}
 								position++
-								goto l1265
-							l1266:
-								position, tokenIndex, depth = position1265, tokenIndex1265, depth1265
-								if buffer[position] != rune('S') {
-									goto l1263
+								goto l1537
+							l1538:
+								position, tokenIndex, depth = position1537, tokenIndex1537, depth1537
+								if buffer[position] != rune('N') {
+									goto l1535
 								}
 								position++
 							}
-						l1265:
+						l1537:
 							{
-								position1267, tokenIndex1267, depth1267 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1268
+								position1539, tokenIndex1539, depth1539 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1540
 								}
 								position++
-								goto l1267
-							l1268:
-								position, tokenIndex, depth = position1267, tokenIndex1267, depth1267
-								if buffer[position] != rune('T') {
-									goto l1263
+								goto l1539
+							l1540:
+								position, tokenIndex, depth = position1539, tokenIndex1539, depth1539
+								if buffer[position] != rune('O') {
+									goto l1535
 								}
 								position++
 							}
-						l1267:
+						l1539:
 							{
-								position1269, tokenIndex1269, depth1269 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1270
+								position1541, tokenIndex1541, depth1541 := position, tokenIndex, depth
+								if buffer[position] != rune('w') {
+									goto l1542
 								}
 								position++
-								goto l1269
-							l1270:
-								position, tokenIndex, depth = position1269, tokenIndex1269, depth1269
-								if buffer[position] != rune('R') {
-									goto l1263
+								goto l1541
+							l1542:
+								position, tokenIndex, depth = position1541, tokenIndex1541, depth1541
+								if buffer[position] != rune('W') {
+									goto l1535
 								}
 								position++
 							}
-						l1269:
-							{
-								position1271, tokenIndex1271, depth1271 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l1272
-								}
-								position++
-								goto l1271
-							l1272:
-								position, tokenIndex, depth = position1271, tokenIndex1271, depth1271
-								if buffer[position] != rune('A') {
-									goto l1263
-								}
-								position++
+						l1541:
+							if !_rules[ruleskip]() {
+								goto l1535
 							}
-						l1271:
+							depth--
+							add(ruleNOW, position1536)
+						}
+						goto l1524
+					l1535:
+						position, tokenIndex, depth = position1524, tokenIndex1524, depth1524
+						{
+							position1544 := position
+							depth++
 							{
-								position1273, tokenIndex1273, depth1273 := position, tokenIndex, depth
-								if buffer[position] != rune('f') {
-									goto l1274
+								position1545, tokenIndex1545, depth1545 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1546
 								}
 								position++
-								goto l1273
-							l1274:
-								position, tokenIndex, depth = position1273, tokenIndex1273, depth1273
-								if buffer[position] != rune('F') {
-									goto l1263
+								goto l1545
+							l1546:
+								position, tokenIndex, depth = position1545, tokenIndex1545, depth1545
+								if buffer[position] != rune('U') {
+									goto l1543
 								}
 								position++
 							}
-						l1273:
+						l1545:
 							{
-								position1275, tokenIndex1275, depth1275 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1276
+								position1547, tokenIndex1547, depth1547 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1548
 								}
 								position++
-								goto l1275
-							l1276:
-								position, tokenIndex, depth = position1275, tokenIndex1275, depth1275
-								if buffer[position] != rune('T') {
-									goto l1263
+								goto l1547
+							l1548:
+								position, tokenIndex, depth = position1547, tokenIndex1547, depth1547
+								if buffer[position] != rune('U') {
+									goto l1543
 								}
 								position++
 							}
-						l1275:
+						l1547:
 							{
-								position1277, tokenIndex1277, depth1277 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l1278
+								position1549, tokenIndex1549, depth1549 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1550
 								}
 								position++
-								goto l1277
-							l1278:
-								position, tokenIndex, depth = position1277, tokenIndex1277, depth1277
-								if buffer[position] != rune('E') {
-									goto l1263
+								goto l1549
+							l1550:
+								position, tokenIndex, depth = position1549, tokenIndex1549, depth1549
+								if buffer[position] != rune('I') {
+									goto l1543
 								}
 								position++
 							}
-						l1277:
+						l1549:
 							{
-								position1279, tokenIndex1279, depth1279 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1280
+								position1551, tokenIndex1551, depth1551 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1552
 								}
 								position++
-								goto l1279
-							l1280:
-								position, tokenIndex, depth = position1279, tokenIndex1279, depth1279
-								if buffer[position] != rune('R') {
-									goto l1263
+								goto l1551
+							l1552:
+								position, tokenIndex, depth = position1551, tokenIndex1551, depth1551
+								if buffer[position] != rune('D') {
+									goto l1543
 								}
 								position++
 							}
-						l1279:
-							if !rules[ruleskip]() {
-								goto l1263
+						l1551:
+							if !_rules[ruleskip]() {
+								goto l1543
 							}
 							depth--
-							add(ruleSTRAFTER, position1264)
+							add(ruleUUID, position1544)
 						}
-						goto l1206
-					l1263:
-						position, tokenIndex, depth = position1206, tokenIndex1206, depth1206
+						goto l1524
+					l1543:
+						position, tokenIndex, depth = position1524, tokenIndex1524, depth1524
 						{
-							position1282 := position
+							position1553 := position
 							depth++
 							{
-								position1283, tokenIndex1283, depth1283 := position, tokenIndex, depth
+								position1554, tokenIndex1554, depth1554 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1284
+									goto l1555
 								}
 								position++
-								goto l1283
-							l1284:
-								position, tokenIndex, depth = position1283, tokenIndex1283, depth1283
+								goto l1554
+							l1555:
+								position, tokenIndex, depth = position1554, tokenIndex1554, depth1554
 								if buffer[position] != rune('S') {
-									goto l1281
+									goto l1523
 								}
 								position++
 							}
-						l1283:
+						l1554:
 							{
-								position1285, tokenIndex1285, depth1285 := position, tokenIndex, depth
+								position1556, tokenIndex1556, depth1556 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1286
+									goto l1557
 								}
 								position++
-								goto l1285
-							l1286:
-								position, tokenIndex, depth = position1285, tokenIndex1285, depth1285
+								goto l1556
+							l1557:
+								position, tokenIndex, depth = position1556, tokenIndex1556, depth1556
 								if buffer[position] != rune('T') {
-									goto l1281
+									goto l1523
 								}
 								position++
 							}
-						l1285:
+						l1556:
 							{
-								position1287, tokenIndex1287, depth1287 := position, tokenIndex, depth
+								position1558, tokenIndex1558, depth1558 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1288
+									goto l1559
 								}
 								position++
-								goto l1287
-							l1288:
-								position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
+								goto l1558
+							l1559:
+								position, tokenIndex, depth = position1558, tokenIndex1558, depth1558
 								if buffer[position] != rune('R') {
-									goto l1281
-								}
-								position++
-							}
-						l1287:
-							{
-								position1289, tokenIndex1289, depth1289 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l1290
-								}
-								position++
-								goto l1289
-							l1290:
-								position, tokenIndex, depth = position1289, tokenIndex1289, depth1289
-								if buffer[position] != rune('L') {
-									goto l1281
-								}
-								position++
-							}
-						l1289:
-							{
-								position1291, tokenIndex1291, depth1291 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l1292
-								}
-								position++
-								goto l1291
-							l1292:
-								position, tokenIndex, depth = position1291, tokenIndex1291, depth1291
-								if buffer[position] != rune('A') {
-									goto l1281
-								}
-								position++
-							}
-						l1291:
-							{
-								position1293, tokenIndex1293, depth1293 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l1294
-								}
-								position++
-								goto l1293
-							l1294:
-								position, tokenIndex, depth = position1293, tokenIndex1293, depth1293
-								if buffer[position] != rune('N') {
-									goto l1281
-								}
-								position++
-							}
-						l1293:
-							{
-								position1295, tokenIndex1295, depth1295 := position, tokenIndex, depth
-								if buffer[position] != rune('g') {
-									goto l1296
-								}
-								position++
-								goto l1295
-							l1296:
-								position, tokenIndex, depth = position1295, tokenIndex1295, depth1295
-								if buffer[position] != rune('G') {
-									goto l1281
-								}
-								position++
-							}
-						l1295:
-							if !rules[ruleskip]() {
-								goto l1281
-							}
-							depth--
-							add(ruleSTRLANG, position1282)
-						}
-						goto l1206
-					l1281:
-						position, tokenIndex, depth = position1206, tokenIndex1206, depth1206
-						{
-							position1298 := position
-							depth++
-							{
-								position1299, tokenIndex1299, depth1299 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1300
-								}
-								position++
-								goto l1299
-							l1300:
-								position, tokenIndex, depth = position1299, tokenIndex1299, depth1299
-								if buffer[position] != rune('S') {
-									goto l1297
+									goto l1523
 								}
 								position++
 							}
-						l1299:
+						l1558:
 							{
-								position1301, tokenIndex1301, depth1301 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1302
+								position1560, tokenIndex1560, depth1560 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1561
 								}
 								position++
-								goto l1301
-							l1302:
-								position, tokenIndex, depth = position1301, tokenIndex1301, depth1301
-								if buffer[position] != rune('T') {
-									goto l1297
+								goto l1560
+							l1561:
+								position, tokenIndex, depth = position1560, tokenIndex1560, depth1560
+								if buffer[position] != rune('U') {
+									goto l1523
 								}
 								position++
 							}
-						l1301:
+						l1560:
 							{
-								position1303, tokenIndex1303, depth1303 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1304
+								position1562, tokenIndex1562, depth1562 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1563
 								}
 								position++
-								goto l1303
-							l1304:
-								position, tokenIndex, depth = position1303, tokenIndex1303, depth1303
-								if buffer[position] != rune('R') {
-									goto l1297
+								goto l1562
+							l1563:
+								position, tokenIndex, depth = position1562, tokenIndex1562, depth1562
+								if buffer[position] != rune('U') {
+									goto l1523
 								}
 								position++
 							}
-						l1303:
+						l1562:
 							{
-								position1305, tokenIndex1305, depth1305 := position, tokenIndex, depth
-								if buffer[position] != rune('d') {
-									goto l1306
+								position1564, tokenIndex1564, depth1564 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1565
 								}
 								position++
-								goto l1305
-							l1306:
-								position, tokenIndex, depth = position1305, tokenIndex1305, depth1305
-								if buffer[position] != rune('D') {
-									goto l1297
+								goto l1564
+							l1565:
+								position, tokenIndex, depth = position1564, tokenIndex1564, depth1564
+								if buffer[position] != rune('I') {
+									goto l1523
 								}
 								position++
 							}
-						l1305:
+						l1564:
 							{
-								position1307, tokenIndex1307, depth1307 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1308
+								position1566, tokenIndex1566, depth1566 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1567
 								}
 								position++
-								goto l1307
-							l1308:
-								position, tokenIndex, depth = position1307, tokenIndex1307, depth1307
-								if buffer[position] != rune('T') {
-									goto l1297
+								goto l1566
+							l1567:
+								position, tokenIndex, depth = position1566, tokenIndex1566, depth1566
+								if buffer[position] != rune('D') {
+									goto l1523
 								}
 								position++
 							}
-						l1307:
-							if !rules[ruleskip]() {
-								goto l1297
+						l1566:
+							if !_rules[ruleskip]() {
+								goto l1523
 							}
 							depth--
-							add(ruleSTRDT, position1298)
+							add(ruleSTRUUID, position1553)
 						}
-						goto l1206
-					l1297:
-						position, tokenIndex, depth = position1206, tokenIndex1206, depth1206
+					}
+				l1524:
+					if !_rules[rulenil]() {
+						goto l1523
+					}
+					goto l958
+				l1523:
+					position, tokenIndex, depth = position958, tokenIndex958, depth958
+					{
+						position1569, tokenIndex1569, depth1569 := position, tokenIndex, depth
 						{
-							switch buffer[position] {
-							case 'S', 's':
-								{
-									position1310 := position
-									depth++
-									{
-										position1311, tokenIndex1311, depth1311 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1312
-										}
-										position++
-										goto l1311
-									l1312:
-										position, tokenIndex, depth = position1311, tokenIndex1311, depth1311
-										if buffer[position] != rune('S') {
-											goto l1205
-										}
-										position++
-									}
-								l1311:
-									{
-										position1313, tokenIndex1313, depth1313 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1314
-										}
-										position++
-										goto l1313
-									l1314:
-										position, tokenIndex, depth = position1313, tokenIndex1313, depth1313
-										if buffer[position] != rune('A') {
-											goto l1205
-										}
-										position++
-									}
-								l1313:
-									{
-										position1315, tokenIndex1315, depth1315 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1316
-										}
-										position++
-										goto l1315
-									l1316:
-										position, tokenIndex, depth = position1315, tokenIndex1315, depth1315
-										if buffer[position] != rune('M') {
-											goto l1205
-										}
-										position++
-									}
-								l1315:
-									{
-										position1317, tokenIndex1317, depth1317 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1318
-										}
-										position++
-										goto l1317
-									l1318:
-										position, tokenIndex, depth = position1317, tokenIndex1317, depth1317
-										if buffer[position] != rune('E') {
-											goto l1205
-										}
-										position++
-									}
-								l1317:
-									{
-										position1319, tokenIndex1319, depth1319 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1320
-										}
-										position++
-										goto l1319
-									l1320:
-										position, tokenIndex, depth = position1319, tokenIndex1319, depth1319
-										if buffer[position] != rune('T') {
-											goto l1205
-										}
-										position++
-									}
-								l1319:
-									{
-										position1321, tokenIndex1321, depth1321 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1322
-										}
-										position++
-										goto l1321
-									l1322:
-										position, tokenIndex, depth = position1321, tokenIndex1321, depth1321
-										if buffer[position] != rune('E') {
-											goto l1205
-										}
-										position++
-									}
-								l1321:
-									{
-										position1323, tokenIndex1323, depth1323 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1324
-										}
-										position++
-										goto l1323
-									l1324:
-										position, tokenIndex, depth = position1323, tokenIndex1323, depth1323
-										if buffer[position] != rune('R') {
-											goto l1205
-										}
-										position++
-									}
-								l1323:
-									{
-										position1325, tokenIndex1325, depth1325 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1326
-										}
-										position++
-										goto l1325
-									l1326:
-										position, tokenIndex, depth = position1325, tokenIndex1325, depth1325
-										if buffer[position] != rune('M') {
-											goto l1205
-										}
-										position++
-									}
-								l1325:
-									if !rules[ruleskip]() {
-										goto l1205
-									}
-									depth--
-									add(ruleSAMETERM, position1310)
+							position1571 := position
+							depth++
+							{
+								position1572, tokenIndex1572, depth1572 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1573
 								}
-								break
-							case 'C', 'c':
-								{
-									position1327 := position
-									depth++
-									{
-										position1328, tokenIndex1328, depth1328 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1329
-										}
-										position++
-										goto l1328
-									l1329:
-										position, tokenIndex, depth = position1328, tokenIndex1328, depth1328
-										if buffer[position] != rune('C') {
-											goto l1205
-										}
-										position++
-									}
-								l1328:
-									{
-										position1330, tokenIndex1330, depth1330 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1331
-										}
-										position++
-										goto l1330
-									l1331:
-										position, tokenIndex, depth = position1330, tokenIndex1330, depth1330
-										if buffer[position] != rune('O') {
-											goto l1205
-										}
-										position++
-									}
-								l1330:
-									{
-										position1332, tokenIndex1332, depth1332 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1333
-										}
-										position++
-										goto l1332
-									l1333:
-										position, tokenIndex, depth = position1332, tokenIndex1332, depth1332
-										if buffer[position] != rune('N') {
-											goto l1205
-										}
-										position++
-									}
-								l1332:
-									{
-										position1334, tokenIndex1334, depth1334 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1335
-										}
-										position++
-										goto l1334
-									l1335:
-										position, tokenIndex, depth = position1334, tokenIndex1334, depth1334
-										if buffer[position] != rune('T') {
-											goto l1205
-										}
-										position++
-									}
-								l1334:
-									{
-										position1336, tokenIndex1336, depth1336 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1337
-										}
-										position++
-										goto l1336
-									l1337:
-										position, tokenIndex, depth = position1336, tokenIndex1336, depth1336
-										if buffer[position] != rune('A') {
-											goto l1205
-										}
-										position++
-									}
-								l1336:
-									{
-										position1338, tokenIndex1338, depth1338 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1339
-										}
-										position++
-										goto l1338
-									l1339:
-										position, tokenIndex, depth = position1338, tokenIndex1338, depth1338
-										if buffer[position] != rune('I') {
-											goto l1205
-										}
-										position++
-									}
-								l1338:
-									{
-										position1340, tokenIndex1340, depth1340 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1341
-										}
-										position++
-										goto l1340
-									l1341:
-										position, tokenIndex, depth = position1340, tokenIndex1340, depth1340
-										if buffer[position] != rune('N') {
-											goto l1205
-										}
-										position++
-									}
-								l1340:
-									{
-										position1342, tokenIndex1342, depth1342 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1343
-										}
-										position++
-										goto l1342
-									l1343:
-										position, tokenIndex, depth = position1342, tokenIndex1342, depth1342
-										if buffer[position] != rune('S') {
-											goto l1205
-										}
-										position++
-									}
-								l1342:
-									if !rules[ruleskip]() {
-										goto l1205
-									}
-									depth--
-									add(ruleCONTAINS, position1327)
+								position++
+								goto l1572
+							l1573:
+								position, tokenIndex, depth = position1572, tokenIndex1572, depth1572
+								if buffer[position] != rune('C') {
+									goto l1570
 								}
-								break
-							default:
-								{
-									position1344 := position
-									depth++
-									{
-										position1345, tokenIndex1345, depth1345 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1346
-										}
-										position++
-										goto l1345
-									l1346:
-										position, tokenIndex, depth = position1345, tokenIndex1345, depth1345
-										if buffer[position] != rune('L') {
-											goto l1205
-										}
-										position++
-									}
-								l1345:
-									{
-										position1347, tokenIndex1347, depth1347 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1348
-										}
-										position++
-										goto l1347
-									l1348:
-										position, tokenIndex, depth = position1347, tokenIndex1347, depth1347
-										if buffer[position] != rune('A') {
-											goto l1205
-										}
-										position++
-									}
-								l1347:
-									{
-										position1349, tokenIndex1349, depth1349 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1350
-										}
-										position++
-										goto l1349
-									l1350:
-										position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
-										if buffer[position] != rune('N') {
-											goto l1205
-										}
-										position++
-									}
-								l1349:
-									{
-										position1351, tokenIndex1351, depth1351 := position, tokenIndex, depth
-										if buffer[position] != rune('g') {
-											goto l1352
-										}
-										position++
-										goto l1351
-									l1352:
-										position, tokenIndex, depth = position1351, tokenIndex1351, depth1351
-										if buffer[position] != rune('G') {
-											goto l1205
-										}
-										position++
-									}
-								l1351:
-									{
-										position1353, tokenIndex1353, depth1353 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1354
-										}
-										position++
-										goto l1353
-									l1354:
-										position, tokenIndex, depth = position1353, tokenIndex1353, depth1353
-										if buffer[position] != rune('M') {
-											goto l1205
-										}
-										position++
-									}
-								l1353:
-									{
-										position1355, tokenIndex1355, depth1355 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1356
-										}
-										position++
-										goto l1355
-									l1356:
-										position, tokenIndex, depth = position1355, tokenIndex1355, depth1355
-										if buffer[position] != rune('A') {
-											goto l1205
-										}
-										position++
-									}
-								l1355:
-									{
-										position1357, tokenIndex1357, depth1357 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1358
-										}
-										position++
-										goto l1357
-									l1358:
-										position, tokenIndex, depth = position1357, tokenIndex1357, depth1357
-										if buffer[position] != rune('T') {
-											goto l1205
-										}
-										position++
-									}
-								l1357:
-									{
-										position1359, tokenIndex1359, depth1359 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1360
-										}
-										position++
-										goto l1359
-									l1360:
-										position, tokenIndex, depth = position1359, tokenIndex1359, depth1359
-										if buffer[position] != rune('C') {
-											goto l1205
-										}
-										position++
-									}
-								l1359:
-									{
-										position1361, tokenIndex1361, depth1361 := position, tokenIndex, depth
-										if buffer[position] != rune('h') {
-											goto l1362
-										}
-										position++
-										goto l1361
-									l1362:
-										position, tokenIndex, depth = position1361, tokenIndex1361, depth1361
-										if buffer[position] != rune('H') {
-											goto l1205
-										}
-										position++
-									}
-								l1361:
-									{
-										position1363, tokenIndex1363, depth1363 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1364
-										}
-										position++
-										goto l1363
-									l1364:
-										position, tokenIndex, depth = position1363, tokenIndex1363, depth1363
-										if buffer[position] != rune('E') {
-											goto l1205
-										}
-										position++
-									}
-								l1363:
-									{
-										position1365, tokenIndex1365, depth1365 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1366
-										}
-										position++
-										goto l1365
-									l1366:
-										position, tokenIndex, depth = position1365, tokenIndex1365, depth1365
-										if buffer[position] != rune('S') {
-											goto l1205
-										}
-										position++
-									}
-								l1365:
-									if !rules[ruleskip]() {
-										goto l1205
-									}
-									depth--
-									add(ruleLANGMATCHES, position1344)
+								position++
+							}
+						l1572:
+							{
+								position1574, tokenIndex1574, depth1574 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1575
+								}
+								position++
+								goto l1574
+							l1575:
+								position, tokenIndex, depth = position1574, tokenIndex1574, depth1574
+								if buffer[position] != rune('O') {
+									goto l1570
 								}
-								break
+								position++
 							}
-						}
-
-					}
-				l1206:
-					if !rules[ruleLPAREN]() {
-						goto l1205
-					}
-					if !rules[ruleexpression]() {
-						goto l1205
-					}
-					if !rules[ruleCOMMA]() {
-						goto l1205
-					}
-					if !rules[ruleexpression]() {
-						goto l1205
-					}
-					if !rules[ruleRPAREN]() {
-						goto l1205
-					}
-					goto l841
-				l1205:
-					position, tokenIndex, depth = position841, tokenIndex841, depth841
-					{
-						position1368 := position
-						depth++
-						{
-							position1369, tokenIndex1369, depth1369 := position, tokenIndex, depth
-							if buffer[position] != rune('b') {
-								goto l1370
+						l1574:
+							{
+								position1576, tokenIndex1576, depth1576 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1577
+								}
+								position++
+								goto l1576
+							l1577:
+								position, tokenIndex, depth = position1576, tokenIndex1576, depth1576
+								if buffer[position] != rune('N') {
+									goto l1570
+								}
+								position++
 							}
-							position++
-							goto l1369
-						l1370:
-							position, tokenIndex, depth = position1369, tokenIndex1369, depth1369
-							if buffer[position] != rune('B') {
-								goto l1367
+						l1576:
+							{
+								position1578, tokenIndex1578, depth1578 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1579
+								}
+								position++
+								goto l1578
+							l1579:
+								position, tokenIndex, depth = position1578, tokenIndex1578, depth1578
+								if buffer[position] != rune('C') {
+									goto l1570
+								}
+								position++
 							}
-							position++
-						}
-					l1369:
-						{
-							position1371, tokenIndex1371, depth1371 := position, tokenIndex, depth
-							if buffer[position] != rune('o') {
-								goto l1372
+						l1578:
+							{
+								position1580, tokenIndex1580, depth1580 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1581
+								}
+								position++
+								goto l1580
+							l1581:
+								position, tokenIndex, depth = position1580, tokenIndex1580, depth1580
+								if buffer[position] != rune('A') {
+									goto l1570
+								}
+								position++
 							}
-							position++
-							goto l1371
-						l1372:
-							position, tokenIndex, depth = position1371, tokenIndex1371, depth1371
-							if buffer[position] != rune('O') {
-								goto l1367
+						l1580:
+							{
+								position1582, tokenIndex1582, depth1582 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1583
+								}
+								position++
+								goto l1582
+							l1583:
+								position, tokenIndex, depth = position1582, tokenIndex1582, depth1582
+								if buffer[position] != rune('T') {
+									goto l1570
+								}
+								position++
 							}
-							position++
+						l1582:
+							if !_rules[ruleskip]() {
+								goto l1570
+							}
+							depth--
+							add(ruleCONCAT, position1571)
 						}
-					l1371:
+						goto l1569
+					l1570:
+						position, tokenIndex, depth = position1569, tokenIndex1569, depth1569
 						{
-							position1373, tokenIndex1373, depth1373 := position, tokenIndex, depth
-							if buffer[position] != rune('u') {
-								goto l1374
+							position1584 := position
+							depth++
+							{
+								position1585, tokenIndex1585, depth1585 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1586
+								}
+								position++
+								goto l1585
+							l1586:
+								position, tokenIndex, depth = position1585, tokenIndex1585, depth1585
+								if buffer[position] != rune('C') {
+									goto l1568
+								}
+								position++
 							}
-							position++
-							goto l1373
-						l1374:
-							position, tokenIndex, depth = position1373, tokenIndex1373, depth1373
-							if buffer[position] != rune('U') {
-								goto l1367
+						l1585:
+							{
+								position1587, tokenIndex1587, depth1587 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1588
+								}
+								position++
+								goto l1587
+							l1588:
+								position, tokenIndex, depth = position1587, tokenIndex1587, depth1587
+								if buffer[position] != rune('O') {
+									goto l1568
+								}
+								position++
 							}
-							position++
-						}
-					l1373:
-						{
-							position1375, tokenIndex1375, depth1375 := position, tokenIndex, depth
-							if buffer[position] != rune('n') {
-								goto l1376
+						l1587:
+							{
+								position1589, tokenIndex1589, depth1589 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1590
+								}
+								position++
+								goto l1589
+							l1590:
+								position, tokenIndex, depth = position1589, tokenIndex1589, depth1589
+								if buffer[position] != rune('A') {
+									goto l1568
+								}
+								position++
 							}
-							position++
-							goto l1375
-						l1376:
-							position, tokenIndex, depth = position1375, tokenIndex1375, depth1375
-							if buffer[position] != rune('N') {
-								goto l1367
+						l1589:
+							{
+								position1591, tokenIndex1591, depth1591 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1592
+								}
+								position++
+								goto l1591
+							l1592:
+								position, tokenIndex, depth = position1591, tokenIndex1591, depth1591
+								if buffer[position] != rune('L') {
+									goto l1568
+								}
+								position++
 							}
-							position++
-						}
-					l1375:
-						{
-							position1377, tokenIndex1377, depth1377 := position, tokenIndex, depth
-							if buffer[position] != rune('d') {
-								goto l1378
+						l1591:
+							{
+								position1593, tokenIndex1593, depth1593 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1594
+								}
+								position++
+								goto l1593
+							l1594:
+								position, tokenIndex, depth = position1593, tokenIndex1593, depth1593
+								if buffer[position] != rune('E') {
+									goto l1568
+								}
+								position++
 							}
-							position++
-							goto l1377
-						l1378:
-							position, tokenIndex, depth = position1377, tokenIndex1377, depth1377
-							if buffer[position] != rune('D') {
-								goto l1367
+						l1593:
+							{
+								position1595, tokenIndex1595, depth1595 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1596
+								}
+								position++
+								goto l1595
+							l1596:
+								position, tokenIndex, depth = position1595, tokenIndex1595, depth1595
+								if buffer[position] != rune('S') {
+									goto l1568
+								}
+								position++
 							}
-							position++
-						}
-					l1377:
-						if !rules[ruleskip]() {
-							goto l1367
+						l1595:
+							{
+								position1597, tokenIndex1597, depth1597 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1598
+								}
+								position++
+								goto l1597
+							l1598:
+								position, tokenIndex, depth = position1597, tokenIndex1597, depth1597
+								if buffer[position] != rune('C') {
+									goto l1568
+								}
+								position++
+							}
+						l1597:
+							{
+								position1599, tokenIndex1599, depth1599 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1600
+								}
+								position++
+								goto l1599
+							l1600:
+								position, tokenIndex, depth = position1599, tokenIndex1599, depth1599
+								if buffer[position] != rune('E') {
+									goto l1568
+								}
+								position++
+							}
+						l1599:
+							if !_rules[ruleskip]() {
+								goto l1568
+							}
+							depth--
+							add(ruleCOALESCE, position1584)
 						}
-						depth--
-						add(ruleBOUND, position1368)
-					}
-					if !rules[ruleLPAREN]() {
-						goto l1367
-					}
-					if !rules[rulevar]() {
-						goto l1367
 					}
-					if !rules[ruleRPAREN]() {
-						goto l1367
+				l1569:
+					if !_rules[ruleargList]() {
+						goto l1568
 					}
-					goto l841
-				l1367:
-					position, tokenIndex, depth = position841, tokenIndex841, depth841
+					goto l958
+				l1568:
+					position, tokenIndex, depth = position958, tokenIndex958, depth958
 					{
-						switch buffer[position] {
-						case 'S', 's':
+						position1602, tokenIndex1602, depth1602 := position, tokenIndex, depth
+						{
+							position1604 := position
+							depth++
 							{
-								position1381 := position
-								depth++
-								{
-									position1382, tokenIndex1382, depth1382 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l1383
-									}
-									position++
-									goto l1382
-								l1383:
-									position, tokenIndex, depth = position1382, tokenIndex1382, depth1382
-									if buffer[position] != rune('S') {
-										goto l1379
-									}
-									position++
+								position1605, tokenIndex1605, depth1605 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1606
 								}
-							l1382:
-								{
-									position1384, tokenIndex1384, depth1384 := position, tokenIndex, depth
-									if buffer[position] != rune('t') {
-										goto l1385
-									}
-									position++
-									goto l1384
-								l1385:
-									position, tokenIndex, depth = position1384, tokenIndex1384, depth1384
-									if buffer[position] != rune('T') {
-										goto l1379
-									}
-									position++
+								position++
+								goto l1605
+							l1606:
+								position, tokenIndex, depth = position1605, tokenIndex1605, depth1605
+								if buffer[position] != rune('S') {
+									goto l1603
 								}
-							l1384:
-								{
-									position1386, tokenIndex1386, depth1386 := position, tokenIndex, depth
-									if buffer[position] != rune('r') {
-										goto l1387
-									}
-									position++
-									goto l1386
-								l1387:
-									position, tokenIndex, depth = position1386, tokenIndex1386, depth1386
-									if buffer[position] != rune('R') {
-										goto l1379
-									}
-									position++
+								position++
+							}
+						l1605:
+							{
+								position1607, tokenIndex1607, depth1607 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1608
 								}
-							l1386:
-								{
-									position1388, tokenIndex1388, depth1388 := position, tokenIndex, depth
-									if buffer[position] != rune('u') {
-										goto l1389
-									}
-									position++
-									goto l1388
-								l1389:
-									position, tokenIndex, depth = position1388, tokenIndex1388, depth1388
-									if buffer[position] != rune('U') {
-										goto l1379
-									}
-									position++
+								position++
+								goto l1607
+							l1608:
+								position, tokenIndex, depth = position1607, tokenIndex1607, depth1607
+								if buffer[position] != rune('U') {
+									goto l1603
 								}
-							l1388:
-								{
-									position1390, tokenIndex1390, depth1390 := position, tokenIndex, depth
-									if buffer[position] != rune('u') {
-										goto l1391
-									}
-									position++
-									goto l1390
-								l1391:
-									position, tokenIndex, depth = position1390, tokenIndex1390, depth1390
-									if buffer[position] != rune('U') {
-										goto l1379
-									}
-									position++
+								position++
+							}
+						l1607:
+							{
+								position1609, tokenIndex1609, depth1609 := position, tokenIndex, depth
+								if buffer[position] != rune('b') {
+									goto l1610
 								}
-							l1390:
-								{
-									position1392, tokenIndex1392, depth1392 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l1393
-									}
-									position++
-									goto l1392
-								l1393:
-									position, tokenIndex, depth = position1392, tokenIndex1392, depth1392
-									if buffer[position] != rune('I') {
-										goto l1379
-									}
-									position++
+								position++
+								goto l1609
+							l1610:
+								position, tokenIndex, depth = position1609, tokenIndex1609, depth1609
+								if buffer[position] != rune('B') {
+									goto l1603
 								}
-							l1392:
-								{
-									position1394, tokenIndex1394, depth1394 := position, tokenIndex, depth
-									if buffer[position] != rune('d') {
-										goto l1395
-									}
-									position++
-									goto l1394
-								l1395:
-									position, tokenIndex, depth = position1394, tokenIndex1394, depth1394
-									if buffer[position] != rune('D') {
-										goto l1379
-									}
-									position++
+								position++
+							}
+						l1609:
+							{
+								position1611, tokenIndex1611, depth1611 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1612
 								}
-							l1394:
-								if !rules[ruleskip]() {
-									goto l1379
+								position++
+								goto l1611
+							l1612:
+								position, tokenIndex, depth = position1611, tokenIndex1611, depth1611
+								if buffer[position] != rune('S') {
+									goto l1603
 								}
-								depth--
-								add(ruleSTRUUID, position1381)
+								position++
 							}
-							break
-						case 'U', 'u':
+						l1611:
 							{
-								position1396 := position
-								depth++
-								{
-									position1397, tokenIndex1397, depth1397 := position, tokenIndex, depth
-									if buffer[position] != rune('u') {
-										goto l1398
-									}
-									position++
-									goto l1397
-								l1398:
-									position, tokenIndex, depth = position1397, tokenIndex1397, depth1397
-									if buffer[position] != rune('U') {
-										goto l1379
-									}
-									position++
+								position1613, tokenIndex1613, depth1613 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1614
 								}
-							l1397:
-								{
-									position1399, tokenIndex1399, depth1399 := position, tokenIndex, depth
-									if buffer[position] != rune('u') {
-										goto l1400
-									}
-									position++
-									goto l1399
-								l1400:
-									position, tokenIndex, depth = position1399, tokenIndex1399, depth1399
-									if buffer[position] != rune('U') {
-										goto l1379
-									}
-									position++
+								position++
+								goto l1613
+							l1614:
+								position, tokenIndex, depth = position1613, tokenIndex1613, depth1613
+								if buffer[position] != rune('T') {
+									goto l1603
 								}
-							l1399:
-								{
-									position1401, tokenIndex1401, depth1401 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l1402
-									}
-									position++
-									goto l1401
-								l1402:
-									position, tokenIndex, depth = position1401, tokenIndex1401, depth1401
-									if buffer[position] != rune('I') {
-										goto l1379
-									}
-									position++
+								position++
+							}
+						l1613:
+							{
+								position1615, tokenIndex1615, depth1615 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1616
 								}
-							l1401:
-								{
-									position1403, tokenIndex1403, depth1403 := position, tokenIndex, depth
-									if buffer[position] != rune('d') {
-										goto l1404
-									}
-									position++
-									goto l1403
-								l1404:
-									position, tokenIndex, depth = position1403, tokenIndex1403, depth1403
-									if buffer[position] != rune('D') {
-										goto l1379
-									}
-									position++
+								position++
+								goto l1615
+							l1616:
+								position, tokenIndex, depth = position1615, tokenIndex1615, depth1615
+								if buffer[position] != rune('R') {
+									goto l1603
+								}
+								position++
+							}
+						l1615:
+							if !_rules[ruleskip]() {
+								goto l1603
+							}
+							depth--
+							add(ruleSUBSTR, position1604)
+						}
+						goto l1602
+					l1603:
+						position, tokenIndex, depth = position1602, tokenIndex1602, depth1602
+						{
+							position1618 := position
+							depth++
+							{
+								position1619, tokenIndex1619, depth1619 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1620
 								}
-							l1403:
-								if !rules[ruleskip]() {
-									goto l1379
+								position++
+								goto l1619
+							l1620:
+								position, tokenIndex, depth = position1619, tokenIndex1619, depth1619
+								if buffer[position] != rune('R') {
+									goto l1617
 								}
-								depth--
-								add(ruleUUID, position1396)
+								position++
 							}
-							break
-						case 'N', 'n':
+						l1619:
 							{
-								position1405 := position
-								depth++
-								{
-									position1406, tokenIndex1406, depth1406 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l1407
-									}
-									position++
-									goto l1406
-								l1407:
-									position, tokenIndex, depth = position1406, tokenIndex1406, depth1406
-									if buffer[position] != rune('N') {
-										goto l1379
-									}
-									position++
+								position1621, tokenIndex1621, depth1621 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1622
 								}
-							l1406:
-								{
-									position1408, tokenIndex1408, depth1408 := position, tokenIndex, depth
-									if buffer[position] != rune('o') {
-										goto l1409
-									}
-									position++
-									goto l1408
-								l1409:
-									position, tokenIndex, depth = position1408, tokenIndex1408, depth1408
-									if buffer[position] != rune('O') {
-										goto l1379
-									}
-									position++
+								position++
+								goto l1621
+							l1622:
+								position, tokenIndex, depth = position1621, tokenIndex1621, depth1621
+								if buffer[position] != rune('E') {
+									goto l1617
 								}
-							l1408:
-								{
-									position1410, tokenIndex1410, depth1410 := position, tokenIndex, depth
-									if buffer[position] != rune('w') {
-										goto l1411
-									}
-									position++
-									goto l1410
-								l1411:
-									position, tokenIndex, depth = position1410, tokenIndex1410, depth1410
-									if buffer[position] != rune('W') {
-										goto l1379
-									}
-									position++
+								position++
+							}
+						l1621:
+							{
+								position1623, tokenIndex1623, depth1623 := position, tokenIndex, depth
+								if buffer[position] != rune('p') {
+									goto l1624
 								}
-							l1410:
-								if !rules[ruleskip]() {
-									goto l1379
+								position++
+								goto l1623
+							l1624:
+								position, tokenIndex, depth = position1623, tokenIndex1623, depth1623
+								if buffer[position] != rune('P') {
+									goto l1617
 								}
-								depth--
-								add(ruleNOW, position1405)
+								position++
 							}
-							break
-						default:
+						l1623:
 							{
-								position1412 := position
-								depth++
-								{
-									position1413, tokenIndex1413, depth1413 := position, tokenIndex, depth
-									if buffer[position] != rune('r') {
-										goto l1414
-									}
-									position++
-									goto l1413
-								l1414:
-									position, tokenIndex, depth = position1413, tokenIndex1413, depth1413
-									if buffer[position] != rune('R') {
-										goto l1379
-									}
-									position++
+								position1625, tokenIndex1625, depth1625 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1626
 								}
-							l1413:
-								{
-									position1415, tokenIndex1415, depth1415 := position, tokenIndex, depth
-									if buffer[position] != rune('a') {
-										goto l1416
-									}
-									position++
-									goto l1415
-								l1416:
-									position, tokenIndex, depth = position1415, tokenIndex1415, depth1415
-									if buffer[position] != rune('A') {
-										goto l1379
-									}
-									position++
+								position++
+								goto l1625
+							l1626:
+								position, tokenIndex, depth = position1625, tokenIndex1625, depth1625
+								if buffer[position] != rune('L') {
+									goto l1617
 								}
-							l1415:
-								{
-									position1417, tokenIndex1417, depth1417 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l1418
-									}
-									position++
-									goto l1417
-								l1418:
-									position, tokenIndex, depth = position1417, tokenIndex1417, depth1417
-									if buffer[position] != rune('N') {
-										goto l1379
-									}
-									position++
+								position++
+							}
+						l1625:
+							{
+								position1627, tokenIndex1627, depth1627 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1628
 								}
-							l1417:
-								{
-									position1419, tokenIndex1419, depth1419 := position, tokenIndex, depth
-									if buffer[position] != rune('d') {
-										goto l1420
-									}
-									position++
-									goto l1419
-								l1420:
-									position, tokenIndex, depth = position1419, tokenIndex1419, depth1419
-									if buffer[position] != rune('D') {
-										goto l1379
-									}
-									position++
+								position++
+								goto l1627
+							l1628:
+								position, tokenIndex, depth = position1627, tokenIndex1627, depth1627
+								if buffer[position] != rune('A') {
+									goto l1617
 								}
-							l1419:
-								if !rules[ruleskip]() {
-									goto l1379
+								position++
+							}
+						l1627:
+							{
+								position1629, tokenIndex1629, depth1629 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1630
 								}
-								depth--
-								add(ruleRAND, position1412)
+								position++
+								goto l1629
+							l1630:
+								position, tokenIndex, depth = position1629, tokenIndex1629, depth1629
+								if buffer[position] != rune('C') {
+									goto l1617
+								}
+								position++
+							}
+						l1629:
+							{
+								position1631, tokenIndex1631, depth1631 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1632
+								}
+								position++
+								goto l1631
+							l1632:
+								position, tokenIndex, depth = position1631, tokenIndex1631, depth1631
+								if buffer[position] != rune('E') {
+									goto l1617
+								}
+								position++
+							}
+						l1631:
+							if !_rules[ruleskip]() {
+								goto l1617
 							}
-							break
+							depth--
+							add(ruleREPLACE, position1618)
 						}
-					}
-
-					if !rules[rulenil]() {
-						goto l1379
-					}
-					goto l841
-				l1379:
-					position, tokenIndex, depth = position841, tokenIndex841, depth841
-					{
-						switch buffer[position] {
-						case 'E', 'N', 'e', 'n':
+						goto l1602
+					l1617:
+						position, tokenIndex, depth = position1602, tokenIndex1602, depth1602
+						{
+							position1633 := position
+							depth++
 							{
-								position1422, tokenIndex1422, depth1422 := position, tokenIndex, depth
-								{
-									position1424 := position
-									depth++
-									{
-										position1425, tokenIndex1425, depth1425 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1426
-										}
-										position++
-										goto l1425
-									l1426:
-										position, tokenIndex, depth = position1425, tokenIndex1425, depth1425
-										if buffer[position] != rune('E') {
-											goto l1423
-										}
-										position++
-									}
-								l1425:
-									{
-										position1427, tokenIndex1427, depth1427 := position, tokenIndex, depth
-										if buffer[position] != rune('x') {
-											goto l1428
-										}
-										position++
-										goto l1427
-									l1428:
-										position, tokenIndex, depth = position1427, tokenIndex1427, depth1427
-										if buffer[position] != rune('X') {
-											goto l1423
-										}
-										position++
-									}
-								l1427:
-									{
-										position1429, tokenIndex1429, depth1429 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1430
-										}
-										position++
-										goto l1429
-									l1430:
-										position, tokenIndex, depth = position1429, tokenIndex1429, depth1429
-										if buffer[position] != rune('I') {
-											goto l1423
-										}
-										position++
-									}
-								l1429:
-									{
-										position1431, tokenIndex1431, depth1431 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1432
-										}
-										position++
-										goto l1431
-									l1432:
-										position, tokenIndex, depth = position1431, tokenIndex1431, depth1431
-										if buffer[position] != rune('S') {
-											goto l1423
-										}
-										position++
-									}
-								l1431:
-									{
-										position1433, tokenIndex1433, depth1433 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1434
-										}
-										position++
-										goto l1433
-									l1434:
-										position, tokenIndex, depth = position1433, tokenIndex1433, depth1433
-										if buffer[position] != rune('T') {
-											goto l1423
-										}
-										position++
-									}
-								l1433:
-									{
-										position1435, tokenIndex1435, depth1435 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1436
-										}
-										position++
-										goto l1435
-									l1436:
-										position, tokenIndex, depth = position1435, tokenIndex1435, depth1435
-										if buffer[position] != rune('S') {
-											goto l1423
-										}
-										position++
-									}
-								l1435:
-									if !rules[ruleskip]() {
-										goto l1423
-									}
-									depth--
-									add(ruleEXISTS, position1424)
+								position1634, tokenIndex1634, depth1634 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1635
 								}
-								goto l1422
-							l1423:
-								position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
-								{
-									position1437 := position
-									depth++
-									{
-										position1438, tokenIndex1438, depth1438 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1439
-										}
-										position++
-										goto l1438
-									l1439:
-										position, tokenIndex, depth = position1438, tokenIndex1438, depth1438
-										if buffer[position] != rune('N') {
-											goto l839
-										}
-										position++
-									}
-								l1438:
-									{
-										position1440, tokenIndex1440, depth1440 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1441
-										}
-										position++
-										goto l1440
-									l1441:
-										position, tokenIndex, depth = position1440, tokenIndex1440, depth1440
-										if buffer[position] != rune('O') {
-											goto l839
-										}
-										position++
-									}
-								l1440:
-									{
-										position1442, tokenIndex1442, depth1442 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1443
-										}
-										position++
-										goto l1442
-									l1443:
-										position, tokenIndex, depth = position1442, tokenIndex1442, depth1442
-										if buffer[position] != rune('T') {
-											goto l839
-										}
-										position++
-									}
-								l1442:
-									if buffer[position] != rune(' ') {
-										goto l839
-									}
-									position++
-									{
-										position1444, tokenIndex1444, depth1444 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1445
-										}
-										position++
-										goto l1444
-									l1445:
-										position, tokenIndex, depth = position1444, tokenIndex1444, depth1444
-										if buffer[position] != rune('E') {
-											goto l839
-										}
-										position++
-									}
-								l1444:
-									{
-										position1446, tokenIndex1446, depth1446 := position, tokenIndex, depth
-										if buffer[position] != rune('x') {
-											goto l1447
-										}
-										position++
-										goto l1446
-									l1447:
-										position, tokenIndex, depth = position1446, tokenIndex1446, depth1446
-										if buffer[position] != rune('X') {
-											goto l839
-										}
-										position++
-									}
-								l1446:
-									{
-										position1448, tokenIndex1448, depth1448 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1449
-										}
-										position++
-										goto l1448
-									l1449:
-										position, tokenIndex, depth = position1448, tokenIndex1448, depth1448
-										if buffer[position] != rune('I') {
-											goto l839
-										}
-										position++
-									}
-								l1448:
-									{
-										position1450, tokenIndex1450, depth1450 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1451
-										}
-										position++
-										goto l1450
-									l1451:
-										position, tokenIndex, depth = position1450, tokenIndex1450, depth1450
-										if buffer[position] != rune('S') {
-											goto l839
-										}
-										position++
-									}
-								l1450:
-									{
-										position1452, tokenIndex1452, depth1452 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1453
-										}
-										position++
-										goto l1452
-									l1453:
-										position, tokenIndex, depth = position1452, tokenIndex1452, depth1452
-										if buffer[position] != rune('T') {
-											goto l839
-										}
-										position++
-									}
-								l1452:
-									{
-										position1454, tokenIndex1454, depth1454 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1455
-										}
-										position++
-										goto l1454
-									l1455:
-										position, tokenIndex, depth = position1454, tokenIndex1454, depth1454
-										if buffer[position] != rune('S') {
-											goto l839
-										}
-										position++
-									}
-								l1454:
-									if !rules[ruleskip]() {
-										goto l839
-									}
-									depth--
-									add(ruleNOTEXIST, position1437)
+								position++
+								goto l1634
+							l1635:
+								position, tokenIndex, depth = position1634, tokenIndex1634, depth1634
+								if buffer[position] != rune('R') {
+									goto l1601
+								}
+								position++
+							}
+						l1634:
+							{
+								position1636, tokenIndex1636, depth1636 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1637
+								}
+								position++
+								goto l1636
+							l1637:
+								position, tokenIndex, depth = position1636, tokenIndex1636, depth1636
+								if buffer[position] != rune('E') {
+									goto l1601
+								}
+								position++
+							}
+						l1636:
+							{
+								position1638, tokenIndex1638, depth1638 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l1639
 								}
+								position++
+								goto l1638
+							l1639:
+								position, tokenIndex, depth = position1638, tokenIndex1638, depth1638
+								if buffer[position] != rune('G') {
+									goto l1601
+								}
+								position++
 							}
-						l1422:
-							if !rules[rulegroupGraphPattern]() {
-								goto l839
-							}
-							break
-						case 'I', 'i':
+						l1638:
 							{
-								position1456 := position
-								depth++
-								{
-									position1457, tokenIndex1457, depth1457 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l1458
-									}
-									position++
-									goto l1457
-								l1458:
-									position, tokenIndex, depth = position1457, tokenIndex1457, depth1457
-									if buffer[position] != rune('I') {
-										goto l839
-									}
-									position++
-								}
-							l1457:
-								{
-									position1459, tokenIndex1459, depth1459 := position, tokenIndex, depth
-									if buffer[position] != rune('f') {
-										goto l1460
-									}
-									position++
-									goto l1459
-								l1460:
-									position, tokenIndex, depth = position1459, tokenIndex1459, depth1459
-									if buffer[position] != rune('F') {
-										goto l839
-									}
-									position++
+								position1640, tokenIndex1640, depth1640 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1641
 								}
-							l1459:
-								if !rules[ruleskip]() {
-									goto l839
+								position++
+								goto l1640
+							l1641:
+								position, tokenIndex, depth = position1640, tokenIndex1640, depth1640
+								if buffer[position] != rune('E') {
+									goto l1601
 								}
-								depth--
-								add(ruleIF, position1456)
+								position++
 							}
-							if !rules[ruleLPAREN]() {
-								goto l839
+						l1640:
+							{
+								position1642, tokenIndex1642, depth1642 := position, tokenIndex, depth
+								if buffer[position] != rune('x') {
+									goto l1643
+								}
+								position++
+								goto l1642
+							l1643:
+								position, tokenIndex, depth = position1642, tokenIndex1642, depth1642
+								if buffer[position] != rune('X') {
+									goto l1601
+								}
+								position++
 							}
-							if !rules[ruleexpression]() {
-								goto l839
+						l1642:
+							if !_rules[ruleskip]() {
+								goto l1601
 							}
-							if !rules[ruleCOMMA]() {
-								goto l839
+							depth--
+							add(ruleREGEX, position1633)
+						}
+					}
+				l1602:
+					if !_rules[ruleLPAREN]() {
+						goto l1601
+					}
+					if !_rules[ruleexpression]() {
+						goto l1601
+					}
+					if !_rules[ruleCOMMA]() {
+						goto l1601
+					}
+					if !_rules[ruleexpression]() {
+						goto l1601
+					}
+					{
+						position1644, tokenIndex1644, depth1644 := position, tokenIndex, depth
+						if !_rules[ruleCOMMA]() {
+							goto l1644
+						}
+						if !_rules[ruleexpression]() {
+							goto l1644
+						}
+						goto l1645
+					l1644:
+						position, tokenIndex, depth = position1644, tokenIndex1644, depth1644
+					}
+				l1645:
+					if !_rules[ruleRPAREN]() {
+						goto l1601
+					}
+					goto l958
+				l1601:
+					position, tokenIndex, depth = position958, tokenIndex958, depth958
+					{
+						position1647 := position
+						depth++
+						{
+							position1648, tokenIndex1648, depth1648 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1649
 							}
-							if !rules[ruleexpression]() {
-								goto l839
+							position++
+							goto l1648
+						l1649:
+							position, tokenIndex, depth = position1648, tokenIndex1648, depth1648
+							if buffer[position] != rune('I') {
+								goto l1646
 							}
-							if !rules[ruleCOMMA]() {
-								goto l839
+							position++
+						}
+					l1648:
+						{
+							position1650, tokenIndex1650, depth1650 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1651
 							}
-							if !rules[ruleexpression]() {
-								goto l839
+							position++
+							goto l1650
+						l1651:
+							position, tokenIndex, depth = position1650, tokenIndex1650, depth1650
+							if buffer[position] != rune('F') {
+								goto l1646
 							}
-							if !rules[ruleRPAREN]() {
-								goto l839
+							position++
+						}
+					l1650:
+						if !_rules[ruleskip]() {
+							goto l1646
+						}
+						depth--
+						add(ruleIF, position1647)
+					}
+					if !_rules[ruleLPAREN]() {
+						goto l1646
+					}
+					if !_rules[ruleexpression]() {
+						goto l1646
+					}
+					if !_rules[ruleCOMMA]() {
+						goto l1646
+					}
+					if !_rules[ruleexpression]() {
+						goto l1646
+					}
+					if !_rules[ruleCOMMA]() {
+						goto l1646
+					}
+					if !_rules[ruleexpression]() {
+						goto l1646
+					}
+					if !_rules[ruleRPAREN]() {
+						goto l1646
+					}
+					goto l958
+				l1646:
+					position, tokenIndex, depth = position958, tokenIndex958, depth958
+					{
+						position1652, tokenIndex1652, depth1652 := position, tokenIndex, depth
+						{
+							position1654 := position
+							depth++
+							{
+								position1655, tokenIndex1655, depth1655 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1656
+								}
+								position++
+								goto l1655
+							l1656:
+								position, tokenIndex, depth = position1655, tokenIndex1655, depth1655
+								if buffer[position] != rune('E') {
+									goto l1653
+								}
+								position++
 							}
-							break
-						case 'C', 'c':
+						l1655:
 							{
-								position1461, tokenIndex1461, depth1461 := position, tokenIndex, depth
-								{
-									position1463 := position
-									depth++
-									{
-										position1464, tokenIndex1464, depth1464 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1465
-										}
-										position++
-										goto l1464
-									l1465:
-										position, tokenIndex, depth = position1464, tokenIndex1464, depth1464
-										if buffer[position] != rune('C') {
-											goto l1462
-										}
-										position++
-									}
-								l1464:
-									{
-										position1466, tokenIndex1466, depth1466 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1467
-										}
-										position++
-										goto l1466
-									l1467:
-										position, tokenIndex, depth = position1466, tokenIndex1466, depth1466
-										if buffer[position] != rune('O') {
-											goto l1462
-										}
-										position++
-									}
-								l1466:
-									{
-										position1468, tokenIndex1468, depth1468 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1469
-										}
-										position++
-										goto l1468
-									l1469:
-										position, tokenIndex, depth = position1468, tokenIndex1468, depth1468
-										if buffer[position] != rune('N') {
-											goto l1462
-										}
-										position++
-									}
-								l1468:
-									{
-										position1470, tokenIndex1470, depth1470 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1471
-										}
-										position++
-										goto l1470
-									l1471:
-										position, tokenIndex, depth = position1470, tokenIndex1470, depth1470
-										if buffer[position] != rune('C') {
-											goto l1462
-										}
-										position++
-									}
-								l1470:
-									{
-										position1472, tokenIndex1472, depth1472 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1473
-										}
-										position++
-										goto l1472
-									l1473:
-										position, tokenIndex, depth = position1472, tokenIndex1472, depth1472
-										if buffer[position] != rune('A') {
-											goto l1462
-										}
-										position++
-									}
-								l1472:
-									{
-										position1474, tokenIndex1474, depth1474 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1475
-										}
-										position++
-										goto l1474
-									l1475:
-										position, tokenIndex, depth = position1474, tokenIndex1474, depth1474
-										if buffer[position] != rune('T') {
-											goto l1462
-										}
-										position++
-									}
-								l1474:
-									if !rules[ruleskip]() {
-										goto l1462
-									}
-									depth--
-									add(ruleCONCAT, position1463)
+								position1657, tokenIndex1657, depth1657 := position, tokenIndex, depth
+								if buffer[position] != rune('x') {
+									goto l1658
 								}
-								goto l1461
-							l1462:
-								position, tokenIndex, depth = position1461, tokenIndex1461, depth1461
-								{
-									position1476 := position
-									depth++
-									{
-										position1477, tokenIndex1477, depth1477 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1478
-										}
-										position++
-										goto l1477
-									l1478:
-										position, tokenIndex, depth = position1477, tokenIndex1477, depth1477
-										if buffer[position] != rune('C') {
-											goto l839
-										}
-										position++
-									}
-								l1477:
-									{
-										position1479, tokenIndex1479, depth1479 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1480
-										}
-										position++
-										goto l1479
-									l1480:
-										position, tokenIndex, depth = position1479, tokenIndex1479, depth1479
-										if buffer[position] != rune('O') {
-											goto l839
-										}
-										position++
-									}
-								l1479:
-									{
-										position1481, tokenIndex1481, depth1481 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1482
-										}
-										position++
-										goto l1481
-									l1482:
-										position, tokenIndex, depth = position1481, tokenIndex1481, depth1481
-										if buffer[position] != rune('A') {
-											goto l839
-										}
-										position++
-									}
-								l1481:
-									{
-										position1483, tokenIndex1483, depth1483 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1484
-										}
-										position++
-										goto l1483
-									l1484:
-										position, tokenIndex, depth = position1483, tokenIndex1483, depth1483
-										if buffer[position] != rune('L') {
-											goto l839
-										}
-										position++
-									}
-								l1483:
-									{
-										position1485, tokenIndex1485, depth1485 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1486
-										}
-										position++
-										goto l1485
-									l1486:
-										position, tokenIndex, depth = position1485, tokenIndex1485, depth1485
-										if buffer[position] != rune('E') {
-											goto l839
-										}
-										position++
-									}
-								l1485:
-									{
-										position1487, tokenIndex1487, depth1487 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1488
-										}
-										position++
-										goto l1487
-									l1488:
-										position, tokenIndex, depth = position1487, tokenIndex1487, depth1487
-										if buffer[position] != rune('S') {
-											goto l839
-										}
-										position++
-									}
-								l1487:
-									{
-										position1489, tokenIndex1489, depth1489 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1490
-										}
-										position++
-										goto l1489
-									l1490:
-										position, tokenIndex, depth = position1489, tokenIndex1489, depth1489
-										if buffer[position] != rune('C') {
-											goto l839
-										}
-										position++
-									}
-								l1489:
-									{
-										position1491, tokenIndex1491, depth1491 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1492
-										}
-										position++
-										goto l1491
-									l1492:
-										position, tokenIndex, depth = position1491, tokenIndex1491, depth1491
-										if buffer[position] != rune('E') {
-											goto l839
-										}
-										position++
-									}
-								l1491:
-									if !rules[ruleskip]() {
-										goto l839
-									}
-									depth--
-									add(ruleCOALESCE, position1476)
+								position++
+								goto l1657
+							l1658:
+								position, tokenIndex, depth = position1657, tokenIndex1657, depth1657
+								if buffer[position] != rune('X') {
+									goto l1653
 								}
+								position++
 							}
-						l1461:
-							if !rules[ruleargList]() {
-								goto l839
+						l1657:
+							{
+								position1659, tokenIndex1659, depth1659 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1660
+								}
+								position++
+								goto l1659
+							l1660:
+								position, tokenIndex, depth = position1659, tokenIndex1659, depth1659
+								if buffer[position] != rune('I') {
+									goto l1653
+								}
+								position++
 							}
-							break
-						case 'B', 'b':
+						l1659:
 							{
-								position1493 := position
-								depth++
-								{
-									position1494, tokenIndex1494, depth1494 := position, tokenIndex, depth
-									if buffer[position] != rune('b') {
-										goto l1495
-									}
-									position++
-									goto l1494
-								l1495:
-									position, tokenIndex, depth = position1494, tokenIndex1494, depth1494
-									if buffer[position] != rune('B') {
-										goto l839
-									}
-									position++
+								position1661, tokenIndex1661, depth1661 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1662
 								}
-							l1494:
-								{
-									position1496, tokenIndex1496, depth1496 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l1497
-									}
-									position++
-									goto l1496
-								l1497:
-									position, tokenIndex, depth = position1496, tokenIndex1496, depth1496
-									if buffer[position] != rune('N') {
-										goto l839
-									}
-									position++
+								position++
+								goto l1661
+							l1662:
+								position, tokenIndex, depth = position1661, tokenIndex1661, depth1661
+								if buffer[position] != rune('S') {
+									goto l1653
 								}
-							l1496:
-								{
-									position1498, tokenIndex1498, depth1498 := position, tokenIndex, depth
-									if buffer[position] != rune('o') {
-										goto l1499
-									}
-									position++
-									goto l1498
-								l1499:
-									position, tokenIndex, depth = position1498, tokenIndex1498, depth1498
-									if buffer[position] != rune('O') {
-										goto l839
-									}
-									position++
+								position++
+							}
+						l1661:
+							{
+								position1663, tokenIndex1663, depth1663 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1664
 								}
-							l1498:
-								{
-									position1500, tokenIndex1500, depth1500 := position, tokenIndex, depth
-									if buffer[position] != rune('d') {
-										goto l1501
-									}
-									position++
-									goto l1500
-								l1501:
-									position, tokenIndex, depth = position1500, tokenIndex1500, depth1500
-									if buffer[position] != rune('D') {
-										goto l839
-									}
-									position++
+								position++
+								goto l1663
+							l1664:
+								position, tokenIndex, depth = position1663, tokenIndex1663, depth1663
+								if buffer[position] != rune('T') {
+									goto l1653
 								}
-							l1500:
-								{
-									position1502, tokenIndex1502, depth1502 := position, tokenIndex, depth
-									if buffer[position] != rune('e') {
-										goto l1503
-									}
-									position++
-									goto l1502
-								l1503:
-									position, tokenIndex, depth = position1502, tokenIndex1502, depth1502
-									if buffer[position] != rune('E') {
-										goto l839
-									}
-									position++
+								position++
+							}
+						l1663:
+							{
+								position1665, tokenIndex1665, depth1665 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1666
 								}
-							l1502:
-								if !rules[ruleskip]() {
-									goto l839
+								position++
+								goto l1665
+							l1666:
+								position, tokenIndex, depth = position1665, tokenIndex1665, depth1665
+								if buffer[position] != rune('S') {
+									goto l1653
 								}
-								depth--
-								add(ruleBNODE, position1493)
+								position++
+							}
+						l1665:
+							if !_rules[ruleskip]() {
+								goto l1653
 							}
+							depth--
+							add(ruleEXISTS, position1654)
+						}
+						goto l1652
+					l1653:
+						position, tokenIndex, depth = position1652, tokenIndex1652, depth1652
+						{
+							position1667 := position
+							depth++
 							{
-								position1504, tokenIndex1504, depth1504 := position, tokenIndex, depth
-								if !rules[ruleLPAREN]() {
-									goto l1505
+								position1668, tokenIndex1668, depth1668 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1669
 								}
-								if !rules[ruleexpression]() {
-									goto l1505
+								position++
+								goto l1668
+							l1669:
+								position, tokenIndex, depth = position1668, tokenIndex1668, depth1668
+								if buffer[position] != rune('N') {
+									goto l956
 								}
-								if !rules[ruleRPAREN]() {
-									goto l1505
+								position++
+							}
+						l1668:
+							{
+								position1670, tokenIndex1670, depth1670 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1671
 								}
-								goto l1504
-							l1505:
-								position, tokenIndex, depth = position1504, tokenIndex1504, depth1504
-								if !rules[rulenil]() {
-									goto l839
+								position++
+								goto l1670
+							l1671:
+								position, tokenIndex, depth = position1670, tokenIndex1670, depth1670
+								if buffer[position] != rune('O') {
+									goto l956
 								}
+								position++
 							}
-						l1504:
-							break
-						default:
+						l1670:
 							{
-								position1506, tokenIndex1506, depth1506 := position, tokenIndex, depth
-								{
-									position1508 := position
-									depth++
-									{
-										position1509, tokenIndex1509, depth1509 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1510
-										}
-										position++
-										goto l1509
-									l1510:
-										position, tokenIndex, depth = position1509, tokenIndex1509, depth1509
-										if buffer[position] != rune('S') {
-											goto l1507
-										}
-										position++
-									}
-								l1509:
-									{
-										position1511, tokenIndex1511, depth1511 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1512
-										}
-										position++
-										goto l1511
-									l1512:
-										position, tokenIndex, depth = position1511, tokenIndex1511, depth1511
-										if buffer[position] != rune('U') {
-											goto l1507
-										}
-										position++
-									}
-								l1511:
-									{
-										position1513, tokenIndex1513, depth1513 := position, tokenIndex, depth
-										if buffer[position] != rune('b') {
-											goto l1514
-										}
-										position++
-										goto l1513
-									l1514:
-										position, tokenIndex, depth = position1513, tokenIndex1513, depth1513
-										if buffer[position] != rune('B') {
-											goto l1507
-										}
-										position++
-									}
-								l1513:
-									{
-										position1515, tokenIndex1515, depth1515 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1516
-										}
-										position++
-										goto l1515
-									l1516:
-										position, tokenIndex, depth = position1515, tokenIndex1515, depth1515
-										if buffer[position] != rune('S') {
-											goto l1507
-										}
-										position++
-									}
-								l1515:
-									{
-										position1517, tokenIndex1517, depth1517 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1518
-										}
-										position++
-										goto l1517
-									l1518:
-										position, tokenIndex, depth = position1517, tokenIndex1517, depth1517
-										if buffer[position] != rune('T') {
-											goto l1507
-										}
-										position++
-									}
-								l1517:
-									{
-										position1519, tokenIndex1519, depth1519 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1520
-										}
-										position++
-										goto l1519
-									l1520:
-										position, tokenIndex, depth = position1519, tokenIndex1519, depth1519
-										if buffer[position] != rune('R') {
-											goto l1507
-										}
-										position++
-									}
-								l1519:
-									if !rules[ruleskip]() {
-										goto l1507
-									}
-									depth--
-									add(ruleSUBSTR, position1508)
+								position1672, tokenIndex1672, depth1672 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1673
 								}
-								goto l1506
-							l1507:
-								position, tokenIndex, depth = position1506, tokenIndex1506, depth1506
-								{
-									position1522 := position
-									depth++
-									{
-										position1523, tokenIndex1523, depth1523 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1524
-										}
-										position++
-										goto l1523
-									l1524:
-										position, tokenIndex, depth = position1523, tokenIndex1523, depth1523
-										if buffer[position] != rune('R') {
-											goto l1521
-										}
-										position++
-									}
-								l1523:
-									{
-										position1525, tokenIndex1525, depth1525 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1526
-										}
-										position++
-										goto l1525
-									l1526:
-										position, tokenIndex, depth = position1525, tokenIndex1525, depth1525
-										if buffer[position] != rune('E') {
-											goto l1521
-										}
-										position++
-									}
-								l1525:
-									{
-										position1527, tokenIndex1527, depth1527 := position, tokenIndex, depth
-										if buffer[position] != rune('p') {
-											goto l1528
-										}
-										position++
-										goto l1527
-									l1528:
-										position, tokenIndex, depth = position1527, tokenIndex1527, depth1527
-										if buffer[position] != rune('P') {
-											goto l1521
-										}
-										position++
-									}
-								l1527:
-									{
-										position1529, tokenIndex1529, depth1529 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1530
-										}
-										position++
-										goto l1529
-									l1530:
-										position, tokenIndex, depth = position1529, tokenIndex1529, depth1529
-										if buffer[position] != rune('L') {
-											goto l1521
-										}
-										position++
-									}
-								l1529:
-									{
-										position1531, tokenIndex1531, depth1531 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1532
-										}
-										position++
-										goto l1531
-									l1532:
-										position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
-										if buffer[position] != rune('A') {
-											goto l1521
-										}
-										position++
-									}
-								l1531:
-									{
-										position1533, tokenIndex1533, depth1533 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1534
-										}
-										position++
-										goto l1533
-									l1534:
-										position, tokenIndex, depth = position1533, tokenIndex1533, depth1533
-										if buffer[position] != rune('C') {
-											goto l1521
-										}
-										position++
-									}
-								l1533:
-									{
-										position1535, tokenIndex1535, depth1535 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1536
-										}
-										position++
-										goto l1535
-									l1536:
-										position, tokenIndex, depth = position1535, tokenIndex1535, depth1535
-										if buffer[position] != rune('E') {
-											goto l1521
-										}
-										position++
-									}
-								l1535:
-									if !rules[ruleskip]() {
-										goto l1521
-									}
-									depth--
-									add(ruleREPLACE, position1522)
+								position++
+								goto l1672
+							l1673:
+								position, tokenIndex, depth = position1672, tokenIndex1672, depth1672
+								if buffer[position] != rune('T') {
+									goto l956
 								}
-								goto l1506
-							l1521:
-								position, tokenIndex, depth = position1506, tokenIndex1506, depth1506
-								{
-									position1537 := position
-									depth++
-									{
-										position1538, tokenIndex1538, depth1538 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1539
-										}
-										position++
-										goto l1538
-									l1539:
-										position, tokenIndex, depth = position1538, tokenIndex1538, depth1538
-										if buffer[position] != rune('R') {
-											goto l839
-										}
-										position++
-									}
-								l1538:
-									{
-										position1540, tokenIndex1540, depth1540 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1541
-										}
-										position++
-										goto l1540
-									l1541:
-										position, tokenIndex, depth = position1540, tokenIndex1540, depth1540
-										if buffer[position] != rune('E') {
-											goto l839
-										}
-										position++
-									}
-								l1540:
-									{
-										position1542, tokenIndex1542, depth1542 := position, tokenIndex, depth
-										if buffer[position] != rune('g') {
-											goto l1543
-										}
-										position++
-										goto l1542
-									l1543:
-										position, tokenIndex, depth = position1542, tokenIndex1542, depth1542
-										if buffer[position] != rune('G') {
-											goto l839
-										}
-										position++
-									}
-								l1542:
-									{
-										position1544, tokenIndex1544, depth1544 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1545
-										}
-										position++
-										goto l1544
-									l1545:
-										position, tokenIndex, depth = position1544, tokenIndex1544, depth1544
-										if buffer[position] != rune('E') {
-											goto l839
-										}
-										position++
-									}
-								l1544:
-									{
-										position1546, tokenIndex1546, depth1546 := position, tokenIndex, depth
-										if buffer[position] != rune('x') {
-											goto l1547
-										}
-										position++
-										goto l1546
-									l1547:
-										position, tokenIndex, depth = position1546, tokenIndex1546, depth1546
-										if buffer[position] != rune('X') {
-											goto l839
-										}
-										position++
-									}
-								l1546:
-									if !rules[ruleskip]() {
-										goto l839
-									}
-									depth--
-									add(ruleREGEX, position1537)
+								position++
+							}
+						l1672:
+							if buffer[position] != rune(' ') {
+								goto l956
+							}
+							position++
+							{
+								position1674, tokenIndex1674, depth1674 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1675
 								}
+								position++
+								goto l1674
+							l1675:
+								position, tokenIndex, depth = position1674, tokenIndex1674, depth1674
+								if buffer[position] != rune('E') {
+									goto l956
+								}
+								position++
 							}
-						l1506:
-							if !rules[ruleLPAREN]() {
-								goto l839
+						l1674:
+							{
+								position1676, tokenIndex1676, depth1676 := position, tokenIndex, depth
+								if buffer[position] != rune('x') {
+									goto l1677
+								}
+								position++
+								goto l1676
+							l1677:
+								position, tokenIndex, depth = position1676, tokenIndex1676, depth1676
+								if buffer[position] != rune('X') {
+									goto l956
+								}
+								position++
 							}
-							if !rules[ruleexpression]() {
-								goto l839
+						l1676:
+							{
+								position1678, tokenIndex1678, depth1678 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1679
+								}
+								position++
+								goto l1678
+							l1679:
+								position, tokenIndex, depth = position1678, tokenIndex1678, depth1678
+								if buffer[position] != rune('I') {
+									goto l956
+								}
+								position++
 							}
-							if !rules[ruleCOMMA]() {
-								goto l839
+						l1678:
+							{
+								position1680, tokenIndex1680, depth1680 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1681
+								}
+								position++
+								goto l1680
+							l1681:
+								position, tokenIndex, depth = position1680, tokenIndex1680, depth1680
+								if buffer[position] != rune('S') {
+									goto l956
+								}
+								position++
 							}
-							if !rules[ruleexpression]() {
-								goto l839
+						l1680:
+							{
+								position1682, tokenIndex1682, depth1682 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1683
+								}
+								position++
+								goto l1682
+							l1683:
+								position, tokenIndex, depth = position1682, tokenIndex1682, depth1682
+								if buffer[position] != rune('T') {
+									goto l956
+								}
+								position++
 							}
+						l1682:
 							{
-								position1548, tokenIndex1548, depth1548 := position, tokenIndex, depth
-								if !rules[ruleCOMMA]() {
-									goto l1548
+								position1684, tokenIndex1684, depth1684 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1685
 								}
-								if !rules[ruleexpression]() {
-									goto l1548
+								position++
+								goto l1684
+							l1685:
+								position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+								if buffer[position] != rune('S') {
+									goto l956
 								}
-								goto l1549
-							l1548:
-								position, tokenIndex, depth = position1548, tokenIndex1548, depth1548
+								position++
 							}
-						l1549:
-							if !rules[ruleRPAREN]() {
-								goto l839
+						l1684:
+							if !_rules[ruleskip]() {
+								goto l956
 							}
-							break
+							depth--
+							add(ruleNOTEXIST, position1667)
 						}
 					}
-
+				l1652:
+					if !_rules[rulegroupGraphPattern]() {
+						goto l956
+					}
+				}
+			l958:
+				depth--
+				add(rulebuiltinCall, position957)
+			}
+			return true
+		l956:
+			position, tokenIndex, depth = position956, tokenIndex956, depth956
+			return false
+		},
+		/* 84 pofVariable <- <(pof Action10)> */
+		func() bool {
+			position1686, tokenIndex1686, depth1686 := position, tokenIndex, depth
+			{
+				position1687 := position
+				depth++
+				if !_rules[rulepof]() {
+					goto l1686
+				}
+				{
+					add(ruleAction10, position)
 				}
-			l841:
 				depth--
-				add(rulebuiltinCall, position840)
+				add(rulepofVariable, position1687)
 			}
 			return true
-		l839:
-			position, tokenIndex, depth = position839, tokenIndex839, depth839
+		l1686:
+			position, tokenIndex, depth = position1686, tokenIndex1686, depth1686
 			return false
 		},
-		/* 75 pof <- <(((<([a-z] / [A-Z])*> ':' Action10) / (<([2-9] [0-9]*)> '/' Action11) / (<((&('+') '+') | (&('_') '_') | (&('-') '-') | (&('.') '.') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*> Action12)) '<' ws skip)> */
+		/* 85 pof <- <(((<pnPrefix?> ':' Action11) / (<([2-9] [0-9]*)> '/' Action12) / (<([a-z] / [A-Z] / [0-9] / '.' / '-' / '_' / '+')*> Action13)) '<' ws skip)> */
 		func() bool {
-			position1550, tokenIndex1550, depth1550 := position, tokenIndex, depth
+			position1689, tokenIndex1689, depth1689 := position, tokenIndex, depth
 			{
-				position1551 := position
+				position1690 := position
 				depth++
 				{
-					position1552, tokenIndex1552, depth1552 := position, tokenIndex, depth
+					position1691, tokenIndex1691, depth1691 := position, tokenIndex, depth
 					{
-						position1554 := position
+						position1693 := position
 						depth++
-					l1555:
 						{
-							position1556, tokenIndex1556, depth1556 := position, tokenIndex, depth
-							{
-								position1557, tokenIndex1557, depth1557 := position, tokenIndex, depth
-								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l1558
-								}
-								position++
-								goto l1557
-							l1558:
-								position, tokenIndex, depth = position1557, tokenIndex1557, depth1557
-								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l1556
-								}
-								position++
+							position1694, tokenIndex1694, depth1694 := position, tokenIndex, depth
+							if !_rules[rulepnPrefix]() {
+								goto l1694
 							}
-						l1557:
-							goto l1555
-						l1556:
-							position, tokenIndex, depth = position1556, tokenIndex1556, depth1556
+							goto l1695
+						l1694:
+							position, tokenIndex, depth = position1694, tokenIndex1694, depth1694
 						}
+					l1695:
 						depth--
-						add(rulePegText, position1554)
+						add(rulePegText, position1693)
 					}
 					if buffer[position] != rune(':') {
-						goto l1553
+						goto l1692
 					}
 					position++
 					{
-						add(ruleAction10, position)
+						add(ruleAction11, position)
 					}
-					goto l1552
-				l1553:
-					position, tokenIndex, depth = position1552, tokenIndex1552, depth1552
+					goto l1691
+				l1692:
+					position, tokenIndex, depth = position1691, tokenIndex1691, depth1691
 					{
-						position1561 := position
+						position1698 := position
 						depth++
 						if c := buffer[position]; c < rune('2') || c > rune('9') {
-							goto l1560
+							goto l1697
 						}
 						position++
-					l1562:
+					l1699:
 						{
-							position1563, tokenIndex1563, depth1563 := position, tokenIndex, depth
+							position1700, tokenIndex1700, depth1700 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1563
+								goto l1700
 							}
 							position++
-							goto l1562
-						l1563:
-							position, tokenIndex, depth = position1563, tokenIndex1563, depth1563
+							goto l1699
+						l1700:
+							position, tokenIndex, depth = position1700, tokenIndex1700, depth1700
 						}
 						depth--
-						add(rulePegText, position1561)
+						add(rulePegText, position1698)
 					}
 					if buffer[position] != rune('/') {
-						goto l1560
+						goto l1697
 					}
 					position++
 					{
-						add(ruleAction11, position)
+						add(ruleAction12, position)
 					}
-					goto l1552
-				l1560:
-					position, tokenIndex, depth = position1552, tokenIndex1552, depth1552
+					goto l1691
+				l1697:
+					position, tokenIndex, depth = position1691, tokenIndex1691, depth1691
 					{
-						position1565 := position
+						position1702 := position
 						depth++
-					l1566:
+					l1703:
 						{
-							position1567, tokenIndex1567, depth1567 := position, tokenIndex, depth
+							position1704, tokenIndex1704, depth1704 := position, tokenIndex, depth
 							{
-								switch buffer[position] {
-								case '+':
-									if buffer[position] != rune('+') {
-										goto l1567
-									}
-									position++
-									break
-								case '_':
-									if buffer[position] != rune('_') {
-										goto l1567
-									}
-									position++
-									break
-								case '-':
-									if buffer[position] != rune('-') {
-										goto l1567
-									}
-									position++
-									break
-								case '.':
-									if buffer[position] != rune('.') {
-										goto l1567
-									}
-									position++
-									break
-								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l1567
-									}
-									position++
-									break
-								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-									if c := buffer[position]; c < rune('A') || c > rune('Z') {
-										goto l1567
-									}
-									position++
-									break
-								default:
-									if c := buffer[position]; c < rune('a') || c > rune('z') {
-										goto l1567
-									}
-									position++
-									break
+								position1705, tokenIndex1705, depth1705 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l1706
+								}
+								position++
+								goto l1705
+							l1706:
+								position, tokenIndex, depth = position1705, tokenIndex1705, depth1705
+								if c := buffer[position]; c < rune('A') || c > rune('Z') {
+									goto l1707
 								}
+								position++
+								goto l1705
+							l1707:
+								position, tokenIndex, depth = position1705, tokenIndex1705, depth1705
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1708
+								}
+								position++
+								goto l1705
+							l1708:
+								position, tokenIndex, depth = position1705, tokenIndex1705, depth1705
+								if buffer[position] != rune('.') {
+									goto l1709
+								}
+								position++
+								goto l1705
+							l1709:
+								position, tokenIndex, depth = position1705, tokenIndex1705, depth1705
+								if buffer[position] != rune('-') {
+									goto l1710
+								}
+								position++
+								goto l1705
+							l1710:
+								position, tokenIndex, depth = position1705, tokenIndex1705, depth1705
+								if buffer[position] != rune('_') {
+									goto l1711
+								}
+								position++
+								goto l1705
+							l1711:
+								position, tokenIndex, depth = position1705, tokenIndex1705, depth1705
+								if buffer[position] != rune('+') {
+									goto l1704
+								}
+								position++
 							}
-
-							goto l1566
-						l1567:
-							position, tokenIndex, depth = position1567, tokenIndex1567, depth1567
+						l1705:
+							goto l1703
+						l1704:
+							position, tokenIndex, depth = position1704, tokenIndex1704, depth1704
 						}
 						depth--
-						add(rulePegText, position1565)
+						add(rulePegText, position1702)
 					}
 					{
-						add(ruleAction12, position)
+						add(ruleAction13, position)
 					}
 				}
-			l1552:
+			l1691:
 				if buffer[position] != rune('<') {
-					goto l1550
+					goto l1689
 				}
 				position++
-				if !rules[rulews]() {
-					goto l1550
+				if !_rules[rulews]() {
+					goto l1689
 				}
-				if !rules[ruleskip]() {
-					goto l1550
+				if !_rules[ruleskip]() {
+					goto l1689
 				}
 				depth--
-				add(rulepof, position1551)
+				add(rulepof, position1690)
 			}
 			return true
-		l1550:
-			position, tokenIndex, depth = position1550, tokenIndex1550, depth1550
+		l1689:
+			position, tokenIndex, depth = position1689, tokenIndex1689, depth1689
 			return false
 		},
-		/* 76 var <- <(('?' / '$') VARNAME skip)> */
+		/* 86 var <- <(('?' / '$') <VARNAME> Action14 skip)> */
 		func() bool {
-			position1570, tokenIndex1570, depth1570 := position, tokenIndex, depth
+			position1713, tokenIndex1713, depth1713 := position, tokenIndex, depth
 			{
-				position1571 := position
+				position1714 := position
 				depth++
 				{
-					position1572, tokenIndex1572, depth1572 := position, tokenIndex, depth
+					position1715, tokenIndex1715, depth1715 := position, tokenIndex, depth
 					if buffer[position] != rune('?') {
-						goto l1573
+						goto l1716
 					}
 					position++
-					goto l1572
-				l1573:
-					position, tokenIndex, depth = position1572, tokenIndex1572, depth1572
+					goto l1715
+				l1716:
+					position, tokenIndex, depth = position1715, tokenIndex1715, depth1715
 					if buffer[position] != rune('$') {
-						goto l1570
+						goto l1713
 					}
 					position++
 				}
-			l1572:
+			l1715:
 				{
-					position1574 := position
+					position1717 := position
 					depth++
 					{
-						position1575, tokenIndex1575, depth1575 := position, tokenIndex, depth
-						if !rules[rulepnCharsU]() {
-							goto l1576
-						}
-						goto l1575
-					l1576:
-						position, tokenIndex, depth = position1575, tokenIndex1575, depth1575
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1570
-						}
-						position++
-					}
-				l1575:
-				l1577:
-					{
-						position1578, tokenIndex1578, depth1578 := position, tokenIndex, depth
+						position1718 := position
+						depth++
 						{
-							position1579, tokenIndex1579, depth1579 := position, tokenIndex, depth
-							if !rules[rulepnCharsU]() {
-								goto l1580
+							position1719, tokenIndex1719, depth1719 := position, tokenIndex, depth
+							if !_rules[rulepnCharsU]() {
+								goto l1720
 							}
-							goto l1579
-						l1580:
-							position, tokenIndex, depth = position1579, tokenIndex1579, depth1579
+							goto l1719
+						l1720:
+							position, tokenIndex, depth = position1719, tokenIndex1719, depth1719
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1713
+							}
+							position++
+						}
+					l1719:
+					l1721:
+						{
+							position1722, tokenIndex1722, depth1722 := position, tokenIndex, depth
 							{
-								switch buffer[position] {
-								case 'â':
-									if c := buffer[position]; c < rune('‿') || c > rune('⁀') {
-										goto l1578
-									}
-									position++
-									break
-								case 'Ì', 'Í':
-									if c := buffer[position]; c < rune('̀') || c > rune('ͯ') {
-										goto l1578
-									}
-									position++
-									break
-								case 'Â':
-									if buffer[position] != rune('·') {
-										goto l1578
-									}
-									position++
-									break
-								default:
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l1578
-									}
-									position++
-									break
+								position1723, tokenIndex1723, depth1723 := position, tokenIndex, depth
+								if !_rules[rulepnCharsU]() {
+									goto l1724
+								}
+								goto l1723
+							l1724:
+								position, tokenIndex, depth = position1723, tokenIndex1723, depth1723
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1725
+								}
+								position++
+								goto l1723
+							l1725:
+								position, tokenIndex, depth = position1723, tokenIndex1723, depth1723
+								if buffer[position] != rune('·') {
+									goto l1726
+								}
+								position++
+								goto l1723
+							l1726:
+								position, tokenIndex, depth = position1723, tokenIndex1723, depth1723
+								if c := buffer[position]; c < rune('̀') || c > rune('ͯ') {
+									goto l1727
+								}
+								position++
+								goto l1723
+							l1727:
+								position, tokenIndex, depth = position1723, tokenIndex1723, depth1723
+								if c := buffer[position]; c < rune('‿') || c > rune('⁀') {
+									goto l1722
 								}
+								position++
 							}
-
+						l1723:
+							goto l1721
+						l1722:
+							position, tokenIndex, depth = position1722, tokenIndex1722, depth1722
 						}
-					l1579:
-						goto l1577
-					l1578:
-						position, tokenIndex, depth = position1578, tokenIndex1578, depth1578
+						depth--
+						add(ruleVARNAME, position1718)
 					}
 					depth--
-					add(ruleVARNAME, position1574)
+					add(rulePegText, position1717)
+				}
+				{
+					add(ruleAction14, position)
 				}
-				if !rules[ruleskip]() {
-					goto l1570
+				if !_rules[ruleskip]() {
+					goto l1713
 				}
 				depth--
-				add(rulevar, position1571)
+				add(rulevar, position1714)
 			}
 			return true
-		l1570:
-			position, tokenIndex, depth = position1570, tokenIndex1570, depth1570
+		l1713:
+			position, tokenIndex, depth = position1713, tokenIndex1713, depth1713
 			return false
 		},
-		/* 77 iriref <- <(iri / prefixedName)> */
+		/* 87 iriref <- <(iri / prefixedName)> */
 		func() bool {
-			position1582, tokenIndex1582, depth1582 := position, tokenIndex, depth
+			position1729, tokenIndex1729, depth1729 := position, tokenIndex, depth
 			{
-				position1583 := position
+				position1730 := position
 				depth++
 				{
-					position1584, tokenIndex1584, depth1584 := position, tokenIndex, depth
-					if !rules[ruleiri]() {
-						goto l1585
+					position1731, tokenIndex1731, depth1731 := position, tokenIndex, depth
+					if !_rules[ruleiri]() {
+						goto l1732
 					}
-					goto l1584
-				l1585:
-					position, tokenIndex, depth = position1584, tokenIndex1584, depth1584
+					goto l1731
+				l1732:
+					position, tokenIndex, depth = position1731, tokenIndex1731, depth1731
 					{
-						position1586 := position
+						position1733 := position
 						depth++
 						{
-							position1587, tokenIndex1587, depth1587 := position, tokenIndex, depth
-							if !rules[rulepnPrefix]() {
-								goto l1587
+							position1734, tokenIndex1734, depth1734 := position, tokenIndex, depth
+							if !_rules[rulepnPrefix]() {
+								goto l1734
 							}
-							goto l1588
-						l1587:
-							position, tokenIndex, depth = position1587, tokenIndex1587, depth1587
+							goto l1735
+						l1734:
+							position, tokenIndex, depth = position1734, tokenIndex1734, depth1734
 						}
-					l1588:
+					l1735:
 						if buffer[position] != rune(':') {
-							goto l1582
+							goto l1729
 						}
 						position++
 						{
-							position1589 := position
-							depth++
+							position1736, tokenIndex1736, depth1736 := position, tokenIndex, depth
 							{
-								switch buffer[position] {
-								case '%', '\\':
-									{
-										position1593 := position
-										depth++
-										{
-											position1594, tokenIndex1594, depth1594 := position, tokenIndex, depth
-											{
-												position1596 := position
-												depth++
-												if buffer[position] != rune('%') {
-													goto l1595
-												}
-												position++
-												if !rules[rulehex]() {
-													goto l1595
-												}
-												if !rules[rulehex]() {
-													goto l1595
-												}
-												depth--
-												add(rulepercent, position1596)
-											}
-											goto l1594
-										l1595:
-											position, tokenIndex, depth = position1594, tokenIndex1594, depth1594
-											{
-												position1597 := position
-												depth++
-												if buffer[position] != rune('\\') {
-													goto l1582
-												}
-												position++
-												{
-													switch buffer[position] {
-													case '%':
-														if buffer[position] != rune('%') {
-															goto l1582
-														}
-														position++
-														break
-													case '@':
-														if buffer[position] != rune('@') {
-															goto l1582
-														}
-														position++
-														break
-													case '#':
-														if buffer[position] != rune('#') {
-															goto l1582
-														}
-														position++
-														break
-													case '?':
-														if buffer[position] != rune('?') {
-															goto l1582
-														}
-														position++
-														break
-													case '/':
-														if buffer[position] != rune('/') {
-															goto l1582
-														}
-														position++
-														break
-													case '=':
-														if buffer[position] != rune('=') {
-															goto l1582
-														}
-														position++
-														break
-													case ';':
-														if buffer[position] != rune(';') {
-															goto l1582
-														}
-														position++
-														break
-													case ',':
-														if buffer[position] != rune(',') {
-															goto l1582
-														}
-														position++
-														break
-													case '+':
-														if buffer[position] != rune('+') {
-															goto l1582
-														}
-														position++
-														break
-													case '*':
-														if buffer[position] != rune('*') {
-															goto l1582
-														}
-														position++
-														break
-													case ')':
-														if buffer[position] != rune(')') {
-															goto l1582
-														}
-														position++
-														break
-													case '(':
-														if buffer[position] != rune('(') {
-															goto l1582
-														}
-														position++
-														break
-													case '\'':
-														if buffer[position] != rune('\'') {
-															goto l1582
-														}
-														position++
-														break
-													case '&':
-														if buffer[position] != rune('&') {
-															goto l1582
-														}
-														position++
-														break
-													case '$':
-														if buffer[position] != rune('$') {
-															goto l1582
-														}
-														position++
-														break
-													case '!':
-														if buffer[position] != rune('!') {
-															goto l1582
-														}
-														position++
-														break
-													case '-':
-														if buffer[position] != rune('-') {
-															goto l1582
-														}
-														position++
-														break
-													case '.':
-														if buffer[position] != rune('.') {
-															goto l1582
-														}
-														position++
-														break
-													case '~':
-														if buffer[position] != rune('~') {
-															goto l1582
-														}
-														position++
-														break
-													default:
-														if buffer[position] != rune('_') {
-															goto l1582
-														}
-														position++
-														break
-													}
-												}
-
-												depth--
-												add(rulepnLocalEsc, position1597)
-											}
-										}
-									l1594:
-										depth--
-										add(ruleplx, position1593)
+								position1738 := position
+								depth++
+								{
+									position1739, tokenIndex1739, depth1739 := position, tokenIndex, depth
+									if !_rules[rulepnCharsU]() {
+										goto l1740
 									}
-									break
-								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l1582
+									goto l1739
+								l1740:
+									position, tokenIndex, depth = position1739, tokenIndex1739, depth1739
+									if buffer[position] != rune(':') {
+										goto l1741
 									}
 									position++
-									break
-								case ':':
-									if buffer[position] != rune(':') {
-										goto l1582
+									goto l1739
+								l1741:
+									position, tokenIndex, depth = position1739, tokenIndex1739, depth1739
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l1742
 									}
 									position++
-									break
-								default:
-									if !rules[rulepnCharsU]() {
-										goto l1582
+									goto l1739
+								l1742:
+									position, tokenIndex, depth = position1739, tokenIndex1739, depth1739
+									if !_rules[ruleplx]() {
+										goto l1737
 									}
-									break
 								}
-							}
-
-						l1590:
-							{
-								position1591, tokenIndex1591, depth1591 := position, tokenIndex, depth
+							l1739:
+							l1743:
 								{
-									switch buffer[position] {
-									case '%', '\\':
-										{
-											position1600 := position
-											depth++
-											{
-												position1601, tokenIndex1601, depth1601 := position, tokenIndex, depth
-												{
-													position1603 := position
-													depth++
-													if buffer[position] != rune('%') {
-														goto l1602
-													}
-													position++
-													if !rules[rulehex]() {
-														goto l1602
-													}
-													if !rules[rulehex]() {
-														goto l1602
-													}
-													depth--
-													add(rulepercent, position1603)
-												}
-												goto l1601
-											l1602:
-												position, tokenIndex, depth = position1601, tokenIndex1601, depth1601
-												{
-													position1604 := position
-													depth++
-													if buffer[position] != rune('\\') {
-														goto l1591
-													}
-													position++
-													{
-														switch buffer[position] {
-														case '%':
-															if buffer[position] != rune('%') {
-																goto l1591
-															}
-															position++
-															break
-														case '@':
-															if buffer[position] != rune('@') {
-																goto l1591
-															}
-															position++
-															break
-														case '#':
-															if buffer[position] != rune('#') {
-																goto l1591
-															}
-															position++
-															break
-														case '?':
-															if buffer[position] != rune('?') {
-																goto l1591
-															}
-															position++
-															break
-														case '/':
-															if buffer[position] != rune('/') {
-																goto l1591
-															}
-															position++
-															break
-														case '=':
-															if buffer[position] != rune('=') {
-																goto l1591
-															}
-															position++
-															break
-														case ';':
-															if buffer[position] != rune(';') {
-																goto l1591
-															}
-															position++
-															break
-														case ',':
-															if buffer[position] != rune(',') {
-																goto l1591
-															}
-															position++
-															break
-														case '+':
-															if buffer[position] != rune('+') {
-																goto l1591
-															}
-															position++
-															break
-														case '*':
-															if buffer[position] != rune('*') {
-																goto l1591
-															}
-															position++
-															break
-														case ')':
-															if buffer[position] != rune(')') {
-																goto l1591
-															}
-															position++
-															break
-														case '(':
-															if buffer[position] != rune('(') {
-																goto l1591
-															}
-															position++
-															break
-														case '\'':
-															if buffer[position] != rune('\'') {
-																goto l1591
-															}
-															position++
-															break
-														case '&':
-															if buffer[position] != rune('&') {
-																goto l1591
-															}
-															position++
-															break
-														case '$':
-															if buffer[position] != rune('$') {
-																goto l1591
-															}
-															position++
-															break
-														case '!':
-															if buffer[position] != rune('!') {
-																goto l1591
-															}
-															position++
-															break
-														case '-':
-															if buffer[position] != rune('-') {
-																goto l1591
-															}
-															position++
-															break
-														case '.':
-															if buffer[position] != rune('.') {
-																goto l1591
-															}
-															position++
-															break
-														case '~':
-															if buffer[position] != rune('~') {
-																goto l1591
-															}
-															position++
-															break
-														default:
-															if buffer[position] != rune('_') {
-																goto l1591
-															}
-															position++
-															break
-														}
-													}
-
-													depth--
-													add(rulepnLocalEsc, position1604)
-												}
-											}
-										l1601:
-											depth--
-											add(ruleplx, position1600)
-										}
-										break
-									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l1591
+									position1744, tokenIndex1744, depth1744 := position, tokenIndex, depth
+								l1745:
+									{
+										position1746, tokenIndex1746, depth1746 := position, tokenIndex, depth
+										if buffer[position] != rune('.') {
+											goto l1746
 										}
 										position++
-										break
-									case ':':
+										goto l1745
+									l1746:
+										position, tokenIndex, depth = position1746, tokenIndex1746, depth1746
+									}
+									{
+										position1747, tokenIndex1747, depth1747 := position, tokenIndex, depth
+										if !_rules[rulepnChars]() {
+											goto l1748
+										}
+										goto l1747
+									l1748:
+										position, tokenIndex, depth = position1747, tokenIndex1747, depth1747
 										if buffer[position] != rune(':') {
-											goto l1591
+											goto l1749
 										}
 										position++
-										break
-									default:
-										if !rules[rulepnCharsU]() {
-											goto l1591
+										goto l1747
+									l1749:
+										position, tokenIndex, depth = position1747, tokenIndex1747, depth1747
+										if !_rules[ruleplx]() {
+											goto l1744
 										}
-										break
 									}
+								l1747:
+									goto l1743
+								l1744:
+									position, tokenIndex, depth = position1744, tokenIndex1744, depth1744
 								}
-
-								goto l1590
-							l1591:
-								position, tokenIndex, depth = position1591, tokenIndex1591, depth1591
+								depth--
+								add(rulepnLocal, position1738)
+							}
+							goto l1736
+						l1737:
+							position, tokenIndex, depth = position1736, tokenIndex1736, depth1736
+							{
+								position1750, tokenIndex1750, depth1750 := position, tokenIndex, depth
+								if buffer[position] != rune('<') {
+									goto l1750
+								}
+								position++
+								goto l1729
+							l1750:
+								position, tokenIndex, depth = position1750, tokenIndex1750, depth1750
 							}
-							depth--
-							add(rulepnLocal, position1589)
 						}
-						if !rules[ruleskip]() {
-							goto l1582
+					l1736:
+						if !_rules[ruleskip]() {
+							goto l1729
 						}
 						depth--
-						add(ruleprefixedName, position1586)
+						add(ruleprefixedName, position1733)
 					}
 				}
-			l1584:
+			l1731:
 				depth--
-				add(ruleiriref, position1583)
+				add(ruleiriref, position1730)
 			}
 			return true
-		l1582:
-			position, tokenIndex, depth = position1582, tokenIndex1582, depth1582
+		l1729:
+			position, tokenIndex, depth = position1729, tokenIndex1729, depth1729
 			return false
 		},
-		/* 78 iri <- <('<' (!'>' .)* '>' skip)> */
+		/* 88 iri <- <('<' ((!('<' / '>' / '"' / '{' / '}' / '|' / '^' / '`' / '\\' / [\x00- ]) .) / uchar)* '>' skip)> */
 		func() bool {
-			position1606, tokenIndex1606, depth1606 := position, tokenIndex, depth
+			position1751, tokenIndex1751, depth1751 := position, tokenIndex, depth
 			{
-				position1607 := position
+				position1752 := position
 				depth++
 				if buffer[position] != rune('<') {
-					goto l1606
+					goto l1751
 				}
 				position++
-			l1608:
+			l1753:
 				{
-					position1609, tokenIndex1609, depth1609 := position, tokenIndex, depth
+					position1754, tokenIndex1754, depth1754 := position, tokenIndex, depth
 					{
-						position1610, tokenIndex1610, depth1610 := position, tokenIndex, depth
-						if buffer[position] != rune('>') {
-							goto l1610
+						position1755, tokenIndex1755, depth1755 := position, tokenIndex, depth
+						{
+							position1757, tokenIndex1757, depth1757 := position, tokenIndex, depth
+							{
+								position1758, tokenIndex1758, depth1758 := position, tokenIndex, depth
+								if buffer[position] != rune('<') {
+									goto l1759
+								}
+								position++
+								goto l1758
+							l1759:
+								position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+								if buffer[position] != rune('>') {
+									goto l1760
+								}
+								position++
+								goto l1758
+							l1760:
+								position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+								if buffer[position] != rune('"') {
+									goto l1761
+								}
+								position++
+								goto l1758
+							l1761:
+								position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+								if buffer[position] != rune('{') {
+									goto l1762
+								}
+								position++
+								goto l1758
+							l1762:
+								position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+								if buffer[position] != rune('}') {
+									goto l1763
+								}
+								position++
+								goto l1758
+							l1763:
+								position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+								if buffer[position] != rune('|') {
+									goto l1764
+								}
+								position++
+								goto l1758
+							l1764:
+								position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+								if buffer[position] != rune('^') {
+									goto l1765
+								}
+								position++
+								goto l1758
+							l1765:
+								position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+								if buffer[position] != rune('`') {
+									goto l1766
+								}
+								position++
+								goto l1758
+							l1766:
+								position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+								if buffer[position] != rune('\\') {
+									goto l1767
+								}
+								position++
+								goto l1758
+							l1767:
+								position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+								if c := buffer[position]; c < rune('\x00') || c > rune(' ') {
+									goto l1757
+								}
+								position++
+							}
+						l1758:
+							goto l1756
+						l1757:
+							position, tokenIndex, depth = position1757, tokenIndex1757, depth1757
+						}
+						if !matchDot() {
+							goto l1756
+						}
+						goto l1755
+					l1756:
+						position, tokenIndex, depth = position1755, tokenIndex1755, depth1755
+						if !_rules[ruleuchar]() {
+							goto l1754
 						}
-						position++
-						goto l1609
-					l1610:
-						position, tokenIndex, depth = position1610, tokenIndex1610, depth1610
-					}
-					if !matchDot() {
-						goto l1609
 					}
-					goto l1608
-				l1609:
-					position, tokenIndex, depth = position1609, tokenIndex1609, depth1609
+				l1755:
+					goto l1753
+				l1754:
+					position, tokenIndex, depth = position1754, tokenIndex1754, depth1754
 				}
 				if buffer[position] != rune('>') {
-					goto l1606
+					goto l1751
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1606
+				if !_rules[ruleskip]() {
+					goto l1751
 				}
 				depth--
-				add(ruleiri, position1607)
+				add(ruleiri, position1752)
 			}
 			return true
-		l1606:
-			position, tokenIndex, depth = position1606, tokenIndex1606, depth1606
+		l1751:
+			position, tokenIndex, depth = position1751, tokenIndex1751, depth1751
 			return false
 		},
-		/* 79 prefixedName <- <(pnPrefix? ':' pnLocal skip)> */
+		/* 89 prefixedName <- <(pnPrefix? ':' (pnLocal / !'<') skip)> */
 		nil,
-		/* 80 literal <- <(string (('@' ([a-z] / [A-Z])+ ('-' ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+)*) / ('^' '^' iriref))? skip)> */
+		/* 90 literal <- <(string (('@' ([a-z] / [A-Z])+ ('-' ([a-z] / [A-Z] / [0-9])+)*) / ('^' '^' iriref))? skip)> */
 		func() bool {
-			position1612, tokenIndex1612, depth1612 := position, tokenIndex, depth
+			position1769, tokenIndex1769, depth1769 := position, tokenIndex, depth
 			{
-				position1613 := position
+				position1770 := position
 				depth++
-				if !rules[rulestring]() {
-					goto l1612
+				if !_rules[rulestring]() {
+					goto l1769
 				}
 				{
-					position1614, tokenIndex1614, depth1614 := position, tokenIndex, depth
+					position1771, tokenIndex1771, depth1771 := position, tokenIndex, depth
 					{
-						position1616, tokenIndex1616, depth1616 := position, tokenIndex, depth
+						position1773, tokenIndex1773, depth1773 := position, tokenIndex, depth
 						if buffer[position] != rune('@') {
-							goto l1617
+							goto l1774
 						}
 						position++
 						{
-							position1620, tokenIndex1620, depth1620 := position, tokenIndex, depth
+							position1777, tokenIndex1777, depth1777 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1621
+								goto l1778
 							}
 							position++
-							goto l1620
-						l1621:
-							position, tokenIndex, depth = position1620, tokenIndex1620, depth1620
+							goto l1777
+						l1778:
+							position, tokenIndex, depth = position1777, tokenIndex1777, depth1777
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1617
+								goto l1774
 							}
 							position++
 						}
-					l1620:
-					l1618:
+					l1777:
+					l1775:
 						{
-							position1619, tokenIndex1619, depth1619 := position, tokenIndex, depth
+							position1776, tokenIndex1776, depth1776 := position, tokenIndex, depth
 							{
-								position1622, tokenIndex1622, depth1622 := position, tokenIndex, depth
+								position1779, tokenIndex1779, depth1779 := position, tokenIndex, depth
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l1623
+									goto l1780
 								}
 								position++
-								goto l1622
-							l1623:
-								position, tokenIndex, depth = position1622, tokenIndex1622, depth1622
+								goto l1779
+							l1780:
+								position, tokenIndex, depth = position1779, tokenIndex1779, depth1779
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l1619
+									goto l1776
 								}
 								position++
 							}
-						l1622:
-							goto l1618
-						l1619:
-							position, tokenIndex, depth = position1619, tokenIndex1619, depth1619
+						l1779:
+							goto l1775
+						l1776:
+							position, tokenIndex, depth = position1776, tokenIndex1776, depth1776
 						}
-					l1624:
+					l1781:
 						{
-							position1625, tokenIndex1625, depth1625 := position, tokenIndex, depth
+							position1782, tokenIndex1782, depth1782 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l1625
+								goto l1782
 							}
 							position++
 							{
-								switch buffer[position] {
-								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l1625
-									}
-									position++
-									break
-								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-									if c := buffer[position]; c < rune('A') || c > rune('Z') {
-										goto l1625
-									}
-									position++
-									break
-								default:
-									if c := buffer[position]; c < rune('a') || c > rune('z') {
-										goto l1625
-									}
-									position++
-									break
-								}
-							}
-
-						l1626:
-							{
-								position1627, tokenIndex1627, depth1627 := position, tokenIndex, depth
-								{
-									switch buffer[position] {
-									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l1627
-										}
-										position++
-										break
-									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-										if c := buffer[position]; c < rune('A') || c > rune('Z') {
-											goto l1627
-										}
-										position++
-										break
-									default:
-										if c := buffer[position]; c < rune('a') || c > rune('z') {
-											goto l1627
-										}
-										position++
-										break
-									}
-								}
-
-								goto l1626
-							l1627:
-								position, tokenIndex, depth = position1627, tokenIndex1627, depth1627
-							}
-							goto l1624
-						l1625:
-							position, tokenIndex, depth = position1625, tokenIndex1625, depth1625
-						}
-						goto l1616
-					l1617:
-						position, tokenIndex, depth = position1616, tokenIndex1616, depth1616
-						if buffer[position] != rune('^') {
-							goto l1614
-						}
-						position++
-						if buffer[position] != rune('^') {
-							goto l1614
-						}
-						position++
-						if !rules[ruleiriref]() {
-							goto l1614
-						}
-					}
-				l1616:
-					goto l1615
-				l1614:
-					position, tokenIndex, depth = position1614, tokenIndex1614, depth1614
-				}
-			l1615:
-				if !rules[ruleskip]() {
-					goto l1612
-				}
-				depth--
-				add(ruleliteral, position1613)
-			}
-			return true
-		l1612:
-			position, tokenIndex, depth = position1612, tokenIndex1612, depth1612
-			return false
-		},
-		/* 81 string <- <(stringLiteralA / stringLiteralB / stringLiteralLongA / stringLiteralLongB)> */
-		func() bool {
-			position1630, tokenIndex1630, depth1630 := position, tokenIndex, depth
-			{
-				position1631 := position
-				depth++
-				{
-					position1632, tokenIndex1632, depth1632 := position, tokenIndex, depth
-					{
-						position1634 := position
-						depth++
-						if buffer[position] != rune('\'') {
-							goto l1633
-						}
-						position++
-					l1635:
-						{
-							position1636, tokenIndex1636, depth1636 := position, tokenIndex, depth
-							{
-								position1637, tokenIndex1637, depth1637 := position, tokenIndex, depth
-								{
-									position1639, tokenIndex1639, depth1639 := position, tokenIndex, depth
-									{
-										switch buffer[position] {
-										case '\r':
-											if buffer[position] != rune('\r') {
-												goto l1639
-											}
-											position++
-											break
-										case '\n':
-											if buffer[position] != rune('\n') {
-												goto l1639
-											}
-											position++
-											break
-										case '\\':
-											if buffer[position] != rune('\\') {
-												goto l1639
-											}
-											position++
-											break
-										default:
-											if buffer[position] != rune('\'') {
-												goto l1639
-											}
-											position++
-											break
-										}
-									}
-
-									goto l1638
-								l1639:
-									position, tokenIndex, depth = position1639, tokenIndex1639, depth1639
+								position1785, tokenIndex1785, depth1785 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l1786
 								}
-								if !matchDot() {
-									goto l1638
+								position++
+								goto l1785
+							l1786:
+								position, tokenIndex, depth = position1785, tokenIndex1785, depth1785
+								if c := buffer[position]; c < rune('A') || c > rune('Z') {
+									goto l1787
 								}
-								goto l1637
-							l1638:
-								position, tokenIndex, depth = position1637, tokenIndex1637, depth1637
-								if !rules[ruleechar]() {
-									goto l1636
+								position++
+								goto l1785
+							l1787:
+								position, tokenIndex, depth = position1785, tokenIndex1785, depth1785
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1782
 								}
+								position++
 							}
-						l1637:
-							goto l1635
-						l1636:
-							position, tokenIndex, depth = position1636, tokenIndex1636, depth1636
-						}
-						if buffer[position] != rune('\'') {
-							goto l1633
-						}
-						position++
-						depth--
-						add(rulestringLiteralA, position1634)
-					}
-					goto l1632
-				l1633:
-					position, tokenIndex, depth = position1632, tokenIndex1632, depth1632
-					{
-						position1642 := position
-						depth++
-						if buffer[position] != rune('"') {
-							goto l1641
-						}
-						position++
-					l1643:
-						{
-							position1644, tokenIndex1644, depth1644 := position, tokenIndex, depth
+						l1785:
+						l1783:
 							{
-								position1645, tokenIndex1645, depth1645 := position, tokenIndex, depth
+								position1784, tokenIndex1784, depth1784 := position, tokenIndex, depth
 								{
-									position1647, tokenIndex1647, depth1647 := position, tokenIndex, depth
-									{
-										switch buffer[position] {
-										case '\r':
-											if buffer[position] != rune('\r') {
-												goto l1647
-											}
-											position++
-											break
-										case '\n':
-											if buffer[position] != rune('\n') {
-												goto l1647
-											}
-											position++
-											break
-										case '\\':
-											if buffer[position] != rune('\\') {
-												goto l1647
-											}
-											position++
-											break
-										default:
-											if buffer[position] != rune('"') {
-												goto l1647
-											}
-											position++
-											break
-										}
+									position1788, tokenIndex1788, depth1788 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l1789
 									}
-
-									goto l1646
-								l1647:
-									position, tokenIndex, depth = position1647, tokenIndex1647, depth1647
-								}
-								if !matchDot() {
-									goto l1646
-								}
-								goto l1645
-							l1646:
-								position, tokenIndex, depth = position1645, tokenIndex1645, depth1645
-								if !rules[ruleechar]() {
-									goto l1644
+									position++
+									goto l1788
+								l1789:
+									position, tokenIndex, depth = position1788, tokenIndex1788, depth1788
+									if c := buffer[position]; c < rune('A') || c > rune('Z') {
+										goto l1790
+									}
+									position++
+									goto l1788
+								l1790:
+									position, tokenIndex, depth = position1788, tokenIndex1788, depth1788
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l1784
+									}
+									position++
 								}
+							l1788:
+								goto l1783
+							l1784:
+								position, tokenIndex, depth = position1784, tokenIndex1784, depth1784
 							}
-						l1645:
-							goto l1643
-						l1644:
-							position, tokenIndex, depth = position1644, tokenIndex1644, depth1644
+							goto l1781
+						l1782:
+							position, tokenIndex, depth = position1782, tokenIndex1782, depth1782
 						}
-						if buffer[position] != rune('"') {
-							goto l1641
+						goto l1773
+					l1774:
+						position, tokenIndex, depth = position1773, tokenIndex1773, depth1773
+						if buffer[position] != rune('^') {
+							goto l1771
 						}
 						position++
-						depth--
-						add(rulestringLiteralB, position1642)
+						if buffer[position] != rune('^') {
+							goto l1771
+						}
+						position++
+						if !_rules[ruleiriref]() {
+							goto l1771
+						}
 					}
-					goto l1632
-				l1641:
-					position, tokenIndex, depth = position1632, tokenIndex1632, depth1632
+				l1773:
+					goto l1772
+				l1771:
+					position, tokenIndex, depth = position1771, tokenIndex1771, depth1771
+				}
+			l1772:
+				if !_rules[ruleskip]() {
+					goto l1769
+				}
+				depth--
+				add(ruleliteral, position1770)
+			}
+			return true
+		l1769:
+			position, tokenIndex, depth = position1769, tokenIndex1769, depth1769
+			return false
+		},
+		/* 91 string <- <(stringLiteralLongA / stringLiteralLongB / stringLiteralA / stringLiteralB)> */
+		func() bool {
+			position1791, tokenIndex1791, depth1791 := position, tokenIndex, depth
+			{
+				position1792 := position
+				depth++
+				{
+					position1793, tokenIndex1793, depth1793 := position, tokenIndex, depth
 					{
-						position1650 := position
+						position1795 := position
 						depth++
 						if buffer[position] != rune('\'') {
-							goto l1649
+							goto l1794
 						}
 						position++
 						if buffer[position] != rune('\'') {
-							goto l1649
+							goto l1794
 						}
 						position++
 						if buffer[position] != rune('\'') {
-							goto l1649
+							goto l1794
 						}
 						position++
-					l1651:
+					l1796:
 						{
-							position1652, tokenIndex1652, depth1652 := position, tokenIndex, depth
+							position1797, tokenIndex1797, depth1797 := position, tokenIndex, depth
 							{
-								position1653, tokenIndex1653, depth1653 := position, tokenIndex, depth
+								position1798, tokenIndex1798, depth1798 := position, tokenIndex, depth
 								{
-									position1655, tokenIndex1655, depth1655 := position, tokenIndex, depth
+									position1800, tokenIndex1800, depth1800 := position, tokenIndex, depth
 									if buffer[position] != rune('\'') {
-										goto l1656
+										goto l1801
 									}
 									position++
-									goto l1655
-								l1656:
-									position, tokenIndex, depth = position1655, tokenIndex1655, depth1655
 									if buffer[position] != rune('\'') {
-										goto l1653
+										goto l1801
 									}
 									position++
+									goto l1800
+								l1801:
+									position, tokenIndex, depth = position1800, tokenIndex1800, depth1800
 									if buffer[position] != rune('\'') {
-										goto l1653
+										goto l1798
 									}
 									position++
 								}
-							l1655:
-								goto l1654
-							l1653:
-								position, tokenIndex, depth = position1653, tokenIndex1653, depth1653
+							l1800:
+								goto l1799
+							l1798:
+								position, tokenIndex, depth = position1798, tokenIndex1798, depth1798
 							}
-						l1654:
+						l1799:
 							{
-								position1657, tokenIndex1657, depth1657 := position, tokenIndex, depth
+								position1802, tokenIndex1802, depth1802 := position, tokenIndex, depth
 								{
-									position1659, tokenIndex1659, depth1659 := position, tokenIndex, depth
+									position1804, tokenIndex1804, depth1804 := position, tokenIndex, depth
 									{
-										position1660, tokenIndex1660, depth1660 := position, tokenIndex, depth
+										position1805, tokenIndex1805, depth1805 := position, tokenIndex, depth
 										if buffer[position] != rune('\'') {
-											goto l1661
+											goto l1806
 										}
 										position++
-										goto l1660
-									l1661:
-										position, tokenIndex, depth = position1660, tokenIndex1660, depth1660
+										goto l1805
+									l1806:
+										position, tokenIndex, depth = position1805, tokenIndex1805, depth1805
 										if buffer[position] != rune('\\') {
-											goto l1659
+											goto l1804
 										}
 										position++
 									}
-								l1660:
-									goto l1658
-								l1659:
-									position, tokenIndex, depth = position1659, tokenIndex1659, depth1659
+								l1805:
+									goto l1803
+								l1804:
+									position, tokenIndex, depth = position1804, tokenIndex1804, depth1804
 								}
 								if !matchDot() {
-									goto l1658
+									goto l1803
 								}
-								goto l1657
-							l1658:
-								position, tokenIndex, depth = position1657, tokenIndex1657, depth1657
-								if !rules[ruleechar]() {
-									goto l1652
+								goto l1802
+							l1803:
+								position, tokenIndex, depth = position1802, tokenIndex1802, depth1802
+								if !_rules[ruleechar]() {
+									goto l1807
+								}
+								goto l1802
+							l1807:
+								position, tokenIndex, depth = position1802, tokenIndex1802, depth1802
+								if !_rules[ruleuchar]() {
+									goto l1797
 								}
 							}
-						l1657:
-							goto l1651
-						l1652:
-							position, tokenIndex, depth = position1652, tokenIndex1652, depth1652
+						l1802:
+							goto l1796
+						l1797:
+							position, tokenIndex, depth = position1797, tokenIndex1797, depth1797
 						}
 						if buffer[position] != rune('\'') {
-							goto l1649
+							goto l1794
 						}
 						position++
 						if buffer[position] != rune('\'') {
-							goto l1649
+							goto l1794
 						}
 						position++
 						if buffer[position] != rune('\'') {
-							goto l1649
+							goto l1794
 						}
 						position++
 						depth--
-						add(rulestringLiteralLongA, position1650)
+						add(rulestringLiteralLongA, position1795)
 					}
-					goto l1632
-				l1649:
-					position, tokenIndex, depth = position1632, tokenIndex1632, depth1632
+					goto l1793
+				l1794:
+					position, tokenIndex, depth = position1793, tokenIndex1793, depth1793
 					{
-						position1662 := position
+						position1809 := position
 						depth++
 						if buffer[position] != rune('"') {
-							goto l1630
+							goto l1808
 						}
 						position++
 						if buffer[position] != rune('"') {
-							goto l1630
+							goto l1808
 						}
 						position++
 						if buffer[position] != rune('"') {
-							goto l1630
+							goto l1808
 						}
 						position++
-					l1663:
+					l1810:
 						{
-							position1664, tokenIndex1664, depth1664 := position, tokenIndex, depth
+							position1811, tokenIndex1811, depth1811 := position, tokenIndex, depth
 							{
-								position1665, tokenIndex1665, depth1665 := position, tokenIndex, depth
+								position1812, tokenIndex1812, depth1812 := position, tokenIndex, depth
 								{
-									position1667, tokenIndex1667, depth1667 := position, tokenIndex, depth
+									position1814, tokenIndex1814, depth1814 := position, tokenIndex, depth
 									if buffer[position] != rune('"') {
-										goto l1668
+										goto l1815
 									}
 									position++
-									goto l1667
-								l1668:
-									position, tokenIndex, depth = position1667, tokenIndex1667, depth1667
 									if buffer[position] != rune('"') {
-										goto l1665
+										goto l1815
 									}
 									position++
+									goto l1814
+								l1815:
+									position, tokenIndex, depth = position1814, tokenIndex1814, depth1814
 									if buffer[position] != rune('"') {
-										goto l1665
+										goto l1812
 									}
 									position++
 								}
-							l1667:
-								goto l1666
-							l1665:
-								position, tokenIndex, depth = position1665, tokenIndex1665, depth1665
+							l1814:
+								goto l1813
+							l1812:
+								position, tokenIndex, depth = position1812, tokenIndex1812, depth1812
 							}
-						l1666:
+						l1813:
 							{
-								position1669, tokenIndex1669, depth1669 := position, tokenIndex, depth
+								position1816, tokenIndex1816, depth1816 := position, tokenIndex, depth
 								{
-									position1671, tokenIndex1671, depth1671 := position, tokenIndex, depth
+									position1818, tokenIndex1818, depth1818 := position, tokenIndex, depth
 									{
-										position1672, tokenIndex1672, depth1672 := position, tokenIndex, depth
+										position1819, tokenIndex1819, depth1819 := position, tokenIndex, depth
 										if buffer[position] != rune('"') {
-											goto l1673
+											goto l1820
 										}
 										position++
-										goto l1672
-									l1673:
-										position, tokenIndex, depth = position1672, tokenIndex1672, depth1672
+										goto l1819
+									l1820:
+										position, tokenIndex, depth = position1819, tokenIndex1819, depth1819
 										if buffer[position] != rune('\\') {
-											goto l1671
+											goto l1818
 										}
 										position++
 									}
-								l1672:
-									goto l1670
-								l1671:
-									position, tokenIndex, depth = position1671, tokenIndex1671, depth1671
+								l1819:
+									goto l1817
+								l1818:
+									position, tokenIndex, depth = position1818, tokenIndex1818, depth1818
 								}
 								if !matchDot() {
-									goto l1670
+									goto l1817
 								}
-								goto l1669
-							l1670:
-								position, tokenIndex, depth = position1669, tokenIndex1669, depth1669
-								if !rules[ruleechar]() {
-									goto l1664
+								goto l1816
+							l1817:
+								position, tokenIndex, depth = position1816, tokenIndex1816, depth1816
+								if !_rules[ruleechar]() {
+									goto l1821
+								}
+								goto l1816
+							l1821:
+								position, tokenIndex, depth = position1816, tokenIndex1816, depth1816
+								if !_rules[ruleuchar]() {
+									goto l1811
 								}
 							}
-						l1669:
-							goto l1663
-						l1664:
-							position, tokenIndex, depth = position1664, tokenIndex1664, depth1664
+						l1816:
+							goto l1810
+						l1811:
+							position, tokenIndex, depth = position1811, tokenIndex1811, depth1811
+						}
+						if buffer[position] != rune('"') {
+							goto l1808
 						}
+						position++
+						if buffer[position] != rune('"') {
+							goto l1808
+						}
+						position++
 						if buffer[position] != rune('"') {
-							goto l1630
+							goto l1808
+						}
+						position++
+						depth--
+						add(rulestringLiteralLongB, position1809)
+					}
+					goto l1793
+				l1808:
+					position, tokenIndex, depth = position1793, tokenIndex1793, depth1793
+					{
+						position1823 := position
+						depth++
+						if buffer[position] != rune('\'') {
+							goto l1822
+						}
+						position++
+					l1824:
+						{
+							position1825, tokenIndex1825, depth1825 := position, tokenIndex, depth
+							{
+								position1826, tokenIndex1826, depth1826 := position, tokenIndex, depth
+								{
+									position1828, tokenIndex1828, depth1828 := position, tokenIndex, depth
+									{
+										position1829, tokenIndex1829, depth1829 := position, tokenIndex, depth
+										if buffer[position] != rune('\'') {
+											goto l1830
+										}
+										position++
+										goto l1829
+									l1830:
+										position, tokenIndex, depth = position1829, tokenIndex1829, depth1829
+										if buffer[position] != rune('\\') {
+											goto l1831
+										}
+										position++
+										goto l1829
+									l1831:
+										position, tokenIndex, depth = position1829, tokenIndex1829, depth1829
+										if buffer[position] != rune('\n') {
+											goto l1832
+										}
+										position++
+										goto l1829
+									l1832:
+										position, tokenIndex, depth = position1829, tokenIndex1829, depth1829
+										if buffer[position] != rune('\r') {
+											goto l1828
+										}
+										position++
+									}
+								l1829:
+									goto l1827
+								l1828:
+									position, tokenIndex, depth = position1828, tokenIndex1828, depth1828
+								}
+								if !matchDot() {
+									goto l1827
+								}
+								goto l1826
+							l1827:
+								position, tokenIndex, depth = position1826, tokenIndex1826, depth1826
+								if !_rules[ruleechar]() {
+									goto l1833
+								}
+								goto l1826
+							l1833:
+								position, tokenIndex, depth = position1826, tokenIndex1826, depth1826
+								if !_rules[ruleuchar]() {
+									goto l1825
+								}
+							}
+						l1826:
+							goto l1824
+						l1825:
+							position, tokenIndex, depth = position1825, tokenIndex1825, depth1825
+						}
+						if buffer[position] != rune('\'') {
+							goto l1822
 						}
 						position++
+						depth--
+						add(rulestringLiteralA, position1823)
+					}
+					goto l1793
+				l1822:
+					position, tokenIndex, depth = position1793, tokenIndex1793, depth1793
+					{
+						position1834 := position
+						depth++
 						if buffer[position] != rune('"') {
-							goto l1630
+							goto l1791
 						}
 						position++
+					l1835:
+						{
+							position1836, tokenIndex1836, depth1836 := position, tokenIndex, depth
+							{
+								position1837, tokenIndex1837, depth1837 := position, tokenIndex, depth
+								{
+									position1839, tokenIndex1839, depth1839 := position, tokenIndex, depth
+									{
+										position1840, tokenIndex1840, depth1840 := position, tokenIndex, depth
+										if buffer[position] != rune('"') {
+											goto l1841
+										}
+										position++
+										goto l1840
+									l1841:
+										position, tokenIndex, depth = position1840, tokenIndex1840, depth1840
+										if buffer[position] != rune('\\') {
+											goto l1842
+										}
+										position++
+										goto l1840
+									l1842:
+										position, tokenIndex, depth = position1840, tokenIndex1840, depth1840
+										if buffer[position] != rune('\n') {
+											goto l1843
+										}
+										position++
+										goto l1840
+									l1843:
+										position, tokenIndex, depth = position1840, tokenIndex1840, depth1840
+										if buffer[position] != rune('\r') {
+											goto l1839
+										}
+										position++
+									}
+								l1840:
+									goto l1838
+								l1839:
+									position, tokenIndex, depth = position1839, tokenIndex1839, depth1839
+								}
+								if !matchDot() {
+									goto l1838
+								}
+								goto l1837
+							l1838:
+								position, tokenIndex, depth = position1837, tokenIndex1837, depth1837
+								if !_rules[ruleechar]() {
+									goto l1844
+								}
+								goto l1837
+							l1844:
+								position, tokenIndex, depth = position1837, tokenIndex1837, depth1837
+								if !_rules[ruleuchar]() {
+									goto l1836
+								}
+							}
+						l1837:
+							goto l1835
+						l1836:
+							position, tokenIndex, depth = position1836, tokenIndex1836, depth1836
+						}
 						if buffer[position] != rune('"') {
-							goto l1630
+							goto l1791
 						}
 						position++
 						depth--
-						add(rulestringLiteralLongB, position1662)
+						add(rulestringLiteralB, position1834)
 					}
 				}
-			l1632:
+			l1793:
 				depth--
-				add(rulestring, position1631)
+				add(rulestring, position1792)
 			}
 			return true
-		l1630:
-			position, tokenIndex, depth = position1630, tokenIndex1630, depth1630
+		l1791:
+			position, tokenIndex, depth = position1791, tokenIndex1791, depth1791
 			return false
 		},
-		/* 82 stringLiteralA <- <('\'' ((!((&('\r') '\r') | (&('\n') '\n') | (&('\\') '\\') | (&('\'') '\'')) .) / echar)* '\'')> */
+		/* 92 stringLiteralA <- <('\'' ((!('\'' / '\\' / '\n' / '\r') .) / echar / uchar)* '\'')> */
 		nil,
-		/* 83 stringLiteralB <- <('"' ((!((&('\r') '\r') | (&('\n') '\n') | (&('\\') '\\') | (&('"') '"')) .) / echar)* '"')> */
+		/* 93 stringLiteralB <- <('"' ((!('"' / '\\' / '\n' / '\r') .) / echar / uchar)* '"')> */
 		nil,
-		/* 84 stringLiteralLongA <- <('\'' '\'' '\'' (('\'' / ('\'' '\''))? ((!('\'' / '\\') .) / echar))* ('\'' '\'' '\''))> */
+		/* 94 stringLiteralLongA <- <('\'' '\'' '\'' ((('\'' '\'') / '\'')? ((!('\'' / '\\') .) / echar / uchar))* ('\'' '\'' '\''))> */
 		nil,
-		/* 85 stringLiteralLongB <- <('"' '"' '"' (('"' / ('"' '"'))? ((!('"' / '\\') .) / echar))* ('"' '"' '"'))> */
+		/* 95 stringLiteralLongB <- <('"' '"' '"' ((('"' '"') / '"')? ((!('"' / '\\') .) / echar / uchar))* ('"' '"' '"'))> */
 		nil,
-		/* 86 echar <- <('\\' ((&('\'') '\'') | (&('"') '"') | (&('\\') '\\') | (&('f') 'f') | (&('r') 'r') | (&('n') 'n') | (&('b') 'b') | (&('t') 't')))> */
+		/* 96 echar <- <('\\' ('t' / 'b' / 'n' / 'r' / 'f' / '\\' / '"' / '\''))> */
 		func() bool {
-			position1678, tokenIndex1678, depth1678 := position, tokenIndex, depth
+			position1849, tokenIndex1849, depth1849 := position, tokenIndex, depth
 			{
-				position1679 := position
+				position1850 := position
 				depth++
 				if buffer[position] != rune('\\') {
-					goto l1678
+					goto l1849
 				}
 				position++
 				{
-					switch buffer[position] {
-					case '\'':
-						if buffer[position] != rune('\'') {
-							goto l1678
+					position1851, tokenIndex1851, depth1851 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l1852
+					}
+					position++
+					goto l1851
+				l1852:
+					position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
+					if buffer[position] != rune('b') {
+						goto l1853
+					}
+					position++
+					goto l1851
+				l1853:
+					position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
+					if buffer[position] != rune('n') {
+						goto l1854
+					}
+					position++
+					goto l1851
+				l1854:
+					position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
+					if buffer[position] != rune('r') {
+						goto l1855
+					}
+					position++
+					goto l1851
+				l1855:
+					position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
+					if buffer[position] != rune('f') {
+						goto l1856
+					}
+					position++
+					goto l1851
+				l1856:
+					position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
+					if buffer[position] != rune('\\') {
+						goto l1857
+					}
+					position++
+					goto l1851
+				l1857:
+					position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
+					if buffer[position] != rune('"') {
+						goto l1858
+					}
+					position++
+					goto l1851
+				l1858:
+					position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
+					if buffer[position] != rune('\'') {
+						goto l1849
+					}
+					position++
+				}
+			l1851:
+				depth--
+				add(ruleechar, position1850)
+			}
+			return true
+		l1849:
+			position, tokenIndex, depth = position1849, tokenIndex1849, depth1849
+			return false
+		},
+		/* 97 uchar <- <(('\\' 'u' hex hex hex hex) / ('\\' 'U' hex hex hex hex hex hex hex hex))> */
+		func() bool {
+			position1859, tokenIndex1859, depth1859 := position, tokenIndex, depth
+			{
+				position1860 := position
+				depth++
+				{
+					position1861, tokenIndex1861, depth1861 := position, tokenIndex, depth
+					if buffer[position] != rune('\\') {
+						goto l1862
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l1862
+					}
+					position++
+					if !_rules[rulehex]() {
+						goto l1862
+					}
+					if !_rules[rulehex]() {
+						goto l1862
+					}
+					if !_rules[rulehex]() {
+						goto l1862
+					}
+					if !_rules[rulehex]() {
+						goto l1862
+					}
+					goto l1861
+				l1862:
+					position, tokenIndex, depth = position1861, tokenIndex1861, depth1861
+					if buffer[position] != rune('\\') {
+						goto l1859
+					}
+					position++
+					if buffer[position] != rune('U') {
+						goto l1859
+					}
+					position++
+					if !_rules[rulehex]() {
+						goto l1859
+					}
+					if !_rules[rulehex]() {
+						goto l1859
+					}
+					if !_rules[rulehex]() {
+						goto l1859
+					}
+					if !_rules[rulehex]() {
+						goto l1859
+					}
+					if !_rules[rulehex]() {
+						goto l1859
+					}
+					if !_rules[rulehex]() {
+						goto l1859
+					}
+					if !_rules[rulehex]() {
+						goto l1859
+					}
+					if !_rules[rulehex]() {
+						goto l1859
+					}
+				}
+			l1861:
+				depth--
+				add(ruleuchar, position1860)
+			}
+			return true
+		l1859:
+			position, tokenIndex, depth = position1859, tokenIndex1859, depth1859
+			return false
+		},
+		/* 98 numericLiteral <- <(('+' / '-')? unsignedNumericLiteral skip)> */
+		func() bool {
+			position1863, tokenIndex1863, depth1863 := position, tokenIndex, depth
+			{
+				position1864 := position
+				depth++
+				{
+					position1865, tokenIndex1865, depth1865 := position, tokenIndex, depth
+					{
+						position1867, tokenIndex1867, depth1867 := position, tokenIndex, depth
+						if buffer[position] != rune('+') {
+							goto l1868
 						}
 						position++
-						break
-					case '"':
-						if buffer[position] != rune('"') {
-							goto l1678
+						goto l1867
+					l1868:
+						position, tokenIndex, depth = position1867, tokenIndex1867, depth1867
+						if buffer[position] != rune('-') {
+							goto l1865
+						}
+						position++
+					}
+				l1867:
+					goto l1866
+				l1865:
+					position, tokenIndex, depth = position1865, tokenIndex1865, depth1865
+				}
+			l1866:
+				if !_rules[ruleunsignedNumericLiteral]() {
+					goto l1863
+				}
+				if !_rules[ruleskip]() {
+					goto l1863
+				}
+				depth--
+				add(rulenumericLiteral, position1864)
+			}
+			return true
+		l1863:
+			position, tokenIndex, depth = position1863, tokenIndex1863, depth1863
+			return false
+		},
+		/* 99 signedNumericLiteral <- <(('+' / '-') unsignedNumericLiteral skip)> */
+		nil,
+		/* 100 unsignedNumericLiteral <- <(double / decimal / integer)> */
+		func() bool {
+			position1870, tokenIndex1870, depth1870 := position, tokenIndex, depth
+			{
+				position1871 := position
+				depth++
+				{
+					position1872, tokenIndex1872, depth1872 := position, tokenIndex, depth
+					{
+						position1874 := position
+						depth++
+						{
+							position1875, tokenIndex1875, depth1875 := position, tokenIndex, depth
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1876
+							}
+							position++
+						l1877:
+							{
+								position1878, tokenIndex1878, depth1878 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1878
+								}
+								position++
+								goto l1877
+							l1878:
+								position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+							}
+							if buffer[position] != rune('.') {
+								goto l1876
+							}
+							position++
+						l1879:
+							{
+								position1880, tokenIndex1880, depth1880 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1880
+								}
+								position++
+								goto l1879
+							l1880:
+								position, tokenIndex, depth = position1880, tokenIndex1880, depth1880
+							}
+							if !_rules[ruleexponent]() {
+								goto l1876
+							}
+							goto l1875
+						l1876:
+							position, tokenIndex, depth = position1875, tokenIndex1875, depth1875
+							if buffer[position] != rune('.') {
+								goto l1881
+							}
+							position++
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1881
+							}
+							position++
+						l1882:
+							{
+								position1883, tokenIndex1883, depth1883 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1883
+								}
+								position++
+								goto l1882
+							l1883:
+								position, tokenIndex, depth = position1883, tokenIndex1883, depth1883
+							}
+							if !_rules[ruleexponent]() {
+								goto l1881
+							}
+							goto l1875
+						l1881:
+							position, tokenIndex, depth = position1875, tokenIndex1875, depth1875
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1873
+							}
+							position++
+						l1884:
+							{
+								position1885, tokenIndex1885, depth1885 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1885
+								}
+								position++
+								goto l1884
+							l1885:
+								position, tokenIndex, depth = position1885, tokenIndex1885, depth1885
+							}
+							if !_rules[ruleexponent]() {
+								goto l1873
+							}
 						}
-						position++
-						break
-					case '\\':
-						if buffer[position] != rune('\\') {
-							goto l1678
+					l1875:
+						depth--
+						add(ruledouble, position1874)
+					}
+					goto l1872
+				l1873:
+					position, tokenIndex, depth = position1872, tokenIndex1872, depth1872
+					{
+						position1887 := position
+						depth++
+					l1888:
+						{
+							position1889, tokenIndex1889, depth1889 := position, tokenIndex, depth
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1889
+							}
+							position++
+							goto l1888
+						l1889:
+							position, tokenIndex, depth = position1889, tokenIndex1889, depth1889
 						}
-						position++
-						break
-					case 'f':
-						if buffer[position] != rune('f') {
-							goto l1678
+						if buffer[position] != rune('.') {
+							goto l1886
 						}
 						position++
-						break
-					case 'r':
-						if buffer[position] != rune('r') {
-							goto l1678
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1886
 						}
 						position++
-						break
-					case 'n':
-						if buffer[position] != rune('n') {
-							goto l1678
+					l1890:
+						{
+							position1891, tokenIndex1891, depth1891 := position, tokenIndex, depth
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1891
+							}
+							position++
+							goto l1890
+						l1891:
+							position, tokenIndex, depth = position1891, tokenIndex1891, depth1891
 						}
-						position++
-						break
-					case 'b':
-						if buffer[position] != rune('b') {
-							goto l1678
+						depth--
+						add(ruledecimal, position1887)
+					}
+					goto l1872
+				l1886:
+					position, tokenIndex, depth = position1872, tokenIndex1872, depth1872
+					{
+						position1892 := position
+						depth++
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1870
 						}
 						position++
-						break
-					default:
-						if buffer[position] != rune('t') {
-							goto l1678
+					l1893:
+						{
+							position1894, tokenIndex1894, depth1894 := position, tokenIndex, depth
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1894
+							}
+							position++
+							goto l1893
+						l1894:
+							position, tokenIndex, depth = position1894, tokenIndex1894, depth1894
 						}
-						position++
-						break
+						depth--
+						add(ruleinteger, position1892)
 					}
 				}
-
+			l1872:
 				depth--
-				add(ruleechar, position1679)
+				add(ruleunsignedNumericLiteral, position1871)
 			}
 			return true
-		l1678:
-			position, tokenIndex, depth = position1678, tokenIndex1678, depth1678
+		l1870:
+			position, tokenIndex, depth = position1870, tokenIndex1870, depth1870
 			return false
 		},
-		/* 87 numericLiteral <- <(('+' / '-')? [0-9]+ ('.' [0-9]*)? skip)> */
+		/* 101 double <- <(([0-9]+ '.' [0-9]* exponent) / ('.' [0-9]+ exponent) / ([0-9]+ exponent))> */
+		nil,
+		/* 102 decimal <- <([0-9]* '.' [0-9]+)> */
+		nil,
+		/* 103 integer <- <[0-9]+> */
+		nil,
+		/* 104 exponent <- <(('e' / 'E') ('+' / '-')? [0-9]+)> */
 		func() bool {
-			position1681, tokenIndex1681, depth1681 := position, tokenIndex, depth
+			position1898, tokenIndex1898, depth1898 := position, tokenIndex, depth
 			{
-				position1682 := position
+				position1899 := position
 				depth++
 				{
-					position1683, tokenIndex1683, depth1683 := position, tokenIndex, depth
+					position1900, tokenIndex1900, depth1900 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1901
+					}
+					position++
+					goto l1900
+				l1901:
+					position, tokenIndex, depth = position1900, tokenIndex1900, depth1900
+					if buffer[position] != rune('E') {
+						goto l1898
+					}
+					position++
+				}
+			l1900:
+				{
+					position1902, tokenIndex1902, depth1902 := position, tokenIndex, depth
 					{
-						position1685, tokenIndex1685, depth1685 := position, tokenIndex, depth
+						position1904, tokenIndex1904, depth1904 := position, tokenIndex, depth
 						if buffer[position] != rune('+') {
-							goto l1686
+							goto l1905
 						}
 						position++
-						goto l1685
-					l1686:
-						position, tokenIndex, depth = position1685, tokenIndex1685, depth1685
+						goto l1904
+					l1905:
+						position, tokenIndex, depth = position1904, tokenIndex1904, depth1904
 						if buffer[position] != rune('-') {
-							goto l1683
+							goto l1902
 						}
 						position++
 					}
-				l1685:
-					goto l1684
-				l1683:
-					position, tokenIndex, depth = position1683, tokenIndex1683, depth1683
+				l1904:
+					goto l1903
+				l1902:
+					position, tokenIndex, depth = position1902, tokenIndex1902, depth1902
 				}
-			l1684:
+			l1903:
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l1681
+					goto l1898
 				}
 				position++
-			l1687:
+			l1906:
 				{
-					position1688, tokenIndex1688, depth1688 := position, tokenIndex, depth
+					position1907, tokenIndex1907, depth1907 := position, tokenIndex, depth
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1688
-					}
-					position++
-					goto l1687
-				l1688:
-					position, tokenIndex, depth = position1688, tokenIndex1688, depth1688
-				}
-				{
-					position1689, tokenIndex1689, depth1689 := position, tokenIndex, depth
-					if buffer[position] != rune('.') {
-						goto l1689
+						goto l1907
 					}
 					position++
-				l1691:
-					{
-						position1692, tokenIndex1692, depth1692 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1692
-						}
-						position++
-						goto l1691
-					l1692:
-						position, tokenIndex, depth = position1692, tokenIndex1692, depth1692
-					}
-					goto l1690
-				l1689:
-					position, tokenIndex, depth = position1689, tokenIndex1689, depth1689
-				}
-			l1690:
-				if !rules[ruleskip]() {
-					goto l1681
+					goto l1906
+				l1907:
+					position, tokenIndex, depth = position1907, tokenIndex1907, depth1907
 				}
 				depth--
-				add(rulenumericLiteral, position1682)
+				add(ruleexponent, position1899)
 			}
 			return true
-		l1681:
-			position, tokenIndex, depth = position1681, tokenIndex1681, depth1681
+		l1898:
+			position, tokenIndex, depth = position1898, tokenIndex1898, depth1898
 			return false
 		},
-		/* 88 signedNumericLiteral <- <(('+' / '-') [0-9]+ ('.' [0-9]*)? skip)> */
-		nil,
-		/* 89 booleanLiteral <- <(TRUE / FALSE)> */
+		/* 105 booleanLiteral <- <(TRUE / FALSE)> */
 		func() bool {
-			position1694, tokenIndex1694, depth1694 := position, tokenIndex, depth
+			position1908, tokenIndex1908, depth1908 := position, tokenIndex, depth
 			{
-				position1695 := position
+				position1909 := position
 				depth++
 				{
-					position1696, tokenIndex1696, depth1696 := position, tokenIndex, depth
+					position1910, tokenIndex1910, depth1910 := position, tokenIndex, depth
 					{
-						position1698 := position
+						position1912 := position
 						depth++
 						{
-							position1699, tokenIndex1699, depth1699 := position, tokenIndex, depth
+							position1913, tokenIndex1913, depth1913 := position, tokenIndex, depth
 							if buffer[position] != rune('t') {
-								goto l1700
+								goto l1914
 							}
 							position++
-							goto l1699
-						l1700:
-							position, tokenIndex, depth = position1699, tokenIndex1699, depth1699
+							goto l1913
+						l1914:
+							position, tokenIndex, depth = position1913, tokenIndex1913, depth1913
 							if buffer[position] != rune('T') {
-								goto l1697
+								goto l1911
 							}
 							position++
 						}
-					l1699:
+					l1913:
 						{
-							position1701, tokenIndex1701, depth1701 := position, tokenIndex, depth
+							position1915, tokenIndex1915, depth1915 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l1702
+								goto l1916
 							}
 							position++
-							goto l1701
-						l1702:
-							position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+							goto l1915
+						l1916:
+							position, tokenIndex, depth = position1915, tokenIndex1915, depth1915
 							if buffer[position] != rune('R') {
-								goto l1697
+								goto l1911
 							}
 							position++
 						}
-					l1701:
+					l1915:
 						{
-							position1703, tokenIndex1703, depth1703 := position, tokenIndex, depth
+							position1917, tokenIndex1917, depth1917 := position, tokenIndex, depth
 							if buffer[position] != rune('u') {
-								goto l1704
+								goto l1918
 							}
 							position++
-							goto l1703
-						l1704:
-							position, tokenIndex, depth = position1703, tokenIndex1703, depth1703
+							goto l1917
+						l1918:
+							position, tokenIndex, depth = position1917, tokenIndex1917, depth1917
 							if buffer[position] != rune('U') {
-								goto l1697
+								goto l1911
 							}
 							position++
 						}
-					l1703:
+					l1917:
 						{
-							position1705, tokenIndex1705, depth1705 := position, tokenIndex, depth
+							position1919, tokenIndex1919, depth1919 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l1706
+								goto l1920
 							}
 							position++
-							goto l1705
-						l1706:
-							position, tokenIndex, depth = position1705, tokenIndex1705, depth1705
+							goto l1919
+						l1920:
+							position, tokenIndex, depth = position1919, tokenIndex1919, depth1919
 							if buffer[position] != rune('E') {
-								goto l1697
+								goto l1911
 							}
 							position++
 						}
-					l1705:
-						if !rules[ruleskip]() {
-							goto l1697
+					l1919:
+						if !_rules[ruleskip]() {
+							goto l1911
 						}
 						depth--
-						add(ruleTRUE, position1698)
+						add(ruleTRUE, position1912)
 					}
-					goto l1696
-				l1697:
-					position, tokenIndex, depth = position1696, tokenIndex1696, depth1696
+					goto l1910
+				l1911:
+					position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
 					{
-						position1707 := position
+						position1921 := position
 						depth++
 						{
-							position1708, tokenIndex1708, depth1708 := position, tokenIndex, depth
+							position1922, tokenIndex1922, depth1922 := position, tokenIndex, depth
 							if buffer[position] != rune('f') {
-								goto l1709
+								goto l1923
 							}
 							position++
-							goto l1708
-						l1709:
-							position, tokenIndex, depth = position1708, tokenIndex1708, depth1708
+							goto l1922
+						l1923:
+							position, tokenIndex, depth = position1922, tokenIndex1922, depth1922
 							if buffer[position] != rune('F') {
-								goto l1694
+								goto l1908
 							}
 							position++
 						}
-					l1708:
+					l1922:
 						{
-							position1710, tokenIndex1710, depth1710 := position, tokenIndex, depth
+							position1924, tokenIndex1924, depth1924 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l1711
+								goto l1925
 							}
 							position++
-							goto l1710
-						l1711:
-							position, tokenIndex, depth = position1710, tokenIndex1710, depth1710
+							goto l1924
+						l1925:
+							position, tokenIndex, depth = position1924, tokenIndex1924, depth1924
 							if buffer[position] != rune('A') {
-								goto l1694
+								goto l1908
 							}
 							position++
 						}
-					l1710:
+					l1924:
 						{
-							position1712, tokenIndex1712, depth1712 := position, tokenIndex, depth
+							position1926, tokenIndex1926, depth1926 := position, tokenIndex, depth
 							if buffer[position] != rune('l') {
-								goto l1713
+								goto l1927
 							}
 							position++
-							goto l1712
-						l1713:
-							position, tokenIndex, depth = position1712, tokenIndex1712, depth1712
+							goto l1926
+						l1927:
+							position, tokenIndex, depth = position1926, tokenIndex1926, depth1926
 							if buffer[position] != rune('L') {
-								goto l1694
+								goto l1908
 							}
 							position++
 						}
-					l1712:
+					l1926:
 						{
-							position1714, tokenIndex1714, depth1714 := position, tokenIndex, depth
+							position1928, tokenIndex1928, depth1928 := position, tokenIndex, depth
 							if buffer[position] != rune('s') {
-								goto l1715
+								goto l1929
 							}
 							position++
-							goto l1714
-						l1715:
-							position, tokenIndex, depth = position1714, tokenIndex1714, depth1714
+							goto l1928
+						l1929:
+							position, tokenIndex, depth = position1928, tokenIndex1928, depth1928
 							if buffer[position] != rune('S') {
-								goto l1694
+								goto l1908
 							}
 							position++
 						}
-					l1714:
+					l1928:
 						{
-							position1716, tokenIndex1716, depth1716 := position, tokenIndex, depth
+							position1930, tokenIndex1930, depth1930 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l1717
+								goto l1931
 							}
 							position++
-							goto l1716
-						l1717:
-							position, tokenIndex, depth = position1716, tokenIndex1716, depth1716
+							goto l1930
+						l1931:
+							position, tokenIndex, depth = position1930, tokenIndex1930, depth1930
 							if buffer[position] != rune('E') {
-								goto l1694
+								goto l1908
 							}
 							position++
 						}
-					l1716:
-						if !rules[ruleskip]() {
-							goto l1694
+					l1930:
+						if !_rules[ruleskip]() {
+							goto l1908
 						}
 						depth--
-						add(ruleFALSE, position1707)
+						add(ruleFALSE, position1921)
 					}
 				}
-			l1696:
+			l1910:
 				depth--
-				add(rulebooleanLiteral, position1695)
+				add(rulebooleanLiteral, position1909)
 			}
 			return true
-		l1694:
-			position, tokenIndex, depth = position1694, tokenIndex1694, depth1694
+		l1908:
+			position, tokenIndex, depth = position1908, tokenIndex1908, depth1908
 			return false
 		},
-		/* 90 blankNode <- <(blankNodeLabel / anon)> */
+		/* 106 blankNode <- <(blankNodeLabel / anon)> */
 		nil,
-		/* 91 blankNodeLabel <- <('_' ':' (pnCharsU / [0-9]) (((pnCharsU / ((&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9])))* pnCharsU) / ([0-9] / '-'))? skip)> */
+		/* 107 blankNodeLabel <- <('_' ':' (pnCharsU / [0-9]) ('.'* pnChars)* skip)> */
 		nil,
-		/* 92 anon <- <('[' ws* ']' skip)> */
+		/* 108 anon <- <('[' ws* ']' skip)> */
 		nil,
-		/* 93 nil <- <('(' ws* ')' skip)> */
+		/* 109 nil <- <('(' ws* ')' skip)> */
 		func() bool {
-			position1721, tokenIndex1721, depth1721 := position, tokenIndex, depth
+			position1935, tokenIndex1935, depth1935 := position, tokenIndex, depth
 			{
-				position1722 := position
+				position1936 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l1721
+					goto l1935
 				}
 				position++
-			l1723:
+			l1937:
 				{
-					position1724, tokenIndex1724, depth1724 := position, tokenIndex, depth
-					if !rules[rulews]() {
-						goto l1724
+					position1938, tokenIndex1938, depth1938 := position, tokenIndex, depth
+					if !_rules[rulews]() {
+						goto l1938
 					}
-					goto l1723
-				l1724:
-					position, tokenIndex, depth = position1724, tokenIndex1724, depth1724
+					goto l1937
+				l1938:
+					position, tokenIndex, depth = position1938, tokenIndex1938, depth1938
 				}
 				if buffer[position] != rune(')') {
-					goto l1721
+					goto l1935
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1721
+				if !_rules[ruleskip]() {
+					goto l1935
 				}
 				depth--
-				add(rulenil, position1722)
+				add(rulenil, position1936)
 			}
 			return true
-		l1721:
-			position, tokenIndex, depth = position1721, tokenIndex1721, depth1721
+		l1935:
+			position, tokenIndex, depth = position1935, tokenIndex1935, depth1935
 			return false
 		},
-		/* 94 VARNAME <- <((pnCharsU / [0-9]) (pnCharsU / ((&('â') [‿-⁀]) | (&('Ì' | 'Í') [̀-ͯ]) | (&('Â') '·') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9])))*)> */
+		/* 110 VARNAME <- <((pnCharsU / [0-9]) (pnCharsU / [0-9] / '·' / [̀-ͯ] / [‿-⁀])*)> */
 		nil,
-		/* 95 pnPrefix <- <(pnCharsBase pnChars*)> */
+		/* 111 pnPrefix <- <(pnCharsBase ('.'* pnChars)*)> */
 		func() bool {
-			position1726, tokenIndex1726, depth1726 := position, tokenIndex, depth
+			position1940, tokenIndex1940, depth1940 := position, tokenIndex, depth
 			{
-				position1727 := position
+				position1941 := position
 				depth++
-				if !rules[rulepnCharsBase]() {
-					goto l1726
+				if !_rules[rulepnCharsBase]() {
+					goto l1940
 				}
-			l1728:
+			l1942:
 				{
-					position1729, tokenIndex1729, depth1729 := position, tokenIndex, depth
+					position1943, tokenIndex1943, depth1943 := position, tokenIndex, depth
+				l1944:
 					{
-						position1730 := position
-						depth++
-						{
-							switch buffer[position] {
-							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l1729
-								}
-								position++
-								break
-							case '-':
-								if buffer[position] != rune('-') {
-									goto l1729
-								}
-								position++
-								break
-							default:
-								if !rules[rulepnCharsU]() {
-									goto l1729
-								}
-								break
-							}
+						position1945, tokenIndex1945, depth1945 := position, tokenIndex, depth
+						if buffer[position] != rune('.') {
+							goto l1945
 						}
-
-						depth--
-						add(rulepnChars, position1730)
+						position++
+						goto l1944
+					l1945:
+						position, tokenIndex, depth = position1945, tokenIndex1945, depth1945
 					}
-					goto l1728
-				l1729:
-					position, tokenIndex, depth = position1729, tokenIndex1729, depth1729
+					if !_rules[rulepnChars]() {
+						goto l1943
+					}
+					goto l1942
+				l1943:
+					position, tokenIndex, depth = position1943, tokenIndex1943, depth1943
 				}
 				depth--
-				add(rulepnPrefix, position1727)
+				add(rulepnPrefix, position1941)
 			}
 			return true
-		l1726:
-			position, tokenIndex, depth = position1726, tokenIndex1726, depth1726
+		l1940:
+			position, tokenIndex, depth = position1940, tokenIndex1940, depth1940
 			return false
 		},
-		/* 96 pnLocal <- <((&('%' | '\\') plx) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&(':') ':') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | 'Ã' | 'Ä' | 'Å' | 'Æ' | 'Ç' | 'È' | 'É' | 'Ê' | 'Ë' | 'Í' | 'Î' | 'Ï' | 'Ð' | 'Ñ' | 'Ò' | 'Ó' | 'Ô' | 'Õ' | 'Ö' | '×' | 'Ø' | 'Ù' | 'Ú' | 'Û' | 'Ü' | 'Ý' | 'Þ' | 'ß' | 'à' | 'á' | 'â' | 'ã' | 'ä' | 'å' | 'æ' | 'ç' | 'è' | 'é' | 'ê' | 'ë' | 'ì' | 'í' | 'ï' | 'ð' | 'ñ' | 'ò' | 'ó') pnCharsU))+> */
-		nil,
-		/* 97 pnChars <- <((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('-') '-') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | 'Ã' | 'Ä' | 'Å' | 'Æ' | 'Ç' | 'È' | 'É' | 'Ê' | 'Ë' | 'Í' | 'Î' | 'Ï' | 'Ð' | 'Ñ' | 'Ò' | 'Ó' | 'Ô' | 'Õ' | 'Ö' | '×' | 'Ø' | 'Ù' | 'Ú' | 'Û' | 'Ü' | 'Ý' | 'Þ' | 'ß' | 'à' | 'á' | 'â' | 'ã' | 'ä' | 'å' | 'æ' | 'ç' | 'è' | 'é' | 'ê' | 'ë' | 'ì' | 'í' | 'ï' | 'ð' | 'ñ' | 'ò' | 'ó') pnCharsU))> */
+		/* 112 pnLocal <- <((pnCharsU / ':' / [0-9] / plx) ('.'* (pnChars / ':' / plx))*)> */
 		nil,
-		/* 98 pnCharsU <- <(pnCharsBase / '_')> */
+		/* 113 pnChars <- <(pnCharsU / '-' / [0-9] / '·' / [̀-ͯ] / [‿-⁀])> */
+		func() bool {
+			position1947, tokenIndex1947, depth1947 := position, tokenIndex, depth
+			{
+				position1948 := position
+				depth++
+				{
+					position1949, tokenIndex1949, depth1949 := position, tokenIndex, depth
+					if !_rules[rulepnCharsU]() {
+						goto l1950
+					}
+					goto l1949
+				l1950:
+					position, tokenIndex, depth = position1949, tokenIndex1949, depth1949
+					if buffer[position] != rune('-') {
+						goto l1951
+					}
+					position++
+					goto l1949
+				l1951:
+					position, tokenIndex, depth = position1949, tokenIndex1949, depth1949
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1952
+					}
+					position++
+					goto l1949
+				l1952:
+					position, tokenIndex, depth = position1949, tokenIndex1949, depth1949
+					if buffer[position] != rune('·') {
+						goto l1953
+					}
+					position++
+					goto l1949
+				l1953:
+					position, tokenIndex, depth = position1949, tokenIndex1949, depth1949
+					if c := buffer[position]; c < rune('̀') || c > rune('ͯ') {
+						goto l1954
+					}
+					position++
+					goto l1949
+				l1954:
+					position, tokenIndex, depth = position1949, tokenIndex1949, depth1949
+					if c := buffer[position]; c < rune('‿') || c > rune('⁀') {
+						goto l1947
+					}
+					position++
+				}
+			l1949:
+				depth--
+				add(rulepnChars, position1948)
+			}
+			return true
+		l1947:
+			position, tokenIndex, depth = position1947, tokenIndex1947, depth1947
+			return false
+		},
+		/* 114 pnCharsU <- <(pnCharsBase / '_')> */
 		func() bool {
-			position1734, tokenIndex1734, depth1734 := position, tokenIndex, depth
+			position1955, tokenIndex1955, depth1955 := position, tokenIndex, depth
 			{
-				position1735 := position
+				position1956 := position
 				depth++
 				{
-					position1736, tokenIndex1736, depth1736 := position, tokenIndex, depth
-					if !rules[rulepnCharsBase]() {
-						goto l1737
+					position1957, tokenIndex1957, depth1957 := position, tokenIndex, depth
+					if !_rules[rulepnCharsBase]() {
+						goto l1958
 					}
-					goto l1736
-				l1737:
-					position, tokenIndex, depth = position1736, tokenIndex1736, depth1736
+					goto l1957
+				l1958:
+					position, tokenIndex, depth = position1957, tokenIndex1957, depth1957
 					if buffer[position] != rune('_') {
-						goto l1734
+						goto l1955
 					}
 					position++
 				}
-			l1736:
+			l1957:
 				depth--
-				add(rulepnCharsU, position1735)
+				add(rulepnCharsU, position1956)
 			}
 			return true
-		l1734:
-			position, tokenIndex, depth = position1734, tokenIndex1734, depth1734
+		l1955:
+			position, tokenIndex, depth = position1955, tokenIndex1955, depth1955
 			return false
 		},
-		/* 99 pnCharsBase <- <([À-Ö] / [Ø-ö] / [Ͱ-ͽ] / [‌-‍] / [⁰-↏] / [豈-﷏] / ((&('ð' | 'ñ' | 'ò' | 'ó') [𐀀-󯿿]) | (&('ï') [ﷰ-�]) | (&('ã' | 'ä' | 'å' | 'æ' | 'ç' | 'è' | 'é' | 'ê' | 'ë' | 'ì' | 'í') [、-퟿]) | (&('â') [Ⰰ-⿯]) | (&('Í' | 'Î' | 'Ï' | 'Ð' | 'Ñ' | 'Ò' | 'Ó' | 'Ô' | 'Õ' | 'Ö' | '×' | 'Ø' | 'Ù' | 'Ú' | 'Û' | 'Ü' | 'Ý' | 'Þ' | 'ß' | 'à' | 'á') [Ϳ-῿]) | (&('Ã' | 'Ä' | 'Å' | 'Æ' | 'Ç' | 'È' | 'É' | 'Ê' | 'Ë') [ø-˿]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])))> */
+		/* 115 pnCharsBase <- <([a-z] / [A-Z] / [À-Ö] / [Ø-ö] / [ø-˿] / [Ͱ-ͽ] / [Ϳ-\u1fff] / [\u200c-\u200d] / [⁰-\u218f] / [Ⰰ-\u2fef] / [、-\ud7ff] / [豈-﷏] / [ﷰ-�] / [𐀀-\U000effff])> */
 		func() bool {
-			position1738, tokenIndex1738, depth1738 := position, tokenIndex, depth
+			position1959, tokenIndex1959, depth1959 := position, tokenIndex, depth
 			{
-				position1739 := position
+				position1960 := position
 				depth++
 				{
-					position1740, tokenIndex1740, depth1740 := position, tokenIndex, depth
+					position1961, tokenIndex1961, depth1961 := position, tokenIndex, depth
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l1962
+					}
+					position++
+					goto l1961
+				l1962:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l1963
+					}
+					position++
+					goto l1961
+				l1963:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
 					if c := buffer[position]; c < rune('À') || c > rune('Ö') {
-						goto l1741
+						goto l1964
 					}
 					position++
-					goto l1740
-				l1741:
-					position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
+					goto l1961
+				l1964:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
 					if c := buffer[position]; c < rune('Ø') || c > rune('ö') {
-						goto l1742
+						goto l1965
 					}
 					position++
-					goto l1740
-				l1742:
-					position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
+					goto l1961
+				l1965:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+					if c := buffer[position]; c < rune('ø') || c > rune('˿') {
+						goto l1966
+					}
+					position++
+					goto l1961
+				l1966:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
 					if c := buffer[position]; c < rune('Ͱ') || c > rune('ͽ') {
-						goto l1743
+						goto l1967
+					}
+					position++
+					goto l1961
+				l1967:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+					if c := buffer[position]; c < rune('Ϳ') || c > rune('\u1fff') {
+						goto l1968
 					}
 					position++
-					goto l1740
-				l1743:
-					position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
+					goto l1961
+				l1968:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
 					if c := buffer[position]; c < rune('\u200c') || c > rune('\u200d') {
-						goto l1744
+						goto l1969
 					}
 					position++
-					goto l1740
-				l1744:
-					position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
+					goto l1961
+				l1969:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
 					if c := buffer[position]; c < rune('⁰') || c > rune('\u218f') {
-						goto l1745
+						goto l1970
+					}
+					position++
+					goto l1961
+				l1970:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+					if c := buffer[position]; c < rune('Ⰰ') || c > rune('\u2fef') {
+						goto l1971
+					}
+					position++
+					goto l1961
+				l1971:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+					if c := buffer[position]; c < rune('、') || c > rune('\ud7ff') {
+						goto l1972
 					}
 					position++
-					goto l1740
-				l1745:
-					position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
-					if c := buffer[position]; c < rune('豈') || c > rune('\ufdcf') {
-						goto l1746
+					goto l1961
+				l1972:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+					if c := buffer[position]; c < rune('豈') || c > rune('﷏') {
+						goto l1973
 					}
 					position++
-					goto l1740
-				l1746:
-					position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
+					goto l1961
+				l1973:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+					if c := buffer[position]; c < rune('ﷰ') || c > rune('�') {
+						goto l1974
+					}
+					position++
+					goto l1961
+				l1974:
+					position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+					if c := buffer[position]; c < rune('𐀀') || c > rune('\U000effff') {
+						goto l1959
+					}
+					position++
+				}
+			l1961:
+				depth--
+				add(rulepnCharsBase, position1960)
+			}
+			return true
+		l1959:
+			position, tokenIndex, depth = position1959, tokenIndex1959, depth1959
+			return false
+		},
+		/* 116 plx <- <(percent / pnLocalEsc)> */
+		func() bool {
+			position1975, tokenIndex1975, depth1975 := position, tokenIndex, depth
+			{
+				position1976 := position
+				depth++
+				{
+					position1977, tokenIndex1977, depth1977 := position, tokenIndex, depth
+					{
+						position1979 := position
+						depth++
+						if buffer[position] != rune('%') {
+							goto l1978
+						}
+						position++
+						if !_rules[rulehex]() {
+							goto l1978
+						}
+						if !_rules[rulehex]() {
+							goto l1978
+						}
+						depth--
+						add(rulepercent, position1979)
+					}
+					goto l1977
+				l1978:
+					position, tokenIndex, depth = position1977, tokenIndex1977, depth1977
 					{
-						switch buffer[position] {
-						case 'ð', 'ñ', 'ò', 'ó':
-							if c := buffer[position]; c < rune('𐀀') || c > rune('\U000effff') {
-								goto l1738
+						position1980 := position
+						depth++
+						if buffer[position] != rune('\\') {
+							goto l1975
+						}
+						position++
+						{
+							position1981, tokenIndex1981, depth1981 := position, tokenIndex, depth
+							if buffer[position] != rune('_') {
+								goto l1982
+							}
+							position++
+							goto l1981
+						l1982:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('~') {
+								goto l1983
 							}
 							position++
-							break
-						case 'ï':
-							if c := buffer[position]; c < rune('ﷰ') || c > rune('�') {
-								goto l1738
+							goto l1981
+						l1983:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('.') {
+								goto l1984
 							}
 							position++
-							break
-						case 'ã', 'ä', 'å', 'æ', 'ç', 'è', 'é', 'ê', 'ë', 'ì', 'í':
-							if c := buffer[position]; c < rune('、') || c > rune('\ud7ff') {
-								goto l1738
+							goto l1981
+						l1984:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('-') {
+								goto l1985
 							}
 							position++
-							break
-						case 'â':
-							if c := buffer[position]; c < rune('Ⰰ') || c > rune('\u2fef') {
-								goto l1738
+							goto l1981
+						l1985:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('!') {
+								goto l1986
 							}
 							position++
-							break
-						case 'Í', 'Î', 'Ï', 'Ð', 'Ñ', 'Ò', 'Ó', 'Ô', 'Õ', 'Ö', '×', 'Ø', 'Ù', 'Ú', 'Û', 'Ü', 'Ý', 'Þ', 'ß', 'à', 'á':
-							if c := buffer[position]; c < rune('\u037f') || c > rune('\u1fff') {
-								goto l1738
+							goto l1981
+						l1986:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('$') {
+								goto l1987
 							}
 							position++
-							break
-						case 'Ã', 'Ä', 'Å', 'Æ', 'Ç', 'È', 'É', 'Ê', 'Ë':
-							if c := buffer[position]; c < rune('ø') || c > rune('˿') {
-								goto l1738
+							goto l1981
+						l1987:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('&') {
+								goto l1988
 							}
 							position++
-							break
-						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1738
+							goto l1981
+						l1988:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('\'') {
+								goto l1989
 							}
 							position++
-							break
-						default:
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1738
+							goto l1981
+						l1989:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('(') {
+								goto l1990
+							}
+							position++
+							goto l1981
+						l1990:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune(')') {
+								goto l1991
+							}
+							position++
+							goto l1981
+						l1991:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('*') {
+								goto l1992
+							}
+							position++
+							goto l1981
+						l1992:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('+') {
+								goto l1993
+							}
+							position++
+							goto l1981
+						l1993:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune(',') {
+								goto l1994
+							}
+							position++
+							goto l1981
+						l1994:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune(';') {
+								goto l1995
+							}
+							position++
+							goto l1981
+						l1995:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('=') {
+								goto l1996
+							}
+							position++
+							goto l1981
+						l1996:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('/') {
+								goto l1997
+							}
+							position++
+							goto l1981
+						l1997:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('?') {
+								goto l1998
+							}
+							position++
+							goto l1981
+						l1998:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('#') {
+								goto l1999
+							}
+							position++
+							goto l1981
+						l1999:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('@') {
+								goto l2000
+							}
+							position++
+							goto l1981
+						l2000:
+							position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+							if buffer[position] != rune('%') {
+								goto l1975
 							}
 							position++
-							break
 						}
+					l1981:
+						depth--
+						add(rulepnLocalEsc, position1980)
 					}
-
 				}
-			l1740:
+			l1977:
 				depth--
-				add(rulepnCharsBase, position1739)
+				add(ruleplx, position1976)
 			}
 			return true
-		l1738:
-			position, tokenIndex, depth = position1738, tokenIndex1738, depth1738
+		l1975:
+			position, tokenIndex, depth = position1975, tokenIndex1975, depth1975
 			return false
 		},
-		/* 100 plx <- <(percent / pnLocalEsc)> */
+		/* 117 percent <- <('%' hex hex)> */
 		nil,
-		/* 101 percent <- <('%' hex hex)> */
-		nil,
-		/* 102 hex <- <((&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]))> */
+		/* 118 hex <- <([0-9] / [a-f] / [A-F])> */
 		func() bool {
-			position1750, tokenIndex1750, depth1750 := position, tokenIndex, depth
+			position2002, tokenIndex2002, depth2002 := position, tokenIndex, depth
 			{
-				position1751 := position
+				position2003 := position
 				depth++
 				{
-					switch buffer[position] {
-					case 'a', 'b', 'c', 'd', 'e', 'f':
-						if c := buffer[position]; c < rune('a') || c > rune('f') {
-							goto l1750
-						}
-						position++
-						break
-					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1750
-						}
-						position++
-						break
-					default:
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1750
-						}
-						position++
-						break
+					position2004, tokenIndex2004, depth2004 := position, tokenIndex, depth
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2005
+					}
+					position++
+					goto l2004
+				l2005:
+					position, tokenIndex, depth = position2004, tokenIndex2004, depth2004
+					if c := buffer[position]; c < rune('a') || c > rune('f') {
+						goto l2006
+					}
+					position++
+					goto l2004
+				l2006:
+					position, tokenIndex, depth = position2004, tokenIndex2004, depth2004
+					if c := buffer[position]; c < rune('A') || c > rune('F') {
+						goto l2002
 					}
+					position++
 				}
-
+			l2004:
 				depth--
-				add(rulehex, position1751)
+				add(rulehex, position2003)
 			}
 			return true
-		l1750:
-			position, tokenIndex, depth = position1750, tokenIndex1750, depth1750
+		l2002:
+			position, tokenIndex, depth = position2002, tokenIndex2002, depth2002
 			return false
 		},
-		/* 103 pnLocalEsc <- <('\\' ((&('%') '%') | (&('@') '@') | (&('#') '#') | (&('?') '?') | (&('/') '/') | (&('=') '=') | (&(';') ';') | (&(',') ',') | (&('+') '+') | (&('*') '*') | (&(')') ')') | (&('(') '(') | (&('\'') '\'') | (&('&') '&') | (&('$') '$') | (&('!') '!') | (&('-') '-') | (&('.') '.') | (&('~') '~') | (&('_') '_')))> */
+		/* 119 pnLocalEsc <- <('\\' ('_' / '~' / '.' / '-' / '!' / '$' / '&' / '\'' / '(' / ')' / '*' / '+' / ',' / ';' / '=' / '/' / '?' / '#' / '@' / '%'))> */
 		nil,
-		/* 104 PREFIX <- <(('p' / 'P') ('r' / 'R') ('e' / 'E') ('f' / 'F') ('i' / 'I') ('x' / 'X') skip)> */
+		/* 120 PREFIX <- <(('p' / 'P') ('r' / 'R') ('e' / 'E') ('f' / 'F') ('i' / 'I') ('x' / 'X') skip)> */
 		nil,
-		/* 105 TRUE <- <(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E') skip)> */
+		/* 121 TRUE <- <(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E') skip)> */
 		nil,
-		/* 106 FALSE <- <(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E') skip)> */
+		/* 122 FALSE <- <(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E') skip)> */
 		nil,
-		/* 107 BASE <- <(('b' / 'B') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
+		/* 123 BASE <- <(('b' / 'B') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
 		nil,
-		/* 108 SELECT <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') skip)> */
+		/* 124 SELECT <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') skip)> */
 		nil,
-		/* 109 REDUCED <- <(('r' / 'R') ('e' / 'E') ('d' / 'D') ('u' / 'U') ('c' / 'C') ('e' / 'E') ('d' / 'D') skip)> */
+		/* 125 REDUCED <- <(('r' / 'R') ('e' / 'E') ('d' / 'D') ('u' / 'U') ('c' / 'C') ('e' / 'E') ('d' / 'D') skip)> */
 		nil,
-		/* 110 DISTINCT <- <(('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T') skip)> */
+		/* 126 DISTINCT <- <(('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T') skip)> */
 		func() bool {
-			position1760, tokenIndex1760, depth1760 := position, tokenIndex, depth
+			position2014, tokenIndex2014, depth2014 := position, tokenIndex, depth
 			{
-				position1761 := position
+				position2015 := position
 				depth++
 				{
-					position1762, tokenIndex1762, depth1762 := position, tokenIndex, depth
+					position2016, tokenIndex2016, depth2016 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l1763
+						goto l2017
 					}
 					position++
-					goto l1762
-				l1763:
-					position, tokenIndex, depth = position1762, tokenIndex1762, depth1762
+					goto l2016
+				l2017:
+					position, tokenIndex, depth = position2016, tokenIndex2016, depth2016
 					if buffer[position] != rune('D') {
-						goto l1760
+						goto l2014
 					}
 					position++
 				}
-			l1762:
+			l2016:
 				{
-					position1764, tokenIndex1764, depth1764 := position, tokenIndex, depth
+					position2018, tokenIndex2018, depth2018 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l1765
+						goto l2019
 					}
 					position++
-					goto l1764
-				l1765:
-					position, tokenIndex, depth = position1764, tokenIndex1764, depth1764
+					goto l2018
+				l2019:
+					position, tokenIndex, depth = position2018, tokenIndex2018, depth2018
 					if buffer[position] != rune('I') {
-						goto l1760
+						goto l2014
 					}
 					position++
 				}
-			l1764:
+			l2018:
 				{
-					position1766, tokenIndex1766, depth1766 := position, tokenIndex, depth
+					position2020, tokenIndex2020, depth2020 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l1767
+						goto l2021
 					}
 					position++
-					goto l1766
-				l1767:
-					position, tokenIndex, depth = position1766, tokenIndex1766, depth1766
+					goto l2020
+				l2021:
+					position, tokenIndex, depth = position2020, tokenIndex2020, depth2020
 					if buffer[position] != rune('S') {
-						goto l1760
+						goto l2014
 					}
 					position++
 				}
-			l1766:
+			l2020:
 				{
-					position1768, tokenIndex1768, depth1768 := position, tokenIndex, depth
+					position2022, tokenIndex2022, depth2022 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l1769
+						goto l2023
 					}
 					position++
-					goto l1768
-				l1769:
-					position, tokenIndex, depth = position1768, tokenIndex1768, depth1768
+					goto l2022
+				l2023:
+					position, tokenIndex, depth = position2022, tokenIndex2022, depth2022
 					if buffer[position] != rune('T') {
-						goto l1760
+						goto l2014
 					}
 					position++
 				}
-			l1768:
+			l2022:
 				{
-					position1770, tokenIndex1770, depth1770 := position, tokenIndex, depth
+					position2024, tokenIndex2024, depth2024 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l1771
+						goto l2025
 					}
 					position++
-					goto l1770
-				l1771:
-					position, tokenIndex, depth = position1770, tokenIndex1770, depth1770
+					goto l2024
+				l2025:
+					position, tokenIndex, depth = position2024, tokenIndex2024, depth2024
 					if buffer[position] != rune('I') {
-						goto l1760
+						goto l2014
 					}
 					position++
 				}
-			l1770:
+			l2024:
 				{
-					position1772, tokenIndex1772, depth1772 := position, tokenIndex, depth
+					position2026, tokenIndex2026, depth2026 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l1773
+						goto l2027
 					}
 					position++
-					goto l1772
-				l1773:
-					position, tokenIndex, depth = position1772, tokenIndex1772, depth1772
+					goto l2026
+				l2027:
+					position, tokenIndex, depth = position2026, tokenIndex2026, depth2026
 					if buffer[position] != rune('N') {
-						goto l1760
+						goto l2014
 					}
 					position++
 				}
-			l1772:
+			l2026:
 				{
-					position1774, tokenIndex1774, depth1774 := position, tokenIndex, depth
+					position2028, tokenIndex2028, depth2028 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l1775
+						goto l2029
 					}
 					position++
-					goto l1774
-				l1775:
-					position, tokenIndex, depth = position1774, tokenIndex1774, depth1774
+					goto l2028
+				l2029:
+					position, tokenIndex, depth = position2028, tokenIndex2028, depth2028
 					if buffer[position] != rune('C') {
-						goto l1760
+						goto l2014
 					}
 					position++
 				}
-			l1774:
+			l2028:
 				{
-					position1776, tokenIndex1776, depth1776 := position, tokenIndex, depth
+					position2030, tokenIndex2030, depth2030 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l1777
+						goto l2031
 					}
 					position++
-					goto l1776
-				l1777:
-					position, tokenIndex, depth = position1776, tokenIndex1776, depth1776
+					goto l2030
+				l2031:
+					position, tokenIndex, depth = position2030, tokenIndex2030, depth2030
 					if buffer[position] != rune('T') {
-						goto l1760
+						goto l2014
 					}
 					position++
 				}
-			l1776:
-				if !rules[ruleskip]() {
-					goto l1760
+			l2030:
+				if !_rules[ruleskip]() {
+					goto l2014
 				}
 				depth--
-				add(ruleDISTINCT, position1761)
+				add(ruleDISTINCT, position2015)
 			}
 			return true
-		l1760:
-			position, tokenIndex, depth = position1760, tokenIndex1760, depth1760
+		l2014:
+			position, tokenIndex, depth = position2014, tokenIndex2014, depth2014
 			return false
 		},
-		/* 111 FROM <- <(('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M') skip)> */
+		/* 127 FROM <- <(('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M') skip)> */
 		nil,
-		/* 112 NAMED <- <(('n' / 'N') ('a' / 'A') ('m' / 'M') ('e' / 'E') ('d' / 'D') skip)> */
+		/* 128 NAMED <- <(('n' / 'N') ('a' / 'A') ('m' / 'M') ('e' / 'E') ('d' / 'D') skip)> */
 		nil,
-		/* 113 WHERE <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E') skip)> */
+		/* 129 WHERE <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E') skip)> */
 		nil,
-		/* 114 LBRACE <- <('{' skip)> */
+		/* 130 LBRACE <- <('{' skip)> */
 		func() bool {
-			position1781, tokenIndex1781, depth1781 := position, tokenIndex, depth
+			position2035, tokenIndex2035, depth2035 := position, tokenIndex, depth
 			{
-				position1782 := position
+				position2036 := position
 				depth++
 				if buffer[position] != rune('{') {
-					goto l1781
+					goto l2035
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1781
+				if !_rules[ruleskip]() {
+					goto l2035
 				}
 				depth--
-				add(ruleLBRACE, position1782)
+				add(ruleLBRACE, position2036)
 			}
 			return true
-		l1781:
-			position, tokenIndex, depth = position1781, tokenIndex1781, depth1781
+		l2035:
+			position, tokenIndex, depth = position2035, tokenIndex2035, depth2035
 			return false
 		},
-		/* 115 RBRACE <- <('}' skip)> */
+		/* 131 RBRACE <- <('}' skip)> */
 		func() bool {
-			position1783, tokenIndex1783, depth1783 := position, tokenIndex, depth
+			position2037, tokenIndex2037, depth2037 := position, tokenIndex, depth
 			{
-				position1784 := position
+				position2038 := position
 				depth++
 				if buffer[position] != rune('}') {
-					goto l1783
+					goto l2037
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1783
+				if !_rules[ruleskip]() {
+					goto l2037
 				}
 				depth--
-				add(ruleRBRACE, position1784)
+				add(ruleRBRACE, position2038)
 			}
 			return true
-		l1783:
-			position, tokenIndex, depth = position1783, tokenIndex1783, depth1783
+		l2037:
+			position, tokenIndex, depth = position2037, tokenIndex2037, depth2037
 			return false
 		},
-		/* 116 LBRACK <- <('[' skip)> */
+		/* 132 LBRACK <- <('[' skip)> */
 		nil,
-		/* 117 RBRACK <- <(']' skip)> */
+		/* 133 RBRACK <- <(']' skip)> */
 		nil,
-		/* 118 SEMICOLON <- <(';' skip)> */
+		/* 134 SEMICOLON <- <(';' skip)> */
 		func() bool {
-			position1787, tokenIndex1787, depth1787 := position, tokenIndex, depth
+			position2041, tokenIndex2041, depth2041 := position, tokenIndex, depth
 			{
-				position1788 := position
+				position2042 := position
 				depth++
 				if buffer[position] != rune(';') {
-					goto l1787
+					goto l2041
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1787
+				if !_rules[ruleskip]() {
+					goto l2041
 				}
 				depth--
-				add(ruleSEMICOLON, position1788)
+				add(ruleSEMICOLON, position2042)
 			}
 			return true
-		l1787:
-			position, tokenIndex, depth = position1787, tokenIndex1787, depth1787
+		l2041:
+			position, tokenIndex, depth = position2041, tokenIndex2041, depth2041
 			return false
 		},
-		/* 119 COMMA <- <(',' skip)> */
+		/* 135 COMMA <- <(',' skip)> */
 		func() bool {
-			position1789, tokenIndex1789, depth1789 := position, tokenIndex, depth
+			position2043, tokenIndex2043, depth2043 := position, tokenIndex, depth
 			{
-				position1790 := position
+				position2044 := position
 				depth++
 				if buffer[position] != rune(',') {
-					goto l1789
+					goto l2043
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1789
+				if !_rules[ruleskip]() {
+					goto l2043
 				}
 				depth--
-				add(ruleCOMMA, position1790)
+				add(ruleCOMMA, position2044)
 			}
 			return true
-		l1789:
-			position, tokenIndex, depth = position1789, tokenIndex1789, depth1789
+		l2043:
+			position, tokenIndex, depth = position2043, tokenIndex2043, depth2043
 			return false
 		},
-		/* 120 DOT <- <('.' skip)> */
+		/* 136 DOT <- <('.' skip)> */
 		func() bool {
-			position1791, tokenIndex1791, depth1791 := position, tokenIndex, depth
+			position2045, tokenIndex2045, depth2045 := position, tokenIndex, depth
 			{
-				position1792 := position
+				position2046 := position
 				depth++
 				if buffer[position] != rune('.') {
-					goto l1791
+					goto l2045
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1791
+				if !_rules[ruleskip]() {
+					goto l2045
 				}
 				depth--
-				add(ruleDOT, position1792)
+				add(ruleDOT, position2046)
 			}
 			return true
-		l1791:
-			position, tokenIndex, depth = position1791, tokenIndex1791, depth1791
+		l2045:
+			position, tokenIndex, depth = position2045, tokenIndex2045, depth2045
 			return false
 		},
-		/* 121 COLON <- <(':' skip)> */
+		/* 137 COLON <- <(':' skip)> */
 		nil,
-		/* 122 PIPE <- <('|' skip)> */
+		/* 138 PIPE <- <('|' skip)> */
 		func() bool {
-			position1794, tokenIndex1794, depth1794 := position, tokenIndex, depth
+			position2048, tokenIndex2048, depth2048 := position, tokenIndex, depth
 			{
-				position1795 := position
+				position2049 := position
 				depth++
 				if buffer[position] != rune('|') {
-					goto l1794
+					goto l2048
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1794
+				if !_rules[ruleskip]() {
+					goto l2048
 				}
 				depth--
-				add(rulePIPE, position1795)
+				add(rulePIPE, position2049)
 			}
 			return true
-		l1794:
-			position, tokenIndex, depth = position1794, tokenIndex1794, depth1794
+		l2048:
+			position, tokenIndex, depth = position2048, tokenIndex2048, depth2048
 			return false
 		},
-		/* 123 SLASH <- <('/' skip)> */
+		/* 139 SLASH <- <('/' skip)> */
 		func() bool {
-			position1796, tokenIndex1796, depth1796 := position, tokenIndex, depth
+			position2050, tokenIndex2050, depth2050 := position, tokenIndex, depth
 			{
-				position1797 := position
+				position2051 := position
 				depth++
 				if buffer[position] != rune('/') {
-					goto l1796
+					goto l2050
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1796
+				if !_rules[ruleskip]() {
+					goto l2050
 				}
 				depth--
-				add(ruleSLASH, position1797)
+				add(ruleSLASH, position2051)
 			}
 			return true
-		l1796:
-			position, tokenIndex, depth = position1796, tokenIndex1796, depth1796
+		l2050:
+			position, tokenIndex, depth = position2050, tokenIndex2050, depth2050
 			return false
 		},
-		/* 124 INVERSE <- <('^' skip)> */
+		/* 140 INVERSE <- <('^' skip)> */
 		func() bool {
-			position1798, tokenIndex1798, depth1798 := position, tokenIndex, depth
+			position2052, tokenIndex2052, depth2052 := position, tokenIndex, depth
 			{
-				position1799 := position
+				position2053 := position
 				depth++
 				if buffer[position] != rune('^') {
-					goto l1798
+					goto l2052
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1798
+				if !_rules[ruleskip]() {
+					goto l2052
 				}
 				depth--
-				add(ruleINVERSE, position1799)
+				add(ruleINVERSE, position2053)
 			}
 			return true
-		l1798:
-			position, tokenIndex, depth = position1798, tokenIndex1798, depth1798
+		l2052:
+			position, tokenIndex, depth = position2052, tokenIndex2052, depth2052
 			return false
 		},
-		/* 125 LPAREN <- <('(' skip)> */
+		/* 141 LPAREN <- <('(' skip)> */
 		func() bool {
-			position1800, tokenIndex1800, depth1800 := position, tokenIndex, depth
+			position2054, tokenIndex2054, depth2054 := position, tokenIndex, depth
 			{
-				position1801 := position
+				position2055 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l1800
+					goto l2054
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1800
+				if !_rules[ruleskip]() {
+					goto l2054
 				}
 				depth--
-				add(ruleLPAREN, position1801)
+				add(ruleLPAREN, position2055)
 			}
 			return true
-		l1800:
-			position, tokenIndex, depth = position1800, tokenIndex1800, depth1800
+		l2054:
+			position, tokenIndex, depth = position2054, tokenIndex2054, depth2054
 			return false
 		},
-		/* 126 RPAREN <- <(')' skip)> */
+		/* 142 RPAREN <- <(')' skip)> */
 		func() bool {
-			position1802, tokenIndex1802, depth1802 := position, tokenIndex, depth
+			position2056, tokenIndex2056, depth2056 := position, tokenIndex, depth
 			{
-				position1803 := position
+				position2057 := position
 				depth++
 				if buffer[position] != rune(')') {
-					goto l1802
+					goto l2056
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1802
+				if !_rules[ruleskip]() {
+					goto l2056
 				}
 				depth--
-				add(ruleRPAREN, position1803)
+				add(ruleRPAREN, position2057)
 			}
 			return true
-		l1802:
-			position, tokenIndex, depth = position1802, tokenIndex1802, depth1802
+		l2056:
+			position, tokenIndex, depth = position2056, tokenIndex2056, depth2056
 			return false
 		},
-		/* 127 ISA <- <('a' skip)> */
+		/* 143 ISA <- <('a' skip)> */
 		func() bool {
-			position1804, tokenIndex1804, depth1804 := position, tokenIndex, depth
+			position2058, tokenIndex2058, depth2058 := position, tokenIndex, depth
 			{
-				position1805 := position
+				position2059 := position
 				depth++
 				if buffer[position] != rune('a') {
-					goto l1804
+					goto l2058
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1804
+				if !_rules[ruleskip]() {
+					goto l2058
 				}
 				depth--
-				add(ruleISA, position1805)
+				add(ruleISA, position2059)
 			}
 			return true
-		l1804:
-			position, tokenIndex, depth = position1804, tokenIndex1804, depth1804
+		l2058:
+			position, tokenIndex, depth = position2058, tokenIndex2058, depth2058
 			return false
 		},
-		/* 128 NOT <- <('!' skip)> */
+		/* 144 NOT <- <('!' skip)> */
 		func() bool {
-			position1806, tokenIndex1806, depth1806 := position, tokenIndex, depth
+			position2060, tokenIndex2060, depth2060 := position, tokenIndex, depth
 			{
-				position1807 := position
+				position2061 := position
 				depth++
 				if buffer[position] != rune('!') {
-					goto l1806
+					goto l2060
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1806
+				if !_rules[ruleskip]() {
+					goto l2060
 				}
 				depth--
-				add(ruleNOT, position1807)
+				add(ruleNOT, position2061)
 			}
 			return true
-		l1806:
-			position, tokenIndex, depth = position1806, tokenIndex1806, depth1806
+		l2060:
+			position, tokenIndex, depth = position2060, tokenIndex2060, depth2060
 			return false
 		},
-		/* 129 STAR <- <('*' skip)> */
+		/* 145 STAR <- <('*' skip)> */
 		func() bool {
-			position1808, tokenIndex1808, depth1808 := position, tokenIndex, depth
+			position2062, tokenIndex2062, depth2062 := position, tokenIndex, depth
 			{
-				position1809 := position
+				position2063 := position
 				depth++
 				if buffer[position] != rune('*') {
-					goto l1808
+					goto l2062
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1808
+				if !_rules[ruleskip]() {
+					goto l2062
 				}
 				depth--
-				add(ruleSTAR, position1809)
+				add(ruleSTAR, position2063)
 			}
 			return true
-		l1808:
-			position, tokenIndex, depth = position1808, tokenIndex1808, depth1808
+		l2062:
+			position, tokenIndex, depth = position2062, tokenIndex2062, depth2062
 			return false
 		},
-		/* 130 QUESTION <- <('?' skip)> */
+		/* 146 QUESTION <- <('?' skip)> */
 		nil,
-		/* 131 PLUS <- <('+' skip)> */
+		/* 147 PLUS <- <('+' skip)> */
 		func() bool {
-			position1811, tokenIndex1811, depth1811 := position, tokenIndex, depth
+			position2065, tokenIndex2065, depth2065 := position, tokenIndex, depth
 			{
-				position1812 := position
+				position2066 := position
 				depth++
 				if buffer[position] != rune('+') {
-					goto l1811
+					goto l2065
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1811
+				if !_rules[ruleskip]() {
+					goto l2065
 				}
 				depth--
-				add(rulePLUS, position1812)
+				add(rulePLUS, position2066)
 			}
 			return true
-		l1811:
-			position, tokenIndex, depth = position1811, tokenIndex1811, depth1811
+		l2065:
+			position, tokenIndex, depth = position2065, tokenIndex2065, depth2065
 			return false
 		},
-		/* 132 MINUS <- <('-' skip)> */
+		/* 148 MINUS <- <('-' skip)> */
 		func() bool {
-			position1813, tokenIndex1813, depth1813 := position, tokenIndex, depth
+			position2067, tokenIndex2067, depth2067 := position, tokenIndex, depth
 			{
-				position1814 := position
+				position2068 := position
 				depth++
 				if buffer[position] != rune('-') {
-					goto l1813
+					goto l2067
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1813
+				if !_rules[ruleskip]() {
+					goto l2067
 				}
 				depth--
-				add(ruleMINUS, position1814)
+				add(ruleMINUS, position2068)
 			}
 			return true
-		l1813:
-			position, tokenIndex, depth = position1813, tokenIndex1813, depth1813
+		l2067:
+			position, tokenIndex, depth = position2067, tokenIndex2067, depth2067
 			return false
 		},
-		/* 133 OPTIONAL <- <(('o' / 'O') ('p' / 'P') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') ('a' / 'A') ('l' / 'L') skip)> */
+		/* 149 OPTIONAL <- <(('o' / 'O') ('p' / 'P') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') ('a' / 'A') ('l' / 'L') skip)> */
 		nil,
-		/* 134 UNION <- <(('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N') skip)> */
+		/* 150 UNION <- <(('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N') skip)> */
 		nil,
-		/* 135 LIMIT <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') skip)> */
+		/* 151 LIMIT <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') skip)> */
 		nil,
-		/* 136 OFFSET <- <(('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T') skip)> */
+		/* 152 OFFSET <- <(('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T') skip)> */
 		nil,
-		/* 137 INTEGER <- <([0-9]+ skip)> */
+		/* 153 INTEGER <- <([0-9]+ skip)> */
 		func() bool {
-			position1819, tokenIndex1819, depth1819 := position, tokenIndex, depth
+			position2073, tokenIndex2073, depth2073 := position, tokenIndex, depth
 			{
-				position1820 := position
+				position2074 := position
 				depth++
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l1819
+					goto l2073
 				}
 				position++
-			l1821:
+			l2075:
 				{
-					position1822, tokenIndex1822, depth1822 := position, tokenIndex, depth
+					position2076, tokenIndex2076, depth2076 := position, tokenIndex, depth
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1822
+						goto l2076
 					}
 					position++
-					goto l1821
-				l1822:
-					position, tokenIndex, depth = position1822, tokenIndex1822, depth1822
+					goto l2075
+				l2076:
+					position, tokenIndex, depth = position2076, tokenIndex2076, depth2076
 				}
-				if !rules[ruleskip]() {
-					goto l1819
+				if !_rules[ruleskip]() {
+					goto l2073
 				}
 				depth--
-				add(ruleINTEGER, position1820)
+				add(ruleINTEGER, position2074)
 			}
 			return true
-		l1819:
-			position, tokenIndex, depth = position1819, tokenIndex1819, depth1819
+		l2073:
+			position, tokenIndex, depth = position2073, tokenIndex2073, depth2073
 			return false
 		},
-		/* 138 CONSTRUCT <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('u' / 'U') ('c' / 'C') ('t' / 'T') skip)> */
+		/* 154 CONSTRUCT <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('u' / 'U') ('c' / 'C') ('t' / 'T') skip)> */
 		nil,
-		/* 139 DESCRIBE <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('e' / 'E') skip)> */
+		/* 155 DESCRIBE <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('e' / 'E') skip)> */
 		nil,
-		/* 140 ASK <- <(('a' / 'A') ('s' / 'S') ('k' / 'K') skip)> */
+		/* 156 ASK <- <(('a' / 'A') ('s' / 'S') ('k' / 'K') skip)> */
 		nil,
-		/* 141 OR <- <('|' '|' skip)> */
+		/* 157 OR <- <('|' '|' skip)> */
 		nil,
-		/* 142 AND <- <('&' '&' skip)> */
+		/* 158 AND <- <('&' '&' skip)> */
 		nil,
-		/* 143 EQ <- <('=' skip)> */
+		/* 159 EQ <- <('=' skip)> */
 		func() bool {
-			position1828, tokenIndex1828, depth1828 := position, tokenIndex, depth
+			position2082, tokenIndex2082, depth2082 := position, tokenIndex, depth
 			{
-				position1829 := position
+				position2083 := position
 				depth++
 				if buffer[position] != rune('=') {
-					goto l1828
+					goto l2082
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1828
+				if !_rules[ruleskip]() {
+					goto l2082
 				}
 				depth--
-				add(ruleEQ, position1829)
+				add(ruleEQ, position2083)
 			}
 			return true
-		l1828:
-			position, tokenIndex, depth = position1828, tokenIndex1828, depth1828
+		l2082:
+			position, tokenIndex, depth = position2082, tokenIndex2082, depth2082
 			return false
 		},
-		/* 144 NE <- <('!' '=' skip)> */
+		/* 160 NE <- <('!' '=' skip)> */
 		nil,
-		/* 145 GT <- <('>' skip)> */
+		/* 161 GT <- <('>' skip)> */
 		nil,
-		/* 146 LT <- <('<' skip)> */
+		/* 162 LT <- <('<' skip)> */
 		nil,
-		/* 147 LE <- <('<' '=' skip)> */
+		/* 163 LE <- <('<' '=' skip)> */
 		nil,
-		/* 148 GE <- <('>' '=' skip)> */
+		/* 164 GE <- <('>' '=' skip)> */
 		nil,
-		/* 149 IN <- <(('i' / 'I') ('n' / 'N') skip)> */
+		/* 165 IN <- <(('i' / 'I') ('n' / 'N') skip)> */
 		nil,
-		/* 150 NOTIN <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') ' ' ('i' / 'I') ('n' / 'N') skip)> */
+		/* 166 NOTIN <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') ' ' ('i' / 'I') ('n' / 'N') skip)> */
 		nil,
-		/* 151 AS <- <(('a' / 'A') ('s' / 'S') skip)> */
+		/* 167 AS <- <(('a' / 'A') ('s' / 'S') skip)> */
 		func() bool {
-			position1837, tokenIndex1837, depth1837 := position, tokenIndex, depth
+			position2091, tokenIndex2091, depth2091 := position, tokenIndex, depth
 			{
-				position1838 := position
+				position2092 := position
 				depth++
 				{
-					position1839, tokenIndex1839, depth1839 := position, tokenIndex, depth
+					position2093, tokenIndex2093, depth2093 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l1840
+						goto l2094
 					}
 					position++
-					goto l1839
-				l1840:
-					position, tokenIndex, depth = position1839, tokenIndex1839, depth1839
+					goto l2093
+				l2094:
+					position, tokenIndex, depth = position2093, tokenIndex2093, depth2093
 					if buffer[position] != rune('A') {
-						goto l1837
+						goto l2091
 					}
 					position++
 				}
-			l1839:
+			l2093:
 				{
-					position1841, tokenIndex1841, depth1841 := position, tokenIndex, depth
+					position2095, tokenIndex2095, depth2095 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l1842
+						goto l2096
 					}
 					position++
-					goto l1841
-				l1842:
-					position, tokenIndex, depth = position1841, tokenIndex1841, depth1841
+					goto l2095
+				l2096:
+					position, tokenIndex, depth = position2095, tokenIndex2095, depth2095
 					if buffer[position] != rune('S') {
-						goto l1837
+						goto l2091
 					}
 					position++
 				}
-			l1841:
-				if !rules[ruleskip]() {
-					goto l1837
+			l2095:
+				if !_rules[ruleskip]() {
+					goto l2091
 				}
 				depth--
-				add(ruleAS, position1838)
+				add(ruleAS, position2092)
 			}
 			return true
-		l1837:
-			position, tokenIndex, depth = position1837, tokenIndex1837, depth1837
+		l2091:
+			position, tokenIndex, depth = position2091, tokenIndex2091, depth2091
 			return false
 		},
-		/* 152 STR <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') skip)> */
+		/* 168 STR <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') skip)> */
 		nil,
-		/* 153 LANG <- <(('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') skip)> */
+		/* 169 LANG <- <(('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') skip)> */
 		nil,
-		/* 154 DATATYPE <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E') skip)> */
+		/* 170 DATATYPE <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E') skip)> */
 		nil,
-		/* 155 IRI <- <(('i' / 'I') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 171 IRI <- <(('i' / 'I') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 156 URI <- <(('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 172 URI <- <(('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 157 ABS <- <(('a' / 'A') ('b' / 'B') ('s' / 'S') skip)> */
+		/* 173 ABS <- <(('a' / 'A') ('b' / 'B') ('s' / 'S') skip)> */
 		nil,
-		/* 158 CEIL <- <(('c' / 'C') ('e' / 'E') ('i' / 'I') ('l' / 'L') skip)> */
+		/* 174 CEIL <- <(('c' / 'C') ('e' / 'E') ('i' / 'I') ('l' / 'L') skip)> */
 		nil,
-		/* 159 ROUND <- <(('r' / 'R') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('d' / 'D') skip)> */
+		/* 175 ROUND <- <(('r' / 'R') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('d' / 'D') skip)> */
 		nil,
-		/* 160 FLOOR <- <(('f' / 'F') ('l' / 'L') ('o' / 'O') ('o' / 'O') ('r' / 'R') skip)> */
+		/* 176 FLOOR <- <(('f' / 'F') ('l' / 'L') ('o' / 'O') ('o' / 'O') ('r' / 'R') skip)> */
 		nil,
-		/* 161 STRLEN <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('l' / 'L') ('e' / 'E') ('n' / 'N') skip)> */
+		/* 177 STRLEN <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('l' / 'L') ('e' / 'E') ('n' / 'N') skip)> */
 		nil,
-		/* 162 UCASE <- <(('u' / 'U') ('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
+		/* 178 UCASE <- <(('u' / 'U') ('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
 		nil,
-		/* 163 LCASE <- <(('l' / 'L') ('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
+		/* 179 LCASE <- <(('l' / 'L') ('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
 		nil,
-		/* 164 ENCODEFORURI <- <(('e' / 'E') ('n' / 'N') ('c' / 'C') ('o' / 'O') ('d' / 'D') ('e' / 'E') '_' ('f' / 'F') ('o' / 'O') ('r' / 'R') '_' ('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 180 ENCODEFORURI <- <(('e' / 'E') ('n' / 'N') ('c' / 'C') ('o' / 'O') ('d' / 'D') ('e' / 'E') '_' ('f' / 'F') ('o' / 'O') ('r' / 'R') '_' ('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 165 YEAR <- <(('y' / 'Y') ('e' / 'E') ('a' / 'A') ('r' / 'R') skip)> */
+		/* 181 YEAR <- <(('y' / 'Y') ('e' / 'E') ('a' / 'A') ('r' / 'R') skip)> */
 		nil,
-		/* 166 MONTH <- <(('m' / 'M') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('h' / 'H') skip)> */
+		/* 182 MONTH <- <(('m' / 'M') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('h' / 'H') skip)> */
 		nil,
-		/* 167 DAY <- <(('d' / 'D') ('a' / 'A') ('y' / 'Y') skip)> */
+		/* 183 DAY <- <(('d' / 'D') ('a' / 'A') ('y' / 'Y') skip)> */
 		nil,
-		/* 168 HOURS <- <(('h' / 'H') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('s' / 'S') skip)> */
+		/* 184 HOURS <- <(('h' / 'H') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('s' / 'S') skip)> */
 		nil,
-		/* 169 MINUTES <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('s' / 'S') skip)> */
+		/* 185 MINUTES <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('s' / 'S') skip)> */
 		nil,
-		/* 170 SECONDS <- <(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S') skip)> */
+		/* 186 SECONDS <- <(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S') skip)> */
 		nil,
-		/* 171 TIMEZONE <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('z' / 'Z') ('o' / 'O') ('n' / 'N') ('e' / 'E') skip)> */
+		/* 187 TIMEZONE <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('z' / 'Z') ('o' / 'O') ('n' / 'N') ('e' / 'E') skip)> */
 		nil,
-		/* 172 TZ <- <(('t' / 'T') ('z' / 'Z') skip)> */
+		/* 188 TZ <- <(('t' / 'T') ('z' / 'Z') skip)> */
 		nil,
-		/* 173 MD5 <- <(('m' / 'M') ('d' / 'D') skip)> */
+		/* 189 MD5 <- <(('m' / 'M') ('d' / 'D') '5' skip)> */
 		nil,
-		/* 174 SHA1 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '1' skip)> */
+		/* 190 SHA1 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '1' skip)> */
 		nil,
-		/* 175 SHA256 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '2' '5' '6' skip)> */
+		/* 191 SHA256 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '2' '5' '6' skip)> */
 		nil,
-		/* 176 SHA384 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '3' '8' '4' skip)> */
+		/* 192 SHA384 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '3' '8' '4' skip)> */
 		nil,
-		/* 177 SHA512 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '5' '1' '2' skip)> */
+		/* 193 SHA512 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '5' '1' '2' skip)> */
 		nil,
-		/* 178 ISIRI <- <(('i' / 'I') ('s' / 'S') ('i' / 'I') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 194 ISIRI <- <(('i' / 'I') ('s' / 'S') ('i' / 'I') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 179 ISURI <- <(('i' / 'I') ('s' / 'S') ('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 195 ISURI <- <(('i' / 'I') ('s' / 'S') ('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 180 ISBLANK <- <(('i' / 'I') ('s' / 'S') ('b' / 'B') ('l' / 'L') ('a' / 'A') ('n' / 'N') ('k' / 'K') skip)> */
+		/* 196 ISBLANK <- <(('i' / 'I') ('s' / 'S') ('b' / 'B') ('l' / 'L') ('a' / 'A') ('n' / 'N') ('k' / 'K') skip)> */
 		nil,
-		/* 181 ISLITERAL <- <(('i' / 'I') ('s' / 'S') ('l' / 'L') ('i' / 'I') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('a' / 'A') ('l' / 'L') skip)> */
+		/* 197 ISLITERAL <- <(('i' / 'I') ('s' / 'S') ('l' / 'L') ('i' / 'I') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('a' / 'A') ('l' / 'L') skip)> */
 		nil,
-		/* 182 ISNUMERIC <- <(('i' / 'I') ('s' / 'S') ('n' / 'N') ('u' / 'U') ('m' / 'M') ('e' / 'E') ('r' / 'R') ('i' / 'I') ('c' / 'C') skip)> */
+		/* 198 ISNUMERIC <- <(('i' / 'I') ('s' / 'S') ('n' / 'N') ('u' / 'U') ('m' / 'M') ('e' / 'E') ('r' / 'R') ('i' / 'I') ('c' / 'C') skip)> */
 		nil,
-		/* 183 LANGMATCHES <- <(('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S') skip)> */
+		/* 199 LANGMATCHES <- <(('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S') skip)> */
 		nil,
-		/* 184 CONTAINS <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S') skip)> */
+		/* 200 CONTAINS <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S') skip)> */
 		nil,
-		/* 185 STRSTARTS <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('s' / 'S') skip)> */
+		/* 201 STRSTARTS <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('s' / 'S') skip)> */
 		nil,
-		/* 186 STRENDS <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('n' / 'N') ('d' / 'D') ('s' / 'S') skip)> */
+		/* 202 STRENDS <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('n' / 'N') ('d' / 'D') ('s' / 'S') skip)> */
 		nil,
-		/* 187 STRBEFORE <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('b' / 'B') ('e' / 'E') ('f' / 'F') ('o' / 'O') ('r' / 'R') ('e' / 'E') skip)> */
+		/* 203 STRBEFORE <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('b' / 'B') ('e' / 'E') ('f' / 'F') ('o' / 'O') ('r' / 'R') ('e' / 'E') skip)> */
 		nil,
-		/* 188 STRAFTER <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('a' / 'A') ('f' / 'F') ('t' / 'T') ('e' / 'E') ('r' / 'R') skip)> */
+		/* 204 STRAFTER <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('a' / 'A') ('f' / 'F') ('t' / 'T') ('e' / 'E') ('r' / 'R') skip)> */
 		nil,
-		/* 189 STRLANG <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') skip)> */
+		/* 205 STRLANG <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') skip)> */
 		nil,
-		/* 190 STRDT <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('d' / 'D') ('t' / 'T') skip)> */
+		/* 206 STRDT <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('d' / 'D') ('t' / 'T') skip)> */
 		nil,
-		/* 191 SAMETERM <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('e' / 'E') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('m' / 'M') skip)> */
+		/* 207 SAMETERM <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('e' / 'E') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('m' / 'M') skip)> */
 		nil,
-		/* 192 BOUND <- <(('b' / 'B') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('d' / 'D') skip)> */
+		/* 208 BOUND <- <(('b' / 'B') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('d' / 'D') skip)> */
 		nil,
-		/* 193 BNODE <- <(('b' / 'B') ('n' / 'N') ('o' / 'O') ('d' / 'D') ('e' / 'E') skip)> */
+		/* 209 BNODE <- <(('b' / 'B') ('n' / 'N') ('o' / 'O') ('d' / 'D') ('e' / 'E') skip)> */
 		nil,
-		/* 194 RAND <- <(('r' / 'R') ('a' / 'A') ('n' / 'N') ('d' / 'D') skip)> */
+		/* 210 RAND <- <(('r' / 'R') ('a' / 'A') ('n' / 'N') ('d' / 'D') skip)> */
 		nil,
-		/* 195 NOW <- <(('n' / 'N') ('o' / 'O') ('w' / 'W') skip)> */
+		/* 211 NOW <- <(('n' / 'N') ('o' / 'O') ('w' / 'W') skip)> */
 		nil,
-		/* 196 UUID <- <(('u' / 'U') ('u' / 'U') ('i' / 'I') ('d' / 'D') skip)> */
+		/* 212 UUID <- <(('u' / 'U') ('u' / 'U') ('i' / 'I') ('d' / 'D') skip)> */
 		nil,
-		/* 197 STRUUID <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('u' / 'U') ('u' / 'U') ('i' / 'I') ('d' / 'D') skip)> */
+		/* 213 STRUUID <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('u' / 'U') ('u' / 'U') ('i' / 'I') ('d' / 'D') skip)> */
 		nil,
-		/* 198 CONCAT <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('c' / 'C') ('a' / 'A') ('t' / 'T') skip)> */
+		/* 214 CONCAT <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('c' / 'C') ('a' / 'A') ('t' / 'T') skip)> */
 		nil,
-		/* 199 SUBSTR <- <(('s' / 'S') ('u' / 'U') ('b' / 'B') ('s' / 'S') ('t' / 'T') ('r' / 'R') skip)> */
+		/* 215 SUBSTR <- <(('s' / 'S') ('u' / 'U') ('b' / 'B') ('s' / 'S') ('t' / 'T') ('r' / 'R') skip)> */
 		nil,
-		/* 200 REPLACE <- <(('r' / 'R') ('e' / 'E') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('c' / 'C') ('e' / 'E') skip)> */
+		/* 216 REPLACE <- <(('r' / 'R') ('e' / 'E') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('c' / 'C') ('e' / 'E') skip)> */
 		nil,
-		/* 201 REGEX <- <(('r' / 'R') ('e' / 'E') ('g' / 'G') ('e' / 'E') ('x' / 'X') skip)> */
+		/* 217 REGEX <- <(('r' / 'R') ('e' / 'E') ('g' / 'G') ('e' / 'E') ('x' / 'X') skip)> */
 		nil,
-		/* 202 IF <- <(('i' / 'I') ('f' / 'F') skip)> */
+		/* 218 IF <- <(('i' / 'I') ('f' / 'F') skip)> */
 		nil,
-		/* 203 EXISTS <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S') skip)> */
+		/* 219 EXISTS <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S') skip)> */
 		nil,
-		/* 204 NOTEXIST <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') ' ' ('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S') skip)> */
+		/* 220 NOTEXIST <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') ' ' ('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S') skip)> */
 		nil,
-		/* 205 COALESCE <- <(('c' / 'C') ('o' / 'O') ('a' / 'A') ('l' / 'L') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('e' / 'E') skip)> */
+		/* 221 COALESCE <- <(('c' / 'C') ('o' / 'O') ('a' / 'A') ('l' / 'L') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('e' / 'E') skip)> */
 		nil,
-		/* 206 FILTER <- <(('f' / 'F') ('i' / 'I') ('l' / 'L') ('t' / 'T') ('e' / 'E') ('r' / 'R') skip)> */
+		/* 222 FILTER <- <(('f' / 'F') ('i' / 'I') ('l' / 'L') ('t' / 'T') ('e' / 'E') ('r' / 'R') skip)> */
 		nil,
-		/* 207 BIND <- <(('b' / 'B') ('i' / 'I') ('n' / 'N') ('d' / 'D') skip)> */
+		/* 223 BIND <- <(('b' / 'B') ('i' / 'I') ('n' / 'N') ('d' / 'D') skip)> */
 		nil,
-		/* 208 SUM <- <(('s' / 'S') ('u' / 'U') ('m' / 'M') skip)> */
+		/* 224 SUM <- <(('s' / 'S') ('u' / 'U') ('m' / 'M') skip)> */
 		nil,
-		/* 209 MIN <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') skip)> */
+		/* 225 MIN <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') skip)> */
 		nil,
-		/* 210 MAX <- <(('m' / 'M') ('a' / 'A') ('x' / 'X') skip)> */
+		/* 226 MAX <- <(('m' / 'M') ('a' / 'A') ('x' / 'X') skip)> */
 		nil,
-		/* 211 AVG <- <(('a' / 'A') ('v' / 'V') ('g' / 'G') skip)> */
+		/* 227 AVG <- <(('a' / 'A') ('v' / 'V') ('g' / 'G') skip)> */
 		nil,
-		/* 212 SAMPLE <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') skip)> */
+		/* 228 SAMPLE <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') skip)> */
 		nil,
-		/* 213 COUNT <- <(('c' / 'C') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('t' / 'T') skip)> */
+		/* 229 COUNT <- <(('c' / 'C') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('t' / 'T') skip)> */
 		nil,
-		/* 214 GROUPCONCAT <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') '_' ('c' / 'C') ('o' / 'O') ('n' / 'N') ('c' / 'C') ('a' / 'A') ('t' / 'T') skip)> */
+		/* 230 GROUPCONCAT <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') '_' ('c' / 'C') ('o' / 'O') ('n' / 'N') ('c' / 'C') ('a' / 'A') ('t' / 'T') skip)> */
 		nil,
-		/* 215 SEPARATOR <- <(('s' / 'S') ('e' / 'E') ('p' / 'P') ('a' / 'A') ('r' / 'R') ('a' / 'A') ('t' / 'T') ('o' / 'O') ('r' / 'R') skip)> */
+		/* 231 SEPARATOR <- <(('s' / 'S') ('e' / 'E') ('p' / 'P') ('a' / 'A') ('r' / 'R') ('a' / 'A') ('t' / 'T') ('o' / 'O') ('r' / 'R') skip)> */
 		nil,
-		/* 216 ASC <- <(('a' / 'A') ('s' / 'S') ('c' / 'C') skip)> */
+		/* 232 ASC <- <(('a' / 'A') ('s' / 'S') ('c' / 'C') skip)> */
 		nil,
-		/* 217 DESC <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') skip)> */
+		/* 233 DESC <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') skip)> */
 		nil,
-		/* 218 ORDER <- <(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') skip)> */
+		/* 234 ORDER <- <(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') skip)> */
 		nil,
-		/* 219 GROUP <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') skip)> */
+		/* 235 GROUP <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') skip)> */
 		nil,
-		/* 220 BY <- <(('b' / 'B') ('y' / 'Y') skip)> */
+		/* 236 BY <- <(('b' / 'B') ('y' / 'Y') skip)> */
 		func() bool {
-			position1911, tokenIndex1911, depth1911 := position, tokenIndex, depth
+			position2165, tokenIndex2165, depth2165 := position, tokenIndex, depth
 			{
-				position1912 := position
+				position2166 := position
 				depth++
 				{
-					position1913, tokenIndex1913, depth1913 := position, tokenIndex, depth
+					position2167, tokenIndex2167, depth2167 := position, tokenIndex, depth
 					if buffer[position] != rune('b') {
-						goto l1914
+						goto l2168
 					}
 					position++
-					goto l1913
-				l1914:
-					position, tokenIndex, depth = position1913, tokenIndex1913, depth1913
+					goto l2167
+				l2168:
+					position, tokenIndex, depth = position2167, tokenIndex2167, depth2167
 					if buffer[position] != rune('B') {
-						goto l1911
+						goto l2165
 					}
 					position++
 				}
-			l1913:
+			l2167:
 				{
-					position1915, tokenIndex1915, depth1915 := position, tokenIndex, depth
+					position2169, tokenIndex2169, depth2169 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l1916
+						goto l2170
 					}
 					position++
-					goto l1915
-				l1916:
-					position, tokenIndex, depth = position1915, tokenIndex1915, depth1915
+					goto l2169
+				l2170:
+					position, tokenIndex, depth = position2169, tokenIndex2169, depth2169
 					if buffer[position] != rune('Y') {
-						goto l1911
+						goto l2165
 					}
 					position++
 				}
-			l1915:
-				if !rules[ruleskip]() {
-					goto l1911
+			l2169:
+				if !_rules[ruleskip]() {
+					goto l2165
 				}
 				depth--
-				add(ruleBY, position1912)
+				add(ruleBY, position2166)
 			}
 			return true
-		l1911:
-			position, tokenIndex, depth = position1911, tokenIndex1911, depth1911
+		l2165:
+			position, tokenIndex, depth = position2165, tokenIndex2165, depth2165
 			return false
 		},
-		/* 221 HAVING <- <(('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G') skip)> */
+		/* 237 HAVING <- <(('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G') skip)> */
+		nil,
+		/* 238 GRAPH <- <(('g' / 'G') ('r' / 'R') ('a' / 'A') ('p' / 'P') ('h' / 'H') skip)> */
+		nil,
+		/* 239 MINUSSETOPER <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('s' / 'S') skip)> */
+		nil,
+		/* 240 SERVICE <- <(('s' / 'S') ('e' / 'E') ('r' / 'R') ('v' / 'V') ('i' / 'I') ('c' / 'C') ('e' / 'E') skip)> */
+		nil,
+		/* 241 SILENT <- <(('s' / 'S') ('i' / 'I') ('l' / 'L') ('e' / 'E') ('n' / 'N') ('t' / 'T') skip)> */
 		nil,
-		/* 222 GRAPH <- <(('g' / 'G') ('r' / 'R') ('a' / 'A') ('p' / 'P') ('h' / 'H') skip)> */
+		/* 242 VALUES <- <(('v' / 'V') ('a' / 'A') ('l' / 'L') ('u' / 'U') ('e' / 'E') ('s' / 'S') skip)> */
 		nil,
-		/* 223 MINUSSETOPER <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('s' / 'S') skip)> */
+		/* 243 UNDEF <- <(('u' / 'U') ('n' / 'N') ('d' / 'D') ('e' / 'E') ('f' / 'F') skip)> */
 		nil,
-		/* 224 skip <- <(<(ws / comment)*> Action13)> */
+		/* 244 skip <- <(<(ws / comment)*> Action15)> */
 		func() bool {
 			{
-				position1921 := position
+				position2179 := position
 				depth++
 				{
-					position1922 := position
+					position2180 := position
 					depth++
-				l1923:
+				l2181:
 					{
-						position1924, tokenIndex1924, depth1924 := position, tokenIndex, depth
+						position2182, tokenIndex2182, depth2182 := position, tokenIndex, depth
 						{
-							position1925, tokenIndex1925, depth1925 := position, tokenIndex, depth
-							if !rules[rulews]() {
-								goto l1926
+							position2183, tokenIndex2183, depth2183 := position, tokenIndex, depth
+							if !_rules[rulews]() {
+								goto l2184
 							}
-							goto l1925
-						l1926:
-							position, tokenIndex, depth = position1925, tokenIndex1925, depth1925
+							goto l2183
+						l2184:
+							position, tokenIndex, depth = position2183, tokenIndex2183, depth2183
 							{
-								position1927 := position
+								position2185 := position
 								depth++
 								if buffer[position] != rune('#') {
-									goto l1924
+									goto l2182
 								}
 								position++
-							l1928:
+							l2186:
 								{
-									position1929, tokenIndex1929, depth1929 := position, tokenIndex, depth
+									position2187, tokenIndex2187, depth2187 := position, tokenIndex, depth
 									{
-										position1930, tokenIndex1930, depth1930 := position, tokenIndex, depth
-										if !rules[ruleendOfLine]() {
-											goto l1930
+										position2188, tokenIndex2188, depth2188 := position, tokenIndex, depth
+										if !_rules[ruleendOfLine]() {
+											goto l2188
 										}
-										goto l1929
-									l1930:
-										position, tokenIndex, depth = position1930, tokenIndex1930, depth1930
+										goto l2187
+									l2188:
+										position, tokenIndex, depth = position2188, tokenIndex2188, depth2188
 									}
 									if !matchDot() {
-										goto l1929
+										goto l2187
 									}
-									goto l1928
-								l1929:
-									position, tokenIndex, depth = position1929, tokenIndex1929, depth1929
+									goto l2186
+								l2187:
+									position, tokenIndex, depth = position2187, tokenIndex2187, depth2187
 								}
-								if !rules[ruleendOfLine]() {
-									goto l1924
+								{
+									position2189, tokenIndex2189, depth2189 := position, tokenIndex, depth
+									if !_rules[ruleendOfLine]() {
+										goto l2190
+									}
+									goto l2189
+								l2190:
+									position, tokenIndex, depth = position2189, tokenIndex2189, depth2189
+									{
+										position2191, tokenIndex2191, depth2191 := position, tokenIndex, depth
+										if !matchDot() {
+											goto l2191
+										}
+										goto l2182
+									l2191:
+										position, tokenIndex, depth = position2191, tokenIndex2191, depth2191
+									}
 								}
+							l2189:
 								depth--
-								add(rulecomment, position1927)
+								add(rulecomment, position2185)
 							}
 						}
-					l1925:
-						goto l1923
-					l1924:
-						position, tokenIndex, depth = position1924, tokenIndex1924, depth1924
+					l2183:
+						goto l2181
+					l2182:
+						position, tokenIndex, depth = position2182, tokenIndex2182, depth2182
 					}
 					depth--
-					add(rulePegText, position1922)
+					add(rulePegText, position2180)
 				}
 				{
-					add(ruleAction13, position)
+					add(ruleAction15, position)
 				}
 				depth--
-				add(ruleskip, position1921)
+				add(ruleskip, position2179)
 			}
 			return true
 		},
-		/* 225 ws <- <((&('\v') '\v') | (&('\f') '\f') | (&('\t') '\t') | (&(' ') ' ') | (&('\n' | '\r') endOfLine))> */
+		/* 245 ws <- <(' ' / '\t' / '\f' / '\v' / endOfLine)> */
 		func() bool {
-			position1932, tokenIndex1932, depth1932 := position, tokenIndex, depth
+			position2193, tokenIndex2193, depth2193 := position, tokenIndex, depth
 			{
-				position1933 := position
+				position2194 := position
 				depth++
 				{
-					switch buffer[position] {
-					case '\v':
-						if buffer[position] != rune('\v') {
-							goto l1932
-						}
-						position++
-						break
-					case '\f':
-						if buffer[position] != rune('\f') {
-							goto l1932
-						}
-						position++
-						break
-					case '\t':
-						if buffer[position] != rune('\t') {
-							goto l1932
-						}
-						position++
-						break
-					case ' ':
-						if buffer[position] != rune(' ') {
-							goto l1932
-						}
-						position++
-						break
-					default:
-						if !rules[ruleendOfLine]() {
-							goto l1932
-						}
-						break
+					position2195, tokenIndex2195, depth2195 := position, tokenIndex, depth
+					if buffer[position] != rune(' ') {
+						goto l2196
+					}
+					position++
+					goto l2195
+				l2196:
+					position, tokenIndex, depth = position2195, tokenIndex2195, depth2195
+					if buffer[position] != rune('\t') {
+						goto l2197
+					}
+					position++
+					goto l2195
+				l2197:
+					position, tokenIndex, depth = position2195, tokenIndex2195, depth2195
+					if buffer[position] != rune('\f') {
+						goto l2198
+					}
+					position++
+					goto l2195
+				l2198:
+					position, tokenIndex, depth = position2195, tokenIndex2195, depth2195
+					if buffer[position] != rune('\v') {
+						goto l2199
+					}
+					position++
+					goto l2195
+				l2199:
+					position, tokenIndex, depth = position2195, tokenIndex2195, depth2195
+					if !_rules[ruleendOfLine]() {
+						goto l2193
 					}
 				}
-
+			l2195:
 				depth--
-				add(rulews, position1933)
+				add(rulews, position2194)
 			}
 			return true
-		l1932:
-			position, tokenIndex, depth = position1932, tokenIndex1932, depth1932
+		l2193:
+			position, tokenIndex, depth = position2193, tokenIndex2193, depth2193
 			return false
 		},
-		/* 226 comment <- <('#' (!endOfLine .)* endOfLine)> */
+		/* 246 comment <- <('#' (!endOfLine .)* (endOfLine / !.))> */
 		nil,
-		/* 227 endOfLine <- <(('\r' '\n') / '\n' / '\r')> */
+		/* 247 endOfLine <- <(('\r' '\n') / '\n' / '\r')> */
 		func() bool {
-			position1936, tokenIndex1936, depth1936 := position, tokenIndex, depth
+			position2201, tokenIndex2201, depth2201 := position, tokenIndex, depth
 			{
-				position1937 := position
+				position2202 := position
 				depth++
 				{
-					position1938, tokenIndex1938, depth1938 := position, tokenIndex, depth
+					position2203, tokenIndex2203, depth2203 := position, tokenIndex, depth
 					if buffer[position] != rune('\r') {
-						goto l1939
+						goto l2204
 					}
 					position++
 					if buffer[position] != rune('\n') {
-						goto l1939
+						goto l2204
 					}
 					position++
-					goto l1938
-				l1939:
-					position, tokenIndex, depth = position1938, tokenIndex1938, depth1938
+					goto l2203
+				l2204:
+					position, tokenIndex, depth = position2203, tokenIndex2203, depth2203
 					if buffer[position] != rune('\n') {
-						goto l1940
+						goto l2205
 					}
 					position++
-					goto l1938
-				l1940:
-					position, tokenIndex, depth = position1938, tokenIndex1938, depth1938
+					goto l2203
+				l2205:
+					position, tokenIndex, depth = position2203, tokenIndex2203, depth2203
 					if buffer[position] != rune('\r') {
-						goto l1936
+						goto l2201
 					}
 					position++
 				}
-			l1938:
+			l2203:
 				depth--
-				add(ruleendOfLine, position1937)
+				add(ruleendOfLine, position2202)
 			}
 			return true
-		l1936:
-			position, tokenIndex, depth = position1936, tokenIndex1936, depth1936
+		l2201:
+			position, tokenIndex, depth = position2201, tokenIndex2201, depth2201
 			return false
 		},
 		nil,
-		/* 230 Action0 <- <{ p.addPrefix(p.skipped(buffer, begin, end)) }> */
+		/* 250 Action0 <- <{ p.addPrefix(p.skipped(buffer, begin, end)) }> */
 		nil,
-		/* 231 SERVICE <- <> */
+		/* 251 Action1 <- <{ p.S = p.skipped(buffer, begin, end) }> */
 		nil,
-		/* 232 SILENT <- <> */
+		/* 252 Action2 <- <{ p.S = p.skipped(buffer, begin, end) }> */
 		nil,
-		/* 233 Action1 <- <{ p.S = p.skipped(buffer, begin, end) }> */
+		/* 253 Action3 <- <{ p.S = "?POF" }> */
 		nil,
-		/* 234 Action2 <- <{ p.S = p.skipped(buffer, begin, end) }> */
+		/* 254 Action4 <- <{ p.P = p.skipped(buffer, begin, end) }> */
 		nil,
-		/* 235 Action3 <- <{ p.S = "?POF" }> */
+		/* 255 Action5 <- <{ p.P = "?POF" }> */
 		nil,
-		/* 236 Action4 <- <{ p.P = p.skipped(buffer, begin, end) }> */
+		/* 256 Action6 <- <{ p.P = p.skipped(buffer, begin, end) }> */
 		nil,
-		/* 237 Action5 <- <{ p.P = "?POF" }> */
+		/* 257 Action7 <- <{ p.O = "?FillVar"; p.addTriplePattern() }> */
 		nil,
-		/* 238 Action6 <- <{ p.P = p.skipped(buffer, begin, end) }> */
+		/* 258 Action8 <- <{ p.O = "?POF"; p.addTriplePattern() }> */
 		nil,
-		/* 239 Action7 <- <{ p.O = "?FillVar"; p.addTriplePattern() }> */
+		/* 259 Action9 <- <{ p.O = p.skipped(buffer, begin, end); p.addTriplePattern() }> */
 		nil,
-		/* 240 Action8 <- <{ p.O = "?POF"; p.addTriplePattern() }> */
+		/* 260 Action10 <- <{ p.setVariablePof() }> */
 		nil,
-		/* 241 Action9 <- <{ p.O = p.skipped(buffer, begin, end); p.addTriplePattern() }> */
+		/* 261 Action11 <- <{ p.setPrefix(p.skipped(buffer, begin, end)) }> */
 		nil,
-		/* 242 Action10 <- <{ p.setPrefix(p.skipped(buffer, begin, end)) }> */
+		/* 262 Action12 <- <{ p.setPathLength(p.skipped(buffer, begin, end)) }> */
 		nil,
-		/* 243 Action11 <- <{ p.setPathLength(p.skipped(buffer, begin, end)) }> */
+		/* 263 Action13 <- <{ p.setKeyword(p.skipped(buffer, begin, end)) }> */
 		nil,
-		/* 244 Action12 <- <{ p.setKeyword(p.skipped(buffer, begin, end)) }> */
+		/* 264 Action14 <- <{ p.addVariable(buffer[begin:end]) }> */
 		nil,
-		/* 245 Action13 <- <{ p.skipBegin = begin }> */
+		/* 265 Action15 <- <{ p.skipBegin = begin }> */
 		nil,
 	}
-	p.rules = rules
+	p.rules = _rules
 }
@@ -0,0 +1,100 @@
+package autocompletion
+
+import (
+    "strconv"
+    "strings"
+)
+
+// The namespace of the analytics vocabulary of graph summaries, as written by
+// the summary package
+const analyticsNS = "http://vocab.sindice.net/analytics#"
+
+// The IRI of rdf:type
+const rdfType = "http://www.w3.org/1999/02/22-rdf-syntax-ns#type"
+
+// The variable bound to the number of entities or of triples summarized by the
+// recommended item
+const cardinalityVar = "?POFCardinality"
+
+// Retrieves the recommended items from a graph summary instead of the data,
+// ranked by the number of entities or of triples they stand for
+const summaryTemplate = `
+        {{range $prefix, $uri := .Prefixes}}
+        PREFIX {{$prefix}}: <{{$uri}}>
+        {{end}}
+
+        SELECT {{.Pof}} (SUM(?POFCardinality) AS ?count)
+        WHERE {
+            {{.SummaryPatterns}}
+        {{if .Keyword}}
+            FILTER regex(?POF, "{{escapeString (escapeRegex .Keyword)}}", "i")
+        {{else if .Prefix}}
+            FILTER regex(?POF, "^{{escapeString (escapeRegex .Prefix)}}")
+        {{end}}
+        }
+        GROUP BY {{.GroupBy}}
+        ORDER BY DESC(?count)
+        {{if gt .Limit 0}}
+        LIMIT {{.Limit}}
+        {{end}}
+        {{if .Offset}}
+        OFFSET {{.Offset}}
+        {{end}}
+    `
+
+// SummaryPatterns returns the triple patterns rewritten against a graph
+// summary, where a node stands for a group of entities and an edge for the
+// triples of a predicate between two groups:
+//
+//  - "?s a C" becomes "?s a an:Node ; an:label C", i.e., ?s is a node with
+//    the class C;
+//  - "?s p ?o" becomes "?e an:source ?s ; an:label p ; an:target ?o".
+//
+// The IRIs and literals at the subject or object position are replaced with
+// variables, since a summary does not keep the entities. The cardinality of
+// the node or the edge of the recommended item is bound to ?POFCardinality.
+// Only classes, predicates and paths can be recommended from a summary: for
+// another kind of recommendation, the patterns have no solution.
+func (b *Scope) SummaryPatterns() string {
+    switch b.RecommendationType() {
+    case CLASS, PREDICATE, PATH:
+    default:
+        return "VALUES ?POF { }"
+    }
+    pof := "?POF"
+    if b.pathLength != 0 {
+        pof = "?POF" + strconv.Itoa(b.pathLength)
+    }
+    an := func(property string) string { return "<" + analyticsNS + property + ">" }
+    nodes := 0
+    node := func(term string) string {
+        if strings.HasPrefix(term, "?") || strings.HasPrefix(term, "$") {
+            return term
+        }
+        nodes++
+        return "?POFNode" + strconv.Itoa(nodes)
+    }
+    var patterns []string
+    for i,tp := range b.Tps {
+        s, o := node(tp.S), tp.O
+        if b.isType(tp.P) {
+            patterns = append(patterns, s + " a " + an("Node") + " ; " + an("label") + " " + o + " .")
+            if o == pof {
+                patterns = append(patterns, s + " " + an("cardinality") + " " + cardinalityVar + " .")
+            }
+            continue
+        }
+        e := "?POFEdge" + strconv.Itoa(i + 1)
+        patterns = append(patterns, e + " " + an("source") + " " + s + " ; " + an("label") + " " + tp.P + " ; " + an("target") + " " + node(o) + " .")
+        if tp.P == pof {
+            patterns = append(patterns, e + " " + an("cardinality") + " " + cardinalityVar + " .")
+        }
+    }
+    return strings.Join(patterns, "\n")
+}
+
+// isType returns true if the predicate of a triple pattern is rdf:type
+func (b *Scope) isType(p string) bool {
+    iri, ok := b.expand(p)
+    return ok && iri == rdfType
+}
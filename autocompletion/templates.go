@@ -0,0 +1,317 @@
+package autocompletion
+
+import (
+    "errors"
+    "fmt"
+    "sort"
+    "strings"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// A template of the recommendation query, along with the settings of the
+// Scope it relies on
+type namedTemplate struct {
+    // The text/template of the recommendation query
+    text string
+    // Sets the fields of the Scope the template relies on. It may be nil.
+    configure func(*Scope)
+}
+
+// The library of named templates
+var templates = map[string]namedTemplate{
+    "default" : { text : recommendationTemplate(regexFilter) },
+    "ranked" : {
+        text : recommendationTemplate(regexFilter),
+        configure : func(s *Scope) { s.Ranked = true },
+    },
+    "sampled" : {
+        text : recommendationTemplate(regexFilter),
+        configure : func(s *Scope) { s.Ranked = true; s.Sample = 1000 },
+    },
+    "label" : {
+        text : recommendationTemplate(regexFilter),
+        configure : func(s *Scope) { s.Labels = NewLabelOptions() },
+    },
+    "count" : { text : countTemplate },
+    "virtuoso" : { text : recommendationTemplate(virtuosoFilter) },
+    "blazegraph" : { text : recommendationTemplate(blazegraphFilter) },
+    "jena" : { text : recommendationTemplate(jenaFilter) },
+    "graphdb" : { text : recommendationTemplate(graphdbFilter) },
+    "summary" : { text : summaryTemplate },
+    "gold" : { text : goldTemplate },
+    "measure" : { text : measureTemplate },
+    "popularity" : { text : popularityTemplate },
+}
+
+// recommendationTemplate returns the template of the recommendation query,
+// where the keyword is matched with the given graph patterns.
+// The template supports the ranking, sampling, labels and paging settings of
+// the Scope.
+func recommendationTemplate(keywordFilter string) string {
+    return `
+        {{range $prefix, $uri := .Prefixes}}
+        PREFIX {{$prefix}}: <{{$uri}}>
+        {{end}}
+
+        SELECT {{if not .Ranked}}DISTINCT {{end}}{{.Pof}}{{if .LabelSearch}} ?POFLabel ?POFComment{{end}}{{if .Ranked}} (COUNT(*) AS ?count){{end}}
+        WHERE {
+        {{if .Sample}}
+        { SELECT * WHERE {
+        {{end}}
+        {{range .Tps}}
+            {{.S}} {{.P}} {{.O}} .
+        {{end}}
+        {{.LabelPatterns}}
+        {{if .Keyword}}` + keywordFilter + `
+        {{else if .Prefix}}
+            FILTER regex(?POF, "^{{escapeString (escapeRegex .Prefix)}}")
+        {{end}}
+        {{if .Sample}}
+        } LIMIT {{.Sample}} }
+        {{end}}
+        }
+        {{if .Ranked}}
+        GROUP BY {{.GroupBy}}{{if .LabelSearch}} ?POFLabel ?POFComment{{end}}
+        ORDER BY DESC(?count)
+        {{end}}
+        {{if gt .Limit 0}}
+        LIMIT {{.Limit}}
+        {{end}}
+        {{if .Offset}}
+        OFFSET {{.Offset}}
+        {{end}}
+    `
+}
+
+// Matches the keyword against the IRI of the recommended item with a regular
+// expression, and also against its label if label search is enabled
+const regexFilter = `
+            {{if .LabelSearch}}
+            FILTER (regex(?POF, "{{escapeString (escapeRegex .Keyword)}}", "i") || regex(?POFLabel, "{{escapeString (escapeRegex .Keyword)}}", "i"))
+            {{else}}
+            FILTER regex(?POF, "{{escapeString (escapeRegex .Keyword)}}", "i")
+            {{end}}`
+
+// Matches the keyword against the labels with the Virtuoso full-text index.
+// A keyword without words, e.g., only punctuation, is not a valid phrase, and
+// any label is matched instead.
+const virtuosoFilter = `
+            ?POF ?POFLabelPredicate ?POFMatch .
+            {{with virtuosoPhrase .Keyword}}
+            ?POFMatch <bif:contains> "'{{.}}*'" .
+            {{end}}
+            FILTER (?POFLabelPredicate IN ({{.LabelPredicates}}))`
+
+// Matches the keyword against the labels with the Blazegraph full-text index
+const blazegraphFilter = `
+            ?POFMatch <http://www.bigdata.com/rdf/search#search> "{{escapeString .Keyword}}" .
+            ?POFMatch <http://www.bigdata.com/rdf/search#prefixMatch> true .
+            ?POF ?POFLabelPredicate ?POFMatch .
+            FILTER (?POFLabelPredicate IN ({{.LabelPredicates}}))`
+
+// Matches the keyword with the Jena text index. The index must be configured
+// with the label predicates.
+const jenaFilter = `
+            ?POF <http://jena.apache.org/text#query> "{{escapeString .Keyword}}*" .`
+
+// Matches the keyword with a GraphDB Lucene connector named "labels".
+// The connector must index the label predicates.
+const graphdbFilter = `
+            ?POFSearch a <http://www.ontotext.com/connectors/lucene/instance#labels> .
+            ?POFSearch <http://www.ontotext.com/connectors/lucene#query> "{{escapeString .Keyword}}*" .
+            ?POFSearch <http://www.ontotext.com/connectors/lucene#entities> ?POF .`
+
+// Returns the number of items that can be recommended
+const countTemplate = `
+        {{range $prefix, $uri := .Prefixes}}
+        PREFIX {{$prefix}}: <{{$uri}}>
+        {{end}}
+
+        SELECT (COUNT(*) AS ?count)
+        WHERE {
+            SELECT DISTINCT {{.Pof}}
+            WHERE {
+            {{range .Tps}}
+                {{.S}} {{.P}} {{.O}} .
+            {{end}}
+            {{if .Keyword}}
+                FILTER regex(?POF, "{{escapeString (escapeRegex .Keyword)}}", "i")
+            {{else if .Prefix}}
+                FILTER regex(?POF, "^{{escapeString (escapeRegex .Prefix)}}")
+            {{end}}
+            }
+        }
+    `
+
+// Returns the recommended items other than rdf:type, ranked by their number of
+// occurrences in the graph, as the gold standard of the evaluation
+const goldTemplate = `
+        {{range $prefix, $uri := .Prefixes}}
+        PREFIX {{$prefix}}: <{{$uri}}>
+        {{end}}
+
+        SELECT {{.Pof}} (COUNT(*) AS ?count){{if .Graph}} FROM {{iri .Graph}}{{end}}
+        WHERE {
+        {{range .Tps}}
+            {{.S}} {{.P}} {{.O}} .
+        {{end}}
+        {{if .Keyword}}
+            FILTER regex(?POF, "{{escapeString (escapeRegex .Keyword)}}", "i")
+        {{else if .Prefix}}
+            FILTER regex(?POF, "^{{escapeString (escapeRegex .Prefix)}}")
+        {{end}}
+            FILTER (?POF != <http://www.w3.org/1999/02/22-rdf-syntax-ns#type>)
+        }
+        GROUP BY {{.GroupBy}}
+        ORDER BY DESC(?count)
+        {{if gt .Limit 0}}
+        LIMIT {{.Limit}}
+        {{end}}
+    `
+
+// Returns the recommended items other than rdf:type from the graph, each
+// solution counted once, for measuring the recommendations of the evaluation
+const measureTemplate = `
+        {{range $prefix, $uri := .Prefixes}}
+        PREFIX {{$prefix}}: <{{$uri}}>
+        {{end}}
+
+        SELECT {{.Pof}} ?count{{if .Graph}} FROM {{iri .Graph}}{{end}}
+        WHERE {
+        {{range .Tps}}
+            {{.S}} {{.P}} {{.O}} .
+        {{end}}
+        {{if .Keyword}}
+            FILTER regex(?POF, "{{escapeString (escapeRegex .Keyword)}}", "i")
+        {{else if .Prefix}}
+            FILTER regex(?POF, "^{{escapeString (escapeRegex .Prefix)}}")
+        {{end}}
+            BIND (1 AS ?count)
+            FILTER (?POF != <http://www.w3.org/1999/02/22-rdf-syntax-ns#type>)
+        }
+        {{if gt .Limit 0}}
+        LIMIT {{.Limit}}
+        {{end}}
+    `
+
+// Returns the number of occurrences in the graph of each of the Values matching
+// the keyword or the prefix
+const popularityTemplate = `
+        {{range $prefix, $uri := .Prefixes}}
+        PREFIX {{$prefix}}: <{{$uri}}>
+        {{end}}
+
+        SELECT ?POF (COUNT(*) AS ?count){{if .Graph}} FROM {{iri .Graph}}{{end}}
+        WHERE {
+            {{valuesBlock "?POF" .Values}}
+        {{range .Tps}}
+            {{.S}} {{.P}} {{.O}} .
+        {{end}}
+        {{if .Keyword}}
+            FILTER regex(?POF, "{{escapeString (escapeRegex .Keyword)}}", "i")
+        {{else if .Prefix}}
+            FILTER regex(?POF, "^{{escapeString (escapeRegex .Prefix)}}")
+        {{end}}
+        }
+        GROUP BY ?POF
+    `
+
+// RegisterTemplate adds the text template to the library under the given name.
+// A template already registered with that name is replaced.
+// An error is returned if the template is not valid.
+func RegisterTemplate(name string, tmpl string) error {
+    if _, err := NewScopeWithTemplate(tmpl); err != nil {
+        return err
+    }
+    templates[name] = namedTemplate{ text : tmpl }
+    return nil
+}
+
+// TemplateNames returns the sorted list of names of the templates in the library
+func TemplateNames() []string {
+    var names []string
+    for name := range templates {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    return names
+}
+
+// NewScopeByName creates a Scope with the named template from the library
+func NewScopeByName(name string) (*Scope, error) {
+    nt, ok := templates[name]
+    if !ok {
+        return nil, errors.New("Unknown template [" + name + "], expected one of " + strings.Join(TemplateNames(), ", "))
+    }
+    scope, err := NewScopeWithTemplate(nt.text)
+    if err != nil {
+        return nil, err
+    }
+    if nt.configure != nil {
+        nt.configure(scope)
+    }
+    return scope, nil
+}
+
+// LabelPredicates returns the comma-separated list of predicates linking an
+// item to its label. If label search is disabled, the default ones are returned.
+func (s *Scope) LabelPredicates() string {
+    labels := s.Labels
+    if labels == nil {
+        labels = NewLabelOptions()
+    }
+    return strings.Join(labels.Predicates, ", ")
+}
+
+// The settings of the sample Scopes used for validating a template.
+// Each sample has the Point Of Focus at the predicate position.
+var samples = []func(*Scope){
+    // no keyword
+    func(s *Scope) {},
+    // keyword
+    func(s *Scope) { s.Keyword = "keyword" },
+    // prefix
+    func(s *Scope) {
+        s.Prefixes["ex"] = "http://example.org/"
+        s.Prefix = "http://example.org/"
+    },
+    // path
+    func(s *Scope) { s.pathLength = 2 },
+    // all the settings of the default template
+    func(s *Scope) {
+        s.Keyword = "keyword"
+        s.Labels = NewLabelOptions()
+        s.Ranked = true
+        s.Sample = 100
+        s.Offset = 10
+    },
+}
+
+// validateTemplate checks that the SPARQL queries rendered by the template
+// with the sample Scopes can be parsed
+func validateTemplate(tmpl string) error {
+    for _,configure := range samples {
+        sample, err := newScope(tmpl)
+        if err != nil {
+            return err
+        }
+        sample.Tps = []triplePattern{
+            { S : "?s", P : "a", O : "<http://example.org/Class>" },
+            { S : "?s", P : "?POF", O : "?o" },
+        }
+        configure(sample)
+        if sample.pathLength != 0 {
+            sample.Pof = pathPof(sample.pathLength)
+        }
+        query, err := sample.render()
+        if err != nil {
+            return err
+        }
+        s := &sparql.Sparql{ Buffer : query }
+        s.Init()
+        if err := s.Parse(); err != nil {
+            return fmt.Errorf("The template renders an invalid SPARQL query\n%v\n%v", query, err)
+        }
+    }
+    return nil
+}
@@ -0,0 +1,283 @@
+/*
+ Package cache keeps the recommendations retrieved from endpoints, so that a
+ completion of the same query is answered without querying the endpoint again.
+
+    c := cache.New(100, 10 * time.Minute)
+    key, err := cache.NewKey("http://dbpedia.org/sparql", "http://dbpedia.org", s.Scope)
+    if err != nil {
+        ...
+    }
+    recs, ok := c.Get(key)
+    if !ok {
+        recs = ... // the recommendations of s.RecommendationQuery()
+        c.Put(key, recs, cache.Complete(s.Scope, len(recs)))
+    }
+
+ Recommendations are keyed by the canonical form of the recommendation query
+ without its keyword and prefix, so that two queries with the same connected
+ scope share their entries. If the recommendations of a broader keyword are complete, i.e.,
+ they are not truncated by the limit, those of a more specific keyword are
+ selected from them without querying the endpoint.
+*/
+package cache
+
+import (
+    "container/list"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "io"
+    "strings"
+    "sync"
+    "time"
+    "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/canonical"
+)
+
+// Key identifies the recommendations of a query to an endpoint
+type Key struct {
+    // The URL of the endpoint
+    Endpoint string
+    // The graph the recommendations are retrieved from, if any
+    Graph string
+    // The hash of the recommendation query without its keyword and prefix
+    Query string
+    // The namespace the recommended items are in, if any
+    Prefix string
+    // The keyword the recommended items match
+    Keyword string
+}
+
+// NewKey returns the key of the recommendations of the scope, retrieved from
+// the graph of the endpoint. An error is returned if the recommendation query
+// of the scope cannot be created.
+func NewKey(endpoint string, graph string, s *autocompletion.Scope) (Key, error) {
+    query, err := s.BaseQuery()
+    if err != nil {
+        return Key{}, err
+    }
+    return Key{
+        Endpoint : endpoint,
+        Graph : graph,
+        Query : hash(query),
+        Prefix : s.Prefix,
+        Keyword : s.Keyword,
+    }, nil
+}
+
+// hash returns the hash of the canonical form of the query, or of the query
+// itself if it cannot be parsed, e.g., because of an extension of a template
+func hash(query string) string {
+    if q, err := canonical.Canonicalize(query); err == nil {
+        return q.Hash
+    }
+    sum := sha256.Sum256([]byte(query))
+    return hex.EncodeToString(sum[:])
+}
+
+// base returns the key of the recommendations of any keyword
+func (k Key) base() Key {
+    k.Keyword = ""
+    return k
+}
+
+// Complete returns true if the n recommendations of the scope are all the items
+// matching its keyword, i.e., they are neither truncated by the limit, nor
+// paged, nor retrieved from a sample.
+func Complete(s *autocompletion.Scope, n int) bool {
+    return s.Offset == 0 && s.Sample == 0 && (s.Limit <= 0 || n < s.Limit)
+}
+
+// Entry is the cached recommendations of a key
+type Entry struct {
+    Key Key
+    Recommendations []autocompletion.Recommendation
+    // True if the recommendations are all the items matching the keyword
+    Complete bool
+    // The time after which the entry is discarded
+    Expires time.Time
+}
+
+// Cache is a least recently used cache of recommendations, whose entries
+// expire after some time. It is safe for concurrent use.
+type Cache struct {
+    // The maximum number of entries. There is no limit if zero.
+    Capacity int
+    // The duration an entry is kept. Entries never expire if zero.
+    TTL time.Duration
+    // If true, the recommendations of a keyword may be selected from the
+    // complete recommendations of a broader keyword. This should be disabled
+    // for templates matching keywords otherwise than with a case-insensitive
+    // regex, e.g., with a full-text index.
+    Refine bool
+    mu sync.Mutex
+    // The entries, from the most recently used to the least
+    entries *list.List
+    elements map[Key]*list.Element
+    // The keywords of the complete entries, by the key of their query
+    complete map[Key]map[string]bool
+    now func() time.Time
+}
+
+// New returns a cache of at most capacity entries, each kept for the ttl
+func New(capacity int, ttl time.Duration) *Cache {
+    return &Cache{
+        Capacity : capacity,
+        TTL : ttl,
+        Refine : true,
+        entries : list.New(),
+        elements : make(map[Key]*list.Element),
+        complete : make(map[Key]map[string]bool),
+        now : time.Now,
+    }
+}
+
+// Get returns the recommendations of the key. If there are none, they are
+// selected from the complete recommendations of a broader keyword, if any.
+func (c *Cache) Get(k Key) ([]autocompletion.Recommendation, bool) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    if e := c.get(k); e != nil {
+        return e.Recommendations, true
+    }
+    if !c.Refine {
+        return nil, false
+    }
+    keyword := strings.ToLower(k.Keyword)
+    for broader := range c.complete[k.base()] {
+        if !strings.Contains(keyword, strings.ToLower(broader)) {
+            continue
+        }
+        b := k
+        b.Keyword = broader
+        if e := c.get(b); e != nil {
+            return filter(e.Recommendations, keyword), true
+        }
+    }
+    return nil, false
+}
+
+// get returns the entry of the key, unless it expired
+func (c *Cache) get(k Key) *Entry {
+    el, ok := c.elements[k]
+    if !ok {
+        return nil
+    }
+    e := el.Value.(*Entry)
+    if !e.Expires.IsZero() && c.now().After(e.Expires) {
+        c.remove(el)
+        return nil
+    }
+    c.entries.MoveToFront(el)
+    return e
+}
+
+// Put adds the recommendations of the key to the cache. If complete, they are
+// all the items matching the keyword.
+func (c *Cache) Put(k Key, recs []autocompletion.Recommendation, complete bool) {
+    e := &Entry{ Key : k, Recommendations : recs, Complete : complete }
+    if c.TTL > 0 {
+        e.Expires = c.now().Add(c.TTL)
+    }
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.add(e)
+}
+
+// add adds the entry as the most recently used, and evicts the least recently
+// used entries above the capacity
+func (c *Cache) add(e *Entry) {
+    if el, ok := c.elements[e.Key]; ok {
+        c.remove(el)
+    }
+    c.elements[e.Key] = c.entries.PushFront(e)
+    if e.Complete && refinable(e.Key) {
+        base := e.Key.base()
+        if c.complete[base] == nil {
+            c.complete[base] = make(map[string]bool)
+        }
+        c.complete[base][e.Key.Keyword] = true
+    }
+    for c.Capacity > 0 && c.entries.Len() > c.Capacity {
+        c.remove(c.entries.Back())
+    }
+}
+
+// remove removes the element of an entry
+func (c *Cache) remove(el *list.Element) {
+    e := c.entries.Remove(el).(*Entry)
+    delete(c.elements, e.Key)
+    base := e.Key.base()
+    if keywords := c.complete[base]; keywords != nil {
+        delete(keywords, e.Key.Keyword)
+        if len(keywords) == 0 {
+            delete(c.complete, base)
+        }
+    }
+}
+
+// Len returns the number of entries, including those that expired but were
+// not yet discarded
+func (c *Cache) Len() int {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    return c.entries.Len()
+}
+
+// Save writes the entries that did not expire as a JSON array, from the most
+// recently used to the least
+func (c *Cache) Save(w io.Writer) error {
+    c.mu.Lock()
+    now := c.now()
+    entries := []*Entry{}
+    for el := c.entries.Front(); el != nil; el = el.Next() {
+        if e := el.Value.(*Entry); e.Expires.IsZero() || !now.After(e.Expires) {
+            entries = append(entries, e)
+        }
+    }
+    c.mu.Unlock()
+    out, err := json.MarshalIndent(entries, "", "  ")
+    if err != nil {
+        return err
+    }
+    _, err = w.Write(append(out, '\n'))
+    return err
+}
+
+// Load adds the entries written by Save, keeping their expiry time. Entries
+// that expired since are discarded.
+func (c *Cache) Load(r io.Reader) error {
+    var entries []*Entry
+    if err := json.NewDecoder(r).Decode(&entries); err != nil {
+        return err
+    }
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    now := c.now()
+    // the least recently used is added first
+    for i := len(entries) - 1; i >= 0; i-- {
+        if e := entries[i]; e.Expires.IsZero() || !now.After(e.Expires) {
+            c.add(e)
+        }
+    }
+    return nil
+}
+
+// refinable returns true if the recommendations of more specific keywords can
+// be selected from those of the key. Without a keyword, the items are in the
+// namespace of the prefix, while a keyword is matched against any item.
+func refinable(k Key) bool {
+    return k.Keyword != "" || k.Prefix == ""
+}
+
+// filter returns the recommendations whose item or label contains the keyword,
+// which is in lower case
+func filter(recs []autocompletion.Recommendation, keyword string) []autocompletion.Recommendation {
+    filtered := []autocompletion.Recommendation{}
+    for _,r := range recs {
+        if strings.Contains(strings.ToLower(r.Item), keyword) || strings.Contains(strings.ToLower(r.Label), keyword) {
+            filtered = append(filtered, r)
+        }
+    }
+    return filtered
+}
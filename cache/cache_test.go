@@ -0,0 +1,181 @@
+package cache
+
+import (
+    "bytes"
+    "reflect"
+    "testing"
+    "time"
+    "github.com/scampi/gosparqled/autocompletion"
+)
+
+// scope returns the scope of the recommendation query of the SPARQL query
+func scope(t *testing.T, query string) *autocompletion.Scope {
+    s := &autocompletion.Sparql{ Buffer : query, Scope : autocompletion.NewScope() }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    return s.Scope
+}
+
+var recs = []autocompletion.Recommendation{
+    { Item : "http://example.org/birthPlace", Label : "place of birth" },
+    { Item : "http://example.org/birthDate" },
+    { Item : "http://example.org/name", Label : "Name" },
+}
+
+// newKey returns the key of the recommendations of the query
+func newKey(t *testing.T, query string) Key {
+    k, err := NewKey("http://a", "", scope(t, query))
+    if err != nil {
+        t.Fatal(err)
+    }
+    return k
+}
+
+func TestNewKey(t *testing.T) {
+    a := newKey(t, "SELECT * { ?s a <Person> ; < }")
+    b := newKey(t, "SELECT * { ?p a <Person> ; birth< }")
+    if a.Query != b.Query {
+        t.Errorf("Expected the queries of the same scope to have the same key\n%v\n%v", a, b)
+    }
+    if b.Keyword != "birth" {
+        t.Errorf("Expected the keyword birth but got %v", b.Keyword)
+    }
+    if c := newKey(t, "SELECT * { ?s a <Place> ; < }"); c.Query == a.Query {
+        t.Errorf("Expected different scopes to have different keys")
+    }
+}
+
+func TestGetPut(t *testing.T) {
+    c := New(2, 0)
+    k := Key{ Endpoint : "http://a", Query : "q" }
+    if _, ok := c.Get(k); ok {
+        t.Error("Expected a miss on an empty cache")
+    }
+    c.Put(k, recs, false)
+    if actual, ok := c.Get(k); !ok || !reflect.DeepEqual(actual, recs) {
+        t.Errorf("Expected %v but got %v", recs, actual)
+    }
+    if _, ok := c.Get(Key{ Endpoint : "http://b", Query : "q" }); ok {
+        t.Error("Expected a miss on another endpoint")
+    }
+    // the least recently used entry is evicted
+    k2, k3 := Key{ Query : "q2" }, Key{ Query : "q3" }
+    c.Put(k2, nil, false)
+    c.Get(k)
+    c.Put(k3, nil, false)
+    if _, ok := c.Get(k2); ok {
+        t.Error("Expected the least recently used entry to be evicted")
+    }
+    if _, ok := c.Get(k); !ok || c.Len() != 2 {
+        t.Errorf("Expected the recently used entry to be kept")
+    }
+}
+
+func TestExpiry(t *testing.T) {
+    now := time.Now()
+    c := New(0, time.Minute)
+    c.now = func() time.Time { return now }
+    k := Key{ Query : "q" }
+    c.Put(k, recs, true)
+    now = now.Add(30 * time.Second)
+    if _, ok := c.Get(k); !ok {
+        t.Error("Expected the entry to be kept")
+    }
+    now = now.Add(time.Minute)
+    if _, ok := c.Get(k); ok || c.Len() != 0 {
+        t.Error("Expected the entry to expire")
+    }
+}
+
+func TestRefine(t *testing.T) {
+    c := New(0, 0)
+    k := Key{ Query : "q", Keyword : "b" }
+    c.Put(k, recs, true)
+    k.Keyword = "BIRTH"
+    expected := recs[:2]
+    if actual, ok := c.Get(k); !ok || !reflect.DeepEqual(actual, expected) {
+        t.Errorf("Expected %v but got %v", expected, actual)
+    }
+    // matched against the label
+    k.Keyword = "of b"
+    if actual, ok := c.Get(k); !ok || !reflect.DeepEqual(actual, recs[:1]) {
+        t.Errorf("Expected %v but got %v", recs[:1], actual)
+    }
+    // not a refinement of the keyword
+    k.Keyword = "name"
+    if _, ok := c.Get(k); ok {
+        t.Error("Expected a miss for a keyword that is not more specific")
+    }
+    // the metacharacters of a regex are matched literally
+    k.Keyword = "b.*th"
+    if actual, ok := c.Get(k); !ok || len(actual) != 0 {
+        t.Errorf("Expected no recommendations but got %v", actual)
+    }
+    // incomplete recommendations are not refined
+    c.Put(Key{ Query : "q2" }, recs, false)
+    if _, ok := c.Get(Key{ Query : "q2", Keyword : "birth" }); ok {
+        t.Error("Expected a miss for incomplete recommendations")
+    }
+    c.Refine = false
+    if _, ok := c.Get(Key{ Query : "q", Keyword : "birth" }); ok {
+        t.Error("Expected a miss without refinement")
+    }
+}
+
+func TestComplete(t *testing.T) {
+    s := autocompletion.NewScope()
+    if !Complete(s, 9) || Complete(s, 10) {
+        t.Error("Expected the recommendations to be complete below the limit")
+    }
+    s.Limit = 0
+    if !Complete(s, 1000) {
+        t.Error("Expected the recommendations to be complete without a limit")
+    }
+    s.Offset = 10
+    if Complete(s, 0) {
+        t.Error("Expected a page not to be complete")
+    }
+}
+
+func TestSaveLoad(t *testing.T) {
+    now := time.Now()
+    c := New(0, time.Minute)
+    c.now = func() time.Time { return now }
+    old := Key{ Query : "old" }
+    c.Put(old, recs, false)
+    now = now.Add(45 * time.Second)
+    c.Put(Key{ Query : "q", Keyword : "b" }, recs, true)
+    var out bytes.Buffer
+    if err := c.Save(&out); err != nil {
+        t.Fatal(err)
+    }
+
+    loaded := New(0, time.Minute)
+    now = now.Add(30 * time.Second)
+    loaded.now = func() time.Time { return now }
+    if err := loaded.Load(&out); err != nil {
+        t.Fatal(err)
+    }
+    if _, ok := loaded.Get(old); ok {
+        t.Error("Expected the expired entry to be discarded")
+    }
+    if actual, ok := loaded.Get(Key{ Query : "q", Keyword : "birth" }); !ok || len(actual) != 2 {
+        t.Errorf("Expected the complete entry to be refined but got %v", actual)
+    }
+}
+
+func TestRefinePrefix(t *testing.T) {
+    c := New(0, 0)
+    // the items in the namespace
+    c.Put(Key{ Query : "q", Prefix : "http://example.org/" }, recs, true)
+    if _, ok := c.Get(Key{ Query : "q", Prefix : "http://example.org/", Keyword : "birth" }); ok {
+        t.Error("Expected a miss since the keyword is matched against any item")
+    }
+    c.Put(Key{ Query : "q", Prefix : "http://example.org/", Keyword : "b" }, recs, true)
+    if _, ok := c.Get(Key{ Query : "q", Prefix : "http://example.org/", Keyword : "birth" }); !ok {
+        t.Error("Expected the keyword to be refined")
+    }
+}
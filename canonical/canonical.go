@@ -0,0 +1,316 @@
+/*
+ Package canonical computes the canonical form of a query, so that two queries
+ have the same canonical form if and only if they are isomorphic, i.e., they
+ are equal up to:
+
+    - the names of their variables and of their blank nodes;
+    - the order of the triple patterns of a basic graph pattern, of the
+      conjunction of filters, and of the rows of a VALUES;
+    - the prefixes and the base IRI;
+    - the lexical forms of their literals, e.g., "01"^^xsd:integer and 1.
+
+ The guarantee holds as long as the variables that cannot be told apart by
+ their role, e.g., in a symmetric pattern, have at most 256 labellings once
+ those that only swap interchangeable variables are pruned. Beyond that, the
+ search for the canonical form is cut, Query.Exact is false, and an
+ isomorphic query may have another form. Equal forms are isomorphic in any
+ case.
+
+ The canonical form is the algebra expression of the query, where variables
+ are renamed after their role in the query. For example, both
+
+    SELECT ?name { ?person <name> ?name ; a <Person> }
+    SELECT ?n { ?p a <Person> . ?p <name> ?n }
+
+ have the same canonical form, and so the same hash.
+*/
+package canonical
+
+import (
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "sort"
+    "strings"
+    "github.com/scampi/gosparqled/algebra"
+)
+
+// The maximum number of labellings of the variables to compare, when some
+// variables cannot be told apart by their role, e.g., in a symmetric pattern.
+// The labellings equal to the first up to an automorphism are pruned, so
+// that a star has as many as its objects. The form is the smallest of those
+// compared, and is not canonical if there are more labellings.
+const maxLeaves = 256
+
+// Query is the canonical form of a query
+type Query struct {
+    // The canonical S-expression of the query
+    Form string
+    // The SHA-256 of the canonical form, in hexadecimal
+    Hash string
+    // True if all the labellings of the variables were compared. Otherwise,
+    // an isomorphic query may have another form.
+    Exact bool
+}
+
+// Canonicalize parses the query and returns its canonical form
+func Canonicalize(query string) (*Query, error) {
+    a, err := algebra.Parse(query)
+    if err != nil {
+        return nil, err
+    }
+    return FromAlgebra(a), nil
+}
+
+// FromAlgebra returns the canonical form of the algebra of a query
+func FromAlgebra(a *algebra.Algebra) *Query {
+    c := &canonicalizer{ algebra : a }
+    // the variables are collected while rendering
+    vars := make(map[string]bool)
+    c.render(func(v string) string {
+        vars[v] = true
+        return v
+    })
+    color := make(map[string]int)
+    for v := range vars {
+        c.vars = append(c.vars, v)
+        color[v] = kind(v)
+    }
+    sort.Strings(c.vars)
+    c.search(color, nil)
+    hash := sha256.Sum256([]byte(c.best))
+    return &Query{ Form : c.best, Hash : hex.EncodeToString(hash[:]), Exact : !c.cut }
+}
+
+// kind returns the kind of the variable: a variable of the query, a blank node
+// or a path variable, or the variable of an aggregate
+func kind(v string) int {
+    switch {
+    case strings.HasPrefix(v, "??"):
+        return 1
+    case strings.HasPrefix(v, "?."):
+        return 2
+    }
+    return 0
+}
+
+// The prefixes of the names of the kinds of variables
+var kindPrefixes = []string{ "?v", "??b", "?.a" }
+
+// canonicalizer holds the state of the search for the canonical form, among
+// the labellings of the variables
+type canonicalizer struct {
+    algebra *algebra.Algebra
+    // The variables of the query, sorted
+    vars []string
+    // The smallest form found, and the number of labellings compared
+    best string
+    leaves int
+    // True if some labellings were not compared
+    cut bool
+    // The form of the first labelling, its colors, and the variables
+    // individualized to reach it, in order
+    first string
+    firstColor map[string]int
+    firstPath []string
+    // The automorphisms found, i.e., the renamings of the variables that give
+    // the form of the first labelling
+    automorphisms []map[string]string
+}
+
+// search refines the colors of the variables, and individualizes in turn each
+// variable of the first class with several variables, until all of them have
+// their own color. The canonical form is the smallest form over the labellings.
+// The variables of the path are individualized, in order. The variables of a
+// class in the same orbit as one already individualized, under the
+// automorphisms that fix the path, give the same forms and are skipped.
+// If a labelling gives the form of the first one, the search returns the length
+// of the path common with the first labelling, up to which it goes back since
+// the rest is an automorphic image of what has been searched. It returns -1
+// otherwise.
+func (c *canonicalizer) search(color map[string]int, path []string) int {
+    color = c.refine(color)
+    classes := make(map[int][]string)
+    for _,v := range c.vars {
+        classes[color[v]] = append(classes[color[v]], v)
+    }
+    first := -1
+    for col,members := range classes {
+        if len(members) > 1 && (first == -1 || col < first) {
+            first = col
+        }
+    }
+    if first == -1 {
+        return c.leaf(color, path)
+    }
+    var tried []string
+    for _,v := range classes[first] {
+        if c.leaves >= maxLeaves {
+            c.cut = true
+            return -1
+        }
+        if c.sameOrbit(v, tried, path) {
+            continue
+        }
+        tried = append(tried, v)
+        individualized := make(map[string]int)
+        for u,col := range color {
+            individualized[u] = 2 * col + 1
+        }
+        individualized[v] = 2 * color[v]
+        if back := c.search(individualized, append(path[:len(path):len(path)], v)); back != -1 && back < len(path) {
+            return back
+        }
+    }
+    return -1
+}
+
+// leaf compares the form of the labelling with the best one. If it is the form
+// of the first labelling, the automorphism is recorded and the length of the
+// path common with the first labelling is returned, and -1 otherwise.
+func (c *canonicalizer) leaf(color map[string]int, path []string) int {
+    form := c.render(func(v string) string {
+        return fmt.Sprintf("%v%v", kindPrefixes[kind(v)], color[v])
+    })
+    c.leaves++
+    if c.leaves == 1 {
+        c.best, c.first, c.firstColor, c.firstPath = form, form, color, path
+        return -1
+    }
+    if form < c.best {
+        c.best = form
+    }
+    if form != c.first {
+        return -1
+    }
+    // the variable with the same color as in the first labelling
+    byColor := make(map[int]string)
+    for v,col := range color {
+        byColor[col] = v
+    }
+    automorphism := make(map[string]string)
+    for v,col := range c.firstColor {
+        automorphism[v] = byColor[col]
+    }
+    c.automorphisms = append(c.automorphisms, automorphism)
+    common := 0
+    for common < len(path) && common < len(c.firstPath) && path[common] == c.firstPath[common] {
+        common++
+    }
+    return common
+}
+
+// sameOrbit returns true if the variable is in the orbit of one of the others
+// under the automorphisms that fix each variable of the path
+func (c *canonicalizer) sameOrbit(v string, others []string, path []string) bool {
+    if len(others) == 0 {
+        return false
+    }
+    parent := make(map[string]string)
+    var find func(string) string
+    find = func(u string) string {
+        if p, ok := parent[u]; ok && p != u {
+            parent[u] = find(p)
+            return parent[u]
+        }
+        return u
+    }
+    for _,a := range c.automorphisms {
+        fixed := true
+        for _,u := range path {
+            if a[u] != u {
+                fixed = false
+                break
+            }
+        }
+        if !fixed {
+            continue
+        }
+        for u,w := range a {
+            if ru, rw := find(u), find(w); ru != rw {
+                parent[ru] = rw
+            }
+        }
+    }
+    for _,u := range others {
+        if find(u) == find(v) {
+            return true
+        }
+    }
+    return false
+}
+
+// refine splits the classes of variables with a same color, according to the
+// colors of the variables they appear with, until the classes are stable. The
+// colors are numbered from 0.
+func (c *canonicalizer) refine(color map[string]int) map[string]int {
+    for {
+        signatures := make(map[string]string)
+        distinct := make(map[string]bool)
+        for _,v := range c.vars {
+            form := c.render(func(u string) string {
+                if u == v {
+                    return "?*"
+                }
+                return fmt.Sprintf("?c%v", color[u])
+            })
+            signatures[v] = fmt.Sprintf("%08d|%v", color[v], form)
+            distinct[signatures[v]] = true
+        }
+        var sorted []string
+        for s := range distinct {
+            sorted = append(sorted, s)
+        }
+        sort.Strings(sorted)
+        rank := make(map[string]int)
+        for i,s := range sorted {
+            rank[s] = i
+        }
+        refined := make(map[string]int)
+        classes := make(map[int]bool)
+        for _,v := range c.vars {
+            refined[v] = rank[signatures[v]]
+            classes[color[v]] = true
+        }
+        if len(sorted) == len(classes) {
+            return refined
+        }
+        color = refined
+    }
+}
+
+// render returns the S-expression of the query, with the variables renamed by
+// the function and the literals normalized. The triple patterns, the filters
+// and the rows of tables are sorted.
+func (c *canonicalizer) render(label func(string) string) string {
+    r := &rewriter{ label : label }
+    a := c.algebra
+    header := "(" + a.Form
+    var describe []string
+    for _,term := range a.Describe {
+        describe = append(describe, r.term(term))
+    }
+    sort.Strings(describe)
+    header += prefixAll(" ", describe) + ")\n"
+    for i,from := range [][]string{ a.From, a.FromNamed } {
+        if len(from) == 0 {
+            continue
+        }
+        sorted := append([]string{}, from...)
+        sort.Strings(sorted)
+        header += "(" + []string{ "from", "from named" }[i] + prefixAll(" ", sorted) + ")\n"
+    }
+    if a.Form == "construct" {
+        header += "(template " + algebra.Print(r.bgp(&algebra.BGP{ Triples : a.Template })) + ")\n"
+    }
+    return header + algebra.Print(r.op(a.Op))
+}
+
+// prefixAll returns the concatenation of the values, each preceded by the separator
+func prefixAll(sep string, values []string) string {
+    out := ""
+    for _,v := range values {
+        out += sep + v
+    }
+    return out
+}
@@ -0,0 +1,146 @@
+package canonical
+
+import (
+    "fmt"
+    "testing"
+)
+
+func canonicalize(t *testing.T, query string) *Query {
+    c, err := Canonicalize(query)
+    if err != nil {
+        t.Fatalf("Failed to canonicalize query\n%v\n%v", query, err)
+    }
+    return c
+}
+
+func TestEquivalent(t *testing.T) {
+    tests := [][]string{
+        // renaming of the variables and of the blank nodes
+        { `SELECT ?name { ?person <name> ?name ; a <Person> }`,
+          `SELECT ?n { ?p a <Person> . ?p <name> ?n }` },
+        { `SELECT * { ?s <p> _:b1 . _:b1 <q> ?o }`,
+          `SELECT * { ?x <p> [ <q> ?y ] }` },
+        // order of the triple patterns and of the filters
+        { `SELECT * { ?a <p> ?b . ?b <q> ?c FILTER(?a != ?c) FILTER(?b > 1) }`,
+          `SELECT * { ?y <q> ?z FILTER(?y > 1) . ?x <p> ?y FILTER(?x != ?z) }` },
+        // prefixes and base
+        { `PREFIX ex: <http://example.org/> SELECT * { ?s ex:p ?o }`,
+          `BASE <http://example.org/> SELECT * { ?x <p> ?y }` },
+        // lexical forms of the literals
+        { `SELECT * { ?s <p> 1, 2.50, true, "a", "b"@EN }`,
+          `SELECT * { ?s <p> "01"^^<http://www.w3.org/2001/XMLSchema#integer>, +2.5, "true"^^<http://www.w3.org/2001/XMLSchema#boolean>,
+                      "a"^^<http://www.w3.org/2001/XMLSchema#string>, "b"@en }` },
+        // symmetric patterns
+        { `SELECT * { ?a <p> ?b . ?c <p> ?d . ?b <q> ?d }`,
+          `SELECT * { ?w <p> ?x . ?y <p> ?z . ?z <q> ?x }` },
+        { `SELECT * { ?a <p> ?b . ?b <p> ?c . ?c <p> ?a }`,
+          `SELECT * { ?z <p> ?x . ?y <p> ?z . ?x <p> ?y }` },
+        // order of the rows of a VALUES
+        { `SELECT * { VALUES ?x { 1 2 } ?x <p> ?o }`,
+          `SELECT * { VALUES ?y { 2 1 } ?y <p> ?z }` },
+    }
+    for _,test := range tests {
+        a, b := canonicalize(t, test[0]), canonicalize(t, test[1])
+        if a.Hash != b.Hash {
+            t.Errorf("Expected equal canonical forms for\n%v\n%v\nbut got\n%v\n%v", test[0], test[1], a.Form, b.Form)
+        }
+    }
+}
+
+func TestDifferent(t *testing.T) {
+    tests := [][]string{
+        // same IRIs, different structure
+        { `SELECT * { ?a <p> ?b . ?b <q> ?c }`,
+          `SELECT * { ?a <p> ?b . ?a <q> ?c }` },
+        { `SELECT * { ?a <p> ?b . ?c <p> ?d . ?b <q> ?d }`,
+          `SELECT * { ?a <p> ?b . ?c <p> ?d . ?b <q> ?c }` },
+        // projected variables
+        { `SELECT ?a { ?a <p> ?b }`,
+          `SELECT ?b { ?a <p> ?b }` },
+        { `SELECT ?a ?b { ?a <p> ?b }`,
+          `SELECT ?b ?a { ?a <p> ?b }` },
+        // a blank node is not returned by SELECT *
+        { `SELECT * { ?a <p> ?b }`,
+          `SELECT * { ?a <p> [] }` },
+        // different literals and forms
+        { `SELECT * { ?s <p> 1 }`,
+          `SELECT * { ?s <p> "1" }` },
+        { `SELECT * { ?s <p> "a"@en }`,
+          `SELECT * { ?s <p> "a"@fr }` },
+        { `ASK { ?s <p> ?o }`,
+          `SELECT * { ?s <p> ?o }` },
+        { `SELECT * FROM <a> { ?s <p> ?o }`,
+          `SELECT * { ?s <p> ?o }` },
+        { `CONSTRUCT { ?s <q> ?o } { ?s <p> ?o }`,
+          `CONSTRUCT { ?o <q> ?s } { ?s <p> ?o }` },
+    }
+    for _,test := range tests {
+        if a, b := canonicalize(t, test[0]), canonicalize(t, test[1]); a.Hash == b.Hash {
+            t.Errorf("Expected different canonical forms for\n%v\n%v\nbut got\n%v", test[0], test[1], a.Form)
+        }
+    }
+}
+
+func TestForm(t *testing.T) {
+    c := canonicalize(t, `SELECT ?name { ?person <name> ?name ; a <Person> }`)
+    expected := `(select)
+(project (?v0)
+  (bgp
+    (triple ?v1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <Person>)
+    (triple ?v1 <name> ?v0)
+  ))`
+    if c.Form != expected {
+        t.Errorf("Expected\n%v\nbut got\n%v", expected, c.Form)
+    }
+}
+
+func TestExact(t *testing.T) {
+    if c := canonicalize(t, `SELECT * { ?a <p> ?b . ?b <p> ?a }`); !c.Exact {
+        t.Errorf("Expected all the labellings to be compared\n%v", c.Form)
+    }
+    // the labellings that are automorphic images of compared ones are pruned,
+    // e.g., the 7! labellings of the symmetric patterns, or the n! of a star
+    patterns, star := "", ""
+    for i := 0; i < 7; i++ {
+        patterns += fmt.Sprintf(" ?a%v <p> ?b%v .", i, i)
+    }
+    for i := 0; i < 16; i++ {
+        star += fmt.Sprintf(" ?s <p> ?o%v .", i)
+    }
+    for _,query := range []string{ "SELECT * {" + patterns + " }", "SELECT * {" + star + " }" } {
+        if c := canonicalize(t, query); !c.Exact {
+            t.Errorf("Expected all the labellings to be compared\n%v", c.Form)
+        }
+    }
+    // the objects of a star are renamed in any order
+    renamed := ""
+    for i := 15; i >= 0; i-- {
+        renamed += fmt.Sprintf(" ?x <p> ?y%v .", i)
+    }
+    if a, b := canonicalize(t, "SELECT * {" + star + " }"), canonicalize(t, "SELECT * {" + renamed + " }"); a.Hash != b.Hash {
+        t.Errorf("Expected equal canonical forms but got\n%v\n%v", a.Form, b.Form)
+    }
+}
+
+func TestNormalize(t *testing.T) {
+    tests := map[string]string{
+        `007` : `7`,
+        `+1` : `1`,
+        `-0` : `0`,
+        `-01` : `-1`,
+        `1.500` : `1.5`,
+        `.5` : `0.5`,
+        `-.50` : `-0.5`,
+        `-0.0` : `0.0`,
+        `1e3` : `1E+03`,
+        `"1.0"^^<http://www.w3.org/2001/XMLSchema#double>` : `1E+00`,
+        `"x"^^<http://www.w3.org/2001/XMLSchema#integer>` : `"x"^^<http://www.w3.org/2001/XMLSchema#integer>`,
+        `"a"@EN-gb` : `"a"@en-gb`,
+        `<http://example.org/>` : `<http://example.org/>`,
+    }
+    for term, expected := range tests {
+        if actual := normalize(term); actual != expected {
+            t.Errorf("Expected %v but got %v for %v", expected, actual, term)
+        }
+    }
+}
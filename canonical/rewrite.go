@@ -0,0 +1,248 @@
+package canonical
+
+import (
+    "regexp"
+    "sort"
+    "strconv"
+    "strings"
+    "github.com/scampi/gosparqled/algebra"
+)
+
+const xsd = "http://www.w3.org/2001/XMLSchema#"
+
+// A literal with a datatype, whose lexical form has no escape sequence
+var typedRe = regexp.MustCompile(`^"([^"\\]*)"\^\^<([^>]*)>$`)
+
+// A literal with a language tag
+var langRe = regexp.MustCompile(`^(".*")@([a-zA-Z0-9\-]+)$`)
+
+var integerRe = regexp.MustCompile(`^[+\-]?[0-9]+$`)
+var decimalRe = regexp.MustCompile(`^[+\-]?[0-9]*\.[0-9]+$`)
+var doubleRe = regexp.MustCompile(`^[+\-]?([0-9]+\.?[0-9]*|\.[0-9]+)[eE][+\-]?[0-9]+$`)
+
+// rewriter copies an operator, with its variables renamed and its literals
+// normalized. The parts of the operator whose order does not matter are sorted.
+type rewriter struct {
+    label func(string) string
+}
+
+// term returns the variable renamed, or the normalized literal
+func (r *rewriter) term(term string) string {
+    if strings.HasPrefix(term, "?") {
+        return r.label(term)
+    }
+    return normalize(term)
+}
+
+// normalize returns the shortest form of the numbers and of the booleans, the
+// literals of type xsd:string as simple literals, and the language tags in lower case
+func normalize(term string) string {
+    if m := langRe.FindStringSubmatch(term); m != nil {
+        return m[1] + "@" + strings.ToLower(m[2])
+    }
+    lexical, datatype := term, ""
+    if m := typedRe.FindStringSubmatch(term); m != nil {
+        lexical, datatype = m[1], strings.TrimPrefix(m[2], xsd)
+        if datatype == "string" {
+            return `"` + lexical + `"`
+        }
+    }
+    switch {
+    case (datatype == "" || datatype == "integer") && integerRe.MatchString(lexical):
+        return normalizeInteger(lexical)
+    case (datatype == "" || datatype == "decimal") && decimalRe.MatchString(lexical):
+        parts := strings.SplitN(lexical, ".", 2)
+        fraction := strings.TrimRight(parts[1], "0")
+        if fraction == "" {
+            fraction = "0"
+        }
+        sign := ""
+        if strings.HasPrefix(parts[0], "-") && strings.Trim(parts[0] + fraction, "-0") != "" {
+            sign = "-"
+        }
+        integer := strings.TrimLeft(parts[0], "+-0")
+        if integer == "" {
+            integer = "0"
+        }
+        return sign + integer + "." + fraction
+    case doubleRe.MatchString(lexical) && (datatype == "" || datatype == "double"),
+        datatype == "double" && (integerRe.MatchString(lexical) || decimalRe.MatchString(lexical)):
+        if f, err := strconv.ParseFloat(lexical, 64); err == nil {
+            return strconv.FormatFloat(f, 'E', -1, 64)
+        }
+    case datatype == "boolean" && (lexical == "true" || lexical == "false"):
+        return lexical
+    }
+    return term
+}
+
+// normalizeInteger returns the integer without sign if positive, and without
+// leading zeros
+func normalizeInteger(integer string) string {
+    sign := ""
+    switch integer[0] {
+    case '-':
+        sign = "-"
+        fallthrough
+    case '+':
+        integer = integer[1:]
+    }
+    integer = strings.TrimLeft(integer, "0")
+    if integer == "" {
+        return "0"
+    }
+    return sign + integer
+}
+
+// terms returns the terms rewritten
+func (r *rewriter) terms(terms []string) []string {
+    out := make([]string, len(terms))
+    for i,term := range terms {
+        out[i] = r.term(term)
+    }
+    return out
+}
+
+// bgp returns the triple patterns rewritten and sorted
+func (r *rewriter) bgp(bgp *algebra.BGP) *algebra.BGP {
+    triples := make([]algebra.Triple, len(bgp.Triples))
+    for i,t := range bgp.Triples {
+        triples[i] = algebra.Triple{ S : r.term(t.S), P : r.term(t.P), O : r.term(t.O) }
+    }
+    sort.Slice(triples, func(i, j int) bool {
+        a, b := triples[i], triples[j]
+        if a.S != b.S {
+            return a.S < b.S
+        }
+        if a.P != b.P {
+            return a.P < b.P
+        }
+        return a.O < b.O
+    })
+    return &algebra.BGP{ Triples : triples }
+}
+
+// sortedExprs returns the expressions of a conjunction rewritten and sorted
+func (r *rewriter) sortedExprs(exprs []algebra.Expr) []algebra.Expr {
+    out := make([]algebra.Expr, len(exprs))
+    printed := make(map[algebra.Expr]string)
+    for i,expr := range exprs {
+        out[i] = r.expr(expr)
+        printed[out[i]] = algebra.PrintExpr(out[i])
+    }
+    sort.SliceStable(out, func(i, j int) bool { return printed[out[i]] < printed[out[j]] })
+    return out
+}
+
+// bindings returns the bindings rewritten
+func (r *rewriter) bindings(bindings []algebra.Binding) []algebra.Binding {
+    out := make([]algebra.Binding, len(bindings))
+    for i,b := range bindings {
+        out[i] = algebra.Binding{ Var : b.Var, Expr : r.expr(b.Expr) }
+        if b.Var != "" {
+            out[i].Var = r.term(b.Var)
+        }
+    }
+    return out
+}
+
+// op returns a copy of the operator rewritten
+func (r *rewriter) op(op algebra.Op) algebra.Op {
+    switch o := op.(type) {
+    case *algebra.BGP:
+        return r.bgp(o)
+    case *algebra.PathOp:
+        return &algebra.PathOp{ S : r.term(o.S), Path : o.Path, O : r.term(o.O) }
+    case *algebra.Table:
+        rows := make([][]string, len(o.Rows))
+        printed := make([]string, len(o.Rows))
+        for i,row := range o.Rows {
+            rows[i] = r.terms(row)
+            printed[i] = strings.Join(rows[i], " ")
+        }
+        sort.Sort(&byKey{ rows, printed })
+        return &algebra.Table{ Vars : r.terms(o.Vars), Rows : rows }
+    case *algebra.Sequence:
+        ops := make([]algebra.Op, len(o.Ops))
+        printed := make(map[algebra.Op]string)
+        for i,child := range o.Ops {
+            ops[i] = r.op(child)
+            printed[ops[i]] = algebra.Print(ops[i])
+        }
+        sort.SliceStable(ops, func(i, j int) bool { return printed[ops[i]] < printed[ops[j]] })
+        return &algebra.Sequence{ Ops : ops }
+    case *algebra.Join:
+        return &algebra.Join{ Left : r.op(o.Left), Right : r.op(o.Right) }
+    case *algebra.LeftJoin:
+        return &algebra.LeftJoin{ Left : r.op(o.Left), Right : r.op(o.Right), Exprs : r.sortedExprs(o.Exprs) }
+    case *algebra.Filter:
+        return &algebra.Filter{ Exprs : r.sortedExprs(o.Exprs), Op : r.op(o.Op) }
+    case *algebra.Union:
+        return &algebra.Union{ Left : r.op(o.Left), Right : r.op(o.Right) }
+    case *algebra.Minus:
+        return &algebra.Minus{ Left : r.op(o.Left), Right : r.op(o.Right) }
+    case *algebra.Graph:
+        return &algebra.Graph{ Name : r.term(o.Name), Op : r.op(o.Op) }
+    case *algebra.Service:
+        return &algebra.Service{ Name : r.term(o.Name), Silent : o.Silent, Op : r.op(o.Op) }
+    case *algebra.Extend:
+        return &algebra.Extend{ Bindings : r.bindings(o.Bindings), Op : r.op(o.Op) }
+    case *algebra.Group:
+        aggregations := make([]algebra.Aggregation, len(o.Aggregations))
+        for i,a := range o.Aggregations {
+            aggregations[i] = algebra.Aggregation{ Var : r.term(a.Var), Aggregate : r.expr(a.Aggregate).(*algebra.Aggregate) }
+        }
+        return &algebra.Group{ Keys : r.bindings(o.Keys), Aggregations : aggregations, Op : r.op(o.Op) }
+    case *algebra.OrderBy:
+        conditions := make([]algebra.OrderCondition, len(o.Conditions))
+        for i,c := range o.Conditions {
+            conditions[i] = algebra.OrderCondition{ Expr : r.expr(c.Expr), Direction : c.Direction }
+        }
+        return &algebra.OrderBy{ Conditions : conditions, Op : r.op(o.Op) }
+    case *algebra.Project:
+        return &algebra.Project{ Vars : r.terms(o.Vars), Op : r.op(o.Op) }
+    case *algebra.Distinct:
+        return &algebra.Distinct{ Op : r.op(o.Op) }
+    case *algebra.Reduced:
+        return &algebra.Reduced{ Op : r.op(o.Op) }
+    case *algebra.Slice:
+        return &algebra.Slice{ Offset : o.Offset, Limit : o.Limit, Op : r.op(o.Op) }
+    }
+    return op
+}
+
+// expr returns a copy of the expression rewritten
+func (r *rewriter) expr(expr algebra.Expr) algebra.Expr {
+    switch e := expr.(type) {
+    case algebra.Term:
+        return algebra.Term(r.term(string(e)))
+    case *algebra.Call:
+        args := make([]algebra.Expr, len(e.Args))
+        for i,arg := range e.Args {
+            args[i] = r.expr(arg)
+        }
+        return &algebra.Call{ Name : e.Name, Args : args }
+    case *algebra.Exists:
+        return &algebra.Exists{ Not : e.Not, Op : r.op(e.Op) }
+    case *algebra.Aggregate:
+        aggregate := *e
+        if e.Expr != nil {
+            aggregate.Expr = r.expr(e.Expr)
+        }
+        return &aggregate
+    }
+    return expr
+}
+
+// byKey sorts the rows of a table by their printed form
+type byKey struct {
+    rows [][]string
+    keys []string
+}
+
+func (b *byKey) Len() int { return len(b.rows) }
+func (b *byKey) Less(i, j int) bool { return b.keys[i] < b.keys[j] }
+func (b *byKey) Swap(i, j int) {
+    b.rows[i], b.rows[j] = b.rows[j], b.rows[i]
+    b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
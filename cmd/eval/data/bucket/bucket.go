@@ -0,0 +1,29 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "os"
+    "github.com/golang/glog"
+    "github.com/scampi/gosparqled/eval/data"
+)
+
+var queries = flag.String("queries", "", "The path to the queries file, with queries separated by a line with \"###\"")
+var output = flag.String("output", "", "The path to the directory of the query_<signature> files")
+
+func missingOption(option string) {
+    fmt.Println("Missing option -" + option)
+    flag.Usage()
+    os.Exit(1)
+}
+
+func main() {
+    flag.Parse()
+    defer glog.Flush()
+
+    if *queries == "" { missingOption("queries") }
+    if *output == "" { missingOption("output") }
+
+    if err := os.MkdirAll(*output, 0755); err != nil { glog.Fatal(err) }
+    data.Bucket(*queries, *output)
+}
@@ -7,9 +7,7 @@ import (
     "bufio"
     "errors"
     "bytes"
-    "regexp"
-    "sort"
-    "strings"
+    "github.com/scampi/gosparqled/canonical"
 )
 
 var queries = flag.String("queries", "", "The path to the queries file")
@@ -25,6 +23,9 @@ func main() {
     if err != nil { log.Fatal(err) }
     r := bufio.NewScanner(fi)
     split := func(data []byte, atEOF bool) (int, []byte, error) {
+        if atEOF && len(data) == 0 {
+            return 0, nil, nil
+        }
         ind := bytes.Index(data, []byte("###\n"))
         if ind == -1 {
             if atEOF {
@@ -35,13 +36,18 @@ func main() {
         return ind + 4, data[:ind-1], nil
     }
 
-    reg := regexp.MustCompile("<[^>]*>")
     r.Split(split)
+    // queries are duplicates if their canonical forms are equal. A query that
+    // cannot be canonicalized is kept as is, and is a duplicate of the same text
+    // only.
     duplicates := make(map[string]string)
     for r.Scan() {
-        uris := reg.FindAllString(r.Text(), -1)
-        sort.Strings(uris)
-        key := strings.Join(uris, " ")
+        key := "raw " + r.Text()
+        if c, err := canonical.Canonicalize(r.Text()); err == nil {
+            key = c.Hash
+        } else {
+            log.Printf("Keeping the query that cannot be canonicalized: %v", err)
+        }
         if v, ok := duplicates[key]; ok {
             log.Printf("Duplicate: %v", v)
         } else {
@@ -0,0 +1,46 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "os"
+    "path/filepath"
+    "github.com/golang/glog"
+    "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/eval/data"
+)
+
+var queries = flag.String("queries", "", "The pattern of the paths to the queries files, with queries separated by a line with \"###\"")
+var output = flag.String("output", "", "The path to the model file")
+
+func missingOption(option string) {
+    fmt.Println("Missing option -" + option)
+    flag.Usage()
+    os.Exit(1)
+}
+
+func main() {
+    flag.Parse()
+    defer glog.Flush()
+
+    if *queries == "" { missingOption("queries") }
+    if *output == "" { missingOption("output") }
+
+    files, err := filepath.Glob(*queries)
+    if err != nil { glog.Fatal(err) }
+    m := autocompletion.NewModel()
+    total := 0
+    for _,file := range files {
+        for _,query := range data.Load(file) {
+            total++
+            if err := m.Learn(query); err != nil {
+                glog.Warningf("Skipping invalid query [%s]: %v", query, err)
+            }
+        }
+    }
+    fi, err := os.Create(*output)
+    if err != nil { glog.Fatal(err) }
+    defer fi.Close()
+    if err := m.Save(fi); err != nil { glog.Fatal(err) }
+    glog.Infof("Trained the model on %v queries out of %v", m.Queries, total)
+}
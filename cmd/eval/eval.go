@@ -4,16 +4,18 @@ import (
     "os"
     "bufio"
     "github.com/golang/glog"
+    "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/endpoint"
     "github.com/scampi/gosparqled/eval"
     "github.com/scampi/gosparqled/eval/data"
     "fmt"
     "flag"
-    "strconv"
 )
 
 var queries = flag.String("queries", "", "The path to the queries file")
 var limit = flag.Int("limit", 10, "The value of the LIMIT clause")
-var endpoint = flag.String("endpoint", "", "The SPARQL endpoint")
+var sparqlEndpoint = flag.String("endpoint", "", "The SPARQL endpoint")
+var settings = endpoint.SettingsFlags(flag.CommandLine, endpoint.PublicSettings)
 var output = flag.String("output", "results", "The path to the output file")
 var recGraph = flag.String("rec-graph", "", "The named graph to get recommendations from")
 var countGraph = flag.String("count-graph", "", "The named graph to get the count of each recommendation")
@@ -29,24 +31,16 @@ func main() {
     defer glog.Flush()
 
     if *queries == "" { missingOption("queries") }
-    if *endpoint == "" { missingOption("endpoint") }
+    if *sparqlEndpoint == "" { missingOption("endpoint") }
+    endpoint.Configure(*sparqlEndpoint, *settings)
     if *output == "" { missingOption("output") }
     if *recGraph == "" { missingOption("rec-graph") }
     if *countGraph == "" { missingOption("count-graph") }
 
-    tmpl := "SELECT ?POF ?count FROM <" + *recGraph + ">" +
-        ` WHERE {
-         {{range .Tps}}
-             {{.S}} {{.P}} {{.O}} .
-         {{end}}
-         {{if .Keyword}}
-             FILTER regex(?POF, "{{.Keyword}}", "i")
-         {{end}}
-             BIND(1 as ?count)
-             FILTER(?POF != <http://www.w3.org/1999/02/22-rdf-syntax-ns#type>)
-         }
-    `
-    tmpl += "LIMIT " + strconv.Itoa(*limit)
+    scope, err := autocompletion.NewScopeByName("measure")
+    if err != nil { glog.Fatal(err) }
+    scope.Graph = *recGraph
+    scope.Limit = *limit
     file := data.Load(*queries)
     glog.Infof("Processing file [%s]", *queries)
 
@@ -60,7 +54,7 @@ func main() {
         glog.Infof("\tProcessing query [%s]", query)
         for _,pof := range data.POFs(query) {
             glog.Infof("\t\tProcessing [%s]", pof)
-            measure := eval.Measure(*endpoint, *countGraph, pof, tmpl)
+            measure := eval.Measure(*sparqlEndpoint, *countGraph, pof, scope)
             w.WriteString(fmt.Sprintf("%v %v %v %v %v %v\n", measure.Min, measure.Max, measure.Avg, measure.Length, measure.ElapsedTime, measure.Recs))
         }
     }
@@ -4,6 +4,8 @@ import (
     "os"
     "bufio"
     "github.com/golang/glog"
+    "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/endpoint"
     "github.com/scampi/gosparqled/eval"
     "github.com/scampi/gosparqled/eval/data"
     "fmt"
@@ -11,7 +13,8 @@ import (
 )
 
 var queries = flag.String("queries", "", "The path to the queries file")
-var endpoint = flag.String("endpoint", "", "The SPARQL endpoint")
+var sparqlEndpoint = flag.String("endpoint", "", "The SPARQL endpoint")
+var settings = endpoint.SettingsFlags(flag.CommandLine, endpoint.PublicSettings)
 var output = flag.String("output", "results", "The path to the output file")
 var graph = flag.String("graph", "", "The named graph to get the count of each recommendation")
 
@@ -26,23 +29,13 @@ func main() {
     defer glog.Flush()
 
     if *queries == "" { missingOption("queries") }
-    if *endpoint == "" { missingOption("endpoint") }
+    if *sparqlEndpoint == "" { missingOption("endpoint") }
+    endpoint.Configure(*sparqlEndpoint, *settings)
     if *output == "" { missingOption("output") }
     if *graph == "" { missingOption("graph") }
 
-    tmpl := "SELECT ?POF (COUNT(?POF) AS ?count) FROM <" + *graph + ">" +
-        ` WHERE {
-         {{range .Tps}}
-             {{.S}} {{.P}} {{.O}} .
-         {{end}}
-         {{if .Keyword}}
-             FILTER regex(?POF, "{{.Keyword}}", "i")
-         {{end}}
-             FILTER(?POF != <http://www.w3.org/1999/02/22-rdf-syntax-ns#type>)
-         }
-         ORDER BY DESC(?count)
-         LIMIT 10
-    `
+    scope, err := autocompletion.NewScopeByName("gold")
+    if err != nil { glog.Fatal(err) }
     file := data.Load(*queries)
     glog.Infof("Processing file [%s]", *queries)
 
@@ -56,7 +49,7 @@ func main() {
         glog.Infof("\tProcessing query [%s]", query)
         for _,pof := range data.POFs(query) {
             glog.Infof("\t\tProcessing [%s]", pof)
-            gold := eval.Gold(*endpoint, *graph, pof, tmpl)
+            gold := eval.Gold(*sparqlEndpoint, *graph, pof, scope)
             w.WriteString(fmt.Sprintf("%v\n", gold))
         }
     }
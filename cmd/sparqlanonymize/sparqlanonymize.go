@@ -0,0 +1,111 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "io/ioutil"
+    "os"
+    "strings"
+    "github.com/scampi/gosparqled/anonymize"
+)
+
+var namespaces = flag.String("namespaces", "", "The namespaces of the IRIs to anonymize, separated by commas")
+var mappingFile = flag.String("mapping", "", "The path to the file of the mapping of the pseudonyms to the original terms. It is loaded if it exists, and saved with the new pseudonyms. It must be kept private.")
+var restore = flag.Bool("restore", false, "Restore the anonymized queries with the mapping")
+
+func usage() {
+    fmt.Fprintln(os.Stderr, "Usage: sparqlanonymize [options] [query files]")
+    fmt.Fprintln(os.Stderr, "The queries are read from the standard input if no file is given.")
+    fmt.Fprintln(os.Stderr, "Queries in a file are separated by a line equal to \"###\".")
+    flag.PrintDefaults()
+}
+
+func fail(err error) {
+    fmt.Fprintln(os.Stderr, err)
+    os.Exit(2)
+}
+
+// transform returns the query anonymized or restored. An invalid query is
+// dropped, so that no sensitive content is left.
+func transform(a *anonymize.Anonymizer, query string) string {
+    var out string
+    var err error
+    if *restore {
+        out, err = a.Restore(query)
+    } else {
+        out, err = a.Anonymize(query)
+    }
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "Skipping invalid query\n%v\n%v\n", query, err)
+        return ""
+    }
+    return out
+}
+
+func main() {
+    flag.Usage = usage
+    flag.Parse()
+
+    if *mappingFile == "" {
+        flag.Usage()
+        fail(fmt.Errorf("Missing mapping option"))
+    }
+    var ns []string
+    if *namespaces != "" {
+        for _,n := range strings.Split(*namespaces, ",") {
+            ns = append(ns, strings.TrimSpace(n))
+        }
+    }
+    a := anonymize.NewAnonymizer(ns)
+    if f, err := os.Open(*mappingFile); err == nil {
+        err = a.LoadMapping(f)
+        f.Close()
+        if err != nil {
+            fail(err)
+        }
+    } else if !os.IsNotExist(err) || *restore {
+        fail(err)
+    }
+
+    files := flag.Args()
+    if len(files) == 0 {
+        files = []string{ "-" }
+    }
+    for _,file := range files {
+        var content []byte
+        var err error
+        if file == "-" {
+            content, err = ioutil.ReadAll(os.Stdin)
+        } else {
+            content, err = ioutil.ReadFile(file)
+        }
+        if err != nil {
+            fail(err)
+        }
+        query := ""
+        for _,line := range strings.SplitAfter(string(content), "\n") {
+            if strings.TrimRight(line, "\r\n") == "###" {
+                if out := transform(a, query); out != "" {
+                    fmt.Print(out + line)
+                }
+                query = ""
+            } else {
+                query += line
+            }
+        }
+        if strings.TrimSpace(query) != "" {
+            fmt.Print(transform(a, query))
+        }
+    }
+
+    if !*restore {
+        f, err := os.Create(*mappingFile)
+        if err != nil {
+            fail(err)
+        }
+        defer f.Close()
+        if err := a.SaveMapping(f); err != nil {
+            fail(err)
+        }
+    }
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "io/ioutil"
+    "os"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+var format = flag.String("format", "ansi", "The output format, either ansi or html")
+
+func usage() {
+    fmt.Fprintln(os.Stderr, "Usage: sparqlhighlight [options] [query file]")
+    fmt.Fprintln(os.Stderr, "The query is read from the standard input if no file is given.")
+    flag.PrintDefaults()
+}
+
+func fail(err error) {
+    fmt.Fprintln(os.Stderr, err)
+    os.Exit(2)
+}
+
+func main() {
+    flag.Usage = usage
+    flag.Parse()
+
+    var query []byte
+    var err error
+    if flag.NArg() == 0 {
+        query, err = ioutil.ReadAll(os.Stdin)
+    } else {
+        query, err = ioutil.ReadFile(flag.Arg(0))
+    }
+    if err != nil {
+        fail(err)
+    }
+    s := &sparql.Sparql{ Buffer : string(query) }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        fail(err)
+    }
+    switch *format {
+    case "ansi":
+        fmt.Print(s.ANSI())
+    case "html":
+        fmt.Print(s.HTML())
+    default:
+        fail(fmt.Errorf("Unknown format [%v], expected ansi or html", *format))
+    }
+}
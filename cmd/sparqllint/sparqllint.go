@@ -0,0 +1,109 @@
+package main
+
+import (
+    "encoding/json"
+    "flag"
+    "fmt"
+    "io/ioutil"
+    "os"
+    "strings"
+    "github.com/scampi/gosparqled/lint"
+)
+
+var enable = flag.String("enable", "", "The rules to check, separated by commas. All rules are checked by default.")
+var disable = flag.String("disable", "", "The rules not to check, separated by commas")
+var jsonOutput = flag.Bool("json", false, "Print the diagnostics as a JSON array")
+var listRules = flag.Bool("rules", false, "Print the rules and exit")
+
+// A diagnostic of a query file
+type fileDiagnostic struct {
+    File string `json:"file"`
+    *lint.Diagnostic
+}
+
+func usage() {
+    fmt.Fprintln(os.Stderr, "Usage: sparqllint [options] [query files]")
+    fmt.Fprintln(os.Stderr, "The query is read from the standard input if no file is given.")
+    flag.PrintDefaults()
+}
+
+func fail(err error) {
+    fmt.Fprintln(os.Stderr, err)
+    os.Exit(2)
+}
+
+// newLinter returns a linter with the rules of the options
+func newLinter() *lint.Linter {
+    l := lint.NewLinter()
+    if *enable != "" {
+        for _,name := range lint.RuleNames() {
+            l.Disable(name)
+        }
+        for _,name := range strings.Split(*enable, ",") {
+            if err := l.Enable(strings.TrimSpace(name)); err != nil {
+                fail(err)
+            }
+        }
+    }
+    if *disable != "" {
+        for _,name := range strings.Split(*disable, ",") {
+            if err := l.Disable(strings.TrimSpace(name)); err != nil {
+                fail(err)
+            }
+        }
+    }
+    return l
+}
+
+func main() {
+    flag.Usage = usage
+    flag.Parse()
+
+    if *listRules {
+        for _,rule := range lint.Rules() {
+            fmt.Printf("%-25v %v\n", rule.Name, rule.Description)
+        }
+        return
+    }
+
+    l := newLinter()
+    files := flag.Args()
+    if len(files) == 0 {
+        files = []string{ "-" }
+    }
+    diagnostics := []fileDiagnostic{}
+    for _,file := range files {
+        var query []byte
+        var err error
+        if file == "-" {
+            query, err = ioutil.ReadAll(os.Stdin)
+        } else {
+            query, err = ioutil.ReadFile(file)
+        }
+        if err != nil {
+            fail(err)
+        }
+        found, err := l.Lint(string(query))
+        if err != nil {
+            fail(fmt.Errorf("%v: %v", file, err))
+        }
+        for _,d := range found {
+            diagnostics = append(diagnostics, fileDiagnostic{ File : file, Diagnostic : d })
+        }
+    }
+
+    if *jsonOutput {
+        out, err := json.MarshalIndent(diagnostics, "", "  ")
+        if err != nil {
+            fail(err)
+        }
+        fmt.Println(string(out))
+    } else {
+        for _,d := range diagnostics {
+            fmt.Printf("%v:%v\n", d.File, d.Diagnostic)
+        }
+    }
+    if len(diagnostics) != 0 {
+        os.Exit(1)
+    }
+}
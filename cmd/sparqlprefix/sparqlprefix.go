@@ -0,0 +1,114 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "io/ioutil"
+    "os"
+    "regexp"
+    "strings"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+var expand = flag.Bool("expand", false, "Expand the prefixed names into full IRIs, instead of compacting the full IRIs")
+var prefixFile = flag.String("prefixes", "", "The path to a file of PREFIX or @prefix declarations used for compacting. Common prefixes are used by default.")
+
+// The prefixes used for compacting if no file is given
+var commonPrefixes = map[string]string{
+    "rdf" : "http://www.w3.org/1999/02/22-rdf-syntax-ns#",
+    "rdfs" : "http://www.w3.org/2000/01/rdf-schema#",
+    "owl" : "http://www.w3.org/2002/07/owl#",
+    "xsd" : "http://www.w3.org/2001/XMLSchema#",
+    "foaf" : "http://xmlns.com/foaf/0.1/",
+    "skos" : "http://www.w3.org/2004/02/skos/core#",
+    "dcterms" : "http://purl.org/dc/terms/",
+    "dbo" : "http://dbpedia.org/ontology/",
+    "dbp" : "http://dbpedia.org/property/",
+    "dbr" : "http://dbpedia.org/resource/",
+}
+
+// A prefix declaration, in SPARQL or in Turtle
+var prefixRe = regexp.MustCompile(`(?im)^\s*@?prefix\s+([^:\s]*):\s*<([^>]*)>`)
+
+func usage() {
+    fmt.Fprintln(os.Stderr, "Usage: sparqlprefix [options] [query files]")
+    fmt.Fprintln(os.Stderr, "The queries are read from the standard input if no file is given.")
+    fmt.Fprintln(os.Stderr, "Queries in a file are separated by a line equal to \"###\".")
+    flag.PrintDefaults()
+}
+
+func fail(err error) {
+    fmt.Fprintln(os.Stderr, err)
+    os.Exit(2)
+}
+
+// loadPrefixes reads the prefix declarations of the file
+func loadPrefixes(file string) map[string]string {
+    content, err := ioutil.ReadFile(file)
+    if err != nil {
+        fail(err)
+    }
+    prefixes := make(map[string]string)
+    for _,m := range prefixRe.FindAllStringSubmatch(string(content), -1) {
+        prefixes[m[1]] = m[2]
+    }
+    return prefixes
+}
+
+// transform returns the query with its prefixes expanded or compacted. The
+// query is returned unchanged if it cannot be parsed.
+func transform(query string, prefixes map[string]string) string {
+    s := &sparql.Sparql{ Buffer : query }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        fmt.Fprintf(os.Stderr, "Skipping invalid query\n%v\n%v\n", query, err)
+        return query
+    }
+    if !*expand {
+        return s.Compact(prefixes)
+    }
+    expanded, err := s.Expand()
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "Skipping query\n%v\n%v\n", query, err)
+        return query
+    }
+    return expanded
+}
+
+func main() {
+    flag.Usage = usage
+    flag.Parse()
+
+    prefixes := commonPrefixes
+    if *prefixFile != "" {
+        prefixes = loadPrefixes(*prefixFile)
+    }
+    files := flag.Args()
+    if len(files) == 0 {
+        files = []string{ "-" }
+    }
+    for _,file := range files {
+        var content []byte
+        var err error
+        if file == "-" {
+            content, err = ioutil.ReadAll(os.Stdin)
+        } else {
+            content, err = ioutil.ReadFile(file)
+        }
+        if err != nil {
+            fail(err)
+        }
+        query := ""
+        for _,line := range strings.SplitAfter(string(content), "\n") {
+            if strings.TrimRight(line, "\r\n") == "###" {
+                fmt.Print(transform(query, prefixes) + line)
+                query = ""
+            } else {
+                query += line
+            }
+        }
+        if strings.TrimSpace(query) != "" {
+            fmt.Print(transform(query, prefixes))
+        }
+    }
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+    "bufio"
+    "flag"
+    "fmt"
+    "io"
+    "os"
+    "github.com/scampi/gosparqled/rdf"
+    "github.com/scampi/gosparqled/summary"
+)
+
+var grouping = flag.String("grouping", "classes", "How the entities are grouped into the nodes of the summary: by their set of \"classes\", or by their set of \"predicates\"")
+var namespace = flag.String("namespace", summary.DefaultNamespace, "The namespace of the nodes and edges of the summary")
+var format = flag.String("format", "", "The media type of the documents, e.g., text/turtle. By default, it is given by the extension of the files.")
+var base = flag.String("base", "", "The base IRI of the Turtle and TriG documents")
+
+func usage() {
+    fmt.Fprintln(os.Stderr, "Usage: sparqlsummary [options] [RDF files]")
+    fmt.Fprintln(os.Stderr, "The summary of the graph of the files is written as N-Triples on the standard output.")
+    fmt.Fprintln(os.Stderr, "The graph is read from the standard input if no file is given, in which case the format option is required.")
+    flag.PrintDefaults()
+}
+
+func fail(err error) {
+    fmt.Fprintln(os.Stderr, err)
+    os.Exit(2)
+}
+
+// load adds the statements of the file to the builder
+func load(b *summary.Builder, file string) error {
+    name := *format
+    if name == "" {
+        name = file
+    }
+    f, err := rdf.FormatOf(name)
+    if err != nil {
+        return err
+    }
+    var in io.Reader = os.Stdin
+    if file != "-" {
+        fd, err := os.Open(file)
+        if err != nil {
+            return err
+        }
+        defer fd.Close()
+        in = fd
+    }
+    r, err := rdf.NewReader(in, f, *base)
+    if err != nil {
+        return err
+    }
+    n, err := b.Load(r)
+    if err != nil {
+        return fmt.Errorf("%v: %v", file, err)
+    }
+    fmt.Fprintf(os.Stderr, "Read %v statements from %v\n", n, file)
+    return nil
+}
+
+func main() {
+    flag.Usage = usage
+    flag.Parse()
+
+    g, err := summary.GroupingOf(*grouping)
+    if err != nil {
+        fail(err)
+    }
+    files := flag.Args()
+    if len(files) == 0 {
+        if *format == "" {
+            flag.Usage()
+            fail(fmt.Errorf("Missing format option"))
+        }
+        files = []string{ "-" }
+    }
+    b := summary.NewBuilder(g)
+    b.Namespace = *namespace
+    for _,file := range files {
+        if err := load(b, file); err != nil {
+            fail(err)
+        }
+    }
+    s := b.Summary()
+    fmt.Fprintf(os.Stderr, "The summary has %v nodes and %v edges\n", len(s.Nodes), len(s.Edges))
+    out := bufio.NewWriter(os.Stdout)
+    if err := s.WriteNTriples(out); err != nil {
+        fail(err)
+    }
+    if err := out.Flush(); err != nil {
+        fail(err)
+    }
+}
@@ -15,11 +15,15 @@ var formatQueryForAutocompletion = function(partialToken, query) {
  */
 var customAutocompletionFunction = function(partialToken, callback) {
     $("#error").html("")
-    autocompletion.RecommendationQuery(formatQueryForAutocompletion(partialToken, yasqe.getValue()), function(q, type, err) {
+    autocompletion.RecommendationQuery(formatQueryForAutocompletion(partialToken, yasqe.getValue()), function(q, type, err, variables) {
         if (err) {
             $("#error").html(ansi_up.ansi_to_html(err))
             return
         }
+        if (type === autocompletion.VARIABLE) {
+            callback(variables);
+            return
+        }
         if (!q) {
             alert("No recommendation at this position")
             return
@@ -77,13 +81,9 @@ var customAutocompletionFunction = function(partialToken, callback) {
  * Plug the recommendation to the YASQE editor
  */
 
-// If token is an uri, return its prefixed form
+// If token is an uri, return its prefixed form with the prefixes of the query
 var postprocessResourceTokenForCompletion = function(token, suggestedString) {
-    if (token.tokenPrefix && token.autocompletionString && token.tokenPrefixUri) {
-        // we need to get the suggested string back to prefixed form
-        suggestedString = token.tokenPrefix + suggestedString.substring(1 + token.tokenPrefixUri.length, suggestedString.length - 1); // remove wrapping angle brackets
-    }
-    return suggestedString;
+    return autocompletion.CompactIRI(suggestedString, yasqe.getPrefixesFromQuery());
 };
 
 YASQE.registerAutocompleter("sparqled", function(yasqe) {
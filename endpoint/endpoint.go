@@ -0,0 +1,315 @@
+/*
+ Package endpoint is a client of SPARQL endpoints, implementing the query
+ operation of the SPARQL 1.1 Protocol.
+
+    c := endpoint.NewClient("http://dbpedia.org/sparql")
+    c.DefaultGraphs = []string{ "http://dbpedia.org" }
+    c.Timeout = 30 * time.Second
+    resp, err := c.Query(context.Background(), "SELECT * { ?s ?p ?o } LIMIT 10")
+    if err != nil {
+        ...
+    }
+    defer resp.Body.Close()
+
+ A response with a status other than 2xx is returned as an *Error.
+*/
+package endpoint
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "mime"
+    "net/http"
+    "net/url"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+)
+
+// Method is the way a query is sent to the endpoint
+type Method uint
+
+const (
+    // The query is a parameter of the URL of a GET request
+    GET Method = iota
+    // The query is a parameter of the URL-encoded body of a POST request
+    POST_FORM
+    // The query is the body of a POST request
+    POST_DIRECT
+)
+
+var methodNames = []string{ "GET", "POST_FORM", "POST_DIRECT" }
+
+func (m Method) String() string {
+    return methodNames[m]
+}
+
+// The media types of the SPARQL results formats
+const (
+    JSON = "application/sparql-results+json"
+    XML = "application/sparql-results+xml"
+    CSV = "text/csv"
+    TSV = "text/tab-separated-values"
+)
+
+// The maximum number of bytes of the body of an error response kept in the Error
+const maxErrorMessage = 4096
+
+// Client sends queries to a SPARQL endpoint
+type Client struct {
+    // The URL of the endpoint
+    URL string
+    Method Method
+    // The value of the Accept header, i.e., the media types of the results
+    // that are accepted
+    Accept string
+    // The IRIs of the graphs of the default graph and of the named graphs of
+    // the dataset. The dataset of the query is used if both are empty.
+    DefaultGraphs, NamedGraphs []string
+    // The maximum duration of an attempt of a query, including the reading of
+    // the results. There is no limit if zero.
+    Timeout time.Duration
+    // The limits of the queries, which may be shared with other clients of the
+    // endpoint. There is no limit if nil.
+    Limiter *Limiter
+    // The number of times a query is retried after a network error, or after
+    // a response with the status 429 Too Many Requests or 503 Service Unavailable
+    Retries int
+    // The delay before the first retry, which is doubled for each following
+    // retry up to MaxBackoff if not zero. The Retry-After header of the
+    // response takes precedence.
+    Backoff, MaxBackoff time.Duration
+    // The credentials of the HTTP basic authentication, if the username is
+    // not empty
+    Username, Password string
+    // The bearer token of the Authorization header, if not empty. It takes
+    // precedence over the basic authentication.
+    Token string
+    // The client sending the requests
+    HTTP *http.Client
+}
+
+// NewClient returns a client of the endpoint, which sends the queries with GET
+// and accepts results in the SPARQL JSON format. The settings of the endpoint
+// set with Configure are applied.
+func NewClient(endpoint string) *Client {
+    c := &Client{ URL : endpoint, Method : GET, Accept : JSON, HTTP : http.DefaultClient }
+    c.configure()
+    return c
+}
+
+// Response is the response of the endpoint to a query
+type Response struct {
+    // The media type of the results, without its parameters
+    ContentType string
+    // The results, which must be closed
+    Body io.ReadCloser
+    // The duration until the response was received
+    Elapsed time.Duration
+}
+
+// Error is the error of a query answered with a status other than 2xx
+type Error struct {
+    // The HTTP status code, e.g., 400
+    StatusCode int
+    // The HTTP status, e.g., "400 Bad Request"
+    Status string
+    // The beginning of the body of the response, which usually explains the error
+    Message string
+    // The delay before retrying given by the Retry-After header, if any
+    RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+    if e.Message == "" {
+        return fmt.Sprintf("Endpoint responded with status [%v]", e.Status)
+    }
+    return fmt.Sprintf("Endpoint responded with status [%v]: %v", e.Status, e.Message)
+}
+
+// Query sends the query to the endpoint and returns its response. The query is
+// cancelled when the context is done, and an attempt after the Timeout of the
+// client. The query waits for the Limiter, and is retried after a transient
+// error.
+func (c *Client) Query(ctx context.Context, query string) (*Response, error) {
+    for attempt := 0; ; attempt++ {
+        resp, err := c.attempt(ctx, query)
+        if err == nil || attempt >= c.Retries || !retryable(err) || ctx.Err() != nil {
+            return resp, err
+        }
+        if err := sleep(ctx, c.backoff(attempt, err)); err != nil {
+            return nil, err
+        }
+    }
+}
+
+// retryable returns true if the error is a network error, or a response with
+// the status 429 or 503. An attempt that timed out is not retried.
+func retryable(err error) bool {
+    switch e := err.(type) {
+    case *Error:
+        return e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusServiceUnavailable
+    case *url.Error:
+        return !errors.Is(e, context.DeadlineExceeded) && !errors.Is(e, context.Canceled)
+    }
+    return false
+}
+
+// backoff returns the delay before retrying the query after the error
+func (c *Client) backoff(attempt int, err error) time.Duration {
+    if e, ok := err.(*Error); ok && e.RetryAfter > 0 {
+        return e.RetryAfter
+    }
+    d := c.Backoff
+    for i := 0; i < attempt && (c.MaxBackoff <= 0 || d < c.MaxBackoff); i++ {
+        d *= 2
+    }
+    if c.MaxBackoff > 0 && d > c.MaxBackoff {
+        d = c.MaxBackoff
+    }
+    return d
+}
+
+// retryAfter returns the delay of the Retry-After header, given in seconds or
+// as a date, or zero if there is none
+func retryAfter(header string) time.Duration {
+    if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+        return time.Duration(seconds) * time.Second
+    }
+    if date, err := http.ParseTime(header); err == nil {
+        if d := time.Until(date); d > 0 {
+            return d
+        }
+    }
+    return 0
+}
+
+// attempt sends the query once
+func (c *Client) attempt(ctx context.Context, query string) (*Response, error) {
+    release := func() {}
+    if c.Limiter != nil {
+        if err := c.Limiter.Acquire(ctx); err != nil {
+            return nil, err
+        }
+        release = c.Limiter.Release
+    }
+    var cancelCtx context.CancelFunc
+    if c.Timeout > 0 {
+        ctx, cancelCtx = context.WithTimeout(ctx, c.Timeout)
+    } else {
+        ctx, cancelCtx = context.WithCancel(ctx)
+    }
+    // the slot of the limiter is held until the results are read
+    var once sync.Once
+    cancel := func() {
+        once.Do(func() {
+            cancelCtx()
+            release()
+        })
+    }
+    req, err := c.request(ctx, query)
+    if err != nil {
+        cancel()
+        return nil, err
+    }
+    client := c.HTTP
+    if client == nil {
+        client = http.DefaultClient
+    }
+    start := time.Now()
+    resp, err := client.Do(req)
+    if err != nil {
+        cancel()
+        return nil, err
+    }
+    elapsed := time.Since(start)
+    if resp.StatusCode < 200 || resp.StatusCode > 299 {
+        message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorMessage))
+        resp.Body.Close()
+        cancel()
+        return nil, &Error{
+            StatusCode : resp.StatusCode,
+            Status : resp.Status,
+            Message : strings.TrimSpace(string(message)),
+            RetryAfter : retryAfter(resp.Header.Get("Retry-After")),
+        }
+    }
+    contentType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+    return &Response{
+        ContentType : contentType,
+        Body : &body{ resp.Body, cancel },
+        Elapsed : elapsed,
+    }, nil
+}
+
+// request returns the HTTP request of the query
+func (c *Client) request(ctx context.Context, query string) (*http.Request, error) {
+    params := url.Values{}
+    for _,g := range c.DefaultGraphs {
+        params.Add("default-graph-uri", g)
+    }
+    for _,g := range c.NamedGraphs {
+        params.Add("named-graph-uri", g)
+    }
+    var req *http.Request
+    var err error
+    switch c.Method {
+    case GET:
+        params.Set("query", query)
+        req, err = http.NewRequest("GET", withParams(c.URL, params), nil)
+    case POST_FORM:
+        params.Set("query", query)
+        req, err = http.NewRequest("POST", c.URL, strings.NewReader(params.Encode()))
+        if err == nil {
+            req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+        }
+    case POST_DIRECT:
+        req, err = http.NewRequest("POST", withParams(c.URL, params), strings.NewReader(query))
+        if err == nil {
+            req.Header.Set("Content-Type", "application/sparql-query")
+        }
+    default:
+        return nil, fmt.Errorf("Unknown method [%v]", uint(c.Method))
+    }
+    if err != nil {
+        return nil, err
+    }
+    if c.Accept != "" {
+        req.Header.Set("Accept", c.Accept)
+    }
+    if c.Token != "" {
+        req.Header.Set("Authorization", "Bearer " + c.Token)
+    } else if c.Username != "" {
+        req.SetBasicAuth(c.Username, c.Password)
+    }
+    return req.WithContext(ctx), nil
+}
+
+// withParams returns the URL with the parameters added to its query string
+func withParams(endpoint string, params url.Values) string {
+    if len(params) == 0 {
+        return endpoint
+    }
+    sep := "?"
+    if strings.Contains(endpoint, "?") {
+        sep = "&"
+    }
+    return endpoint + sep + params.Encode()
+}
+
+// body is the body of a response, which releases the context of the query
+// when closed
+type body struct {
+    io.ReadCloser
+    cancel func()
+}
+
+func (b *body) Close() error {
+    err := b.ReadCloser.Close()
+    b.cancel()
+    return err
+}
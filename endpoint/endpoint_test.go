@@ -0,0 +1,145 @@
+package endpoint
+
+import (
+    "context"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "net/url"
+    "reflect"
+    "testing"
+    "time"
+)
+
+// request is what the test server received
+type request struct {
+    method, contentType, accept, auth string
+    query, body string
+    defaultGraphs, namedGraphs []string
+}
+
+// server returns a test server that records the last request, and answers
+// with the status and the body
+func server(status int, body string) (*httptest.Server, *request) {
+    last := &request{}
+    s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        content, _ := ioutil.ReadAll(r.Body)
+        last.method = r.Method
+        last.contentType = r.Header.Get("Content-Type")
+        last.accept = r.Header.Get("Accept")
+        last.auth = r.Header.Get("Authorization")
+        last.body = string(content)
+        last.query = r.URL.Query().Get("query")
+        last.defaultGraphs = r.URL.Query()["default-graph-uri"]
+        last.namedGraphs = r.URL.Query()["named-graph-uri"]
+        if r.Method == "POST" && last.contentType == "application/x-www-form-urlencoded" {
+            if form, err := url.ParseQuery(string(content)); err == nil {
+                last.query = form.Get("query")
+                last.defaultGraphs = form["default-graph-uri"]
+                last.namedGraphs = form["named-graph-uri"]
+            }
+        }
+        if last.contentType == "application/sparql-query" {
+            last.query = last.body
+        }
+        w.Header().Set("Content-Type", JSON + "; charset=utf-8")
+        w.WriteHeader(status)
+        w.Write([]byte(body))
+    }))
+    return s, last
+}
+
+func query(t *testing.T, c *Client, q string) string {
+    resp, err := c.Query(context.Background(), q)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer resp.Body.Close()
+    if resp.ContentType != JSON {
+        t.Errorf("Expected the content type %v but got %v", JSON, resp.ContentType)
+    }
+    content, err := ioutil.ReadAll(resp.Body)
+    if err != nil {
+        t.Fatal(err)
+    }
+    return string(content)
+}
+
+func TestMethods(t *testing.T) {
+    s, last := server(200, `{"boolean":true}`)
+    defer s.Close()
+    const q = "ASK { ?s <p> 'a&b' }"
+    tests := []struct {
+        method Method
+        httpMethod, contentType string
+    }{
+        { GET, "GET", "" },
+        { POST_FORM, "POST", "application/x-www-form-urlencoded" },
+        { POST_DIRECT, "POST", "application/sparql-query" },
+    }
+    for _,test := range tests {
+        c := NewClient(s.URL)
+        c.Method = test.method
+        c.DefaultGraphs = []string{ "http://a", "http://b" }
+        c.NamedGraphs = []string{ "http://c" }
+        if body := query(t, c, q); body != `{"boolean":true}` {
+            t.Errorf("Unexpected results %v", body)
+        }
+        if last.method != test.httpMethod || last.contentType != test.contentType {
+            t.Errorf("Expected a %v request of %v but got %v of %v", test.httpMethod, test.contentType, last.method, last.contentType)
+        }
+        if last.query != q {
+            t.Errorf("Expected the query %v but got %v with %v", q, last.query, test.method)
+        }
+        if !reflect.DeepEqual(last.defaultGraphs, c.DefaultGraphs) || !reflect.DeepEqual(last.namedGraphs, c.NamedGraphs) {
+            t.Errorf("Unexpected dataset %v %v with %v", last.defaultGraphs, last.namedGraphs, test.method)
+        }
+        if last.accept != JSON {
+            t.Errorf("Expected the Accept header %v but got %v", JSON, last.accept)
+        }
+    }
+}
+
+func TestAuthentication(t *testing.T) {
+    s, last := server(200, "{}")
+    defer s.Close()
+    c := NewClient(s.URL)
+    c.Username, c.Password = "user", "secret"
+    query(t, c, "ASK {}")
+    if last.auth != "Basic dXNlcjpzZWNyZXQ=" {
+        t.Errorf("Unexpected Authorization header %v", last.auth)
+    }
+    c.Token = "token"
+    query(t, c, "ASK {}")
+    if last.auth != "Bearer token" {
+        t.Errorf("Unexpected Authorization header %v", last.auth)
+    }
+}
+
+func TestError(t *testing.T) {
+    s, _ := server(400, "Parse error: line 1\n")
+    defer s.Close()
+    _, err := NewClient(s.URL).Query(context.Background(), "SELECT")
+    e, ok := err.(*Error)
+    if !ok {
+        t.Fatalf("Expected an *Error but got %v", err)
+    }
+    if e.StatusCode != 400 || e.Message != "Parse error: line 1" {
+        t.Errorf("Unexpected error %v", e)
+    }
+}
+
+func TestTimeout(t *testing.T) {
+    s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        select {
+        case <-r.Context().Done():
+        case <-time.After(time.Second):
+        }
+    }))
+    defer s.Close()
+    c := NewClient(s.URL)
+    c.Timeout = 10 * time.Millisecond
+    if _, err := c.Query(context.Background(), "ASK {}"); err == nil {
+        t.Error("Expected the query to time out")
+    }
+}
@@ -0,0 +1,162 @@
+package endpoint
+
+import (
+    "context"
+    "flag"
+    "sync"
+    "time"
+)
+
+// Limiter limits the rate and the number of concurrent queries, e.g., of all
+// the clients of an endpoint
+type Limiter struct {
+    // The minimum duration between the start of two queries
+    interval time.Duration
+    // The slots of the queries in flight, or nil if there is no limit
+    slots chan struct{}
+    mu sync.Mutex
+    // The earliest time of the next query
+    next time.Time
+}
+
+// NewLimiter returns a limiter of the queries per second, and of the queries
+// in flight. A limit lower or equal to zero is not applied.
+func NewLimiter(rate float64, inFlight int) *Limiter {
+    l := &Limiter{}
+    if rate > 0 {
+        l.interval = time.Duration(float64(time.Second) / rate)
+    }
+    if inFlight > 0 {
+        l.slots = make(chan struct{}, inFlight)
+    }
+    return l
+}
+
+// Acquire waits until a query can be sent. Release must be called once the
+// query is done, unless an error is returned because the context is done.
+func (l *Limiter) Acquire(ctx context.Context) error {
+    if l.slots != nil {
+        select {
+        case l.slots <- struct{}{}:
+        case <-ctx.Done():
+            return ctx.Err()
+        }
+    }
+    if l.interval > 0 {
+        l.mu.Lock()
+        now := time.Now()
+        at := l.next
+        if at.Before(now) {
+            at = now
+        }
+        l.next = at.Add(l.interval)
+        l.mu.Unlock()
+        if err := sleep(ctx, at.Sub(now)); err != nil {
+            l.cancel(at)
+            l.Release()
+            return err
+        }
+    }
+    return nil
+}
+
+// cancel gives back the time reserved at for a query that is not sent, unless
+// a later query has been scheduled after it
+func (l *Limiter) cancel(at time.Time) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    if l.next.Equal(at.Add(l.interval)) {
+        l.next = at
+    }
+}
+
+// Release frees the slot of a query acquired with Acquire
+func (l *Limiter) Release() {
+    if l.slots != nil {
+        <-l.slots
+    }
+}
+
+// sleep waits for the duration, or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+    if d <= 0 {
+        return ctx.Err()
+    }
+    t := time.NewTimer(d)
+    defer t.Stop()
+    select {
+    case <-t.C:
+        return nil
+    case <-ctx.Done():
+        return ctx.Err()
+    }
+}
+
+// Settings are the limits of the queries sent to an endpoint
+type Settings struct {
+    // The maximum number of queries per second. There is no limit if zero.
+    Rate float64
+    // The maximum number of queries in flight. There is no limit if zero.
+    InFlight int
+    // The number of times a query is retried after a network error, or after
+    // a response with the status 429 Too Many Requests or 503 Service Unavailable
+    Retries int
+    // The delay before the first retry, which is doubled for each following
+    // retry up to MaxBackoff. The Retry-After header of the response takes
+    // precedence.
+    Backoff, MaxBackoff time.Duration
+    // The Timeout of each attempt of a query
+    Timeout time.Duration
+}
+
+// PublicSettings are settings for the endpoints open to everyone, which should
+// not be overloaded
+var PublicSettings = Settings{
+    Rate : 1,
+    InFlight : 1,
+    Retries : 3,
+    Backoff : time.Second,
+    MaxBackoff : time.Minute,
+}
+
+// The settings of the endpoints, and the limiter shared by their clients
+var registry = struct {
+    sync.Mutex
+    settings map[string]Settings
+    limiters map[string]*Limiter
+}{ settings : make(map[string]Settings), limiters : make(map[string]*Limiter) }
+
+// Configure sets the settings of the endpoint, which apply to the clients
+// created afterwards with NewClient. All those clients share the same limits.
+func Configure(endpoint string, s Settings) {
+    registry.Lock()
+    defer registry.Unlock()
+    registry.settings[endpoint] = s
+    registry.limiters[endpoint] = NewLimiter(s.Rate, s.InFlight)
+}
+
+// configure applies the settings of the endpoint of the client, if any
+func (c *Client) configure() {
+    registry.Lock()
+    defer registry.Unlock()
+    if s, ok := registry.settings[c.URL]; ok {
+        c.Limiter = registry.limiters[c.URL]
+        c.Retries = s.Retries
+        c.Backoff = s.Backoff
+        c.MaxBackoff = s.MaxBackoff
+        c.Timeout = s.Timeout
+    }
+}
+
+// SettingsFlags defines the flags of the settings in the flag set, with the
+// given default values. The settings are set once the flags are parsed.
+func SettingsFlags(fs *flag.FlagSet, defaults Settings) *Settings {
+    s := &Settings{}
+    fs.Float64Var(&s.Rate, "rate", defaults.Rate, "The maximum number of queries per second sent to the endpoint, or 0 for no limit")
+    fs.IntVar(&s.InFlight, "in-flight", defaults.InFlight, "The maximum number of queries in flight, or 0 for no limit")
+    fs.IntVar(&s.Retries, "retries", defaults.Retries, "The number of retries of a query after a network error or a 429 or 503 status")
+    fs.DurationVar(&s.Backoff, "backoff", defaults.Backoff, "The delay before the first retry, doubled for each following retry")
+    fs.DurationVar(&s.MaxBackoff, "max-backoff", defaults.MaxBackoff, "The maximum delay before a retry")
+    fs.DurationVar(&s.Timeout, "timeout", defaults.Timeout, "The timeout of a query, or 0 for none")
+    return s
+}
@@ -0,0 +1,162 @@
+package endpoint
+
+import (
+    "context"
+    "net/http"
+    "net/http/httptest"
+    "sync/atomic"
+    "testing"
+    "time"
+)
+
+// flaky returns a test server that fails the first queries with the status
+func flaky(failures int32, status int, attempts *int32) *httptest.Server {
+    return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if atomic.AddInt32(attempts, 1) <= failures {
+            w.WriteHeader(status)
+            return
+        }
+        w.Write([]byte("{}"))
+    }))
+}
+
+func TestRetries(t *testing.T) {
+    var attempts int32
+    s := flaky(2, http.StatusServiceUnavailable, &attempts)
+    defer s.Close()
+    c := NewClient(s.URL)
+    c.Retries = 2
+    c.Backoff = time.Millisecond
+    resp, err := c.Query(context.Background(), "ASK {}")
+    if err != nil {
+        t.Fatal(err)
+    }
+    resp.Body.Close()
+    if n := atomic.LoadInt32(&attempts); n != 3 {
+        t.Errorf("Expected 3 attempts but got %v", n)
+    }
+
+    // the error of the last attempt is returned
+    atomic.StoreInt32(&attempts, 0)
+    c.Retries = 1
+    _, err = c.Query(context.Background(), "ASK {}")
+    if e, ok := err.(*Error); !ok || e.StatusCode != http.StatusServiceUnavailable {
+        t.Errorf("Expected the 503 error but got %v", err)
+    }
+}
+
+func TestNoRetry(t *testing.T) {
+    var attempts int32
+    s := flaky(1, http.StatusBadRequest, &attempts)
+    defer s.Close()
+    c := NewClient(s.URL)
+    c.Retries = 3
+    if _, err := c.Query(context.Background(), "ASK {}"); err == nil {
+        t.Error("Expected the 400 error")
+    }
+    if n := atomic.LoadInt32(&attempts); n != 1 {
+        t.Errorf("Expected 1 attempt but got %v", n)
+    }
+}
+
+func TestBackoff(t *testing.T) {
+    c := &Client{ Backoff : time.Second, MaxBackoff : 5 * time.Second }
+    expected := []time.Duration{ time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second }
+    for attempt,d := range expected {
+        if actual := c.backoff(attempt, &Error{}); actual != d {
+            t.Errorf("Expected %v but got %v for attempt %v", d, actual, attempt)
+        }
+    }
+    if d := c.backoff(0, &Error{ RetryAfter : time.Minute }); d != time.Minute {
+        t.Errorf("Expected the delay of Retry-After but got %v", d)
+    }
+    if d := retryAfter("120"); d != 2 * time.Minute {
+        t.Errorf("Expected 2m but got %v", d)
+    }
+    if d := retryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)); d < 59 * time.Minute || d > time.Hour {
+        t.Errorf("Expected about 1h but got %v", d)
+    }
+}
+
+func TestLimiterRate(t *testing.T) {
+    l := NewLimiter(50, 0)
+    start := time.Now()
+    for i := 0; i < 5; i++ {
+        if err := l.Acquire(context.Background()); err != nil {
+            t.Fatal(err)
+        }
+        l.Release()
+    }
+    if elapsed := time.Since(start); elapsed < 80 * time.Millisecond {
+        t.Errorf("Expected 5 queries at 50 per second to take at least 80ms but took %v", elapsed)
+    }
+}
+
+func TestLimiterCancel(t *testing.T) {
+    l := NewLimiter(1, 1)
+    if err := l.Acquire(context.Background()); err != nil {
+        t.Fatal(err)
+    }
+    l.Release()
+    next := l.next
+    // the time reserved by the cancelled queries is given back
+    for i := 0; i < 3; i++ {
+        ctx, cancel := context.WithTimeout(context.Background(), 10 * time.Millisecond)
+        if err := l.Acquire(ctx); err == nil {
+            t.Error("Expected the query to wait for the rate")
+        }
+        cancel()
+    }
+    if !l.next.Equal(next) {
+        t.Errorf("Expected the next query at %v but got %v", next, l.next)
+    }
+    // the slot is released too
+    select {
+    case l.slots <- struct{}{}:
+    default:
+        t.Error("Expected the slot of the cancelled query to be released")
+    }
+}
+
+func TestLimiterInFlight(t *testing.T) {
+    l := NewLimiter(0, 1)
+    if err := l.Acquire(context.Background()); err != nil {
+        t.Fatal(err)
+    }
+    ctx, cancel := context.WithTimeout(context.Background(), 10 * time.Millisecond)
+    defer cancel()
+    if err := l.Acquire(ctx); err == nil {
+        t.Error("Expected the second query to wait for the first")
+    }
+    l.Release()
+    if err := l.Acquire(context.Background()); err != nil {
+        t.Fatal(err)
+    }
+}
+
+func TestConfigure(t *testing.T) {
+    var attempts int32
+    s := flaky(0, 0, &attempts)
+    defer s.Close()
+    Configure(s.URL, Settings{ InFlight : 1, Retries : 2 })
+    a, b := NewClient(s.URL), NewClient(s.URL)
+    if a.Limiter == nil || a.Limiter != b.Limiter || a.Retries != 2 {
+        t.Fatalf("Expected the clients to share the settings of the endpoint")
+    }
+    // the slot is held until the results are closed
+    resp, err := a.Query(context.Background(), "ASK {}")
+    if err != nil {
+        t.Fatal(err)
+    }
+    ctx, cancel := context.WithTimeout(context.Background(), 10 * time.Millisecond)
+    defer cancel()
+    if _, err := b.Query(ctx, "ASK {}"); err == nil {
+        t.Error("Expected the query to wait for the results of the other")
+    }
+    resp.Body.Close()
+    resp.Body.Close()
+    if resp, err = b.Query(context.Background(), "ASK {}"); err != nil {
+        t.Fatal(err)
+    }
+    resp.Body.Close()
+}
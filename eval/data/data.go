@@ -6,8 +6,10 @@ import (
     "bufio"
     "github.com/golang/glog"
     "github.com/scampi/gosparqled/eval"
+    "github.com/scampi/gosparqled/shape"
     "strings"
     "regexp"
+    "path/filepath"
 )
 
 // Load reads a file with SPARQL queries separated by a line with "###".
@@ -39,7 +41,10 @@ func Clean(endpoint string, graph string, queries string, out string) {
     defer w.Flush()
     for _,query := range Load(queries) {
         ask := strings.Replace(query, "SELECT *", "ASK FROM <" + graph + "> ", 1)
-        res, _ := eval.Ask(endpoint, ask)
+        res, _, err := eval.Ask(endpoint, ask)
+        if err != nil {
+            glog.Fatal(err)
+        }
         if res {
             w.WriteString(query)
             w.WriteString("###\n")
@@ -47,6 +52,42 @@ func Clean(endpoint string, graph string, queries string, out string) {
     }
 }
 
+// Bucket loads the queries and writes them into the directory, in files
+// named "query_" followed by their star-decomposition signature, e.g.,
+// "query_1-2". The queries that cannot be parsed are skipped.
+func Bucket(queries string, dir string) {
+    buckets := make(map[string][]string)
+    var signatures []string
+    for _,query := range Load(queries) {
+        s, err := shape.Analyze(query)
+        if err != nil {
+            glog.Warningf("Skipping invalid query [%s]: %v", query, err)
+            continue
+        }
+        if s.Signature == "" {
+            glog.Warningf("Skipping query without triple patterns [%s]", query)
+            continue
+        }
+        if buckets[s.Signature] == nil {
+            signatures = append(signatures, s.Signature)
+        }
+        buckets[s.Signature] = append(buckets[s.Signature], query)
+    }
+    for _,signature := range signatures {
+        fi, err := os.Create(filepath.Join(dir, "query_" + signature))
+        if err != nil { glog.Fatal(err) }
+        w := bufio.NewWriter(fi)
+        for _,query := range buckets[signature] {
+            // the lines of a loaded query are joined
+            w.WriteString(query + "\n")
+            w.WriteString("###\n")
+        }
+        w.Flush()
+        fi.Close()
+        glog.Infof("Wrote %v queries to [query_%s]", len(buckets[signature]), signature)
+    }
+}
+
 // POFs returns a list of Recommendation queries.
 // Each URI in the given query is transformed into a Point Of Focus.
 func POFs(query string) []string {
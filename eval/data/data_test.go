@@ -1,6 +1,11 @@
 package data
 
-import "testing"
+import (
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "testing"
+)
 
 func TestPOFs(t *testing.T) {
     pofs := POFs(`
@@ -32,3 +37,30 @@ func TestPOFs(t *testing.T) {
     }
 }
 
+
+// The queries of the dataset are bucketed into their own file
+func TestBucket(t *testing.T) {
+    dir, err := ioutil.TempDir("", "bucket")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+    files, err := filepath.Glob("dbpedia33/query_*")
+    if err != nil || len(files) == 0 {
+        t.Fatalf("No query file found: %v", err)
+    }
+    for _,file := range files {
+        Bucket(file, dir)
+        out, err := ioutil.ReadFile(filepath.Join(dir, filepath.Base(file)))
+        if err != nil {
+            t.Fatalf("The queries of %v are not bucketed in the same file: %v", file, err)
+        }
+        if len(Load(filepath.Join(dir, filepath.Base(file)))) != len(Load(file)) {
+            t.Errorf("Expected %v queries in %v, but got\n%s", len(Load(file)), file, out)
+        }
+        os.Remove(filepath.Join(dir, filepath.Base(file)))
+    }
+    if left, _ := filepath.Glob(filepath.Join(dir, "*")); len(left) != 0 {
+        t.Errorf("Unexpected buckets %v", left)
+    }
+}
@@ -1,61 +1,47 @@
 package eval
 
 import (
-    "runtime"
-    "net/http"
-    "net/url"
-    "github.com/golang/glog"
-    "encoding/json"
-    "io"
+    "context"
     "time"
+    "github.com/golang/glog"
+    "github.com/scampi/gosparqled/endpoint"
+    "github.com/scampi/gosparqled/results"
 )
 
-// Binding maps a variable name to its solution
-type Binding map[string]string
-
-// executeQuery executes the SPARQL query over the endpoint
-// and returns the io.ReadClosers body if successful
-func executeQuery(endpoint string, query string) (io.ReadCloser, time.Duration) {
-    time.Sleep(time.Second)
-    q := endpoint + "?format=application/json&query=" + url.QueryEscape(query)
-    glog.Infof("Execute request: [%s]", q)
-    start := time.Now()
-    resp, err := http.Get(q)
+// executeQuery executes the SPARQL query over the endpoint and returns its
+// results, in any of the SPARQL results formats. The limits of the queries to
+// the endpoint are set with endpoint.Configure.
+func executeQuery(url string, query string) (*results.Results, time.Duration, error) {
+    glog.Infof("Execute query on [%s]: [%s]", url, query)
+    c := endpoint.NewClient(url)
+    c.Accept = results.Accept
+    resp, err := c.Query(context.Background(), query)
+    if err != nil {
+        return nil, 0, err
+    }
+    defer resp.Body.Close()
+    format, err := results.FormatOf(resp.ContentType)
     if err != nil {
-        glog.Fatal(err)
+        return nil, resp.Elapsed, err
     }
-    return resp.Body, time.Since(start)
+    res, err := results.Read(resp.Body, format)
+    return res, resp.Elapsed, err
 }
 
-// GetBindings returns the list of Bindings for the query over the endpoint.
-func GetBindings(endpoint string, query string) ([]map[string]Binding, time.Duration) {
-    body, et := executeQuery(endpoint, query)
-    defer body.Close()
-    dec := json.NewDecoder(body)
-    var res = new(struct{Results struct{Bindings []map[string]Binding}})
-    if err := dec.Decode(&res); err != nil {
-        _, file, line, ok := runtime.Caller(2)
-        if ok {
-            glog.Infof("Caller: file=%v line=%v", file, line)
-        }
-        glog.Fatal(err)
+// GetBindings returns the solutions of the query over the endpoint.
+func GetBindings(endpoint string, query string) ([]results.Solution, time.Duration, error) {
+    res, et, err := executeQuery(endpoint, query)
+    if err != nil {
+        return nil, et, err
     }
-    return res.Results.Bindings, et
+    return res.Solutions, et, nil
 }
 
 // Ask returns the result of the ASK query over the endpoint.
-func Ask(endpoint string, query string) (bool, time.Duration) {
-    body, et := executeQuery(endpoint, query)
-    defer body.Close()
-    dec := json.NewDecoder(body)
-    var res = new(struct{Boolean bool})
-    if err := dec.Decode(&res); err != nil {
-        _, file, line, ok := runtime.Caller(2)
-        if ok {
-            glog.Infof("Caller: file=%v line=%v", file, line)
-        }
-        glog.Fatal(err)
+func Ask(endpoint string, query string) (bool, time.Duration, error) {
+    res, et, err := executeQuery(endpoint, query)
+    if err != nil {
+        return false, et, err
     }
-    return res.Boolean, et
+    return res.Boolean, et, nil
 }
-
@@ -3,6 +3,7 @@ package eval
 
 import (
     "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/rank"
     "github.com/golang/glog"
     "strconv"
     "time"
@@ -31,46 +32,50 @@ type Recommendation struct {
     Count int
 }
 
-// byCount sorts the list of recommendation by Recommendation#Count
-type byCount []Recommendation
-func (bc byCount) Len() int { return len(bc) }
-func (bc byCount) Swap(i, j int)      { bc[i], bc[j] = bc[j], bc[i] }
-func (bc byCount) Less(i, j int) bool { return bc[i].Count < bc[j].Count }
-
 // Gold retrieves the gold standard list of recommendations.
-// Endpoint is the address of the SPARQL endpoint, from is the named graph,
-// query is the SPARQL query with the POF, and template is the text/template
-// used for generating the Recommendation query.
-func Gold(endpoint string, from string, query string, template string) []Recommendation {
+// Endpoint is the address of the SPARQL endpoint, from is the named graph set
+// as the Graph of the scope, query is the SPARQL query with the POF, and scope
+// is the Scope used for generating the Recommendation query, e.g., with the
+// gold template of the library. The default template is used if the scope is
+// nil.
+func Gold(endpoint string, from string, query string, scope *autocompletion.Scope) []Recommendation {
     // retrieve the recommendations
-    var scope *autocompletion.Scope
-    if len(template) == 0 {
+    if scope == nil {
         scope = autocompletion.NewScope()
-    } else {
-        scope = autocompletion.NewScopeWithTemplate(template)
     }
+    scope.Graph = from
     s := &autocompletion.Sparql{ Buffer : query, Scope : scope }
     s.Init()
+    autocompletion.Reset(s)
     err := s.Parse()
     if err != nil {
         glog.Fatal(err)
     }
     s.Execute()
-    bindings, _ := GetBindings(endpoint, s.RecommendationQuery())
+    recQuery, err := s.RecommendationQuery()
+    if err != nil {
+        glog.Fatal(err)
+    }
+    bindings, _, err := GetBindings(endpoint, recQuery)
+    if err != nil {
+        glog.Fatal(err)
+    }
     var recs []Recommendation
     for _,v := range bindings {
-        count,_ := strconv.Atoi(v["count"]["value"])
-        recs = append(recs, Recommendation{ Item : v["POF"]["value"], Count : count })
+        count,_ := strconv.Atoi(v["count"].Value)
+        recs = append(recs, Recommendation{ Item : v["POF"].Value, Count : count })
     }
     return recs
 }
 
 // Measure measures the list of recommendations retrieved for the given query.
-// Endpoint is the address of the SPARQL endpoint, from is the named graph,
-// query is the SPARQL query with the POF, and template is the text/template
-// used for generating the Recommendation query.
-func Measure(endpoint string, from string, query string, template string) Measurement {
-    pofs, elapsedTime := getRecommendations(endpoint, from, query, template)
+// Endpoint is the address of the SPARQL endpoint, from is the named graph the
+// occurrences are counted in, query is the SPARQL query with the POF, and scope
+// is the Scope used for generating the Recommendation query, e.g., with the
+// measure template of the library. The default template is used if the scope
+// is nil.
+func Measure(endpoint string, from string, query string, scope *autocompletion.Scope) Measurement {
+    pofs, elapsedTime := getRecommendations(endpoint, from, query, scope)
     min, max, sum := math.MaxInt32, 0, float32(0)
     for _,c := range pofs {
         if c.Count < min {
@@ -87,54 +92,56 @@ func Measure(endpoint string, from string, query string, template string) Measur
 // getRecommendations retrives the list of recommendation for the given query
 // and rank it based on Recommendation#count. It returns the list of
 // recommendations along with the time it took to retrieve them.
-// Endpoint is the address of the SPARQL endpoint, from is the named graph,
-// query is the SPARQL query with the POF, and template is the text/template
-// used for generating the Recommendation query.
-func getRecommendations(endpoint string, from string, query string, template string) ([]Recommendation, time.Duration) {
+// Endpoint is the address of the SPARQL endpoint, from is the named graph the
+// occurrences are counted in, query is the SPARQL query with the POF, and scope
+// is the Scope used for generating the Recommendation query.
+func getRecommendations(endpoint string, from string, query string, scope *autocompletion.Scope) ([]Recommendation, time.Duration) {
     // retrieve the recommendations
-    var scope *autocompletion.Scope
-    if len(template) == 0 {
+    if scope == nil {
         scope = autocompletion.NewScope()
-    } else {
-        scope = autocompletion.NewScopeWithTemplate(template)
     }
     s := &autocompletion.Sparql{ Buffer : query, Scope : scope }
     s.Init()
+    autocompletion.Reset(s)
     err := s.Parse()
     if err != nil {
         glog.Fatal(err)
     }
     s.Execute()
-    bindings, elapsedTime := GetBindings(endpoint, s.RecommendationQuery())
+    recQuery, err := s.RecommendationQuery()
+    if err != nil {
+        glog.Fatal(err)
+    }
+    bindings, elapsedTime, err := GetBindings(endpoint, recQuery)
+    if err != nil {
+        glog.Fatal(err)
+    }
     // get the POF bindings and rank them
     counts := make(map[string]int, len(bindings))
     for _,v := range bindings {
-        count,_ := strconv.Atoi(v["count"]["value"])
-        counts[v["POF"]["value"]] += count
+        count,_ := strconv.Atoi(v["count"].Value)
+        counts[v["POF"].Value] += count
     }
     glog.Infof("Results: %v\n", counts)
-    var pofs byCount
+    pofs := make([]autocompletion.Recommendation, 0, len(counts))
     for k,v := range counts {
-        pofs = append(pofs, Recommendation{ Item: k, Count: v })
+        pofs = append(pofs, autocompletion.Recommendation{ Item: k, Count: v })
     }
-    sort.Sort(sort.Reverse(pofs))
+    // the items with the same count are ranked in alphabetical order
+    sort.Slice(pofs, func(i, j int) bool { return pofs[i].Item < pofs[j].Item })
+    pofs = rank.Rank(scope, pofs, rank.Frequency{})
     min := int(math.Min(10, float64(len(pofs))))
     top := pofs[:min]
     glog.Infof("TOP10: %v\n", top)
     // get the total number of occurrences of each recommended item
-    values := "values ?POF { "
+    scope, err = autocompletion.NewScopeByName("popularity")
+    if err != nil {
+        glog.Fatal(err)
+    }
+    scope.Graph = from
     for _,r := range top {
-        values += "<" + r.Item + "> "
-    }
-    values += "}"
-    tmpl := "SELECT ?POF (count(?POF) as ?count) FROM <" + from + "> WHERE {" +
-         values + `
-         {{range .Tps}}
-             {{.S}} {{.P}} {{.O}} .
-         {{end}}
-         }
-        `
-    scope = autocompletion.NewScopeWithTemplate(tmpl)
+        scope.Values = append(scope.Values, "<" + r.Item + ">")
+    }
     s = &autocompletion.Sparql{ Buffer : query, Scope : scope }
     s.Init()
     err = s.Parse()
@@ -142,11 +149,18 @@ func getRecommendations(endpoint string, from string, query string, template str
         glog.Fatal(err)
     }
     s.Execute()
-    bindings, _ = GetBindings(endpoint, s.RecommendationQuery())
+    recQuery, err = s.RecommendationQuery()
+    if err != nil {
+        glog.Fatal(err)
+    }
+    bindings, _, err = GetBindings(endpoint, recQuery)
+    if err != nil {
+        glog.Fatal(err)
+    }
     var popularity []Recommendation
     for _,v := range bindings {
-        count,_ := strconv.Atoi(v["count"]["value"])
-        popularity = append(popularity, Recommendation{ Item: v["POF"]["value"], Count: count })
+        count,_ := strconv.Atoi(v["count"].Value)
+        popularity = append(popularity, Recommendation{ Item: v["POF"].Value, Count: count })
     }
     glog.Infof("Popularity=%v\n", bindings)
     return popularity, elapsedTime
@@ -0,0 +1,70 @@
+package eval
+
+import (
+    "net/http/httptest"
+    "reflect"
+    "sort"
+    "testing"
+    "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/results"
+    "github.com/scampi/gosparqled/store"
+)
+
+func TestGoldOffline(t *testing.T) {
+    st := store.New()
+    person := results.NewIRI("http://example.org/Person")
+    rdfType := results.NewIRI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type")
+    for _,p := range []string{ "name", "knows" } {
+        st.Add(results.NewIRI("http://example.org/alice"), results.NewIRI("http://example.org/" + p), results.NewLiteral("x"))
+    }
+    st.Add(results.NewIRI("http://example.org/alice"), rdfType, person)
+    server := httptest.NewServer(st)
+    defer server.Close()
+
+    recs := Gold(server.URL, "", "SELECT * { ?s a <http://example.org/Person> ; < }", nil)
+    var items []string
+    for _,r := range recs {
+        items = append(items, r.Item)
+    }
+    sort.Strings(items)
+    expected := []string{ "http://example.org/knows", "http://example.org/name", "http://www.w3.org/1999/02/22-rdf-syntax-ns#type" }
+    if len(items) != 3 || items[0] != expected[0] || items[1] != expected[1] || items[2] != expected[2] {
+        t.Errorf("Expected %v but got %v", expected, items)
+    }
+}
+
+func TestMeasureOffline(t *testing.T) {
+    st := store.New()
+    rdfType := results.NewIRI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type")
+    for _,s := range []string{ "alice", "bob" } {
+        st.Add(results.NewIRI("http://example.org/" + s), rdfType, results.NewIRI("http://example.org/Person"))
+        st.Add(results.NewIRI("http://example.org/" + s), results.NewIRI("http://example.org/name"), results.NewLiteral(s))
+    }
+    st.Add(results.NewIRI("http://example.org/alice"), results.NewIRI("http://example.org/knows"), results.NewIRI("http://example.org/bob"))
+    server := httptest.NewServer(st)
+    defer server.Close()
+
+    query := "SELECT * { ?s a <http://example.org/Person> ; < }"
+    gold, err := autocompletion.NewScopeByName("gold")
+    if err != nil {
+        t.Fatal(err)
+    }
+    recs := Gold(server.URL, "http://example.org/graph", query, gold)
+    expected := []Recommendation{ { "http://example.org/name", 2 }, { "http://example.org/knows", 1 } }
+    if !reflect.DeepEqual(recs, expected) {
+        t.Errorf("Expected the gold standard %v but got %v", expected, recs)
+    }
+
+    scope, err := autocompletion.NewScopeByName("measure")
+    if err != nil {
+        t.Fatal(err)
+    }
+    m := Measure(server.URL, "http://example.org/graph", query, scope)
+    sort.Slice(m.Recs, func(i, j int) bool { return m.Recs[i].Item < m.Recs[j].Item })
+    if !reflect.DeepEqual(m.Recs, []Recommendation{ { "http://example.org/knows", 1 }, { "http://example.org/name", 2 } }) {
+        t.Errorf("Expected the popularity of knows and name but got %v", m.Recs)
+    }
+    if m.Min != 1 || m.Max != 2 || m.Length != 2 {
+        t.Errorf("Unexpected measurement %+v", m)
+    }
+}
@@ -1,33 +1,377 @@
 package main
 
 import (
+    "strings"
+    "time"
     "github.com/gopherjs/gopherjs/js"
     "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/cache"
+    "github.com/scampi/gosparqled/rank"
+    "github.com/scampi/gosparqled/rdf"
+    "github.com/scampi/gosparqled/sparql"
 )
 
 // Scope as a global variable so that the text/template is created only once
 var scope = autocompletion.NewScope()
 
+// The settings made with LabelSearch, Ranking and Page, by name, which are
+// applied again to the scope of a template selected with UseTemplate
+var settings = map[string]func(*autocompletion.Scope){}
+
+// set applies the setting to the scope, and keeps it for the next templates
+func set(name string, setting func(*autocompletion.Scope)) {
+    settings[name] = setting
+    setting(scope)
+}
+
+// The recommendations retrieved from the endpoints
+var recommendations = cache.New(100, 10 * time.Minute)
+
+// The ontology classes and predicates are recommended from, without an endpoint
+var schema *autocompletion.Schema
+
+// The model of a query log classes and predicates are recommended from
+var model *autocompletion.Model
+
+// The weights of the rankers of the recommendations, by name
+var weights = map[string]float64{}
+
+// The preferred namespaces of the recommended items
+var namespaces rank.Namespaces
+
+// The items the user recently selected
+var session = rank.NewSession(50)
+
+// parse returns the processed query, within the global scope
+func parse(query string) (*autocompletion.Sparql, error) {
+    s := &autocompletion.Sparql{ Buffer : query, Scope : scope }
+    s.Init()
+    autocompletion.Reset(s)
+    if err := s.Parse(); err != nil {
+        return nil, err
+    }
+    s.Execute()
+    return s, nil
+}
+
+// ranker returns the combination of the rankers with a weight, or nil if
+// there is none. The log popularity requires a loaded model.
+func ranker() rank.Ranker {
+    rankers := map[string]rank.Ranker{
+        "frequency" : rank.Frequency{},
+        "distance" : rank.EditDistance{},
+        "namespaces" : namespaces,
+        "session" : session,
+    }
+    if model != nil {
+        rankers["popularity"] = rank.Popularity{ Model : model }
+    }
+    var c rank.Combination
+    for name,weight := range weights {
+        if r, ok := rankers[name]; ok && weight > 0 {
+            c = append(c, rank.Weighted{ Ranker : r, Weight : weight })
+        }
+    }
+    if len(c) == 0 {
+        return nil
+    }
+    return c
+}
+
+// present returns the recommendations ranked for the client, each with its
+// item, label and comment
+func present(s *autocompletion.Scope, recs []autocompletion.Recommendation) []map[string]interface{} {
+    if r := ranker(); r != nil {
+        recs = rank.Rank(s, recs, r)
+    }
+    items := []map[string]interface{}{}
+    for _,r := range recs {
+        items = append(items, map[string]interface{}{ "item" : r.Item, "label" : r.Label, "comment" : r.Comment })
+    }
+    return items
+}
+
 // RecommendationQuery returns a SPARQL query for retrieving recommendations.
-// If the input query does not have a Point Of Focus, an empty string is returned
-func RecommendationQuery(query string, callback func(string, autocompletion.Type, string)) {
+// If the input query does not have a Point Of Focus, an empty string is returned.
+// Variables are recommended directly, without a query. An error message is
+// returned if the query cannot be parsed or the template cannot be executed.
+func RecommendationQuery(query string, callback func(string, autocompletion.Type, string, []string)) {
     go func(query string) {
-        s := &autocompletion.Sparql{ Buffer : query, Scope : scope }
-        s.Init()
-        autocompletion.Reset(s)
-        err := s.Parse()
-        if err == nil {
-            s.Execute()
-            callback(s.RecommendationQuery(), s.RecommendationType(), "")
-        } else {
-            callback(query, autocompletion.NONE, "Unable to create recommendation query\n" + err.Error())
+        s, err := parse(query)
+        if err != nil {
+            callback(query, autocompletion.NONE, "Unable to create recommendation query\n" + err.Error(), nil)
+            return
         }
+        recQuery, err := s.RecommendationQuery()
+        if err != nil {
+            callback(query, autocompletion.NONE, "Unable to create recommendation query\n" + err.Error(), nil)
+            return
+        }
+        callback(recQuery, s.RecommendationType(), "", s.Variables())
     }(query)
 }
 
+// CachedRecommendations returns the recommendations for the query retrieved
+// from the graph of the endpoint, each with its item, label and comment. It
+// returns null if they are not in the cache, e.g., if they expired.
+func CachedRecommendations(query string, endpoint string, graph string) []map[string]interface{} {
+    s, err := parse(query)
+    if err != nil {
+        return nil
+    }
+    key, err := cache.NewKey(endpoint, graph, s.Scope)
+    if err != nil {
+        return nil
+    }
+    recs, ok := recommendations.Get(key)
+    if !ok {
+        return nil
+    }
+    return present(s.Scope, recs)
+}
+
+// CacheRecommendations adds to the cache the solutions of the recommendation
+// query of the query, retrieved from the graph of the endpoint. A solution maps
+// a variable name, without the leading '?', to its value.
+func CacheRecommendations(query string, endpoint string, graph string, solutions []map[string]interface{}) {
+    s, err := parse(query)
+    if err != nil {
+        return
+    }
+    key, err := cache.NewKey(endpoint, graph, s.Scope)
+    if err != nil {
+        return
+    }
+    recs := recommendationsOf(solutions)
+    recommendations.Put(key, recs, cache.Complete(s.Scope, len(recs)))
+}
+
+// recommendationsOf returns the recommendations of the solutions of a
+// recommendation query, each mapping a variable name to its value
+func recommendationsOf(solutions []map[string]interface{}) []autocompletion.Recommendation {
+    var recs []autocompletion.Recommendation
+    for _,solution := range solutions {
+        values := make(map[string]string)
+        for name,value := range solution {
+            if v, ok := value.(string); ok {
+                values[name] = v
+            }
+        }
+        recs = append(recs, autocompletion.NewRecommendation(values))
+    }
+    return recs
+}
+
+// Validate returns the static errors of the query, each with its message and
+// position. A syntax error is returned without a position.
+func Validate(query string) []map[string]interface{} {
+    s := &sparql.Sparql{ Buffer : query }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        return []map[string]interface{}{ { "message" : err.Error() } }
+    }
+    var errors []map[string]interface{}
+    for _,err := range s.Validate() {
+        errors = append(errors, map[string]interface{}{
+            "message" : err.Message,
+            "begin" : err.Begin,
+            "end" : err.End,
+            "line" : err.Line,
+            "column" : err.Column,
+        })
+    }
+    return errors
+}
+
+// CompactIRI returns the IRI, written between angle brackets, as a prefixed
+// name with one of the prefixes, given as an object mapping names to namespaces.
+// The IRI is returned unchanged if no prefix matches.
+func CompactIRI(iri string, prefixes map[string]interface{}) string {
+    namespaces := make(map[string]string)
+    for name,ns := range prefixes {
+        if s, ok := ns.(string); ok {
+            namespaces[name] = s
+        }
+    }
+    return sparql.CompactIRI(iri, namespaces)
+}
+
+// UseTemplate replaces the template of the recommendation query with the named
+// one from the library. The settings made with LabelSearch, Ranking and Page are
+// kept, and take precedence over those of the template. It returns an error
+// message if there is no such template.
+func UseTemplate(name string) string {
+    named, err := autocompletion.NewScopeByName(name)
+    if err != nil {
+        return err.Error()
+    }
+    for _,setting := range settings {
+        setting(named)
+    }
+    scope = named
+    // the full-text indexes do not match a keyword as a substring
+    switch name {
+    case "virtuoso", "blazegraph", "jena", "graphdb":
+        recommendations.Refine = false
+    default:
+        recommendations.Refine = true
+    }
+    return ""
+}
+
+// LabelSearch enables or disables the matching of keywords against the labels
+// of the recommended items
+func LabelSearch(enabled bool) {
+    set("labels", func(s *autocompletion.Scope) {
+        if enabled {
+            s.Labels = autocompletion.NewLabelOptions()
+        } else {
+            s.Labels = nil
+        }
+    })
+}
+
+// Ranking enables or disables the ranking of the recommended items by their
+// number of occurrences. If sample is greater than 0, only that many solutions
+// are considered for counting occurrences.
+func Ranking(enabled bool, sample int) {
+    set("ranking", func(s *autocompletion.Scope) {
+        s.Ranked = enabled
+        s.Sample = sample
+    })
+}
+
+// LoadSchema loads the ontology in the document, whose format is given by its
+// media type, e.g., "text/turtle". It returns an error message if the document
+// cannot be read.
+func LoadSchema(document string, mediaType string) string {
+    format, err := rdf.FormatOf(mediaType)
+    if err != nil {
+        return err.Error()
+    }
+    r, err := rdf.NewReader(strings.NewReader(document), format, "")
+    if err != nil {
+        return err.Error()
+    }
+    s, err := autocompletion.LoadSchema(r)
+    if err != nil {
+        return err.Error()
+    }
+    schema = s
+    return ""
+}
+
+// SchemaRecommendations returns the classes or the predicates recommended for
+// the query from the loaded ontology, each with its item, label and comment. It
+// returns null if there is no ontology or if the query cannot be parsed.
+func SchemaRecommendations(query string) []map[string]interface{} {
+    if schema == nil {
+        return nil
+    }
+    s, err := parse(query)
+    if err != nil {
+        return nil
+    }
+    return present(s.Scope, s.SchemaRecommendations(schema))
+}
+
+// LoadModel loads the model of a query log, as written by Model.Save. It
+// returns an error message if the model cannot be read.
+func LoadModel(document string) string {
+    m, err := autocompletion.LoadModel(strings.NewReader(document))
+    if err != nil {
+        return err.Error()
+    }
+    model = m
+    return ""
+}
+
+// ModelRecommendations returns the classes or the predicates recommended for
+// the query from the loaded model. If solutions are given, the recommendations
+// of the recommendation query are ranked together with those of the model,
+// whose weight is between 0 and 1. It returns null if there is no model or if
+// the query cannot be parsed.
+func ModelRecommendations(query string, solutions []map[string]interface{}, weight float64) []map[string]interface{} {
+    if model == nil {
+        return nil
+    }
+    s, err := parse(query)
+    if err != nil {
+        return nil
+    }
+    var recs []autocompletion.Recommendation
+    if len(solutions) == 0 {
+        recs = s.ModelRecommendations(model)
+    } else {
+        recs = s.BlendRecommendations(model, recommendationsOf(solutions), weight)
+    }
+    return present(s.Scope, recs)
+}
+
+// RankRecommendations returns the solutions of the recommendation query of
+// the query as recommendations, ranked as set with RankingWeights
+func RankRecommendations(query string, solutions []map[string]interface{}) []map[string]interface{} {
+    s, err := parse(query)
+    if err != nil {
+        return nil
+    }
+    return present(s.Scope, recommendationsOf(solutions))
+}
+
+// RankingWeights sets the weight of each ranker of the recommendations, given
+// as an object mapping the names "frequency", "popularity", "distance",
+// "namespaces" and "session" to a number. The recommendations are not ranked
+// if no ranker has a weight.
+func RankingWeights(w map[string]interface{}) {
+    weights = make(map[string]float64)
+    for name,weight := range w {
+        if f, ok := weight.(float64); ok {
+            weights[name] = f
+        }
+    }
+}
+
+// PreferNamespaces sets the namespaces of the items ranked first, in order of
+// preference
+func PreferNamespaces(ns []string) {
+    namespaces = rank.Namespaces(ns)
+}
+
+// SelectRecommendation records that the user selected the item, so that it is
+// ranked first when recommended again
+func SelectRecommendation(item string) {
+    session.Select(item)
+}
+
+// Page sets the number of recommended items to retrieve and how many to skip.
+// All the items are retrieved if the limit is 0 or less.
+func Page(limit int, offset int) {
+    set("page", func(s *autocompletion.Scope) {
+        s.Limit = limit
+        s.Offset = offset
+    })
+}
+
 func main() {
     js.Global.Set("autocompletion", map[string]interface{}{
         "RecommendationQuery": RecommendationQuery,
+        "CachedRecommendations": CachedRecommendations,
+        "CacheRecommendations": CacheRecommendations,
+        "Validate": Validate,
+        "CompactIRI": CompactIRI,
+        "UseTemplate": UseTemplate,
+        "LabelSearch": LabelSearch,
+        "Ranking": Ranking,
+        "Page": Page,
+        "LoadSchema": LoadSchema,
+        "SchemaRecommendations": SchemaRecommendations,
+        "LoadModel": LoadModel,
+        "ModelRecommendations": ModelRecommendations,
+        "RankRecommendations": RankRecommendations,
+        "RankingWeights": RankingWeights,
+        "PreferNamespaces": PreferNamespaces,
+        "SelectRecommendation": SelectRecommendation,
         "PATH": autocompletion.PATH,
+        "VARIABLE": autocompletion.VARIABLE,
     })
 }
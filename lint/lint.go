@@ -0,0 +1,147 @@
+/*
+ Package lint flags likely mistakes in SPARQL queries, e.g., a cartesian
+ product between triple patterns that share no variable. Unlike the errors of
+ sparql.Sparql's Validate, the queries are valid and are answered by endpoints,
+ but likely not as the user intended.
+
+ Each kind of mistake is checked by a rule, which can be disabled:
+
+    l := lint.NewLinter()
+    l.Disable("missing-limit")
+    diagnostics, err := l.Lint(query)
+*/
+package lint
+
+import (
+    "errors"
+    "fmt"
+    "sort"
+    "strings"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// Diagnostic is a likely mistake found in a query
+type Diagnostic struct {
+    // The name of the rule that found the mistake
+    Rule string `json:"rule"`
+    Message string `json:"message"`
+    // The position of the offending text in the query, as a number of runes
+    Begin int `json:"begin"`
+    End int `json:"end"`
+    // The line and the column of the beginning of the offending text,
+    // starting from 1
+    Line int `json:"line"`
+    Column int `json:"column"`
+}
+
+func (d *Diagnostic) String() string {
+    return fmt.Sprintf("%v:%v: %v [%v]", d.Line, d.Column, d.Message, d.Rule)
+}
+
+// Rule checks a query for a kind of mistake
+type Rule struct {
+    Name string
+    Description string
+    check func(c *checker, tree *sparql.Node)
+}
+
+// Rules returns the rules of the linter, sorted by name
+func Rules() []*Rule {
+    var list []*Rule
+    for _,rule := range rules {
+        list = append(list, rule)
+    }
+    sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+    return list
+}
+
+// RuleNames returns the names of the rules, sorted
+func RuleNames() []string {
+    var names []string
+    for _,rule := range Rules() {
+        names = append(names, rule.Name)
+    }
+    return names
+}
+
+// Linter checks queries with a set of rules
+type Linter struct {
+    // The names of the enabled rules
+    enabled map[string]bool
+}
+
+// Linter struct constructor, with all the rules enabled
+func NewLinter() *Linter {
+    l := &Linter{ enabled : make(map[string]bool) }
+    for name := range rules {
+        l.enabled[name] = true
+    }
+    return l
+}
+
+// Enable enables the named rule
+func (l *Linter) Enable(name string) error {
+    if rules[name] == nil {
+        return errors.New("Unknown rule [" + name + "], expected one of " + strings.Join(RuleNames(), ", "))
+    }
+    l.enabled[name] = true
+    return nil
+}
+
+// Disable disables the named rule
+func (l *Linter) Disable(name string) error {
+    if rules[name] == nil {
+        return errors.New("Unknown rule [" + name + "], expected one of " + strings.Join(RuleNames(), ", "))
+    }
+    delete(l.enabled, name)
+    return nil
+}
+
+// Lint returns the diagnostics of the enabled rules on the query, sorted by
+// position. An error is returned if the query cannot be parsed.
+func (l *Linter) Lint(query string) ([]*Diagnostic, error) {
+    s := &sparql.Sparql{ Buffer : query }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        return nil, err
+    }
+    c := &checker{ query : []rune(query) }
+    tree := s.Tree()
+    for _,rule := range Rules() {
+        if l.enabled[rule.Name] {
+            c.rule = rule.Name
+            rule.check(c, tree)
+        }
+    }
+    sort.SliceStable(c.diagnostics, func(i, j int) bool { return c.diagnostics[i].Begin < c.diagnostics[j].Begin })
+    return c.diagnostics, nil
+}
+
+// checker collects the diagnostics of the rules
+type checker struct {
+    query []rune
+    // The name of the rule being checked
+    rule string
+    diagnostics []*Diagnostic
+}
+
+// report adds a diagnostic about the text of the node
+func (c *checker) report(n *sparql.Node, format string, args ...interface{}) {
+    d := &Diagnostic{
+        Rule : c.rule,
+        Message : fmt.Sprintf(format, args...),
+        Begin : n.Begin,
+        End : n.Begin + len([]rune(n.Value())),
+        Line : 1,
+        Column : 1,
+    }
+    for _,r := range c.query[:n.Begin] {
+        if r == '\n' {
+            d.Line++
+            d.Column = 1
+        } else {
+            d.Column++
+        }
+    }
+    c.diagnostics = append(c.diagnostics, d)
+}
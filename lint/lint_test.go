@@ -0,0 +1,180 @@
+package lint
+
+import (
+    "fmt"
+    "testing"
+)
+
+// lint returns the diagnostics of the query with only the named rule enabled
+func lint(t *testing.T, rule string, query string) []*Diagnostic {
+    l := NewLinter()
+    for _,name := range RuleNames() {
+        if name != rule {
+            l.Disable(name)
+        }
+    }
+    diagnostics, err := l.Lint(query)
+    if err != nil {
+        t.Fatalf("Failed to lint query\n%v\n%v", query, err)
+    }
+    return diagnostics
+}
+
+// check asserts the messages of the diagnostics of the rule on each query
+func check(t *testing.T, rule string, tests map[string][]string) {
+    for query, expected := range tests {
+        diagnostics := lint(t, rule, query)
+        if len(diagnostics) != len(expected) {
+            t.Errorf("Expected %v but got %v in\n%v", expected, diagnostics, query)
+            continue
+        }
+        for i,d := range diagnostics {
+            if d.Rule != rule || d.Message != expected[i] {
+                t.Errorf("Expected [%v] but got %v in\n%v", expected[i], d, query)
+            }
+        }
+    }
+}
+
+const (
+    cartesian = "The triple pattern shares no variable with the previous ones of the group, which makes a cartesian product"
+    nested = "The nested pattern shares no variable with the previous ones of the group, which makes a cartesian product"
+)
+
+func TestCartesianProduct(t *testing.T) {
+    check(t, "cartesian-product", map[string][]string{
+        `SELECT * { ?s <p> ?o . ?o <q> ?z }` : nil,
+        `SELECT * { ?s <p> _:b . _:b <q> ?z }` : nil,
+        `SELECT * { ?s <p> ?o . <a> <b> <c> }` : nil,
+        `SELECT * { ?s <p> ?o FILTER (?o > 1) ?o <q> ?z }` : nil,
+        `SELECT * { ?s <p> ?o . ?a <q> ?b . ?b <r> ?s }` : nil,
+        `SELECT * { ?s <p> ?o . ?a <q> ?b }` : { cartesian },
+        `SELECT * { ?s <p> ?o . ?a <q> ?b . ?c <r> ?d }` : { cartesian, cartesian },
+        `SELECT * { ?s <p> ?o OPTIONAL { ?o <q> ?z . ?a <r> ?b } }` : { cartesian },
+        // the nested patterns are joined with the group
+        `SELECT * { ?s <p> ?o { ?o <q> ?b } ?b <r> ?c }` : nil,
+        `SELECT * { ?s <p> ?o OPTIONAL { ?o <q> ?b } ?b <r> ?c }` : nil,
+        `SELECT * { ?s <p> ?o { ?o <q> ?b } UNION { ?o <r> ?c } ?c <s> ?d }` : nil,
+        `SELECT * { ?s <p> ?o { ?o <q> ?b FILTER EXISTS { ?b <r> ?c } } UNION { ?o <r> ?d } ?c <s> ?e }` : { cartesian },
+        `SELECT * { ?s <p> ?o BIND (str(?o) AS ?x) ?y <q> ?x }` : nil,
+        // a BIND extends the solutions of its group
+        `SELECT * { ?s ?p ?o BIND (1 AS ?x) }` : nil,
+        `SELECT * { ?s ?p ?o BIND (NOW() AS ?t) }` : nil,
+        `SELECT * { ?s ?p ?o BIND (str(?z) AS ?x) }` : nil,
+        `SELECT * { ?s ?p ?o BIND (1 AS ?x) ?a <q> ?b }` : { cartesian },
+        `SELECT * { ?s <p> ?o VALUES ?o { 1 2 } }` : nil,
+        `SELECT * { ?s <p> ?o { ?a <q> ?b } }` : { nested },
+        `SELECT * { ?s <p> ?o OPTIONAL { ?a <q> ?b } }` : { nested },
+        `SELECT * { ?s <p> ?o GRAPH ?g { ?a <q> ?b } ?g <r> ?s }` : nil,
+        // MINUS binds no variable
+        `SELECT * { ?s <p> ?o MINUS { ?s <q> ?b } ?b <r> ?c }` : { cartesian },
+    })
+}
+
+func TestUnusedPrefix(t *testing.T) {
+    check(t, "unused-prefix", map[string][]string{
+        `PREFIX a: <http://a/> SELECT * { ?s a:p ?o }` : nil,
+        `PREFIX : <http://a/> SELECT * { ?s :p ?o }` : nil,
+        `PREFIX a: <http://a/> PREFIX b: <http://b/> PREFIX : <http://c/> SELECT * { ?s a:p ?o }` : {
+            "The prefix b: is not used",
+            "The prefix : is not used",
+        },
+    })
+}
+
+func TestUnboundProjection(t *testing.T) {
+    check(t, "unbound-projection", map[string][]string{
+        `SELECT ?s ?z { ?s <p> ?o OPTIONAL { ?o <q> ?z } }` : nil,
+        `SELECT ?k (COUNT(*) AS ?c) { ?s <p> ?o } GROUP BY (STR(?s) AS ?k)` : nil,
+        `SELECT ?s ?v { ?s <p> ?o } VALUES ?v { 1 }` : nil,
+        `SELECT ?s { { SELECT ?s { ?s <p> ?o } } }` : nil,
+        `SELECT ?s ?x { ?s <p> ?o }` : { "The variable ?x is projected but never bound" },
+        `SELECT ?o { { SELECT ?s { ?s <p> ?o } } }` : { "The variable ?o is projected but never bound" },
+        `SELECT ?s ?z { ?s <p> ?o MINUS { ?s <q> ?z } }` : { "The variable ?z is projected but never bound" },
+    })
+}
+
+func TestUnboundFilterVariable(t *testing.T) {
+    check(t, "unbound-filter-variable", map[string][]string{
+        `SELECT * { ?s <p> ?o FILTER (?o > 1) }` : nil,
+        `SELECT * { FILTER (?o > 1) ?s <p> ?o }` : nil,
+        `SELECT * { ?s <p> ?o OPTIONAL { ?o <q> ?z FILTER (?s != ?z) } }` : nil,
+        `SELECT * { ?s <p> ?o FILTER NOT EXISTS { ?o <q> ?z } }` : nil,
+        `SELECT * { ?s <p> ?o FILTER (?x > 1) }` : { "The variable ?x of the FILTER is never bound" },
+        `SELECT * { ?s <p> ?o { SELECT ?a { ?a <q> ?b FILTER (?s != ?a) } } }` : { "The variable ?s of the FILTER is never bound" },
+        // a FILTER of a nested group does not see the variables of the enclosing one
+        `SELECT * { ?s <p> ?o { ?o <q> ?z FILTER (?z != ?o) } }` : nil,
+        `SELECT * { ?s <p> ?o { ?o <q> ?z FILTER (?s != ?z) } }` : { "The variable ?s of the FILTER is never bound" },
+        `SELECT * { ?s <p> ?o { ?a <q> ?b } UNION { ?a <r> ?c FILTER (?o > 1) } }` : { "The variable ?o of the FILTER is never bound" },
+        `SELECT * { ?s <p> ?o OPTIONAL { ?o <q> ?z { ?z <r> ?w FILTER (?s != ?w) } } }` : { "The variable ?s of the FILTER is never bound" },
+        `SELECT * { ?s <p> ?o FILTER EXISTS { ?o <q> ?z FILTER (?s != ?z) } }` : nil,
+    })
+}
+
+func TestRegexPrefix(t *testing.T) {
+    check(t, "regex-prefix", map[string][]string{
+        `SELECT * { ?s <p> ?o FILTER regex(?o, "ab") }` : nil,
+        `SELECT * { ?s <p> ?o FILTER regex(?o, "^a.b") }` : nil,
+        `SELECT * { ?s <p> ?o FILTER regex(?o, "^ab", "i") }` : nil,
+        `SELECT * { ?s <p> ?o FILTER regex(?o, "^a\\.b") }` : nil,
+        `SELECT * { ?s <p> ?o FILTER regex(?o, "^ab"@en) }` : nil,
+        `SELECT * { ?s <p> ?o FILTER regex(?o, "^ab") }` : { `STRSTARTS(?o, "ab") is faster than regex(?o, "^ab")` },
+        `SELECT * { ?s <p> ?o FILTER (regex(str(?o), '^ab')) }` : { `STRSTARTS(str(?o), "ab") is faster than regex(str(?o), '^ab')` },
+    })
+}
+
+func TestMissingLimit(t *testing.T) {
+    const missing = "The query has no LIMIT and may return an unbounded number of results"
+    check(t, "missing-limit", map[string][]string{
+        `SELECT * { ?s <p> ?o } LIMIT 10` : nil,
+        `SELECT * { ?s <p> ?o } OFFSET 5 LIMIT 10` : nil,
+        `SELECT (COUNT(*) AS ?c) { ?s <p> ?o }` : nil,
+        `ASK { ?s <p> ?o }` : nil,
+        `SELECT * { ?s <p> ?o }` : { missing },
+        `SELECT * { ?s <p> ?o } OFFSET 5` : { missing },
+        `SELECT ?s (COUNT(*) AS ?c) { ?s <p> ?o } GROUP BY ?s` : { missing },
+        `CONSTRUCT { ?s <p> ?o } WHERE { ?s <p> ?o }` : { missing },
+        `DESCRIBE ?s { ?s <p> ?o }` : { missing },
+    })
+}
+
+func TestDiagnosticPosition(t *testing.T) {
+    diagnostics := lint(t, "unbound-filter-variable", "SELECT * {\n  ?s <p> ?o\n  FILTER (?x > 1)\n}")
+    if len(diagnostics) != 1 {
+        t.Fatalf("Expected one diagnostic but got %v", diagnostics)
+    }
+    d := diagnostics[0]
+    if d.Begin != 33 || d.End != 35 || d.Line != 3 || d.Column != 11 {
+        t.Errorf("Unexpected position %v-%v (line %v column %v)", d.Begin, d.End, d.Line, d.Column)
+    }
+    if d.String() != "3:11: The variable ?x of the FILTER is never bound [unbound-filter-variable]" {
+        t.Errorf("Unexpected diagnostic %v", d)
+    }
+}
+
+func TestLinter(t *testing.T) {
+    l := NewLinter()
+    if err := l.Disable("unknown"); err == nil {
+        t.Error("Expected an error for an unknown rule")
+    }
+    diagnostics, err := l.Lint(`PREFIX a: <http://a/> SELECT ?x { ?s <p> ?o . ?a <q> ?b }`)
+    if err != nil {
+        t.Fatal(err)
+    }
+    var names []string
+    for _,d := range diagnostics {
+        names = append(names, d.Rule)
+    }
+    expected := "[unused-prefix missing-limit unbound-projection cartesian-product]"
+    if got := fmt.Sprint(names); got != expected {
+        t.Errorf("Expected %v but got %v", expected, got)
+    }
+    l.Disable("missing-limit")
+    l.Disable("unused-prefix")
+    if diagnostics, _ = l.Lint(`PREFIX a: <http://a/> SELECT * { ?s <p> ?o }`); len(diagnostics) != 0 {
+        t.Errorf("Unexpected diagnostics %v", diagnostics)
+    }
+    if _, err := l.Lint(`SELECT * {`); err == nil {
+        t.Error("Expected a parse error")
+    }
+}
@@ -0,0 +1,396 @@
+package lint
+
+import (
+    "sort"
+    "strings"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// The rules of the linter, by name
+var rules = map[string]*Rule{
+    "cartesian-product" : {
+        Name : "cartesian-product",
+        Description : "triple patterns or nested patterns of a group that share no variable with the others",
+        check : cartesianProduct,
+    },
+    "unused-prefix" : {
+        Name : "unused-prefix",
+        Description : "PREFIX declarations that are not used",
+        check : unusedPrefix,
+    },
+    "unbound-projection" : {
+        Name : "unbound-projection",
+        Description : "projected variables that are never bound",
+        check : unboundProjection,
+    },
+    "unbound-filter-variable" : {
+        Name : "unbound-filter-variable",
+        Description : "FILTER expressions referencing variables that are not in-scope in their group",
+        check : unboundFilterVariable,
+    },
+    "regex-prefix" : {
+        Name : "regex-prefix",
+        Description : "regex calls that only match a prefix, for which STRSTARTS is faster",
+        check : regexPrefix,
+    },
+    "missing-limit" : {
+        Name : "missing-limit",
+        Description : "queries with an unbounded number of results and no LIMIT",
+        check : missingLimit,
+    },
+}
+
+// varName returns the name of the variable, with the '?' prefix
+func varName(n *sparql.Node) string {
+    return "?" + n.Value()[1:]
+}
+
+// expressionVars returns the variables of the expression. The variables of
+// the patterns of EXISTS are excluded.
+func expressionVars(expr *sparql.Node) []*sparql.Node {
+    var vars []*sparql.Node
+    expr.Walk(func(n *sparql.Node) bool {
+        switch n.Rule {
+        case "var":
+            vars = append(vars, n)
+            return false
+        case "groupGraphPattern":
+            return false
+        }
+        return true
+    })
+    return vars
+}
+
+// A unit of the connectivity analysis, i.e., the triple patterns with a same
+// subject, a nested pattern of the group, or a BIND connecting its variable
+// with those of its expression
+type unit struct {
+    node *sparql.Node
+    // The variables and the blank node labels of the unit
+    terms []string
+}
+
+// cartesianProduct reports the units of a group that are not connected to the
+// previous ones. Two units are connected if they share a variable or a blank
+// node label, directly or not, as the triple patterns in the scope of the Point
+// Of Focus of the autocompletion.
+func cartesianProduct(c *checker, tree *sparql.Node) {
+    tree.Walk(func(n *sparql.Node) bool {
+        if n.Rule == "groupGraphPattern" || n.Rule == "optionalGraphPattern" {
+            if gp := n.Child("graphPattern"); gp != nil {
+                checkConnected(c, groupUnits(gp))
+            }
+        }
+        return true
+    })
+}
+
+// groupUnits returns the units of the group. A nested pattern is a unit with
+// its in-scope variables, joined with the triple patterns of the group. The
+// patterns of a MINUS and the filters bind no variable, and are not units.
+// A BIND only extends the solutions of its group: it connects its variable
+// with those of its expression, if any, but is never reported.
+func groupUnits(gp *sparql.Node) []*unit {
+    var units []*unit
+    add := func(n *sparql.Node, scope map[string]bool) {
+        u := &unit{ node : n }
+        for v := range scope {
+            u.terms = append(u.terms, v)
+        }
+        sort.Strings(u.terms)
+        // a pattern without variables is a check, not a join
+        if len(u.terms) != 0 {
+            units = append(units, u)
+        }
+    }
+    gp.Walk(func(n *sparql.Node) bool {
+        switch n.Rule {
+        case "triplesSameSubjectPath":
+            scope := make(map[string]bool)
+            for _,v := range n.FindAll("var") {
+                scope[varName(v)] = true
+            }
+            for _,label := range n.FindAll("blankNodeLabel") {
+                scope[label.Value()] = true
+            }
+            add(n, scope)
+            return false
+        case "graphPatternNotTriples":
+            add(n, nestedScope(n.Children[0]))
+            return false
+        case "filterOrBind":
+            if n.Child("BIND") != nil {
+                if vars := expressionVars(n.Child("expression")); len(vars) != 0 {
+                    scope := map[string]bool{ varName(n.Child("var")) : true }
+                    for _,v := range vars {
+                        scope[varName(v)] = true
+                    }
+                    add(n, scope)
+                }
+            }
+            return false
+        }
+        return true
+    })
+    return units
+}
+
+// nestedScope returns the variables the nested pattern joins with its group
+func nestedScope(n *sparql.Node) map[string]bool {
+    scope := make(map[string]bool)
+    switch n.Rule {
+    case "optionalGraphPattern":
+        scope = sparql.InScope(n)
+    case "groupOrUnionGraphPattern":
+        for union := n; union != nil; union = union.Child("groupOrUnionGraphPattern") {
+            for v := range sparql.InScope(union.Child("groupGraphPattern")) {
+                scope[v] = true
+            }
+        }
+    case "graphGraphPattern", "serviceGraphPattern":
+        scope = sparql.InScope(n.Child("groupGraphPattern"))
+        if v := n.Child("var"); v != nil {
+            scope[varName(v)] = true
+        }
+    case "inlineData":
+        for _,v := range n.FindAll("var") {
+            scope[varName(v)] = true
+        }
+    }
+    return scope
+}
+
+// checkConnected reports the units that are not connected to the previous
+// ones, once per connected component after the first one. The first unit of
+// a component that is not a BIND is reported, and a component of BINDs only
+// is not.
+func checkConnected(c *checker, units []*unit) {
+    first := true
+    for len(units) != 0 {
+        sets := make([][]string, len(units))
+        for i,u := range units {
+            sets[i] = u.terms
+        }
+        var pattern *sparql.Node
+        var rest []*unit
+        for i,in := range sparql.Connected(units[0].terms, sets) {
+            switch {
+            case !in:
+                rest = append(rest, units[i])
+            case pattern == nil && units[i].node.Rule != "filterOrBind":
+                pattern = units[i].node
+            }
+        }
+        units = rest
+        if pattern == nil {
+            continue
+        }
+        if !first {
+            if pattern.Rule == "triplesSameSubjectPath" {
+                c.report(pattern, "The triple pattern shares no variable with the previous ones of the group, which makes a cartesian product")
+            } else {
+                c.report(pattern, "The nested pattern shares no variable with the previous ones of the group, which makes a cartesian product")
+            }
+        }
+        first = false
+    }
+}
+
+// unusedPrefix reports the prefix declarations that are not used by any
+// prefixed name
+func unusedPrefix(c *checker, tree *sparql.Node) {
+    prolog := tree.Child("prolog")
+    if prolog == nil {
+        return
+    }
+    used := make(map[string]bool)
+    for _,name := range tree.FindAll("prefixedName") {
+        prefix := ""
+        if p := name.Child("pnPrefix"); p != nil {
+            prefix = p.Value()
+        }
+        used[prefix] = true
+    }
+    for _,decl := range prolog.ChildrenOf("prefixDecl") {
+        prefix := ""
+        if p := decl.Child("pnPrefix"); p != nil {
+            prefix = p.Value()
+        }
+        if !used[prefix] {
+            c.report(decl, "The prefix %v: is not used", prefix)
+        }
+    }
+}
+
+// unboundProjection reports the projected variables that are not bound by the
+// WHERE clause, the GROUP BY or the VALUES of the query
+func unboundProjection(c *checker, tree *sparql.Node) {
+    tree.Walk(func(n *sparql.Node) bool {
+        if n.Rule != "selectQuery" && n.Rule != "subSelect" {
+            return true
+        }
+        bound := sparql.InScope(n.Child("whereClause").Child("groupGraphPattern"))
+        if modifier := n.Child("solutionModifier"); modifier != nil {
+            if group := modifier.Child("groupClause"); group != nil {
+                for _,cond := range group.ChildrenOf("groupCondition") {
+                    if v := cond.Child("var"); v != nil {
+                        bound[varName(v)] = true
+                    }
+                }
+            }
+        }
+        values := n.Child("valuesClause")
+        if n.Rule == "selectQuery" {
+            if query := tree.Find("query"); query != nil {
+                values = query.Child("valuesClause")
+            }
+        }
+        if values != nil {
+            for _,v := range values.FindAll("var") {
+                bound[varName(v)] = true
+            }
+        }
+        for _,elem := range n.Child("select").ChildrenOf("projectionElem") {
+            v := elem.Child("var")
+            if elem.Child("expression") != nil {
+                bound[varName(v)] = true
+            } else if !bound[varName(v)] {
+                c.report(v, "The variable %v is projected but never bound", varName(v))
+            }
+        }
+        return true
+    })
+}
+
+// unboundFilterVariable reports the variables of a FILTER that are not
+// in-scope in its group. A FILTER of an OPTIONAL is the condition of the left
+// join, and also sees the variables of the enclosing group, as does a FILTER
+// in the pattern of an EXISTS, which is evaluated with the bindings of the
+// solution.
+func unboundFilterVariable(c *checker, tree *sparql.Node) {
+    var visit func(n *sparql.Node, scope map[string]bool)
+    visit = func(n *sparql.Node, scope map[string]bool) {
+        switch n.Rule {
+        case "groupGraphPattern":
+            if n.Child("graphPattern") != nil {
+                scope = sparql.InScope(n)
+            }
+        case "optionalGraphPattern":
+            if n.Child("graphPattern") != nil {
+                scope = union(scope, sparql.InScope(n))
+            }
+        case "filterOrBind":
+            if n.Child("FILTER") != nil {
+                for _,v := range expressionVars(n.Child("constraint")) {
+                    if !scope[varName(v)] {
+                        c.report(v, "The variable %v of the FILTER is never bound", varName(v))
+                    }
+                }
+            }
+        }
+        exists := n.Child("EXISTS") != nil || n.Child("NOTEXIST") != nil
+        for _,child := range n.Children {
+            if exists && child.Rule == "groupGraphPattern" && child.Child("graphPattern") != nil {
+                inner := union(scope, sparql.InScope(child))
+                for _,grandChild := range child.Children {
+                    visit(grandChild, inner)
+                }
+                continue
+            }
+            visit(child, scope)
+        }
+    }
+    visit(tree, nil)
+}
+
+// union returns the variables of both scopes
+func union(a, b map[string]bool) map[string]bool {
+    scope := make(map[string]bool, len(a) + len(b))
+    for v := range a {
+        scope[v] = true
+    }
+    for v := range b {
+        scope[v] = true
+    }
+    return scope
+}
+
+// The characters with a special meaning in a regular expression
+const regexMeta = `\.[]()*+?{}|^$`
+
+// regexPrefix reports the calls to regex without flags whose pattern only
+// matches a literal prefix, e.g., regex(?name, "^Al")
+func regexPrefix(c *checker, tree *sparql.Node) {
+    for _,call := range tree.FindAll("builtinCall") {
+        if call.Child("REGEX") == nil {
+            continue
+        }
+        args := call.ChildrenOf("expression")
+        if len(args) != 2 {
+            continue
+        }
+        pattern, ok := plainString(args[1])
+        if !ok || !strings.HasPrefix(pattern, "^") || len(pattern) == 1 || strings.ContainsAny(pattern[1:], regexMeta) {
+            continue
+        }
+        c.report(call, "STRSTARTS(%v, \"%v\") is faster than %v", args[0].Value(), pattern[1:], call.Value())
+    }
+}
+
+// plainString returns the value of the expression if it is a string literal
+// without escape sequences, a language tag or a datatype
+func plainString(expr *sparql.Node) (string, bool) {
+    literal := expr.Find("literal")
+    if literal == nil || literal.Value() != expr.Value() {
+        return "", false
+    }
+    str := literal.Child("string")
+    if str == nil || str.Value() != literal.Value() {
+        return "", false
+    }
+    value := str.Value()
+    quotes := 1
+    if strings.HasPrefix(value, `"""`) || strings.HasPrefix(value, "'''") {
+        quotes = 3
+    }
+    value = value[quotes:len(value) - quotes]
+    if strings.Contains(value, `\`) {
+        return "", false
+    }
+    return value, true
+}
+
+// missingLimit reports the SELECT, CONSTRUCT and DESCRIBE queries without a
+// LIMIT. A query with aggregates and without a GROUP BY has a single solution
+// and is not reported.
+func missingLimit(c *checker, tree *sparql.Node) {
+    query := tree.Find("query")
+    if query == nil {
+        return
+    }
+    q := query.Children[0]
+    if q.Rule == "askQuery" {
+        return
+    }
+    modifier := q.Child("solutionModifier")
+    if modifier != nil {
+        if clauses := modifier.Child("limitOffsetClauses"); clauses != nil && clauses.Child("limit") != nil {
+            return
+        }
+    }
+    if sel := q.Child("select"); sel != nil && (modifier == nil || modifier.Child("groupClause") == nil) {
+        aggregated := false
+        sel.Walk(func(n *sparql.Node) bool {
+            if n.Rule == "aggregate" {
+                aggregated = true
+            }
+            return !aggregated && n.Rule != "groupGraphPattern"
+        })
+        if aggregated {
+            return
+        }
+    }
+    keyword := q.Children[0].Children[0]
+    c.report(keyword, "The query has no LIMIT and may return an unbounded number of results")
+}
@@ -0,0 +1,267 @@
+/*
+ Package rank orders the recommendations of a query before they are presented,
+ by combining the scores of several rankers.
+
+    r := rank.Combination{
+        { Ranker : rank.Frequency{}, Weight : 1 },
+        { Ranker : rank.Popularity{ Model : m }, Weight : 0.5 },
+        { Ranker : rank.EditDistance{}, Weight : 1 },
+        { Ranker : rank.Namespaces{ "http://dbpedia.org/ontology/" }, Weight : 0.5 },
+        { Ranker : session, Weight : 1 },
+    }
+    recs = rank.Rank(s.Scope, recs, r)
+
+ A ranker scores each recommendation between 0 and 1, the higher the better.
+ The built-in rankers score the number of occurrences in the data, the
+ popularity in a query log, the similarity with the keyword, the preferred
+ namespaces, and the items recently selected in the session of the user.
+*/
+package rank
+
+import (
+    "sort"
+    "strings"
+    "sync"
+    "github.com/scampi/gosparqled/autocompletion"
+)
+
+// Ranker scores the recommendations of a scope
+type Ranker interface {
+    // Score returns the score of each recommendation, between 0 and 1
+    Score(s *autocompletion.Scope, recs []autocompletion.Recommendation) []float64
+}
+
+// Rank returns the recommendations by decreasing score. The recommendations
+// with the same score are kept in their order.
+func Rank(s *autocompletion.Scope, recs []autocompletion.Recommendation, r Ranker) []autocompletion.Recommendation {
+    scores := r.Score(s, recs)
+    order := make([]int, len(recs))
+    for i := range order {
+        order[i] = i
+    }
+    sort.SliceStable(order, func(i, j int) bool { return scores[order[i]] > scores[order[j]] })
+    ranked := make([]autocompletion.Recommendation, len(recs))
+    for i,o := range order {
+        ranked[i] = recs[o]
+    }
+    return ranked
+}
+
+// normalize divides the values by the greatest one
+func normalize(values []float64) []float64 {
+    max := 0.0
+    for _,v := range values {
+        if v > max {
+            max = v
+        }
+    }
+    if max > 0 {
+        for i := range values {
+            values[i] /= max
+        }
+    }
+    return values
+}
+
+// Frequency scores the recommendations by their number of occurrences in the
+// data, as counted by a ranked recommendation query, relative to the most
+// frequent one
+type Frequency struct {}
+
+func (Frequency) Score(s *autocompletion.Scope, recs []autocompletion.Recommendation) []float64 {
+    scores := make([]float64, len(recs))
+    for i,r := range recs {
+        scores[i] = float64(r.Count)
+    }
+    return normalize(scores)
+}
+
+// Popularity scores the recommendations by their number of entities in the
+// model of a query log, relative to the most popular one. Without a model, all
+// the recommendations have the score 0.
+type Popularity struct {
+    // The model of the query log
+    Model *autocompletion.Model
+}
+
+func (p Popularity) Score(s *autocompletion.Scope, recs []autocompletion.Recommendation) []float64 {
+    scores := make([]float64, len(recs))
+    if p.Model == nil {
+        return scores
+    }
+    for i,r := range recs {
+        scores[i] = float64(p.Model.Classes[r.Item] + p.Model.Predicates[r.Item])
+    }
+    return normalize(scores)
+}
+
+// EditDistance scores the recommendations by the similarity of the keyword
+// with the local name of the item or with its label, ignoring the case. The
+// similarity is 1 minus the edit distance over the length of the longest
+// string. Without a keyword, all the recommendations have the score 0.
+type EditDistance struct {}
+
+func (EditDistance) Score(s *autocompletion.Scope, recs []autocompletion.Recommendation) []float64 {
+    scores := make([]float64, len(recs))
+    if s.Keyword == "" {
+        return scores
+    }
+    keyword := strings.ToLower(s.Keyword)
+    for i,r := range recs {
+        for _,name := range []string{ localName(r.Item), r.Label } {
+            if name == "" {
+                continue
+            }
+            if sim := similarity(keyword, strings.ToLower(name)); sim > scores[i] {
+                scores[i] = sim
+            }
+        }
+    }
+    return scores
+}
+
+// localName returns the part of the IRI after its last '#' or '/'
+func localName(iri string) string {
+    return iri[strings.LastIndexAny(iri, "#/") + 1:]
+}
+
+// similarity returns 1 minus the Levenshtein distance between the strings over
+// the length of the longest one
+func similarity(a string, b string) float64 {
+    x, y := []rune(a), []rune(b)
+    if len(x) < len(y) {
+        x, y = y, x
+    }
+    if len(x) == 0 {
+        return 1
+    }
+    // the distances between the prefixes of x and those of y
+    previous := make([]int, len(y) + 1)
+    current := make([]int, len(y) + 1)
+    for j := range previous {
+        previous[j] = j
+    }
+    for i := 1; i <= len(x); i++ {
+        current[0] = i
+        for j := 1; j <= len(y); j++ {
+            cost := 1
+            if x[i - 1] == y[j - 1] {
+                cost = 0
+            }
+            current[j] = minimum(previous[j] + 1, current[j - 1] + 1, previous[j - 1] + cost)
+        }
+        previous, current = current, previous
+    }
+    return 1 - float64(previous[len(y)]) / float64(len(x))
+}
+
+// minimum returns the smallest of the values
+func minimum(values ...int) int {
+    m := values[0]
+    for _,v := range values[1:] {
+        if v < m {
+            m = v
+        }
+    }
+    return m
+}
+
+// Namespaces are the preferred namespaces, in order of preference. A
+// recommendation in the first one has the score 1, decreasing with the rank of
+// its namespace. The items in none have the score 0.
+type Namespaces []string
+
+func (n Namespaces) Score(s *autocompletion.Scope, recs []autocompletion.Recommendation) []float64 {
+    scores := make([]float64, len(recs))
+    for i,r := range recs {
+        for j,ns := range n {
+            if strings.HasPrefix(r.Item, ns) {
+                scores[i] = 1 - float64(j) / float64(len(n))
+                break
+            }
+        }
+    }
+    return scores
+}
+
+// Session keeps the items the user recently selected, and scores the
+// recommendations by how recently they were. The most recent item has the
+// score 1, decreasing with its age in the session. The other items have the
+// score 0.
+type Session struct {
+    // The number of items kept. The last selected item is kept if it is less
+    // than 1.
+    Capacity int
+    mu sync.Mutex
+    // The selected items, from the least recent to the most
+    items []string
+}
+
+// NewSession returns a session keeping at most capacity items, and at least the
+// last selected one
+func NewSession(capacity int) *Session {
+    return &Session{ Capacity : capacity }
+}
+
+// Select records that the user selected the item
+func (se *Session) Select(item string) {
+    se.mu.Lock()
+    defer se.mu.Unlock()
+    for i,it := range se.items {
+        if it == item {
+            se.items = append(se.items[:i], se.items[i + 1:]...)
+            break
+        }
+    }
+    se.items = append(se.items, item)
+    capacity := se.Capacity
+    if capacity < 1 {
+        capacity = 1
+    }
+    if len(se.items) > capacity {
+        se.items = se.items[len(se.items) - capacity:]
+    }
+}
+
+func (se *Session) Score(s *autocompletion.Scope, recs []autocompletion.Recommendation) []float64 {
+    se.mu.Lock()
+    defer se.mu.Unlock()
+    ages := make(map[string]int, len(se.items))
+    for i,item := range se.items {
+        ages[item] = len(se.items) - 1 - i
+    }
+    scores := make([]float64, len(recs))
+    for i,r := range recs {
+        if age, ok := ages[r.Item]; ok {
+            scores[i] = 1 - float64(age) / float64(len(se.items))
+        }
+    }
+    return scores
+}
+
+// Weighted is a ranker with the weight of its scores in a combination
+type Weighted struct {
+    Ranker Ranker
+    Weight float64
+}
+
+// Combination scores the recommendations by the weighted average of the scores
+// of its rankers
+type Combination []Weighted
+
+func (c Combination) Score(s *autocompletion.Scope, recs []autocompletion.Recommendation) []float64 {
+    scores := make([]float64, len(recs))
+    total := 0.0
+    for _,w := range c {
+        total += w.Weight
+        for i,score := range w.Ranker.Score(s, recs) {
+            scores[i] += w.Weight * score
+        }
+    }
+    if total > 0 {
+        for i := range scores {
+            scores[i] /= total
+        }
+    }
+    return scores
+}
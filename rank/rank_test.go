@@ -0,0 +1,115 @@
+package rank
+
+import (
+    "math"
+    "strings"
+    "testing"
+    "github.com/scampi/gosparqled/autocompletion"
+)
+
+var recs = []autocompletion.Recommendation{
+    { Item : "http://xmlns.com/foaf/0.1/name", Count : 10 },
+    { Item : "http://dbpedia.org/ontology/birthPlace", Label : "place of birth", Count : 40 },
+    { Item : "http://dbpedia.org/property/birthDate", Count : 20 },
+    { Item : "http://dbpedia.org/ontology/birthName" },
+}
+
+// scope returns the scope of the query
+func scope(t *testing.T, query string) *autocompletion.Scope {
+    s := &autocompletion.Sparql{ Buffer : query, Scope : autocompletion.NewScope() }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    return s.Scope
+}
+
+// local returns the local names of the items, in order
+func local(recs []autocompletion.Recommendation) string {
+    var names []string
+    for _,r := range recs {
+        names = append(names, localName(r.Item))
+    }
+    return strings.Join(names, " ")
+}
+
+func TestRankers(t *testing.T) {
+    s := scope(t, "SELECT * { ?s birthp< }")
+    session := NewSession(2)
+    for _,item := range []string{ "http://xmlns.com/foaf/0.1/name", "http://dbpedia.org/ontology/birthName", "http://dbpedia.org/property/birthDate" } {
+        session.Select(item)
+    }
+    m := autocompletion.TrainModel([]string{
+        "SELECT * { ?s <http://xmlns.com/foaf/0.1/name> ?n ; <http://dbpedia.org/ontology/birthName> ?b }",
+        "SELECT * { ?s <http://dbpedia.org/ontology/birthName> ?b }",
+    })
+    for name,tc := range map[string]struct{
+        ranker Ranker
+        expected string
+    }{
+        "frequency" : { Frequency{}, "birthPlace birthDate name birthName" },
+        "popularity" : { Popularity{ Model : m }, "birthName name birthPlace birthDate" },
+        "distance" : { EditDistance{}, "birthPlace birthDate birthName name" },
+        "namespaces" : { Namespaces{ "http://dbpedia.org/property/", "http://dbpedia.org/ontology/" }, "birthDate birthPlace birthName name" },
+        "session" : { session, "birthDate birthName name birthPlace" },
+        "combination" : { Combination{ { Frequency{}, 1 }, { session, 2 } }, "birthDate birthPlace birthName name" },
+    } {
+        if actual := local(Rank(s, recs, tc.ranker)); actual != tc.expected {
+            t.Errorf("Expected [%v] with the %v ranker but got [%v]", tc.expected, name, actual)
+        }
+    }
+}
+
+func TestSessionCapacity(t *testing.T) {
+    s := scope(t, "SELECT * { ?s birthp< }")
+    for _,capacity := range []int{ -1, 0, 1 } {
+        session := NewSession(capacity)
+        session.Select(recs[0].Item)
+        session.Select(recs[1].Item)
+        if actual := local(Rank(s, recs, session)); actual != "birthPlace name birthDate birthName" {
+            t.Errorf("Expected the last selected item only with the capacity %v but got [%v]", capacity, actual)
+        }
+    }
+}
+
+func TestScores(t *testing.T) {
+    s := scope(t, "SELECT * { ?s birthp< }")
+    expected := []float64{ 0.25, 1, 0.5, 0 }
+    for i,score := range (Frequency{}).Score(s, recs) {
+        if score != expected[i] {
+            t.Errorf("Expected the frequency %v of %v but got %v", expected[i], recs[i].Item, score)
+        }
+    }
+    scores := Combination{ { Frequency{}, 1 }, { Namespaces{ "http://xmlns.com/foaf/0.1/" }, 3 } }.Score(s, recs)
+    if math.Abs(scores[0] - 0.8125) > 1e-9 || math.Abs(scores[1] - 0.25) > 1e-9 {
+        t.Errorf("Expected the weighted average of the scores but got %v", scores)
+    }
+    // without a keyword, the edit distance does not rank
+    if actual := local(Rank(scope(t, "SELECT * { ?s < }"), recs, EditDistance{})); actual != "name birthPlace birthDate birthName" {
+        t.Errorf("Expected the recommendations unchanged but got [%v]", actual)
+    }
+    // without a model, the popularity does not rank
+    for i,score := range (Popularity{}).Score(s, recs) {
+        if score != 0 {
+            t.Errorf("Expected the popularity 0 of %v but got %v", recs[i].Item, score)
+        }
+    }
+}
+
+func TestSimilarity(t *testing.T) {
+    for _,tc := range []struct{
+        a, b string
+        expected float64
+    }{
+        { "", "", 1 },
+        { "birth", "birth", 1 },
+        { "birth", "birthdate", 1 - 4.0 / 9 },
+        { "kitten", "sitting", 1 - 3.0 / 7 },
+        { "été", "ete", 1 - 2.0 / 3 },
+    } {
+        if actual := similarity(tc.a, tc.b); math.Abs(actual - tc.expected) > 1e-9 {
+            t.Errorf("Expected the similarity %v of [%v] and [%v] but got %v", tc.expected, tc.a, tc.b, actual)
+        }
+    }
+}
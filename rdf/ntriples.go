@@ -0,0 +1,235 @@
+package rdf
+
+import (
+    "bufio"
+    "io"
+    "strings"
+    "github.com/scampi/gosparqled/results"
+)
+
+// lineReader reads an N-Triples or an N-Quads document, one line at a time
+type lineReader struct {
+    in *bufio.Reader
+    // True if a statement may have a graph
+    quads bool
+    // The number of lines read
+    line int
+    err error
+}
+
+// NewNTriplesReader returns a reader of an N-Triples document
+func NewNTriplesReader(r io.Reader) Reader {
+    return &lineReader{ in : bufio.NewReader(r) }
+}
+
+// NewNQuadsReader returns a reader of an N-Quads document
+func NewNQuadsReader(r io.Reader) Reader {
+    return &lineReader{ in : bufio.NewReader(r), quads : true }
+}
+
+func (r *lineReader) Read() (Quad, error) {
+    for r.err == nil {
+        text, err := r.in.ReadString('\n')
+        if err != nil {
+            r.err = err
+            if text == "" {
+                break
+            }
+        }
+        r.line++
+        l := &lexer{ text : []rune(strings.TrimRight(text, "\r\n")), line : r.line }
+        if q, ok, err := l.statement(r.quads); err != nil {
+            r.err = err
+            return Quad{}, err
+        } else if ok {
+            return q, nil
+        }
+    }
+    return Quad{}, r.err
+}
+
+// lexer reads the terms of a line of an N-Triples or an N-Quads document
+type lexer struct {
+    text []rune
+    pos int
+    line int
+}
+
+// errorf returns the syntax error at the current position
+func (l *lexer) errorf(message string) error {
+    return &SyntaxError{ Message : message, Line : l.line, Column : l.pos + 1 }
+}
+
+// skip skips the whitespaces
+func (l *lexer) skip() {
+    for l.pos < len(l.text) && (l.text[l.pos] == ' ' || l.text[l.pos] == '\t') {
+        l.pos++
+    }
+}
+
+// peek returns the current character, or 0 at the end of the line
+func (l *lexer) peek() rune {
+    if l.pos < len(l.text) {
+        return l.text[l.pos]
+    }
+    return 0
+}
+
+// statement returns the statement of the line, or false if the line is empty
+// or a comment
+func (l *lexer) statement(quads bool) (Quad, bool, error) {
+    l.skip()
+    if c := l.peek(); c == 0 || c == '#' {
+        return Quad{}, false, nil
+    }
+    var q Quad
+    var err error
+    begin := l.pos
+    if q.Subject, err = l.term(); err != nil {
+        return q, false, err
+    }
+    if q.Subject.Kind == results.LITERAL {
+        l.pos = begin
+        return q, false, l.errorf("The subject must be an IRI or a blank node")
+    }
+    begin = l.pos
+    if q.Predicate, err = l.term(); err != nil {
+        return q, false, err
+    }
+    if q.Predicate.Kind != results.IRI {
+        l.pos = begin
+        return q, false, l.errorf("The predicate must be an IRI")
+    }
+    if q.Object, err = l.term(); err != nil {
+        return q, false, err
+    }
+    if quads && l.peek() != '.' {
+        begin = l.pos
+        if q.Graph, err = l.term(); err != nil {
+            return q, false, err
+        }
+        if q.Graph.Kind == results.LITERAL {
+            l.pos = begin
+            return q, false, l.errorf("The graph must be an IRI or a blank node")
+        }
+    }
+    if l.peek() != '.' {
+        return q, false, l.errorf("Expected [.] at the end of the statement")
+    }
+    l.pos++
+    l.skip()
+    if c := l.peek(); c != 0 && c != '#' {
+        return q, false, l.errorf("Unexpected text after the statement")
+    }
+    return q, true, nil
+}
+
+// term returns the next term, and skips the whitespaces after it
+func (l *lexer) term() (results.Term, error) {
+    begin := l.pos
+    switch c := l.peek(); {
+    case c == '<':
+        if !l.iri() {
+            l.pos = begin
+            return results.Term{}, l.errorf("Unterminated IRI")
+        }
+    case c == '_' && l.pos + 1 < len(l.text) && l.text[l.pos + 1] == ':':
+        l.pos += 2
+        for l.pos < len(l.text) && nameChar(l.text[l.pos]) {
+            l.pos++
+        }
+        // a label does not end with a dot
+        for l.pos > begin + 2 && l.text[l.pos - 1] == '.' {
+            l.pos--
+        }
+    case c == '"':
+        if !l.literal() {
+            l.pos = begin
+            return results.Term{}, l.errorf("Unterminated literal")
+        }
+    case c == 0:
+        return results.Term{}, l.errorf("Unexpected end of line")
+    default:
+        return results.Term{}, l.errorf("Expected an IRI, a blank node or a literal")
+    }
+    t, err := results.ParseTerm(string(l.text[begin:l.pos]))
+    if err != nil {
+        l.pos = begin
+        return t, l.errorf(err.Error())
+    }
+    if t.Kind == results.IRI && !absolute(t.Value) || t.Datatype != "" && !absolute(t.Datatype) {
+        l.pos = begin
+        return t, l.errorf("Relative IRIs are not allowed")
+    }
+    l.skip()
+    return t, nil
+}
+
+// iri moves past the IRI, and returns false if it is not terminated
+func (l *lexer) iri() bool {
+    for l.pos++; l.pos < len(l.text); l.pos++ {
+        switch l.text[l.pos] {
+        case '>':
+            l.pos++
+            return true
+        case ' ', '<', '"':
+            return false
+        }
+    }
+    return false
+}
+
+// literal moves past the literal with its language tag or datatype, and returns
+// false if it is not terminated
+func (l *lexer) literal() bool {
+    for l.pos++; ; l.pos++ {
+        if l.pos >= len(l.text) {
+            return false
+        }
+        if c := l.text[l.pos]; c == '\\' {
+            l.pos++
+        } else if c == '"' {
+            l.pos++
+            break
+        }
+    }
+    switch {
+    case l.peek() == '@':
+        for l.pos++; l.pos < len(l.text) && (isLetter(l.text[l.pos]) || isDigit(l.text[l.pos]) || l.text[l.pos] == '-'); {
+            l.pos++
+        }
+    case strings.HasPrefix(string(l.text[l.pos:]), "^^<"):
+        l.pos += 2
+        return l.iri()
+    }
+    return true
+}
+
+// absolute returns true if the IRI starts with a scheme
+func absolute(iri string) bool {
+    for i,c := range iri {
+        switch {
+        case isLetter(c):
+        case i > 0 && (isDigit(c) || strings.ContainsRune("+-.", c)):
+        case i > 0 && c == ':':
+            return true
+        default:
+            return false
+        }
+    }
+    return false
+}
+
+func isLetter(c rune) bool {
+    return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c rune) bool {
+    return c >= '0' && c <= '9'
+}
+
+// nameChar returns true if the character may be part of a blank node label or
+// of a prefixed name
+func nameChar(c rune) bool {
+    return isLetter(c) || isDigit(c) || c >= 0x80 || strings.ContainsRune("_-.:%", c)
+}
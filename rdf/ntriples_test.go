@@ -0,0 +1,115 @@
+package rdf
+
+import (
+    "io"
+    "reflect"
+    "strings"
+    "testing"
+    "github.com/scampi/gosparqled/results"
+)
+
+// readAll returns the statements of the document, as N-Quads
+func readAll(t *testing.T, r Reader) []string {
+    var quads []string
+    for {
+        q, err := r.Read()
+        if err == io.EOF {
+            return quads
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        quads = append(quads, q.String())
+    }
+}
+
+// readError returns the error of reading the document
+func readError(r Reader) error {
+    for {
+        if _, err := r.Read(); err != nil {
+            if err == io.EOF {
+                return nil
+            }
+            return err
+        }
+    }
+}
+
+func TestNTriples(t *testing.T) {
+    doc := `# people
+<http://example.org/alice> <http://xmlns.com/foaf/0.1/name> "Alice \"A\""@en .
+
+_:b1 <http://example.org/age> "42"^^<http://www.w3.org/2001/XMLSchema#integer>.
+	<http://example.org/alice>  <http://example.org/knows> _:b1 . # a friend
+<http://example.org/é> <http://example.org/p> "café" .`
+    expected := []string{
+        `<http://example.org/alice> <http://xmlns.com/foaf/0.1/name> "Alice \"A\""@en .`,
+        `_:b1 <http://example.org/age> "42"^^<http://www.w3.org/2001/XMLSchema#integer> .`,
+        `<http://example.org/alice> <http://example.org/knows> _:b1 .`,
+        `<http://example.org/é> <http://example.org/p> "café" .`,
+    }
+    if actual := readAll(t, NewNTriplesReader(strings.NewReader(doc))); !reflect.DeepEqual(actual, expected) {
+        t.Errorf("Expected\n%v\nbut got\n%v", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+    }
+}
+
+func TestNQuads(t *testing.T) {
+    doc := "<http://a> <http://b> <http://c> <http://g> .\r\n<http://a> <http://b> <http://c> .\n_:s <http://b> \"c\" _:g .\n"
+    r := NewNQuadsReader(strings.NewReader(doc))
+    var graphs []results.Term
+    for {
+        q, err := r.Read()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        graphs = append(graphs, q.Graph)
+    }
+    expected := []results.Term{ results.NewIRI("http://g"), {}, results.NewBlank("g") }
+    if !reflect.DeepEqual(graphs, expected) {
+        t.Errorf("Expected the graphs %v but got %v", expected, graphs)
+    }
+}
+
+func TestNTriplesErrors(t *testing.T) {
+    tests := []struct {
+        doc string
+        line, column int
+    }{
+        { "<http://a> <http://b> <http://c>\n", 1, 33 },
+        { "<http://a> <http://b> <http://c> .\n\"a\" <http://b> <http://c> .\n", 2, 1 },
+        { "<http://a> _:b <http://c> .", 1, 12 },
+        { "<http://a> <http://b> \"c .", 1, 23 },
+        { "<http://a> <http://b> <http://c . ", 1, 23 },
+        { "<http://a> <http://b> <http://c> <http://g> .", 1, 34 },
+        { "<http://a> <http://b> <http://c> . <http://d>", 1, 36 },
+        // the IRIs are absolute
+        { "<http://a> <b> <http://c> .", 1, 12 },
+        { "<http://a> <http://b> \"c\"^^<d> .", 1, 23 },
+    }
+    for _,test := range tests {
+        err, ok := readError(NewNTriplesReader(strings.NewReader(test.doc))).(*SyntaxError)
+        if !ok || err.Line != test.line || err.Column != test.column {
+            t.Errorf("Expected an error at %v:%v but got %v for %v", test.line, test.column, err, test.doc)
+        }
+    }
+}
+
+func TestFormatOf(t *testing.T) {
+    tests := map[string]Format{
+        "dump.nt" : NTRIPLES,
+        "data/DUMP.NQ" : NQUADS,
+        "text/turtle; charset=utf-8" : TURTLE,
+        "application/trig" : TRIG,
+    }
+    for name,expected := range tests {
+        if f, err := FormatOf(name); err != nil || f != expected {
+            t.Errorf("Expected %v but got %v %v for %v", expected, f, err, name)
+        }
+    }
+    if _, err := FormatOf("dump.rdf"); err == nil {
+        t.Error("Expected an error for an unknown format")
+    }
+}
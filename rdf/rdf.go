@@ -0,0 +1,148 @@
+/*
+ Package rdf reads RDF documents in the N-Triples, N-Quads, Turtle and TriG
+ formats, e.g., to load a dump into the in-memory store.
+
+    r, err := rdf.NewReader(f, rdf.TURTLE, "http://example.org/")
+    if err != nil {
+        ...
+    }
+    for {
+        q, err := r.Read()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            ... // a *rdf.SyntaxError gives the line and the column
+        }
+        fmt.Println(q.Subject, q.Predicate, q.Object)
+    }
+
+ The statements are read one at a time, so that large documents are not loaded
+ in memory. The N-Triples and N-Quads documents are read line by line, and their
+ IRIs must be absolute. The Turtle and TriG documents are split into blocks of
+ statements, which are parsed with the rules of the SPARQL grammar for the
+ triples of a query: the prefixed names, IRIs, literals and blank nodes have
+ the same syntax. Variables and property paths are rejected. A syntax error
+ ends the reading, and the statements of the block with the error are not
+ returned.
+
+ The blank nodes of a Turtle or TriG document are given new labels, so that
+ the nodes of a property list or of a collection do not clash with the labelled
+ ones. The labels of an N-Triples or N-Quads document are kept.
+*/
+package rdf
+
+import (
+    "fmt"
+    "io"
+    "mime"
+    "path/filepath"
+    "strings"
+    "github.com/scampi/gosparqled/results"
+)
+
+// The namespaces of the RDF vocabulary and of the XML Schema datatypes
+const (
+    rdfNS = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+    xsd = "http://www.w3.org/2001/XMLSchema#"
+)
+
+// Quad is a triple with the graph it belongs to
+type Quad struct {
+    Subject, Predicate, Object results.Term
+    // The name of the graph, which is the zero term for the default graph
+    Graph results.Term
+}
+
+func (q Quad) String() string {
+    if q.Graph == (results.Term{}) {
+        return fmt.Sprintf("%v %v %v .", q.Subject, q.Predicate, q.Object)
+    }
+    return fmt.Sprintf("%v %v %v %v .", q.Subject, q.Predicate, q.Object, q.Graph)
+}
+
+// Reader reads the statements of an RDF document
+type Reader interface {
+    // Read returns the next statement, or io.EOF if there is none left
+    Read() (Quad, error)
+}
+
+// SyntaxError is an error of a malformed document
+type SyntaxError struct {
+    Message string
+    // The line and the column of the error, starting from 1
+    Line, Column int
+}
+
+func (e *SyntaxError) Error() string {
+    return fmt.Sprintf("%v (line %v symbol %v)", e.Message, e.Line, e.Column)
+}
+
+// Format is a format of RDF documents
+type Format uint
+
+const (
+    // RDF 1.1 N-Triples
+    NTRIPLES Format = iota
+    // RDF 1.1 N-Quads
+    NQUADS
+    // RDF 1.1 Turtle
+    TURTLE
+    // RDF 1.1 TriG
+    TRIG
+)
+
+var formatNames = []string{ "ntriples", "nquads", "turtle", "trig" }
+
+var mediaTypes = []string{
+    "application/n-triples",
+    "application/n-quads",
+    "text/turtle",
+    "application/trig",
+}
+
+var extensions = []string{ ".nt", ".nq", ".ttl", ".trig" }
+
+func (f Format) String() string {
+    return formatNames[f]
+}
+
+// MediaType returns the media type of the format
+func (f Format) MediaType() string {
+    return mediaTypes[f]
+}
+
+// FormatOf returns the format of the media type, or of the extension of the
+// file name. The parameters of the media type are ignored.
+func FormatOf(name string) (Format, error) {
+    if mt, _, err := mime.ParseMediaType(name); err == nil {
+        for f,t := range mediaTypes {
+            if t == mt {
+                return Format(f), nil
+            }
+        }
+    }
+    ext := strings.ToLower(filepath.Ext(name))
+    for f,e := range extensions {
+        if e == ext {
+            return Format(f), nil
+        }
+    }
+    return 0, fmt.Errorf("Unknown RDF format [%v]", name)
+}
+
+// NewReader returns a reader of the document in the format. The relative IRIs
+// of a Turtle or TriG document are resolved against the base IRI, if any.
+func NewReader(r io.Reader, format Format, base string) (Reader, error) {
+    switch format {
+    case NTRIPLES:
+        return NewNTriplesReader(r), nil
+    case NQUADS:
+        return NewNQuadsReader(r), nil
+    case TURTLE:
+        return NewTurtleReader(r, base), nil
+    case TRIG:
+        return NewTriGReader(r, base), nil
+    }
+    return nil, fmt.Errorf("Unknown RDF format [%v]", uint(format))
+}
@@ -0,0 +1,643 @@
+package rdf
+
+import (
+    "bufio"
+    "fmt"
+    "io"
+    "strconv"
+    "strings"
+    "unicode"
+    "github.com/scampi/gosparqled/results"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// The size in bytes above which a block of statements is parsed
+const batchSize = 64 * 1024
+
+// The end of the document
+const eof rune = -1
+
+// turtleReader reads a Turtle or a TriG document, one block of statements at
+// a time
+type turtleReader struct {
+    in *bufio.Reader
+    // True if the statements may be in graph blocks
+    trig bool
+    // The characters read ahead
+    ahead []rune
+    // The position of the next character, starting from 1
+    line, column int
+    // The text of the current block, if it is recorded
+    text *strings.Builder
+    // The last recorded character
+    last rune
+    base string
+    prefixes map[string]string
+    // The new labels of the blank nodes
+    labels map[string]string
+    blanks int
+    // The graph of the current block, and true if in a graph block
+    graph results.Term
+    inGraph bool
+    // The statements read but not yet returned
+    quads []Quad
+    err error
+}
+
+// NewTurtleReader returns a reader of a Turtle document, whose relative IRIs
+// are resolved against the base IRI, if any
+func NewTurtleReader(r io.Reader, base string) Reader {
+    return newTurtleReader(r, base, false)
+}
+
+// NewTriGReader returns a reader of a TriG document, whose relative IRIs are
+// resolved against the base IRI, if any
+func NewTriGReader(r io.Reader, base string) Reader {
+    return newTurtleReader(r, base, true)
+}
+
+func newTurtleReader(r io.Reader, base string, trig bool) *turtleReader {
+    return &turtleReader{
+        in : bufio.NewReader(r),
+        trig : trig,
+        line : 1,
+        column : 1,
+        base : base,
+        prefixes : make(map[string]string),
+        labels : make(map[string]string),
+    }
+}
+
+func (r *turtleReader) Read() (Quad, error) {
+    for len(r.quads) == 0 {
+        if r.err != nil {
+            return Quad{}, r.err
+        }
+        r.err = r.next()
+    }
+    q := r.quads[0]
+    r.quads = r.quads[1:]
+    return q, nil
+}
+
+// peek returns the character at the offset from the next one, or eof
+func (r *turtleReader) peek(offset int) rune {
+    for len(r.ahead) <= offset {
+        c, _, err := r.in.ReadRune()
+        if err != nil {
+            return eof
+        }
+        r.ahead = append(r.ahead, c)
+    }
+    return r.ahead[offset]
+}
+
+// advance moves past the next character, which is recorded in the text of the
+// current block, if any
+func (r *turtleReader) advance() rune {
+    c := r.peek(0)
+    if c == eof {
+        return c
+    }
+    r.ahead = r.ahead[1:]
+    if c == '\n' {
+        r.line++
+        r.column = 1
+    } else {
+        r.column++
+    }
+    if r.text != nil {
+        r.text.WriteRune(c)
+        r.last = c
+    }
+    return c
+}
+
+// errorf returns the syntax error at the position of the next character
+func (r *turtleReader) errorf(format string, args ...interface{}) error {
+    return &SyntaxError{ Message : fmt.Sprintf(format, args...), Line : r.line, Column : r.column }
+}
+
+// skip moves past the whitespaces and the comments
+func (r *turtleReader) skip() {
+    for {
+        switch c := r.peek(0); {
+        case c == '#':
+            for c != eof && c != '\n' {
+                r.advance()
+                c = r.peek(0)
+            }
+        case unicode.IsSpace(c):
+            r.advance()
+        default:
+            return
+        }
+    }
+}
+
+// keyword returns true if the next characters are the keyword, in any case,
+// followed by a whitespace or an IRI
+func (r *turtleReader) keyword(k string) bool {
+    for i,c := range k {
+        if unicode.ToUpper(r.peek(i)) != c {
+            return false
+        }
+    }
+    next := r.peek(len(k))
+    return unicode.IsSpace(next) || next == '<'
+}
+
+// next reads the next directive, graph block boundary or block of statements
+func (r *turtleReader) next() error {
+    r.skip()
+    switch c := r.peek(0); {
+    case c == eof:
+        if r.inGraph {
+            return r.errorf("Unterminated graph block")
+        }
+        return io.EOF
+    case c == '@':
+        r.advance()
+        switch {
+        case r.keyword("PREFIX") && r.peek(0) == 'p':
+            return r.directive("prefix", true)
+        case r.keyword("BASE") && r.peek(0) == 'b':
+            return r.directive("base", true)
+        }
+        return r.errorf("Unknown directive")
+    case r.keyword("PREFIX"):
+        return r.directive("prefix", false)
+    case r.keyword("BASE"):
+        return r.directive("base", false)
+    case r.trig && c == '{':
+        if r.inGraph {
+            return r.errorf("Nested graph block")
+        }
+        r.advance()
+        r.graph, r.inGraph = results.Term{}, true
+        return nil
+    case r.trig && c == '}':
+        if !r.inGraph {
+            return r.errorf("Unexpected end of graph block")
+        }
+        r.advance()
+        r.graph, r.inGraph = results.Term{}, false
+        return nil
+    case r.trig && r.keyword("GRAPH"):
+        for range "GRAPH" {
+            r.advance()
+        }
+        r.skip()
+        return r.statements(true)
+    }
+    return r.statements(false)
+}
+
+// directive reads a prefix or a base declaration. The declarations starting
+// with "@" end with a dot.
+func (r *turtleReader) directive(name string, dot bool) error {
+    for range name {
+        r.advance()
+    }
+    r.skip()
+    prefix := ""
+    if name == "prefix" {
+        b := r.begin()
+        for c := r.peek(0); c != ':'; c = r.peek(0) {
+            if c == eof || unicode.IsSpace(c) {
+                return r.errorf("Expected [:] after the prefix")
+            }
+            r.advance()
+        }
+        if prefix = r.text.String(); prefix != "" {
+            if _, err := sparql.ParseRule(prefix, "pnPrefix"); err != nil {
+                return b.errorAt(prefix, 0, "Invalid prefix [%v]", prefix)
+            }
+        }
+        r.advance()
+        r.skip()
+    }
+    b := r.begin()
+    for c := r.peek(0); c != '>'; c = r.peek(0) {
+        if c == eof || c == '\n' {
+            return r.errorf("Unterminated IRI")
+        }
+        r.advance()
+    }
+    r.advance()
+    node, err := sparql.ParseRule(r.text.String(), "iri")
+    if err != nil {
+        return b.syntaxError(err)
+    }
+    r.text = nil
+    if dot {
+        r.skip()
+        if r.peek(0) != '.' {
+            return r.errorf("Expected [.] at the end of the directive")
+        }
+        r.advance()
+    }
+    iri := r.resolve(sparql.Unescape(sparql.Unbracket(node.Value())))
+    if name == "base" {
+        r.base = iri
+    } else {
+        r.prefixes[prefix] = iri
+    }
+    return nil
+}
+
+// batch is the text of a block, starting at a position of the document
+type batch struct {
+    line, column int
+}
+
+// begin starts recording the text of a block at the next character
+func (r *turtleReader) begin() *batch {
+    r.text = &strings.Builder{}
+    r.last = 0
+    return &batch{ line : r.line, column : r.column }
+}
+
+// errorAt returns the syntax error at the position in runes of the block text
+func (b *batch) errorAt(text string, position int, format string, args ...interface{}) error {
+    err := &SyntaxError{ Message : fmt.Sprintf(format, args...), Line : b.line, Column : b.column }
+    for _,c := range []rune(text)[:position] {
+        if c == '\n' {
+            err.Line++
+            err.Column = 1
+        } else {
+            err.Column++
+        }
+    }
+    return err
+}
+
+// syntaxError converts the error of parsing the block text
+func (b *batch) syntaxError(err error) error {
+    serr, ok := err.(*sparql.SyntaxError)
+    if !ok {
+        return err
+    }
+    line, column := b.line + serr.Line - 1, serr.Column
+    if serr.Line == 1 {
+        column += b.column - 1
+    }
+    return &SyntaxError{ Message : serr.Message, Line : line, Column : column }
+}
+
+// statements reads a block of statements up to a directive, a graph block
+// boundary, or the batch size. If label, the block is the label of a graph.
+func (r *turtleReader) statements(label bool) error {
+    b := r.begin()
+    defer func() { r.text = nil }()
+    for {
+        begin := r.text.Len()
+        end, err := r.statement()
+        if err != nil {
+            return err
+        }
+        if end == '{' {
+            text := r.text.String()
+            if r.inGraph {
+                return r.errorf("Nested graph block")
+            }
+            if err := r.parse(text[:begin], b); err != nil {
+                return err
+            }
+            if err := r.label(text, b, len([]rune(text[:begin]))); err != nil {
+                return err
+            }
+            r.advance()
+            r.inGraph = true
+            return nil
+        }
+        if label {
+            return r.errorf("Expected [{] after the graph name")
+        }
+        if end == '}' || r.text.Len() >= batchSize {
+            break
+        }
+        r.skip()
+        c := r.peek(0)
+        if c == eof || c == '@' || r.keyword("PREFIX") || r.keyword("BASE") || r.trig && (c == '{' || c == '}' || r.keyword("GRAPH")) {
+            break
+        }
+    }
+    return r.parse(r.text.String(), b)
+}
+
+// statement records the text of a statement, and returns the character it
+// ends with: a dot, which is recorded, or the braces of a graph block.
+func (r *turtleReader) statement() (rune, error) {
+    depth := 0
+    for {
+        switch c := r.peek(0); c {
+        case eof:
+            return c, r.errorf("Unexpected end of document")
+        case '<':
+            for c = r.advance(); c != '>'; c = r.advance() {
+                if c == eof || c == '\n' {
+                    return c, r.errorf("Unterminated IRI")
+                }
+            }
+        case '"', '\'':
+            if err := r.string(c); err != nil {
+                return c, err
+            }
+        case '#':
+            for c != eof && c != '\n' {
+                r.advance()
+                c = r.peek(0)
+            }
+        case '\\':
+            r.advance()
+            r.advance()
+        case '[', '(':
+            depth++
+            r.advance()
+        case ']', ')':
+            depth--
+            r.advance()
+        case '.':
+            // a dot within a number or a name does not end the statement
+            next := r.peek(1)
+            if depth > 0 || isDigit(next) || nameChar(r.last) && nameChar(next) && next != '.' {
+                r.advance()
+                continue
+            }
+            r.advance()
+            return c, nil
+        case '{', '}':
+            if r.trig && depth == 0 {
+                return c, nil
+            }
+            r.advance()
+        default:
+            r.advance()
+        }
+    }
+}
+
+// string records a string literal, which is either short or long
+func (r *turtleReader) string(quote rune) error {
+    err := r.errorf("Unterminated string")
+    long := r.peek(1) == quote && r.peek(2) == quote
+    if long {
+        r.advance()
+        r.advance()
+    }
+    for r.advance(); ; {
+        switch c := r.advance(); {
+        case c == eof || c == '\n' && !long:
+            return err
+        case c == '\\':
+            r.advance()
+        case c == quote && !long:
+            return nil
+        case c == quote && r.peek(0) == quote && r.peek(1) == quote:
+            // the quotes ending a long string may be preceded by others
+            for r.peek(2) == quote {
+                r.advance()
+            }
+            r.advance()
+            r.advance()
+            return nil
+        }
+    }
+}
+
+// parse parses the statements of the block text, starting at the position of
+// the batch
+func (r *turtleReader) parse(text string, b *batch) error {
+    if strings.TrimSpace(text) == "" {
+        return nil
+    }
+    tree, err := sparql.ParseRule(text, "triplesBlock")
+    if err != nil {
+        return b.syntaxError(err)
+    }
+    c := &converter{ r : r, text : text, b : b }
+    c.triplesBlock(tree)
+    if c.err != nil {
+        return c.err
+    }
+    r.quads = append(r.quads, c.quads...)
+    return nil
+}
+
+// label sets the graph of the block to the IRI or the blank node at the
+// position in runes of the block text
+func (r *turtleReader) label(text string, b *batch, position int) error {
+    name := strings.TrimRightFunc(string([]rune(text)[position:]), unicode.IsSpace)
+    node, err := sparql.ParseRule(name, "varOrTerm")
+    if err != nil {
+        return b.errorAt(text, position, "Invalid graph name [%v]", name)
+    }
+    c := &converter{ r : r, text : text, b : b, offset : position }
+    t := c.term(node)
+    switch {
+    case c.err != nil:
+        return c.err
+    case t.Kind == results.LITERAL:
+        return b.errorAt(text, position, "The graph name must be an IRI or a blank node")
+    }
+    r.graph = t
+    return nil
+}
+
+// resolve returns the IRI resolved against the base IRI
+func (r *turtleReader) resolve(iri string) string {
+    return sparql.ResolveIRI(r.base, iri)
+}
+
+// blank returns a new blank node
+func (r *turtleReader) blank() results.Term {
+    r.blanks++
+    return results.NewBlank("b" + strconv.Itoa(r.blanks))
+}
+
+// converter converts the syntax tree of a block of statements into quads
+type converter struct {
+    r *turtleReader
+    text string
+    b *batch
+    // The position in runes of the parsed text in the block text
+    offset int
+    quads []Quad
+    // The first error
+    err error
+}
+
+// errorf keeps the first error, at the position of the node
+func (c *converter) errorf(node *sparql.Node, format string, args ...interface{}) {
+    if c.err == nil {
+        c.err = c.b.errorAt(c.text, c.offset + node.Begin, format, args...)
+    }
+}
+
+// add adds the triple in the graph of the block
+func (c *converter) add(s, p, o results.Term) {
+    c.quads = append(c.quads, Quad{ Subject : s, Predicate : p, Object : o, Graph : c.r.graph })
+}
+
+// triplesBlock converts the statements of a block
+func (c *converter) triplesBlock(node *sparql.Node) {
+    for _,tss := range node.ChildrenOf("triplesSameSubjectPath") {
+        var subject results.Term
+        if n := tss.Child("varOrTerm"); n != nil {
+            subject = c.term(n)
+            if subject.Kind == results.LITERAL {
+                c.errorf(n, "The subject must be an IRI or a blank node")
+            }
+        } else {
+            subject = c.triplesNode(tss.Child("triplesNodePath"))
+        }
+        if pl := tss.Child("propertyListPath"); pl != nil {
+            c.propertyList(subject, pl)
+        }
+    }
+}
+
+// propertyList adds the triples of the property list of the subject
+func (c *converter) propertyList(subject results.Term, node *sparql.Node) {
+    for pl := node; pl != nil; pl = pl.Child("propertyListPath") {
+        verb := c.verb(pl)
+        for _,object := range pl.Child("objectListPath").ChildrenOf("objectPath") {
+            c.add(subject, verb, c.graphNode(object.Child("graphNodePath")))
+        }
+    }
+}
+
+// verb returns the predicate of the property list, which is an IRI
+func (c *converter) verb(node *sparql.Node) results.Term {
+    if v := node.Child("var"); v != nil {
+        c.errorf(v, "Variables are not allowed")
+        return results.Term{}
+    }
+    path := node.Child("verbPath")
+    seqs := path.Find("pathAlternative").ChildrenOf("pathSequence")
+    if len(seqs) == 1 {
+        if elts := seqs[0].ChildrenOf("pathElt"); len(elts) == 1 && len(elts[0].Children) == 1 {
+            primary := elts[0].Child("pathPrimary")
+            if n := primary.Child("iriref"); n != nil {
+                return c.iriref(n)
+            }
+            if primary.Child("ISA") != nil {
+                return results.NewIRI(rdfNS + "type")
+            }
+        }
+    }
+    c.errorf(path, "Property paths are not allowed")
+    return results.Term{}
+}
+
+// graphNode returns the term of the node, after adding the triples of a
+// blank node property list or of a collection
+func (c *converter) graphNode(node *sparql.Node) results.Term {
+    if n := node.Child("triplesNodePath"); n != nil {
+        return c.triplesNode(n)
+    }
+    return c.term(node.Child("varOrTerm"))
+}
+
+// triplesNode adds the triples of a blank node property list or of a
+// collection, and returns the term of the node
+func (c *converter) triplesNode(node *sparql.Node) results.Term {
+    if list := node.Child("blankNodePropertyListPath"); list != nil {
+        b := c.r.blank()
+        c.propertyList(b, list.Child("propertyListPath"))
+        return b
+    }
+    var items []results.Term
+    for _,item := range node.Child("collectionPath").ChildrenOf("graphNodePath") {
+        items = append(items, c.graphNode(item))
+    }
+    head := c.r.blank()
+    cur := head
+    for i,item := range items {
+        c.add(cur, results.NewIRI(rdfNS + "first"), item)
+        next := results.NewIRI(rdfNS + "nil")
+        if i < len(items) - 1 {
+            next = c.r.blank()
+        }
+        c.add(cur, results.NewIRI(rdfNS + "rest"), next)
+        cur = next
+    }
+    return head
+}
+
+// term returns the term of the node
+func (c *converter) term(node *sparql.Node) results.Term {
+    for node.Rule == "varOrTerm" || node.Rule == "graphTerm" {
+        node = node.Children[0]
+    }
+    switch node.Rule {
+    case "var":
+        c.errorf(node, "Variables are not allowed")
+    case "iriref":
+        return c.iriref(node)
+    case "literal":
+        return c.literal(node)
+    case "numericLiteral":
+        datatype := node.Child("unsignedNumericLiteral").Children[0].Rule
+        return results.Term{ Kind : results.LITERAL, Value : node.Value(), Datatype : xsd + datatype }
+    case "booleanLiteral":
+        return results.Term{ Kind : results.LITERAL, Value : strings.ToLower(node.Value()), Datatype : xsd + "boolean" }
+    case "blankNode":
+        if label := node.Child("blankNodeLabel"); label != nil {
+            l, ok := c.r.labels[label.Value()]
+            if !ok {
+                l = c.r.blank().Value
+                c.r.labels[label.Value()] = l
+            }
+            return results.NewBlank(l)
+        }
+        return c.r.blank()
+    case "nil":
+        return results.NewIRI(rdfNS + "nil")
+    default:
+        c.errorf(node, "Unexpected term [%v]", node.Value())
+    }
+    return results.Term{}
+}
+
+// iriref returns the absolute IRI of an IRI reference or of a prefixed name
+func (c *converter) iriref(node *sparql.Node) results.Term {
+    if iri := node.Child("iri"); iri != nil {
+        return results.NewIRI(c.r.resolve(sparql.Unescape(sparql.Unbracket(iri.Value()))))
+    }
+    name := node.Child("prefixedName")
+    prefix := ""
+    if n := name.Child("pnPrefix"); n != nil {
+        prefix = n.Value()
+    }
+    ns, ok := c.r.prefixes[prefix]
+    if !ok {
+        c.errorf(name, "Undeclared prefix [%v:]", prefix)
+    }
+    local := ""
+    if n := name.Child("pnLocal"); n != nil {
+        local = n.Value()
+        for _,esc := range n.FindAll("pnLocalEsc") {
+            local = strings.Replace(local, esc.Value(), esc.Value()[1:], 1)
+        }
+    }
+    return results.NewIRI(ns + local)
+}
+
+// literal returns the literal with its language tag or datatype
+func (c *converter) literal(node *sparql.Node) results.Term {
+    str := node.Child("string")
+    value := str.Children[0].Value()
+    quotes := 1
+    if rule := str.Children[0].Rule; rule == "stringLiteralLongA" || rule == "stringLiteralLongB" {
+        quotes = 3
+    }
+    t := results.NewLiteral(sparql.Unescape(value[quotes:len(value) - quotes]))
+    if dt := node.Child("iriref"); dt != nil {
+        t.Datatype = c.iriref(dt).Value
+    } else if text := node.Value(); len(text) > len(str.Value()) {
+        t.Lang = text[len(str.Value()) + 1:]
+    }
+    return t
+}
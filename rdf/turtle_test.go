@@ -0,0 +1,118 @@
+package rdf
+
+import (
+    "reflect"
+    "strings"
+    "testing"
+)
+
+func TestTurtle(t *testing.T) {
+    doc := `@prefix ex: <http://example.org/> .
+@base <http://example.org/people/> .
+PREFIX foaf: <http://xmlns.com/foaf/0.1/>
+
+# Alice
+<alice> a foaf:Person ;
+    foaf:name "Alice"@en, 'Al' ;
+    ex:age 42 ; ex:height 1.65 ; ex:weight 5.5e1 ; ex:adult true ;
+    foaf:knows [ foaf:name """Bob "the"
+builder""" ] , _:carol .
+_:carol ex:pets ( "cat" ex:dog ) ; ex:note "a\tbé"^^ex:text .
+ex:v1.2 ex:p ex:o.
+`
+    expected := []string{
+        `<http://example.org/people/alice> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://xmlns.com/foaf/0.1/Person> .`,
+        `<http://example.org/people/alice> <http://xmlns.com/foaf/0.1/name> "Alice"@en .`,
+        `<http://example.org/people/alice> <http://xmlns.com/foaf/0.1/name> "Al" .`,
+        `<http://example.org/people/alice> <http://example.org/age> "42"^^<http://www.w3.org/2001/XMLSchema#integer> .`,
+        `<http://example.org/people/alice> <http://example.org/height> "1.65"^^<http://www.w3.org/2001/XMLSchema#decimal> .`,
+        `<http://example.org/people/alice> <http://example.org/weight> "5.5e1"^^<http://www.w3.org/2001/XMLSchema#double> .`,
+        `<http://example.org/people/alice> <http://example.org/adult> "true"^^<http://www.w3.org/2001/XMLSchema#boolean> .`,
+        `_:b1 <http://xmlns.com/foaf/0.1/name> "Bob \"the\"\nbuilder" .`,
+        `<http://example.org/people/alice> <http://xmlns.com/foaf/0.1/knows> _:b1 .`,
+        `<http://example.org/people/alice> <http://xmlns.com/foaf/0.1/knows> _:b2 .`,
+        `_:b3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> "cat" .`,
+        `_:b3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> _:b4 .`,
+        `_:b4 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> <http://example.org/dog> .`,
+        `_:b4 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .`,
+        `_:b2 <http://example.org/pets> _:b3 .`,
+        `_:b2 <http://example.org/note> "a\tbé"^^<http://example.org/text> .`,
+        `<http://example.org/v1.2> <http://example.org/p> <http://example.org/o> .`,
+    }
+    if actual := readAll(t, NewTurtleReader(strings.NewReader(doc), "")); !reflect.DeepEqual(actual, expected) {
+        t.Errorf("Expected\n%v\nbut got\n%v", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+    }
+}
+
+func TestRepeatedSemicolons(t *testing.T) {
+    doc := "<a> <p> 1 ;; <q> 2 ; ; ."
+    expected := []string{
+        `<http://example.org/a> <http://example.org/p> "1"^^<http://www.w3.org/2001/XMLSchema#integer> .`,
+        `<http://example.org/a> <http://example.org/q> "2"^^<http://www.w3.org/2001/XMLSchema#integer> .`,
+    }
+    if actual := readAll(t, NewTurtleReader(strings.NewReader(doc), "http://example.org/")); !reflect.DeepEqual(actual, expected) {
+        t.Errorf("Expected\n%v\nbut got\n%v", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+    }
+}
+
+func TestTurtleBatches(t *testing.T) {
+    var doc strings.Builder
+    doc.WriteString("@prefix ex: <http://example.org/> .\n")
+    n := 3 * batchSize / 20
+    for i := 0; i < n; i++ {
+        doc.WriteString("ex:s ex:p \"0123\" .\n")
+    }
+    if quads := readAll(t, NewTurtleReader(strings.NewReader(doc.String()), "")); len(quads) != n {
+        t.Errorf("Expected %v statements but got %v", n, len(quads))
+    }
+}
+
+func TestTriG(t *testing.T) {
+    doc := `@prefix ex: <http://example.org/> .
+ex:a ex:p ex:b .
+ex:g { ex:a ex:p ex:c . ex:a ex:p ex:d }
+GRAPH _:h {
+    ex:a ex:p ex:e .
+}
+{ ex:a ex:p ex:f }
+`
+    expected := []string{
+        `<http://example.org/a> <http://example.org/p> <http://example.org/b> .`,
+        `<http://example.org/a> <http://example.org/p> <http://example.org/c> <http://example.org/g> .`,
+        `<http://example.org/a> <http://example.org/p> <http://example.org/d> <http://example.org/g> .`,
+        `<http://example.org/a> <http://example.org/p> <http://example.org/e> _:b1 .`,
+        `<http://example.org/a> <http://example.org/p> <http://example.org/f> .`,
+    }
+    if actual := readAll(t, NewTriGReader(strings.NewReader(doc), "")); !reflect.DeepEqual(actual, expected) {
+        t.Errorf("Expected\n%v\nbut got\n%v", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+    }
+}
+
+func TestTurtleErrors(t *testing.T) {
+    prolog := "@prefix ex: <http://example.org/> .\n"
+    tests := []struct {
+        doc, message string
+        line, column int
+    }{
+        { "ex:a ex:p ?o .", "Variables are not allowed", 2, 11 },
+        { "ex:a ex:p ex:b .\nex:a foo:p ex:b .", "Undeclared prefix [foo:]", 3, 6 },
+        { "ex:a ex:p/ex:q ex:b .", "Property paths are not allowed", 2, 6 },
+        { "ex:a ex:p ex:b ex:c .", "Unexpected text [.]", 2, 21 },
+        { "ex:a ex:p ex:b", "Unexpected end of document", 2, 15 },
+        { "ex:a ex:p \"b .\n", "Unterminated string", 2, 11 },
+        { "@prefix ex <http://example.org/> .", "Expected [:] after the prefix", 2, 11 },
+        { "@foo ex: <http://example.org/> .", "Unknown directive", 2, 2 },
+        { "\"a\" ex:p ex:b .", "The subject must be an IRI or a blank node", 2, 1 },
+    }
+    for _,test := range tests {
+        err, ok := readError(NewTurtleReader(strings.NewReader(prolog + test.doc), "")).(*SyntaxError)
+        if !ok || err.Message != test.message || err.Line != test.line || err.Column != test.column {
+            t.Errorf("Expected %v at %v:%v but got %v for %v", test.message, test.line, test.column, err, test.doc)
+        }
+    }
+    for _,doc := range []string{ "ex:g { ex:a ex:p ex:b .", "ex:a ex:p ex:b . }", "ex:g { ex:h { ex:a ex:p ex:b } }" } {
+        if _, ok := readError(NewTriGReader(strings.NewReader(prolog + doc), "")).(*SyntaxError); !ok {
+            t.Errorf("Expected a syntax error for %v", doc)
+        }
+    }
+}
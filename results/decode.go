@@ -0,0 +1,413 @@
+package results
+
+import (
+    "bufio"
+    "encoding/csv"
+    "encoding/json"
+    "encoding/xml"
+    "fmt"
+    "io"
+    "regexp"
+    "strings"
+)
+
+// Decoder reads results one solution at a time
+type Decoder struct {
+    // The variables of the results of a SELECT query
+    Vars []string
+    // True for the results of an ASK query, whose result is Boolean
+    Ask bool
+    Boolean bool
+    // next returns the next solution, or io.EOF
+    next func() (Solution, error)
+}
+
+// NewDecoder returns a decoder of the results in the format. The header of the
+// results, i.e., the variables or the boolean, is read.
+func NewDecoder(r io.Reader, format Format) (*Decoder, error) {
+    d := &Decoder{}
+    var err error
+    switch format {
+    case JSON:
+        err = d.initJSON(r)
+    case XML:
+        err = d.initXML(r)
+    case CSV:
+        err = d.initCSV(r)
+    case TSV:
+        err = d.initTSV(r)
+    default:
+        err = fmt.Errorf("Unknown results format [%v]", uint(format))
+    }
+    if err != nil {
+        return nil, err
+    }
+    return d, nil
+}
+
+// Next returns the next solution, or io.EOF if there is none left
+func (d *Decoder) Next() (Solution, error) {
+    return d.next()
+}
+
+// eof is the next function of a decoder without solutions left
+func eof() (Solution, error) {
+    return nil, io.EOF
+}
+
+// jsonTerm is a term in the SPARQL JSON format
+type jsonTerm struct {
+    Type string `json:"type"`
+    Value string `json:"value"`
+    Datatype string `json:"datatype,omitempty"`
+    Lang string `json:"xml:lang,omitempty"`
+}
+
+var jsonTypes = []string{ "uri", "literal", "bnode" }
+
+func (t jsonTerm) term() (Term, error) {
+    switch t.Type {
+    case "uri":
+        return NewIRI(t.Value), nil
+    case "bnode":
+        return NewBlank(t.Value), nil
+    case "literal", "typed-literal":
+        return Term{ Kind : LITERAL, Value : t.Value, Datatype : t.Datatype, Lang : t.Lang }, nil
+    }
+    return Term{}, fmt.Errorf("Unknown type of term [%v]", t.Type)
+}
+
+// expectDelim reads the JSON delimiter
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+    tok, err := dec.Token()
+    if err != nil {
+        return err
+    }
+    if tok != delim {
+        return fmt.Errorf("Expected [%v] but got [%v]", delim, tok)
+    }
+    return nil
+}
+
+// jsonSolution decodes the next solution of the bindings array
+func jsonSolution(dec *json.Decoder) (Solution, error) {
+    var binding map[string]jsonTerm
+    if err := dec.Decode(&binding); err != nil {
+        return nil, err
+    }
+    s := make(Solution, len(binding))
+    for v,t := range binding {
+        term, err := t.term()
+        if err != nil {
+            return nil, err
+        }
+        s[v] = term
+    }
+    return s, nil
+}
+
+// initJSON reads the JSON results until the bindings. If the bindings come
+// before the head, they are read in memory.
+func (d *Decoder) initJSON(r io.Reader) error {
+    dec := json.NewDecoder(r)
+    if err := expectDelim(dec, '{'); err != nil {
+        return err
+    }
+    var buffered []Solution
+    head := false
+    for dec.More() {
+        key, err := dec.Token()
+        if err != nil {
+            return err
+        }
+        switch key {
+        case "head":
+            var h struct { Vars []string `json:"vars"` }
+            if err := dec.Decode(&h); err != nil {
+                return err
+            }
+            d.Vars = h.Vars
+            head = true
+        case "boolean":
+            if err := dec.Decode(&d.Boolean); err != nil {
+                return err
+            }
+            d.Ask = true
+        case "results":
+            if err := expectDelim(dec, '{'); err != nil {
+                return err
+            }
+            for dec.More() {
+                key, err := dec.Token()
+                if err != nil {
+                    return err
+                }
+                if key != "bindings" {
+                    var skip json.RawMessage
+                    if err := dec.Decode(&skip); err != nil {
+                        return err
+                    }
+                    continue
+                }
+                if err := expectDelim(dec, '['); err != nil {
+                    return err
+                }
+                if head {
+                    // the solutions are streamed
+                    d.next = func() (Solution, error) {
+                        if dec.More() {
+                            return jsonSolution(dec)
+                        }
+                        d.next = eof
+                        if err := expectDelim(dec, ']'); err != nil {
+                            return nil, err
+                        }
+                        return nil, io.EOF
+                    }
+                    return nil
+                }
+                for dec.More() {
+                    s, err := jsonSolution(dec)
+                    if err != nil {
+                        return err
+                    }
+                    buffered = append(buffered, s)
+                }
+                if err := expectDelim(dec, ']'); err != nil {
+                    return err
+                }
+            }
+            if err := expectDelim(dec, '}'); err != nil {
+                return err
+            }
+        default:
+            var skip json.RawMessage
+            if err := dec.Decode(&skip); err != nil {
+                return err
+            }
+        }
+    }
+    if err := expectDelim(dec, '}'); err != nil {
+        return err
+    }
+    d.next = func() (Solution, error) {
+        if len(buffered) == 0 {
+            return nil, io.EOF
+        }
+        s := buffered[0]
+        buffered = buffered[1:]
+        return s, nil
+    }
+    return nil
+}
+
+// xmlResult is a solution in the SPARQL XML format
+type xmlResult struct {
+    Bindings []struct {
+        Name string `xml:"name,attr"`
+        URI *string `xml:"uri"`
+        BNode *string `xml:"bnode"`
+        Literal *struct {
+            Value string `xml:",chardata"`
+            Datatype string `xml:"datatype,attr"`
+            Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+        } `xml:"literal"`
+    } `xml:"binding"`
+}
+
+// initXML reads the XML results until the boolean or the results element
+func (d *Decoder) initXML(r io.Reader) error {
+    dec := xml.NewDecoder(r)
+    for {
+        tok, err := dec.Token()
+        if err == io.EOF {
+            return fmt.Errorf("Missing the results or the boolean")
+        }
+        if err != nil {
+            return err
+        }
+        start, ok := tok.(xml.StartElement)
+        if !ok {
+            continue
+        }
+        switch start.Name.Local {
+        case "variable":
+            for _,attr := range start.Attr {
+                if attr.Name.Local == "name" {
+                    d.Vars = append(d.Vars, attr.Value)
+                }
+            }
+        case "boolean":
+            var b string
+            if err := dec.DecodeElement(&b, &start); err != nil {
+                return err
+            }
+            d.Ask = true
+            d.Boolean = strings.TrimSpace(b) == "true"
+            d.next = eof
+            return nil
+        case "results":
+            d.next = func() (Solution, error) {
+                for {
+                    tok, err := dec.Token()
+                    if err == io.EOF {
+                        return nil, io.ErrUnexpectedEOF
+                    }
+                    if err != nil {
+                        return nil, err
+                    }
+                    switch t := tok.(type) {
+                    case xml.StartElement:
+                        if t.Name.Local == "result" {
+                            return xmlSolution(dec, &t)
+                        }
+                    case xml.EndElement:
+                        if t.Name.Local == "results" {
+                            d.next = eof
+                            return nil, io.EOF
+                        }
+                    }
+                }
+            }
+            return nil
+        }
+    }
+}
+
+// xmlSolution decodes the result element
+func xmlSolution(dec *xml.Decoder, start *xml.StartElement) (Solution, error) {
+    var res xmlResult
+    if err := dec.DecodeElement(&res, start); err != nil {
+        return nil, err
+    }
+    s := make(Solution, len(res.Bindings))
+    for _,b := range res.Bindings {
+        switch {
+        case b.URI != nil:
+            s[b.Name] = NewIRI(strings.TrimSpace(*b.URI))
+        case b.BNode != nil:
+            s[b.Name] = NewBlank(strings.TrimSpace(*b.BNode))
+        case b.Literal != nil:
+            s[b.Name] = Term{ Kind : LITERAL, Value : b.Literal.Value, Datatype : b.Literal.Datatype, Lang : b.Literal.Lang }
+        default:
+            return nil, fmt.Errorf("Missing the term of the binding of [%v]", b.Name)
+        }
+    }
+    return s, nil
+}
+
+// A value of the CSV format read as an IRI, i.e., starting with a scheme
+var csvIRIRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.\-]*:\S*$`)
+
+// initCSV reads the header of the CSV results
+func (d *Decoder) initCSV(r io.Reader) error {
+    br := bufio.NewReader(r)
+    // The records are read line by line, since encoding/csv skips the empty
+    // lines, which are the solutions without a binding of a single variable.
+    // A record spans the lines until its quotes are balanced.
+    readRecord := func() ([]string, error) {
+        text := ""
+        for {
+            line, err := br.ReadString('\n')
+            text += line
+            if err == io.EOF && text != "" {
+                break
+            }
+            if err != nil {
+                return nil, err
+            }
+            if strings.Count(text, `"`) % 2 == 0 {
+                break
+            }
+        }
+        if strings.TrimRight(text, "\r\n") == "" {
+            return []string{ "" }, nil
+        }
+        return csv.NewReader(strings.NewReader(text)).Read()
+    }
+    header, err := readRecord()
+    if err == io.EOF {
+        d.next = eof
+        return nil
+    }
+    if err != nil {
+        return err
+    }
+    d.Vars = header
+    d.next = func() (Solution, error) {
+        record, err := readRecord()
+        if err != nil {
+            return nil, err
+        }
+        if len(record) != len(d.Vars) {
+            return nil, fmt.Errorf("Expected %v values but got %v", len(d.Vars), len(record))
+        }
+        s := make(Solution, len(record))
+        for i,value := range record {
+            switch {
+            case value == "":
+            case strings.HasPrefix(value, "_:"):
+                s[d.Vars[i]] = NewBlank(value[2:])
+            case csvIRIRe.MatchString(value):
+                s[d.Vars[i]] = NewIRI(value)
+            default:
+                s[d.Vars[i]] = NewLiteral(value)
+            }
+        }
+        return s, nil
+    }
+    return nil
+}
+
+// initTSV reads the header of the TSV results
+func (d *Decoder) initTSV(r io.Reader) error {
+    br := bufio.NewReader(r)
+    readLine := func() ([]string, error) {
+        line, err := br.ReadString('\n')
+        if err == io.EOF && line != "" {
+            err = nil
+        }
+        if err != nil {
+            return nil, err
+        }
+        return strings.Split(strings.TrimRight(line, "\r\n"), "\t"), nil
+    }
+    header, err := readLine()
+    if err == io.EOF {
+        d.next = eof
+        return nil
+    }
+    if err != nil {
+        return err
+    }
+    for _,v := range header {
+        if v != "" {
+            d.Vars = append(d.Vars, v[1:])
+        }
+    }
+    d.next = func() (Solution, error) {
+        values, err := readLine()
+        if err != nil {
+            return nil, err
+        }
+        if len(d.Vars) == 0 && len(values) == 1 && values[0] == "" {
+            values = nil
+        }
+        if len(values) != len(d.Vars) {
+            return nil, fmt.Errorf("Expected %v values but got %v", len(d.Vars), len(values))
+        }
+        s := make(Solution, len(values))
+        for i,value := range values {
+            if value == "" {
+                continue
+            }
+            term, err := ParseTerm(value)
+            if err != nil {
+                return nil, err
+            }
+            s[d.Vars[i]] = term
+        }
+        return s, nil
+    }
+    return nil
+}
@@ -0,0 +1,180 @@
+package results
+
+import (
+    "bytes"
+    "encoding/csv"
+    "encoding/json"
+    "encoding/xml"
+    "fmt"
+    "io"
+    "strings"
+)
+
+// Encoder writes results one solution at a time. The header is written first,
+// then the solutions, and the encoder is closed. The results of an ASK query
+// are written at once with WriteBoolean.
+type Encoder struct {
+    w io.Writer
+    format Format
+    vars []string
+    // The number of solutions written
+    count int
+    csv *csv.Writer
+}
+
+// NewEncoder returns an encoder of results in the format
+func NewEncoder(w io.Writer, format Format) *Encoder {
+    e := &Encoder{ w : w, format : format }
+    if format == CSV {
+        e.csv = csv.NewWriter(w)
+        e.csv.UseCRLF = true
+    }
+    return e
+}
+
+// The opening of the SPARQL XML format
+const xmlOpening = `<?xml version="1.0"?>
+<sparql xmlns="http://www.w3.org/2005/sparql-results#">
+`
+
+// xmlEscape returns the text escaped for XML
+func xmlEscape(s string) string {
+    var b bytes.Buffer
+    xml.EscapeText(&b, []byte(s))
+    return b.String()
+}
+
+// WriteHeader writes the variables of the results of a SELECT query
+func (e *Encoder) WriteHeader(vars []string) error {
+    e.vars = vars
+    switch e.format {
+    case JSON:
+        head, err := json.Marshal(vars)
+        if err != nil {
+            return err
+        }
+        if vars == nil {
+            head = []byte("[]")
+        }
+        return e.write(`{"head":{"vars":` + string(head) + `},"results":{"bindings":[`)
+    case XML:
+        out := xmlOpening + "  <head>\n"
+        for _,v := range vars {
+            out += `    <variable name="` + xmlEscape(v) + `"/>` + "\n"
+        }
+        return e.write(out + "  </head>\n  <results>\n")
+    case CSV:
+        if err := e.csv.Write(vars); err != nil {
+            return err
+        }
+        e.csv.Flush()
+        return e.csv.Error()
+    case TSV:
+        header := make([]string, len(vars))
+        for i,v := range vars {
+            header[i] = "?" + v
+        }
+        return e.write(strings.Join(header, "\t") + "\n")
+    }
+    return fmt.Errorf("Unknown results format [%v]", uint(e.format))
+}
+
+// Write writes the solution. Only the variables of the header are written.
+func (e *Encoder) Write(s Solution) error {
+    defer func() { e.count++ }()
+    switch e.format {
+    case JSON:
+        binding := make(map[string]jsonTerm)
+        for _,v := range e.vars {
+            if t, ok := s[v]; ok {
+                binding[v] = jsonTerm{ Type : jsonTypes[t.Kind], Value : t.Value, Datatype : t.Datatype, Lang : t.Lang }
+            }
+        }
+        out, err := json.Marshal(binding)
+        if err != nil {
+            return err
+        }
+        sep := "\n"
+        if e.count != 0 {
+            sep = ",\n"
+        }
+        return e.write(sep + string(out))
+    case XML:
+        out := "    <result>\n"
+        for _,v := range e.vars {
+            t, ok := s[v]
+            if !ok {
+                continue
+            }
+            out += `      <binding name="` + xmlEscape(v) + `">`
+            switch t.Kind {
+            case IRI:
+                out += "<uri>" + xmlEscape(t.Value) + "</uri>"
+            case BLANK:
+                out += "<bnode>" + xmlEscape(t.Value) + "</bnode>"
+            default:
+                attrs := ""
+                if t.Lang != "" {
+                    attrs = ` xml:lang="` + xmlEscape(t.Lang) + `"`
+                } else if t.Datatype != "" {
+                    attrs = ` datatype="` + xmlEscape(t.Datatype) + `"`
+                }
+                out += "<literal" + attrs + ">" + xmlEscape(t.Value) + "</literal>"
+            }
+            out += "</binding>\n"
+        }
+        return e.write(out + "    </result>\n")
+    case CSV:
+        record := make([]string, len(e.vars))
+        for i,v := range e.vars {
+            if t, ok := s[v]; ok {
+                record[i] = t.Value
+                if t.Kind == BLANK {
+                    record[i] = "_:" + t.Value
+                }
+            }
+        }
+        if err := e.csv.Write(record); err != nil {
+            return err
+        }
+        e.csv.Flush()
+        return e.csv.Error()
+    case TSV:
+        values := make([]string, len(e.vars))
+        for i,v := range e.vars {
+            if t, ok := s[v]; ok {
+                values[i] = t.tsv()
+            }
+        }
+        return e.write(strings.Join(values, "\t") + "\n")
+    }
+    return fmt.Errorf("Unknown results format [%v]", uint(e.format))
+}
+
+// Close writes the end of the results
+func (e *Encoder) Close() error {
+    switch e.format {
+    case JSON:
+        return e.write("\n]}}\n")
+    case XML:
+        return e.write("  </results>\n</sparql>\n")
+    }
+    return nil
+}
+
+// WriteBoolean writes the results of an ASK query, which cannot be written in
+// the CSV and TSV formats
+func (e *Encoder) WriteBoolean(b bool) error {
+    switch e.format {
+    case JSON:
+        return e.write(fmt.Sprintf(`{"head":{},"boolean":%v}` + "\n", b))
+    case XML:
+        return e.write(xmlOpening + fmt.Sprintf("  <head/>\n  <boolean>%v</boolean>\n</sparql>\n", b))
+    }
+    return fmt.Errorf("Boolean results cannot be written in the %v format", e.format)
+}
+
+func (e *Encoder) write(s string) error {
+    _, err := io.WriteString(e.w, s)
+    return err
+}
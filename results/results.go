@@ -0,0 +1,138 @@
+/*
+ Package results reads and writes the results of SPARQL queries, in the SPARQL
+ JSON, XML, CSV and TSV formats.
+
+ The solutions are decoded one at a time, so that large results are not loaded
+ in memory:
+
+    d, err := results.NewDecoder(body, results.JSON)
+    if err != nil {
+        ...
+    }
+    for {
+        solution, err := d.Next()
+        if err == io.EOF {
+            break
+        }
+        ...
+        fmt.Println(solution["name"].Value)
+    }
+
+ The CSV format does not keep the kind of the terms: a value is read as a blank
+ node if it starts with "_:", as an IRI if it starts with a scheme, and as a
+ simple literal otherwise. The results of an ASK query can only be written in
+ the JSON and XML formats.
+*/
+package results
+
+import (
+    "fmt"
+    "io"
+    "mime"
+)
+
+// Format is a format of SPARQL results
+type Format uint
+
+const (
+    // SPARQL 1.1 Query Results JSON Format
+    JSON Format = iota
+    // SPARQL Query Results XML Format
+    XML
+    // SPARQL 1.1 Query Results CSV Format
+    CSV
+    // SPARQL 1.1 Query Results TSV Format
+    TSV
+)
+
+var formatNames = []string{ "json", "xml", "csv", "tsv" }
+
+var mediaTypes = []string{
+    "application/sparql-results+json",
+    "application/sparql-results+xml",
+    "text/csv",
+    "text/tab-separated-values",
+}
+
+func (f Format) String() string {
+    return formatNames[f]
+}
+
+// MediaType returns the media type of the format
+func (f Format) MediaType() string {
+    return mediaTypes[f]
+}
+
+// FormatOf returns the format of the media type, e.g., of the Content-Type of
+// a response. The parameters of the media type are ignored.
+func FormatOf(mediaType string) (Format, error) {
+    if mt, _, err := mime.ParseMediaType(mediaType); err == nil {
+        mediaType = mt
+    }
+    for f,mt := range mediaTypes {
+        if mt == mediaType {
+            return Format(f), nil
+        }
+    }
+    // the media types used by some endpoints
+    switch mediaType {
+    case "application/json":
+        return JSON, nil
+    case "application/xml", "text/xml":
+        return XML, nil
+    }
+    return 0, fmt.Errorf("Unknown results format [%v]", mediaType)
+}
+
+// Accept is a value of the Accept header for the formats that can be read,
+// preferring the ones that keep the kind of the terms
+var Accept = mediaTypes[JSON] + ", " + mediaTypes[XML] + ";q=0.9, " + mediaTypes[TSV] + ";q=0.8, " + mediaTypes[CSV] + ";q=0.5"
+
+// Solution binds variables to terms. An unbound variable is not in the map.
+type Solution map[string]Term
+
+// Results are the results of a SELECT or of an ASK query
+type Results struct {
+    // The variables of the results of a SELECT query
+    Vars []string
+    Solutions []Solution
+    // True for the results of an ASK query, whose result is Boolean
+    Ask bool
+    Boolean bool
+}
+
+// Read decodes all the results in the format
+func Read(r io.Reader, format Format) (*Results, error) {
+    d, err := NewDecoder(r, format)
+    if err != nil {
+        return nil, err
+    }
+    res := &Results{ Vars : d.Vars, Ask : d.Ask, Boolean : d.Boolean }
+    for {
+        s, err := d.Next()
+        if err == io.EOF {
+            return res, nil
+        }
+        if err != nil {
+            return nil, err
+        }
+        res.Solutions = append(res.Solutions, s)
+    }
+}
+
+// Write encodes the results in the format
+func (res *Results) Write(w io.Writer, format Format) error {
+    e := NewEncoder(w, format)
+    if res.Ask {
+        return e.WriteBoolean(res.Boolean)
+    }
+    if err := e.WriteHeader(res.Vars); err != nil {
+        return err
+    }
+    for _,s := range res.Solutions {
+        if err := e.Write(s); err != nil {
+            return err
+        }
+    }
+    return e.Close()
+}
@@ -0,0 +1,179 @@
+package results
+
+import (
+    "bytes"
+    "io"
+    "reflect"
+    "strings"
+    "testing"
+)
+
+var sample = &Results{
+    Vars : []string{ "s", "label", "n" },
+    Solutions : []Solution{
+        {
+            "s" : NewIRI("http://example.org/a"),
+            "label" : Term{ Kind : LITERAL, Value : "chat \"noir\"\n\ttab", Lang : "fr" },
+            "n" : Term{ Kind : LITERAL, Value : "42", Datatype : xsd + "integer" },
+        },
+        {
+            "s" : NewBlank("b0"),
+            "label" : Term{ Kind : LITERAL, Value : "1 < 2 & 3", Datatype : "http://example.org/dt" },
+        },
+        {},
+    },
+}
+
+func read(t *testing.T, input string, format Format) *Results {
+    res, err := Read(strings.NewReader(input), format)
+    if err != nil {
+        t.Fatalf("Failed to read the %v results\n%v\n%v", format, input, err)
+    }
+    return res
+}
+
+func TestRoundTrip(t *testing.T) {
+    for _,format := range []Format{ JSON, XML, TSV } {
+        var out bytes.Buffer
+        if err := sample.Write(&out, format); err != nil {
+            t.Fatal(err)
+        }
+        if res := read(t, out.String(), format); !reflect.DeepEqual(res, sample) {
+            t.Errorf("Expected\n%v\nbut got\n%v\nfrom\n%v", sample, res, out.String())
+        }
+    }
+}
+
+func TestCSV(t *testing.T) {
+    var out bytes.Buffer
+    if err := sample.Write(&out, CSV); err != nil {
+        t.Fatal(err)
+    }
+    expected := "s,label,n\r\nhttp://example.org/a,\"chat \"\"noir\"\"\r\n\ttab\",42\r\n_:b0,1 < 2 & 3,\r\n,,\r\n"
+    if out.String() != expected {
+        t.Errorf("Expected\n%q\nbut got\n%q", expected, out.String())
+    }
+    // the datatypes and the language tags are lost
+    res := read(t, out.String(), CSV)
+    if len(res.Solutions) != 3 {
+        t.Fatalf("Expected 3 solutions but got %v", res.Solutions)
+    }
+    first := res.Solutions[0]
+    if first["s"] != NewIRI("http://example.org/a") || first["label"] != NewLiteral("chat \"noir\"\n\ttab") || first["n"] != NewLiteral("42") {
+        t.Errorf("Unexpected solution %v", first)
+    }
+    if res.Solutions[1]["s"] != NewBlank("b0") {
+        t.Errorf("Unexpected solution %v", res.Solutions[1])
+    }
+}
+
+func TestCSVUnbound(t *testing.T) {
+    // the unbound values of a single variable are empty lines
+    res := read(t, "x\r\nhttp://a\r\n\r\n\"b\r\n\r\nc\"\r\n\r\n", CSV)
+    expected := []Solution{ { "x" : NewIRI("http://a") }, {}, { "x" : NewLiteral("b\n\nc") }, {} }
+    if !reflect.DeepEqual(res.Solutions, expected) {
+        t.Errorf("Expected %v but got %v", expected, res.Solutions)
+    }
+    var out bytes.Buffer
+    single := &Results{ Vars : []string{ "x" }, Solutions : []Solution{ {}, { "x" : NewLiteral("a") }, {} } }
+    if err := single.Write(&out, CSV); err != nil {
+        t.Fatal(err)
+    }
+    if res := read(t, out.String(), CSV); !reflect.DeepEqual(res.Solutions, single.Solutions) {
+        t.Errorf("Expected %v but got %v from %q", single.Solutions, res.Solutions, out.String())
+    }
+    if _, err := Read(strings.NewReader("x,y\r\na\r\n"), CSV); err == nil {
+        t.Error("Expected an error for a missing value")
+    }
+}
+
+func TestBoolean(t *testing.T) {
+    for _,format := range []Format{ JSON, XML } {
+        var out bytes.Buffer
+        ask := &Results{ Ask : true, Boolean : true }
+        if err := ask.Write(&out, format); err != nil {
+            t.Fatal(err)
+        }
+        if res := read(t, out.String(), format); !res.Ask || !res.Boolean {
+            t.Errorf("Expected true but got %v from\n%v", res, out.String())
+        }
+    }
+    if err := (&Results{ Ask : true }).Write(&bytes.Buffer{}, TSV); err == nil {
+        t.Error("Expected an error for a boolean in TSV")
+    }
+}
+
+func TestJSONBindingsBeforeHead(t *testing.T) {
+    input := `{
+        "results" : { "bindings" : [ { "x" : { "type" : "typed-literal", "value" : "1", "datatype" : "http://www.w3.org/2001/XMLSchema#integer" } } ], "ordered" : false },
+        "head" : { "vars" : [ "x" ], "link" : [] }
+    }`
+    res := read(t, input, JSON)
+    expected := &Results{
+        Vars : []string{ "x" },
+        Solutions : []Solution{ { "x" : Term{ Kind : LITERAL, Value : "1", Datatype : xsd + "integer" } } },
+    }
+    if !reflect.DeepEqual(res, expected) {
+        t.Errorf("Expected %v but got %v", expected, res)
+    }
+}
+
+func TestStreaming(t *testing.T) {
+    // the first solution is decoded before the input is complete
+    r, w := io.Pipe()
+    go w.Write([]byte(`{"head":{"vars":["x"]},"results":{"bindings":[{"x":{"type":"uri","value":"http://a"}},`))
+    d, err := NewDecoder(r, JSON)
+    if err != nil {
+        t.Fatal(err)
+    }
+    s, err := d.Next()
+    if err != nil || s["x"] != NewIRI("http://a") {
+        t.Errorf("Unexpected solution %v %v", s, err)
+    }
+    w.Close()
+    if _, err := d.Next(); err == nil || err == io.EOF {
+        t.Errorf("Expected an error for the truncated results but got %v", err)
+    }
+}
+
+func TestParseTerm(t *testing.T) {
+    tests := map[string]Term{
+        `<http://example.org/é>` : NewIRI("http://example.org/é"),
+        `_:b1` : NewBlank("b1"),
+        `"a\"b\\c\n"` : NewLiteral("a\"b\\c\n"),
+        `"chat"@fr-BE` : Term{ Kind : LITERAL, Value : "chat", Lang : "fr-BE" },
+        `"1"^^<http://www.w3.org/2001/XMLSchema#integer>` : Term{ Kind : LITERAL, Value : "1", Datatype : xsd + "integer" },
+        `-1.5` : Term{ Kind : LITERAL, Value : "-1.5", Datatype : xsd + "decimal" },
+        `1e10` : Term{ Kind : LITERAL, Value : "1e10", Datatype : xsd + "double" },
+        `true` : Term{ Kind : LITERAL, Value : "true", Datatype : xsd + "boolean" },
+    }
+    for input, expected := range tests {
+        term, err := ParseTerm(input)
+        if err != nil {
+            t.Errorf("Failed to parse %v: %v", input, err)
+        } else if term != expected {
+            t.Errorf("Expected %#v but got %#v for %v", expected, term, input)
+        }
+    }
+    if _, err := ParseTerm(`"unterminated`); err == nil {
+        t.Error("Expected an error for an invalid term")
+    }
+}
+
+func TestFormatOf(t *testing.T) {
+    tests := map[string]Format{
+        "application/sparql-results+json; charset=utf-8" : JSON,
+        "application/sparql-results+xml" : XML,
+        "text/csv" : CSV,
+        "text/tab-separated-values; charset=utf-8" : TSV,
+        "application/json" : JSON,
+    }
+    for mediaType, expected := range tests {
+        if format, err := FormatOf(mediaType); err != nil || format != expected {
+            t.Errorf("Expected %v but got %v %v for %v", expected, format, err, mediaType)
+        }
+    }
+    if _, err := FormatOf("text/html"); err == nil {
+        t.Error("Expected an error for text/html")
+    }
+}
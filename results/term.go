@@ -0,0 +1,136 @@
+package results
+
+import (
+    "fmt"
+    "regexp"
+    "strings"
+)
+
+// Kind is the kind of an RDF term
+type Kind uint
+
+const (
+    // An IRI
+    IRI Kind = iota
+    // A literal, with an optional datatype or language tag
+    LITERAL
+    // A blank node
+    BLANK
+)
+
+var kindNames = []string{ "iri", "literal", "blank" }
+
+func (k Kind) String() string {
+    return kindNames[k]
+}
+
+const xsd = "http://www.w3.org/2001/XMLSchema#"
+
+// Term is an RDF term bound to a variable
+type Term struct {
+    Kind Kind
+    // The IRI, the lexical form of the literal, or the label of the blank node
+    Value string
+    // The IRI of the datatype of a literal, if any
+    Datatype string
+    // The language tag of a literal, if any
+    Lang string
+}
+
+// NewIRI returns the term of the IRI
+func NewIRI(iri string) Term {
+    return Term{ Kind : IRI, Value : iri }
+}
+
+// NewLiteral returns the simple literal
+func NewLiteral(value string) Term {
+    return Term{ Kind : LITERAL, Value : value }
+}
+
+// NewBlank returns the blank node with the label
+func NewBlank(label string) Term {
+    return Term{ Kind : BLANK, Value : label }
+}
+
+// String returns the term in the N-Triples syntax, e.g., <http://example.org/>,
+// "chat"@fr, or _:b0
+func (t Term) String() string {
+    switch t.Kind {
+    case IRI:
+        return "<" + t.Value + ">"
+    case BLANK:
+        return "_:" + t.Value
+    }
+    out := `"` + literalEscaper.Replace(t.Value) + `"`
+    if t.Lang != "" {
+        return out + "@" + t.Lang
+    }
+    if t.Datatype != "" && t.Datatype != xsd + "string" {
+        return out + "^^<" + t.Datatype + ">"
+    }
+    return out
+}
+
+// The numbers and booleans written without quotes in TSV
+var shortForms = map[string]*regexp.Regexp{
+    xsd + "integer" : regexp.MustCompile(`^[+\-]?[0-9]+$`),
+    xsd + "decimal" : regexp.MustCompile(`^[+\-]?[0-9]*\.[0-9]+$`),
+    xsd + "double" : regexp.MustCompile(`^[+\-]?([0-9]+\.?[0-9]*|\.[0-9]+)[eE][+\-]?[0-9]+$`),
+    xsd + "boolean" : regexp.MustCompile(`^(true|false)$`),
+}
+
+// tsv returns the term in the syntax of the TSV format, i.e., the N-Triples
+// syntax with the short forms of numbers and booleans
+func (t Term) tsv() string {
+    if t.Kind == LITERAL {
+        if re, ok := shortForms[t.Datatype]; ok && re.MatchString(t.Value) {
+            return t.Value
+        }
+    }
+    return t.String()
+}
+
+var literalEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+// An escape sequence of a literal or of an IRI
+var escapeRe = regexp.MustCompile(`\\(u[0-9A-Fa-f]{4}|U[0-9A-Fa-f]{8}|.)`)
+
+var echars = map[byte]string{ 't' : "\t", 'b' : "\b", 'n' : "\n", 'r' : "\r", 'f' : "\f", '"' : `"`, '\'' : "'", '\\' : `\` }
+
+// unescape returns the string with its escape sequences replaced
+func unescape(s string) string {
+    return escapeRe.ReplaceAllStringFunc(s, func(esc string) string {
+        if esc[1] == 'u' || esc[1] == 'U' {
+            var r rune
+            fmt.Sscanf(esc[2:], "%x", &r)
+            return string(r)
+        }
+        if c, ok := echars[esc[1]]; ok {
+            return c
+        }
+        return esc
+    })
+}
+
+// The lexical form of a literal, followed by its language tag or datatype
+var literalRe = regexp.MustCompile(`^"((?:[^"\\]|\\.)*)"(?:@([a-zA-Z]+(?:-[a-zA-Z0-9]+)*)|\^\^<([^>]*)>)?$`)
+
+// ParseTerm returns the term written in the N-Triples syntax, or as a number or
+// a boolean as in Turtle
+func ParseTerm(s string) (Term, error) {
+    switch {
+    case strings.HasPrefix(s, "<") && strings.HasSuffix(s, ">"):
+        return NewIRI(unescape(s[1:len(s) - 1])), nil
+    case strings.HasPrefix(s, "_:") && len(s) > 2:
+        return NewBlank(s[2:]), nil
+    }
+    if m := literalRe.FindStringSubmatch(s); m != nil {
+        return Term{ Kind : LITERAL, Value : unescape(m[1]), Lang : m[2], Datatype : m[3] }, nil
+    }
+    for _,datatype := range []string{ "integer", "decimal", "double", "boolean" } {
+        if shortForms[xsd + datatype].MatchString(s) {
+            return Term{ Kind : LITERAL, Value : s, Datatype : xsd + datatype }, nil
+        }
+    }
+    return Term{}, fmt.Errorf("Invalid RDF term [%v]", s)
+}
@@ -0,0 +1,240 @@
+/*
+ Package shape analyses the graph formed by the triple patterns of a query.
+
+ The subjects and the objects of the triple patterns are the vertices of the
+ graph, and each triple pattern is an edge. Only the variables and the blank
+ nodes join triple patterns: a constant, e.g., an IRI or a literal, is a
+ distinct leaf of each triple pattern it is in. The shape of the graph, e.g., a
+ chain or a star, is a measure of the complexity of the query. For example,
+ the query
+
+    SELECT * {
+        ?s a <Person> ; <knows> ?friend .
+        ?friend <name> ?name
+    }
+
+ is a chain, with the star-decomposition signature "1-2": a star of one triple
+ pattern about ?friend, and a star of two triple patterns about ?s.
+*/
+package shape
+
+import (
+    "sort"
+    "strconv"
+    "strings"
+    "github.com/scampi/gosparqled/algebra"
+)
+
+// The kind of shape of the graph of triple patterns
+type Kind uint
+
+const (
+    // No triple pattern
+    EMPTY Kind = iota
+    // All triple patterns share a same vertex
+    STAR
+    // The triple patterns form a path
+    CHAIN
+    // Stars whose centers are adjacent to a central vertex
+    SNOWFLAKE
+    // Any other acyclic and connected graph
+    TREE
+    // The graph has a cycle
+    CYCLE
+    // Some triple patterns share no vertex with the others, directly or not
+    DISCONNECTED
+)
+
+var kindNames = []string{ "empty", "star", "chain", "snowflake", "tree", "cycle", "disconnected" }
+
+func (k Kind) String() string {
+    return kindNames[k]
+}
+
+// Shape is the analysis of the triple patterns of a query
+type Shape struct {
+    Kind Kind
+    // The star-decomposition signature, i.e., the number of triple patterns of
+    // each subject vertex in ascending order and separated by '-', e.g., "1-1-3"
+    Signature string
+    // The number of triple patterns, including the property paths
+    Triples int
+    // The number of triple patterns of each variable that is in more than one
+    JoinDegrees map[string]int
+}
+
+// Analyze parses the query and returns the shape of its triple patterns
+func Analyze(query string) (*Shape, error) {
+    a, err := algebra.Parse(query)
+    if err != nil {
+        return nil, err
+    }
+    return FromAlgebra(a.Op), nil
+}
+
+// An edge of the graph, i.e., a triple pattern or a property path
+type edge struct {
+    s, p, o string
+}
+
+// FromAlgebra returns the shape of the triple patterns of the operator. The
+// patterns of all the operators are considered together, e.g., those of both
+// sides of a UNION or of an OPTIONAL.
+func FromAlgebra(op algebra.Op) *Shape {
+    var edges []edge
+    algebra.Walk(op, func(o algebra.Op) bool {
+        switch t := o.(type) {
+        case *algebra.BGP:
+            for _,tp := range t.Triples {
+                edges = append(edges, edge{ tp.S, tp.P, tp.O })
+            }
+        case *algebra.PathOp:
+            edges = append(edges, edge{ s : t.S, o : t.O })
+        }
+        return true
+    })
+    return &Shape{
+        Kind : kind(edges),
+        Signature : signature(edges),
+        Triples : len(edges),
+        JoinDegrees : joinDegrees(edges),
+    }
+}
+
+// signature returns the star-decomposition signature of the edges. As for the
+// kind of shape, a constant subject is a distinct vertex per edge.
+func signature(edges []edge) string {
+    stars := make(map[string]int)
+    for i,e := range edges {
+        stars[vertex(e.s, i)]++
+    }
+    var sizes []int
+    for _,size := range stars {
+        sizes = append(sizes, size)
+    }
+    sort.Ints(sizes)
+    parts := make([]string, len(sizes))
+    for i,size := range sizes {
+        parts[i] = strconv.Itoa(size)
+    }
+    return strings.Join(parts, "-")
+}
+
+// joinDegrees returns the number of edges of each variable that is in more
+// than one
+func joinDegrees(edges []edge) map[string]int {
+    degrees := make(map[string]int)
+    for _,e := range edges {
+        seen := make(map[string]bool)
+        for _,term := range []string{ e.s, e.p, e.o } {
+            if strings.HasPrefix(term, "?") && !seen[term] {
+                seen[term] = true
+                degrees[term]++
+            }
+        }
+    }
+    for v,degree := range degrees {
+        if degree < 2 {
+            delete(degrees, v)
+        }
+    }
+    return degrees
+}
+
+// vertex returns the vertex of the term of the i-th edge. The blank nodes are
+// variables prefixed with "??", and a constant is a distinct vertex per edge.
+func vertex(term string, i int) string {
+    if strings.HasPrefix(term, "?") {
+        return term
+    }
+    return term + " " + strconv.Itoa(i)
+}
+
+// kind returns the kind of shape of the graph of the edges
+func kind(edges []edge) Kind {
+    if len(edges) == 0 {
+        return EMPTY
+    }
+    joins := make([]edge, len(edges))
+    for i,e := range edges {
+        joins[i] = edge{ s : vertex(e.s, i), p : e.p, o : vertex(e.o, i) }
+    }
+    edges = joins
+    degrees := make(map[string]int)
+    for _,e := range edges {
+        degrees[e.s]++
+        degrees[e.o]++
+    }
+    if !connected(edges) {
+        return DISCONNECTED
+    }
+    // a connected graph without cycle has one edge less than vertices
+    if len(edges) >= len(degrees) {
+        return CYCLE
+    }
+    if center(edges) {
+        return STAR
+    }
+    chain := true
+    for _,degree := range degrees {
+        if degree > 2 {
+            chain = false
+        }
+    }
+    if chain {
+        return CHAIN
+    }
+    // without the leaves, a snowflake is a star
+    var inner []edge
+    for _,e := range edges {
+        if degrees[e.s] > 1 && degrees[e.o] > 1 {
+            inner = append(inner, e)
+        }
+    }
+    if center(inner) {
+        return SNOWFLAKE
+    }
+    return TREE
+}
+
+// center returns true if a vertex is in all the edges
+func center(edges []edge) bool {
+    if len(edges) == 0 {
+        return true
+    }
+    for _,v := range []string{ edges[0].s, edges[0].o } {
+        all := true
+        for _,e := range edges {
+            if e.s != v && e.o != v {
+                all = false
+                break
+            }
+        }
+        if all {
+            return true
+        }
+    }
+    return false
+}
+
+// connected returns true if all the edges are in the connected component of
+// the first one
+func connected(edges []edge) bool {
+    scope := map[string]bool{ edges[0].s : true, edges[0].o : true }
+    size := 0
+    for size != len(scope) {
+        size = len(scope)
+        for _,e := range edges {
+            if scope[e.s] || scope[e.o] {
+                scope[e.s] = true
+                scope[e.o] = true
+            }
+        }
+    }
+    for _,e := range edges {
+        if !scope[e.s] {
+            return false
+        }
+    }
+    return true
+}
@@ -0,0 +1,77 @@
+package shape
+
+import (
+    "testing"
+)
+
+func analyze(t *testing.T, query string) *Shape {
+    s, err := Analyze(query)
+    if err != nil {
+        t.Fatalf("Failed to analyze query\n%v\n%v", query, err)
+    }
+    return s
+}
+
+func TestKinds(t *testing.T) {
+    tests := map[string]Kind{
+        `SELECT * {}` : EMPTY,
+        `SELECT * { ?s <p> ?o }` : STAR,
+        `SELECT * { ?s a <Person> ; <name> ?n ; <age> ?a }` : STAR,
+        `SELECT * { ?a <p> ?s . ?b <q> ?s . ?s <r> ?c }` : STAR,
+        `SELECT * { ?a <p> ?b . ?b <q> ?c . ?c <r> ?d }` : CHAIN,
+        `SELECT * { ?a <p>/<q> ?b . ?b <r> ?c . ?c <s> ?d }` : CHAIN,
+        `SELECT * { ?s <p> ?a , ?b . ?a <q> ?a1 , ?a2 . ?b <r> ?b1 , ?b2 }` : SNOWFLAKE,
+        `SELECT * { ?a <p> ?b . ?b <q> ?c . ?c <r> ?d . ?d <s> ?e . ?e <t> ?f , ?g }` : TREE,
+        `SELECT * { ?a <p> ?b . ?b <q> ?c . ?c <r> ?a }` : CYCLE,
+        `SELECT * { ?s <p> ?o . ?s <q> ?o }` : CYCLE,
+        `SELECT * { ?s <p> ?o . ?x <q> ?y }` : DISCONNECTED,
+        // the constants are not join vertices
+        `SELECT * { ?s a <Person> ; <knows> ?f . ?f a <Person> }` : CHAIN,
+        `SELECT * { ?a <p> <c> . ?b <q> <c> }` : DISCONNECTED,
+        `SELECT * { <a> <p> ?o . <a> <q> ?o }` : STAR,
+        `SELECT * { <a> <b> <c> , <d> ; <e> 1 }` : DISCONNECTED,
+        `SELECT * { [] <p> ?o ; <q> ?z . ?z <r> ?o }` : CYCLE,
+        // the patterns of all operators are considered
+        `SELECT * { ?s <p> ?o OPTIONAL { ?o <q> ?z . ?z <r> ?y } }` : CHAIN,
+        `SELECT * { ?s <p> ?o FILTER NOT EXISTS { ?x <q> ?y } }` : DISCONNECTED,
+    }
+    for query, expected := range tests {
+        if s := analyze(t, query); s.Kind != expected {
+            t.Errorf("Expected %v but got %v for\n%v", expected, s.Kind, query)
+        }
+    }
+}
+
+func TestSignature(t *testing.T) {
+    tests := map[string]string{
+        `SELECT * {}` : "",
+        `SELECT * { ?s <p> ?o }` : "1",
+        `SELECT * { ?s a <Person> ; <knows> ?f . ?f <name> ?n }` : "1-2",
+        `SELECT * { ?a <p> ?b . ?b <q> ?c . ?d <r> ?a , ?e . ?e <s> ?f }` : "1-1-1-2",
+        `SELECT * { [] <p> ?o ; <q> ?z }` : "2",
+        // a constant subject is a distinct vertex of each triple pattern
+        `SELECT * { <a> <b> <c> , <d> ; <e> 1 }` : "1-1-1",
+        `SELECT * { <a> <p> ?o . <a> <q> ?o }` : "1-1",
+    }
+    for query, expected := range tests {
+        if s := analyze(t, query); s.Signature != expected {
+            t.Errorf("Expected signature %v but got %v for\n%v", expected, s.Signature, query)
+        }
+    }
+}
+
+func TestCounts(t *testing.T) {
+    s := analyze(t, `SELECT * { ?s a ?c ; <knows>+ ?f . ?f a ?c ; ?p ?o . ?o ?p ?s }`)
+    if s.Triples != 5 {
+        t.Errorf("Expected 5 triple patterns but got %v", s.Triples)
+    }
+    expected := map[string]int{ "?s" : 3, "?c" : 2, "?f" : 3, "?p" : 2, "?o" : 2 }
+    if len(s.JoinDegrees) != len(expected) {
+        t.Errorf("Expected the join degrees %v but got %v", expected, s.JoinDegrees)
+    }
+    for v,degree := range expected {
+        if s.JoinDegrees[v] != degree {
+            t.Errorf("Expected the degree %v of %v but got %v", degree, v, s.JoinDegrees[v])
+        }
+    }
+}
@@ -0,0 +1,34 @@
+package sparql
+
+// Connected returns which sets of terms are connected to the seed, i.e., share
+// a term with it or with a connected set, directly or not. It is the scope of
+// the Point Of Focus of the autocompletion, whose seed is the Point Of Focus
+// and whose sets are the terms of the triple patterns.
+func Connected(seed []string, sets [][]string) []bool {
+    scope := make(map[string]bool)
+    for _,term := range seed {
+        scope[term] = true
+    }
+    connected := make([]bool, len(sets))
+    for changed := true; changed; {
+        changed = false
+        for i,set := range sets {
+            if connected[i] {
+                continue
+            }
+            for _,term := range set {
+                if scope[term] {
+                    connected[i] = true
+                    break
+                }
+            }
+            if connected[i] {
+                changed = true
+                for _,term := range set {
+                    scope[term] = true
+                }
+            }
+        }
+    }
+    return connected
+}
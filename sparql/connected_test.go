@@ -0,0 +1,29 @@
+package sparql
+
+import (
+    "reflect"
+    "testing"
+)
+
+func TestConnected(t *testing.T) {
+    sets := [][]string{
+        { "?s", "<p>", "?o" },
+        { "?x", "<q>", "?y" },
+        { "?o", "<r>", "?x" },
+        { "?a", "<p>", "?b" },
+    }
+    // the second set is connected through the third one, and the last one
+    // shares a constant only
+    expected := []bool{ true, true, true, true }
+    if actual := Connected([]string{ "?s" }, sets); !reflect.DeepEqual(actual, expected) {
+        t.Errorf("Expected %v but got %v", expected, actual)
+    }
+    sets[3] = []string{ "?a", "<t>", "?b" }
+    expected = []bool{ true, true, true, false }
+    if actual := Connected([]string{ "?s" }, sets); !reflect.DeepEqual(actual, expected) {
+        t.Errorf("Expected %v but got %v", expected, actual)
+    }
+    if actual := Connected([]string{ "?none" }, sets); !reflect.DeepEqual(actual, make([]bool, 4)) {
+        t.Errorf("Expected no connected set but got %v", actual)
+    }
+}
@@ -0,0 +1,157 @@
+package sparql
+
+import (
+    "html"
+    "regexp"
+)
+
+// The class of a token of a query, for syntax highlighting
+type Class uint
+
+const (
+    // Whitespaces, and any text of no other class
+    TEXT Class = iota
+    // A keyword, e.g., SELECT or STRLEN
+    KEYWORD
+    // A variable, or a blank node label
+    VARIABLE
+    // An IRI between angle brackets
+    IRI
+    // A prefixed name, e.g., rdfs:label
+    PREFIXED_NAME
+    // A string, a number or a boolean
+    LITERAL
+    // A comment, without the end of line
+    COMMENT
+    // A brace, a dot, an operator, and other symbols
+    PUNCTUATION
+)
+
+var classNames = []string{ "text", "keyword", "variable", "iri", "prefixed-name", "literal", "comment", "punctuation" }
+
+func (c Class) String() string {
+    return classNames[c]
+}
+
+// The classes of the grammar rules. The rules of the keywords and of the
+// punctuation are recognized by their upper case name.
+var ruleClasses = map[string]Class{
+    "var" : VARIABLE,
+    "VARNAME" : VARIABLE,
+    "blankNodeLabel" : VARIABLE,
+    "iri" : IRI,
+    "prefixedName" : PREFIXED_NAME,
+    "literal" : LITERAL,
+    "numericLiteral" : LITERAL,
+    "signedNumericLiteral" : LITERAL,
+    "booleanLiteral" : LITERAL,
+    "INTEGER" : LITERAL,
+    "anon" : PUNCTUATION,
+    "nil" : PUNCTUATION,
+    "comment" : COMMENT,
+}
+
+// The name of the rule of a keyword or of a symbol
+var tokenRuleRe = regexp.MustCompile(`^[A-Z]+$`)
+
+// The text of a keyword
+var keywordRe = regexp.MustCompile(`^[a-zA-Z_ ]+$`)
+
+// Token is a part of the query with a same class
+type Token struct {
+    Class Class
+    // The position of the text in the query, as a number of runes
+    Begin, End int
+    Text string
+}
+
+// Highlight returns the tokens of the query, which together cover all of its
+// text. It must be called after a successful Parse.
+func (p *Sparql) Highlight() []Token {
+    query := []rune(p.Buffer)
+    classes := make([]Class, len(query))
+    tree := p.Tree()
+    // the class of a rule overrides the class of the rules it is part of
+    tree.Walk(func(n *Node) bool {
+        class, ok := ruleClasses[n.Rule]
+        value := n.Value()
+        if !ok && tokenRuleRe.MatchString(n.Rule) {
+            ok = true
+            class = PUNCTUATION
+            if keywordRe.MatchString(value) {
+                class = KEYWORD
+            }
+        }
+        if ok {
+            for i := n.Begin; i < n.Begin + len([]rune(value)); i++ {
+                classes[i] = class
+            }
+        }
+        return n.Rule != "comment"
+    })
+    // the declared prefix is written like a prefixed name
+    if prolog := tree.Child("prolog"); prolog != nil {
+        for _,decl := range prolog.ChildrenOf("prefixDecl") {
+            colon := decl.Child("COLON")
+            begin := colon.Begin
+            if prefix := decl.Child("pnPrefix"); prefix != nil {
+                begin = prefix.Begin
+            }
+            for i := begin; i <= colon.Begin; i++ {
+                classes[i] = PREFIXED_NAME
+            }
+        }
+    }
+    var tokens []Token
+    for begin := 0; begin < len(query); {
+        end := begin + 1
+        for end < len(query) && classes[end] == classes[begin] {
+            end++
+        }
+        tokens = append(tokens, Token{ Class : classes[begin], Begin : begin, End : end, Text : string(query[begin:end]) })
+        begin = end
+    }
+    return tokens
+}
+
+// HTML returns the query as HTML, with each token that is not of the TEXT
+// class within a span element. The class attribute of the span is the name of
+// the class of the token prefixed with "sparql-", e.g., "sparql-keyword".
+// It must be called after a successful Parse.
+func (p *Sparql) HTML() string {
+    out := ""
+    for _,token := range p.Highlight() {
+        if token.Class == TEXT {
+            out += html.EscapeString(token.Text)
+        } else {
+            out += `<span class="sparql-` + token.Class.String() + `">` + html.EscapeString(token.Text) + "</span>"
+        }
+    }
+    return out
+}
+
+// The ANSI escape codes of the colors of the classes. A class without a color
+// is not colored.
+var ANSIColors = map[Class]string{
+    KEYWORD : "\x1B[1;34m",
+    VARIABLE : "\x1B[33m",
+    IRI : "\x1B[32m",
+    PREFIXED_NAME : "\x1B[36m",
+    LITERAL : "\x1B[35m",
+    COMMENT : "\x1B[90m",
+}
+
+// ANSI returns the query with its tokens colored with the ANSI escape codes of
+// ANSIColors, e.g., for printing in a terminal. It must be called after a
+// successful Parse.
+func (p *Sparql) ANSI() string {
+    out := ""
+    for _,token := range p.Highlight() {
+        if color, ok := ANSIColors[token.Class]; ok {
+            out += color + token.Text + "\x1B[0m"
+        } else {
+            out += token.Text
+        }
+    }
+    return out
+}
@@ -0,0 +1,63 @@
+package sparql
+
+import (
+    "fmt"
+    "strings"
+    "testing"
+)
+
+// classes returns the tokens that are not of the TEXT class, as class:text
+func classes(tokens []Token) string {
+    var out []string
+    for _,token := range tokens {
+        if token.Class != TEXT {
+            out = append(out, fmt.Sprintf("%v:%v", token.Class, token.Text))
+        }
+    }
+    return strings.Join(out, " ")
+}
+
+func TestHighlight(t *testing.T) {
+    tests := map[string]string{
+        "PREFIX ex: <http://ex/> # the prefix\nSELECT ?s { ?s a ex:C ; ex:p \"x\"@en, \"1\"^^ex:int, 2 } LIMIT 10" :
+            "keyword:PREFIX prefixed-name:ex: iri:<http://ex/> comment:# the prefix keyword:SELECT variable:?s " +
+            "punctuation:{ variable:?s keyword:a prefixed-name:ex:C punctuation:; prefixed-name:ex:p literal:\"x\"@en punctuation:, " +
+            "literal:\"1\"^^ prefixed-name:ex:int punctuation:, literal:2 punctuation:} keyword:LIMIT literal:10",
+        `SELECT * { _:b <p> [] FILTER (?o >= -1 && !BOUND(?z)) }` :
+            "keyword:SELECT punctuation:* punctuation:{ variable:_:b iri:<p> punctuation:[] keyword:FILTER punctuation:( variable:?o " +
+            "punctuation:>= punctuation:- literal:1 punctuation:&& punctuation:! keyword:BOUND punctuation:( variable:?z punctuation:))" +
+            " punctuation:}",
+    }
+    for query, expected := range tests {
+        if actual := classes(parse(t, query).Highlight()); actual != expected {
+            t.Errorf("Expected\n%v\nbut got\n%v", expected, actual)
+        }
+    }
+}
+
+func TestHighlightCoversQuery(t *testing.T) {
+    query := "  SELECT  ?s\n{ ?s <p> 'o' }  # end"
+    text := ""
+    for _,token := range parse(t, query).Highlight() {
+        text += token.Text
+    }
+    if text != query {
+        t.Errorf("Expected the tokens to cover\n%v\nbut got\n%v", query, text)
+    }
+}
+
+func TestHTML(t *testing.T) {
+    expected := `<span class="sparql-keyword">ASK</span> <span class="sparql-punctuation">{</span> ` +
+        `<span class="sparql-variable">?s</span> <span class="sparql-iri">&lt;p&gt;</span> ` +
+        `<span class="sparql-literal">&#34;a&amp;b&#34;</span> <span class="sparql-punctuation">}</span>`
+    if actual := parse(t, `ASK { ?s <p> "a&b" }`).HTML(); actual != expected {
+        t.Errorf("Expected\n%v\nbut got\n%v", expected, actual)
+    }
+}
+
+func TestANSI(t *testing.T) {
+    expected := "\x1B[1;34mASK\x1B[0m { \x1B[33m?s\x1B[0m \x1B[32m<p>\x1B[0m \x1B[35m1\x1B[0m }"
+    if actual := parse(t, `ASK { ?s <p> 1 }`).ANSI(); actual != expected {
+        t.Errorf("Expected %q but got %q", expected, actual)
+    }
+}
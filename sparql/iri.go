@@ -0,0 +1,59 @@
+package sparql
+
+import (
+    "net/url"
+    "regexp"
+    "strconv"
+    "strings"
+)
+
+// Unbracket returns the IRI without the angle brackets around it, if any
+func Unbracket(iri string) string {
+    return strings.TrimSuffix(strings.TrimPrefix(iri, "<"), ">")
+}
+
+// ResolveIRI returns the IRI resolved against the base IRI, both without angle
+// brackets. The IRI is returned unchanged if the base is empty, or if either
+// cannot be parsed.
+func ResolveIRI(base string, iri string) string {
+    if base == "" {
+        return iri
+    }
+    b, err := url.Parse(base)
+    if err != nil {
+        return iri
+    }
+    ref, err := url.Parse(iri)
+    if err != nil {
+        return iri
+    }
+    resolved := b.ResolveReference(ref).String()
+    // an empty fragment is dropped by the url package
+    if strings.HasSuffix(iri, "#") && !strings.HasSuffix(resolved, "#") {
+        resolved += "#"
+    }
+    return resolved
+}
+
+// An escape sequence of a string or of an IRI
+var escapeRe = regexp.MustCompile(`\\(u[0-9A-Fa-f]{4}|U[0-9A-Fa-f]{8}|.)`)
+
+var echars = map[byte]string{ 't' : "\t", 'b' : "\b", 'n' : "\n", 'r' : "\r", 'f' : "\f", '"' : `"`, '\'' : "'", '\\' : `\` }
+
+// Unescape returns the string with its escape sequences replaced, i.e., the
+// ECHAR and the UCHAR of the grammar. An unknown escape sequence is kept.
+func Unescape(s string) string {
+    if !strings.Contains(s, `\`) {
+        return s
+    }
+    return escapeRe.ReplaceAllStringFunc(s, func(esc string) string {
+        if esc[1] == 'u' || esc[1] == 'U' {
+            code, _ := strconv.ParseUint(esc[2:], 16, 32)
+            return string(rune(code))
+        }
+        if c, ok := echars[esc[1]]; ok {
+            return c
+        }
+        return esc
+    })
+}
@@ -0,0 +1,46 @@
+package sparql
+
+import (
+    "testing"
+)
+
+func TestUnbracket(t *testing.T) {
+    for iri, expected := range map[string]string{ "<http://a/b>" : "http://a/b", "http://a/b" : "http://a/b", "<>" : "" } {
+        if actual := Unbracket(iri); actual != expected {
+            t.Errorf("Expected %v but got %v for %v", expected, actual, iri)
+        }
+    }
+}
+
+func TestResolveIRI(t *testing.T) {
+    tests := []struct{ base, iri, expected string }{
+        { "", "a", "a" },
+        { "http://a/b/c", "d", "http://a/b/d" },
+        { "http://a/b/c", "../d", "http://a/d" },
+        { "http://a/b/c", "http://e/f", "http://e/f" },
+        // the empty fragment is kept
+        { "http://a/b/c", "#", "http://a/b/c#" },
+        { "http://a/b/c", "d#", "http://a/b/d#" },
+    }
+    for _,test := range tests {
+        if actual := ResolveIRI(test.base, test.iri); actual != test.expected {
+            t.Errorf("Expected %v but got %v for %v against %v", test.expected, actual, test.iri, test.base)
+        }
+    }
+}
+
+func TestUnescape(t *testing.T) {
+    tests := map[string]string{
+        `a\tb\n` : "a\tb\n",
+        `\"q\' \\` : `"q' \`,
+        `é\U0001F600` : "é😀",
+        `\u0041` : "A",
+        `\\u0041` : `\u0041`,
+        `\q` : `\q`,
+    }
+    for s, expected := range tests {
+        if actual := Unescape(s); actual != expected {
+            t.Errorf("Expected %q but got %q for %v", expected, actual, s)
+        }
+    }
+}
@@ -0,0 +1,99 @@
+package sparql_test
+
+import (
+    "testing"
+)
+
+// Lexical forms of SPARQL 1.1 terms at the object position, and whether they
+// are valid or not
+var lexicalForms = []struct {
+    term string
+    valid bool
+}{
+    // INTEGER
+    { "1", true },
+    { "+1", true },
+    { "-1", true },
+    // DECIMAL
+    { "1.5", true },
+    { ".5", true },
+    { "-.5", true },
+    { "+1.5", true },
+    // DOUBLE
+    { "1e10", true },
+    { "1E10", true },
+    { "1.2E-3", true },
+    { ".2e+3", true },
+    { "1.e3", true },
+    { "-1.5e3", true },
+    { "1e", false },
+    { ".e5", false },
+    { "1.2e+", false },
+    // ECHAR and UCHAR
+    { `"\t\b\n\r\f\\\"\'"`, true },
+    { `"café"`, true },
+    { `'\U0001F600'`, true },
+    { `"""aé"""`, true },
+    { `'''a'b''c'''`, true },
+    { "\"\"\"a\nb\"\"\"", true },
+    { `"\x"`, false },
+    { `"\u12G4"`, false },
+    { `"\U0001F60"`, false },
+    // IRIREF
+    { "<http://example.org/a#b>", true },
+    { `<http://example.org/café>`, true },
+    { "<>", true },
+    { "<http://example.org/a b>", false },
+    { `<http://example.org/a"b>`, false },
+    { "<http://example.org/a{b}>", false },
+    { "<http://example.org/a|b>", false },
+    { "<http://example.org/a^b>", false },
+    { "<http://example.org/a`b>", false },
+    { `<http://example.org/a\b>`, false },
+    { "<http://example.org/<a>", false },
+    // PNAME_NS and PNAME_LN
+    { "ex:", true },
+    { ":", true },
+    { ":a", true },
+    { "ex:a.b", true },
+    { "ex:a:b", true },
+    { "ex:1a", true },
+    { "ex:a-b", true },
+    { "ex:%20a", true },
+    { "ex:a%2Fb", true },
+    { `ex:a\~b`, true },
+    { `ex:a\.b`, true },
+    { "e.x:a", true },
+    { "ex:%2g", false },
+    { "ex:-a", false },
+    { ".ex:a", false },
+    { "ex.:a", false },
+    { "1ex:a", false },
+    // BLANK_NODE_LABEL
+    { "_:b1", true },
+    { "_:a1b2", true },
+    { "_:a.b", true },
+    { "_:1a", true },
+    { "_:a-b", true },
+    { "_:-a", false },
+    // LANGTAG
+    { `"a"@en`, true },
+    { `"a"@en-US`, true },
+    { `"a"@de-CH-1901`, true },
+    { `"a"@-en`, false },
+}
+
+// The prefixes of the prefixed names of the lexical forms
+const lexicalPrefixes = "PREFIX : <http://example.org/> PREFIX ex: <http://example.org/> PREFIX e.x: <http://example.org/> "
+
+// Checks the lexical forms against each grammar, so that the terms of both
+// are kept in sync
+func TestLexicalForms(t *testing.T) {
+    for _,g := range grammars {
+        for _,lf := range lexicalForms {
+            if err := g.parse(lexicalPrefixes + "SELECT * { ?s ?p " + lf.term + " }"); (err == nil) != lf.valid {
+                t.Errorf("[%v] Expected the term [%v] to be valid=%v", g.name, lf.term, lf.valid)
+            }
+        }
+    }
+}
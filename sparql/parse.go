@@ -0,0 +1,99 @@
+package sparql
+
+import (
+    "fmt"
+)
+
+// SyntaxError is the error of a text that is not matched by a grammar rule
+type SyntaxError struct {
+    Message string
+    // The position where the text stops being matched, as a number of runes
+    Position int
+    // The line and the column of the position, starting from 1
+    Line, Column int
+}
+
+func (e *SyntaxError) Error() string {
+    return fmt.Sprintf("%v (line %v symbol %v)", e.Message, e.Line, e.Column)
+}
+
+// ParseRule returns the syntax tree of the text matched by the grammar rule,
+// e.g., "triplesBlock" for the statements of a Turtle document. The whole text
+// must be matched, and the rule does not skip leading whitespaces. The rules
+// inlined by the parser generator, e.g., "prolog", cannot be parsed on their own.
+func ParseRule(text string, rule string) (*Node, error) {
+    r := -1
+    for i,name := range rul3s {
+        if name == rule {
+            r = i
+            break
+        }
+    }
+    if r <= 0 {
+        return nil, fmt.Errorf("Unknown grammar rule [%v]", rule)
+    }
+    buffer := []rune(text)
+    tree, end, err := parseRule(buffer, r)
+    switch {
+    case err != nil:
+        return nil, err
+    case tree == nil:
+        return nil, syntaxError(buffer, end)
+    case end < len(buffer):
+        // the error is where the rest of the text stops being matched, if
+        // it does not match the rule either
+        if rest, n, _ := parseRule(buffer[end:], r); rest == nil {
+            end += n
+        }
+        return nil, syntaxError(buffer, end)
+    }
+    return tree, nil
+}
+
+// parseRule parses the text with the rule, and returns the syntax tree with the
+// length of the matched text. If the rule does not match, the tree is nil and
+// the length is the furthest position reached.
+func parseRule(buffer []rune, r int) (*Node, int, error) {
+    p := &Sparql{ Buffer : string(buffer) }
+    p.Init()
+    if p.rules[r] == nil {
+        return nil, 0, fmt.Errorf("Grammar rule [%v] is inlined", rul3s[r])
+    }
+    if err := p.Parse(r); err != nil {
+        if perr, ok := err.(*parseError); ok {
+            return nil, int(perr.max.end), nil
+        }
+        return nil, 0, err
+    }
+    tree := p.Tree()
+    if tree == nil {
+        return nil, 0, nil
+    }
+    return tree, tree.End, nil
+}
+
+// syntaxError returns the error of the text not matched from the position
+func syntaxError(buffer []rune, position int) *SyntaxError {
+    err := &SyntaxError{ Position : position, Line : 1, Column : 1 }
+    for _,c := range buffer[:position] {
+        if c == '\n' {
+            err.Line++
+            err.Column = 1
+        } else {
+            err.Column++
+        }
+    }
+    if position == len(buffer) {
+        err.Message = "Unexpected end of text"
+        return err
+    }
+    near := buffer[position:]
+    for i,c := range near {
+        if c == '\n' || i == 10 {
+            near = near[:i]
+            break
+        }
+    }
+    err.Message = fmt.Sprintf("Unexpected text [%v]", string(near))
+    return err
+}
@@ -0,0 +1,43 @@
+package sparql
+
+import (
+    "testing"
+)
+
+func TestParseRule(t *testing.T) {
+    tree, err := ParseRule(`<s> <p> "o" ; <q> [ <r> 1 ] . _:b <p> ( 1 2 )`, "triplesBlock")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if tree.Rule != "triplesBlock" || len(tree.ChildrenOf("triplesSameSubjectPath")) != 2 {
+        t.Errorf("Unexpected tree %v", tree.Text)
+    }
+    if _, err := ParseRule("<s>", "prolog"); err == nil {
+        t.Error("Expected an error for an inlined rule")
+    }
+    if _, err := ParseRule("<s>", "unknown"); err == nil {
+        t.Error("Expected an error for an unknown rule")
+    }
+}
+
+func TestParseRuleErrors(t *testing.T) {
+    tests := []struct {
+        text, message string
+        line, column int
+    }{
+        { "<s> <p> <o> .\n<s> <p>", "Unexpected end of text", 2, 8 },
+        { "<s> <p> <o> <q> .", "Unexpected text [.]", 1, 17 },
+        { "<s> <p> <o> .\n  @x\n", "Unexpected text [@x]", 2, 3 },
+    }
+    for _,test := range tests {
+        _, err := ParseRule(test.text, "triplesBlock")
+        serr, ok := err.(*SyntaxError)
+        if !ok {
+            t.Errorf("Expected a syntax error for %v but got %v", test.text, err)
+            continue
+        }
+        if serr.Message != test.message || serr.Line != test.line || serr.Column != test.column {
+            t.Errorf("Expected %v at %v:%v but got %v for %v", test.message, test.line, test.column, serr, test.text)
+        }
+    }
+}
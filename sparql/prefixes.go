@@ -0,0 +1,178 @@
+package sparql
+
+import (
+    "fmt"
+    "regexp"
+    "sort"
+    "strings"
+)
+
+// The characters of a local name, following the PN_CHARS_BASE, PN_CHARS_U and
+// PN_CHARS productions of the grammar
+const (
+    pnCharsBase = `A-Za-z\x{C0}-\x{D6}\x{D8}-\x{F6}\x{F8}-\x{2FF}\x{370}-\x{37D}\x{37F}-\x{1FFF}\x{200C}-\x{200D}\x{2070}-\x{218F}\x{2C00}-\x{2FEF}\x{3001}-\x{D7FF}\x{F900}-\x{FDCF}\x{FDF0}-\x{FFFD}\x{10000}-\x{EFFFF}`
+    pnCharsU = pnCharsBase + `_`
+    pnChars = pnCharsU + `\-0-9\x{B7}\x{300}-\x{36F}\x{203F}-\x{2040}`
+    percent = `%[0-9A-Fa-f]{2}`
+)
+
+// PlainLocalRe matches the local part of a prefixed name that can be written
+// as is, i.e., the PN_LOCAL production of the grammar without the PN_LOCAL_ESC
+// escape sequences. A percent-encoded character is kept as is.
+var PlainLocalRe = regexp.MustCompile(`^(?:(?:[` + pnCharsU + `:0-9]|` + percent + `)(?:(?:[` + pnChars + `.:]|` + percent + `)*(?:[` + pnChars + `:]|` + percent + `))?)?$`)
+
+// Escaped characters of the local part of a prefixed name
+var localEscRe = regexp.MustCompile(`\\(.)`)
+
+// An edit of the text of the query, i.e., the runes from begin to end are
+// replaced with the text
+type edit struct {
+    begin, end int
+    text string
+}
+
+// applyEdits returns the query with the edits applied. The edits do not overlap.
+func (p *Sparql) applyEdits(edits []edit) string {
+    sort.Slice(edits, func(i, j int) bool { return edits[i].begin < edits[j].begin })
+    query := []rune(p.Buffer)
+    out := ""
+    last := 0
+    for _,e := range edits {
+        out += string(query[last:e.begin]) + e.text
+        last = e.end
+    }
+    return out + string(query[last:])
+}
+
+// Prefixes returns the IRIs of the prefixes declared in the query, by name.
+// It must be called after a successful Parse.
+func (p *Sparql) Prefixes() map[string]string {
+    prefixes := make(map[string]string)
+    if prolog := p.Tree().Child("prolog"); prolog != nil {
+        for _,decl := range prolog.ChildrenOf("prefixDecl") {
+            prefixes[prefixName(decl)] = Unbracket(decl.Child("iri").Value())
+        }
+    }
+    return prefixes
+}
+
+// prefixName returns the name of the prefix of a declaration or of a prefixed name
+func prefixName(n *Node) string {
+    if prefix := n.Child("pnPrefix"); prefix != nil {
+        return prefix.Value()
+    }
+    return ""
+}
+
+// Expand returns the query with each prefixed name replaced by its full IRI,
+// and without the PREFIX declarations. An error is returned if a prefix is not
+// declared. It must be called after a successful Parse.
+func (p *Sparql) Expand() (string, error) {
+    tree := p.Tree()
+    prefixes := p.Prefixes()
+    var edits []edit
+    if prolog := tree.Child("prolog"); prolog != nil {
+        for _,decl := range prolog.ChildrenOf("prefixDecl") {
+            edits = append(edits, edit{ decl.Begin, decl.End, "" })
+        }
+    }
+    for _,name := range tree.FindAll("prefixedName") {
+        ns, ok := prefixes[prefixName(name)]
+        if !ok {
+            return "", fmt.Errorf("Undeclared prefix [%v:] at position %v", prefixName(name), name.Begin)
+        }
+        local := ""
+        if l := name.Child("pnLocal"); l != nil {
+            local = localEscRe.ReplaceAllString(l.Value(), "$1")
+        }
+        edits = append(edits, edit{ name.Begin, name.Begin + len([]rune(name.Value())), "<" + ns + local + ">" })
+    }
+    return p.applyEdits(edits), nil
+}
+
+// Compact returns the query with the full IRIs written as prefixed names. The
+// prefixes declared in the query are used, and those of the map whose name and
+// namespace are not declared. The declarations of the prefixes from the map that are needed
+// are added, and the declarations that are not used are removed. It must be
+// called after a successful Parse.
+func (p *Sparql) Compact(prefixes map[string]string) string {
+    tree := p.Tree()
+    declared := p.Prefixes()
+    candidates := make(map[string]string)
+    namespaces := make(map[string]bool)
+    for name,ns := range declared {
+        candidates[name] = ns
+        namespaces[ns] = true
+    }
+    for name,ns := range prefixes {
+        if _, ok := declared[name]; !ok && !namespaces[ns] {
+            candidates[name] = ns
+        }
+    }
+
+    var edits []edit
+    used := make(map[string]bool)
+    tree.Walk(func(n *Node) bool {
+        switch n.Rule {
+        case "prolog":
+            return false
+        case "prefixedName":
+            used[prefixName(n)] = true
+        case "iri":
+            compact := CompactIRI(n.Value(), candidates)
+            if compact != n.Value() {
+                used[compact[:strings.Index(compact, ":")]] = true
+                edits = append(edits, edit{ n.Begin, n.Begin + len([]rune(n.Value())), compact })
+            }
+        }
+        return true
+    })
+
+    if prolog := tree.Child("prolog"); prolog != nil {
+        for _,decl := range prolog.ChildrenOf("prefixDecl") {
+            if !used[prefixName(decl)] {
+                edits = append(edits, edit{ decl.Begin, decl.End, "" })
+            }
+        }
+    }
+    var added []string
+    for name := range used {
+        if _, ok := declared[name]; !ok && prefixes[name] != "" {
+            added = append(added, name)
+        }
+    }
+    if len(added) != 0 {
+        sort.Strings(added)
+        decls := ""
+        for _,name := range added {
+            decls += "PREFIX " + name + ": <" + prefixes[name] + ">\n"
+        }
+        begin := tree.Child("query").Begin
+        edits = append(edits, edit{ begin, begin, decls })
+    }
+    return p.applyEdits(edits)
+}
+
+// CompactIRI returns the IRI, written between angle brackets, as a prefixed
+// name with the prefix of the longest matching namespace. If several prefixes
+// have that namespace, the first name in alphabetical order is used. The IRI
+// is returned unchanged if no prefix matches.
+func CompactIRI(iri string, prefixes map[string]string) string {
+    if !strings.HasPrefix(iri, "<") || !strings.HasSuffix(iri, ">") {
+        return iri
+    }
+    value := Unbracket(iri)
+    found, name, ns := false, "", ""
+    for n,namespace := range prefixes {
+        if namespace == "" || !strings.HasPrefix(value, namespace) || !PlainLocalRe.MatchString(value[len(namespace):]) {
+            continue
+        }
+        if !found || len(namespace) > len(ns) || (len(namespace) == len(ns) && n < name) {
+            found, name, ns = true, n, namespace
+        }
+    }
+    if !found {
+        return iri
+    }
+    return name + ":" + value[len(ns):]
+}
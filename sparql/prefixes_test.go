@@ -0,0 +1,124 @@
+package sparql
+
+import (
+    "testing"
+)
+
+func TestPrefixes(t *testing.T) {
+    s := parse(t, `PREFIX : <http://a/> PREFIX b: <http://b/> SELECT * { ?s :p b:o }`)
+    prefixes := s.Prefixes()
+    if len(prefixes) != 2 || prefixes[""] != "http://a/" || prefixes["b"] != "http://b/" {
+        t.Errorf("Unexpected prefixes %v", prefixes)
+    }
+}
+
+func TestExpand(t *testing.T) {
+    tests := map[string]string{
+        `PREFIX : <http://a/>
+        PREFIX b: <http://b/> # comment
+        SELECT * { ?s :p b:o ; b: "1"^^b:int . # keep
+        }` : `SELECT * { ?s <http://a/p> <http://b/o> ; <http://b/> "1"^^<http://b/int> . # keep
+        }`,
+        `PREFIX a: <http://a/> SELECT * { ?s a:p\~q a:%41 }` : `SELECT * { ?s <http://a/p~q> <http://a/%41> }`,
+        `BASE <http://base/> PREFIX a: <ns#> SELECT * { ?s a:p ?o }` : `BASE <http://base/> SELECT * { ?s <ns#p> ?o }`,
+    }
+    for query, expected := range tests {
+        actual, err := parse(t, query).Expand()
+        if err != nil {
+            t.Errorf("Failed to expand\n%v\n%v", query, err)
+        } else if actual != expected {
+            t.Errorf("Expected\n%v\nbut got\n%v", expected, actual)
+        }
+    }
+    if _, err := parse(t, `SELECT * { ?s a:p ?o }`).Expand(); err == nil {
+        t.Error("Expected an error for an undeclared prefix")
+    }
+}
+
+func TestCompact(t *testing.T) {
+    prefixes := map[string]string{
+        "rdfs" : "http://www.w3.org/2000/01/rdf-schema#",
+        "dbo" : "http://dbpedia.org/ontology/",
+        "dbr" : "http://dbpedia.org/resource/",
+        "xsd" : "http://www.w3.org/2001/XMLSchema#",
+        "a" : "http://other/",
+    }
+    tests := map[string]string{
+        // full IRIs of the log
+        `SELECT * { ?s <http://www.w3.org/2000/01/rdf-schema#label> ?l ; <http://dbpedia.org/ontology/age> "1"^^<http://www.w3.org/2001/XMLSchema#int> }` :
+        "PREFIX dbo: <http://dbpedia.org/ontology/>\nPREFIX rdfs: <http://www.w3.org/2000/01/rdf-schema#>\nPREFIX xsd: <http://www.w3.org/2001/XMLSchema#>\n" +
+        `SELECT * { ?s rdfs:label ?l ; dbo:age "1"^^xsd:int }`,
+        // the declared prefixes have precedence, unused ones are dropped
+        `PREFIX a: <http://dbpedia.org/resource/>
+        PREFIX unused: <http://unused/>
+        SELECT * { <http://dbpedia.org/resource/Lyon> ?p ?o }` :
+        `PREFIX a: <http://dbpedia.org/resource/>
+        SELECT * { a:Lyon ?p ?o }`,
+        // the longest namespace is used
+        `PREFIX d: <http://dbpedia.org/> SELECT * { ?s ?p <http://dbpedia.org/ontology/Person>, <http://dbpedia.org/page> }` :
+        "PREFIX d: <http://dbpedia.org/> PREFIX dbo: <http://dbpedia.org/ontology/>\nSELECT * { ?s ?p dbo:Person, d:page }",
+        // a used but undeclared prefix of the map is declared
+        `SELECT * { ?s dbo:p ?o }` : "PREFIX dbo: <http://dbpedia.org/ontology/>\nSELECT * { ?s dbo:p ?o }",
+        // local names that would need escaping are left as is
+        `SELECT * { ?s ?p <http://dbpedia.org/resource/A(B)>, <http://dbpedia.org/resource/x.> }` :
+        `SELECT * { ?s ?p <http://dbpedia.org/resource/A(B)>, <http://dbpedia.org/resource/x.> }`,
+    }
+    for query, expected := range tests {
+        if actual := parse(t, query).Compact(prefixes); actual != expected {
+            t.Errorf("Expected\n%v\nbut got\n%v", expected, actual)
+        }
+    }
+}
+
+func TestCompactIRI(t *testing.T) {
+    prefixes := map[string]string{ "a" : "http://a/", "b" : "http://a/", "c" : "http://a/c/" }
+    tests := map[string]string{
+        "<http://a/x>" : "a:x",
+        "<http://a/c/x>" : "c:x",
+        "<http://a/>" : "a:",
+        "<http://b/x>" : "<http://b/x>",
+        "?x" : "?x",
+    }
+    for iri, expected := range tests {
+        if actual := CompactIRI(iri, prefixes); actual != expected {
+            t.Errorf("Expected %v but got %v", expected, actual)
+        }
+    }
+}
+
+func TestCompactRoundTrip(t *testing.T) {
+    prefixes := map[string]string{ "a" : "http://a/" }
+    // the local parts written as is are parsed back into the same IRI
+    for _,local := range []string{ "", "p", "café", "1a", "_a", "a.b", "a..b", "a:b", ":", "a-", "%41", "a%2F", "a\u00B7b", "\u00E9t\u00E9", "a\u0301" } {
+        iri := "<http://a/" + local + ">"
+        name := CompactIRI(iri, prefixes)
+        if name != "a:" + local {
+            t.Errorf("Expected a:%v but got %v", local, name)
+            continue
+        }
+        actual, err := parse(t, `PREFIX a: <http://a/> SELECT * { ?s ` + name + ` ?o }`).Expand()
+        if expected := `SELECT * { ?s ` + iri + ` ?o }`; err != nil || actual != expected {
+            t.Errorf("Expected\n%v\nbut got\n%v %v", expected, actual, err)
+        }
+    }
+    // the other local parts are rejected by the grammar
+    for _,local := range []string{ ".a", "a.", "-a", "\u00B7a", "a b", "a/b", "a~", "a#b", "%4", "a%G1" } {
+        iri := "<http://a/" + local + ">"
+        if name := CompactIRI(iri, prefixes); name != iri {
+            t.Errorf("Expected %v but got %v", iri, name)
+        }
+        s := &Sparql{ Buffer : `PREFIX a: <http://a/> SELECT * { ?s a:` + local + ` ?o }` }
+        s.Init()
+        if err := s.Parse(); err == nil {
+            t.Errorf("Expected the local part %q to be rejected", local)
+        }
+    }
+}
+
+func TestCompactDeclaredFirst(t *testing.T) {
+    query := `PREFIX z: <http://a/> SELECT * { ?s <http://a/p> ?o }`
+    expected := `PREFIX z: <http://a/> SELECT * { ?s z:p ?o }`
+    if actual := parse(t, query).Compact(map[string]string{ "a" : "http://a/", "z" : "http://z/" }); actual != expected {
+        t.Errorf("Expected\n%v\nbut got\n%v", expected, actual)
+    }
+}
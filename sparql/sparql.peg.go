@@ -7,7 +7,7 @@ import (
 	"strconv"
 )
 
-const end_symbol rune = 4
+const endSymbol rune = 1114112
 
 /* The rule types inferred from the grammar are below. */
 type pegRule uint8
@@ -62,11 +62,20 @@ const (
 	ruleobjectPath
 	rulegraphNodePath
 	rulesolutionModifier
+	rulegroupClause
+	rulehavingClause
+	ruleorderClause
 	rulegroupCondition
 	ruleorderCondition
 	rulelimitOffsetClauses
 	rulelimit
 	ruleoffset
+	rulevaluesClause
+	ruleinlineData
+	ruledataBlock
+	ruleinlineDataOneVar
+	ruleinlineDataFull
+	ruledataBlockValue
 	ruleexpression
 	ruleconditionalOrExpression
 	ruleconditionalAndExpression
@@ -95,8 +104,14 @@ const (
 	rulestringLiteralLongA
 	rulestringLiteralLongB
 	ruleechar
+	ruleuchar
 	rulenumericLiteral
 	rulesignedNumericLiteral
+	ruleunsignedNumericLiteral
+	ruledouble
+	ruledecimal
+	ruleinteger
+	ruleexponent
 	rulebooleanLiteral
 	ruleblankNode
 	ruleblankNodeLabel
@@ -232,16 +247,18 @@ const (
 	ruleHAVING
 	ruleGRAPH
 	ruleMINUSSETOPER
+	ruleSERVICE
+	ruleSILENT
+	ruleVALUES
+	ruleUNDEF
 	ruleskip
 	rulews
 	rulecomment
 	ruleendOfLine
-	ruleSERVICE
-	ruleSILENT
 
-	rulePre_
-	rule_In_
-	rule_Suf
+	rulePre
+	ruleIn
+	ruleSuf
 )
 
 var rul3s = [...]string{
@@ -294,11 +311,20 @@ var rul3s = [...]string{
 	"objectPath",
 	"graphNodePath",
 	"solutionModifier",
+	"groupClause",
+	"havingClause",
+	"orderClause",
 	"groupCondition",
 	"orderCondition",
 	"limitOffsetClauses",
 	"limit",
 	"offset",
+	"valuesClause",
+	"inlineData",
+	"dataBlock",
+	"inlineDataOneVar",
+	"inlineDataFull",
+	"dataBlockValue",
 	"expression",
 	"conditionalOrExpression",
 	"conditionalAndExpression",
@@ -327,8 +353,14 @@ var rul3s = [...]string{
 	"stringLiteralLongA",
 	"stringLiteralLongB",
 	"echar",
+	"uchar",
 	"numericLiteral",
 	"signedNumericLiteral",
+	"unsignedNumericLiteral",
+	"double",
+	"decimal",
+	"integer",
+	"exponent",
 	"booleanLiteral",
 	"blankNode",
 	"blankNodeLabel",
@@ -464,30 +496,20 @@ var rul3s = [...]string{
 	"HAVING",
 	"GRAPH",
 	"MINUSSETOPER",
+	"SERVICE",
+	"SILENT",
+	"VALUES",
+	"UNDEF",
 	"skip",
 	"ws",
 	"comment",
 	"endOfLine",
-	"SERVICE",
-	"SILENT",
 
 	"Pre_",
 	"_In_",
 	"_Suf",
 }
 
-type tokenTree interface {
-	Print()
-	PrintSyntax()
-	PrintSyntaxTree(buffer string)
-	Add(rule pegRule, begin, end, next, depth int)
-	Expand(index int) tokenTree
-	Tokens() <-chan token32
-	AST() *node32
-	Error() []token32
-	trim(length int)
-}
-
 type node32 struct {
 	token32
 	up, next *node32
@@ -498,7 +520,7 @@ func (node *node32) print(depth int, buffer string) {
 		for c := 0; c < depth; c++ {
 			fmt.Printf(" ")
 		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[node.pegRule], strconv.Quote(buffer[node.begin:node.end]))
+		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[node.pegRule], strconv.Quote(string(([]rune(buffer)[node.begin:node.end]))))
 		if node.up != nil {
 			node.up.print(depth+1, buffer)
 		}
@@ -506,8 +528,8 @@ func (node *node32) print(depth int, buffer string) {
 	}
 }
 
-func (ast *node32) Print(buffer string) {
-	ast.print(0, buffer)
+func (node *node32) Print(buffer string) {
+	node.print(0, buffer)
 }
 
 type element struct {
@@ -515,258 +537,10 @@ type element struct {
 	down *element
 }
 
-/* ${@} bit structure for abstract syntax tree */
-type token16 struct {
-	pegRule
-	begin, end, next int16
-}
-
-func (t *token16) isZero() bool {
-	return t.pegRule == ruleUnknown && t.begin == 0 && t.end == 0 && t.next == 0
-}
-
-func (t *token16) isParentOf(u token16) bool {
-	return t.begin <= u.begin && t.end >= u.end && t.next > u.next
-}
-
-func (t *token16) getToken32() token32 {
-	return token32{pegRule: t.pegRule, begin: int32(t.begin), end: int32(t.end), next: int32(t.next)}
-}
-
-func (t *token16) String() string {
-	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v %v", rul3s[t.pegRule], t.begin, t.end, t.next)
-}
-
-type tokens16 struct {
-	tree    []token16
-	ordered [][]token16
-}
-
-func (t *tokens16) trim(length int) {
-	t.tree = t.tree[0:length]
-}
-
-func (t *tokens16) Print() {
-	for _, token := range t.tree {
-		fmt.Println(token.String())
-	}
-}
-
-func (t *tokens16) Order() [][]token16 {
-	if t.ordered != nil {
-		return t.ordered
-	}
-
-	depths := make([]int16, 1, math.MaxInt16)
-	for i, token := range t.tree {
-		if token.pegRule == ruleUnknown {
-			t.tree = t.tree[:i]
-			break
-		}
-		depth := int(token.next)
-		if length := len(depths); depth >= length {
-			depths = depths[:depth+1]
-		}
-		depths[depth]++
-	}
-	depths = append(depths, 0)
-
-	ordered, pool := make([][]token16, len(depths)), make([]token16, len(t.tree)+len(depths))
-	for i, depth := range depths {
-		depth++
-		ordered[i], pool, depths[i] = pool[:depth], pool[depth:], 0
-	}
-
-	for i, token := range t.tree {
-		depth := token.next
-		token.next = int16(i)
-		ordered[depth][depths[depth]] = token
-		depths[depth]++
-	}
-	t.ordered = ordered
-	return ordered
-}
-
-type state16 struct {
-	token16
-	depths []int16
-	leaf   bool
-}
-
-func (t *tokens16) AST() *node32 {
-	tokens := t.Tokens()
-	stack := &element{node: &node32{token32: <-tokens}}
-	for token := range tokens {
-		if token.begin == token.end {
-			continue
-		}
-		node := &node32{token32: token}
-		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
-			stack.node.next = node.up
-			node.up = stack.node
-			stack = stack.down
-		}
-		stack = &element{node: node, down: stack}
-	}
-	return stack.node
-}
-
-func (t *tokens16) PreOrder() (<-chan state16, [][]token16) {
-	s, ordered := make(chan state16, 6), t.Order()
-	go func() {
-		var states [8]state16
-		for i, _ := range states {
-			states[i].depths = make([]int16, len(ordered))
-		}
-		depths, state, depth := make([]int16, len(ordered)), 0, 1
-		write := func(t token16, leaf bool) {
-			S := states[state]
-			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, int16(depth), leaf
-			copy(S.depths, depths)
-			s <- S
-		}
-
-		states[state].token16 = ordered[0][0]
-		depths[0]++
-		state++
-		a, b := ordered[depth-1][depths[depth-1]-1], ordered[depth][depths[depth]]
-	depthFirstSearch:
-		for {
-			for {
-				if i := depths[depth]; i > 0 {
-					if c, j := ordered[depth][i-1], depths[depth-1]; a.isParentOf(c) &&
-						(j < 2 || !ordered[depth-1][j-2].isParentOf(c)) {
-						if c.end != b.begin {
-							write(token16{pegRule: rule_In_, begin: c.end, end: b.begin}, true)
-						}
-						break
-					}
-				}
-
-				if a.begin < b.begin {
-					write(token16{pegRule: rulePre_, begin: a.begin, end: b.begin}, true)
-				}
-				break
-			}
-
-			next := depth + 1
-			if c := ordered[next][depths[next]]; c.pegRule != ruleUnknown && b.isParentOf(c) {
-				write(b, false)
-				depths[depth]++
-				depth, a, b = next, b, c
-				continue
-			}
-
-			write(b, true)
-			depths[depth]++
-			c, parent := ordered[depth][depths[depth]], true
-			for {
-				if c.pegRule != ruleUnknown && a.isParentOf(c) {
-					b = c
-					continue depthFirstSearch
-				} else if parent && b.end != a.end {
-					write(token16{pegRule: rule_Suf, begin: b.end, end: a.end}, true)
-				}
-
-				depth--
-				if depth > 0 {
-					a, b, c = ordered[depth-1][depths[depth-1]-1], a, ordered[depth][depths[depth]]
-					parent = a.isParentOf(b)
-					continue
-				}
-
-				break depthFirstSearch
-			}
-		}
-
-		close(s)
-	}()
-	return s, ordered
-}
-
-func (t *tokens16) PrintSyntax() {
-	tokens, ordered := t.PreOrder()
-	max := -1
-	for token := range tokens {
-		if !token.leaf {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[36m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[36m%v\x1B[m\n", rul3s[token.pegRule])
-		} else if token.begin == token.end {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[31m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[31m%v\x1B[m\n", rul3s[token.pegRule])
-		} else {
-			for c, end := token.begin, token.end; c < end; c++ {
-				if i := int(c); max+1 < i {
-					for j := max; j < i; j++ {
-						fmt.Printf("skip %v %v\n", j, token.String())
-					}
-					max = i
-				} else if i := int(c); i <= max {
-					for j := i; j <= max; j++ {
-						fmt.Printf("dupe %v %v\n", j, token.String())
-					}
-				} else {
-					max = int(c)
-				}
-				fmt.Printf("%v", c)
-				for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-					fmt.Printf(" \x1B[34m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-				}
-				fmt.Printf(" \x1B[34m%v\x1B[m\n", rul3s[token.pegRule])
-			}
-			fmt.Printf("\n")
-		}
-	}
-}
-
-func (t *tokens16) PrintSyntaxTree(buffer string) {
-	tokens, _ := t.PreOrder()
-	for token := range tokens {
-		for c := 0; c < int(token.next); c++ {
-			fmt.Printf(" ")
-		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(buffer[token.begin:token.end]))
-	}
-}
-
-func (t *tokens16) Add(rule pegRule, begin, end, depth, index int) {
-	t.tree[index] = token16{pegRule: rule, begin: int16(begin), end: int16(end), next: int16(depth)}
-}
-
-func (t *tokens16) Tokens() <-chan token32 {
-	s := make(chan token32, 16)
-	go func() {
-		for _, v := range t.tree {
-			s <- v.getToken32()
-		}
-		close(s)
-	}()
-	return s
-}
-
-func (t *tokens16) Error() []token32 {
-	ordered := t.Order()
-	length := len(ordered)
-	tokens, length := make([]token32, length), length-1
-	for i, _ := range tokens {
-		o := ordered[length-i]
-		if len(o) > 1 {
-			tokens[i] = o[len(o)-2].getToken32()
-		}
-	}
-	return tokens
-}
-
 /* ${@} bit structure for abstract syntax tree */
 type token32 struct {
 	pegRule
-	begin, end, next int32
+	begin, end, next uint32
 }
 
 func (t *token32) isZero() bool {
@@ -778,7 +552,7 @@ func (t *token32) isParentOf(u token32) bool {
 }
 
 func (t *token32) getToken32() token32 {
-	return token32{pegRule: t.pegRule, begin: int32(t.begin), end: int32(t.end), next: int32(t.next)}
+	return token32{pegRule: t.pegRule, begin: uint32(t.begin), end: uint32(t.end), next: uint32(t.next)}
 }
 
 func (t *token32) String() string {
@@ -827,7 +601,7 @@ func (t *tokens32) Order() [][]token32 {
 
 	for i, token := range t.tree {
 		depth := token.next
-		token.next = int32(i)
+		token.next = uint32(i)
 		ordered[depth][depths[depth]] = token
 		depths[depth]++
 	}
@@ -863,13 +637,13 @@ func (t *tokens32) PreOrder() (<-chan state32, [][]token32) {
 	s, ordered := make(chan state32, 6), t.Order()
 	go func() {
 		var states [8]state32
-		for i, _ := range states {
+		for i := range states {
 			states[i].depths = make([]int32, len(ordered))
 		}
 		depths, state, depth := make([]int32, len(ordered)), 0, 1
 		write := func(t token32, leaf bool) {
 			S := states[state]
-			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, int32(depth), leaf
+			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, uint32(depth), leaf
 			copy(S.depths, depths)
 			s <- S
 		}
@@ -885,14 +659,14 @@ func (t *tokens32) PreOrder() (<-chan state32, [][]token32) {
 					if c, j := ordered[depth][i-1], depths[depth-1]; a.isParentOf(c) &&
 						(j < 2 || !ordered[depth-1][j-2].isParentOf(c)) {
 						if c.end != b.begin {
-							write(token32{pegRule: rule_In_, begin: c.end, end: b.begin}, true)
+							write(token32{pegRule: ruleIn, begin: c.end, end: b.begin}, true)
 						}
 						break
 					}
 				}
 
 				if a.begin < b.begin {
-					write(token32{pegRule: rulePre_, begin: a.begin, end: b.begin}, true)
+					write(token32{pegRule: rulePre, begin: a.begin, end: b.begin}, true)
 				}
 				break
 			}
@@ -913,7 +687,7 @@ func (t *tokens32) PreOrder() (<-chan state32, [][]token32) {
 					b = c
 					continue depthFirstSearch
 				} else if parent && b.end != a.end {
-					write(token32{pegRule: rule_Suf, begin: b.end, end: a.end}, true)
+					write(token32{pegRule: ruleSuf, begin: b.end, end: a.end}, true)
 				}
 
 				depth--
@@ -979,12 +753,12 @@ func (t *tokens32) PrintSyntaxTree(buffer string) {
 		for c := 0; c < int(token.next); c++ {
 			fmt.Printf(" ")
 		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(buffer[token.begin:token.end]))
+		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(string(([]rune(buffer)[token.begin:token.end]))))
 	}
 }
 
-func (t *tokens32) Add(rule pegRule, begin, end, depth, index int) {
-	t.tree[index] = token32{pegRule: rule, begin: int32(begin), end: int32(end), next: int32(depth)}
+func (t *tokens32) Add(rule pegRule, begin, end, depth uint32, index int) {
+	t.tree[index] = token32{pegRule: rule, begin: uint32(begin), end: uint32(end), next: uint32(depth)}
 }
 
 func (t *tokens32) Tokens() <-chan token32 {
@@ -1002,7 +776,7 @@ func (t *tokens32) Error() []token32 {
 	ordered := t.Order()
 	length := len(ordered)
 	tokens, length := make([]token32, length), length-1
-	for i, _ := range tokens {
+	for i := range tokens {
 		o := ordered[length-i]
 		if len(o) > 1 {
 			tokens[i] = o[len(o)-2].getToken32()
@@ -1011,35 +785,23 @@ func (t *tokens32) Error() []token32 {
 	return tokens
 }
 
-func (t *tokens16) Expand(index int) tokenTree {
-	tree := t.tree
-	if index >= len(tree) {
-		expanded := make([]token32, 2*len(tree))
-		for i, v := range tree {
-			expanded[i] = v.getToken32()
-		}
-		return &tokens32{tree: expanded}
-	}
-	return nil
-}
-
-func (t *tokens32) Expand(index int) tokenTree {
+func (t *tokens32) Expand(index int) {
 	tree := t.tree
 	if index >= len(tree) {
 		expanded := make([]token32, 2*len(tree))
 		copy(expanded, tree)
 		t.tree = expanded
 	}
-	return nil
 }
 
 type Sparql struct {
 	Buffer string
 	buffer []rune
-	rules  [225]func() bool
+	rules  [242]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
-	tokenTree
+	Pretty bool
+	tokens32
 }
 
 type textPosition struct {
@@ -1048,12 +810,12 @@ type textPosition struct {
 
 type textPositionMap map[int]textPosition
 
-func translatePositions(buffer string, positions []int) textPositionMap {
+func translatePositions(buffer []rune, positions []int) textPositionMap {
 	length, translations, j, line, symbol := len(positions), make(textPositionMap, len(positions)), 0, 1, 0
 	sort.Ints(positions)
 
 search:
-	for i, c := range buffer[0:] {
+	for i, c := range buffer {
 		if c == '\n' {
 			line, symbol = line+1, 0
 		} else {
@@ -1074,45 +836,51 @@ search:
 }
 
 type parseError struct {
-	p *Sparql
+	p   *Sparql
+	max token32
 }
 
 func (e *parseError) Error() string {
-	tokens, error := e.p.tokenTree.Error(), "\n"
+	tokens, error := []token32{e.max}, "\n"
 	positions, p := make([]int, 2*len(tokens)), 0
 	for _, token := range tokens {
 		positions[p], p = int(token.begin), p+1
 		positions[p], p = int(token.end), p+1
 	}
-	translations := translatePositions(e.p.Buffer, positions)
+	translations := translatePositions(e.p.buffer, positions)
+	format := "parse error near %v (line %v symbol %v - line %v symbol %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (line %v symbol %v - line %v symbol %v):\n%v\n"
+	}
 	for _, token := range tokens {
 		begin, end := int(token.begin), int(token.end)
-		error += fmt.Sprintf("parse error near \x1B[34m%v\x1B[m (line %v symbol %v - line %v symbol %v):\n%v\n",
+		error += fmt.Sprintf(format,
 			rul3s[token.pegRule],
 			translations[begin].line, translations[begin].symbol,
 			translations[end].line, translations[end].symbol,
-			/*strconv.Quote(*/ e.p.Buffer[begin:end] /*)*/)
+			strconv.Quote(string(e.p.buffer[begin:end])))
 	}
 
 	return error
 }
 
 func (p *Sparql) PrintSyntaxTree() {
-	p.tokenTree.PrintSyntaxTree(p.Buffer)
+	p.tokens32.PrintSyntaxTree(p.Buffer)
 }
 
 func (p *Sparql) Highlighter() {
-	p.tokenTree.PrintSyntax()
+	p.PrintSyntax()
 }
 
 func (p *Sparql) Init() {
 	p.buffer = []rune(p.Buffer)
-	if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != end_symbol {
-		p.buffer = append(p.buffer, end_symbol)
+	if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+		p.buffer = append(p.buffer, endSymbol)
 	}
 
-	var tree tokenTree = &tokens16{tree: make([]token16, math.MaxInt16)}
-	position, depth, tokenIndex, buffer, rules := 0, 0, 0, p.buffer, p.rules
+	tree := tokens32{tree: make([]token32, math.MaxInt16)}
+	var max token32
+	position, depth, tokenIndex, buffer, _rules := uint32(0), uint32(0), 0, p.buffer, p.rules
 
 	p.Parse = func(rule ...int) error {
 		r := 1
@@ -1120,28 +888,29 @@ func (p *Sparql) Init() {
 			r = rule[0]
 		}
 		matches := p.rules[r]()
-		p.tokenTree = tree
+		p.tokens32 = tree
 		if matches {
-			p.tokenTree.trim(tokenIndex)
+			p.trim(tokenIndex)
 			return nil
 		}
-		return &parseError{p}
+		return &parseError{p, max}
 	}
 
 	p.Reset = func() {
 		position, tokenIndex, depth = 0, 0, 0
 	}
 
-	add := func(rule pegRule, begin int) {
-		if t := tree.Expand(tokenIndex); t != nil {
-			tree = t
-		}
+	add := func(rule pegRule, begin uint32) {
+		tree.Expand(tokenIndex)
 		tree.Add(rule, begin, position, depth, tokenIndex)
 		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position, depth}
+		}
 	}
 
 	matchDot := func() bool {
-		if buffer[position] != end_symbol {
+		if buffer[position] != endSymbol {
 			position++
 			return true
 		}
@@ -1164,7 +933,7 @@ func (p *Sparql) Init() {
 		return false
 	}*/
 
-	rules = [...]func() bool{
+	_rules = [...]func() bool{
 		nil,
 		/* 0 queryContainer <- <(skip prolog query !.)> */
 		func() bool {
@@ -1172,7 +941,7 @@ func (p *Sparql) Init() {
 			{
 				position1 := position
 				depth++
-				if !rules[ruleskip]() {
+				if !_rules[ruleskip]() {
 					goto l0
 				}
 				{
@@ -1279,7 +1048,7 @@ func (p *Sparql) Init() {
 										position++
 									}
 								l19:
-									if !rules[ruleskip]() {
+									if !_rules[ruleskip]() {
 										goto l6
 									}
 									depth--
@@ -1287,7 +1056,7 @@ func (p *Sparql) Init() {
 								}
 								{
 									position21, tokenIndex21, depth21 := position, tokenIndex, depth
-									if !rules[rulepnPrefix]() {
+									if !_rules[rulepnPrefix]() {
 										goto l21
 									}
 									goto l22
@@ -1302,13 +1071,13 @@ func (p *Sparql) Init() {
 										goto l6
 									}
 									position++
-									if !rules[ruleskip]() {
+									if !_rules[ruleskip]() {
 										goto l6
 									}
 									depth--
 									add(ruleCOLON, position23)
 								}
-								if !rules[ruleiri]() {
+								if !_rules[ruleiri]() {
 									goto l6
 								}
 								depth--
@@ -1383,13 +1152,13 @@ func (p *Sparql) Init() {
 										position++
 									}
 								l32:
-									if !rules[ruleskip]() {
+									if !_rules[ruleskip]() {
 										goto l4
 									}
 									depth--
 									add(ruleBASE, position25)
 								}
-								if !rules[ruleiri]() {
+								if !_rules[ruleiri]() {
 									goto l4
 								}
 								depth--
@@ -1408,499 +1177,508 @@ func (p *Sparql) Init() {
 					position34 := position
 					depth++
 					{
-						switch buffer[position] {
-						case 'A', 'a':
+						position35, tokenIndex35, depth35 := position, tokenIndex, depth
+						{
+							position37 := position
+							depth++
+							if !_rules[ruleselect]() {
+								goto l36
+							}
+						l38:
+							{
+								position39, tokenIndex39, depth39 := position, tokenIndex, depth
+								if !_rules[ruledatasetClause]() {
+									goto l39
+								}
+								goto l38
+							l39:
+								position, tokenIndex, depth = position39, tokenIndex39, depth39
+							}
+							if !_rules[rulewhereClause]() {
+								goto l36
+							}
+							if !_rules[rulesolutionModifier]() {
+								goto l36
+							}
+							depth--
+							add(ruleselectQuery, position37)
+						}
+						goto l35
+					l36:
+						position, tokenIndex, depth = position35, tokenIndex35, depth35
+						{
+							position41 := position
+							depth++
 							{
-								position36 := position
+								position42 := position
 								depth++
 								{
-									position37 := position
+									position43 := position
 									depth++
 									{
-										position38, tokenIndex38, depth38 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l39
+										position44, tokenIndex44, depth44 := position, tokenIndex, depth
+										if buffer[position] != rune('c') {
+											goto l45
 										}
 										position++
-										goto l38
-									l39:
-										position, tokenIndex, depth = position38, tokenIndex38, depth38
-										if buffer[position] != rune('A') {
-											goto l0
+										goto l44
+									l45:
+										position, tokenIndex, depth = position44, tokenIndex44, depth44
+										if buffer[position] != rune('C') {
+											goto l40
 										}
 										position++
 									}
-								l38:
+								l44:
 									{
-										position40, tokenIndex40, depth40 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l41
+										position46, tokenIndex46, depth46 := position, tokenIndex, depth
+										if buffer[position] != rune('o') {
+											goto l47
 										}
 										position++
-										goto l40
-									l41:
-										position, tokenIndex, depth = position40, tokenIndex40, depth40
-										if buffer[position] != rune('S') {
-											goto l0
+										goto l46
+									l47:
+										position, tokenIndex, depth = position46, tokenIndex46, depth46
+										if buffer[position] != rune('O') {
+											goto l40
 										}
 										position++
 									}
-								l40:
+								l46:
 									{
-										position42, tokenIndex42, depth42 := position, tokenIndex, depth
-										if buffer[position] != rune('k') {
-											goto l43
+										position48, tokenIndex48, depth48 := position, tokenIndex, depth
+										if buffer[position] != rune('n') {
+											goto l49
 										}
 										position++
-										goto l42
-									l43:
-										position, tokenIndex, depth = position42, tokenIndex42, depth42
-										if buffer[position] != rune('K') {
-											goto l0
+										goto l48
+									l49:
+										position, tokenIndex, depth = position48, tokenIndex48, depth48
+										if buffer[position] != rune('N') {
+											goto l40
 										}
 										position++
 									}
-								l42:
-									if !rules[ruleskip]() {
-										goto l0
-									}
-									depth--
-									add(ruleASK, position37)
-								}
-							l44:
-								{
-									position45, tokenIndex45, depth45 := position, tokenIndex, depth
-									if !rules[ruledatasetClause]() {
-										goto l45
-									}
-									goto l44
-								l45:
-									position, tokenIndex, depth = position45, tokenIndex45, depth45
-								}
-								if !rules[rulewhereClause]() {
-									goto l0
-								}
-								depth--
-								add(ruleaskQuery, position36)
-							}
-							break
-						case 'D', 'd':
-							{
-								position46 := position
-								depth++
-								{
-									position47 := position
-									depth++
+								l48:
 									{
-										position48 := position
-										depth++
-										{
-											position49, tokenIndex49, depth49 := position, tokenIndex, depth
-											if buffer[position] != rune('d') {
-												goto l50
-											}
-											position++
-											goto l49
-										l50:
-											position, tokenIndex, depth = position49, tokenIndex49, depth49
-											if buffer[position] != rune('D') {
-												goto l0
-											}
-											position++
-										}
-									l49:
-										{
-											position51, tokenIndex51, depth51 := position, tokenIndex, depth
-											if buffer[position] != rune('e') {
-												goto l52
-											}
-											position++
+										position50, tokenIndex50, depth50 := position, tokenIndex, depth
+										if buffer[position] != rune('s') {
 											goto l51
-										l52:
-											position, tokenIndex, depth = position51, tokenIndex51, depth51
-											if buffer[position] != rune('E') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l50
 									l51:
-										{
-											position53, tokenIndex53, depth53 := position, tokenIndex, depth
-											if buffer[position] != rune('s') {
-												goto l54
-											}
-											position++
+										position, tokenIndex, depth = position50, tokenIndex50, depth50
+										if buffer[position] != rune('S') {
+											goto l40
+										}
+										position++
+									}
+								l50:
+									{
+										position52, tokenIndex52, depth52 := position, tokenIndex, depth
+										if buffer[position] != rune('t') {
 											goto l53
-										l54:
-											position, tokenIndex, depth = position53, tokenIndex53, depth53
-											if buffer[position] != rune('S') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l52
 									l53:
-										{
-											position55, tokenIndex55, depth55 := position, tokenIndex, depth
-											if buffer[position] != rune('c') {
-												goto l56
-											}
-											position++
+										position, tokenIndex, depth = position52, tokenIndex52, depth52
+										if buffer[position] != rune('T') {
+											goto l40
+										}
+										position++
+									}
+								l52:
+									{
+										position54, tokenIndex54, depth54 := position, tokenIndex, depth
+										if buffer[position] != rune('r') {
 											goto l55
-										l56:
-											position, tokenIndex, depth = position55, tokenIndex55, depth55
-											if buffer[position] != rune('C') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l54
 									l55:
-										{
-											position57, tokenIndex57, depth57 := position, tokenIndex, depth
-											if buffer[position] != rune('r') {
-												goto l58
-											}
-											position++
+										position, tokenIndex, depth = position54, tokenIndex54, depth54
+										if buffer[position] != rune('R') {
+											goto l40
+										}
+										position++
+									}
+								l54:
+									{
+										position56, tokenIndex56, depth56 := position, tokenIndex, depth
+										if buffer[position] != rune('u') {
 											goto l57
-										l58:
-											position, tokenIndex, depth = position57, tokenIndex57, depth57
-											if buffer[position] != rune('R') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l56
 									l57:
-										{
-											position59, tokenIndex59, depth59 := position, tokenIndex, depth
-											if buffer[position] != rune('i') {
-												goto l60
-											}
-											position++
+										position, tokenIndex, depth = position56, tokenIndex56, depth56
+										if buffer[position] != rune('U') {
+											goto l40
+										}
+										position++
+									}
+								l56:
+									{
+										position58, tokenIndex58, depth58 := position, tokenIndex, depth
+										if buffer[position] != rune('c') {
 											goto l59
-										l60:
-											position, tokenIndex, depth = position59, tokenIndex59, depth59
-											if buffer[position] != rune('I') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l58
 									l59:
-										{
-											position61, tokenIndex61, depth61 := position, tokenIndex, depth
-											if buffer[position] != rune('b') {
-												goto l62
-											}
-											position++
+										position, tokenIndex, depth = position58, tokenIndex58, depth58
+										if buffer[position] != rune('C') {
+											goto l40
+										}
+										position++
+									}
+								l58:
+									{
+										position60, tokenIndex60, depth60 := position, tokenIndex, depth
+										if buffer[position] != rune('t') {
 											goto l61
-										l62:
-											position, tokenIndex, depth = position61, tokenIndex61, depth61
-											if buffer[position] != rune('B') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l60
 									l61:
-										{
-											position63, tokenIndex63, depth63 := position, tokenIndex, depth
-											if buffer[position] != rune('e') {
-												goto l64
-											}
-											position++
-											goto l63
-										l64:
-											position, tokenIndex, depth = position63, tokenIndex63, depth63
-											if buffer[position] != rune('E') {
-												goto l0
-											}
-											position++
-										}
-									l63:
-										if !rules[ruleskip]() {
-											goto l0
+										position, tokenIndex, depth = position60, tokenIndex60, depth60
+										if buffer[position] != rune('T') {
+											goto l40
 										}
-										depth--
-										add(ruleDESCRIBE, position48)
+										position++
 									}
-									{
-										switch buffer[position] {
-										case '*':
-											if !rules[ruleSTAR]() {
-												goto l0
-											}
-											break
-										case '$', '?':
-											if !rules[rulevar]() {
-												goto l0
-											}
-											break
-										default:
-											if !rules[ruleiriref]() {
-												goto l0
-											}
-											break
-										}
+								l60:
+									if !_rules[ruleskip]() {
+										goto l40
 									}
-
 									depth--
-									add(ruledescribe, position47)
+									add(ruleCONSTRUCT, position43)
 								}
-							l66:
-								{
-									position67, tokenIndex67, depth67 := position, tokenIndex, depth
-									if !rules[ruledatasetClause]() {
-										goto l67
-									}
-									goto l66
-								l67:
-									position, tokenIndex, depth = position67, tokenIndex67, depth67
+								if !_rules[ruleLBRACE]() {
+									goto l40
 								}
 								{
-									position68, tokenIndex68, depth68 := position, tokenIndex, depth
-									if !rules[rulewhereClause]() {
-										goto l68
+									position62, tokenIndex62, depth62 := position, tokenIndex, depth
+									if !_rules[ruletriplesBlock]() {
+										goto l62
 									}
-									goto l69
-								l68:
-									position, tokenIndex, depth = position68, tokenIndex68, depth68
+									goto l63
+								l62:
+									position, tokenIndex, depth = position62, tokenIndex62, depth62
 								}
-							l69:
-								if !rules[rulesolutionModifier]() {
-									goto l0
+							l63:
+								if !_rules[ruleRBRACE]() {
+									goto l40
 								}
 								depth--
-								add(ruledescribeQuery, position46)
+								add(ruleconstruct, position42)
+							}
+						l64:
+							{
+								position65, tokenIndex65, depth65 := position, tokenIndex, depth
+								if !_rules[ruledatasetClause]() {
+									goto l65
+								}
+								goto l64
+							l65:
+								position, tokenIndex, depth = position65, tokenIndex65, depth65
+							}
+							if !_rules[rulewhereClause]() {
+								goto l40
+							}
+							if !_rules[rulesolutionModifier]() {
+								goto l40
 							}
-							break
-						case 'C', 'c':
+							depth--
+							add(ruleconstructQuery, position41)
+						}
+						goto l35
+					l40:
+						position, tokenIndex, depth = position35, tokenIndex35, depth35
+						{
+							position67 := position
+							depth++
 							{
-								position70 := position
+								position68 := position
 								depth++
 								{
-									position71 := position
+									position69 := position
 									depth++
 									{
-										position72 := position
-										depth++
-										{
-											position73, tokenIndex73, depth73 := position, tokenIndex, depth
-											if buffer[position] != rune('c') {
-												goto l74
-											}
-											position++
+										position70, tokenIndex70, depth70 := position, tokenIndex, depth
+										if buffer[position] != rune('d') {
+											goto l71
+										}
+										position++
+										goto l70
+									l71:
+										position, tokenIndex, depth = position70, tokenIndex70, depth70
+										if buffer[position] != rune('D') {
+											goto l66
+										}
+										position++
+									}
+								l70:
+									{
+										position72, tokenIndex72, depth72 := position, tokenIndex, depth
+										if buffer[position] != rune('e') {
 											goto l73
-										l74:
-											position, tokenIndex, depth = position73, tokenIndex73, depth73
-											if buffer[position] != rune('C') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l72
 									l73:
-										{
-											position75, tokenIndex75, depth75 := position, tokenIndex, depth
-											if buffer[position] != rune('o') {
-												goto l76
-											}
-											position++
+										position, tokenIndex, depth = position72, tokenIndex72, depth72
+										if buffer[position] != rune('E') {
+											goto l66
+										}
+										position++
+									}
+								l72:
+									{
+										position74, tokenIndex74, depth74 := position, tokenIndex, depth
+										if buffer[position] != rune('s') {
 											goto l75
-										l76:
-											position, tokenIndex, depth = position75, tokenIndex75, depth75
-											if buffer[position] != rune('O') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l74
 									l75:
-										{
-											position77, tokenIndex77, depth77 := position, tokenIndex, depth
-											if buffer[position] != rune('n') {
-												goto l78
-											}
-											position++
+										position, tokenIndex, depth = position74, tokenIndex74, depth74
+										if buffer[position] != rune('S') {
+											goto l66
+										}
+										position++
+									}
+								l74:
+									{
+										position76, tokenIndex76, depth76 := position, tokenIndex, depth
+										if buffer[position] != rune('c') {
 											goto l77
-										l78:
-											position, tokenIndex, depth = position77, tokenIndex77, depth77
-											if buffer[position] != rune('N') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l76
 									l77:
-										{
-											position79, tokenIndex79, depth79 := position, tokenIndex, depth
-											if buffer[position] != rune('s') {
-												goto l80
-											}
-											position++
+										position, tokenIndex, depth = position76, tokenIndex76, depth76
+										if buffer[position] != rune('C') {
+											goto l66
+										}
+										position++
+									}
+								l76:
+									{
+										position78, tokenIndex78, depth78 := position, tokenIndex, depth
+										if buffer[position] != rune('r') {
 											goto l79
-										l80:
-											position, tokenIndex, depth = position79, tokenIndex79, depth79
-											if buffer[position] != rune('S') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l78
 									l79:
-										{
-											position81, tokenIndex81, depth81 := position, tokenIndex, depth
-											if buffer[position] != rune('t') {
-												goto l82
-											}
-											position++
+										position, tokenIndex, depth = position78, tokenIndex78, depth78
+										if buffer[position] != rune('R') {
+											goto l66
+										}
+										position++
+									}
+								l78:
+									{
+										position80, tokenIndex80, depth80 := position, tokenIndex, depth
+										if buffer[position] != rune('i') {
 											goto l81
-										l82:
-											position, tokenIndex, depth = position81, tokenIndex81, depth81
-											if buffer[position] != rune('T') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l80
 									l81:
-										{
-											position83, tokenIndex83, depth83 := position, tokenIndex, depth
-											if buffer[position] != rune('r') {
-												goto l84
-											}
-											position++
+										position, tokenIndex, depth = position80, tokenIndex80, depth80
+										if buffer[position] != rune('I') {
+											goto l66
+										}
+										position++
+									}
+								l80:
+									{
+										position82, tokenIndex82, depth82 := position, tokenIndex, depth
+										if buffer[position] != rune('b') {
 											goto l83
-										l84:
-											position, tokenIndex, depth = position83, tokenIndex83, depth83
-											if buffer[position] != rune('R') {
-												goto l0
-											}
-											position++
 										}
+										position++
+										goto l82
 									l83:
-										{
-											position85, tokenIndex85, depth85 := position, tokenIndex, depth
-											if buffer[position] != rune('u') {
-												goto l86
-											}
-											position++
-											goto l85
-										l86:
-											position, tokenIndex, depth = position85, tokenIndex85, depth85
-											if buffer[position] != rune('U') {
-												goto l0
-											}
-											position++
-										}
-									l85:
-										{
-											position87, tokenIndex87, depth87 := position, tokenIndex, depth
-											if buffer[position] != rune('c') {
-												goto l88
-											}
-											position++
-											goto l87
-										l88:
-											position, tokenIndex, depth = position87, tokenIndex87, depth87
-											if buffer[position] != rune('C') {
-												goto l0
-											}
-											position++
-										}
-									l87:
-										{
-											position89, tokenIndex89, depth89 := position, tokenIndex, depth
-											if buffer[position] != rune('t') {
-												goto l90
-											}
-											position++
-											goto l89
-										l90:
-											position, tokenIndex, depth = position89, tokenIndex89, depth89
-											if buffer[position] != rune('T') {
-												goto l0
-											}
-											position++
-										}
-									l89:
-										if !rules[ruleskip]() {
-											goto l0
+										position, tokenIndex, depth = position82, tokenIndex82, depth82
+										if buffer[position] != rune('B') {
+											goto l66
 										}
-										depth--
-										add(ruleCONSTRUCT, position72)
-									}
-									if !rules[ruleLBRACE]() {
-										goto l0
+										position++
 									}
+								l82:
 									{
-										position91, tokenIndex91, depth91 := position, tokenIndex, depth
-										if !rules[ruletriplesBlock]() {
-											goto l91
+										position84, tokenIndex84, depth84 := position, tokenIndex, depth
+										if buffer[position] != rune('e') {
+											goto l85
+										}
+										position++
+										goto l84
+									l85:
+										position, tokenIndex, depth = position84, tokenIndex84, depth84
+										if buffer[position] != rune('E') {
+											goto l66
 										}
-										goto l92
-									l91:
-										position, tokenIndex, depth = position91, tokenIndex91, depth91
+										position++
 									}
-								l92:
-									if !rules[ruleRBRACE]() {
-										goto l0
+								l84:
+									if !_rules[ruleskip]() {
+										goto l66
 									}
 									depth--
-									add(ruleconstruct, position71)
+									add(ruleDESCRIBE, position69)
 								}
-							l93:
 								{
-									position94, tokenIndex94, depth94 := position, tokenIndex, depth
-									if !rules[ruledatasetClause]() {
-										goto l94
+									position86, tokenIndex86, depth86 := position, tokenIndex, depth
+									if !_rules[ruleSTAR]() {
+										goto l87
+									}
+									goto l86
+								l87:
+									position, tokenIndex, depth = position86, tokenIndex86, depth86
+									if !_rules[rulevar]() {
+										goto l88
+									}
+									goto l86
+								l88:
+									position, tokenIndex, depth = position86, tokenIndex86, depth86
+									if !_rules[ruleiriref]() {
+										goto l66
 									}
-									goto l93
-								l94:
-									position, tokenIndex, depth = position94, tokenIndex94, depth94
-								}
-								if !rules[rulewhereClause]() {
-									goto l0
-								}
-								if !rules[rulesolutionModifier]() {
-									goto l0
 								}
+							l86:
 								depth--
-								add(ruleconstructQuery, position70)
+								add(ruledescribe, position68)
+							}
+						l89:
+							{
+								position90, tokenIndex90, depth90 := position, tokenIndex, depth
+								if !_rules[ruledatasetClause]() {
+									goto l90
+								}
+								goto l89
+							l90:
+								position, tokenIndex, depth = position90, tokenIndex90, depth90
 							}
-							break
-						default:
 							{
-								position95 := position
+								position91, tokenIndex91, depth91 := position, tokenIndex, depth
+								if !_rules[rulewhereClause]() {
+									goto l91
+								}
+								goto l92
+							l91:
+								position, tokenIndex, depth = position91, tokenIndex91, depth91
+							}
+						l92:
+							if !_rules[rulesolutionModifier]() {
+								goto l66
+							}
+							depth--
+							add(ruledescribeQuery, position67)
+						}
+						goto l35
+					l66:
+						position, tokenIndex, depth = position35, tokenIndex35, depth35
+						{
+							position93 := position
+							depth++
+							{
+								position94 := position
 								depth++
-								if !rules[ruleselect]() {
-									goto l0
+								{
+									position95, tokenIndex95, depth95 := position, tokenIndex, depth
+									if buffer[position] != rune('a') {
+										goto l96
+									}
+									position++
+									goto l95
+								l96:
+									position, tokenIndex, depth = position95, tokenIndex95, depth95
+									if buffer[position] != rune('A') {
+										goto l0
+									}
+									position++
 								}
-							l96:
+							l95:
 								{
 									position97, tokenIndex97, depth97 := position, tokenIndex, depth
-									if !rules[ruledatasetClause]() {
-										goto l97
+									if buffer[position] != rune('s') {
+										goto l98
 									}
-									goto l96
-								l97:
+									position++
+									goto l97
+								l98:
 									position, tokenIndex, depth = position97, tokenIndex97, depth97
+									if buffer[position] != rune('S') {
+										goto l0
+									}
+									position++
 								}
-								if !rules[rulewhereClause]() {
-									goto l0
+							l97:
+								{
+									position99, tokenIndex99, depth99 := position, tokenIndex, depth
+									if buffer[position] != rune('k') {
+										goto l100
+									}
+									position++
+									goto l99
+								l100:
+									position, tokenIndex, depth = position99, tokenIndex99, depth99
+									if buffer[position] != rune('K') {
+										goto l0
+									}
+									position++
 								}
-								if !rules[rulesolutionModifier]() {
+							l99:
+								if !_rules[ruleskip]() {
 									goto l0
 								}
 								depth--
-								add(ruleselectQuery, position95)
+								add(ruleASK, position94)
+							}
+						l101:
+							{
+								position102, tokenIndex102, depth102 := position, tokenIndex, depth
+								if !_rules[ruledatasetClause]() {
+									goto l102
+								}
+								goto l101
+							l102:
+								position, tokenIndex, depth = position102, tokenIndex102, depth102
+							}
+							if !_rules[rulewhereClause]() {
+								goto l0
 							}
-							break
+							depth--
+							add(ruleaskQuery, position93)
 						}
 					}
-
+				l35:
+					{
+						position103, tokenIndex103, depth103 := position, tokenIndex, depth
+						if !_rules[rulevaluesClause]() {
+							goto l103
+						}
+						goto l104
+					l103:
+						position, tokenIndex, depth = position103, tokenIndex103, depth103
+					}
+				l104:
 					depth--
 					add(rulequery, position34)
 				}
 				{
-					position98, tokenIndex98, depth98 := position, tokenIndex, depth
+					position105, tokenIndex105, depth105 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l98
+						goto l105
 					}
 					goto l0
-				l98:
-					position, tokenIndex, depth = position98, tokenIndex98, depth98
+				l105:
+					position, tokenIndex, depth = position105, tokenIndex105, depth105
 				}
 				depth--
 				add(rulequeryContainer, position1)
@@ -1916,354 +1694,364 @@ func (p *Sparql) Init() {
 		nil,
 		/* 3 baseDecl <- <(BASE iri)> */
 		nil,
-		/* 4 query <- <((&('A' | 'a') askQuery) | (&('D' | 'd') describeQuery) | (&('C' | 'c') constructQuery) | (&('S' | 's') selectQuery))> */
+		/* 4 query <- <((selectQuery / constructQuery / describeQuery / askQuery) valuesClause?)> */
 		nil,
 		/* 5 selectQuery <- <(select datasetClause* whereClause solutionModifier)> */
 		nil,
 		/* 6 select <- <(SELECT (DISTINCT / REDUCED)? (STAR / projectionElem+))> */
 		func() bool {
-			position104, tokenIndex104, depth104 := position, tokenIndex, depth
+			position111, tokenIndex111, depth111 := position, tokenIndex, depth
 			{
-				position105 := position
+				position112 := position
 				depth++
 				{
-					position106 := position
+					position113 := position
 					depth++
 					{
-						position107, tokenIndex107, depth107 := position, tokenIndex, depth
+						position114, tokenIndex114, depth114 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l108
+							goto l115
 						}
 						position++
-						goto l107
-					l108:
-						position, tokenIndex, depth = position107, tokenIndex107, depth107
+						goto l114
+					l115:
+						position, tokenIndex, depth = position114, tokenIndex114, depth114
 						if buffer[position] != rune('S') {
-							goto l104
+							goto l111
 						}
 						position++
 					}
-				l107:
+				l114:
 					{
-						position109, tokenIndex109, depth109 := position, tokenIndex, depth
+						position116, tokenIndex116, depth116 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l110
+							goto l117
 						}
 						position++
-						goto l109
-					l110:
-						position, tokenIndex, depth = position109, tokenIndex109, depth109
+						goto l116
+					l117:
+						position, tokenIndex, depth = position116, tokenIndex116, depth116
 						if buffer[position] != rune('E') {
-							goto l104
+							goto l111
 						}
 						position++
 					}
-				l109:
+				l116:
 					{
-						position111, tokenIndex111, depth111 := position, tokenIndex, depth
+						position118, tokenIndex118, depth118 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l112
+							goto l119
 						}
 						position++
-						goto l111
-					l112:
-						position, tokenIndex, depth = position111, tokenIndex111, depth111
+						goto l118
+					l119:
+						position, tokenIndex, depth = position118, tokenIndex118, depth118
 						if buffer[position] != rune('L') {
-							goto l104
+							goto l111
 						}
 						position++
 					}
-				l111:
+				l118:
 					{
-						position113, tokenIndex113, depth113 := position, tokenIndex, depth
+						position120, tokenIndex120, depth120 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l114
+							goto l121
 						}
 						position++
-						goto l113
-					l114:
-						position, tokenIndex, depth = position113, tokenIndex113, depth113
+						goto l120
+					l121:
+						position, tokenIndex, depth = position120, tokenIndex120, depth120
 						if buffer[position] != rune('E') {
-							goto l104
+							goto l111
 						}
 						position++
 					}
-				l113:
+				l120:
 					{
-						position115, tokenIndex115, depth115 := position, tokenIndex, depth
+						position122, tokenIndex122, depth122 := position, tokenIndex, depth
 						if buffer[position] != rune('c') {
-							goto l116
+							goto l123
 						}
 						position++
-						goto l115
-					l116:
-						position, tokenIndex, depth = position115, tokenIndex115, depth115
+						goto l122
+					l123:
+						position, tokenIndex, depth = position122, tokenIndex122, depth122
 						if buffer[position] != rune('C') {
-							goto l104
+							goto l111
 						}
 						position++
 					}
-				l115:
+				l122:
 					{
-						position117, tokenIndex117, depth117 := position, tokenIndex, depth
+						position124, tokenIndex124, depth124 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l118
+							goto l125
 						}
 						position++
-						goto l117
-					l118:
-						position, tokenIndex, depth = position117, tokenIndex117, depth117
+						goto l124
+					l125:
+						position, tokenIndex, depth = position124, tokenIndex124, depth124
 						if buffer[position] != rune('T') {
-							goto l104
+							goto l111
 						}
 						position++
 					}
-				l117:
-					if !rules[ruleskip]() {
-						goto l104
+				l124:
+					if !_rules[ruleskip]() {
+						goto l111
 					}
 					depth--
-					add(ruleSELECT, position106)
+					add(ruleSELECT, position113)
 				}
 				{
-					position119, tokenIndex119, depth119 := position, tokenIndex, depth
+					position126, tokenIndex126, depth126 := position, tokenIndex, depth
 					{
-						position121, tokenIndex121, depth121 := position, tokenIndex, depth
-						if !rules[ruleDISTINCT]() {
-							goto l122
+						position128, tokenIndex128, depth128 := position, tokenIndex, depth
+						if !_rules[ruleDISTINCT]() {
+							goto l129
 						}
-						goto l121
-					l122:
-						position, tokenIndex, depth = position121, tokenIndex121, depth121
+						goto l128
+					l129:
+						position, tokenIndex, depth = position128, tokenIndex128, depth128
 						{
-							position123 := position
+							position130 := position
 							depth++
 							{
-								position124, tokenIndex124, depth124 := position, tokenIndex, depth
+								position131, tokenIndex131, depth131 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l125
+									goto l132
 								}
 								position++
-								goto l124
-							l125:
-								position, tokenIndex, depth = position124, tokenIndex124, depth124
+								goto l131
+							l132:
+								position, tokenIndex, depth = position131, tokenIndex131, depth131
 								if buffer[position] != rune('R') {
-									goto l119
+									goto l126
 								}
 								position++
 							}
-						l124:
+						l131:
 							{
-								position126, tokenIndex126, depth126 := position, tokenIndex, depth
+								position133, tokenIndex133, depth133 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l127
+									goto l134
 								}
 								position++
-								goto l126
-							l127:
-								position, tokenIndex, depth = position126, tokenIndex126, depth126
+								goto l133
+							l134:
+								position, tokenIndex, depth = position133, tokenIndex133, depth133
 								if buffer[position] != rune('E') {
-									goto l119
+									goto l126
 								}
 								position++
 							}
-						l126:
+						l133:
 							{
-								position128, tokenIndex128, depth128 := position, tokenIndex, depth
+								position135, tokenIndex135, depth135 := position, tokenIndex, depth
 								if buffer[position] != rune('d') {
-									goto l129
+									goto l136
 								}
 								position++
-								goto l128
-							l129:
-								position, tokenIndex, depth = position128, tokenIndex128, depth128
+								goto l135
+							l136:
+								position, tokenIndex, depth = position135, tokenIndex135, depth135
 								if buffer[position] != rune('D') {
-									goto l119
+									goto l126
 								}
 								position++
 							}
-						l128:
+						l135:
 							{
-								position130, tokenIndex130, depth130 := position, tokenIndex, depth
+								position137, tokenIndex137, depth137 := position, tokenIndex, depth
 								if buffer[position] != rune('u') {
-									goto l131
+									goto l138
 								}
 								position++
-								goto l130
-							l131:
-								position, tokenIndex, depth = position130, tokenIndex130, depth130
+								goto l137
+							l138:
+								position, tokenIndex, depth = position137, tokenIndex137, depth137
 								if buffer[position] != rune('U') {
-									goto l119
+									goto l126
 								}
 								position++
 							}
-						l130:
+						l137:
 							{
-								position132, tokenIndex132, depth132 := position, tokenIndex, depth
+								position139, tokenIndex139, depth139 := position, tokenIndex, depth
 								if buffer[position] != rune('c') {
-									goto l133
+									goto l140
 								}
 								position++
-								goto l132
-							l133:
-								position, tokenIndex, depth = position132, tokenIndex132, depth132
+								goto l139
+							l140:
+								position, tokenIndex, depth = position139, tokenIndex139, depth139
 								if buffer[position] != rune('C') {
-									goto l119
+									goto l126
 								}
 								position++
 							}
-						l132:
+						l139:
 							{
-								position134, tokenIndex134, depth134 := position, tokenIndex, depth
+								position141, tokenIndex141, depth141 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l135
+									goto l142
 								}
 								position++
-								goto l134
-							l135:
-								position, tokenIndex, depth = position134, tokenIndex134, depth134
+								goto l141
+							l142:
+								position, tokenIndex, depth = position141, tokenIndex141, depth141
 								if buffer[position] != rune('E') {
-									goto l119
+									goto l126
 								}
 								position++
 							}
-						l134:
+						l141:
 							{
-								position136, tokenIndex136, depth136 := position, tokenIndex, depth
+								position143, tokenIndex143, depth143 := position, tokenIndex, depth
 								if buffer[position] != rune('d') {
-									goto l137
+									goto l144
 								}
 								position++
-								goto l136
-							l137:
-								position, tokenIndex, depth = position136, tokenIndex136, depth136
+								goto l143
+							l144:
+								position, tokenIndex, depth = position143, tokenIndex143, depth143
 								if buffer[position] != rune('D') {
-									goto l119
+									goto l126
 								}
 								position++
 							}
-						l136:
-							if !rules[ruleskip]() {
-								goto l119
+						l143:
+							if !_rules[ruleskip]() {
+								goto l126
 							}
 							depth--
-							add(ruleREDUCED, position123)
+							add(ruleREDUCED, position130)
 						}
 					}
-				l121:
-					goto l120
-				l119:
-					position, tokenIndex, depth = position119, tokenIndex119, depth119
+				l128:
+					goto l127
+				l126:
+					position, tokenIndex, depth = position126, tokenIndex126, depth126
 				}
-			l120:
+			l127:
 				{
-					position138, tokenIndex138, depth138 := position, tokenIndex, depth
-					if !rules[ruleSTAR]() {
-						goto l139
+					position145, tokenIndex145, depth145 := position, tokenIndex, depth
+					if !_rules[ruleSTAR]() {
+						goto l146
 					}
-					goto l138
-				l139:
-					position, tokenIndex, depth = position138, tokenIndex138, depth138
+					goto l145
+				l146:
+					position, tokenIndex, depth = position145, tokenIndex145, depth145
 					{
-						position142 := position
+						position149 := position
 						depth++
 						{
-							position143, tokenIndex143, depth143 := position, tokenIndex, depth
-							if !rules[rulevar]() {
-								goto l144
+							position150, tokenIndex150, depth150 := position, tokenIndex, depth
+							if !_rules[rulevar]() {
+								goto l151
 							}
-							goto l143
-						l144:
-							position, tokenIndex, depth = position143, tokenIndex143, depth143
-							if !rules[ruleLPAREN]() {
-								goto l104
+							goto l150
+						l151:
+							position, tokenIndex, depth = position150, tokenIndex150, depth150
+							if !_rules[ruleLPAREN]() {
+								goto l111
 							}
-							if !rules[ruleexpression]() {
-								goto l104
+							if !_rules[ruleexpression]() {
+								goto l111
 							}
-							if !rules[ruleAS]() {
-								goto l104
+							if !_rules[ruleAS]() {
+								goto l111
 							}
-							if !rules[rulevar]() {
-								goto l104
+							if !_rules[rulevar]() {
+								goto l111
 							}
-							if !rules[ruleRPAREN]() {
-								goto l104
+							if !_rules[ruleRPAREN]() {
+								goto l111
 							}
 						}
-					l143:
+					l150:
 						depth--
-						add(ruleprojectionElem, position142)
+						add(ruleprojectionElem, position149)
 					}
-				l140:
+				l147:
 					{
-						position141, tokenIndex141, depth141 := position, tokenIndex, depth
+						position148, tokenIndex148, depth148 := position, tokenIndex, depth
 						{
-							position145 := position
+							position152 := position
 							depth++
 							{
-								position146, tokenIndex146, depth146 := position, tokenIndex, depth
-								if !rules[rulevar]() {
-									goto l147
+								position153, tokenIndex153, depth153 := position, tokenIndex, depth
+								if !_rules[rulevar]() {
+									goto l154
 								}
-								goto l146
-							l147:
-								position, tokenIndex, depth = position146, tokenIndex146, depth146
-								if !rules[ruleLPAREN]() {
-									goto l141
+								goto l153
+							l154:
+								position, tokenIndex, depth = position153, tokenIndex153, depth153
+								if !_rules[ruleLPAREN]() {
+									goto l148
 								}
-								if !rules[ruleexpression]() {
-									goto l141
+								if !_rules[ruleexpression]() {
+									goto l148
 								}
-								if !rules[ruleAS]() {
-									goto l141
+								if !_rules[ruleAS]() {
+									goto l148
 								}
-								if !rules[rulevar]() {
-									goto l141
+								if !_rules[rulevar]() {
+									goto l148
 								}
-								if !rules[ruleRPAREN]() {
-									goto l141
+								if !_rules[ruleRPAREN]() {
+									goto l148
 								}
 							}
-						l146:
+						l153:
 							depth--
-							add(ruleprojectionElem, position145)
+							add(ruleprojectionElem, position152)
 						}
-						goto l140
-					l141:
-						position, tokenIndex, depth = position141, tokenIndex141, depth141
+						goto l147
+					l148:
+						position, tokenIndex, depth = position148, tokenIndex148, depth148
 					}
 				}
-			l138:
+			l145:
 				depth--
-				add(ruleselect, position105)
+				add(ruleselect, position112)
 			}
 			return true
-		l104:
-			position, tokenIndex, depth = position104, tokenIndex104, depth104
+		l111:
+			position, tokenIndex, depth = position111, tokenIndex111, depth111
 			return false
 		},
-		/* 7 subSelect <- <(select whereClause solutionModifier)> */
+		/* 7 subSelect <- <(select whereClause solutionModifier valuesClause?)> */
 		func() bool {
-			position148, tokenIndex148, depth148 := position, tokenIndex, depth
+			position155, tokenIndex155, depth155 := position, tokenIndex, depth
 			{
-				position149 := position
+				position156 := position
 				depth++
-				if !rules[ruleselect]() {
-					goto l148
+				if !_rules[ruleselect]() {
+					goto l155
+				}
+				if !_rules[rulewhereClause]() {
+					goto l155
 				}
-				if !rules[rulewhereClause]() {
-					goto l148
+				if !_rules[rulesolutionModifier]() {
+					goto l155
 				}
-				if !rules[rulesolutionModifier]() {
-					goto l148
+				{
+					position157, tokenIndex157, depth157 := position, tokenIndex, depth
+					if !_rules[rulevaluesClause]() {
+						goto l157
+					}
+					goto l158
+				l157:
+					position, tokenIndex, depth = position157, tokenIndex157, depth157
 				}
+			l158:
 				depth--
-				add(rulesubSelect, position149)
+				add(rulesubSelect, position156)
 			}
 			return true
-		l148:
-			position, tokenIndex, depth = position148, tokenIndex148, depth148
+		l155:
+			position, tokenIndex, depth = position155, tokenIndex155, depth155
 			return false
 		},
 		/* 8 constructQuery <- <(construct datasetClause* whereClause solutionModifier)> */
@@ -2272,7 +2060,7 @@ func (p *Sparql) Init() {
 		nil,
 		/* 10 describeQuery <- <(describe datasetClause* whereClause? solutionModifier)> */
 		nil,
-		/* 11 describe <- <(DESCRIBE ((&('*') STAR) | (&('$' | '?') var) | (&(':' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | 'Ã' | 'Ä' | 'Å' | 'Æ' | 'Ç' | 'È' | 'É' | 'Ê' | 'Ë' | 'Í' | 'Î' | 'Ï' | 'Ð' | 'Ñ' | 'Ò' | 'Ó' | 'Ô' | 'Õ' | 'Ö' | '×' | 'Ø' | 'Ù' | 'Ú' | 'Û' | 'Ü' | 'Ý' | 'Þ' | 'ß' | 'à' | 'á' | 'â' | 'ã' | 'ä' | 'å' | 'æ' | 'ç' | 'è' | 'é' | 'ê' | 'ë' | 'ì' | 'í' | 'ï' | 'ð' | 'ñ' | 'ò' | 'ó') iriref)))> */
+		/* 11 describe <- <(DESCRIBE (STAR / var / iriref))> */
 		nil,
 		/* 12 askQuery <- <(ASK datasetClause* whereClause)> */
 		nil,
@@ -2280,992 +2068,1199 @@ func (p *Sparql) Init() {
 		nil,
 		/* 14 datasetClause <- <(FROM NAMED? iriref)> */
 		func() bool {
-			position156, tokenIndex156, depth156 := position, tokenIndex, depth
+			position165, tokenIndex165, depth165 := position, tokenIndex, depth
 			{
-				position157 := position
+				position166 := position
 				depth++
 				{
-					position158 := position
+					position167 := position
 					depth++
 					{
-						position159, tokenIndex159, depth159 := position, tokenIndex, depth
+						position168, tokenIndex168, depth168 := position, tokenIndex, depth
 						if buffer[position] != rune('f') {
-							goto l160
+							goto l169
 						}
 						position++
-						goto l159
-					l160:
-						position, tokenIndex, depth = position159, tokenIndex159, depth159
+						goto l168
+					l169:
+						position, tokenIndex, depth = position168, tokenIndex168, depth168
 						if buffer[position] != rune('F') {
-							goto l156
+							goto l165
 						}
 						position++
 					}
-				l159:
+				l168:
 					{
-						position161, tokenIndex161, depth161 := position, tokenIndex, depth
+						position170, tokenIndex170, depth170 := position, tokenIndex, depth
 						if buffer[position] != rune('r') {
-							goto l162
+							goto l171
 						}
 						position++
-						goto l161
-					l162:
-						position, tokenIndex, depth = position161, tokenIndex161, depth161
+						goto l170
+					l171:
+						position, tokenIndex, depth = position170, tokenIndex170, depth170
 						if buffer[position] != rune('R') {
-							goto l156
+							goto l165
 						}
 						position++
 					}
-				l161:
+				l170:
 					{
-						position163, tokenIndex163, depth163 := position, tokenIndex, depth
+						position172, tokenIndex172, depth172 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l164
+							goto l173
 						}
 						position++
-						goto l163
-					l164:
-						position, tokenIndex, depth = position163, tokenIndex163, depth163
+						goto l172
+					l173:
+						position, tokenIndex, depth = position172, tokenIndex172, depth172
 						if buffer[position] != rune('O') {
-							goto l156
+							goto l165
 						}
 						position++
 					}
-				l163:
+				l172:
 					{
-						position165, tokenIndex165, depth165 := position, tokenIndex, depth
+						position174, tokenIndex174, depth174 := position, tokenIndex, depth
 						if buffer[position] != rune('m') {
-							goto l166
+							goto l175
 						}
 						position++
-						goto l165
-					l166:
-						position, tokenIndex, depth = position165, tokenIndex165, depth165
+						goto l174
+					l175:
+						position, tokenIndex, depth = position174, tokenIndex174, depth174
 						if buffer[position] != rune('M') {
-							goto l156
+							goto l165
 						}
 						position++
 					}
-				l165:
-					if !rules[ruleskip]() {
-						goto l156
+				l174:
+					if !_rules[ruleskip]() {
+						goto l165
 					}
 					depth--
-					add(ruleFROM, position158)
+					add(ruleFROM, position167)
 				}
 				{
-					position167, tokenIndex167, depth167 := position, tokenIndex, depth
+					position176, tokenIndex176, depth176 := position, tokenIndex, depth
 					{
-						position169 := position
+						position178 := position
 						depth++
 						{
-							position170, tokenIndex170, depth170 := position, tokenIndex, depth
+							position179, tokenIndex179, depth179 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l171
+								goto l180
 							}
 							position++
-							goto l170
-						l171:
-							position, tokenIndex, depth = position170, tokenIndex170, depth170
+							goto l179
+						l180:
+							position, tokenIndex, depth = position179, tokenIndex179, depth179
 							if buffer[position] != rune('N') {
-								goto l167
+								goto l176
 							}
 							position++
 						}
-					l170:
+					l179:
 						{
-							position172, tokenIndex172, depth172 := position, tokenIndex, depth
+							position181, tokenIndex181, depth181 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l173
+								goto l182
 							}
 							position++
-							goto l172
-						l173:
-							position, tokenIndex, depth = position172, tokenIndex172, depth172
+							goto l181
+						l182:
+							position, tokenIndex, depth = position181, tokenIndex181, depth181
 							if buffer[position] != rune('A') {
-								goto l167
+								goto l176
 							}
 							position++
 						}
-					l172:
+					l181:
 						{
-							position174, tokenIndex174, depth174 := position, tokenIndex, depth
+							position183, tokenIndex183, depth183 := position, tokenIndex, depth
 							if buffer[position] != rune('m') {
-								goto l175
+								goto l184
 							}
 							position++
-							goto l174
-						l175:
-							position, tokenIndex, depth = position174, tokenIndex174, depth174
+							goto l183
+						l184:
+							position, tokenIndex, depth = position183, tokenIndex183, depth183
 							if buffer[position] != rune('M') {
-								goto l167
+								goto l176
 							}
 							position++
 						}
-					l174:
+					l183:
 						{
-							position176, tokenIndex176, depth176 := position, tokenIndex, depth
+							position185, tokenIndex185, depth185 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l177
+								goto l186
 							}
 							position++
-							goto l176
-						l177:
-							position, tokenIndex, depth = position176, tokenIndex176, depth176
+							goto l185
+						l186:
+							position, tokenIndex, depth = position185, tokenIndex185, depth185
 							if buffer[position] != rune('E') {
-								goto l167
+								goto l176
 							}
 							position++
 						}
-					l176:
+					l185:
 						{
-							position178, tokenIndex178, depth178 := position, tokenIndex, depth
+							position187, tokenIndex187, depth187 := position, tokenIndex, depth
 							if buffer[position] != rune('d') {
-								goto l179
+								goto l188
 							}
 							position++
-							goto l178
-						l179:
-							position, tokenIndex, depth = position178, tokenIndex178, depth178
+							goto l187
+						l188:
+							position, tokenIndex, depth = position187, tokenIndex187, depth187
 							if buffer[position] != rune('D') {
-								goto l167
+								goto l176
 							}
 							position++
 						}
-					l178:
-						if !rules[ruleskip]() {
-							goto l167
+					l187:
+						if !_rules[ruleskip]() {
+							goto l176
 						}
 						depth--
-						add(ruleNAMED, position169)
+						add(ruleNAMED, position178)
 					}
-					goto l168
-				l167:
-					position, tokenIndex, depth = position167, tokenIndex167, depth167
+					goto l177
+				l176:
+					position, tokenIndex, depth = position176, tokenIndex176, depth176
 				}
-			l168:
-				if !rules[ruleiriref]() {
-					goto l156
+			l177:
+				if !_rules[ruleiriref]() {
+					goto l165
 				}
 				depth--
-				add(ruledatasetClause, position157)
+				add(ruledatasetClause, position166)
 			}
 			return true
-		l156:
-			position, tokenIndex, depth = position156, tokenIndex156, depth156
+		l165:
+			position, tokenIndex, depth = position165, tokenIndex165, depth165
 			return false
 		},
 		/* 15 whereClause <- <(WHERE? groupGraphPattern)> */
 		func() bool {
-			position180, tokenIndex180, depth180 := position, tokenIndex, depth
+			position189, tokenIndex189, depth189 := position, tokenIndex, depth
 			{
-				position181 := position
+				position190 := position
 				depth++
 				{
-					position182, tokenIndex182, depth182 := position, tokenIndex, depth
+					position191, tokenIndex191, depth191 := position, tokenIndex, depth
 					{
-						position184 := position
+						position193 := position
 						depth++
 						{
-							position185, tokenIndex185, depth185 := position, tokenIndex, depth
+							position194, tokenIndex194, depth194 := position, tokenIndex, depth
 							if buffer[position] != rune('w') {
-								goto l186
+								goto l195
 							}
 							position++
-							goto l185
-						l186:
-							position, tokenIndex, depth = position185, tokenIndex185, depth185
+							goto l194
+						l195:
+							position, tokenIndex, depth = position194, tokenIndex194, depth194
 							if buffer[position] != rune('W') {
-								goto l182
+								goto l191
 							}
 							position++
 						}
-					l185:
+					l194:
 						{
-							position187, tokenIndex187, depth187 := position, tokenIndex, depth
+							position196, tokenIndex196, depth196 := position, tokenIndex, depth
 							if buffer[position] != rune('h') {
-								goto l188
+								goto l197
 							}
 							position++
-							goto l187
-						l188:
-							position, tokenIndex, depth = position187, tokenIndex187, depth187
+							goto l196
+						l197:
+							position, tokenIndex, depth = position196, tokenIndex196, depth196
 							if buffer[position] != rune('H') {
-								goto l182
+								goto l191
 							}
 							position++
 						}
-					l187:
+					l196:
 						{
-							position189, tokenIndex189, depth189 := position, tokenIndex, depth
+							position198, tokenIndex198, depth198 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l190
+								goto l199
 							}
 							position++
-							goto l189
-						l190:
-							position, tokenIndex, depth = position189, tokenIndex189, depth189
+							goto l198
+						l199:
+							position, tokenIndex, depth = position198, tokenIndex198, depth198
 							if buffer[position] != rune('E') {
-								goto l182
+								goto l191
 							}
 							position++
 						}
-					l189:
+					l198:
 						{
-							position191, tokenIndex191, depth191 := position, tokenIndex, depth
+							position200, tokenIndex200, depth200 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l192
+								goto l201
 							}
 							position++
-							goto l191
-						l192:
-							position, tokenIndex, depth = position191, tokenIndex191, depth191
+							goto l200
+						l201:
+							position, tokenIndex, depth = position200, tokenIndex200, depth200
 							if buffer[position] != rune('R') {
-								goto l182
+								goto l191
 							}
 							position++
 						}
-					l191:
+					l200:
 						{
-							position193, tokenIndex193, depth193 := position, tokenIndex, depth
+							position202, tokenIndex202, depth202 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l194
+								goto l203
 							}
 							position++
-							goto l193
-						l194:
-							position, tokenIndex, depth = position193, tokenIndex193, depth193
+							goto l202
+						l203:
+							position, tokenIndex, depth = position202, tokenIndex202, depth202
 							if buffer[position] != rune('E') {
-								goto l182
+								goto l191
 							}
 							position++
 						}
-					l193:
-						if !rules[ruleskip]() {
-							goto l182
+					l202:
+						if !_rules[ruleskip]() {
+							goto l191
 						}
 						depth--
-						add(ruleWHERE, position184)
+						add(ruleWHERE, position193)
 					}
-					goto l183
-				l182:
-					position, tokenIndex, depth = position182, tokenIndex182, depth182
+					goto l192
+				l191:
+					position, tokenIndex, depth = position191, tokenIndex191, depth191
 				}
-			l183:
-				if !rules[rulegroupGraphPattern]() {
-					goto l180
+			l192:
+				if !_rules[rulegroupGraphPattern]() {
+					goto l189
 				}
 				depth--
-				add(rulewhereClause, position181)
+				add(rulewhereClause, position190)
 			}
 			return true
-		l180:
-			position, tokenIndex, depth = position180, tokenIndex180, depth180
+		l189:
+			position, tokenIndex, depth = position189, tokenIndex189, depth189
 			return false
 		},
 		/* 16 groupGraphPattern <- <(LBRACE (subSelect / graphPattern) RBRACE)> */
 		func() bool {
-			position195, tokenIndex195, depth195 := position, tokenIndex, depth
+			position204, tokenIndex204, depth204 := position, tokenIndex, depth
 			{
-				position196 := position
+				position205 := position
 				depth++
-				if !rules[ruleLBRACE]() {
-					goto l195
+				if !_rules[ruleLBRACE]() {
+					goto l204
 				}
 				{
-					position197, tokenIndex197, depth197 := position, tokenIndex, depth
-					if !rules[rulesubSelect]() {
-						goto l198
+					position206, tokenIndex206, depth206 := position, tokenIndex, depth
+					if !_rules[rulesubSelect]() {
+						goto l207
 					}
-					goto l197
-				l198:
-					position, tokenIndex, depth = position197, tokenIndex197, depth197
-					if !rules[rulegraphPattern]() {
-						goto l195
+					goto l206
+				l207:
+					position, tokenIndex, depth = position206, tokenIndex206, depth206
+					if !_rules[rulegraphPattern]() {
+						goto l204
 					}
 				}
-			l197:
-				if !rules[ruleRBRACE]() {
-					goto l195
+			l206:
+				if !_rules[ruleRBRACE]() {
+					goto l204
 				}
 				depth--
-				add(rulegroupGraphPattern, position196)
+				add(rulegroupGraphPattern, position205)
 			}
 			return true
-		l195:
-			position, tokenIndex, depth = position195, tokenIndex195, depth195
+		l204:
+			position, tokenIndex, depth = position204, tokenIndex204, depth204
 			return false
 		},
 		/* 17 graphPattern <- <(basicGraphPattern? (graphPatternNotTriples DOT? graphPattern)?)> */
 		func() bool {
 			{
-				position200 := position
+				position209 := position
 				depth++
 				{
-					position201, tokenIndex201, depth201 := position, tokenIndex, depth
+					position210, tokenIndex210, depth210 := position, tokenIndex, depth
 					{
-						position203 := position
+						position212 := position
 						depth++
 						{
-							position204, tokenIndex204, depth204 := position, tokenIndex, depth
-							if !rules[ruletriplesBlock]() {
-								goto l205
+							position213, tokenIndex213, depth213 := position, tokenIndex, depth
+							if !_rules[ruletriplesBlock]() {
+								goto l214
 							}
-						l206:
+						l215:
 							{
-								position207, tokenIndex207, depth207 := position, tokenIndex, depth
-								if !rules[rulefilterOrBind]() {
-									goto l207
+								position216, tokenIndex216, depth216 := position, tokenIndex, depth
+								if !_rules[rulefilterOrBind]() {
+									goto l216
 								}
 								{
-									position208, tokenIndex208, depth208 := position, tokenIndex, depth
-									if !rules[ruleDOT]() {
-										goto l208
+									position217, tokenIndex217, depth217 := position, tokenIndex, depth
+									if !_rules[ruleDOT]() {
+										goto l217
 									}
-									goto l209
-								l208:
-									position, tokenIndex, depth = position208, tokenIndex208, depth208
+									goto l218
+								l217:
+									position, tokenIndex, depth = position217, tokenIndex217, depth217
 								}
-							l209:
+							l218:
 								{
-									position210, tokenIndex210, depth210 := position, tokenIndex, depth
-									if !rules[ruletriplesBlock]() {
-										goto l210
-									}
-									goto l211
-								l210:
-									position, tokenIndex, depth = position210, tokenIndex210, depth210
-								}
-							l211:
-								goto l206
-							l207:
-								position, tokenIndex, depth = position207, tokenIndex207, depth207
-							}
-							goto l204
-						l205:
-							position, tokenIndex, depth = position204, tokenIndex204, depth204
-							if !rules[rulefilterOrBind]() {
-								goto l201
+									position219, tokenIndex219, depth219 := position, tokenIndex, depth
+									if !_rules[ruletriplesBlock]() {
+										goto l219
+									}
+									goto l220
+								l219:
+									position, tokenIndex, depth = position219, tokenIndex219, depth219
+								}
+							l220:
+								goto l215
+							l216:
+								position, tokenIndex, depth = position216, tokenIndex216, depth216
+							}
+							goto l213
+						l214:
+							position, tokenIndex, depth = position213, tokenIndex213, depth213
+							if !_rules[rulefilterOrBind]() {
+								goto l210
 							}
 							{
-								position214, tokenIndex214, depth214 := position, tokenIndex, depth
-								if !rules[ruleDOT]() {
-									goto l214
+								position223, tokenIndex223, depth223 := position, tokenIndex, depth
+								if !_rules[ruleDOT]() {
+									goto l223
 								}
-								goto l215
-							l214:
-								position, tokenIndex, depth = position214, tokenIndex214, depth214
+								goto l224
+							l223:
+								position, tokenIndex, depth = position223, tokenIndex223, depth223
 							}
-						l215:
+						l224:
 							{
-								position216, tokenIndex216, depth216 := position, tokenIndex, depth
-								if !rules[ruletriplesBlock]() {
-									goto l216
+								position225, tokenIndex225, depth225 := position, tokenIndex, depth
+								if !_rules[ruletriplesBlock]() {
+									goto l225
 								}
-								goto l217
-							l216:
-								position, tokenIndex, depth = position216, tokenIndex216, depth216
+								goto l226
+							l225:
+								position, tokenIndex, depth = position225, tokenIndex225, depth225
 							}
-						l217:
-						l212:
+						l226:
+						l221:
 							{
-								position213, tokenIndex213, depth213 := position, tokenIndex, depth
-								if !rules[rulefilterOrBind]() {
-									goto l213
+								position222, tokenIndex222, depth222 := position, tokenIndex, depth
+								if !_rules[rulefilterOrBind]() {
+									goto l222
 								}
 								{
-									position218, tokenIndex218, depth218 := position, tokenIndex, depth
-									if !rules[ruleDOT]() {
-										goto l218
+									position227, tokenIndex227, depth227 := position, tokenIndex, depth
+									if !_rules[ruleDOT]() {
+										goto l227
 									}
-									goto l219
-								l218:
-									position, tokenIndex, depth = position218, tokenIndex218, depth218
+									goto l228
+								l227:
+									position, tokenIndex, depth = position227, tokenIndex227, depth227
 								}
-							l219:
+							l228:
 								{
-									position220, tokenIndex220, depth220 := position, tokenIndex, depth
-									if !rules[ruletriplesBlock]() {
-										goto l220
+									position229, tokenIndex229, depth229 := position, tokenIndex, depth
+									if !_rules[ruletriplesBlock]() {
+										goto l229
 									}
-									goto l221
-								l220:
-									position, tokenIndex, depth = position220, tokenIndex220, depth220
+									goto l230
+								l229:
+									position, tokenIndex, depth = position229, tokenIndex229, depth229
 								}
-							l221:
-								goto l212
-							l213:
-								position, tokenIndex, depth = position213, tokenIndex213, depth213
+							l230:
+								goto l221
+							l222:
+								position, tokenIndex, depth = position222, tokenIndex222, depth222
 							}
 						}
-					l204:
+					l213:
 						depth--
-						add(rulebasicGraphPattern, position203)
+						add(rulebasicGraphPattern, position212)
 					}
-					goto l202
-				l201:
-					position, tokenIndex, depth = position201, tokenIndex201, depth201
+					goto l211
+				l210:
+					position, tokenIndex, depth = position210, tokenIndex210, depth210
 				}
-			l202:
+			l211:
 				{
-					position222, tokenIndex222, depth222 := position, tokenIndex, depth
+					position231, tokenIndex231, depth231 := position, tokenIndex, depth
 					{
-						position224 := position
+						position233 := position
 						depth++
 						{
-							position225, tokenIndex225, depth225 := position, tokenIndex, depth
+							position234, tokenIndex234, depth234 := position, tokenIndex, depth
 							{
-								position227 := position
+								position236 := position
 								depth++
 								{
-									position228 := position
+									position237 := position
 									depth++
 									{
-										position229, tokenIndex229, depth229 := position, tokenIndex, depth
+										position238, tokenIndex238, depth238 := position, tokenIndex, depth
 										if buffer[position] != rune('o') {
-											goto l230
+											goto l239
 										}
 										position++
-										goto l229
-									l230:
-										position, tokenIndex, depth = position229, tokenIndex229, depth229
+										goto l238
+									l239:
+										position, tokenIndex, depth = position238, tokenIndex238, depth238
 										if buffer[position] != rune('O') {
-											goto l226
+											goto l235
 										}
 										position++
 									}
-								l229:
+								l238:
 									{
-										position231, tokenIndex231, depth231 := position, tokenIndex, depth
+										position240, tokenIndex240, depth240 := position, tokenIndex, depth
 										if buffer[position] != rune('p') {
-											goto l232
+											goto l241
 										}
 										position++
-										goto l231
-									l232:
-										position, tokenIndex, depth = position231, tokenIndex231, depth231
+										goto l240
+									l241:
+										position, tokenIndex, depth = position240, tokenIndex240, depth240
 										if buffer[position] != rune('P') {
-											goto l226
+											goto l235
 										}
 										position++
 									}
-								l231:
+								l240:
 									{
-										position233, tokenIndex233, depth233 := position, tokenIndex, depth
+										position242, tokenIndex242, depth242 := position, tokenIndex, depth
 										if buffer[position] != rune('t') {
-											goto l234
+											goto l243
 										}
 										position++
-										goto l233
-									l234:
-										position, tokenIndex, depth = position233, tokenIndex233, depth233
+										goto l242
+									l243:
+										position, tokenIndex, depth = position242, tokenIndex242, depth242
 										if buffer[position] != rune('T') {
-											goto l226
+											goto l235
 										}
 										position++
 									}
-								l233:
+								l242:
 									{
-										position235, tokenIndex235, depth235 := position, tokenIndex, depth
+										position244, tokenIndex244, depth244 := position, tokenIndex, depth
 										if buffer[position] != rune('i') {
-											goto l236
+											goto l245
 										}
 										position++
-										goto l235
-									l236:
-										position, tokenIndex, depth = position235, tokenIndex235, depth235
+										goto l244
+									l245:
+										position, tokenIndex, depth = position244, tokenIndex244, depth244
 										if buffer[position] != rune('I') {
-											goto l226
+											goto l235
 										}
 										position++
 									}
-								l235:
+								l244:
 									{
-										position237, tokenIndex237, depth237 := position, tokenIndex, depth
+										position246, tokenIndex246, depth246 := position, tokenIndex, depth
 										if buffer[position] != rune('o') {
-											goto l238
+											goto l247
 										}
 										position++
-										goto l237
-									l238:
-										position, tokenIndex, depth = position237, tokenIndex237, depth237
+										goto l246
+									l247:
+										position, tokenIndex, depth = position246, tokenIndex246, depth246
 										if buffer[position] != rune('O') {
-											goto l226
+											goto l235
 										}
 										position++
 									}
-								l237:
+								l246:
 									{
-										position239, tokenIndex239, depth239 := position, tokenIndex, depth
+										position248, tokenIndex248, depth248 := position, tokenIndex, depth
 										if buffer[position] != rune('n') {
-											goto l240
+											goto l249
 										}
 										position++
-										goto l239
-									l240:
-										position, tokenIndex, depth = position239, tokenIndex239, depth239
+										goto l248
+									l249:
+										position, tokenIndex, depth = position248, tokenIndex248, depth248
 										if buffer[position] != rune('N') {
-											goto l226
+											goto l235
 										}
 										position++
 									}
-								l239:
+								l248:
 									{
-										position241, tokenIndex241, depth241 := position, tokenIndex, depth
+										position250, tokenIndex250, depth250 := position, tokenIndex, depth
 										if buffer[position] != rune('a') {
-											goto l242
+											goto l251
 										}
 										position++
-										goto l241
-									l242:
-										position, tokenIndex, depth = position241, tokenIndex241, depth241
+										goto l250
+									l251:
+										position, tokenIndex, depth = position250, tokenIndex250, depth250
 										if buffer[position] != rune('A') {
-											goto l226
+											goto l235
 										}
 										position++
 									}
-								l241:
+								l250:
 									{
-										position243, tokenIndex243, depth243 := position, tokenIndex, depth
+										position252, tokenIndex252, depth252 := position, tokenIndex, depth
 										if buffer[position] != rune('l') {
-											goto l244
+											goto l253
 										}
 										position++
-										goto l243
-									l244:
-										position, tokenIndex, depth = position243, tokenIndex243, depth243
+										goto l252
+									l253:
+										position, tokenIndex, depth = position252, tokenIndex252, depth252
 										if buffer[position] != rune('L') {
-											goto l226
+											goto l235
 										}
 										position++
 									}
-								l243:
-									if !rules[ruleskip]() {
-										goto l226
+								l252:
+									if !_rules[ruleskip]() {
+										goto l235
 									}
 									depth--
-									add(ruleOPTIONAL, position228)
+									add(ruleOPTIONAL, position237)
 								}
-								if !rules[ruleLBRACE]() {
-									goto l226
+								if !_rules[ruleLBRACE]() {
+									goto l235
 								}
 								{
-									position245, tokenIndex245, depth245 := position, tokenIndex, depth
-									if !rules[rulesubSelect]() {
-										goto l246
+									position254, tokenIndex254, depth254 := position, tokenIndex, depth
+									if !_rules[rulesubSelect]() {
+										goto l255
 									}
-									goto l245
-								l246:
-									position, tokenIndex, depth = position245, tokenIndex245, depth245
-									if !rules[rulegraphPattern]() {
-										goto l226
+									goto l254
+								l255:
+									position, tokenIndex, depth = position254, tokenIndex254, depth254
+									if !_rules[rulegraphPattern]() {
+										goto l235
 									}
 								}
-							l245:
-								if !rules[ruleRBRACE]() {
-									goto l226
+							l254:
+								if !_rules[ruleRBRACE]() {
+									goto l235
 								}
 								depth--
-								add(ruleoptionalGraphPattern, position227)
+								add(ruleoptionalGraphPattern, position236)
 							}
-							goto l225
-						l226:
-							position, tokenIndex, depth = position225, tokenIndex225, depth225
-							if !rules[rulegroupOrUnionGraphPattern]() {
-								goto l247
+							goto l234
+						l235:
+							position, tokenIndex, depth = position234, tokenIndex234, depth234
+							if !_rules[rulegroupOrUnionGraphPattern]() {
+								goto l256
 							}
-							goto l225
-						l247:
-							position, tokenIndex, depth = position225, tokenIndex225, depth225
+							goto l234
+						l256:
+							position, tokenIndex, depth = position234, tokenIndex234, depth234
 							{
-								position249 := position
+								position258 := position
 								depth++
 								{
-									position250 := position
+									position259 := position
 									depth++
 									{
-										position251, tokenIndex251, depth251 := position, tokenIndex, depth
+										position260, tokenIndex260, depth260 := position, tokenIndex, depth
 										if buffer[position] != rune('g') {
-											goto l252
+											goto l261
 										}
 										position++
-										goto l251
-									l252:
-										position, tokenIndex, depth = position251, tokenIndex251, depth251
+										goto l260
+									l261:
+										position, tokenIndex, depth = position260, tokenIndex260, depth260
 										if buffer[position] != rune('G') {
-											goto l248
+											goto l257
 										}
 										position++
 									}
-								l251:
+								l260:
 									{
-										position253, tokenIndex253, depth253 := position, tokenIndex, depth
+										position262, tokenIndex262, depth262 := position, tokenIndex, depth
 										if buffer[position] != rune('r') {
-											goto l254
+											goto l263
 										}
 										position++
-										goto l253
-									l254:
-										position, tokenIndex, depth = position253, tokenIndex253, depth253
+										goto l262
+									l263:
+										position, tokenIndex, depth = position262, tokenIndex262, depth262
 										if buffer[position] != rune('R') {
-											goto l248
+											goto l257
 										}
 										position++
 									}
-								l253:
+								l262:
 									{
-										position255, tokenIndex255, depth255 := position, tokenIndex, depth
+										position264, tokenIndex264, depth264 := position, tokenIndex, depth
 										if buffer[position] != rune('a') {
-											goto l256
+											goto l265
 										}
 										position++
-										goto l255
-									l256:
-										position, tokenIndex, depth = position255, tokenIndex255, depth255
+										goto l264
+									l265:
+										position, tokenIndex, depth = position264, tokenIndex264, depth264
 										if buffer[position] != rune('A') {
-											goto l248
+											goto l257
 										}
 										position++
 									}
-								l255:
+								l264:
 									{
-										position257, tokenIndex257, depth257 := position, tokenIndex, depth
+										position266, tokenIndex266, depth266 := position, tokenIndex, depth
 										if buffer[position] != rune('p') {
-											goto l258
+											goto l267
 										}
 										position++
-										goto l257
-									l258:
-										position, tokenIndex, depth = position257, tokenIndex257, depth257
+										goto l266
+									l267:
+										position, tokenIndex, depth = position266, tokenIndex266, depth266
 										if buffer[position] != rune('P') {
-											goto l248
+											goto l257
 										}
 										position++
 									}
-								l257:
+								l266:
 									{
-										position259, tokenIndex259, depth259 := position, tokenIndex, depth
+										position268, tokenIndex268, depth268 := position, tokenIndex, depth
 										if buffer[position] != rune('h') {
-											goto l260
+											goto l269
 										}
 										position++
-										goto l259
-									l260:
-										position, tokenIndex, depth = position259, tokenIndex259, depth259
+										goto l268
+									l269:
+										position, tokenIndex, depth = position268, tokenIndex268, depth268
 										if buffer[position] != rune('H') {
-											goto l248
+											goto l257
 										}
 										position++
 									}
-								l259:
-									if !rules[ruleskip]() {
-										goto l248
+								l268:
+									if !_rules[ruleskip]() {
+										goto l257
 									}
 									depth--
-									add(ruleGRAPH, position250)
+									add(ruleGRAPH, position259)
 								}
 								{
-									position261, tokenIndex261, depth261 := position, tokenIndex, depth
-									if !rules[rulevar]() {
-										goto l262
-									}
-									goto l261
-								l262:
-									position, tokenIndex, depth = position261, tokenIndex261, depth261
-									if !rules[ruleiriref]() {
-										goto l248
+									position270, tokenIndex270, depth270 := position, tokenIndex, depth
+									if !_rules[rulevar]() {
+										goto l271
+									}
+									goto l270
+								l271:
+									position, tokenIndex, depth = position270, tokenIndex270, depth270
+									if !_rules[ruleiriref]() {
+										goto l257
 									}
 								}
-							l261:
-								if !rules[rulegroupGraphPattern]() {
-									goto l248
+							l270:
+								if !_rules[rulegroupGraphPattern]() {
+									goto l257
 								}
 								depth--
-								add(rulegraphGraphPattern, position249)
+								add(rulegraphGraphPattern, position258)
 							}
-							goto l225
-						l248:
-							position, tokenIndex, depth = position225, tokenIndex225, depth225
+							goto l234
+						l257:
+							position, tokenIndex, depth = position234, tokenIndex234, depth234
 							{
-								position264 := position
+								position273 := position
 								depth++
 								{
-									position265 := position
+									position274 := position
 									depth++
 									{
-										position266, tokenIndex266, depth266 := position, tokenIndex, depth
+										position275, tokenIndex275, depth275 := position, tokenIndex, depth
 										if buffer[position] != rune('m') {
-											goto l267
+											goto l276
 										}
 										position++
-										goto l266
-									l267:
-										position, tokenIndex, depth = position266, tokenIndex266, depth266
+										goto l275
+									l276:
+										position, tokenIndex, depth = position275, tokenIndex275, depth275
 										if buffer[position] != rune('M') {
-											goto l263
+											goto l272
 										}
 										position++
 									}
-								l266:
+								l275:
 									{
-										position268, tokenIndex268, depth268 := position, tokenIndex, depth
+										position277, tokenIndex277, depth277 := position, tokenIndex, depth
 										if buffer[position] != rune('i') {
-											goto l269
+											goto l278
 										}
 										position++
-										goto l268
-									l269:
-										position, tokenIndex, depth = position268, tokenIndex268, depth268
+										goto l277
+									l278:
+										position, tokenIndex, depth = position277, tokenIndex277, depth277
 										if buffer[position] != rune('I') {
-											goto l263
+											goto l272
 										}
 										position++
 									}
-								l268:
+								l277:
 									{
-										position270, tokenIndex270, depth270 := position, tokenIndex, depth
+										position279, tokenIndex279, depth279 := position, tokenIndex, depth
 										if buffer[position] != rune('n') {
-											goto l271
+											goto l280
 										}
 										position++
-										goto l270
-									l271:
-										position, tokenIndex, depth = position270, tokenIndex270, depth270
+										goto l279
+									l280:
+										position, tokenIndex, depth = position279, tokenIndex279, depth279
 										if buffer[position] != rune('N') {
-											goto l263
+											goto l272
 										}
 										position++
 									}
-								l270:
+								l279:
 									{
-										position272, tokenIndex272, depth272 := position, tokenIndex, depth
+										position281, tokenIndex281, depth281 := position, tokenIndex, depth
 										if buffer[position] != rune('u') {
-											goto l273
+											goto l282
 										}
 										position++
-										goto l272
-									l273:
-										position, tokenIndex, depth = position272, tokenIndex272, depth272
+										goto l281
+									l282:
+										position, tokenIndex, depth = position281, tokenIndex281, depth281
 										if buffer[position] != rune('U') {
-											goto l263
+											goto l272
 										}
 										position++
 									}
-								l272:
+								l281:
 									{
-										position274, tokenIndex274, depth274 := position, tokenIndex, depth
+										position283, tokenIndex283, depth283 := position, tokenIndex, depth
 										if buffer[position] != rune('s') {
-											goto l275
+											goto l284
 										}
 										position++
-										goto l274
-									l275:
-										position, tokenIndex, depth = position274, tokenIndex274, depth274
+										goto l283
+									l284:
+										position, tokenIndex, depth = position283, tokenIndex283, depth283
 										if buffer[position] != rune('S') {
-											goto l263
+											goto l272
 										}
 										position++
 									}
-								l274:
-									if !rules[ruleskip]() {
-										goto l263
+								l283:
+									if !_rules[ruleskip]() {
+										goto l272
 									}
 									depth--
-									add(ruleMINUSSETOPER, position265)
+									add(ruleMINUSSETOPER, position274)
 								}
-								if !rules[rulegroupGraphPattern]() {
-									goto l263
+								if !_rules[rulegroupGraphPattern]() {
+									goto l272
 								}
 								depth--
-								add(ruleminusGraphPattern, position264)
+								add(ruleminusGraphPattern, position273)
 							}
-							goto l225
-						l263:
-							position, tokenIndex, depth = position225, tokenIndex225, depth225
+							goto l234
+						l272:
+							position, tokenIndex, depth = position234, tokenIndex234, depth234
 							{
-								position276 := position
+								position286 := position
 								depth++
 								{
-									position277 := position
+									position287 := position
 									depth++
-									depth--
-									add(ruleSERVICE, position277)
-								}
-								{
-									position278, tokenIndex278, depth278 := position, tokenIndex, depth
 									{
-										position280 := position
-										depth++
-										depth--
-										add(ruleSILENT, position280)
+										position288, tokenIndex288, depth288 := position, tokenIndex, depth
+										if buffer[position] != rune('s') {
+											goto l289
+										}
+										position++
+										goto l288
+									l289:
+										position, tokenIndex, depth = position288, tokenIndex288, depth288
+										if buffer[position] != rune('S') {
+											goto l285
+										}
+										position++
 									}
-									goto l279
-
-									position, tokenIndex, depth = position278, tokenIndex278, depth278
-								}
-							l279:
-								{
-									position281, tokenIndex281, depth281 := position, tokenIndex, depth
-									if !rules[rulevar]() {
-										goto l282
+								l288:
+									{
+										position290, tokenIndex290, depth290 := position, tokenIndex, depth
+										if buffer[position] != rune('e') {
+											goto l291
+										}
+										position++
+										goto l290
+									l291:
+										position, tokenIndex, depth = position290, tokenIndex290, depth290
+										if buffer[position] != rune('E') {
+											goto l285
+										}
+										position++
+									}
+								l290:
+									{
+										position292, tokenIndex292, depth292 := position, tokenIndex, depth
+										if buffer[position] != rune('r') {
+											goto l293
+										}
+										position++
+										goto l292
+									l293:
+										position, tokenIndex, depth = position292, tokenIndex292, depth292
+										if buffer[position] != rune('R') {
+											goto l285
+										}
+										position++
 									}
-									goto l281
-								l282:
-									position, tokenIndex, depth = position281, tokenIndex281, depth281
-									if !rules[ruleiriref]() {
-										goto l222
+								l292:
+									{
+										position294, tokenIndex294, depth294 := position, tokenIndex, depth
+										if buffer[position] != rune('v') {
+											goto l295
+										}
+										position++
+										goto l294
+									l295:
+										position, tokenIndex, depth = position294, tokenIndex294, depth294
+										if buffer[position] != rune('V') {
+											goto l285
+										}
+										position++
 									}
+								l294:
+									{
+										position296, tokenIndex296, depth296 := position, tokenIndex, depth
+										if buffer[position] != rune('i') {
+											goto l297
+										}
+										position++
+										goto l296
+									l297:
+										position, tokenIndex, depth = position296, tokenIndex296, depth296
+										if buffer[position] != rune('I') {
+											goto l285
+										}
+										position++
+									}
+								l296:
+									{
+										position298, tokenIndex298, depth298 := position, tokenIndex, depth
+										if buffer[position] != rune('c') {
+											goto l299
+										}
+										position++
+										goto l298
+									l299:
+										position, tokenIndex, depth = position298, tokenIndex298, depth298
+										if buffer[position] != rune('C') {
+											goto l285
+										}
+										position++
+									}
+								l298:
+									{
+										position300, tokenIndex300, depth300 := position, tokenIndex, depth
+										if buffer[position] != rune('e') {
+											goto l301
+										}
+										position++
+										goto l300
+									l301:
+										position, tokenIndex, depth = position300, tokenIndex300, depth300
+										if buffer[position] != rune('E') {
+											goto l285
+										}
+										position++
+									}
+								l300:
+									if !_rules[ruleskip]() {
+										goto l285
+									}
+									depth--
+									add(ruleSERVICE, position287)
 								}
-							l281:
-								if !rules[rulegroupGraphPattern]() {
-									goto l222
-								}
-								depth--
-								add(ruleserviceGraphPattern, position276)
-							}
-						}
-					l225:
-						depth--
-						add(rulegraphPatternNotTriples, position224)
-					}
-					{
-						position283, tokenIndex283, depth283 := position, tokenIndex, depth
-						if !rules[ruleDOT]() {
-							goto l283
-						}
-						goto l284
-					l283:
-						position, tokenIndex, depth = position283, tokenIndex283, depth283
-					}
-				l284:
-					if !rules[rulegraphPattern]() {
-						goto l222
-					}
-					goto l223
-				l222:
-					position, tokenIndex, depth = position222, tokenIndex222, depth222
-				}
-			l223:
-				depth--
-				add(rulegraphPattern, position200)
-			}
-			return true
-		},
-		/* 18 graphPatternNotTriples <- <(optionalGraphPattern / groupOrUnionGraphPattern / graphGraphPattern / minusGraphPattern / serviceGraphPattern)> */
-		nil,
-		/* 19 serviceGraphPattern <- <(SERVICE SILENT? (var / iriref) groupGraphPattern)> */
-		nil,
-		/* 20 optionalGraphPattern <- <(OPTIONAL LBRACE (subSelect / graphPattern) RBRACE)> */
-		nil,
-		/* 21 groupOrUnionGraphPattern <- <(groupGraphPattern (UNION groupOrUnionGraphPattern)?)> */
-		func() bool {
-			position288, tokenIndex288, depth288 := position, tokenIndex, depth
-			{
-				position289 := position
+								{
+									position302, tokenIndex302, depth302 := position, tokenIndex, depth
+									{
+										position304 := position
+										depth++
+										{
+											position305, tokenIndex305, depth305 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l306
+											}
+											position++
+											goto l305
+										l306:
+											position, tokenIndex, depth = position305, tokenIndex305, depth305
+											if buffer[position] != rune('S') {
+												goto l302
+											}
+											position++
+										}
+									l305:
+										{
+											position307, tokenIndex307, depth307 := position, tokenIndex, depth
+											if buffer[position] != rune('i') {
+												goto l308
+											}
+											position++
+											goto l307
+										l308:
+											position, tokenIndex, depth = position307, tokenIndex307, depth307
+											if buffer[position] != rune('I') {
+												goto l302
+											}
+											position++
+										}
+									l307:
+										{
+											position309, tokenIndex309, depth309 := position, tokenIndex, depth
+											if buffer[position] != rune('l') {
+												goto l310
+											}
+											position++
+											goto l309
+										l310:
+											position, tokenIndex, depth = position309, tokenIndex309, depth309
+											if buffer[position] != rune('L') {
+												goto l302
+											}
+											position++
+										}
+									l309:
+										{
+											position311, tokenIndex311, depth311 := position, tokenIndex, depth
+											if buffer[position] != rune('e') {
+												goto l312
+											}
+											position++
+											goto l311
+										l312:
+											position, tokenIndex, depth = position311, tokenIndex311, depth311
+											if buffer[position] != rune('E') {
+												goto l302
+											}
+											position++
+										}
+									l311:
+										{
+											position313, tokenIndex313, depth313 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l314
+											}
+											position++
+											goto l313
+										l314:
+											position, tokenIndex, depth = position313, tokenIndex313, depth313
+											if buffer[position] != rune('N') {
+												goto l302
+											}
+											position++
+										}
+									l313:
+										{
+											position315, tokenIndex315, depth315 := position, tokenIndex, depth
+											if buffer[position] != rune('t') {
+												goto l316
+											}
+											position++
+											goto l315
+										l316:
+											position, tokenIndex, depth = position315, tokenIndex315, depth315
+											if buffer[position] != rune('T') {
+												goto l302
+											}
+											position++
+										}
+									l315:
+										if !_rules[ruleskip]() {
+											goto l302
+										}
+										depth--
+										add(ruleSILENT, position304)
+									}
+									goto l303
+								l302:
+									position, tokenIndex, depth = position302, tokenIndex302, depth302
+								}
+							l303:
+								{
+									position317, tokenIndex317, depth317 := position, tokenIndex, depth
+									if !_rules[rulevar]() {
+										goto l318
+									}
+									goto l317
+								l318:
+									position, tokenIndex, depth = position317, tokenIndex317, depth317
+									if !_rules[ruleiriref]() {
+										goto l285
+									}
+								}
+							l317:
+								if !_rules[rulegroupGraphPattern]() {
+									goto l285
+								}
+								depth--
+								add(ruleserviceGraphPattern, position286)
+							}
+							goto l234
+						l285:
+							position, tokenIndex, depth = position234, tokenIndex234, depth234
+							if !_rules[ruleinlineData]() {
+								goto l231
+							}
+						}
+					l234:
+						depth--
+						add(rulegraphPatternNotTriples, position233)
+					}
+					{
+						position319, tokenIndex319, depth319 := position, tokenIndex, depth
+						if !_rules[ruleDOT]() {
+							goto l319
+						}
+						goto l320
+					l319:
+						position, tokenIndex, depth = position319, tokenIndex319, depth319
+					}
+				l320:
+					if !_rules[rulegraphPattern]() {
+						goto l231
+					}
+					goto l232
+				l231:
+					position, tokenIndex, depth = position231, tokenIndex231, depth231
+				}
+			l232:
+				depth--
+				add(rulegraphPattern, position209)
+			}
+			return true
+		},
+		/* 18 graphPatternNotTriples <- <(optionalGraphPattern / groupOrUnionGraphPattern / graphGraphPattern / minusGraphPattern / serviceGraphPattern / inlineData)> */
+		nil,
+		/* 19 serviceGraphPattern <- <(SERVICE SILENT? (var / iriref) groupGraphPattern)> */
+		nil,
+		/* 20 optionalGraphPattern <- <(OPTIONAL LBRACE (subSelect / graphPattern) RBRACE)> */
+		nil,
+		/* 21 groupOrUnionGraphPattern <- <(groupGraphPattern (UNION groupOrUnionGraphPattern)?)> */
+		func() bool {
+			position324, tokenIndex324, depth324 := position, tokenIndex, depth
+			{
+				position325 := position
 				depth++
-				if !rules[rulegroupGraphPattern]() {
-					goto l288
+				if !_rules[rulegroupGraphPattern]() {
+					goto l324
 				}
 				{
-					position290, tokenIndex290, depth290 := position, tokenIndex, depth
+					position326, tokenIndex326, depth326 := position, tokenIndex, depth
 					{
-						position292 := position
+						position328 := position
 						depth++
 						{
-							position293, tokenIndex293, depth293 := position, tokenIndex, depth
+							position329, tokenIndex329, depth329 := position, tokenIndex, depth
 							if buffer[position] != rune('u') {
-								goto l294
+								goto l330
 							}
 							position++
-							goto l293
-						l294:
-							position, tokenIndex, depth = position293, tokenIndex293, depth293
+							goto l329
+						l330:
+							position, tokenIndex, depth = position329, tokenIndex329, depth329
 							if buffer[position] != rune('U') {
-								goto l290
+								goto l326
 							}
 							position++
 						}
-					l293:
+					l329:
 						{
-							position295, tokenIndex295, depth295 := position, tokenIndex, depth
+							position331, tokenIndex331, depth331 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l296
+								goto l332
 							}
 							position++
-							goto l295
-						l296:
-							position, tokenIndex, depth = position295, tokenIndex295, depth295
+							goto l331
+						l332:
+							position, tokenIndex, depth = position331, tokenIndex331, depth331
 							if buffer[position] != rune('N') {
-								goto l290
+								goto l326
 							}
 							position++
 						}
-					l295:
+					l331:
 						{
-							position297, tokenIndex297, depth297 := position, tokenIndex, depth
+							position333, tokenIndex333, depth333 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l298
+								goto l334
 							}
 							position++
-							goto l297
-						l298:
-							position, tokenIndex, depth = position297, tokenIndex297, depth297
+							goto l333
+						l334:
+							position, tokenIndex, depth = position333, tokenIndex333, depth333
 							if buffer[position] != rune('I') {
-								goto l290
+								goto l326
 							}
 							position++
 						}
-					l297:
+					l333:
 						{
-							position299, tokenIndex299, depth299 := position, tokenIndex, depth
+							position335, tokenIndex335, depth335 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l300
+								goto l336
 							}
 							position++
-							goto l299
-						l300:
-							position, tokenIndex, depth = position299, tokenIndex299, depth299
+							goto l335
+						l336:
+							position, tokenIndex, depth = position335, tokenIndex335, depth335
 							if buffer[position] != rune('O') {
-								goto l290
+								goto l326
 							}
 							position++
 						}
-					l299:
+					l335:
 						{
-							position301, tokenIndex301, depth301 := position, tokenIndex, depth
+							position337, tokenIndex337, depth337 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l302
+								goto l338
 							}
 							position++
-							goto l301
-						l302:
-							position, tokenIndex, depth = position301, tokenIndex301, depth301
+							goto l337
+						l338:
+							position, tokenIndex, depth = position337, tokenIndex337, depth337
 							if buffer[position] != rune('N') {
-								goto l290
+								goto l326
 							}
 							position++
 						}
-					l301:
-						if !rules[ruleskip]() {
-							goto l290
+					l337:
+						if !_rules[ruleskip]() {
+							goto l326
 						}
 						depth--
-						add(ruleUNION, position292)
+						add(ruleUNION, position328)
 					}
-					if !rules[rulegroupOrUnionGraphPattern]() {
-						goto l290
+					if !_rules[rulegroupOrUnionGraphPattern]() {
+						goto l326
 					}
-					goto l291
-				l290:
-					position, tokenIndex, depth = position290, tokenIndex290, depth290
+					goto l327
+				l326:
+					position, tokenIndex, depth = position326, tokenIndex326, depth326
 				}
-			l291:
+			l327:
 				depth--
-				add(rulegroupOrUnionGraphPattern, position289)
+				add(rulegroupOrUnionGraphPattern, position325)
 			}
 			return true
-		l288:
-			position, tokenIndex, depth = position288, tokenIndex288, depth288
+		l324:
+			position, tokenIndex, depth = position324, tokenIndex324, depth324
 			return false
 		},
 		/* 22 graphGraphPattern <- <(GRAPH (var / iriref) groupGraphPattern)> */
@@ -3276,11728 +3271,12345 @@ func (p *Sparql) Init() {
 		nil,
 		/* 25 filterOrBind <- <((FILTER constraint) / (BIND LPAREN expression AS var RPAREN))> */
 		func() bool {
-			position306, tokenIndex306, depth306 := position, tokenIndex, depth
+			position342, tokenIndex342, depth342 := position, tokenIndex, depth
 			{
-				position307 := position
+				position343 := position
 				depth++
 				{
-					position308, tokenIndex308, depth308 := position, tokenIndex, depth
+					position344, tokenIndex344, depth344 := position, tokenIndex, depth
 					{
-						position310 := position
+						position346 := position
 						depth++
 						{
-							position311, tokenIndex311, depth311 := position, tokenIndex, depth
+							position347, tokenIndex347, depth347 := position, tokenIndex, depth
 							if buffer[position] != rune('f') {
-								goto l312
+								goto l348
 							}
 							position++
-							goto l311
-						l312:
-							position, tokenIndex, depth = position311, tokenIndex311, depth311
+							goto l347
+						l348:
+							position, tokenIndex, depth = position347, tokenIndex347, depth347
 							if buffer[position] != rune('F') {
-								goto l309
+								goto l345
 							}
 							position++
 						}
-					l311:
+					l347:
 						{
-							position313, tokenIndex313, depth313 := position, tokenIndex, depth
+							position349, tokenIndex349, depth349 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l314
+								goto l350
 							}
 							position++
-							goto l313
-						l314:
-							position, tokenIndex, depth = position313, tokenIndex313, depth313
+							goto l349
+						l350:
+							position, tokenIndex, depth = position349, tokenIndex349, depth349
 							if buffer[position] != rune('I') {
-								goto l309
+								goto l345
 							}
 							position++
 						}
-					l313:
+					l349:
 						{
-							position315, tokenIndex315, depth315 := position, tokenIndex, depth
+							position351, tokenIndex351, depth351 := position, tokenIndex, depth
 							if buffer[position] != rune('l') {
-								goto l316
+								goto l352
 							}
 							position++
-							goto l315
-						l316:
-							position, tokenIndex, depth = position315, tokenIndex315, depth315
+							goto l351
+						l352:
+							position, tokenIndex, depth = position351, tokenIndex351, depth351
 							if buffer[position] != rune('L') {
-								goto l309
+								goto l345
 							}
 							position++
 						}
-					l315:
+					l351:
 						{
-							position317, tokenIndex317, depth317 := position, tokenIndex, depth
+							position353, tokenIndex353, depth353 := position, tokenIndex, depth
 							if buffer[position] != rune('t') {
-								goto l318
+								goto l354
 							}
 							position++
-							goto l317
-						l318:
-							position, tokenIndex, depth = position317, tokenIndex317, depth317
+							goto l353
+						l354:
+							position, tokenIndex, depth = position353, tokenIndex353, depth353
 							if buffer[position] != rune('T') {
-								goto l309
+								goto l345
 							}
 							position++
 						}
-					l317:
+					l353:
 						{
-							position319, tokenIndex319, depth319 := position, tokenIndex, depth
+							position355, tokenIndex355, depth355 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l320
+								goto l356
 							}
 							position++
-							goto l319
-						l320:
-							position, tokenIndex, depth = position319, tokenIndex319, depth319
+							goto l355
+						l356:
+							position, tokenIndex, depth = position355, tokenIndex355, depth355
 							if buffer[position] != rune('E') {
-								goto l309
+								goto l345
 							}
 							position++
 						}
-					l319:
+					l355:
 						{
-							position321, tokenIndex321, depth321 := position, tokenIndex, depth
+							position357, tokenIndex357, depth357 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l322
+								goto l358
 							}
 							position++
-							goto l321
-						l322:
-							position, tokenIndex, depth = position321, tokenIndex321, depth321
+							goto l357
+						l358:
+							position, tokenIndex, depth = position357, tokenIndex357, depth357
 							if buffer[position] != rune('R') {
-								goto l309
+								goto l345
 							}
 							position++
 						}
-					l321:
-						if !rules[ruleskip]() {
-							goto l309
+					l357:
+						if !_rules[ruleskip]() {
+							goto l345
 						}
 						depth--
-						add(ruleFILTER, position310)
+						add(ruleFILTER, position346)
 					}
-					if !rules[ruleconstraint]() {
-						goto l309
+					if !_rules[ruleconstraint]() {
+						goto l345
 					}
-					goto l308
-				l309:
-					position, tokenIndex, depth = position308, tokenIndex308, depth308
+					goto l344
+				l345:
+					position, tokenIndex, depth = position344, tokenIndex344, depth344
 					{
-						position323 := position
+						position359 := position
 						depth++
 						{
-							position324, tokenIndex324, depth324 := position, tokenIndex, depth
+							position360, tokenIndex360, depth360 := position, tokenIndex, depth
 							if buffer[position] != rune('b') {
-								goto l325
+								goto l361
 							}
 							position++
-							goto l324
-						l325:
-							position, tokenIndex, depth = position324, tokenIndex324, depth324
+							goto l360
+						l361:
+							position, tokenIndex, depth = position360, tokenIndex360, depth360
 							if buffer[position] != rune('B') {
-								goto l306
+								goto l342
 							}
 							position++
 						}
-					l324:
+					l360:
 						{
-							position326, tokenIndex326, depth326 := position, tokenIndex, depth
+							position362, tokenIndex362, depth362 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l327
+								goto l363
 							}
 							position++
-							goto l326
-						l327:
-							position, tokenIndex, depth = position326, tokenIndex326, depth326
+							goto l362
+						l363:
+							position, tokenIndex, depth = position362, tokenIndex362, depth362
 							if buffer[position] != rune('I') {
-								goto l306
+								goto l342
 							}
 							position++
 						}
-					l326:
+					l362:
 						{
-							position328, tokenIndex328, depth328 := position, tokenIndex, depth
+							position364, tokenIndex364, depth364 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l329
+								goto l365
 							}
 							position++
-							goto l328
-						l329:
-							position, tokenIndex, depth = position328, tokenIndex328, depth328
+							goto l364
+						l365:
+							position, tokenIndex, depth = position364, tokenIndex364, depth364
 							if buffer[position] != rune('N') {
-								goto l306
+								goto l342
 							}
 							position++
 						}
-					l328:
+					l364:
 						{
-							position330, tokenIndex330, depth330 := position, tokenIndex, depth
+							position366, tokenIndex366, depth366 := position, tokenIndex, depth
 							if buffer[position] != rune('d') {
-								goto l331
+								goto l367
 							}
 							position++
-							goto l330
-						l331:
-							position, tokenIndex, depth = position330, tokenIndex330, depth330
+							goto l366
+						l367:
+							position, tokenIndex, depth = position366, tokenIndex366, depth366
 							if buffer[position] != rune('D') {
-								goto l306
+								goto l342
 							}
 							position++
 						}
-					l330:
-						if !rules[ruleskip]() {
-							goto l306
+					l366:
+						if !_rules[ruleskip]() {
+							goto l342
 						}
 						depth--
-						add(ruleBIND, position323)
+						add(ruleBIND, position359)
 					}
-					if !rules[ruleLPAREN]() {
-						goto l306
+					if !_rules[ruleLPAREN]() {
+						goto l342
 					}
-					if !rules[ruleexpression]() {
-						goto l306
+					if !_rules[ruleexpression]() {
+						goto l342
 					}
-					if !rules[ruleAS]() {
-						goto l306
+					if !_rules[ruleAS]() {
+						goto l342
 					}
-					if !rules[rulevar]() {
-						goto l306
+					if !_rules[rulevar]() {
+						goto l342
 					}
-					if !rules[ruleRPAREN]() {
-						goto l306
+					if !_rules[ruleRPAREN]() {
+						goto l342
 					}
 				}
-			l308:
+			l344:
 				depth--
-				add(rulefilterOrBind, position307)
+				add(rulefilterOrBind, position343)
 			}
 			return true
-		l306:
-			position, tokenIndex, depth = position306, tokenIndex306, depth306
+		l342:
+			position, tokenIndex, depth = position342, tokenIndex342, depth342
 			return false
 		},
 		/* 26 constraint <- <(brackettedExpression / builtinCall / functionCall)> */
 		func() bool {
-			position332, tokenIndex332, depth332 := position, tokenIndex, depth
+			position368, tokenIndex368, depth368 := position, tokenIndex, depth
 			{
-				position333 := position
+				position369 := position
 				depth++
 				{
-					position334, tokenIndex334, depth334 := position, tokenIndex, depth
-					if !rules[rulebrackettedExpression]() {
-						goto l335
+					position370, tokenIndex370, depth370 := position, tokenIndex, depth
+					if !_rules[rulebrackettedExpression]() {
+						goto l371
 					}
-					goto l334
-				l335:
-					position, tokenIndex, depth = position334, tokenIndex334, depth334
-					if !rules[rulebuiltinCall]() {
-						goto l336
+					goto l370
+				l371:
+					position, tokenIndex, depth = position370, tokenIndex370, depth370
+					if !_rules[rulebuiltinCall]() {
+						goto l372
 					}
-					goto l334
-				l336:
-					position, tokenIndex, depth = position334, tokenIndex334, depth334
-					if !rules[rulefunctionCall]() {
-						goto l332
+					goto l370
+				l372:
+					position, tokenIndex, depth = position370, tokenIndex370, depth370
+					if !_rules[rulefunctionCall]() {
+						goto l368
 					}
 				}
-			l334:
+			l370:
 				depth--
-				add(ruleconstraint, position333)
+				add(ruleconstraint, position369)
 			}
 			return true
-		l332:
-			position, tokenIndex, depth = position332, tokenIndex332, depth332
+		l368:
+			position, tokenIndex, depth = position368, tokenIndex368, depth368
 			return false
 		},
 		/* 27 triplesBlock <- <(triplesSameSubjectPath (DOT triplesSameSubjectPath)* DOT?)> */
 		func() bool {
-			position337, tokenIndex337, depth337 := position, tokenIndex, depth
+			position373, tokenIndex373, depth373 := position, tokenIndex, depth
 			{
-				position338 := position
+				position374 := position
 				depth++
-				if !rules[ruletriplesSameSubjectPath]() {
-					goto l337
+				if !_rules[ruletriplesSameSubjectPath]() {
+					goto l373
 				}
-			l339:
+			l375:
 				{
-					position340, tokenIndex340, depth340 := position, tokenIndex, depth
-					if !rules[ruleDOT]() {
-						goto l340
+					position376, tokenIndex376, depth376 := position, tokenIndex, depth
+					if !_rules[ruleDOT]() {
+						goto l376
 					}
-					if !rules[ruletriplesSameSubjectPath]() {
-						goto l340
+					if !_rules[ruletriplesSameSubjectPath]() {
+						goto l376
 					}
-					goto l339
-				l340:
-					position, tokenIndex, depth = position340, tokenIndex340, depth340
+					goto l375
+				l376:
+					position, tokenIndex, depth = position376, tokenIndex376, depth376
 				}
 				{
-					position341, tokenIndex341, depth341 := position, tokenIndex, depth
-					if !rules[ruleDOT]() {
-						goto l341
+					position377, tokenIndex377, depth377 := position, tokenIndex, depth
+					if !_rules[ruleDOT]() {
+						goto l377
 					}
-					goto l342
-				l341:
-					position, tokenIndex, depth = position341, tokenIndex341, depth341
+					goto l378
+				l377:
+					position, tokenIndex, depth = position377, tokenIndex377, depth377
 				}
-			l342:
+			l378:
 				depth--
-				add(ruletriplesBlock, position338)
+				add(ruletriplesBlock, position374)
 			}
 			return true
-		l337:
-			position, tokenIndex, depth = position337, tokenIndex337, depth337
+		l373:
+			position, tokenIndex, depth = position373, tokenIndex373, depth373
 			return false
 		},
 		/* 28 triplesSameSubjectPath <- <((varOrTerm propertyListPath) / (triplesNodePath propertyListPath?))> */
 		func() bool {
-			position343, tokenIndex343, depth343 := position, tokenIndex, depth
+			position379, tokenIndex379, depth379 := position, tokenIndex, depth
 			{
-				position344 := position
+				position380 := position
 				depth++
 				{
-					position345, tokenIndex345, depth345 := position, tokenIndex, depth
-					if !rules[rulevarOrTerm]() {
-						goto l346
+					position381, tokenIndex381, depth381 := position, tokenIndex, depth
+					if !_rules[rulevarOrTerm]() {
+						goto l382
 					}
-					if !rules[rulepropertyListPath]() {
-						goto l346
+					if !_rules[rulepropertyListPath]() {
+						goto l382
 					}
-					goto l345
-				l346:
-					position, tokenIndex, depth = position345, tokenIndex345, depth345
-					if !rules[ruletriplesNodePath]() {
-						goto l343
+					goto l381
+				l382:
+					position, tokenIndex, depth = position381, tokenIndex381, depth381
+					if !_rules[ruletriplesNodePath]() {
+						goto l379
 					}
 					{
-						position347, tokenIndex347, depth347 := position, tokenIndex, depth
-						if !rules[rulepropertyListPath]() {
-							goto l347
+						position383, tokenIndex383, depth383 := position, tokenIndex, depth
+						if !_rules[rulepropertyListPath]() {
+							goto l383
 						}
-						goto l348
-					l347:
-						position, tokenIndex, depth = position347, tokenIndex347, depth347
+						goto l384
+					l383:
+						position, tokenIndex, depth = position383, tokenIndex383, depth383
 					}
-				l348:
+				l384:
 				}
-			l345:
+			l381:
 				depth--
-				add(ruletriplesSameSubjectPath, position344)
+				add(ruletriplesSameSubjectPath, position380)
 			}
 			return true
-		l343:
-			position, tokenIndex, depth = position343, tokenIndex343, depth343
+		l379:
+			position, tokenIndex, depth = position379, tokenIndex379, depth379
 			return false
 		},
 		/* 29 varOrTerm <- <(var / graphTerm)> */
 		func() bool {
-			position349, tokenIndex349, depth349 := position, tokenIndex, depth
+			position385, tokenIndex385, depth385 := position, tokenIndex, depth
 			{
-				position350 := position
+				position386 := position
 				depth++
 				{
-					position351, tokenIndex351, depth351 := position, tokenIndex, depth
-					if !rules[rulevar]() {
-						goto l352
+					position387, tokenIndex387, depth387 := position, tokenIndex, depth
+					if !_rules[rulevar]() {
+						goto l388
 					}
-					goto l351
-				l352:
-					position, tokenIndex, depth = position351, tokenIndex351, depth351
+					goto l387
+				l388:
+					position, tokenIndex, depth = position387, tokenIndex387, depth387
 					{
-						position353 := position
+						position389 := position
 						depth++
 						{
-							position354, tokenIndex354, depth354 := position, tokenIndex, depth
-							if !rules[ruleiriref]() {
-								goto l355
+							position390, tokenIndex390, depth390 := position, tokenIndex, depth
+							if !_rules[ruleiriref]() {
+								goto l391
+							}
+							goto l390
+						l391:
+							position, tokenIndex, depth = position390, tokenIndex390, depth390
+							if !_rules[ruleliteral]() {
+								goto l392
+							}
+							goto l390
+						l392:
+							position, tokenIndex, depth = position390, tokenIndex390, depth390
+							if !_rules[rulenumericLiteral]() {
+								goto l393
+							}
+							goto l390
+						l393:
+							position, tokenIndex, depth = position390, tokenIndex390, depth390
+							if !_rules[rulebooleanLiteral]() {
+								goto l394
 							}
-							goto l354
-						l355:
-							position, tokenIndex, depth = position354, tokenIndex354, depth354
+							goto l390
+						l394:
+							position, tokenIndex, depth = position390, tokenIndex390, depth390
 							{
-								switch buffer[position] {
-								case '(':
-									if !rules[rulenil]() {
-										goto l349
-									}
-									break
-								case '[', '_':
+								position396 := position
+								depth++
+								{
+									position397, tokenIndex397, depth397 := position, tokenIndex, depth
 									{
-										position357 := position
+										position399 := position
 										depth++
+										if buffer[position] != rune('_') {
+											goto l398
+										}
+										position++
+										if buffer[position] != rune(':') {
+											goto l398
+										}
+										position++
 										{
-											position358, tokenIndex358, depth358 := position, tokenIndex, depth
-											{
-												position360 := position
-												depth++
-												if buffer[position] != rune('_') {
-													goto l359
-												}
-												position++
-												if buffer[position] != rune(':') {
-													goto l359
-												}
-												position++
-												{
-													position361, tokenIndex361, depth361 := position, tokenIndex, depth
-													if !rules[rulepnCharsU]() {
-														goto l362
-													}
-													goto l361
-												l362:
-													position, tokenIndex, depth = position361, tokenIndex361, depth361
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l359
-													}
-													position++
-												}
-											l361:
-												{
-													position363, tokenIndex363, depth363 := position, tokenIndex, depth
-													{
-														position365, tokenIndex365, depth365 := position, tokenIndex, depth
-													l367:
-														{
-															position368, tokenIndex368, depth368 := position, tokenIndex, depth
-															{
-																position369, tokenIndex369, depth369 := position, tokenIndex, depth
-																if !rules[rulepnCharsU]() {
-																	goto l370
-																}
-																goto l369
-															l370:
-																position, tokenIndex, depth = position369, tokenIndex369, depth369
-																{
-																	switch buffer[position] {
-																	case '.':
-																		if buffer[position] != rune('.') {
-																			goto l368
-																		}
-																		position++
-																		break
-																	case '-':
-																		if buffer[position] != rune('-') {
-																			goto l368
-																		}
-																		position++
-																		break
-																	default:
-																		if c := buffer[position]; c < rune('0') || c > rune('9') {
-																			goto l368
-																		}
-																		position++
-																		break
-																	}
-																}
-
-															}
-														l369:
-															goto l367
-														l368:
-															position, tokenIndex, depth = position368, tokenIndex368, depth368
-														}
-														if !rules[rulepnCharsU]() {
-															goto l366
-														}
-														goto l365
-													l366:
-														position, tokenIndex, depth = position365, tokenIndex365, depth365
-														{
-															position372, tokenIndex372, depth372 := position, tokenIndex, depth
-															if c := buffer[position]; c < rune('0') || c > rune('9') {
-																goto l373
-															}
-															position++
-															goto l372
-														l373:
-															position, tokenIndex, depth = position372, tokenIndex372, depth372
-															if buffer[position] != rune('-') {
-																goto l363
-															}
-															position++
-														}
-													l372:
-													}
-												l365:
-													goto l364
-												l363:
-													position, tokenIndex, depth = position363, tokenIndex363, depth363
-												}
-											l364:
-												if !rules[ruleskip]() {
-													goto l359
-												}
-												depth--
-												add(ruleblankNodeLabel, position360)
+											position400, tokenIndex400, depth400 := position, tokenIndex, depth
+											if !_rules[rulepnCharsU]() {
+												goto l401
+											}
+											goto l400
+										l401:
+											position, tokenIndex, depth = position400, tokenIndex400, depth400
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l398
 											}
-											goto l358
-										l359:
-											position, tokenIndex, depth = position358, tokenIndex358, depth358
+											position++
+										}
+									l400:
+									l402:
+										{
+											position403, tokenIndex403, depth403 := position, tokenIndex, depth
+										l404:
 											{
-												position374 := position
-												depth++
-												if buffer[position] != rune('[') {
-													goto l349
-												}
-												position++
-											l375:
-												{
-													position376, tokenIndex376, depth376 := position, tokenIndex, depth
-													if !rules[rulews]() {
-														goto l376
-													}
-													goto l375
-												l376:
-													position, tokenIndex, depth = position376, tokenIndex376, depth376
-												}
-												if buffer[position] != rune(']') {
-													goto l349
+												position405, tokenIndex405, depth405 := position, tokenIndex, depth
+												if buffer[position] != rune('.') {
+													goto l405
 												}
 												position++
-												if !rules[ruleskip]() {
-													goto l349
-												}
-												depth--
-												add(ruleanon, position374)
+												goto l404
+											l405:
+												position, tokenIndex, depth = position405, tokenIndex405, depth405
+											}
+											if !_rules[rulepnChars]() {
+												goto l403
 											}
+											goto l402
+										l403:
+											position, tokenIndex, depth = position403, tokenIndex403, depth403
+										}
+										if !_rules[ruleskip]() {
+											goto l398
 										}
-									l358:
 										depth--
-										add(ruleblankNode, position357)
-									}
-									break
-								case 'F', 'T', 'f', 't':
-									if !rules[rulebooleanLiteral]() {
-										goto l349
+										add(ruleblankNodeLabel, position399)
 									}
-									break
-								case '"', '\'':
-									if !rules[ruleliteral]() {
-										goto l349
-									}
-									break
-								default:
-									if !rules[rulenumericLiteral]() {
-										goto l349
+									goto l397
+								l398:
+									position, tokenIndex, depth = position397, tokenIndex397, depth397
+									{
+										position406 := position
+										depth++
+										if buffer[position] != rune('[') {
+											goto l395
+										}
+										position++
+									l407:
+										{
+											position408, tokenIndex408, depth408 := position, tokenIndex, depth
+											if !_rules[rulews]() {
+												goto l408
+											}
+											goto l407
+										l408:
+											position, tokenIndex, depth = position408, tokenIndex408, depth408
+										}
+										if buffer[position] != rune(']') {
+											goto l395
+										}
+										position++
+										if !_rules[ruleskip]() {
+											goto l395
+										}
+										depth--
+										add(ruleanon, position406)
 									}
-									break
 								}
+							l397:
+								depth--
+								add(ruleblankNode, position396)
+							}
+							goto l390
+						l395:
+							position, tokenIndex, depth = position390, tokenIndex390, depth390
+							if !_rules[rulenil]() {
+								goto l385
 							}
-
 						}
-					l354:
+					l390:
 						depth--
-						add(rulegraphTerm, position353)
+						add(rulegraphTerm, position389)
 					}
 				}
-			l351:
+			l387:
 				depth--
-				add(rulevarOrTerm, position350)
+				add(rulevarOrTerm, position386)
 			}
 			return true
-		l349:
-			position, tokenIndex, depth = position349, tokenIndex349, depth349
+		l385:
+			position, tokenIndex, depth = position385, tokenIndex385, depth385
 			return false
 		},
-		/* 30 graphTerm <- <(iriref / ((&('(') nil) | (&('[' | '_') blankNode) | (&('F' | 'T' | 'f' | 't') booleanLiteral) | (&('"' | '\'') literal) | (&('+' | '-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') numericLiteral)))> */
+		/* 30 graphTerm <- <(iriref / literal / numericLiteral / booleanLiteral / blankNode / nil)> */
 		nil,
 		/* 31 triplesNodePath <- <(collectionPath / blankNodePropertyListPath)> */
 		func() bool {
-			position378, tokenIndex378, depth378 := position, tokenIndex, depth
+			position410, tokenIndex410, depth410 := position, tokenIndex, depth
 			{
-				position379 := position
+				position411 := position
 				depth++
 				{
-					position380, tokenIndex380, depth380 := position, tokenIndex, depth
+					position412, tokenIndex412, depth412 := position, tokenIndex, depth
 					{
-						position382 := position
+						position414 := position
 						depth++
-						if !rules[ruleLPAREN]() {
-							goto l381
+						if !_rules[ruleLPAREN]() {
+							goto l413
 						}
-						if !rules[rulegraphNodePath]() {
-							goto l381
+						if !_rules[rulegraphNodePath]() {
+							goto l413
 						}
-					l383:
+					l415:
 						{
-							position384, tokenIndex384, depth384 := position, tokenIndex, depth
-							if !rules[rulegraphNodePath]() {
-								goto l384
+							position416, tokenIndex416, depth416 := position, tokenIndex, depth
+							if !_rules[rulegraphNodePath]() {
+								goto l416
 							}
-							goto l383
-						l384:
-							position, tokenIndex, depth = position384, tokenIndex384, depth384
+							goto l415
+						l416:
+							position, tokenIndex, depth = position416, tokenIndex416, depth416
 						}
-						if !rules[ruleRPAREN]() {
-							goto l381
+						if !_rules[ruleRPAREN]() {
+							goto l413
 						}
 						depth--
-						add(rulecollectionPath, position382)
+						add(rulecollectionPath, position414)
 					}
-					goto l380
-				l381:
-					position, tokenIndex, depth = position380, tokenIndex380, depth380
+					goto l412
+				l413:
+					position, tokenIndex, depth = position412, tokenIndex412, depth412
 					{
-						position385 := position
+						position417 := position
 						depth++
 						{
-							position386 := position
+							position418 := position
 							depth++
 							if buffer[position] != rune('[') {
-								goto l378
+								goto l410
 							}
 							position++
-							if !rules[ruleskip]() {
-								goto l378
+							if !_rules[ruleskip]() {
+								goto l410
 							}
 							depth--
-							add(ruleLBRACK, position386)
+							add(ruleLBRACK, position418)
 						}
-						if !rules[rulepropertyListPath]() {
-							goto l378
+						if !_rules[rulepropertyListPath]() {
+							goto l410
 						}
 						{
-							position387 := position
+							position419 := position
 							depth++
 							if buffer[position] != rune(']') {
-								goto l378
+								goto l410
 							}
 							position++
-							if !rules[ruleskip]() {
-								goto l378
+							if !_rules[ruleskip]() {
+								goto l410
 							}
 							depth--
-							add(ruleRBRACK, position387)
+							add(ruleRBRACK, position419)
 						}
 						depth--
-						add(ruleblankNodePropertyListPath, position385)
+						add(ruleblankNodePropertyListPath, position417)
 					}
 				}
-			l380:
+			l412:
 				depth--
-				add(ruletriplesNodePath, position379)
+				add(ruletriplesNodePath, position411)
 			}
 			return true
-		l378:
-			position, tokenIndex, depth = position378, tokenIndex378, depth378
+		l410:
+			position, tokenIndex, depth = position410, tokenIndex410, depth410
 			return false
 		},
 		/* 32 collectionPath <- <(LPAREN graphNodePath+ RPAREN)> */
 		nil,
 		/* 33 blankNodePropertyListPath <- <(LBRACK propertyListPath RBRACK)> */
 		nil,
-		/* 34 propertyListPath <- <((var / verbPath) objectListPath (SEMICOLON propertyListPath?)?)> */
+		/* 34 propertyListPath <- <((var / verbPath) objectListPath (SEMICOLON+ propertyListPath?)?)> */
 		func() bool {
-			position390, tokenIndex390, depth390 := position, tokenIndex, depth
+			position422, tokenIndex422, depth422 := position, tokenIndex, depth
 			{
-				position391 := position
+				position423 := position
 				depth++
 				{
-					position392, tokenIndex392, depth392 := position, tokenIndex, depth
-					if !rules[rulevar]() {
-						goto l393
+					position424, tokenIndex424, depth424 := position, tokenIndex, depth
+					if !_rules[rulevar]() {
+						goto l425
 					}
-					goto l392
-				l393:
-					position, tokenIndex, depth = position392, tokenIndex392, depth392
+					goto l424
+				l425:
+					position, tokenIndex, depth = position424, tokenIndex424, depth424
 					{
-						position394 := position
+						position426 := position
 						depth++
-						if !rules[rulepath]() {
-							goto l390
+						if !_rules[rulepath]() {
+							goto l422
 						}
 						depth--
-						add(ruleverbPath, position394)
+						add(ruleverbPath, position426)
 					}
 				}
-			l392:
+			l424:
 				{
-					position395 := position
+					position427 := position
 					depth++
-					if !rules[ruleobjectPath]() {
-						goto l390
+					if !_rules[ruleobjectPath]() {
+						goto l422
 					}
-				l396:
+				l428:
 					{
-						position397, tokenIndex397, depth397 := position, tokenIndex, depth
-						if !rules[ruleCOMMA]() {
-							goto l397
+						position429, tokenIndex429, depth429 := position, tokenIndex, depth
+						if !_rules[ruleCOMMA]() {
+							goto l429
 						}
-						if !rules[ruleobjectPath]() {
-							goto l397
+						if !_rules[ruleobjectPath]() {
+							goto l429
 						}
-						goto l396
-					l397:
-						position, tokenIndex, depth = position397, tokenIndex397, depth397
+						goto l428
+					l429:
+						position, tokenIndex, depth = position429, tokenIndex429, depth429
 					}
 					depth--
-					add(ruleobjectListPath, position395)
+					add(ruleobjectListPath, position427)
 				}
 				{
-					position398, tokenIndex398, depth398 := position, tokenIndex, depth
-					if !rules[ruleSEMICOLON]() {
-						goto l398
+					position430, tokenIndex430, depth430 := position, tokenIndex, depth
+					if !_rules[ruleSEMICOLON]() {
+						goto l430
+					}
+				l432:
+					{
+						position433, tokenIndex433, depth433 := position, tokenIndex, depth
+						if !_rules[ruleSEMICOLON]() {
+							goto l433
+						}
+						goto l432
+					l433:
+						position, tokenIndex, depth = position433, tokenIndex433, depth433
 					}
 					{
-						position400, tokenIndex400, depth400 := position, tokenIndex, depth
-						if !rules[rulepropertyListPath]() {
-							goto l400
+						position434, tokenIndex434, depth434 := position, tokenIndex, depth
+						if !_rules[rulepropertyListPath]() {
+							goto l434
 						}
-						goto l401
-					l400:
-						position, tokenIndex, depth = position400, tokenIndex400, depth400
+						goto l435
+					l434:
+						position, tokenIndex, depth = position434, tokenIndex434, depth434
 					}
-				l401:
-					goto l399
-				l398:
-					position, tokenIndex, depth = position398, tokenIndex398, depth398
+				l435:
+					goto l431
+				l430:
+					position, tokenIndex, depth = position430, tokenIndex430, depth430
 				}
-			l399:
+			l431:
 				depth--
-				add(rulepropertyListPath, position391)
+				add(rulepropertyListPath, position423)
 			}
 			return true
-		l390:
-			position, tokenIndex, depth = position390, tokenIndex390, depth390
+		l422:
+			position, tokenIndex, depth = position422, tokenIndex422, depth422
 			return false
 		},
 		/* 35 verbPath <- <path> */
 		nil,
 		/* 36 path <- <pathAlternative> */
 		func() bool {
-			position403, tokenIndex403, depth403 := position, tokenIndex, depth
+			position437, tokenIndex437, depth437 := position, tokenIndex, depth
 			{
-				position404 := position
+				position438 := position
 				depth++
 				{
-					position405 := position
+					position439 := position
 					depth++
-					if !rules[rulepathSequence]() {
-						goto l403
+					if !_rules[rulepathSequence]() {
+						goto l437
 					}
-				l406:
+				l440:
 					{
-						position407, tokenIndex407, depth407 := position, tokenIndex, depth
-						if !rules[rulePIPE]() {
-							goto l407
+						position441, tokenIndex441, depth441 := position, tokenIndex, depth
+						if !_rules[rulePIPE]() {
+							goto l441
 						}
-						if !rules[rulepathSequence]() {
-							goto l407
+						if !_rules[rulepathSequence]() {
+							goto l441
 						}
-						goto l406
-					l407:
-						position, tokenIndex, depth = position407, tokenIndex407, depth407
+						goto l440
+					l441:
+						position, tokenIndex, depth = position441, tokenIndex441, depth441
 					}
 					depth--
-					add(rulepathAlternative, position405)
+					add(rulepathAlternative, position439)
 				}
 				depth--
-				add(rulepath, position404)
+				add(rulepath, position438)
 			}
 			return true
-		l403:
-			position, tokenIndex, depth = position403, tokenIndex403, depth403
+		l437:
+			position, tokenIndex, depth = position437, tokenIndex437, depth437
 			return false
 		},
 		/* 37 pathAlternative <- <(pathSequence (PIPE pathSequence)*)> */
 		nil,
 		/* 38 pathSequence <- <(pathElt (SLASH pathElt)*)> */
 		func() bool {
-			position409, tokenIndex409, depth409 := position, tokenIndex, depth
+			position443, tokenIndex443, depth443 := position, tokenIndex, depth
 			{
-				position410 := position
+				position444 := position
 				depth++
-				if !rules[rulepathElt]() {
-					goto l409
+				if !_rules[rulepathElt]() {
+					goto l443
 				}
-			l411:
+			l445:
 				{
-					position412, tokenIndex412, depth412 := position, tokenIndex, depth
-					if !rules[ruleSLASH]() {
-						goto l412
+					position446, tokenIndex446, depth446 := position, tokenIndex, depth
+					if !_rules[ruleSLASH]() {
+						goto l446
 					}
-					if !rules[rulepathElt]() {
-						goto l412
+					if !_rules[rulepathElt]() {
+						goto l446
 					}
-					goto l411
-				l412:
-					position, tokenIndex, depth = position412, tokenIndex412, depth412
+					goto l445
+				l446:
+					position, tokenIndex, depth = position446, tokenIndex446, depth446
 				}
 				depth--
-				add(rulepathSequence, position410)
+				add(rulepathSequence, position444)
 			}
 			return true
-		l409:
-			position, tokenIndex, depth = position409, tokenIndex409, depth409
+		l443:
+			position, tokenIndex, depth = position443, tokenIndex443, depth443
 			return false
 		},
 		/* 39 pathElt <- <(INVERSE? pathPrimary pathMod?)> */
 		func() bool {
-			position413, tokenIndex413, depth413 := position, tokenIndex, depth
+			position447, tokenIndex447, depth447 := position, tokenIndex, depth
 			{
-				position414 := position
+				position448 := position
 				depth++
 				{
-					position415, tokenIndex415, depth415 := position, tokenIndex, depth
-					if !rules[ruleINVERSE]() {
-						goto l415
+					position449, tokenIndex449, depth449 := position, tokenIndex, depth
+					if !_rules[ruleINVERSE]() {
+						goto l449
 					}
-					goto l416
-				l415:
-					position, tokenIndex, depth = position415, tokenIndex415, depth415
+					goto l450
+				l449:
+					position, tokenIndex, depth = position449, tokenIndex449, depth449
 				}
-			l416:
+			l450:
 				{
-					position417 := position
+					position451 := position
 					depth++
 					{
-						position418, tokenIndex418, depth418 := position, tokenIndex, depth
-						if !rules[ruleiriref]() {
-							goto l419
+						position452, tokenIndex452, depth452 := position, tokenIndex, depth
+						if !_rules[ruleiriref]() {
+							goto l453
+						}
+						goto l452
+					l453:
+						position, tokenIndex, depth = position452, tokenIndex452, depth452
+						if !_rules[ruleISA]() {
+							goto l454
+						}
+						goto l452
+					l454:
+						position, tokenIndex, depth = position452, tokenIndex452, depth452
+						if !_rules[ruleNOT]() {
+							goto l455
 						}
-						goto l418
-					l419:
-						position, tokenIndex, depth = position418, tokenIndex418, depth418
 						{
-							switch buffer[position] {
-							case '(':
-								if !rules[ruleLPAREN]() {
-									goto l413
-								}
-								if !rules[rulepath]() {
-									goto l413
-								}
-								if !rules[ruleRPAREN]() {
-									goto l413
+							position456 := position
+							depth++
+							{
+								position457, tokenIndex457, depth457 := position, tokenIndex, depth
+								if !_rules[rulepathOneInPropertySet]() {
+									goto l458
 								}
-								break
-							case '!':
-								if !rules[ruleNOT]() {
-									goto l413
+								goto l457
+							l458:
+								position, tokenIndex, depth = position457, tokenIndex457, depth457
+								if !_rules[ruleLPAREN]() {
+									goto l455
 								}
 								{
-									position421 := position
-									depth++
+									position459, tokenIndex459, depth459 := position, tokenIndex, depth
+									if !_rules[rulepathOneInPropertySet]() {
+										goto l459
+									}
+								l461:
 									{
-										position422, tokenIndex422, depth422 := position, tokenIndex, depth
-										if !rules[rulepathOneInPropertySet]() {
-											goto l423
-										}
-										goto l422
-									l423:
-										position, tokenIndex, depth = position422, tokenIndex422, depth422
-										if !rules[ruleLPAREN]() {
-											goto l413
-										}
-										{
-											position424, tokenIndex424, depth424 := position, tokenIndex, depth
-											if !rules[rulepathOneInPropertySet]() {
-												goto l424
-											}
-										l426:
-											{
-												position427, tokenIndex427, depth427 := position, tokenIndex, depth
-												if !rules[rulePIPE]() {
-													goto l427
-												}
-												if !rules[rulepathOneInPropertySet]() {
-													goto l427
-												}
-												goto l426
-											l427:
-												position, tokenIndex, depth = position427, tokenIndex427, depth427
-											}
-											goto l425
-										l424:
-											position, tokenIndex, depth = position424, tokenIndex424, depth424
+										position462, tokenIndex462, depth462 := position, tokenIndex, depth
+										if !_rules[rulePIPE]() {
+											goto l462
 										}
-									l425:
-										if !rules[ruleRPAREN]() {
-											goto l413
+										if !_rules[rulepathOneInPropertySet]() {
+											goto l462
 										}
+										goto l461
+									l462:
+										position, tokenIndex, depth = position462, tokenIndex462, depth462
 									}
-								l422:
-									depth--
-									add(rulepathNegatedPropertySet, position421)
+									goto l460
+								l459:
+									position, tokenIndex, depth = position459, tokenIndex459, depth459
 								}
-								break
-							default:
-								if !rules[ruleISA]() {
-									goto l413
+							l460:
+								if !_rules[ruleRPAREN]() {
+									goto l455
 								}
-								break
 							}
+						l457:
+							depth--
+							add(rulepathNegatedPropertySet, position456)
+						}
+						goto l452
+					l455:
+						position, tokenIndex, depth = position452, tokenIndex452, depth452
+						if !_rules[ruleLPAREN]() {
+							goto l447
+						}
+						if !_rules[rulepath]() {
+							goto l447
+						}
+						if !_rules[ruleRPAREN]() {
+							goto l447
 						}
-
 					}
-				l418:
+				l452:
 					depth--
-					add(rulepathPrimary, position417)
+					add(rulepathPrimary, position451)
 				}
 				{
-					position428, tokenIndex428, depth428 := position, tokenIndex, depth
+					position463, tokenIndex463, depth463 := position, tokenIndex, depth
 					{
-						position430 := position
+						position465 := position
 						depth++
 						{
-							switch buffer[position] {
-							case '+':
-								if !rules[rulePLUS]() {
-									goto l428
+							position466, tokenIndex466, depth466 := position, tokenIndex, depth
+							if !_rules[ruleSTAR]() {
+								goto l467
+							}
+							goto l466
+						l467:
+							position, tokenIndex, depth = position466, tokenIndex466, depth466
+							{
+								position469, tokenIndex469, depth469 := position, tokenIndex, depth
+								if !_rules[rulevar]() {
+									goto l469
 								}
-								break
-							case '?':
-								{
-									position432 := position
-									depth++
-									if buffer[position] != rune('?') {
-										goto l428
-									}
-									position++
-									if !rules[ruleskip]() {
-										goto l428
-									}
-									depth--
-									add(ruleQUESTION, position432)
+								goto l468
+							l469:
+								position, tokenIndex, depth = position469, tokenIndex469, depth469
+							}
+							{
+								position470 := position
+								depth++
+								if buffer[position] != rune('?') {
+									goto l468
 								}
-								break
-							default:
-								if !rules[ruleSTAR]() {
-									goto l428
+								position++
+								if !_rules[ruleskip]() {
+									goto l468
 								}
-								break
+								depth--
+								add(ruleQUESTION, position470)
 							}
-						}
-
-						{
-							position433, tokenIndex433, depth433 := position, tokenIndex, depth
-							if !matchDot() {
-								goto l433
+							goto l466
+						l468:
+							position, tokenIndex, depth = position466, tokenIndex466, depth466
+							if !_rules[rulePLUS]() {
+								goto l463
 							}
-							goto l428
-						l433:
-							position, tokenIndex, depth = position433, tokenIndex433, depth433
 						}
+					l466:
 						depth--
-						add(rulepathMod, position430)
+						add(rulepathMod, position465)
 					}
-					goto l429
-				l428:
-					position, tokenIndex, depth = position428, tokenIndex428, depth428
+					goto l464
+				l463:
+					position, tokenIndex, depth = position463, tokenIndex463, depth463
 				}
-			l429:
+			l464:
 				depth--
-				add(rulepathElt, position414)
+				add(rulepathElt, position448)
 			}
 			return true
-		l413:
-			position, tokenIndex, depth = position413, tokenIndex413, depth413
+		l447:
+			position, tokenIndex, depth = position447, tokenIndex447, depth447
 			return false
 		},
-		/* 40 pathPrimary <- <(iriref / ((&('(') (LPAREN path RPAREN)) | (&('!') (NOT pathNegatedPropertySet)) | (&('a') ISA)))> */
+		/* 40 pathPrimary <- <(iriref / ISA / (NOT pathNegatedPropertySet) / (LPAREN path RPAREN))> */
 		nil,
 		/* 41 pathNegatedPropertySet <- <(pathOneInPropertySet / (LPAREN (pathOneInPropertySet (PIPE pathOneInPropertySet)*)? RPAREN))> */
 		nil,
 		/* 42 pathOneInPropertySet <- <(iriref / ISA / (INVERSE (iriref / ISA)))> */
 		func() bool {
-			position436, tokenIndex436, depth436 := position, tokenIndex, depth
+			position473, tokenIndex473, depth473 := position, tokenIndex, depth
 			{
-				position437 := position
+				position474 := position
 				depth++
 				{
-					position438, tokenIndex438, depth438 := position, tokenIndex, depth
-					if !rules[ruleiriref]() {
-						goto l439
-					}
-					goto l438
-				l439:
-					position, tokenIndex, depth = position438, tokenIndex438, depth438
-					if !rules[ruleISA]() {
-						goto l440
-					}
-					goto l438
-				l440:
-					position, tokenIndex, depth = position438, tokenIndex438, depth438
-					if !rules[ruleINVERSE]() {
-						goto l436
+					position475, tokenIndex475, depth475 := position, tokenIndex, depth
+					if !_rules[ruleiriref]() {
+						goto l476
+					}
+					goto l475
+				l476:
+					position, tokenIndex, depth = position475, tokenIndex475, depth475
+					if !_rules[ruleISA]() {
+						goto l477
+					}
+					goto l475
+				l477:
+					position, tokenIndex, depth = position475, tokenIndex475, depth475
+					if !_rules[ruleINVERSE]() {
+						goto l473
 					}
 					{
-						position441, tokenIndex441, depth441 := position, tokenIndex, depth
-						if !rules[ruleiriref]() {
-							goto l442
+						position478, tokenIndex478, depth478 := position, tokenIndex, depth
+						if !_rules[ruleiriref]() {
+							goto l479
 						}
-						goto l441
-					l442:
-						position, tokenIndex, depth = position441, tokenIndex441, depth441
-						if !rules[ruleISA]() {
-							goto l436
+						goto l478
+					l479:
+						position, tokenIndex, depth = position478, tokenIndex478, depth478
+						if !_rules[ruleISA]() {
+							goto l473
 						}
 					}
-				l441:
+				l478:
 				}
-			l438:
+			l475:
 				depth--
-				add(rulepathOneInPropertySet, position437)
+				add(rulepathOneInPropertySet, position474)
 			}
 			return true
-		l436:
-			position, tokenIndex, depth = position436, tokenIndex436, depth436
+		l473:
+			position, tokenIndex, depth = position473, tokenIndex473, depth473
 			return false
 		},
-		/* 43 pathMod <- <(((&('+') PLUS) | (&('?') QUESTION) | (&('*') STAR)) !.)> */
+		/* 43 pathMod <- <(STAR / (!var QUESTION) / PLUS)> */
 		nil,
 		/* 44 objectListPath <- <(objectPath (COMMA objectPath)*)> */
 		nil,
 		/* 45 objectPath <- <graphNodePath> */
 		func() bool {
-			position445, tokenIndex445, depth445 := position, tokenIndex, depth
+			position482, tokenIndex482, depth482 := position, tokenIndex, depth
 			{
-				position446 := position
+				position483 := position
 				depth++
-				if !rules[rulegraphNodePath]() {
-					goto l445
+				if !_rules[rulegraphNodePath]() {
+					goto l482
 				}
 				depth--
-				add(ruleobjectPath, position446)
+				add(ruleobjectPath, position483)
 			}
 			return true
-		l445:
-			position, tokenIndex, depth = position445, tokenIndex445, depth445
+		l482:
+			position, tokenIndex, depth = position482, tokenIndex482, depth482
 			return false
 		},
 		/* 46 graphNodePath <- <(varOrTerm / triplesNodePath)> */
 		func() bool {
-			position447, tokenIndex447, depth447 := position, tokenIndex, depth
+			position484, tokenIndex484, depth484 := position, tokenIndex, depth
 			{
-				position448 := position
+				position485 := position
 				depth++
 				{
-					position449, tokenIndex449, depth449 := position, tokenIndex, depth
-					if !rules[rulevarOrTerm]() {
-						goto l450
+					position486, tokenIndex486, depth486 := position, tokenIndex, depth
+					if !_rules[rulevarOrTerm]() {
+						goto l487
 					}
-					goto l449
-				l450:
-					position, tokenIndex, depth = position449, tokenIndex449, depth449
-					if !rules[ruletriplesNodePath]() {
-						goto l447
+					goto l486
+				l487:
+					position, tokenIndex, depth = position486, tokenIndex486, depth486
+					if !_rules[ruletriplesNodePath]() {
+						goto l484
 					}
 				}
-			l449:
+			l486:
 				depth--
-				add(rulegraphNodePath, position448)
+				add(rulegraphNodePath, position485)
 			}
 			return true
-		l447:
-			position, tokenIndex, depth = position447, tokenIndex447, depth447
+		l484:
+			position, tokenIndex, depth = position484, tokenIndex484, depth484
 			return false
 		},
-		/* 47 solutionModifier <- <((ORDER BY orderCondition+) / ((&('H' | 'h') (HAVING constraint)) | (&('G' | 'g') (GROUP BY groupCondition+)) | (&('L' | 'O' | 'l' | 'o') limitOffsetClauses)))?> */
+		/* 47 solutionModifier <- <(groupClause? havingClause? orderClause? limitOffsetClauses?)> */
 		func() bool {
 			{
-				position452 := position
+				position489 := position
 				depth++
 				{
-					position453, tokenIndex453, depth453 := position, tokenIndex, depth
+					position490, tokenIndex490, depth490 := position, tokenIndex, depth
 					{
-						position455, tokenIndex455, depth455 := position, tokenIndex, depth
+						position492 := position
+						depth++
 						{
-							position457 := position
+							position493 := position
 							depth++
 							{
-								position458, tokenIndex458, depth458 := position, tokenIndex, depth
-								if buffer[position] != rune('o') {
-									goto l459
+								position494, tokenIndex494, depth494 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l495
 								}
 								position++
-								goto l458
-							l459:
-								position, tokenIndex, depth = position458, tokenIndex458, depth458
-								if buffer[position] != rune('O') {
-									goto l456
+								goto l494
+							l495:
+								position, tokenIndex, depth = position494, tokenIndex494, depth494
+								if buffer[position] != rune('G') {
+									goto l490
 								}
 								position++
 							}
-						l458:
+						l494:
 							{
-								position460, tokenIndex460, depth460 := position, tokenIndex, depth
+								position496, tokenIndex496, depth496 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l461
+									goto l497
 								}
 								position++
-								goto l460
-							l461:
-								position, tokenIndex, depth = position460, tokenIndex460, depth460
+								goto l496
+							l497:
+								position, tokenIndex, depth = position496, tokenIndex496, depth496
 								if buffer[position] != rune('R') {
-									goto l456
+									goto l490
 								}
 								position++
 							}
-						l460:
+						l496:
 							{
-								position462, tokenIndex462, depth462 := position, tokenIndex, depth
-								if buffer[position] != rune('d') {
-									goto l463
+								position498, tokenIndex498, depth498 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l499
 								}
 								position++
-								goto l462
-							l463:
-								position, tokenIndex, depth = position462, tokenIndex462, depth462
-								if buffer[position] != rune('D') {
-									goto l456
+								goto l498
+							l499:
+								position, tokenIndex, depth = position498, tokenIndex498, depth498
+								if buffer[position] != rune('O') {
+									goto l490
 								}
 								position++
 							}
-						l462:
+						l498:
 							{
-								position464, tokenIndex464, depth464 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l465
+								position500, tokenIndex500, depth500 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l501
 								}
 								position++
-								goto l464
-							l465:
-								position, tokenIndex, depth = position464, tokenIndex464, depth464
-								if buffer[position] != rune('E') {
-									goto l456
+								goto l500
+							l501:
+								position, tokenIndex, depth = position500, tokenIndex500, depth500
+								if buffer[position] != rune('U') {
+									goto l490
 								}
 								position++
 							}
-						l464:
+						l500:
 							{
-								position466, tokenIndex466, depth466 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l467
+								position502, tokenIndex502, depth502 := position, tokenIndex, depth
+								if buffer[position] != rune('p') {
+									goto l503
 								}
 								position++
-								goto l466
-							l467:
-								position, tokenIndex, depth = position466, tokenIndex466, depth466
-								if buffer[position] != rune('R') {
-									goto l456
+								goto l502
+							l503:
+								position, tokenIndex, depth = position502, tokenIndex502, depth502
+								if buffer[position] != rune('P') {
+									goto l490
 								}
 								position++
 							}
-						l466:
-							if !rules[ruleskip]() {
-								goto l456
+						l502:
+							if !_rules[ruleskip]() {
+								goto l490
 							}
 							depth--
-							add(ruleORDER, position457)
+							add(ruleGROUP, position493)
 						}
-						if !rules[ruleBY]() {
-							goto l456
+						if !_rules[ruleBY]() {
+							goto l490
 						}
 						{
-							position470 := position
+							position506 := position
 							depth++
 							{
-								position471, tokenIndex471, depth471 := position, tokenIndex, depth
+								position507, tokenIndex507, depth507 := position, tokenIndex, depth
+								if !_rules[rulefunctionCall]() {
+									goto l508
+								}
+								goto l507
+							l508:
+								position, tokenIndex, depth = position507, tokenIndex507, depth507
+								if !_rules[rulebuiltinCall]() {
+									goto l509
+								}
+								goto l507
+							l509:
+								position, tokenIndex, depth = position507, tokenIndex507, depth507
+								if !_rules[ruleLPAREN]() {
+									goto l510
+								}
+								if !_rules[ruleexpression]() {
+									goto l510
+								}
 								{
-									position473, tokenIndex473, depth473 := position, tokenIndex, depth
-									{
-										position475, tokenIndex475, depth475 := position, tokenIndex, depth
-										{
-											position477 := position
-											depth++
-											{
-												position478, tokenIndex478, depth478 := position, tokenIndex, depth
-												if buffer[position] != rune('a') {
-													goto l479
-												}
-												position++
-												goto l478
-											l479:
-												position, tokenIndex, depth = position478, tokenIndex478, depth478
-												if buffer[position] != rune('A') {
-													goto l476
+									position511, tokenIndex511, depth511 := position, tokenIndex, depth
+									if !_rules[ruleAS]() {
+										goto l511
+									}
+									if !_rules[rulevar]() {
+										goto l511
+									}
+									goto l512
+								l511:
+									position, tokenIndex, depth = position511, tokenIndex511, depth511
+								}
+							l512:
+								if !_rules[ruleRPAREN]() {
+									goto l510
+								}
+								goto l507
+							l510:
+								position, tokenIndex, depth = position507, tokenIndex507, depth507
+								if !_rules[rulevar]() {
+									goto l490
+								}
+							}
+						l507:
+							depth--
+							add(rulegroupCondition, position506)
+						}
+					l504:
+						{
+							position505, tokenIndex505, depth505 := position, tokenIndex, depth
+							{
+								position513 := position
+								depth++
+								{
+									position514, tokenIndex514, depth514 := position, tokenIndex, depth
+									if !_rules[rulefunctionCall]() {
+										goto l515
+									}
+									goto l514
+								l515:
+									position, tokenIndex, depth = position514, tokenIndex514, depth514
+									if !_rules[rulebuiltinCall]() {
+										goto l516
+									}
+									goto l514
+								l516:
+									position, tokenIndex, depth = position514, tokenIndex514, depth514
+									if !_rules[ruleLPAREN]() {
+										goto l517
+									}
+									if !_rules[ruleexpression]() {
+										goto l517
+									}
+									{
+										position518, tokenIndex518, depth518 := position, tokenIndex, depth
+										if !_rules[ruleAS]() {
+											goto l518
+										}
+										if !_rules[rulevar]() {
+											goto l518
+										}
+										goto l519
+									l518:
+										position, tokenIndex, depth = position518, tokenIndex518, depth518
+									}
+								l519:
+									if !_rules[ruleRPAREN]() {
+										goto l517
+									}
+									goto l514
+								l517:
+									position, tokenIndex, depth = position514, tokenIndex514, depth514
+									if !_rules[rulevar]() {
+										goto l505
+									}
+								}
+							l514:
+								depth--
+								add(rulegroupCondition, position513)
+							}
+							goto l504
+						l505:
+							position, tokenIndex, depth = position505, tokenIndex505, depth505
+						}
+						depth--
+						add(rulegroupClause, position492)
+					}
+					goto l491
+				l490:
+					position, tokenIndex, depth = position490, tokenIndex490, depth490
+				}
+			l491:
+				{
+					position520, tokenIndex520, depth520 := position, tokenIndex, depth
+					{
+						position522 := position
+						depth++
+						{
+							position523 := position
+							depth++
+							{
+								position524, tokenIndex524, depth524 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l525
+								}
+								position++
+								goto l524
+							l525:
+								position, tokenIndex, depth = position524, tokenIndex524, depth524
+								if buffer[position] != rune('H') {
+									goto l520
+								}
+								position++
+							}
+						l524:
+							{
+								position526, tokenIndex526, depth526 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l527
+								}
+								position++
+								goto l526
+							l527:
+								position, tokenIndex, depth = position526, tokenIndex526, depth526
+								if buffer[position] != rune('A') {
+									goto l520
+								}
+								position++
+							}
+						l526:
+							{
+								position528, tokenIndex528, depth528 := position, tokenIndex, depth
+								if buffer[position] != rune('v') {
+									goto l529
+								}
+								position++
+								goto l528
+							l529:
+								position, tokenIndex, depth = position528, tokenIndex528, depth528
+								if buffer[position] != rune('V') {
+									goto l520
+								}
+								position++
+							}
+						l528:
+							{
+								position530, tokenIndex530, depth530 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l531
+								}
+								position++
+								goto l530
+							l531:
+								position, tokenIndex, depth = position530, tokenIndex530, depth530
+								if buffer[position] != rune('I') {
+									goto l520
+								}
+								position++
+							}
+						l530:
+							{
+								position532, tokenIndex532, depth532 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l533
+								}
+								position++
+								goto l532
+							l533:
+								position, tokenIndex, depth = position532, tokenIndex532, depth532
+								if buffer[position] != rune('N') {
+									goto l520
+								}
+								position++
+							}
+						l532:
+							{
+								position534, tokenIndex534, depth534 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l535
+								}
+								position++
+								goto l534
+							l535:
+								position, tokenIndex, depth = position534, tokenIndex534, depth534
+								if buffer[position] != rune('G') {
+									goto l520
+								}
+								position++
+							}
+						l534:
+							if !_rules[ruleskip]() {
+								goto l520
+							}
+							depth--
+							add(ruleHAVING, position523)
+						}
+						if !_rules[ruleconstraint]() {
+							goto l520
+						}
+					l536:
+						{
+							position537, tokenIndex537, depth537 := position, tokenIndex, depth
+							if !_rules[ruleconstraint]() {
+								goto l537
+							}
+							goto l536
+						l537:
+							position, tokenIndex, depth = position537, tokenIndex537, depth537
+						}
+						depth--
+						add(rulehavingClause, position522)
+					}
+					goto l521
+				l520:
+					position, tokenIndex, depth = position520, tokenIndex520, depth520
+				}
+			l521:
+				{
+					position538, tokenIndex538, depth538 := position, tokenIndex, depth
+					{
+						position540 := position
+						depth++
+						{
+							position541 := position
+							depth++
+							{
+								position542, tokenIndex542, depth542 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l543
+								}
+								position++
+								goto l542
+							l543:
+								position, tokenIndex, depth = position542, tokenIndex542, depth542
+								if buffer[position] != rune('O') {
+									goto l538
+								}
+								position++
+							}
+						l542:
+							{
+								position544, tokenIndex544, depth544 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l545
+								}
+								position++
+								goto l544
+							l545:
+								position, tokenIndex, depth = position544, tokenIndex544, depth544
+								if buffer[position] != rune('R') {
+									goto l538
+								}
+								position++
+							}
+						l544:
+							{
+								position546, tokenIndex546, depth546 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l547
+								}
+								position++
+								goto l546
+							l547:
+								position, tokenIndex, depth = position546, tokenIndex546, depth546
+								if buffer[position] != rune('D') {
+									goto l538
+								}
+								position++
+							}
+						l546:
+							{
+								position548, tokenIndex548, depth548 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l549
+								}
+								position++
+								goto l548
+							l549:
+								position, tokenIndex, depth = position548, tokenIndex548, depth548
+								if buffer[position] != rune('E') {
+									goto l538
+								}
+								position++
+							}
+						l548:
+							{
+								position550, tokenIndex550, depth550 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l551
+								}
+								position++
+								goto l550
+							l551:
+								position, tokenIndex, depth = position550, tokenIndex550, depth550
+								if buffer[position] != rune('R') {
+									goto l538
+								}
+								position++
+							}
+						l550:
+							if !_rules[ruleskip]() {
+								goto l538
+							}
+							depth--
+							add(ruleORDER, position541)
+						}
+						if !_rules[ruleBY]() {
+							goto l538
+						}
+						{
+							position554 := position
+							depth++
+							{
+								position555, tokenIndex555, depth555 := position, tokenIndex, depth
+								{
+									position557, tokenIndex557, depth557 := position, tokenIndex, depth
+									{
+										position559, tokenIndex559, depth559 := position, tokenIndex, depth
+										{
+											position561 := position
+											depth++
+											{
+												position562, tokenIndex562, depth562 := position, tokenIndex, depth
+												if buffer[position] != rune('a') {
+													goto l563
+												}
+												position++
+												goto l562
+											l563:
+												position, tokenIndex, depth = position562, tokenIndex562, depth562
+												if buffer[position] != rune('A') {
+													goto l560
 												}
 												position++
 											}
-										l478:
+										l562:
 											{
-												position480, tokenIndex480, depth480 := position, tokenIndex, depth
+												position564, tokenIndex564, depth564 := position, tokenIndex, depth
 												if buffer[position] != rune('s') {
-													goto l481
+													goto l565
 												}
 												position++
-												goto l480
-											l481:
-												position, tokenIndex, depth = position480, tokenIndex480, depth480
+												goto l564
+											l565:
+												position, tokenIndex, depth = position564, tokenIndex564, depth564
 												if buffer[position] != rune('S') {
-													goto l476
+													goto l560
 												}
 												position++
 											}
-										l480:
+										l564:
 											{
-												position482, tokenIndex482, depth482 := position, tokenIndex, depth
+												position566, tokenIndex566, depth566 := position, tokenIndex, depth
 												if buffer[position] != rune('c') {
-													goto l483
+													goto l567
 												}
 												position++
-												goto l482
-											l483:
-												position, tokenIndex, depth = position482, tokenIndex482, depth482
+												goto l566
+											l567:
+												position, tokenIndex, depth = position566, tokenIndex566, depth566
 												if buffer[position] != rune('C') {
-													goto l476
+													goto l560
 												}
 												position++
 											}
-										l482:
-											if !rules[ruleskip]() {
-												goto l476
+										l566:
+											if !_rules[ruleskip]() {
+												goto l560
 											}
 											depth--
-											add(ruleASC, position477)
+											add(ruleASC, position561)
 										}
-										goto l475
-									l476:
-										position, tokenIndex, depth = position475, tokenIndex475, depth475
+										goto l559
+									l560:
+										position, tokenIndex, depth = position559, tokenIndex559, depth559
 										{
-											position484 := position
+											position568 := position
 											depth++
 											{
-												position485, tokenIndex485, depth485 := position, tokenIndex, depth
+												position569, tokenIndex569, depth569 := position, tokenIndex, depth
 												if buffer[position] != rune('d') {
-													goto l486
+													goto l570
 												}
 												position++
-												goto l485
-											l486:
-												position, tokenIndex, depth = position485, tokenIndex485, depth485
+												goto l569
+											l570:
+												position, tokenIndex, depth = position569, tokenIndex569, depth569
 												if buffer[position] != rune('D') {
-													goto l473
+													goto l557
 												}
 												position++
 											}
-										l485:
+										l569:
 											{
-												position487, tokenIndex487, depth487 := position, tokenIndex, depth
+												position571, tokenIndex571, depth571 := position, tokenIndex, depth
 												if buffer[position] != rune('e') {
-													goto l488
+													goto l572
 												}
 												position++
-												goto l487
-											l488:
-												position, tokenIndex, depth = position487, tokenIndex487, depth487
+												goto l571
+											l572:
+												position, tokenIndex, depth = position571, tokenIndex571, depth571
 												if buffer[position] != rune('E') {
-													goto l473
+													goto l557
 												}
 												position++
 											}
-										l487:
+										l571:
 											{
-												position489, tokenIndex489, depth489 := position, tokenIndex, depth
+												position573, tokenIndex573, depth573 := position, tokenIndex, depth
 												if buffer[position] != rune('s') {
-													goto l490
+													goto l574
 												}
 												position++
-												goto l489
-											l490:
-												position, tokenIndex, depth = position489, tokenIndex489, depth489
+												goto l573
+											l574:
+												position, tokenIndex, depth = position573, tokenIndex573, depth573
 												if buffer[position] != rune('S') {
-													goto l473
+													goto l557
 												}
 												position++
 											}
-										l489:
+										l573:
 											{
-												position491, tokenIndex491, depth491 := position, tokenIndex, depth
+												position575, tokenIndex575, depth575 := position, tokenIndex, depth
 												if buffer[position] != rune('c') {
-													goto l492
+													goto l576
 												}
 												position++
-												goto l491
-											l492:
-												position, tokenIndex, depth = position491, tokenIndex491, depth491
+												goto l575
+											l576:
+												position, tokenIndex, depth = position575, tokenIndex575, depth575
 												if buffer[position] != rune('C') {
-													goto l473
+													goto l557
 												}
 												position++
 											}
-										l491:
-											if !rules[ruleskip]() {
-												goto l473
+										l575:
+											if !_rules[ruleskip]() {
+												goto l557
 											}
 											depth--
-											add(ruleDESC, position484)
+											add(ruleDESC, position568)
 										}
 									}
-								l475:
-									goto l474
-								l473:
-									position, tokenIndex, depth = position473, tokenIndex473, depth473
+								l559:
+									goto l558
+								l557:
+									position, tokenIndex, depth = position557, tokenIndex557, depth557
 								}
-							l474:
-								if !rules[rulebrackettedExpression]() {
-									goto l472
+							l558:
+								if !_rules[rulebrackettedExpression]() {
+									goto l556
 								}
-								goto l471
-							l472:
-								position, tokenIndex, depth = position471, tokenIndex471, depth471
-								if !rules[rulefunctionCall]() {
-									goto l493
+								goto l555
+							l556:
+								position, tokenIndex, depth = position555, tokenIndex555, depth555
+								if !_rules[rulefunctionCall]() {
+									goto l577
 								}
-								goto l471
-							l493:
-								position, tokenIndex, depth = position471, tokenIndex471, depth471
-								if !rules[rulebuiltinCall]() {
-									goto l494
+								goto l555
+							l577:
+								position, tokenIndex, depth = position555, tokenIndex555, depth555
+								if !_rules[rulebuiltinCall]() {
+									goto l578
 								}
-								goto l471
-							l494:
-								position, tokenIndex, depth = position471, tokenIndex471, depth471
-								if !rules[rulevar]() {
-									goto l456
+								goto l555
+							l578:
+								position, tokenIndex, depth = position555, tokenIndex555, depth555
+								if !_rules[rulevar]() {
+									goto l538
 								}
 							}
-						l471:
+						l555:
 							depth--
-							add(ruleorderCondition, position470)
+							add(ruleorderCondition, position554)
 						}
-					l468:
+					l552:
 						{
-							position469, tokenIndex469, depth469 := position, tokenIndex, depth
+							position553, tokenIndex553, depth553 := position, tokenIndex, depth
 							{
-								position495 := position
+								position579 := position
 								depth++
 								{
-									position496, tokenIndex496, depth496 := position, tokenIndex, depth
+									position580, tokenIndex580, depth580 := position, tokenIndex, depth
 									{
-										position498, tokenIndex498, depth498 := position, tokenIndex, depth
+										position582, tokenIndex582, depth582 := position, tokenIndex, depth
 										{
-											position500, tokenIndex500, depth500 := position, tokenIndex, depth
+											position584, tokenIndex584, depth584 := position, tokenIndex, depth
 											{
-												position502 := position
+												position586 := position
 												depth++
 												{
-													position503, tokenIndex503, depth503 := position, tokenIndex, depth
+													position587, tokenIndex587, depth587 := position, tokenIndex, depth
 													if buffer[position] != rune('a') {
-														goto l504
+														goto l588
 													}
 													position++
-													goto l503
-												l504:
-													position, tokenIndex, depth = position503, tokenIndex503, depth503
+													goto l587
+												l588:
+													position, tokenIndex, depth = position587, tokenIndex587, depth587
 													if buffer[position] != rune('A') {
-														goto l501
+														goto l585
 													}
 													position++
 												}
-											l503:
+											l587:
 												{
-													position505, tokenIndex505, depth505 := position, tokenIndex, depth
+													position589, tokenIndex589, depth589 := position, tokenIndex, depth
 													if buffer[position] != rune('s') {
-														goto l506
+														goto l590
 													}
 													position++
-													goto l505
-												l506:
-													position, tokenIndex, depth = position505, tokenIndex505, depth505
+													goto l589
+												l590:
+													position, tokenIndex, depth = position589, tokenIndex589, depth589
 													if buffer[position] != rune('S') {
-														goto l501
+														goto l585
 													}
 													position++
 												}
-											l505:
+											l589:
 												{
-													position507, tokenIndex507, depth507 := position, tokenIndex, depth
+													position591, tokenIndex591, depth591 := position, tokenIndex, depth
 													if buffer[position] != rune('c') {
-														goto l508
+														goto l592
 													}
 													position++
-													goto l507
-												l508:
-													position, tokenIndex, depth = position507, tokenIndex507, depth507
+													goto l591
+												l592:
+													position, tokenIndex, depth = position591, tokenIndex591, depth591
 													if buffer[position] != rune('C') {
-														goto l501
+														goto l585
 													}
 													position++
 												}
-											l507:
-												if !rules[ruleskip]() {
-													goto l501
+											l591:
+												if !_rules[ruleskip]() {
+													goto l585
 												}
 												depth--
-												add(ruleASC, position502)
+												add(ruleASC, position586)
 											}
-											goto l500
-										l501:
-											position, tokenIndex, depth = position500, tokenIndex500, depth500
+											goto l584
+										l585:
+											position, tokenIndex, depth = position584, tokenIndex584, depth584
 											{
-												position509 := position
+												position593 := position
 												depth++
 												{
-													position510, tokenIndex510, depth510 := position, tokenIndex, depth
+													position594, tokenIndex594, depth594 := position, tokenIndex, depth
 													if buffer[position] != rune('d') {
-														goto l511
+														goto l595
 													}
 													position++
-													goto l510
-												l511:
-													position, tokenIndex, depth = position510, tokenIndex510, depth510
+													goto l594
+												l595:
+													position, tokenIndex, depth = position594, tokenIndex594, depth594
 													if buffer[position] != rune('D') {
-														goto l498
+														goto l582
 													}
 													position++
 												}
-											l510:
+											l594:
 												{
-													position512, tokenIndex512, depth512 := position, tokenIndex, depth
+													position596, tokenIndex596, depth596 := position, tokenIndex, depth
 													if buffer[position] != rune('e') {
-														goto l513
+														goto l597
 													}
 													position++
-													goto l512
-												l513:
-													position, tokenIndex, depth = position512, tokenIndex512, depth512
+													goto l596
+												l597:
+													position, tokenIndex, depth = position596, tokenIndex596, depth596
 													if buffer[position] != rune('E') {
-														goto l498
+														goto l582
 													}
 													position++
 												}
-											l512:
+											l596:
 												{
-													position514, tokenIndex514, depth514 := position, tokenIndex, depth
+													position598, tokenIndex598, depth598 := position, tokenIndex, depth
 													if buffer[position] != rune('s') {
-														goto l515
+														goto l599
 													}
 													position++
-													goto l514
-												l515:
-													position, tokenIndex, depth = position514, tokenIndex514, depth514
+													goto l598
+												l599:
+													position, tokenIndex, depth = position598, tokenIndex598, depth598
 													if buffer[position] != rune('S') {
-														goto l498
+														goto l582
 													}
 													position++
 												}
-											l514:
+											l598:
 												{
-													position516, tokenIndex516, depth516 := position, tokenIndex, depth
+													position600, tokenIndex600, depth600 := position, tokenIndex, depth
 													if buffer[position] != rune('c') {
-														goto l517
+														goto l601
 													}
 													position++
-													goto l516
-												l517:
-													position, tokenIndex, depth = position516, tokenIndex516, depth516
+													goto l600
+												l601:
+													position, tokenIndex, depth = position600, tokenIndex600, depth600
 													if buffer[position] != rune('C') {
-														goto l498
+														goto l582
 													}
 													position++
 												}
-											l516:
-												if !rules[ruleskip]() {
-													goto l498
+											l600:
+												if !_rules[ruleskip]() {
+													goto l582
 												}
 												depth--
-												add(ruleDESC, position509)
+												add(ruleDESC, position593)
 											}
 										}
-									l500:
-										goto l499
-									l498:
-										position, tokenIndex, depth = position498, tokenIndex498, depth498
-									}
-								l499:
-									if !rules[rulebrackettedExpression]() {
-										goto l497
-									}
-									goto l496
-								l497:
-									position, tokenIndex, depth = position496, tokenIndex496, depth496
-									if !rules[rulefunctionCall]() {
-										goto l518
-									}
-									goto l496
-								l518:
-									position, tokenIndex, depth = position496, tokenIndex496, depth496
-									if !rules[rulebuiltinCall]() {
-										goto l519
+									l584:
+										goto l583
+									l582:
+										position, tokenIndex, depth = position582, tokenIndex582, depth582
 									}
-									goto l496
-								l519:
-									position, tokenIndex, depth = position496, tokenIndex496, depth496
-									if !rules[rulevar]() {
-										goto l469
+								l583:
+									if !_rules[rulebrackettedExpression]() {
+										goto l581
+									}
+									goto l580
+								l581:
+									position, tokenIndex, depth = position580, tokenIndex580, depth580
+									if !_rules[rulefunctionCall]() {
+										goto l602
+									}
+									goto l580
+								l602:
+									position, tokenIndex, depth = position580, tokenIndex580, depth580
+									if !_rules[rulebuiltinCall]() {
+										goto l603
+									}
+									goto l580
+								l603:
+									position, tokenIndex, depth = position580, tokenIndex580, depth580
+									if !_rules[rulevar]() {
+										goto l553
 									}
 								}
-							l496:
+							l580:
 								depth--
-								add(ruleorderCondition, position495)
+								add(ruleorderCondition, position579)
 							}
-							goto l468
-						l469:
-							position, tokenIndex, depth = position469, tokenIndex469, depth469
+							goto l552
+						l553:
+							position, tokenIndex, depth = position553, tokenIndex553, depth553
 						}
-						goto l455
-					l456:
-						position, tokenIndex, depth = position455, tokenIndex455, depth455
+						depth--
+						add(ruleorderClause, position540)
+					}
+					goto l539
+				l538:
+					position, tokenIndex, depth = position538, tokenIndex538, depth538
+				}
+			l539:
+				{
+					position604, tokenIndex604, depth604 := position, tokenIndex, depth
+					{
+						position606 := position
+						depth++
 						{
-							switch buffer[position] {
-							case 'H', 'h':
-								{
-									position521 := position
-									depth++
-									{
-										position522, tokenIndex522, depth522 := position, tokenIndex, depth
-										if buffer[position] != rune('h') {
-											goto l523
-										}
-										position++
-										goto l522
-									l523:
-										position, tokenIndex, depth = position522, tokenIndex522, depth522
-										if buffer[position] != rune('H') {
-											goto l453
-										}
-										position++
-									}
-								l522:
-									{
-										position524, tokenIndex524, depth524 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l525
-										}
-										position++
-										goto l524
-									l525:
-										position, tokenIndex, depth = position524, tokenIndex524, depth524
-										if buffer[position] != rune('A') {
-											goto l453
-										}
-										position++
-									}
-								l524:
-									{
-										position526, tokenIndex526, depth526 := position, tokenIndex, depth
-										if buffer[position] != rune('v') {
-											goto l527
-										}
-										position++
-										goto l526
-									l527:
-										position, tokenIndex, depth = position526, tokenIndex526, depth526
-										if buffer[position] != rune('V') {
-											goto l453
-										}
-										position++
-									}
-								l526:
-									{
-										position528, tokenIndex528, depth528 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l529
-										}
-										position++
-										goto l528
-									l529:
-										position, tokenIndex, depth = position528, tokenIndex528, depth528
-										if buffer[position] != rune('I') {
-											goto l453
-										}
-										position++
-									}
-								l528:
-									{
-										position530, tokenIndex530, depth530 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l531
-										}
-										position++
-										goto l530
-									l531:
-										position, tokenIndex, depth = position530, tokenIndex530, depth530
-										if buffer[position] != rune('N') {
-											goto l453
-										}
-										position++
-									}
-								l530:
-									{
-										position532, tokenIndex532, depth532 := position, tokenIndex, depth
-										if buffer[position] != rune('g') {
-											goto l533
-										}
-										position++
-										goto l532
-									l533:
-										position, tokenIndex, depth = position532, tokenIndex532, depth532
-										if buffer[position] != rune('G') {
-											goto l453
-										}
-										position++
-									}
-								l532:
-									if !rules[ruleskip]() {
-										goto l453
-									}
-									depth--
-									add(ruleHAVING, position521)
+							position607, tokenIndex607, depth607 := position, tokenIndex, depth
+							if !_rules[rulelimit]() {
+								goto l608
+							}
+							{
+								position609, tokenIndex609, depth609 := position, tokenIndex, depth
+								if !_rules[ruleoffset]() {
+									goto l609
 								}
-								if !rules[ruleconstraint]() {
-									goto l453
+								goto l610
+							l609:
+								position, tokenIndex, depth = position609, tokenIndex609, depth609
+							}
+						l610:
+							goto l607
+						l608:
+							position, tokenIndex, depth = position607, tokenIndex607, depth607
+							if !_rules[ruleoffset]() {
+								goto l604
+							}
+							{
+								position611, tokenIndex611, depth611 := position, tokenIndex, depth
+								if !_rules[rulelimit]() {
+									goto l611
 								}
-								break
-							case 'G', 'g':
-								{
-									position534 := position
-									depth++
-									{
-										position535, tokenIndex535, depth535 := position, tokenIndex, depth
-										if buffer[position] != rune('g') {
-											goto l536
-										}
-										position++
-										goto l535
-									l536:
-										position, tokenIndex, depth = position535, tokenIndex535, depth535
-										if buffer[position] != rune('G') {
-											goto l453
-										}
-										position++
-									}
-								l535:
-									{
-										position537, tokenIndex537, depth537 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l538
-										}
-										position++
-										goto l537
-									l538:
-										position, tokenIndex, depth = position537, tokenIndex537, depth537
-										if buffer[position] != rune('R') {
-											goto l453
-										}
-										position++
-									}
-								l537:
-									{
-										position539, tokenIndex539, depth539 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l540
-										}
-										position++
-										goto l539
-									l540:
-										position, tokenIndex, depth = position539, tokenIndex539, depth539
-										if buffer[position] != rune('O') {
-											goto l453
-										}
-										position++
-									}
-								l539:
-									{
-										position541, tokenIndex541, depth541 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l542
-										}
-										position++
-										goto l541
-									l542:
-										position, tokenIndex, depth = position541, tokenIndex541, depth541
-										if buffer[position] != rune('U') {
-											goto l453
-										}
-										position++
-									}
-								l541:
-									{
-										position543, tokenIndex543, depth543 := position, tokenIndex, depth
-										if buffer[position] != rune('p') {
-											goto l544
-										}
-										position++
-										goto l543
-									l544:
-										position, tokenIndex, depth = position543, tokenIndex543, depth543
-										if buffer[position] != rune('P') {
-											goto l453
-										}
-										position++
-									}
-								l543:
-									if !rules[ruleskip]() {
-										goto l453
-									}
-									depth--
-									add(ruleGROUP, position534)
-								}
-								if !rules[ruleBY]() {
-									goto l453
-								}
-								{
-									position547 := position
-									depth++
-									{
-										position548, tokenIndex548, depth548 := position, tokenIndex, depth
-										if !rules[rulefunctionCall]() {
-											goto l549
-										}
-										goto l548
-									l549:
-										position, tokenIndex, depth = position548, tokenIndex548, depth548
-										{
-											switch buffer[position] {
-											case '$', '?':
-												if !rules[rulevar]() {
-													goto l453
-												}
-												break
-											case '(':
-												if !rules[ruleLPAREN]() {
-													goto l453
-												}
-												if !rules[ruleexpression]() {
-													goto l453
-												}
-												{
-													position551, tokenIndex551, depth551 := position, tokenIndex, depth
-													if !rules[ruleAS]() {
-														goto l551
-													}
-													if !rules[rulevar]() {
-														goto l551
-													}
-													goto l552
-												l551:
-													position, tokenIndex, depth = position551, tokenIndex551, depth551
-												}
-											l552:
-												if !rules[ruleRPAREN]() {
-													goto l453
-												}
-												break
-											default:
-												if !rules[rulebuiltinCall]() {
-													goto l453
-												}
-												break
-											}
-										}
-
-									}
-								l548:
-									depth--
-									add(rulegroupCondition, position547)
-								}
-							l545:
-								{
-									position546, tokenIndex546, depth546 := position, tokenIndex, depth
-									{
-										position553 := position
-										depth++
-										{
-											position554, tokenIndex554, depth554 := position, tokenIndex, depth
-											if !rules[rulefunctionCall]() {
-												goto l555
-											}
-											goto l554
-										l555:
-											position, tokenIndex, depth = position554, tokenIndex554, depth554
-											{
-												switch buffer[position] {
-												case '$', '?':
-													if !rules[rulevar]() {
-														goto l546
-													}
-													break
-												case '(':
-													if !rules[ruleLPAREN]() {
-														goto l546
-													}
-													if !rules[ruleexpression]() {
-														goto l546
-													}
-													{
-														position557, tokenIndex557, depth557 := position, tokenIndex, depth
-														if !rules[ruleAS]() {
-															goto l557
-														}
-														if !rules[rulevar]() {
-															goto l557
-														}
-														goto l558
-													l557:
-														position, tokenIndex, depth = position557, tokenIndex557, depth557
-													}
-												l558:
-													if !rules[ruleRPAREN]() {
-														goto l546
-													}
-													break
-												default:
-													if !rules[rulebuiltinCall]() {
-														goto l546
-													}
-													break
-												}
-											}
-
-										}
-									l554:
-										depth--
-										add(rulegroupCondition, position553)
-									}
-									goto l545
-								l546:
-									position, tokenIndex, depth = position546, tokenIndex546, depth546
-								}
-								break
-							default:
-								{
-									position559 := position
-									depth++
-									{
-										position560, tokenIndex560, depth560 := position, tokenIndex, depth
-										if !rules[rulelimit]() {
-											goto l561
-										}
-										{
-											position562, tokenIndex562, depth562 := position, tokenIndex, depth
-											if !rules[ruleoffset]() {
-												goto l562
-											}
-											goto l563
-										l562:
-											position, tokenIndex, depth = position562, tokenIndex562, depth562
-										}
-									l563:
-										goto l560
-									l561:
-										position, tokenIndex, depth = position560, tokenIndex560, depth560
-										if !rules[ruleoffset]() {
-											goto l453
-										}
-										{
-											position564, tokenIndex564, depth564 := position, tokenIndex, depth
-											if !rules[rulelimit]() {
-												goto l564
-											}
-											goto l565
-										l564:
-											position, tokenIndex, depth = position564, tokenIndex564, depth564
-										}
-									l565:
-									}
-								l560:
-									depth--
-									add(rulelimitOffsetClauses, position559)
-								}
-								break
+								goto l612
+							l611:
+								position, tokenIndex, depth = position611, tokenIndex611, depth611
 							}
+						l612:
 						}
-
+					l607:
+						depth--
+						add(rulelimitOffsetClauses, position606)
 					}
-				l455:
-					goto l454
-				l453:
-					position, tokenIndex, depth = position453, tokenIndex453, depth453
+					goto l605
+				l604:
+					position, tokenIndex, depth = position604, tokenIndex604, depth604
 				}
-			l454:
+			l605:
 				depth--
-				add(rulesolutionModifier, position452)
+				add(rulesolutionModifier, position489)
 			}
 			return true
 		},
-		/* 48 groupCondition <- <(functionCall / ((&('$' | '?') var) | (&('(') (LPAREN expression (AS var)? RPAREN)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'H' | 'I' | 'L' | 'M' | 'N' | 'R' | 'S' | 'T' | 'U' | 'Y' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'h' | 'i' | 'l' | 'm' | 'n' | 'r' | 's' | 't' | 'u' | 'y') builtinCall)))> */
+		/* 48 groupClause <- <(GROUP BY groupCondition+)> */
+		nil,
+		/* 49 havingClause <- <(HAVING constraint+)> */
 		nil,
-		/* 49 orderCondition <- <(((ASC / DESC)? brackettedExpression) / functionCall / builtinCall / var)> */
+		/* 50 orderClause <- <(ORDER BY orderCondition+)> */
 		nil,
-		/* 50 limitOffsetClauses <- <((limit offset?) / (offset limit?))> */
+		/* 51 groupCondition <- <(functionCall / builtinCall / (LPAREN expression (AS var)? RPAREN) / var)> */
 		nil,
-		/* 51 limit <- <(LIMIT INTEGER)> */
+		/* 52 orderCondition <- <(((ASC / DESC)? brackettedExpression) / functionCall / builtinCall / var)> */
+		nil,
+		/* 53 limitOffsetClauses <- <((limit offset?) / (offset limit?))> */
+		nil,
+		/* 54 limit <- <(LIMIT INTEGER)> */
 		func() bool {
-			position569, tokenIndex569, depth569 := position, tokenIndex, depth
+			position619, tokenIndex619, depth619 := position, tokenIndex, depth
 			{
-				position570 := position
+				position620 := position
 				depth++
 				{
-					position571 := position
+					position621 := position
 					depth++
 					{
-						position572, tokenIndex572, depth572 := position, tokenIndex, depth
+						position622, tokenIndex622, depth622 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l573
+							goto l623
 						}
 						position++
-						goto l572
-					l573:
-						position, tokenIndex, depth = position572, tokenIndex572, depth572
+						goto l622
+					l623:
+						position, tokenIndex, depth = position622, tokenIndex622, depth622
 						if buffer[position] != rune('L') {
-							goto l569
+							goto l619
 						}
 						position++
 					}
-				l572:
+				l622:
 					{
-						position574, tokenIndex574, depth574 := position, tokenIndex, depth
+						position624, tokenIndex624, depth624 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l575
+							goto l625
 						}
 						position++
-						goto l574
-					l575:
-						position, tokenIndex, depth = position574, tokenIndex574, depth574
+						goto l624
+					l625:
+						position, tokenIndex, depth = position624, tokenIndex624, depth624
 						if buffer[position] != rune('I') {
-							goto l569
+							goto l619
 						}
 						position++
 					}
-				l574:
+				l624:
 					{
-						position576, tokenIndex576, depth576 := position, tokenIndex, depth
+						position626, tokenIndex626, depth626 := position, tokenIndex, depth
 						if buffer[position] != rune('m') {
-							goto l577
+							goto l627
 						}
 						position++
-						goto l576
-					l577:
-						position, tokenIndex, depth = position576, tokenIndex576, depth576
+						goto l626
+					l627:
+						position, tokenIndex, depth = position626, tokenIndex626, depth626
 						if buffer[position] != rune('M') {
-							goto l569
+							goto l619
 						}
 						position++
 					}
-				l576:
+				l626:
 					{
-						position578, tokenIndex578, depth578 := position, tokenIndex, depth
+						position628, tokenIndex628, depth628 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l579
+							goto l629
 						}
 						position++
-						goto l578
-					l579:
-						position, tokenIndex, depth = position578, tokenIndex578, depth578
+						goto l628
+					l629:
+						position, tokenIndex, depth = position628, tokenIndex628, depth628
 						if buffer[position] != rune('I') {
-							goto l569
+							goto l619
 						}
 						position++
 					}
-				l578:
+				l628:
 					{
-						position580, tokenIndex580, depth580 := position, tokenIndex, depth
+						position630, tokenIndex630, depth630 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l581
+							goto l631
 						}
 						position++
-						goto l580
-					l581:
-						position, tokenIndex, depth = position580, tokenIndex580, depth580
+						goto l630
+					l631:
+						position, tokenIndex, depth = position630, tokenIndex630, depth630
 						if buffer[position] != rune('T') {
-							goto l569
+							goto l619
 						}
 						position++
 					}
-				l580:
-					if !rules[ruleskip]() {
-						goto l569
+				l630:
+					if !_rules[ruleskip]() {
+						goto l619
 					}
 					depth--
-					add(ruleLIMIT, position571)
+					add(ruleLIMIT, position621)
 				}
-				if !rules[ruleINTEGER]() {
-					goto l569
+				if !_rules[ruleINTEGER]() {
+					goto l619
 				}
 				depth--
-				add(rulelimit, position570)
+				add(rulelimit, position620)
 			}
 			return true
-		l569:
-			position, tokenIndex, depth = position569, tokenIndex569, depth569
+		l619:
+			position, tokenIndex, depth = position619, tokenIndex619, depth619
 			return false
 		},
-		/* 52 offset <- <(OFFSET INTEGER)> */
+		/* 55 offset <- <(OFFSET INTEGER)> */
 		func() bool {
-			position582, tokenIndex582, depth582 := position, tokenIndex, depth
+			position632, tokenIndex632, depth632 := position, tokenIndex, depth
 			{
-				position583 := position
+				position633 := position
 				depth++
 				{
-					position584 := position
+					position634 := position
 					depth++
 					{
-						position585, tokenIndex585, depth585 := position, tokenIndex, depth
+						position635, tokenIndex635, depth635 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l586
+							goto l636
 						}
 						position++
-						goto l585
-					l586:
-						position, tokenIndex, depth = position585, tokenIndex585, depth585
+						goto l635
+					l636:
+						position, tokenIndex, depth = position635, tokenIndex635, depth635
 						if buffer[position] != rune('O') {
-							goto l582
+							goto l632
 						}
 						position++
 					}
-				l585:
+				l635:
 					{
-						position587, tokenIndex587, depth587 := position, tokenIndex, depth
+						position637, tokenIndex637, depth637 := position, tokenIndex, depth
 						if buffer[position] != rune('f') {
-							goto l588
+							goto l638
 						}
 						position++
-						goto l587
-					l588:
-						position, tokenIndex, depth = position587, tokenIndex587, depth587
+						goto l637
+					l638:
+						position, tokenIndex, depth = position637, tokenIndex637, depth637
 						if buffer[position] != rune('F') {
-							goto l582
+							goto l632
 						}
 						position++
 					}
-				l587:
+				l637:
 					{
-						position589, tokenIndex589, depth589 := position, tokenIndex, depth
+						position639, tokenIndex639, depth639 := position, tokenIndex, depth
 						if buffer[position] != rune('f') {
-							goto l590
+							goto l640
 						}
 						position++
-						goto l589
-					l590:
-						position, tokenIndex, depth = position589, tokenIndex589, depth589
+						goto l639
+					l640:
+						position, tokenIndex, depth = position639, tokenIndex639, depth639
 						if buffer[position] != rune('F') {
-							goto l582
+							goto l632
 						}
 						position++
 					}
-				l589:
+				l639:
 					{
-						position591, tokenIndex591, depth591 := position, tokenIndex, depth
+						position641, tokenIndex641, depth641 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l592
+							goto l642
 						}
 						position++
-						goto l591
-					l592:
-						position, tokenIndex, depth = position591, tokenIndex591, depth591
+						goto l641
+					l642:
+						position, tokenIndex, depth = position641, tokenIndex641, depth641
 						if buffer[position] != rune('S') {
-							goto l582
+							goto l632
 						}
 						position++
 					}
-				l591:
+				l641:
 					{
-						position593, tokenIndex593, depth593 := position, tokenIndex, depth
+						position643, tokenIndex643, depth643 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l594
+							goto l644
 						}
 						position++
-						goto l593
-					l594:
-						position, tokenIndex, depth = position593, tokenIndex593, depth593
+						goto l643
+					l644:
+						position, tokenIndex, depth = position643, tokenIndex643, depth643
 						if buffer[position] != rune('E') {
-							goto l582
+							goto l632
 						}
 						position++
 					}
-				l593:
+				l643:
 					{
-						position595, tokenIndex595, depth595 := position, tokenIndex, depth
+						position645, tokenIndex645, depth645 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l596
+							goto l646
 						}
 						position++
-						goto l595
-					l596:
-						position, tokenIndex, depth = position595, tokenIndex595, depth595
+						goto l645
+					l646:
+						position, tokenIndex, depth = position645, tokenIndex645, depth645
 						if buffer[position] != rune('T') {
-							goto l582
+							goto l632
 						}
 						position++
 					}
-				l595:
-					if !rules[ruleskip]() {
-						goto l582
+				l645:
+					if !_rules[ruleskip]() {
+						goto l632
 					}
 					depth--
-					add(ruleOFFSET, position584)
+					add(ruleOFFSET, position634)
 				}
-				if !rules[ruleINTEGER]() {
-					goto l582
+				if !_rules[ruleINTEGER]() {
+					goto l632
 				}
 				depth--
-				add(ruleoffset, position583)
+				add(ruleoffset, position633)
 			}
 			return true
-		l582:
-			position, tokenIndex, depth = position582, tokenIndex582, depth582
+		l632:
+			position, tokenIndex, depth = position632, tokenIndex632, depth632
 			return false
 		},
-		/* 53 expression <- <conditionalOrExpression> */
+		/* 56 valuesClause <- <inlineData> */
 		func() bool {
-			position597, tokenIndex597, depth597 := position, tokenIndex, depth
+			position647, tokenIndex647, depth647 := position, tokenIndex, depth
 			{
-				position598 := position
+				position648 := position
 				depth++
-				if !rules[ruleconditionalOrExpression]() {
-					goto l597
+				if !_rules[ruleinlineData]() {
+					goto l647
 				}
 				depth--
-				add(ruleexpression, position598)
+				add(rulevaluesClause, position648)
 			}
 			return true
-		l597:
-			position, tokenIndex, depth = position597, tokenIndex597, depth597
+		l647:
+			position, tokenIndex, depth = position647, tokenIndex647, depth647
 			return false
 		},
-		/* 54 conditionalOrExpression <- <(conditionalAndExpression (OR conditionalOrExpression)?)> */
+		/* 57 inlineData <- <(VALUES dataBlock)> */
 		func() bool {
-			position599, tokenIndex599, depth599 := position, tokenIndex, depth
+			position649, tokenIndex649, depth649 := position, tokenIndex, depth
 			{
-				position600 := position
+				position650 := position
 				depth++
-				if !rules[ruleconditionalAndExpression]() {
-					goto l599
-				}
 				{
-					position601, tokenIndex601, depth601 := position, tokenIndex, depth
+					position651 := position
+					depth++
 					{
-						position603 := position
-						depth++
-						if buffer[position] != rune('|') {
-							goto l601
+						position652, tokenIndex652, depth652 := position, tokenIndex, depth
+						if buffer[position] != rune('v') {
+							goto l653
 						}
 						position++
-						if buffer[position] != rune('|') {
-							goto l601
+						goto l652
+					l653:
+						position, tokenIndex, depth = position652, tokenIndex652, depth652
+						if buffer[position] != rune('V') {
+							goto l649
+						}
+						position++
+					}
+				l652:
+					{
+						position654, tokenIndex654, depth654 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l655
 						}
 						position++
-						if !rules[ruleskip]() {
-							goto l601
+						goto l654
+					l655:
+						position, tokenIndex, depth = position654, tokenIndex654, depth654
+						if buffer[position] != rune('A') {
+							goto l649
 						}
-						depth--
-						add(ruleOR, position603)
+						position++
+					}
+				l654:
+					{
+						position656, tokenIndex656, depth656 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l657
+						}
+						position++
+						goto l656
+					l657:
+						position, tokenIndex, depth = position656, tokenIndex656, depth656
+						if buffer[position] != rune('L') {
+							goto l649
+						}
+						position++
+					}
+				l656:
+					{
+						position658, tokenIndex658, depth658 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l659
+						}
+						position++
+						goto l658
+					l659:
+						position, tokenIndex, depth = position658, tokenIndex658, depth658
+						if buffer[position] != rune('U') {
+							goto l649
+						}
+						position++
+					}
+				l658:
+					{
+						position660, tokenIndex660, depth660 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l661
+						}
+						position++
+						goto l660
+					l661:
+						position, tokenIndex, depth = position660, tokenIndex660, depth660
+						if buffer[position] != rune('E') {
+							goto l649
+						}
+						position++
+					}
+				l660:
+					{
+						position662, tokenIndex662, depth662 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l663
+						}
+						position++
+						goto l662
+					l663:
+						position, tokenIndex, depth = position662, tokenIndex662, depth662
+						if buffer[position] != rune('S') {
+							goto l649
+						}
+						position++
 					}
-					if !rules[ruleconditionalOrExpression]() {
-						goto l601
+				l662:
+					if !_rules[ruleskip]() {
+						goto l649
 					}
-					goto l602
-				l601:
-					position, tokenIndex, depth = position601, tokenIndex601, depth601
+					depth--
+					add(ruleVALUES, position651)
 				}
-			l602:
-				depth--
-				add(ruleconditionalOrExpression, position600)
-			}
-			return true
-		l599:
-			position, tokenIndex, depth = position599, tokenIndex599, depth599
-			return false
-		},
-		/* 55 conditionalAndExpression <- <(valueLogical (AND conditionalAndExpression)?)> */
-		func() bool {
-			position604, tokenIndex604, depth604 := position, tokenIndex, depth
-			{
-				position605 := position
-				depth++
 				{
-					position606 := position
+					position664 := position
 					depth++
-					if !rules[rulenumericExpression]() {
-						goto l604
-					}
 					{
-						position607, tokenIndex607, depth607 := position, tokenIndex, depth
+						position665, tokenIndex665, depth665 := position, tokenIndex, depth
 						{
-							switch buffer[position] {
-							case 'N', 'n':
-								{
-									position610 := position
-									depth++
-									{
-										position611 := position
-										depth++
-										{
-											position612, tokenIndex612, depth612 := position, tokenIndex, depth
-											if buffer[position] != rune('n') {
-												goto l613
-											}
-											position++
-											goto l612
-										l613:
-											position, tokenIndex, depth = position612, tokenIndex612, depth612
-											if buffer[position] != rune('N') {
-												goto l607
-											}
-											position++
-										}
-									l612:
-										{
-											position614, tokenIndex614, depth614 := position, tokenIndex, depth
-											if buffer[position] != rune('o') {
-												goto l615
-											}
-											position++
-											goto l614
-										l615:
-											position, tokenIndex, depth = position614, tokenIndex614, depth614
-											if buffer[position] != rune('O') {
-												goto l607
-											}
-											position++
-										}
-									l614:
-										{
-											position616, tokenIndex616, depth616 := position, tokenIndex, depth
-											if buffer[position] != rune('t') {
-												goto l617
-											}
-											position++
-											goto l616
-										l617:
-											position, tokenIndex, depth = position616, tokenIndex616, depth616
-											if buffer[position] != rune('T') {
-												goto l607
-											}
-											position++
-										}
-									l616:
-										if buffer[position] != rune(' ') {
-											goto l607
-										}
-										position++
-										{
-											position618, tokenIndex618, depth618 := position, tokenIndex, depth
-											if buffer[position] != rune('i') {
-												goto l619
-											}
-											position++
-											goto l618
-										l619:
-											position, tokenIndex, depth = position618, tokenIndex618, depth618
-											if buffer[position] != rune('I') {
-												goto l607
-											}
-											position++
-										}
-									l618:
-										{
-											position620, tokenIndex620, depth620 := position, tokenIndex, depth
-											if buffer[position] != rune('n') {
-												goto l621
-											}
-											position++
-											goto l620
-										l621:
-											position, tokenIndex, depth = position620, tokenIndex620, depth620
-											if buffer[position] != rune('N') {
-												goto l607
-											}
-											position++
-										}
-									l620:
-										if !rules[ruleskip]() {
-											goto l607
-										}
-										depth--
-										add(ruleNOTIN, position611)
-									}
-									if !rules[ruleargList]() {
-										goto l607
-									}
-									depth--
-									add(rulenotin, position610)
+							position667 := position
+							depth++
+							if !_rules[rulevar]() {
+								goto l666
+							}
+							if !_rules[ruleLBRACE]() {
+								goto l666
+							}
+						l668:
+							{
+								position669, tokenIndex669, depth669 := position, tokenIndex, depth
+								if !_rules[ruledataBlockValue]() {
+									goto l669
+								}
+								goto l668
+							l669:
+								position, tokenIndex, depth = position669, tokenIndex669, depth669
+							}
+							if !_rules[ruleRBRACE]() {
+								goto l666
+							}
+							depth--
+							add(ruleinlineDataOneVar, position667)
+						}
+						goto l665
+					l666:
+						position, tokenIndex, depth = position665, tokenIndex665, depth665
+						{
+							position670 := position
+							depth++
+							{
+								position671, tokenIndex671, depth671 := position, tokenIndex, depth
+								if !_rules[rulenil]() {
+									goto l672
 								}
-								break
-							case 'I', 'i':
+								goto l671
+							l672:
+								position, tokenIndex, depth = position671, tokenIndex671, depth671
+								if !_rules[ruleLPAREN]() {
+									goto l649
+								}
+							l673:
 								{
-									position622 := position
-									depth++
-									{
-										position623 := position
-										depth++
-										{
-											position624, tokenIndex624, depth624 := position, tokenIndex, depth
-											if buffer[position] != rune('i') {
-												goto l625
-											}
-											position++
-											goto l624
-										l625:
-											position, tokenIndex, depth = position624, tokenIndex624, depth624
-											if buffer[position] != rune('I') {
-												goto l607
-											}
-											position++
-										}
-									l624:
-										{
-											position626, tokenIndex626, depth626 := position, tokenIndex, depth
-											if buffer[position] != rune('n') {
-												goto l627
-											}
-											position++
-											goto l626
-										l627:
-											position, tokenIndex, depth = position626, tokenIndex626, depth626
-											if buffer[position] != rune('N') {
-												goto l607
-											}
-											position++
-										}
-									l626:
-										if !rules[ruleskip]() {
-											goto l607
-										}
-										depth--
-										add(ruleIN, position623)
+									position674, tokenIndex674, depth674 := position, tokenIndex, depth
+									if !_rules[rulevar]() {
+										goto l674
 									}
-									if !rules[ruleargList]() {
-										goto l607
-									}
-									depth--
-									add(rulein, position622)
+									goto l673
+								l674:
+									position, tokenIndex, depth = position674, tokenIndex674, depth674
 								}
-								break
-							default:
+								if !_rules[ruleRPAREN]() {
+									goto l649
+								}
+							}
+						l671:
+							if !_rules[ruleLBRACE]() {
+								goto l649
+							}
+						l675:
+							{
+								position676, tokenIndex676, depth676 := position, tokenIndex, depth
 								{
-									position628, tokenIndex628, depth628 := position, tokenIndex, depth
-									{
-										position630 := position
-										depth++
-										if buffer[position] != rune('<') {
-											goto l629
-										}
-										position++
-										if !rules[ruleskip]() {
-											goto l629
-										}
-										depth--
-										add(ruleLT, position630)
+									position677, tokenIndex677, depth677 := position, tokenIndex, depth
+									if !_rules[ruleLPAREN]() {
+										goto l678
 									}
-									goto l628
-								l629:
-									position, tokenIndex, depth = position628, tokenIndex628, depth628
+								l679:
 									{
-										position632 := position
-										depth++
-										if buffer[position] != rune('>') {
-											goto l631
-										}
-										position++
-										if buffer[position] != rune('=') {
-											goto l631
-										}
-										position++
-										if !rules[ruleskip]() {
-											goto l631
+										position680, tokenIndex680, depth680 := position, tokenIndex, depth
+										if !_rules[ruledataBlockValue]() {
+											goto l680
 										}
-										depth--
-										add(ruleGE, position632)
+										goto l679
+									l680:
+										position, tokenIndex, depth = position680, tokenIndex680, depth680
 									}
-									goto l628
-								l631:
-									position, tokenIndex, depth = position628, tokenIndex628, depth628
-									{
-										switch buffer[position] {
-										case '>':
-											{
-												position634 := position
-												depth++
-												if buffer[position] != rune('>') {
-													goto l607
-												}
-												position++
-												if !rules[ruleskip]() {
-													goto l607
-												}
-												depth--
-												add(ruleGT, position634)
-											}
-											break
-										case '<':
-											{
-												position635 := position
-												depth++
-												if buffer[position] != rune('<') {
-													goto l607
-												}
-												position++
-												if buffer[position] != rune('=') {
-													goto l607
-												}
-												position++
-												if !rules[ruleskip]() {
-													goto l607
-												}
-												depth--
-												add(ruleLE, position635)
-											}
-											break
-										case '!':
-											{
-												position636 := position
-												depth++
-												if buffer[position] != rune('!') {
-													goto l607
-												}
-												position++
-												if buffer[position] != rune('=') {
-													goto l607
-												}
-												position++
-												if !rules[ruleskip]() {
-													goto l607
-												}
-												depth--
-												add(ruleNE, position636)
-											}
-											break
-										default:
-											if !rules[ruleEQ]() {
-												goto l607
-											}
-											break
-										}
+									if !_rules[ruleRPAREN]() {
+										goto l678
+									}
+									goto l677
+								l678:
+									position, tokenIndex, depth = position677, tokenIndex677, depth677
+									if !_rules[rulenil]() {
+										goto l676
 									}
-
-								}
-							l628:
-								if !rules[rulenumericExpression]() {
-									goto l607
 								}
-								break
+							l677:
+								goto l675
+							l676:
+								position, tokenIndex, depth = position676, tokenIndex676, depth676
+							}
+							if !_rules[ruleRBRACE]() {
+								goto l649
 							}
+							depth--
+							add(ruleinlineDataFull, position670)
 						}
-
-						goto l608
-					l607:
-						position, tokenIndex, depth = position607, tokenIndex607, depth607
 					}
-				l608:
+				l665:
 					depth--
-					add(rulevalueLogical, position606)
-				}
-				{
-					position637, tokenIndex637, depth637 := position, tokenIndex, depth
-					{
-						position639 := position
-						depth++
-						if buffer[position] != rune('&') {
-							goto l637
-						}
-						position++
-						if buffer[position] != rune('&') {
-							goto l637
-						}
-						position++
-						if !rules[ruleskip]() {
-							goto l637
-						}
-						depth--
-						add(ruleAND, position639)
-					}
-					if !rules[ruleconditionalAndExpression]() {
-						goto l637
-					}
-					goto l638
-				l637:
-					position, tokenIndex, depth = position637, tokenIndex637, depth637
+					add(ruledataBlock, position664)
 				}
-			l638:
 				depth--
-				add(ruleconditionalAndExpression, position605)
+				add(ruleinlineData, position650)
 			}
 			return true
-		l604:
-			position, tokenIndex, depth = position604, tokenIndex604, depth604
+		l649:
+			position, tokenIndex, depth = position649, tokenIndex649, depth649
 			return false
 		},
-		/* 56 valueLogical <- <(numericExpression ((&('N' | 'n') notin) | (&('I' | 'i') in) | (&('!' | '<' | '=' | '>') ((LT / GE / ((&('>') GT) | (&('<') LE) | (&('!') NE) | (&('=') EQ))) numericExpression)))?)> */
+		/* 58 dataBlock <- <(inlineDataOneVar / inlineDataFull)> */
+		nil,
+		/* 59 inlineDataOneVar <- <(var LBRACE dataBlockValue* RBRACE)> */
 		nil,
-		/* 57 numericExpression <- <(multiplicativeExpression (((PLUS / MINUS) multiplicativeExpression) / signedNumericLiteral)*)> */
+		/* 60 inlineDataFull <- <((nil / (LPAREN var* RPAREN)) LBRACE ((LPAREN dataBlockValue* RPAREN) / nil)* RBRACE)> */
+		nil,
+		/* 61 dataBlockValue <- <(iriref / literal / numericLiteral / booleanLiteral / UNDEF)> */
 		func() bool {
-			position641, tokenIndex641, depth641 := position, tokenIndex, depth
+			position684, tokenIndex684, depth684 := position, tokenIndex, depth
 			{
-				position642 := position
+				position685 := position
 				depth++
-				if !rules[rulemultiplicativeExpression]() {
-					goto l641
-				}
-			l643:
 				{
-					position644, tokenIndex644, depth644 := position, tokenIndex, depth
+					position686, tokenIndex686, depth686 := position, tokenIndex, depth
+					if !_rules[ruleiriref]() {
+						goto l687
+					}
+					goto l686
+				l687:
+					position, tokenIndex, depth = position686, tokenIndex686, depth686
+					if !_rules[ruleliteral]() {
+						goto l688
+					}
+					goto l686
+				l688:
+					position, tokenIndex, depth = position686, tokenIndex686, depth686
+					if !_rules[rulenumericLiteral]() {
+						goto l689
+					}
+					goto l686
+				l689:
+					position, tokenIndex, depth = position686, tokenIndex686, depth686
+					if !_rules[rulebooleanLiteral]() {
+						goto l690
+					}
+					goto l686
+				l690:
+					position, tokenIndex, depth = position686, tokenIndex686, depth686
 					{
-						position645, tokenIndex645, depth645 := position, tokenIndex, depth
+						position691 := position
+						depth++
 						{
-							position647, tokenIndex647, depth647 := position, tokenIndex, depth
-							if !rules[rulePLUS]() {
-								goto l648
+							position692, tokenIndex692, depth692 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l693
 							}
-							goto l647
-						l648:
-							position, tokenIndex, depth = position647, tokenIndex647, depth647
-							if !rules[ruleMINUS]() {
-								goto l646
+							position++
+							goto l692
+						l693:
+							position, tokenIndex, depth = position692, tokenIndex692, depth692
+							if buffer[position] != rune('U') {
+								goto l684
 							}
+							position++
 						}
-					l647:
-						if !rules[rulemultiplicativeExpression]() {
-							goto l646
+					l692:
+						{
+							position694, tokenIndex694, depth694 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l695
+							}
+							position++
+							goto l694
+						l695:
+							position, tokenIndex, depth = position694, tokenIndex694, depth694
+							if buffer[position] != rune('N') {
+								goto l684
+							}
+							position++
 						}
-						goto l645
-					l646:
-						position, tokenIndex, depth = position645, tokenIndex645, depth645
+					l694:
 						{
-							position649 := position
-							depth++
-							{
-								position650, tokenIndex650, depth650 := position, tokenIndex, depth
-								if buffer[position] != rune('+') {
-									goto l651
-								}
-								position++
-								goto l650
-							l651:
-								position, tokenIndex, depth = position650, tokenIndex650, depth650
-								if buffer[position] != rune('-') {
-									goto l644
-								}
-								position++
+							position696, tokenIndex696, depth696 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l697
 							}
-						l650:
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l644
+							position++
+							goto l696
+						l697:
+							position, tokenIndex, depth = position696, tokenIndex696, depth696
+							if buffer[position] != rune('D') {
+								goto l684
 							}
 							position++
-						l652:
-							{
-								position653, tokenIndex653, depth653 := position, tokenIndex, depth
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l653
-								}
-								position++
-								goto l652
-							l653:
-								position, tokenIndex, depth = position653, tokenIndex653, depth653
+						}
+					l696:
+						{
+							position698, tokenIndex698, depth698 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l699
 							}
-							{
-								position654, tokenIndex654, depth654 := position, tokenIndex, depth
-								if buffer[position] != rune('.') {
-									goto l654
-								}
-								position++
-							l656:
-								{
-									position657, tokenIndex657, depth657 := position, tokenIndex, depth
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l657
-									}
-									position++
-									goto l656
-								l657:
-									position, tokenIndex, depth = position657, tokenIndex657, depth657
-								}
-								goto l655
-							l654:
-								position, tokenIndex, depth = position654, tokenIndex654, depth654
+							position++
+							goto l698
+						l699:
+							position, tokenIndex, depth = position698, tokenIndex698, depth698
+							if buffer[position] != rune('E') {
+								goto l684
 							}
-						l655:
-							if !rules[ruleskip]() {
-								goto l644
+							position++
+						}
+					l698:
+						{
+							position700, tokenIndex700, depth700 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l701
 							}
-							depth--
-							add(rulesignedNumericLiteral, position649)
+							position++
+							goto l700
+						l701:
+							position, tokenIndex, depth = position700, tokenIndex700, depth700
+							if buffer[position] != rune('F') {
+								goto l684
+							}
+							position++
+						}
+					l700:
+						if !_rules[ruleskip]() {
+							goto l684
 						}
+						depth--
+						add(ruleUNDEF, position691)
 					}
-				l645:
-					goto l643
-				l644:
-					position, tokenIndex, depth = position644, tokenIndex644, depth644
+				}
+			l686:
+				depth--
+				add(ruledataBlockValue, position685)
+			}
+			return true
+		l684:
+			position, tokenIndex, depth = position684, tokenIndex684, depth684
+			return false
+		},
+		/* 62 expression <- <conditionalOrExpression> */
+		func() bool {
+			position702, tokenIndex702, depth702 := position, tokenIndex, depth
+			{
+				position703 := position
+				depth++
+				if !_rules[ruleconditionalOrExpression]() {
+					goto l702
 				}
 				depth--
-				add(rulenumericExpression, position642)
+				add(ruleexpression, position703)
 			}
 			return true
-		l641:
-			position, tokenIndex, depth = position641, tokenIndex641, depth641
+		l702:
+			position, tokenIndex, depth = position702, tokenIndex702, depth702
 			return false
 		},
-		/* 58 multiplicativeExpression <- <(unaryExpression ((STAR / SLASH) unaryExpression)*)> */
+		/* 63 conditionalOrExpression <- <(conditionalAndExpression (OR conditionalOrExpression)?)> */
 		func() bool {
-			position658, tokenIndex658, depth658 := position, tokenIndex, depth
+			position704, tokenIndex704, depth704 := position, tokenIndex, depth
 			{
-				position659 := position
+				position705 := position
 				depth++
-				if !rules[ruleunaryExpression]() {
-					goto l658
+				if !_rules[ruleconditionalAndExpression]() {
+					goto l704
 				}
-			l660:
 				{
-					position661, tokenIndex661, depth661 := position, tokenIndex, depth
+					position706, tokenIndex706, depth706 := position, tokenIndex, depth
 					{
-						position662, tokenIndex662, depth662 := position, tokenIndex, depth
-						if !rules[ruleSTAR]() {
-							goto l663
+						position708 := position
+						depth++
+						if buffer[position] != rune('|') {
+							goto l706
 						}
-						goto l662
-					l663:
-						position, tokenIndex, depth = position662, tokenIndex662, depth662
-						if !rules[ruleSLASH]() {
-							goto l661
+						position++
+						if buffer[position] != rune('|') {
+							goto l706
+						}
+						position++
+						if !_rules[ruleskip]() {
+							goto l706
 						}
+						depth--
+						add(ruleOR, position708)
 					}
-				l662:
-					if !rules[ruleunaryExpression]() {
-						goto l661
+					if !_rules[ruleconditionalOrExpression]() {
+						goto l706
 					}
-					goto l660
-				l661:
-					position, tokenIndex, depth = position661, tokenIndex661, depth661
+					goto l707
+				l706:
+					position, tokenIndex, depth = position706, tokenIndex706, depth706
 				}
+			l707:
 				depth--
-				add(rulemultiplicativeExpression, position659)
+				add(ruleconditionalOrExpression, position705)
 			}
 			return true
-		l658:
-			position, tokenIndex, depth = position658, tokenIndex658, depth658
+		l704:
+			position, tokenIndex, depth = position704, tokenIndex704, depth704
 			return false
 		},
-		/* 59 unaryExpression <- <(((&('+') PLUS) | (&('-') MINUS) | (&('!') NOT))? primaryExpression)> */
+		/* 64 conditionalAndExpression <- <(valueLogical (AND conditionalAndExpression)?)> */
 		func() bool {
-			position664, tokenIndex664, depth664 := position, tokenIndex, depth
+			position709, tokenIndex709, depth709 := position, tokenIndex, depth
 			{
-				position665 := position
+				position710 := position
 				depth++
 				{
-					position666, tokenIndex666, depth666 := position, tokenIndex, depth
-					{
-						switch buffer[position] {
-						case '+':
-							if !rules[rulePLUS]() {
-								goto l666
-							}
-							break
-						case '-':
-							if !rules[ruleMINUS]() {
-								goto l666
-							}
-							break
-						default:
-							if !rules[ruleNOT]() {
-								goto l666
-							}
-							break
-						}
-					}
-
-					goto l667
-				l666:
-					position, tokenIndex, depth = position666, tokenIndex666, depth666
-				}
-			l667:
-				{
-					position669 := position
+					position711 := position
 					depth++
+					if !_rules[rulenumericExpression]() {
+						goto l709
+					}
 					{
-						position670, tokenIndex670, depth670 := position, tokenIndex, depth
-						if !rules[rulebuiltinCall]() {
-							goto l671
-						}
-						goto l670
-					l671:
-						position, tokenIndex, depth = position670, tokenIndex670, depth670
-						if !rules[rulefunctionCall]() {
-							goto l672
-						}
-						goto l670
-					l672:
-						position, tokenIndex, depth = position670, tokenIndex670, depth670
-						if !rules[ruleiriref]() {
-							goto l673
-						}
-						goto l670
-					l673:
-						position, tokenIndex, depth = position670, tokenIndex670, depth670
-						{
-							switch buffer[position] {
-							case 'A', 'C', 'G', 'M', 'S', 'a', 'c', 'g', 'm', 's':
+						position712, tokenIndex712, depth712 := position, tokenIndex, depth
+						{
+							position714, tokenIndex714, depth714 := position, tokenIndex, depth
+							{
+								position716, tokenIndex716, depth716 := position, tokenIndex, depth
+								if !_rules[ruleEQ]() {
+									goto l717
+								}
+								goto l716
+							l717:
+								position, tokenIndex, depth = position716, tokenIndex716, depth716
 								{
-									position675 := position
+									position719 := position
 									depth++
-									{
-										switch buffer[position] {
-										case 'G', 'g':
-											{
-												position677 := position
-												depth++
-												{
-													position678 := position
-													depth++
-													{
-														position679, tokenIndex679, depth679 := position, tokenIndex, depth
-														if buffer[position] != rune('g') {
-															goto l680
-														}
-														position++
-														goto l679
-													l680:
-														position, tokenIndex, depth = position679, tokenIndex679, depth679
-														if buffer[position] != rune('G') {
-															goto l664
-														}
-														position++
-													}
-												l679:
-													{
-														position681, tokenIndex681, depth681 := position, tokenIndex, depth
-														if buffer[position] != rune('r') {
-															goto l682
-														}
-														position++
-														goto l681
-													l682:
-														position, tokenIndex, depth = position681, tokenIndex681, depth681
-														if buffer[position] != rune('R') {
-															goto l664
-														}
-														position++
-													}
-												l681:
-													{
-														position683, tokenIndex683, depth683 := position, tokenIndex, depth
-														if buffer[position] != rune('o') {
-															goto l684
-														}
-														position++
-														goto l683
-													l684:
-														position, tokenIndex, depth = position683, tokenIndex683, depth683
-														if buffer[position] != rune('O') {
-															goto l664
-														}
-														position++
-													}
-												l683:
-													{
-														position685, tokenIndex685, depth685 := position, tokenIndex, depth
-														if buffer[position] != rune('u') {
-															goto l686
-														}
-														position++
-														goto l685
-													l686:
-														position, tokenIndex, depth = position685, tokenIndex685, depth685
-														if buffer[position] != rune('U') {
-															goto l664
-														}
-														position++
-													}
-												l685:
-													{
-														position687, tokenIndex687, depth687 := position, tokenIndex, depth
-														if buffer[position] != rune('p') {
-															goto l688
-														}
-														position++
-														goto l687
-													l688:
-														position, tokenIndex, depth = position687, tokenIndex687, depth687
-														if buffer[position] != rune('P') {
-															goto l664
-														}
-														position++
-													}
-												l687:
-													if buffer[position] != rune('_') {
-														goto l664
-													}
-													position++
-													{
-														position689, tokenIndex689, depth689 := position, tokenIndex, depth
-														if buffer[position] != rune('c') {
-															goto l690
-														}
-														position++
-														goto l689
-													l690:
-														position, tokenIndex, depth = position689, tokenIndex689, depth689
-														if buffer[position] != rune('C') {
-															goto l664
-														}
-														position++
-													}
-												l689:
-													{
-														position691, tokenIndex691, depth691 := position, tokenIndex, depth
-														if buffer[position] != rune('o') {
-															goto l692
-														}
-														position++
-														goto l691
-													l692:
-														position, tokenIndex, depth = position691, tokenIndex691, depth691
-														if buffer[position] != rune('O') {
-															goto l664
-														}
-														position++
-													}
-												l691:
-													{
-														position693, tokenIndex693, depth693 := position, tokenIndex, depth
-														if buffer[position] != rune('n') {
-															goto l694
-														}
-														position++
-														goto l693
-													l694:
-														position, tokenIndex, depth = position693, tokenIndex693, depth693
-														if buffer[position] != rune('N') {
-															goto l664
-														}
-														position++
-													}
-												l693:
-													{
-														position695, tokenIndex695, depth695 := position, tokenIndex, depth
-														if buffer[position] != rune('c') {
-															goto l696
-														}
-														position++
-														goto l695
-													l696:
-														position, tokenIndex, depth = position695, tokenIndex695, depth695
-														if buffer[position] != rune('C') {
-															goto l664
-														}
-														position++
-													}
-												l695:
-													{
-														position697, tokenIndex697, depth697 := position, tokenIndex, depth
-														if buffer[position] != rune('a') {
-															goto l698
-														}
-														position++
-														goto l697
-													l698:
-														position, tokenIndex, depth = position697, tokenIndex697, depth697
-														if buffer[position] != rune('A') {
-															goto l664
-														}
-														position++
-													}
-												l697:
-													{
-														position699, tokenIndex699, depth699 := position, tokenIndex, depth
-														if buffer[position] != rune('t') {
-															goto l700
-														}
-														position++
-														goto l699
-													l700:
-														position, tokenIndex, depth = position699, tokenIndex699, depth699
-														if buffer[position] != rune('T') {
-															goto l664
-														}
-														position++
-													}
-												l699:
-													if !rules[ruleskip]() {
-														goto l664
-													}
-													depth--
-													add(ruleGROUPCONCAT, position678)
-												}
-												if !rules[ruleLPAREN]() {
-													goto l664
-												}
-												{
-													position701, tokenIndex701, depth701 := position, tokenIndex, depth
-													if !rules[ruleDISTINCT]() {
-														goto l701
-													}
-													goto l702
-												l701:
-													position, tokenIndex, depth = position701, tokenIndex701, depth701
-												}
-											l702:
-												if !rules[ruleexpression]() {
-													goto l664
-												}
-												{
-													position703, tokenIndex703, depth703 := position, tokenIndex, depth
-													if !rules[ruleSEMICOLON]() {
-														goto l703
-													}
-													{
-														position705 := position
-														depth++
-														{
-															position706, tokenIndex706, depth706 := position, tokenIndex, depth
-															if buffer[position] != rune('s') {
-																goto l707
-															}
-															position++
-															goto l706
-														l707:
-															position, tokenIndex, depth = position706, tokenIndex706, depth706
-															if buffer[position] != rune('S') {
-																goto l703
-															}
-															position++
-														}
-													l706:
-														{
-															position708, tokenIndex708, depth708 := position, tokenIndex, depth
-															if buffer[position] != rune('e') {
-																goto l709
-															}
-															position++
-															goto l708
-														l709:
-															position, tokenIndex, depth = position708, tokenIndex708, depth708
-															if buffer[position] != rune('E') {
-																goto l703
-															}
-															position++
-														}
-													l708:
-														{
-															position710, tokenIndex710, depth710 := position, tokenIndex, depth
-															if buffer[position] != rune('p') {
-																goto l711
-															}
-															position++
-															goto l710
-														l711:
-															position, tokenIndex, depth = position710, tokenIndex710, depth710
-															if buffer[position] != rune('P') {
-																goto l703
-															}
-															position++
-														}
-													l710:
-														{
-															position712, tokenIndex712, depth712 := position, tokenIndex, depth
-															if buffer[position] != rune('a') {
-																goto l713
-															}
-															position++
-															goto l712
-														l713:
-															position, tokenIndex, depth = position712, tokenIndex712, depth712
-															if buffer[position] != rune('A') {
-																goto l703
-															}
-															position++
-														}
-													l712:
-														{
-															position714, tokenIndex714, depth714 := position, tokenIndex, depth
-															if buffer[position] != rune('r') {
-																goto l715
-															}
-															position++
-															goto l714
-														l715:
-															position, tokenIndex, depth = position714, tokenIndex714, depth714
-															if buffer[position] != rune('R') {
-																goto l703
-															}
-															position++
-														}
-													l714:
-														{
-															position716, tokenIndex716, depth716 := position, tokenIndex, depth
-															if buffer[position] != rune('a') {
-																goto l717
-															}
-															position++
-															goto l716
-														l717:
-															position, tokenIndex, depth = position716, tokenIndex716, depth716
-															if buffer[position] != rune('A') {
-																goto l703
-															}
-															position++
-														}
-													l716:
-														{
-															position718, tokenIndex718, depth718 := position, tokenIndex, depth
-															if buffer[position] != rune('t') {
-																goto l719
-															}
-															position++
-															goto l718
-														l719:
-															position, tokenIndex, depth = position718, tokenIndex718, depth718
-															if buffer[position] != rune('T') {
-																goto l703
-															}
-															position++
-														}
-													l718:
-														{
-															position720, tokenIndex720, depth720 := position, tokenIndex, depth
-															if buffer[position] != rune('o') {
-																goto l721
-															}
-															position++
-															goto l720
-														l721:
-															position, tokenIndex, depth = position720, tokenIndex720, depth720
-															if buffer[position] != rune('O') {
-																goto l703
-															}
-															position++
-														}
-													l720:
-														{
-															position722, tokenIndex722, depth722 := position, tokenIndex, depth
-															if buffer[position] != rune('r') {
-																goto l723
-															}
-															position++
-															goto l722
-														l723:
-															position, tokenIndex, depth = position722, tokenIndex722, depth722
-															if buffer[position] != rune('R') {
-																goto l703
-															}
-															position++
-														}
-													l722:
-														if !rules[ruleskip]() {
-															goto l703
-														}
-														depth--
-														add(ruleSEPARATOR, position705)
-													}
-													if !rules[ruleEQ]() {
-														goto l703
-													}
-													if !rules[rulestring]() {
-														goto l703
-													}
-													goto l704
-												l703:
-													position, tokenIndex, depth = position703, tokenIndex703, depth703
-												}
-											l704:
-												if !rules[ruleRPAREN]() {
-													goto l664
-												}
-												depth--
-												add(rulegroupConcat, position677)
-											}
-											break
-										case 'C', 'c':
-											{
-												position724 := position
-												depth++
-												{
-													position725 := position
-													depth++
-													{
-														position726, tokenIndex726, depth726 := position, tokenIndex, depth
-														if buffer[position] != rune('c') {
-															goto l727
-														}
-														position++
-														goto l726
-													l727:
-														position, tokenIndex, depth = position726, tokenIndex726, depth726
-														if buffer[position] != rune('C') {
-															goto l664
-														}
-														position++
-													}
-												l726:
-													{
-														position728, tokenIndex728, depth728 := position, tokenIndex, depth
-														if buffer[position] != rune('o') {
-															goto l729
-														}
-														position++
-														goto l728
-													l729:
-														position, tokenIndex, depth = position728, tokenIndex728, depth728
-														if buffer[position] != rune('O') {
-															goto l664
-														}
-														position++
-													}
-												l728:
-													{
-														position730, tokenIndex730, depth730 := position, tokenIndex, depth
-														if buffer[position] != rune('u') {
-															goto l731
-														}
-														position++
-														goto l730
-													l731:
-														position, tokenIndex, depth = position730, tokenIndex730, depth730
-														if buffer[position] != rune('U') {
-															goto l664
-														}
-														position++
-													}
-												l730:
-													{
-														position732, tokenIndex732, depth732 := position, tokenIndex, depth
-														if buffer[position] != rune('n') {
-															goto l733
-														}
-														position++
-														goto l732
-													l733:
-														position, tokenIndex, depth = position732, tokenIndex732, depth732
-														if buffer[position] != rune('N') {
-															goto l664
-														}
-														position++
-													}
-												l732:
-													{
-														position734, tokenIndex734, depth734 := position, tokenIndex, depth
-														if buffer[position] != rune('t') {
-															goto l735
-														}
-														position++
-														goto l734
-													l735:
-														position, tokenIndex, depth = position734, tokenIndex734, depth734
-														if buffer[position] != rune('T') {
-															goto l664
-														}
-														position++
-													}
-												l734:
-													if !rules[ruleskip]() {
-														goto l664
-													}
-													depth--
-													add(ruleCOUNT, position725)
-												}
-												if !rules[ruleLPAREN]() {
-													goto l664
-												}
-												{
-													position736, tokenIndex736, depth736 := position, tokenIndex, depth
-													if !rules[ruleDISTINCT]() {
-														goto l736
-													}
-													goto l737
-												l736:
-													position, tokenIndex, depth = position736, tokenIndex736, depth736
-												}
-											l737:
-												{
-													position738, tokenIndex738, depth738 := position, tokenIndex, depth
-													if !rules[ruleSTAR]() {
-														goto l739
-													}
-													goto l738
-												l739:
-													position, tokenIndex, depth = position738, tokenIndex738, depth738
-													if !rules[ruleexpression]() {
-														goto l664
-													}
-												}
-											l738:
-												if !rules[ruleRPAREN]() {
-													goto l664
-												}
-												depth--
-												add(rulecount, position724)
-											}
-											break
-										default:
-											{
-												position740, tokenIndex740, depth740 := position, tokenIndex, depth
-												{
-													position742 := position
-													depth++
-													{
-														position743, tokenIndex743, depth743 := position, tokenIndex, depth
-														if buffer[position] != rune('s') {
-															goto l744
-														}
-														position++
-														goto l743
-													l744:
-														position, tokenIndex, depth = position743, tokenIndex743, depth743
-														if buffer[position] != rune('S') {
-															goto l741
-														}
-														position++
-													}
-												l743:
-													{
-														position745, tokenIndex745, depth745 := position, tokenIndex, depth
-														if buffer[position] != rune('u') {
-															goto l746
-														}
-														position++
-														goto l745
-													l746:
-														position, tokenIndex, depth = position745, tokenIndex745, depth745
-														if buffer[position] != rune('U') {
-															goto l741
-														}
-														position++
-													}
-												l745:
-													{
-														position747, tokenIndex747, depth747 := position, tokenIndex, depth
-														if buffer[position] != rune('m') {
-															goto l748
-														}
-														position++
-														goto l747
-													l748:
-														position, tokenIndex, depth = position747, tokenIndex747, depth747
-														if buffer[position] != rune('M') {
-															goto l741
-														}
-														position++
-													}
-												l747:
-													if !rules[ruleskip]() {
-														goto l741
-													}
-													depth--
-													add(ruleSUM, position742)
-												}
-												goto l740
-											l741:
-												position, tokenIndex, depth = position740, tokenIndex740, depth740
-												{
-													position750 := position
-													depth++
-													{
-														position751, tokenIndex751, depth751 := position, tokenIndex, depth
-														if buffer[position] != rune('m') {
-															goto l752
-														}
-														position++
-														goto l751
-													l752:
-														position, tokenIndex, depth = position751, tokenIndex751, depth751
-														if buffer[position] != rune('M') {
-															goto l749
-														}
-														position++
-													}
-												l751:
-													{
-														position753, tokenIndex753, depth753 := position, tokenIndex, depth
-														if buffer[position] != rune('i') {
-															goto l754
-														}
-														position++
-														goto l753
-													l754:
-														position, tokenIndex, depth = position753, tokenIndex753, depth753
-														if buffer[position] != rune('I') {
-															goto l749
-														}
-														position++
-													}
-												l753:
-													{
-														position755, tokenIndex755, depth755 := position, tokenIndex, depth
-														if buffer[position] != rune('n') {
-															goto l756
-														}
-														position++
-														goto l755
-													l756:
-														position, tokenIndex, depth = position755, tokenIndex755, depth755
-														if buffer[position] != rune('N') {
-															goto l749
-														}
-														position++
-													}
-												l755:
-													if !rules[ruleskip]() {
-														goto l749
-													}
-													depth--
-													add(ruleMIN, position750)
-												}
-												goto l740
-											l749:
-												position, tokenIndex, depth = position740, tokenIndex740, depth740
-												{
-													switch buffer[position] {
-													case 'S', 's':
-														{
-															position758 := position
-															depth++
-															{
-																position759, tokenIndex759, depth759 := position, tokenIndex, depth
-																if buffer[position] != rune('s') {
-																	goto l760
-																}
-																position++
-																goto l759
-															l760:
-																position, tokenIndex, depth = position759, tokenIndex759, depth759
-																if buffer[position] != rune('S') {
-																	goto l664
-																}
-																position++
-															}
-														l759:
-															{
-																position761, tokenIndex761, depth761 := position, tokenIndex, depth
-																if buffer[position] != rune('a') {
-																	goto l762
-																}
-																position++
-																goto l761
-															l762:
-																position, tokenIndex, depth = position761, tokenIndex761, depth761
-																if buffer[position] != rune('A') {
-																	goto l664
-																}
-																position++
-															}
-														l761:
-															{
-																position763, tokenIndex763, depth763 := position, tokenIndex, depth
-																if buffer[position] != rune('m') {
-																	goto l764
-																}
-																position++
-																goto l763
-															l764:
-																position, tokenIndex, depth = position763, tokenIndex763, depth763
-																if buffer[position] != rune('M') {
-																	goto l664
-																}
-																position++
-															}
-														l763:
-															{
-																position765, tokenIndex765, depth765 := position, tokenIndex, depth
-																if buffer[position] != rune('p') {
-																	goto l766
-																}
-																position++
-																goto l765
-															l766:
-																position, tokenIndex, depth = position765, tokenIndex765, depth765
-																if buffer[position] != rune('P') {
-																	goto l664
-																}
-																position++
-															}
-														l765:
-															{
-																position767, tokenIndex767, depth767 := position, tokenIndex, depth
-																if buffer[position] != rune('l') {
-																	goto l768
-																}
-																position++
-																goto l767
-															l768:
-																position, tokenIndex, depth = position767, tokenIndex767, depth767
-																if buffer[position] != rune('L') {
-																	goto l664
-																}
-																position++
-															}
-														l767:
-															{
-																position769, tokenIndex769, depth769 := position, tokenIndex, depth
-																if buffer[position] != rune('e') {
-																	goto l770
-																}
-																position++
-																goto l769
-															l770:
-																position, tokenIndex, depth = position769, tokenIndex769, depth769
-																if buffer[position] != rune('E') {
-																	goto l664
-																}
-																position++
-															}
-														l769:
-															if !rules[ruleskip]() {
-																goto l664
-															}
-															depth--
-															add(ruleSAMPLE, position758)
-														}
-														break
-													case 'A', 'a':
-														{
-															position771 := position
-															depth++
-															{
-																position772, tokenIndex772, depth772 := position, tokenIndex, depth
-																if buffer[position] != rune('a') {
-																	goto l773
-																}
-																position++
-																goto l772
-															l773:
-																position, tokenIndex, depth = position772, tokenIndex772, depth772
-																if buffer[position] != rune('A') {
-																	goto l664
-																}
-																position++
-															}
-														l772:
-															{
-																position774, tokenIndex774, depth774 := position, tokenIndex, depth
-																if buffer[position] != rune('v') {
-																	goto l775
-																}
-																position++
-																goto l774
-															l775:
-																position, tokenIndex, depth = position774, tokenIndex774, depth774
-																if buffer[position] != rune('V') {
-																	goto l664
-																}
-																position++
-															}
-														l774:
-															{
-																position776, tokenIndex776, depth776 := position, tokenIndex, depth
-																if buffer[position] != rune('g') {
-																	goto l777
-																}
-																position++
-																goto l776
-															l777:
-																position, tokenIndex, depth = position776, tokenIndex776, depth776
-																if buffer[position] != rune('G') {
-																	goto l664
-																}
-																position++
-															}
-														l776:
-															if !rules[ruleskip]() {
-																goto l664
-															}
-															depth--
-															add(ruleAVG, position771)
-														}
-														break
-													default:
-														{
-															position778 := position
-															depth++
-															{
-																position779, tokenIndex779, depth779 := position, tokenIndex, depth
-																if buffer[position] != rune('m') {
-																	goto l780
-																}
-																position++
-																goto l779
-															l780:
-																position, tokenIndex, depth = position779, tokenIndex779, depth779
-																if buffer[position] != rune('M') {
-																	goto l664
-																}
-																position++
-															}
-														l779:
-															{
-																position781, tokenIndex781, depth781 := position, tokenIndex, depth
-																if buffer[position] != rune('a') {
-																	goto l782
-																}
-																position++
-																goto l781
-															l782:
-																position, tokenIndex, depth = position781, tokenIndex781, depth781
-																if buffer[position] != rune('A') {
-																	goto l664
-																}
-																position++
-															}
-														l781:
-															{
-																position783, tokenIndex783, depth783 := position, tokenIndex, depth
-																if buffer[position] != rune('x') {
-																	goto l784
-																}
-																position++
-																goto l783
-															l784:
-																position, tokenIndex, depth = position783, tokenIndex783, depth783
-																if buffer[position] != rune('X') {
-																	goto l664
-																}
-																position++
-															}
-														l783:
-															if !rules[ruleskip]() {
-																goto l664
-															}
-															depth--
-															add(ruleMAX, position778)
-														}
-														break
-													}
-												}
-
-											}
-										l740:
-											if !rules[ruleLPAREN]() {
-												goto l664
-											}
-											{
-												position785, tokenIndex785, depth785 := position, tokenIndex, depth
-												if !rules[ruleDISTINCT]() {
-													goto l785
-												}
-												goto l786
-											l785:
-												position, tokenIndex, depth = position785, tokenIndex785, depth785
-											}
-										l786:
-											if !rules[ruleexpression]() {
-												goto l664
-											}
-											if !rules[ruleRPAREN]() {
-												goto l664
-											}
-											break
-										}
+									if buffer[position] != rune('!') {
+										goto l718
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l718
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l718
 									}
-
 									depth--
-									add(ruleaggregate, position675)
+									add(ruleNE, position719)
 								}
-								break
-							case '$', '?':
-								if !rules[rulevar]() {
-									goto l664
+								goto l716
+							l718:
+								position, tokenIndex, depth = position716, tokenIndex716, depth716
+								{
+									position721 := position
+									depth++
+									if buffer[position] != rune('<') {
+										goto l720
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l720
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l720
+									}
+									depth--
+									add(ruleLE, position721)
 								}
-								break
-							case 'F', 'T', 'f', 't':
-								if !rules[rulebooleanLiteral]() {
-									goto l664
+								goto l716
+							l720:
+								position, tokenIndex, depth = position716, tokenIndex716, depth716
+								{
+									position723 := position
+									depth++
+									if buffer[position] != rune('>') {
+										goto l722
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l722
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l722
+									}
+									depth--
+									add(ruleGE, position723)
 								}
-								break
-							case '(':
-								if !rules[rulebrackettedExpression]() {
-									goto l664
+								goto l716
+							l722:
+								position, tokenIndex, depth = position716, tokenIndex716, depth716
+								{
+									position725 := position
+									depth++
+									if buffer[position] != rune('<') {
+										goto l724
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l724
+									}
+									depth--
+									add(ruleLT, position725)
 								}
-								break
-							case '"', '\'':
-								if !rules[ruleliteral]() {
-									goto l664
+								goto l716
+							l724:
+								position, tokenIndex, depth = position716, tokenIndex716, depth716
+								{
+									position726 := position
+									depth++
+									if buffer[position] != rune('>') {
+										goto l715
+									}
+									position++
+									if !_rules[ruleskip]() {
+										goto l715
+									}
+									depth--
+									add(ruleGT, position726)
+								}
+							}
+						l716:
+							if !_rules[rulenumericExpression]() {
+								goto l715
+							}
+							goto l714
+						l715:
+							position, tokenIndex, depth = position714, tokenIndex714, depth714
+							{
+								position728 := position
+								depth++
+								{
+									position729 := position
+									depth++
+									{
+										position730, tokenIndex730, depth730 := position, tokenIndex, depth
+										if buffer[position] != rune('i') {
+											goto l731
+										}
+										position++
+										goto l730
+									l731:
+										position, tokenIndex, depth = position730, tokenIndex730, depth730
+										if buffer[position] != rune('I') {
+											goto l727
+										}
+										position++
+									}
+								l730:
+									{
+										position732, tokenIndex732, depth732 := position, tokenIndex, depth
+										if buffer[position] != rune('n') {
+											goto l733
+										}
+										position++
+										goto l732
+									l733:
+										position, tokenIndex, depth = position732, tokenIndex732, depth732
+										if buffer[position] != rune('N') {
+											goto l727
+										}
+										position++
+									}
+								l732:
+									if !_rules[ruleskip]() {
+										goto l727
+									}
+									depth--
+									add(ruleIN, position729)
+								}
+								if !_rules[ruleargList]() {
+									goto l727
+								}
+								depth--
+								add(rulein, position728)
+							}
+							goto l714
+						l727:
+							position, tokenIndex, depth = position714, tokenIndex714, depth714
+							{
+								position734 := position
+								depth++
+								{
+									position735 := position
+									depth++
+									{
+										position736, tokenIndex736, depth736 := position, tokenIndex, depth
+										if buffer[position] != rune('n') {
+											goto l737
+										}
+										position++
+										goto l736
+									l737:
+										position, tokenIndex, depth = position736, tokenIndex736, depth736
+										if buffer[position] != rune('N') {
+											goto l712
+										}
+										position++
+									}
+								l736:
+									{
+										position738, tokenIndex738, depth738 := position, tokenIndex, depth
+										if buffer[position] != rune('o') {
+											goto l739
+										}
+										position++
+										goto l738
+									l739:
+										position, tokenIndex, depth = position738, tokenIndex738, depth738
+										if buffer[position] != rune('O') {
+											goto l712
+										}
+										position++
+									}
+								l738:
+									{
+										position740, tokenIndex740, depth740 := position, tokenIndex, depth
+										if buffer[position] != rune('t') {
+											goto l741
+										}
+										position++
+										goto l740
+									l741:
+										position, tokenIndex, depth = position740, tokenIndex740, depth740
+										if buffer[position] != rune('T') {
+											goto l712
+										}
+										position++
+									}
+								l740:
+									if buffer[position] != rune(' ') {
+										goto l712
+									}
+									position++
+									{
+										position742, tokenIndex742, depth742 := position, tokenIndex, depth
+										if buffer[position] != rune('i') {
+											goto l743
+										}
+										position++
+										goto l742
+									l743:
+										position, tokenIndex, depth = position742, tokenIndex742, depth742
+										if buffer[position] != rune('I') {
+											goto l712
+										}
+										position++
+									}
+								l742:
+									{
+										position744, tokenIndex744, depth744 := position, tokenIndex, depth
+										if buffer[position] != rune('n') {
+											goto l745
+										}
+										position++
+										goto l744
+									l745:
+										position, tokenIndex, depth = position744, tokenIndex744, depth744
+										if buffer[position] != rune('N') {
+											goto l712
+										}
+										position++
+									}
+								l744:
+									if !_rules[ruleskip]() {
+										goto l712
+									}
+									depth--
+									add(ruleNOTIN, position735)
 								}
-								break
-							default:
-								if !rules[rulenumericLiteral]() {
-									goto l664
+								if !_rules[ruleargList]() {
+									goto l712
 								}
-								break
+								depth--
+								add(rulenotin, position734)
 							}
 						}
-
+					l714:
+						goto l713
+					l712:
+						position, tokenIndex, depth = position712, tokenIndex712, depth712
 					}
-				l670:
+				l713:
 					depth--
-					add(ruleprimaryExpression, position669)
+					add(rulevalueLogical, position711)
+				}
+				{
+					position746, tokenIndex746, depth746 := position, tokenIndex, depth
+					{
+						position748 := position
+						depth++
+						if buffer[position] != rune('&') {
+							goto l746
+						}
+						position++
+						if buffer[position] != rune('&') {
+							goto l746
+						}
+						position++
+						if !_rules[ruleskip]() {
+							goto l746
+						}
+						depth--
+						add(ruleAND, position748)
+					}
+					if !_rules[ruleconditionalAndExpression]() {
+						goto l746
+					}
+					goto l747
+				l746:
+					position, tokenIndex, depth = position746, tokenIndex746, depth746
 				}
+			l747:
 				depth--
-				add(ruleunaryExpression, position665)
+				add(ruleconditionalAndExpression, position710)
 			}
 			return true
-		l664:
-			position, tokenIndex, depth = position664, tokenIndex664, depth664
+		l709:
+			position, tokenIndex, depth = position709, tokenIndex709, depth709
 			return false
 		},
-		/* 60 primaryExpression <- <(builtinCall / functionCall / iriref / ((&('A' | 'C' | 'G' | 'M' | 'S' | 'a' | 'c' | 'g' | 'm' | 's') aggregate) | (&('$' | '?') var) | (&('F' | 'T' | 'f' | 't') booleanLiteral) | (&('(') brackettedExpression) | (&('"' | '\'') literal) | (&('+' | '-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') numericLiteral)))> */
+		/* 65 valueLogical <- <(numericExpression (((EQ / NE / LE / GE / LT / GT) numericExpression) / in / notin)?)> */
 		nil,
-		/* 61 brackettedExpression <- <(LPAREN expression RPAREN)> */
+		/* 66 numericExpression <- <(multiplicativeExpression (((PLUS / MINUS) multiplicativeExpression) / signedNumericLiteral)*)> */
 		func() bool {
-			position788, tokenIndex788, depth788 := position, tokenIndex, depth
+			position750, tokenIndex750, depth750 := position, tokenIndex, depth
 			{
-				position789 := position
+				position751 := position
 				depth++
-				if !rules[ruleLPAREN]() {
-					goto l788
+				if !_rules[rulemultiplicativeExpression]() {
+					goto l750
 				}
-				if !rules[ruleexpression]() {
-					goto l788
-				}
-				if !rules[ruleRPAREN]() {
-					goto l788
+			l752:
+				{
+					position753, tokenIndex753, depth753 := position, tokenIndex, depth
+					{
+						position754, tokenIndex754, depth754 := position, tokenIndex, depth
+						{
+							position756, tokenIndex756, depth756 := position, tokenIndex, depth
+							if !_rules[rulePLUS]() {
+								goto l757
+							}
+							goto l756
+						l757:
+							position, tokenIndex, depth = position756, tokenIndex756, depth756
+							if !_rules[ruleMINUS]() {
+								goto l755
+							}
+						}
+					l756:
+						if !_rules[rulemultiplicativeExpression]() {
+							goto l755
+						}
+						goto l754
+					l755:
+						position, tokenIndex, depth = position754, tokenIndex754, depth754
+						{
+							position758 := position
+							depth++
+							{
+								position759, tokenIndex759, depth759 := position, tokenIndex, depth
+								if buffer[position] != rune('+') {
+									goto l760
+								}
+								position++
+								goto l759
+							l760:
+								position, tokenIndex, depth = position759, tokenIndex759, depth759
+								if buffer[position] != rune('-') {
+									goto l753
+								}
+								position++
+							}
+						l759:
+							if !_rules[ruleunsignedNumericLiteral]() {
+								goto l753
+							}
+							if !_rules[ruleskip]() {
+								goto l753
+							}
+							depth--
+							add(rulesignedNumericLiteral, position758)
+						}
+					}
+				l754:
+					goto l752
+				l753:
+					position, tokenIndex, depth = position753, tokenIndex753, depth753
 				}
 				depth--
-				add(rulebrackettedExpression, position789)
+				add(rulenumericExpression, position751)
 			}
 			return true
-		l788:
-			position, tokenIndex, depth = position788, tokenIndex788, depth788
+		l750:
+			position, tokenIndex, depth = position750, tokenIndex750, depth750
 			return false
 		},
-		/* 62 functionCall <- <(iriref argList)> */
+		/* 67 multiplicativeExpression <- <(unaryExpression ((STAR / SLASH) unaryExpression)*)> */
 		func() bool {
-			position790, tokenIndex790, depth790 := position, tokenIndex, depth
+			position761, tokenIndex761, depth761 := position, tokenIndex, depth
 			{
-				position791 := position
+				position762 := position
 				depth++
-				if !rules[ruleiriref]() {
-					goto l790
-				}
-				if !rules[ruleargList]() {
-					goto l790
+				if !_rules[ruleunaryExpression]() {
+					goto l761
 				}
-				depth--
-				add(rulefunctionCall, position791)
-			}
-			return true
-		l790:
-			position, tokenIndex, depth = position790, tokenIndex790, depth790
-			return false
-		},
-		/* 63 in <- <(IN argList)> */
-		nil,
-		/* 64 notin <- <(NOTIN argList)> */
-		nil,
-		/* 65 argList <- <(nil / (LPAREN expression (COMMA expression)* RPAREN))> */
-		func() bool {
-			position794, tokenIndex794, depth794 := position, tokenIndex, depth
-			{
-				position795 := position
-				depth++
+			l763:
 				{
-					position796, tokenIndex796, depth796 := position, tokenIndex, depth
-					if !rules[rulenil]() {
-						goto l797
-					}
-					goto l796
-				l797:
-					position, tokenIndex, depth = position796, tokenIndex796, depth796
-					if !rules[ruleLPAREN]() {
-						goto l794
-					}
-					if !rules[ruleexpression]() {
-						goto l794
-					}
-				l798:
+					position764, tokenIndex764, depth764 := position, tokenIndex, depth
 					{
-						position799, tokenIndex799, depth799 := position, tokenIndex, depth
-						if !rules[ruleCOMMA]() {
-							goto l799
+						position765, tokenIndex765, depth765 := position, tokenIndex, depth
+						if !_rules[ruleSTAR]() {
+							goto l766
 						}
-						if !rules[ruleexpression]() {
-							goto l799
+						goto l765
+					l766:
+						position, tokenIndex, depth = position765, tokenIndex765, depth765
+						if !_rules[ruleSLASH]() {
+							goto l764
 						}
-						goto l798
-					l799:
-						position, tokenIndex, depth = position799, tokenIndex799, depth799
 					}
-					if !rules[ruleRPAREN]() {
-						goto l794
+				l765:
+					if !_rules[ruleunaryExpression]() {
+						goto l764
 					}
+					goto l763
+				l764:
+					position, tokenIndex, depth = position764, tokenIndex764, depth764
 				}
-			l796:
 				depth--
-				add(ruleargList, position795)
+				add(rulemultiplicativeExpression, position762)
 			}
 			return true
-		l794:
-			position, tokenIndex, depth = position794, tokenIndex794, depth794
+		l761:
+			position, tokenIndex, depth = position761, tokenIndex761, depth761
 			return false
 		},
-		/* 66 aggregate <- <((&('G' | 'g') groupConcat) | (&('C' | 'c') count) | (&('A' | 'M' | 'S' | 'a' | 'm' | 's') ((SUM / MIN / ((&('S' | 's') SAMPLE) | (&('A' | 'a') AVG) | (&('M' | 'm') MAX))) LPAREN DISTINCT? expression RPAREN)))> */
-		nil,
-		/* 67 count <- <(COUNT LPAREN DISTINCT? (STAR / expression) RPAREN)> */
-		nil,
-		/* 68 groupConcat <- <(GROUPCONCAT LPAREN DISTINCT? expression (SEMICOLON SEPARATOR EQ string)? RPAREN)> */
-		nil,
-		/* 69 builtinCall <- <(((STR / LANG / DATATYPE / IRI / URI / STRLEN / MONTH / MINUTES / SECONDS / TIMEZONE / SHA1 / SHA256 / SHA384 / ISIRI / ISURI / ISBLANK / ISLITERAL / ((&('I' | 'i') ISNUMERIC) | (&('S' | 's') SHA512) | (&('M' | 'm') MD5) | (&('T' | 't') TZ) | (&('H' | 'h') HOURS) | (&('D' | 'd') DAY) | (&('Y' | 'y') YEAR) | (&('E' | 'e') ENCODEFORURI) | (&('L' | 'l') LCASE) | (&('U' | 'u') UCASE) | (&('F' | 'f') FLOOR) | (&('R' | 'r') ROUND) | (&('C' | 'c') CEIL) | (&('A' | 'a') ABS))) LPAREN expression RPAREN) / ((STRSTARTS / STRENDS / STRBEFORE / STRAFTER / STRLANG / STRDT / ((&('S' | 's') SAMETERM) | (&('C' | 'c') CONTAINS) | (&('L' | 'l') LANGMATCHES))) LPAREN expression COMMA expression RPAREN) / (BOUND LPAREN var RPAREN) / (((&('S' | 's') STRUUID) | (&('U' | 'u') UUID) | (&('N' | 'n') NOW) | (&('R' | 'r') RAND)) nil) / ((&('E' | 'N' | 'e' | 'n') ((EXISTS / NOTEXIST) groupGraphPattern)) | (&('I' | 'i') (IF LPAREN expression COMMA expression COMMA expression RPAREN)) | (&('C' | 'c') ((CONCAT / COALESCE) argList)) | (&('B' | 'b') (BNODE ((LPAREN expression RPAREN) / nil))) | (&('R' | 'S' | 'r' | 's') ((SUBSTR / REPLACE / REGEX) LPAREN expression COMMA expression (COMMA expression)? RPAREN))))> */
+		/* 68 unaryExpression <- <((NOT / MINUS / PLUS)? primaryExpression)> */
 		func() bool {
-			position803, tokenIndex803, depth803 := position, tokenIndex, depth
+			position767, tokenIndex767, depth767 := position, tokenIndex, depth
 			{
-				position804 := position
+				position768 := position
 				depth++
 				{
-					position805, tokenIndex805, depth805 := position, tokenIndex, depth
+					position769, tokenIndex769, depth769 := position, tokenIndex, depth
 					{
-						position807, tokenIndex807, depth807 := position, tokenIndex, depth
-						{
-							position809 := position
-							depth++
-							{
-								position810, tokenIndex810, depth810 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l811
-								}
-								position++
-								goto l810
-							l811:
-								position, tokenIndex, depth = position810, tokenIndex810, depth810
-								if buffer[position] != rune('S') {
-									goto l808
-								}
-								position++
-							}
-						l810:
-							{
-								position812, tokenIndex812, depth812 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l813
-								}
-								position++
-								goto l812
-							l813:
-								position, tokenIndex, depth = position812, tokenIndex812, depth812
-								if buffer[position] != rune('T') {
-									goto l808
-								}
-								position++
-							}
-						l812:
-							{
-								position814, tokenIndex814, depth814 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l815
-								}
-								position++
-								goto l814
-							l815:
-								position, tokenIndex, depth = position814, tokenIndex814, depth814
-								if buffer[position] != rune('R') {
-									goto l808
-								}
-								position++
-							}
-						l814:
-							if !rules[ruleskip]() {
-								goto l808
-							}
-							depth--
-							add(ruleSTR, position809)
+						position771, tokenIndex771, depth771 := position, tokenIndex, depth
+						if !_rules[ruleNOT]() {
+							goto l772
 						}
-						goto l807
-					l808:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
-						{
-							position817 := position
-							depth++
-							{
-								position818, tokenIndex818, depth818 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l819
-								}
-								position++
-								goto l818
-							l819:
-								position, tokenIndex, depth = position818, tokenIndex818, depth818
-								if buffer[position] != rune('L') {
-									goto l816
-								}
-								position++
-							}
-						l818:
-							{
-								position820, tokenIndex820, depth820 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l821
-								}
-								position++
-								goto l820
-							l821:
-								position, tokenIndex, depth = position820, tokenIndex820, depth820
-								if buffer[position] != rune('A') {
-									goto l816
-								}
-								position++
-							}
-						l820:
-							{
-								position822, tokenIndex822, depth822 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l823
-								}
-								position++
-								goto l822
-							l823:
-								position, tokenIndex, depth = position822, tokenIndex822, depth822
-								if buffer[position] != rune('N') {
-									goto l816
-								}
-								position++
-							}
-						l822:
-							{
-								position824, tokenIndex824, depth824 := position, tokenIndex, depth
-								if buffer[position] != rune('g') {
-									goto l825
-								}
-								position++
-								goto l824
-							l825:
-								position, tokenIndex, depth = position824, tokenIndex824, depth824
-								if buffer[position] != rune('G') {
-									goto l816
-								}
-								position++
-							}
-						l824:
-							if !rules[ruleskip]() {
-								goto l816
-							}
-							depth--
-							add(ruleLANG, position817)
+						goto l771
+					l772:
+						position, tokenIndex, depth = position771, tokenIndex771, depth771
+						if !_rules[ruleMINUS]() {
+							goto l773
 						}
-						goto l807
-					l816:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l771
+					l773:
+						position, tokenIndex, depth = position771, tokenIndex771, depth771
+						if !_rules[rulePLUS]() {
+							goto l769
+						}
+					}
+				l771:
+					goto l770
+				l769:
+					position, tokenIndex, depth = position769, tokenIndex769, depth769
+				}
+			l770:
+				{
+					position774 := position
+					depth++
+					{
+						position775, tokenIndex775, depth775 := position, tokenIndex, depth
+						if !_rules[rulebrackettedExpression]() {
+							goto l776
+						}
+						goto l775
+					l776:
+						position, tokenIndex, depth = position775, tokenIndex775, depth775
+						if !_rules[rulebuiltinCall]() {
+							goto l777
+						}
+						goto l775
+					l777:
+						position, tokenIndex, depth = position775, tokenIndex775, depth775
+						if !_rules[rulefunctionCall]() {
+							goto l778
+						}
+						goto l775
+					l778:
+						position, tokenIndex, depth = position775, tokenIndex775, depth775
+						if !_rules[ruleiriref]() {
+							goto l779
+						}
+						goto l775
+					l779:
+						position, tokenIndex, depth = position775, tokenIndex775, depth775
+						if !_rules[ruleliteral]() {
+							goto l780
+						}
+						goto l775
+					l780:
+						position, tokenIndex, depth = position775, tokenIndex775, depth775
+						if !_rules[rulenumericLiteral]() {
+							goto l781
+						}
+						goto l775
+					l781:
+						position, tokenIndex, depth = position775, tokenIndex775, depth775
+						if !_rules[rulebooleanLiteral]() {
+							goto l782
+						}
+						goto l775
+					l782:
+						position, tokenIndex, depth = position775, tokenIndex775, depth775
+						if !_rules[rulevar]() {
+							goto l783
+						}
+						goto l775
+					l783:
+						position, tokenIndex, depth = position775, tokenIndex775, depth775
 						{
-							position827 := position
+							position784 := position
 							depth++
 							{
-								position828, tokenIndex828, depth828 := position, tokenIndex, depth
-								if buffer[position] != rune('d') {
-									goto l829
-								}
-								position++
-								goto l828
-							l829:
-								position, tokenIndex, depth = position828, tokenIndex828, depth828
-								if buffer[position] != rune('D') {
-									goto l826
-								}
-								position++
-							}
-						l828:
-							{
-								position830, tokenIndex830, depth830 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l831
-								}
-								position++
-								goto l830
-							l831:
-								position, tokenIndex, depth = position830, tokenIndex830, depth830
-								if buffer[position] != rune('A') {
-									goto l826
-								}
-								position++
-							}
-						l830:
-							{
-								position832, tokenIndex832, depth832 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l833
-								}
-								position++
-								goto l832
-							l833:
-								position, tokenIndex, depth = position832, tokenIndex832, depth832
-								if buffer[position] != rune('T') {
-									goto l826
-								}
-								position++
-							}
-						l832:
-							{
-								position834, tokenIndex834, depth834 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l835
-								}
-								position++
-								goto l834
-							l835:
-								position, tokenIndex, depth = position834, tokenIndex834, depth834
-								if buffer[position] != rune('A') {
-									goto l826
-								}
-								position++
-							}
-						l834:
-							{
-								position836, tokenIndex836, depth836 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l837
-								}
-								position++
-								goto l836
-							l837:
-								position, tokenIndex, depth = position836, tokenIndex836, depth836
-								if buffer[position] != rune('T') {
-									goto l826
-								}
-								position++
-							}
-						l836:
-							{
-								position838, tokenIndex838, depth838 := position, tokenIndex, depth
-								if buffer[position] != rune('y') {
-									goto l839
-								}
-								position++
-								goto l838
-							l839:
-								position, tokenIndex, depth = position838, tokenIndex838, depth838
-								if buffer[position] != rune('Y') {
-									goto l826
-								}
-								position++
+								position785, tokenIndex785, depth785 := position, tokenIndex, depth
+								{
+									position787 := position
+									depth++
+									{
+										position788 := position
+										depth++
+										{
+											position789, tokenIndex789, depth789 := position, tokenIndex, depth
+											if buffer[position] != rune('c') {
+												goto l790
+											}
+											position++
+											goto l789
+										l790:
+											position, tokenIndex, depth = position789, tokenIndex789, depth789
+											if buffer[position] != rune('C') {
+												goto l786
+											}
+											position++
+										}
+									l789:
+										{
+											position791, tokenIndex791, depth791 := position, tokenIndex, depth
+											if buffer[position] != rune('o') {
+												goto l792
+											}
+											position++
+											goto l791
+										l792:
+											position, tokenIndex, depth = position791, tokenIndex791, depth791
+											if buffer[position] != rune('O') {
+												goto l786
+											}
+											position++
+										}
+									l791:
+										{
+											position793, tokenIndex793, depth793 := position, tokenIndex, depth
+											if buffer[position] != rune('u') {
+												goto l794
+											}
+											position++
+											goto l793
+										l794:
+											position, tokenIndex, depth = position793, tokenIndex793, depth793
+											if buffer[position] != rune('U') {
+												goto l786
+											}
+											position++
+										}
+									l793:
+										{
+											position795, tokenIndex795, depth795 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l796
+											}
+											position++
+											goto l795
+										l796:
+											position, tokenIndex, depth = position795, tokenIndex795, depth795
+											if buffer[position] != rune('N') {
+												goto l786
+											}
+											position++
+										}
+									l795:
+										{
+											position797, tokenIndex797, depth797 := position, tokenIndex, depth
+											if buffer[position] != rune('t') {
+												goto l798
+											}
+											position++
+											goto l797
+										l798:
+											position, tokenIndex, depth = position797, tokenIndex797, depth797
+											if buffer[position] != rune('T') {
+												goto l786
+											}
+											position++
+										}
+									l797:
+										if !_rules[ruleskip]() {
+											goto l786
+										}
+										depth--
+										add(ruleCOUNT, position788)
+									}
+									if !_rules[ruleLPAREN]() {
+										goto l786
+									}
+									{
+										position799, tokenIndex799, depth799 := position, tokenIndex, depth
+										if !_rules[ruleDISTINCT]() {
+											goto l799
+										}
+										goto l800
+									l799:
+										position, tokenIndex, depth = position799, tokenIndex799, depth799
+									}
+								l800:
+									{
+										position801, tokenIndex801, depth801 := position, tokenIndex, depth
+										if !_rules[ruleSTAR]() {
+											goto l802
+										}
+										goto l801
+									l802:
+										position, tokenIndex, depth = position801, tokenIndex801, depth801
+										if !_rules[ruleexpression]() {
+											goto l786
+										}
+									}
+								l801:
+									if !_rules[ruleRPAREN]() {
+										goto l786
+									}
+									depth--
+									add(rulecount, position787)
+								}
+								goto l785
+							l786:
+								position, tokenIndex, depth = position785, tokenIndex785, depth785
+								{
+									position804 := position
+									depth++
+									{
+										position805 := position
+										depth++
+										{
+											position806, tokenIndex806, depth806 := position, tokenIndex, depth
+											if buffer[position] != rune('g') {
+												goto l807
+											}
+											position++
+											goto l806
+										l807:
+											position, tokenIndex, depth = position806, tokenIndex806, depth806
+											if buffer[position] != rune('G') {
+												goto l803
+											}
+											position++
+										}
+									l806:
+										{
+											position808, tokenIndex808, depth808 := position, tokenIndex, depth
+											if buffer[position] != rune('r') {
+												goto l809
+											}
+											position++
+											goto l808
+										l809:
+											position, tokenIndex, depth = position808, tokenIndex808, depth808
+											if buffer[position] != rune('R') {
+												goto l803
+											}
+											position++
+										}
+									l808:
+										{
+											position810, tokenIndex810, depth810 := position, tokenIndex, depth
+											if buffer[position] != rune('o') {
+												goto l811
+											}
+											position++
+											goto l810
+										l811:
+											position, tokenIndex, depth = position810, tokenIndex810, depth810
+											if buffer[position] != rune('O') {
+												goto l803
+											}
+											position++
+										}
+									l810:
+										{
+											position812, tokenIndex812, depth812 := position, tokenIndex, depth
+											if buffer[position] != rune('u') {
+												goto l813
+											}
+											position++
+											goto l812
+										l813:
+											position, tokenIndex, depth = position812, tokenIndex812, depth812
+											if buffer[position] != rune('U') {
+												goto l803
+											}
+											position++
+										}
+									l812:
+										{
+											position814, tokenIndex814, depth814 := position, tokenIndex, depth
+											if buffer[position] != rune('p') {
+												goto l815
+											}
+											position++
+											goto l814
+										l815:
+											position, tokenIndex, depth = position814, tokenIndex814, depth814
+											if buffer[position] != rune('P') {
+												goto l803
+											}
+											position++
+										}
+									l814:
+										if buffer[position] != rune('_') {
+											goto l803
+										}
+										position++
+										{
+											position816, tokenIndex816, depth816 := position, tokenIndex, depth
+											if buffer[position] != rune('c') {
+												goto l817
+											}
+											position++
+											goto l816
+										l817:
+											position, tokenIndex, depth = position816, tokenIndex816, depth816
+											if buffer[position] != rune('C') {
+												goto l803
+											}
+											position++
+										}
+									l816:
+										{
+											position818, tokenIndex818, depth818 := position, tokenIndex, depth
+											if buffer[position] != rune('o') {
+												goto l819
+											}
+											position++
+											goto l818
+										l819:
+											position, tokenIndex, depth = position818, tokenIndex818, depth818
+											if buffer[position] != rune('O') {
+												goto l803
+											}
+											position++
+										}
+									l818:
+										{
+											position820, tokenIndex820, depth820 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l821
+											}
+											position++
+											goto l820
+										l821:
+											position, tokenIndex, depth = position820, tokenIndex820, depth820
+											if buffer[position] != rune('N') {
+												goto l803
+											}
+											position++
+										}
+									l820:
+										{
+											position822, tokenIndex822, depth822 := position, tokenIndex, depth
+											if buffer[position] != rune('c') {
+												goto l823
+											}
+											position++
+											goto l822
+										l823:
+											position, tokenIndex, depth = position822, tokenIndex822, depth822
+											if buffer[position] != rune('C') {
+												goto l803
+											}
+											position++
+										}
+									l822:
+										{
+											position824, tokenIndex824, depth824 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l825
+											}
+											position++
+											goto l824
+										l825:
+											position, tokenIndex, depth = position824, tokenIndex824, depth824
+											if buffer[position] != rune('A') {
+												goto l803
+											}
+											position++
+										}
+									l824:
+										{
+											position826, tokenIndex826, depth826 := position, tokenIndex, depth
+											if buffer[position] != rune('t') {
+												goto l827
+											}
+											position++
+											goto l826
+										l827:
+											position, tokenIndex, depth = position826, tokenIndex826, depth826
+											if buffer[position] != rune('T') {
+												goto l803
+											}
+											position++
+										}
+									l826:
+										if !_rules[ruleskip]() {
+											goto l803
+										}
+										depth--
+										add(ruleGROUPCONCAT, position805)
+									}
+									if !_rules[ruleLPAREN]() {
+										goto l803
+									}
+									{
+										position828, tokenIndex828, depth828 := position, tokenIndex, depth
+										if !_rules[ruleDISTINCT]() {
+											goto l828
+										}
+										goto l829
+									l828:
+										position, tokenIndex, depth = position828, tokenIndex828, depth828
+									}
+								l829:
+									if !_rules[ruleexpression]() {
+										goto l803
+									}
+									{
+										position830, tokenIndex830, depth830 := position, tokenIndex, depth
+										if !_rules[ruleSEMICOLON]() {
+											goto l830
+										}
+										{
+											position832 := position
+											depth++
+											{
+												position833, tokenIndex833, depth833 := position, tokenIndex, depth
+												if buffer[position] != rune('s') {
+													goto l834
+												}
+												position++
+												goto l833
+											l834:
+												position, tokenIndex, depth = position833, tokenIndex833, depth833
+												if buffer[position] != rune('S') {
+													goto l830
+												}
+												position++
+											}
+										l833:
+											{
+												position835, tokenIndex835, depth835 := position, tokenIndex, depth
+												if buffer[position] != rune('e') {
+													goto l836
+												}
+												position++
+												goto l835
+											l836:
+												position, tokenIndex, depth = position835, tokenIndex835, depth835
+												if buffer[position] != rune('E') {
+													goto l830
+												}
+												position++
+											}
+										l835:
+											{
+												position837, tokenIndex837, depth837 := position, tokenIndex, depth
+												if buffer[position] != rune('p') {
+													goto l838
+												}
+												position++
+												goto l837
+											l838:
+												position, tokenIndex, depth = position837, tokenIndex837, depth837
+												if buffer[position] != rune('P') {
+													goto l830
+												}
+												position++
+											}
+										l837:
+											{
+												position839, tokenIndex839, depth839 := position, tokenIndex, depth
+												if buffer[position] != rune('a') {
+													goto l840
+												}
+												position++
+												goto l839
+											l840:
+												position, tokenIndex, depth = position839, tokenIndex839, depth839
+												if buffer[position] != rune('A') {
+													goto l830
+												}
+												position++
+											}
+										l839:
+											{
+												position841, tokenIndex841, depth841 := position, tokenIndex, depth
+												if buffer[position] != rune('r') {
+													goto l842
+												}
+												position++
+												goto l841
+											l842:
+												position, tokenIndex, depth = position841, tokenIndex841, depth841
+												if buffer[position] != rune('R') {
+													goto l830
+												}
+												position++
+											}
+										l841:
+											{
+												position843, tokenIndex843, depth843 := position, tokenIndex, depth
+												if buffer[position] != rune('a') {
+													goto l844
+												}
+												position++
+												goto l843
+											l844:
+												position, tokenIndex, depth = position843, tokenIndex843, depth843
+												if buffer[position] != rune('A') {
+													goto l830
+												}
+												position++
+											}
+										l843:
+											{
+												position845, tokenIndex845, depth845 := position, tokenIndex, depth
+												if buffer[position] != rune('t') {
+													goto l846
+												}
+												position++
+												goto l845
+											l846:
+												position, tokenIndex, depth = position845, tokenIndex845, depth845
+												if buffer[position] != rune('T') {
+													goto l830
+												}
+												position++
+											}
+										l845:
+											{
+												position847, tokenIndex847, depth847 := position, tokenIndex, depth
+												if buffer[position] != rune('o') {
+													goto l848
+												}
+												position++
+												goto l847
+											l848:
+												position, tokenIndex, depth = position847, tokenIndex847, depth847
+												if buffer[position] != rune('O') {
+													goto l830
+												}
+												position++
+											}
+										l847:
+											{
+												position849, tokenIndex849, depth849 := position, tokenIndex, depth
+												if buffer[position] != rune('r') {
+													goto l850
+												}
+												position++
+												goto l849
+											l850:
+												position, tokenIndex, depth = position849, tokenIndex849, depth849
+												if buffer[position] != rune('R') {
+													goto l830
+												}
+												position++
+											}
+										l849:
+											if !_rules[ruleskip]() {
+												goto l830
+											}
+											depth--
+											add(ruleSEPARATOR, position832)
+										}
+										if !_rules[ruleEQ]() {
+											goto l830
+										}
+										if !_rules[rulestring]() {
+											goto l830
+										}
+										goto l831
+									l830:
+										position, tokenIndex, depth = position830, tokenIndex830, depth830
+									}
+								l831:
+									if !_rules[ruleRPAREN]() {
+										goto l803
+									}
+									depth--
+									add(rulegroupConcat, position804)
+								}
+								goto l785
+							l803:
+								position, tokenIndex, depth = position785, tokenIndex785, depth785
+								{
+									position851, tokenIndex851, depth851 := position, tokenIndex, depth
+									{
+										position853 := position
+										depth++
+										{
+											position854, tokenIndex854, depth854 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l855
+											}
+											position++
+											goto l854
+										l855:
+											position, tokenIndex, depth = position854, tokenIndex854, depth854
+											if buffer[position] != rune('S') {
+												goto l852
+											}
+											position++
+										}
+									l854:
+										{
+											position856, tokenIndex856, depth856 := position, tokenIndex, depth
+											if buffer[position] != rune('u') {
+												goto l857
+											}
+											position++
+											goto l856
+										l857:
+											position, tokenIndex, depth = position856, tokenIndex856, depth856
+											if buffer[position] != rune('U') {
+												goto l852
+											}
+											position++
+										}
+									l856:
+										{
+											position858, tokenIndex858, depth858 := position, tokenIndex, depth
+											if buffer[position] != rune('m') {
+												goto l859
+											}
+											position++
+											goto l858
+										l859:
+											position, tokenIndex, depth = position858, tokenIndex858, depth858
+											if buffer[position] != rune('M') {
+												goto l852
+											}
+											position++
+										}
+									l858:
+										if !_rules[ruleskip]() {
+											goto l852
+										}
+										depth--
+										add(ruleSUM, position853)
+									}
+									goto l851
+								l852:
+									position, tokenIndex, depth = position851, tokenIndex851, depth851
+									{
+										position861 := position
+										depth++
+										{
+											position862, tokenIndex862, depth862 := position, tokenIndex, depth
+											if buffer[position] != rune('m') {
+												goto l863
+											}
+											position++
+											goto l862
+										l863:
+											position, tokenIndex, depth = position862, tokenIndex862, depth862
+											if buffer[position] != rune('M') {
+												goto l860
+											}
+											position++
+										}
+									l862:
+										{
+											position864, tokenIndex864, depth864 := position, tokenIndex, depth
+											if buffer[position] != rune('i') {
+												goto l865
+											}
+											position++
+											goto l864
+										l865:
+											position, tokenIndex, depth = position864, tokenIndex864, depth864
+											if buffer[position] != rune('I') {
+												goto l860
+											}
+											position++
+										}
+									l864:
+										{
+											position866, tokenIndex866, depth866 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l867
+											}
+											position++
+											goto l866
+										l867:
+											position, tokenIndex, depth = position866, tokenIndex866, depth866
+											if buffer[position] != rune('N') {
+												goto l860
+											}
+											position++
+										}
+									l866:
+										if !_rules[ruleskip]() {
+											goto l860
+										}
+										depth--
+										add(ruleMIN, position861)
+									}
+									goto l851
+								l860:
+									position, tokenIndex, depth = position851, tokenIndex851, depth851
+									{
+										position869 := position
+										depth++
+										{
+											position870, tokenIndex870, depth870 := position, tokenIndex, depth
+											if buffer[position] != rune('m') {
+												goto l871
+											}
+											position++
+											goto l870
+										l871:
+											position, tokenIndex, depth = position870, tokenIndex870, depth870
+											if buffer[position] != rune('M') {
+												goto l868
+											}
+											position++
+										}
+									l870:
+										{
+											position872, tokenIndex872, depth872 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l873
+											}
+											position++
+											goto l872
+										l873:
+											position, tokenIndex, depth = position872, tokenIndex872, depth872
+											if buffer[position] != rune('A') {
+												goto l868
+											}
+											position++
+										}
+									l872:
+										{
+											position874, tokenIndex874, depth874 := position, tokenIndex, depth
+											if buffer[position] != rune('x') {
+												goto l875
+											}
+											position++
+											goto l874
+										l875:
+											position, tokenIndex, depth = position874, tokenIndex874, depth874
+											if buffer[position] != rune('X') {
+												goto l868
+											}
+											position++
+										}
+									l874:
+										if !_rules[ruleskip]() {
+											goto l868
+										}
+										depth--
+										add(ruleMAX, position869)
+									}
+									goto l851
+								l868:
+									position, tokenIndex, depth = position851, tokenIndex851, depth851
+									{
+										position877 := position
+										depth++
+										{
+											position878, tokenIndex878, depth878 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l879
+											}
+											position++
+											goto l878
+										l879:
+											position, tokenIndex, depth = position878, tokenIndex878, depth878
+											if buffer[position] != rune('A') {
+												goto l876
+											}
+											position++
+										}
+									l878:
+										{
+											position880, tokenIndex880, depth880 := position, tokenIndex, depth
+											if buffer[position] != rune('v') {
+												goto l881
+											}
+											position++
+											goto l880
+										l881:
+											position, tokenIndex, depth = position880, tokenIndex880, depth880
+											if buffer[position] != rune('V') {
+												goto l876
+											}
+											position++
+										}
+									l880:
+										{
+											position882, tokenIndex882, depth882 := position, tokenIndex, depth
+											if buffer[position] != rune('g') {
+												goto l883
+											}
+											position++
+											goto l882
+										l883:
+											position, tokenIndex, depth = position882, tokenIndex882, depth882
+											if buffer[position] != rune('G') {
+												goto l876
+											}
+											position++
+										}
+									l882:
+										if !_rules[ruleskip]() {
+											goto l876
+										}
+										depth--
+										add(ruleAVG, position877)
+									}
+									goto l851
+								l876:
+									position, tokenIndex, depth = position851, tokenIndex851, depth851
+									{
+										position884 := position
+										depth++
+										{
+											position885, tokenIndex885, depth885 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l886
+											}
+											position++
+											goto l885
+										l886:
+											position, tokenIndex, depth = position885, tokenIndex885, depth885
+											if buffer[position] != rune('S') {
+												goto l767
+											}
+											position++
+										}
+									l885:
+										{
+											position887, tokenIndex887, depth887 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l888
+											}
+											position++
+											goto l887
+										l888:
+											position, tokenIndex, depth = position887, tokenIndex887, depth887
+											if buffer[position] != rune('A') {
+												goto l767
+											}
+											position++
+										}
+									l887:
+										{
+											position889, tokenIndex889, depth889 := position, tokenIndex, depth
+											if buffer[position] != rune('m') {
+												goto l890
+											}
+											position++
+											goto l889
+										l890:
+											position, tokenIndex, depth = position889, tokenIndex889, depth889
+											if buffer[position] != rune('M') {
+												goto l767
+											}
+											position++
+										}
+									l889:
+										{
+											position891, tokenIndex891, depth891 := position, tokenIndex, depth
+											if buffer[position] != rune('p') {
+												goto l892
+											}
+											position++
+											goto l891
+										l892:
+											position, tokenIndex, depth = position891, tokenIndex891, depth891
+											if buffer[position] != rune('P') {
+												goto l767
+											}
+											position++
+										}
+									l891:
+										{
+											position893, tokenIndex893, depth893 := position, tokenIndex, depth
+											if buffer[position] != rune('l') {
+												goto l894
+											}
+											position++
+											goto l893
+										l894:
+											position, tokenIndex, depth = position893, tokenIndex893, depth893
+											if buffer[position] != rune('L') {
+												goto l767
+											}
+											position++
+										}
+									l893:
+										{
+											position895, tokenIndex895, depth895 := position, tokenIndex, depth
+											if buffer[position] != rune('e') {
+												goto l896
+											}
+											position++
+											goto l895
+										l896:
+											position, tokenIndex, depth = position895, tokenIndex895, depth895
+											if buffer[position] != rune('E') {
+												goto l767
+											}
+											position++
+										}
+									l895:
+										if !_rules[ruleskip]() {
+											goto l767
+										}
+										depth--
+										add(ruleSAMPLE, position884)
+									}
+								}
+							l851:
+								if !_rules[ruleLPAREN]() {
+									goto l767
+								}
+								{
+									position897, tokenIndex897, depth897 := position, tokenIndex, depth
+									if !_rules[ruleDISTINCT]() {
+										goto l897
+									}
+									goto l898
+								l897:
+									position, tokenIndex, depth = position897, tokenIndex897, depth897
+								}
+							l898:
+								if !_rules[ruleexpression]() {
+									goto l767
+								}
+								if !_rules[ruleRPAREN]() {
+									goto l767
+								}
+							}
+						l785:
+							depth--
+							add(ruleaggregate, position784)
+						}
+					}
+				l775:
+					depth--
+					add(ruleprimaryExpression, position774)
+				}
+				depth--
+				add(ruleunaryExpression, position768)
+			}
+			return true
+		l767:
+			position, tokenIndex, depth = position767, tokenIndex767, depth767
+			return false
+		},
+		/* 69 primaryExpression <- <(brackettedExpression / builtinCall / functionCall / iriref / literal / numericLiteral / booleanLiteral / var / aggregate)> */
+		nil,
+		/* 70 brackettedExpression <- <(LPAREN expression RPAREN)> */
+		func() bool {
+			position900, tokenIndex900, depth900 := position, tokenIndex, depth
+			{
+				position901 := position
+				depth++
+				if !_rules[ruleLPAREN]() {
+					goto l900
+				}
+				if !_rules[ruleexpression]() {
+					goto l900
+				}
+				if !_rules[ruleRPAREN]() {
+					goto l900
+				}
+				depth--
+				add(rulebrackettedExpression, position901)
+			}
+			return true
+		l900:
+			position, tokenIndex, depth = position900, tokenIndex900, depth900
+			return false
+		},
+		/* 71 functionCall <- <(iriref argList)> */
+		func() bool {
+			position902, tokenIndex902, depth902 := position, tokenIndex, depth
+			{
+				position903 := position
+				depth++
+				if !_rules[ruleiriref]() {
+					goto l902
+				}
+				if !_rules[ruleargList]() {
+					goto l902
+				}
+				depth--
+				add(rulefunctionCall, position903)
+			}
+			return true
+		l902:
+			position, tokenIndex, depth = position902, tokenIndex902, depth902
+			return false
+		},
+		/* 72 in <- <(IN argList)> */
+		nil,
+		/* 73 notin <- <(NOTIN argList)> */
+		nil,
+		/* 74 argList <- <(nil / (LPAREN expression (COMMA expression)* RPAREN))> */
+		func() bool {
+			position906, tokenIndex906, depth906 := position, tokenIndex, depth
+			{
+				position907 := position
+				depth++
+				{
+					position908, tokenIndex908, depth908 := position, tokenIndex, depth
+					if !_rules[rulenil]() {
+						goto l909
+					}
+					goto l908
+				l909:
+					position, tokenIndex, depth = position908, tokenIndex908, depth908
+					if !_rules[ruleLPAREN]() {
+						goto l906
+					}
+					if !_rules[ruleexpression]() {
+						goto l906
+					}
+				l910:
+					{
+						position911, tokenIndex911, depth911 := position, tokenIndex, depth
+						if !_rules[ruleCOMMA]() {
+							goto l911
+						}
+						if !_rules[ruleexpression]() {
+							goto l911
+						}
+						goto l910
+					l911:
+						position, tokenIndex, depth = position911, tokenIndex911, depth911
+					}
+					if !_rules[ruleRPAREN]() {
+						goto l906
+					}
+				}
+			l908:
+				depth--
+				add(ruleargList, position907)
+			}
+			return true
+		l906:
+			position, tokenIndex, depth = position906, tokenIndex906, depth906
+			return false
+		},
+		/* 75 aggregate <- <(count / groupConcat / ((SUM / MIN / MAX / AVG / SAMPLE) LPAREN DISTINCT? expression RPAREN))> */
+		nil,
+		/* 76 count <- <(COUNT LPAREN DISTINCT? (STAR / expression) RPAREN)> */
+		nil,
+		/* 77 groupConcat <- <(GROUPCONCAT LPAREN DISTINCT? expression (SEMICOLON SEPARATOR EQ string)? RPAREN)> */
+		nil,
+		/* 78 builtinCall <- <(((STRLEN / STR / LANG / DATATYPE / IRI / URI / ABS / CEIL / ROUND / FLOOR / UCASE / LCASE / ENCODEFORURI / YEAR / MONTH / DAY / HOURS / MINUTES / SECONDS / TIMEZONE / TZ / MD5 / SHA1 / SHA256 / SHA384 / SHA512 / ISIRI / ISURI / ISBLANK / ISLITERAL / ISNUMERIC) LPAREN expression RPAREN) / ((LANGMATCHES / CONTAINS / STRSTARTS / STRENDS / STRBEFORE / STRAFTER / STRLANG / STRDT / SAMETERM) LPAREN expression COMMA expression RPAREN) / (BOUND LPAREN var RPAREN) / (BNODE ((LPAREN expression RPAREN) / nil)) / ((RAND / NOW / UUID / STRUUID) nil) / ((CONCAT / COALESCE) argList) / ((SUBSTR / REPLACE / REGEX) LPAREN expression COMMA expression (COMMA expression)? RPAREN) / (IF LPAREN expression COMMA expression COMMA expression RPAREN) / ((EXISTS / NOTEXIST) groupGraphPattern))> */
+		func() bool {
+			position915, tokenIndex915, depth915 := position, tokenIndex, depth
+			{
+				position916 := position
+				depth++
+				{
+					position917, tokenIndex917, depth917 := position, tokenIndex, depth
+					{
+						position919, tokenIndex919, depth919 := position, tokenIndex, depth
+						{
+							position921 := position
+							depth++
+							{
+								position922, tokenIndex922, depth922 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l923
+								}
+								position++
+								goto l922
+							l923:
+								position, tokenIndex, depth = position922, tokenIndex922, depth922
+								if buffer[position] != rune('S') {
+									goto l920
+								}
+								position++
+							}
+						l922:
+							{
+								position924, tokenIndex924, depth924 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l925
+								}
+								position++
+								goto l924
+							l925:
+								position, tokenIndex, depth = position924, tokenIndex924, depth924
+								if buffer[position] != rune('T') {
+									goto l920
+								}
+								position++
+							}
+						l924:
+							{
+								position926, tokenIndex926, depth926 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l927
+								}
+								position++
+								goto l926
+							l927:
+								position, tokenIndex, depth = position926, tokenIndex926, depth926
+								if buffer[position] != rune('R') {
+									goto l920
+								}
+								position++
+							}
+						l926:
+							{
+								position928, tokenIndex928, depth928 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l929
+								}
+								position++
+								goto l928
+							l929:
+								position, tokenIndex, depth = position928, tokenIndex928, depth928
+								if buffer[position] != rune('L') {
+									goto l920
+								}
+								position++
+							}
+						l928:
+							{
+								position930, tokenIndex930, depth930 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l931
+								}
+								position++
+								goto l930
+							l931:
+								position, tokenIndex, depth = position930, tokenIndex930, depth930
+								if buffer[position] != rune('E') {
+									goto l920
+								}
+								position++
+							}
+						l930:
+							{
+								position932, tokenIndex932, depth932 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l933
+								}
+								position++
+								goto l932
+							l933:
+								position, tokenIndex, depth = position932, tokenIndex932, depth932
+								if buffer[position] != rune('N') {
+									goto l920
+								}
+								position++
+							}
+						l932:
+							if !_rules[ruleskip]() {
+								goto l920
+							}
+							depth--
+							add(ruleSTRLEN, position921)
+						}
+						goto l919
+					l920:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position935 := position
+							depth++
+							{
+								position936, tokenIndex936, depth936 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l937
+								}
+								position++
+								goto l936
+							l937:
+								position, tokenIndex, depth = position936, tokenIndex936, depth936
+								if buffer[position] != rune('S') {
+									goto l934
+								}
+								position++
+							}
+						l936:
+							{
+								position938, tokenIndex938, depth938 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l939
+								}
+								position++
+								goto l938
+							l939:
+								position, tokenIndex, depth = position938, tokenIndex938, depth938
+								if buffer[position] != rune('T') {
+									goto l934
+								}
+								position++
+							}
+						l938:
+							{
+								position940, tokenIndex940, depth940 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l941
+								}
+								position++
+								goto l940
+							l941:
+								position, tokenIndex, depth = position940, tokenIndex940, depth940
+								if buffer[position] != rune('R') {
+									goto l934
+								}
+								position++
+							}
+						l940:
+							if !_rules[ruleskip]() {
+								goto l934
+							}
+							depth--
+							add(ruleSTR, position935)
+						}
+						goto l919
+					l934:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position943 := position
+							depth++
+							{
+								position944, tokenIndex944, depth944 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l945
+								}
+								position++
+								goto l944
+							l945:
+								position, tokenIndex, depth = position944, tokenIndex944, depth944
+								if buffer[position] != rune('L') {
+									goto l942
+								}
+								position++
+							}
+						l944:
+							{
+								position946, tokenIndex946, depth946 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l947
+								}
+								position++
+								goto l946
+							l947:
+								position, tokenIndex, depth = position946, tokenIndex946, depth946
+								if buffer[position] != rune('A') {
+									goto l942
+								}
+								position++
+							}
+						l946:
+							{
+								position948, tokenIndex948, depth948 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l949
+								}
+								position++
+								goto l948
+							l949:
+								position, tokenIndex, depth = position948, tokenIndex948, depth948
+								if buffer[position] != rune('N') {
+									goto l942
+								}
+								position++
+							}
+						l948:
+							{
+								position950, tokenIndex950, depth950 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l951
+								}
+								position++
+								goto l950
+							l951:
+								position, tokenIndex, depth = position950, tokenIndex950, depth950
+								if buffer[position] != rune('G') {
+									goto l942
+								}
+								position++
+							}
+						l950:
+							if !_rules[ruleskip]() {
+								goto l942
+							}
+							depth--
+							add(ruleLANG, position943)
+						}
+						goto l919
+					l942:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position953 := position
+							depth++
+							{
+								position954, tokenIndex954, depth954 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l955
+								}
+								position++
+								goto l954
+							l955:
+								position, tokenIndex, depth = position954, tokenIndex954, depth954
+								if buffer[position] != rune('D') {
+									goto l952
+								}
+								position++
+							}
+						l954:
+							{
+								position956, tokenIndex956, depth956 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l957
+								}
+								position++
+								goto l956
+							l957:
+								position, tokenIndex, depth = position956, tokenIndex956, depth956
+								if buffer[position] != rune('A') {
+									goto l952
+								}
+								position++
+							}
+						l956:
+							{
+								position958, tokenIndex958, depth958 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l959
+								}
+								position++
+								goto l958
+							l959:
+								position, tokenIndex, depth = position958, tokenIndex958, depth958
+								if buffer[position] != rune('T') {
+									goto l952
+								}
+								position++
+							}
+						l958:
+							{
+								position960, tokenIndex960, depth960 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l961
+								}
+								position++
+								goto l960
+							l961:
+								position, tokenIndex, depth = position960, tokenIndex960, depth960
+								if buffer[position] != rune('A') {
+									goto l952
+								}
+								position++
+							}
+						l960:
+							{
+								position962, tokenIndex962, depth962 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l963
+								}
+								position++
+								goto l962
+							l963:
+								position, tokenIndex, depth = position962, tokenIndex962, depth962
+								if buffer[position] != rune('T') {
+									goto l952
+								}
+								position++
+							}
+						l962:
+							{
+								position964, tokenIndex964, depth964 := position, tokenIndex, depth
+								if buffer[position] != rune('y') {
+									goto l965
+								}
+								position++
+								goto l964
+							l965:
+								position, tokenIndex, depth = position964, tokenIndex964, depth964
+								if buffer[position] != rune('Y') {
+									goto l952
+								}
+								position++
+							}
+						l964:
+							{
+								position966, tokenIndex966, depth966 := position, tokenIndex, depth
+								if buffer[position] != rune('p') {
+									goto l967
+								}
+								position++
+								goto l966
+							l967:
+								position, tokenIndex, depth = position966, tokenIndex966, depth966
+								if buffer[position] != rune('P') {
+									goto l952
+								}
+								position++
+							}
+						l966:
+							{
+								position968, tokenIndex968, depth968 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l969
+								}
+								position++
+								goto l968
+							l969:
+								position, tokenIndex, depth = position968, tokenIndex968, depth968
+								if buffer[position] != rune('E') {
+									goto l952
+								}
+								position++
+							}
+						l968:
+							if !_rules[ruleskip]() {
+								goto l952
+							}
+							depth--
+							add(ruleDATATYPE, position953)
+						}
+						goto l919
+					l952:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position971 := position
+							depth++
+							{
+								position972, tokenIndex972, depth972 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l973
+								}
+								position++
+								goto l972
+							l973:
+								position, tokenIndex, depth = position972, tokenIndex972, depth972
+								if buffer[position] != rune('I') {
+									goto l970
+								}
+								position++
+							}
+						l972:
+							{
+								position974, tokenIndex974, depth974 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l975
+								}
+								position++
+								goto l974
+							l975:
+								position, tokenIndex, depth = position974, tokenIndex974, depth974
+								if buffer[position] != rune('R') {
+									goto l970
+								}
+								position++
+							}
+						l974:
+							{
+								position976, tokenIndex976, depth976 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l977
+								}
+								position++
+								goto l976
+							l977:
+								position, tokenIndex, depth = position976, tokenIndex976, depth976
+								if buffer[position] != rune('I') {
+									goto l970
+								}
+								position++
+							}
+						l976:
+							if !_rules[ruleskip]() {
+								goto l970
+							}
+							depth--
+							add(ruleIRI, position971)
+						}
+						goto l919
+					l970:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position979 := position
+							depth++
+							{
+								position980, tokenIndex980, depth980 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l981
+								}
+								position++
+								goto l980
+							l981:
+								position, tokenIndex, depth = position980, tokenIndex980, depth980
+								if buffer[position] != rune('U') {
+									goto l978
+								}
+								position++
+							}
+						l980:
+							{
+								position982, tokenIndex982, depth982 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l983
+								}
+								position++
+								goto l982
+							l983:
+								position, tokenIndex, depth = position982, tokenIndex982, depth982
+								if buffer[position] != rune('R') {
+									goto l978
+								}
+								position++
+							}
+						l982:
+							{
+								position984, tokenIndex984, depth984 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l985
+								}
+								position++
+								goto l984
+							l985:
+								position, tokenIndex, depth = position984, tokenIndex984, depth984
+								if buffer[position] != rune('I') {
+									goto l978
+								}
+								position++
+							}
+						l984:
+							if !_rules[ruleskip]() {
+								goto l978
+							}
+							depth--
+							add(ruleURI, position979)
+						}
+						goto l919
+					l978:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position987 := position
+							depth++
+							{
+								position988, tokenIndex988, depth988 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l989
+								}
+								position++
+								goto l988
+							l989:
+								position, tokenIndex, depth = position988, tokenIndex988, depth988
+								if buffer[position] != rune('A') {
+									goto l986
+								}
+								position++
+							}
+						l988:
+							{
+								position990, tokenIndex990, depth990 := position, tokenIndex, depth
+								if buffer[position] != rune('b') {
+									goto l991
+								}
+								position++
+								goto l990
+							l991:
+								position, tokenIndex, depth = position990, tokenIndex990, depth990
+								if buffer[position] != rune('B') {
+									goto l986
+								}
+								position++
+							}
+						l990:
+							{
+								position992, tokenIndex992, depth992 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l993
+								}
+								position++
+								goto l992
+							l993:
+								position, tokenIndex, depth = position992, tokenIndex992, depth992
+								if buffer[position] != rune('S') {
+									goto l986
+								}
+								position++
+							}
+						l992:
+							if !_rules[ruleskip]() {
+								goto l986
+							}
+							depth--
+							add(ruleABS, position987)
+						}
+						goto l919
+					l986:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position995 := position
+							depth++
+							{
+								position996, tokenIndex996, depth996 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l997
+								}
+								position++
+								goto l996
+							l997:
+								position, tokenIndex, depth = position996, tokenIndex996, depth996
+								if buffer[position] != rune('C') {
+									goto l994
+								}
+								position++
+							}
+						l996:
+							{
+								position998, tokenIndex998, depth998 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l999
+								}
+								position++
+								goto l998
+							l999:
+								position, tokenIndex, depth = position998, tokenIndex998, depth998
+								if buffer[position] != rune('E') {
+									goto l994
+								}
+								position++
+							}
+						l998:
+							{
+								position1000, tokenIndex1000, depth1000 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1001
+								}
+								position++
+								goto l1000
+							l1001:
+								position, tokenIndex, depth = position1000, tokenIndex1000, depth1000
+								if buffer[position] != rune('I') {
+									goto l994
+								}
+								position++
+							}
+						l1000:
+							{
+								position1002, tokenIndex1002, depth1002 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1003
+								}
+								position++
+								goto l1002
+							l1003:
+								position, tokenIndex, depth = position1002, tokenIndex1002, depth1002
+								if buffer[position] != rune('L') {
+									goto l994
+								}
+								position++
+							}
+						l1002:
+							if !_rules[ruleskip]() {
+								goto l994
+							}
+							depth--
+							add(ruleCEIL, position995)
+						}
+						goto l919
+					l994:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1005 := position
+							depth++
+							{
+								position1006, tokenIndex1006, depth1006 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1007
+								}
+								position++
+								goto l1006
+							l1007:
+								position, tokenIndex, depth = position1006, tokenIndex1006, depth1006
+								if buffer[position] != rune('R') {
+									goto l1004
+								}
+								position++
+							}
+						l1006:
+							{
+								position1008, tokenIndex1008, depth1008 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1009
+								}
+								position++
+								goto l1008
+							l1009:
+								position, tokenIndex, depth = position1008, tokenIndex1008, depth1008
+								if buffer[position] != rune('O') {
+									goto l1004
+								}
+								position++
+							}
+						l1008:
+							{
+								position1010, tokenIndex1010, depth1010 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1011
+								}
+								position++
+								goto l1010
+							l1011:
+								position, tokenIndex, depth = position1010, tokenIndex1010, depth1010
+								if buffer[position] != rune('U') {
+									goto l1004
+								}
+								position++
+							}
+						l1010:
+							{
+								position1012, tokenIndex1012, depth1012 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1013
+								}
+								position++
+								goto l1012
+							l1013:
+								position, tokenIndex, depth = position1012, tokenIndex1012, depth1012
+								if buffer[position] != rune('N') {
+									goto l1004
+								}
+								position++
+							}
+						l1012:
+							{
+								position1014, tokenIndex1014, depth1014 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1015
+								}
+								position++
+								goto l1014
+							l1015:
+								position, tokenIndex, depth = position1014, tokenIndex1014, depth1014
+								if buffer[position] != rune('D') {
+									goto l1004
+								}
+								position++
+							}
+						l1014:
+							if !_rules[ruleskip]() {
+								goto l1004
+							}
+							depth--
+							add(ruleROUND, position1005)
+						}
+						goto l919
+					l1004:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1017 := position
+							depth++
+							{
+								position1018, tokenIndex1018, depth1018 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1019
+								}
+								position++
+								goto l1018
+							l1019:
+								position, tokenIndex, depth = position1018, tokenIndex1018, depth1018
+								if buffer[position] != rune('F') {
+									goto l1016
+								}
+								position++
+							}
+						l1018:
+							{
+								position1020, tokenIndex1020, depth1020 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1021
+								}
+								position++
+								goto l1020
+							l1021:
+								position, tokenIndex, depth = position1020, tokenIndex1020, depth1020
+								if buffer[position] != rune('L') {
+									goto l1016
+								}
+								position++
+							}
+						l1020:
+							{
+								position1022, tokenIndex1022, depth1022 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1023
+								}
+								position++
+								goto l1022
+							l1023:
+								position, tokenIndex, depth = position1022, tokenIndex1022, depth1022
+								if buffer[position] != rune('O') {
+									goto l1016
+								}
+								position++
+							}
+						l1022:
+							{
+								position1024, tokenIndex1024, depth1024 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1025
+								}
+								position++
+								goto l1024
+							l1025:
+								position, tokenIndex, depth = position1024, tokenIndex1024, depth1024
+								if buffer[position] != rune('O') {
+									goto l1016
+								}
+								position++
+							}
+						l1024:
+							{
+								position1026, tokenIndex1026, depth1026 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1027
+								}
+								position++
+								goto l1026
+							l1027:
+								position, tokenIndex, depth = position1026, tokenIndex1026, depth1026
+								if buffer[position] != rune('R') {
+									goto l1016
+								}
+								position++
+							}
+						l1026:
+							if !_rules[ruleskip]() {
+								goto l1016
+							}
+							depth--
+							add(ruleFLOOR, position1017)
+						}
+						goto l919
+					l1016:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1029 := position
+							depth++
+							{
+								position1030, tokenIndex1030, depth1030 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1031
+								}
+								position++
+								goto l1030
+							l1031:
+								position, tokenIndex, depth = position1030, tokenIndex1030, depth1030
+								if buffer[position] != rune('U') {
+									goto l1028
+								}
+								position++
+							}
+						l1030:
+							{
+								position1032, tokenIndex1032, depth1032 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1033
+								}
+								position++
+								goto l1032
+							l1033:
+								position, tokenIndex, depth = position1032, tokenIndex1032, depth1032
+								if buffer[position] != rune('C') {
+									goto l1028
+								}
+								position++
+							}
+						l1032:
+							{
+								position1034, tokenIndex1034, depth1034 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1035
+								}
+								position++
+								goto l1034
+							l1035:
+								position, tokenIndex, depth = position1034, tokenIndex1034, depth1034
+								if buffer[position] != rune('A') {
+									goto l1028
+								}
+								position++
+							}
+						l1034:
+							{
+								position1036, tokenIndex1036, depth1036 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1037
+								}
+								position++
+								goto l1036
+							l1037:
+								position, tokenIndex, depth = position1036, tokenIndex1036, depth1036
+								if buffer[position] != rune('S') {
+									goto l1028
+								}
+								position++
+							}
+						l1036:
+							{
+								position1038, tokenIndex1038, depth1038 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1039
+								}
+								position++
+								goto l1038
+							l1039:
+								position, tokenIndex, depth = position1038, tokenIndex1038, depth1038
+								if buffer[position] != rune('E') {
+									goto l1028
+								}
+								position++
+							}
+						l1038:
+							if !_rules[ruleskip]() {
+								goto l1028
+							}
+							depth--
+							add(ruleUCASE, position1029)
+						}
+						goto l919
+					l1028:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1041 := position
+							depth++
+							{
+								position1042, tokenIndex1042, depth1042 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1043
+								}
+								position++
+								goto l1042
+							l1043:
+								position, tokenIndex, depth = position1042, tokenIndex1042, depth1042
+								if buffer[position] != rune('L') {
+									goto l1040
+								}
+								position++
+							}
+						l1042:
+							{
+								position1044, tokenIndex1044, depth1044 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1045
+								}
+								position++
+								goto l1044
+							l1045:
+								position, tokenIndex, depth = position1044, tokenIndex1044, depth1044
+								if buffer[position] != rune('C') {
+									goto l1040
+								}
+								position++
+							}
+						l1044:
+							{
+								position1046, tokenIndex1046, depth1046 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1047
+								}
+								position++
+								goto l1046
+							l1047:
+								position, tokenIndex, depth = position1046, tokenIndex1046, depth1046
+								if buffer[position] != rune('A') {
+									goto l1040
+								}
+								position++
+							}
+						l1046:
+							{
+								position1048, tokenIndex1048, depth1048 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1049
+								}
+								position++
+								goto l1048
+							l1049:
+								position, tokenIndex, depth = position1048, tokenIndex1048, depth1048
+								if buffer[position] != rune('S') {
+									goto l1040
+								}
+								position++
+							}
+						l1048:
+							{
+								position1050, tokenIndex1050, depth1050 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1051
+								}
+								position++
+								goto l1050
+							l1051:
+								position, tokenIndex, depth = position1050, tokenIndex1050, depth1050
+								if buffer[position] != rune('E') {
+									goto l1040
+								}
+								position++
+							}
+						l1050:
+							if !_rules[ruleskip]() {
+								goto l1040
+							}
+							depth--
+							add(ruleLCASE, position1041)
+						}
+						goto l919
+					l1040:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1053 := position
+							depth++
+							{
+								position1054, tokenIndex1054, depth1054 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1055
+								}
+								position++
+								goto l1054
+							l1055:
+								position, tokenIndex, depth = position1054, tokenIndex1054, depth1054
+								if buffer[position] != rune('E') {
+									goto l1052
+								}
+								position++
+							}
+						l1054:
+							{
+								position1056, tokenIndex1056, depth1056 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1057
+								}
+								position++
+								goto l1056
+							l1057:
+								position, tokenIndex, depth = position1056, tokenIndex1056, depth1056
+								if buffer[position] != rune('N') {
+									goto l1052
+								}
+								position++
+							}
+						l1056:
+							{
+								position1058, tokenIndex1058, depth1058 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1059
+								}
+								position++
+								goto l1058
+							l1059:
+								position, tokenIndex, depth = position1058, tokenIndex1058, depth1058
+								if buffer[position] != rune('C') {
+									goto l1052
+								}
+								position++
+							}
+						l1058:
+							{
+								position1060, tokenIndex1060, depth1060 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1061
+								}
+								position++
+								goto l1060
+							l1061:
+								position, tokenIndex, depth = position1060, tokenIndex1060, depth1060
+								if buffer[position] != rune('O') {
+									goto l1052
+								}
+								position++
+							}
+						l1060:
+							{
+								position1062, tokenIndex1062, depth1062 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1063
+								}
+								position++
+								goto l1062
+							l1063:
+								position, tokenIndex, depth = position1062, tokenIndex1062, depth1062
+								if buffer[position] != rune('D') {
+									goto l1052
+								}
+								position++
+							}
+						l1062:
+							{
+								position1064, tokenIndex1064, depth1064 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1065
+								}
+								position++
+								goto l1064
+							l1065:
+								position, tokenIndex, depth = position1064, tokenIndex1064, depth1064
+								if buffer[position] != rune('E') {
+									goto l1052
+								}
+								position++
+							}
+						l1064:
+							if buffer[position] != rune('_') {
+								goto l1052
+							}
+							position++
+							{
+								position1066, tokenIndex1066, depth1066 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1067
+								}
+								position++
+								goto l1066
+							l1067:
+								position, tokenIndex, depth = position1066, tokenIndex1066, depth1066
+								if buffer[position] != rune('F') {
+									goto l1052
+								}
+								position++
+							}
+						l1066:
+							{
+								position1068, tokenIndex1068, depth1068 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1069
+								}
+								position++
+								goto l1068
+							l1069:
+								position, tokenIndex, depth = position1068, tokenIndex1068, depth1068
+								if buffer[position] != rune('O') {
+									goto l1052
+								}
+								position++
+							}
+						l1068:
+							{
+								position1070, tokenIndex1070, depth1070 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1071
+								}
+								position++
+								goto l1070
+							l1071:
+								position, tokenIndex, depth = position1070, tokenIndex1070, depth1070
+								if buffer[position] != rune('R') {
+									goto l1052
+								}
+								position++
+							}
+						l1070:
+							if buffer[position] != rune('_') {
+								goto l1052
+							}
+							position++
+							{
+								position1072, tokenIndex1072, depth1072 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1073
+								}
+								position++
+								goto l1072
+							l1073:
+								position, tokenIndex, depth = position1072, tokenIndex1072, depth1072
+								if buffer[position] != rune('U') {
+									goto l1052
+								}
+								position++
+							}
+						l1072:
+							{
+								position1074, tokenIndex1074, depth1074 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1075
+								}
+								position++
+								goto l1074
+							l1075:
+								position, tokenIndex, depth = position1074, tokenIndex1074, depth1074
+								if buffer[position] != rune('R') {
+									goto l1052
+								}
+								position++
+							}
+						l1074:
+							{
+								position1076, tokenIndex1076, depth1076 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1077
+								}
+								position++
+								goto l1076
+							l1077:
+								position, tokenIndex, depth = position1076, tokenIndex1076, depth1076
+								if buffer[position] != rune('I') {
+									goto l1052
+								}
+								position++
+							}
+						l1076:
+							if !_rules[ruleskip]() {
+								goto l1052
+							}
+							depth--
+							add(ruleENCODEFORURI, position1053)
+						}
+						goto l919
+					l1052:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1079 := position
+							depth++
+							{
+								position1080, tokenIndex1080, depth1080 := position, tokenIndex, depth
+								if buffer[position] != rune('y') {
+									goto l1081
+								}
+								position++
+								goto l1080
+							l1081:
+								position, tokenIndex, depth = position1080, tokenIndex1080, depth1080
+								if buffer[position] != rune('Y') {
+									goto l1078
+								}
+								position++
+							}
+						l1080:
+							{
+								position1082, tokenIndex1082, depth1082 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1083
+								}
+								position++
+								goto l1082
+							l1083:
+								position, tokenIndex, depth = position1082, tokenIndex1082, depth1082
+								if buffer[position] != rune('E') {
+									goto l1078
+								}
+								position++
+							}
+						l1082:
+							{
+								position1084, tokenIndex1084, depth1084 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1085
+								}
+								position++
+								goto l1084
+							l1085:
+								position, tokenIndex, depth = position1084, tokenIndex1084, depth1084
+								if buffer[position] != rune('A') {
+									goto l1078
+								}
+								position++
 							}
-						l838:
+						l1084:
 							{
-								position840, tokenIndex840, depth840 := position, tokenIndex, depth
-								if buffer[position] != rune('p') {
-									goto l841
+								position1086, tokenIndex1086, depth1086 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1087
 								}
 								position++
-								goto l840
-							l841:
-								position, tokenIndex, depth = position840, tokenIndex840, depth840
-								if buffer[position] != rune('P') {
-									goto l826
+								goto l1086
+							l1087:
+								position, tokenIndex, depth = position1086, tokenIndex1086, depth1086
+								if buffer[position] != rune('R') {
+									goto l1078
 								}
 								position++
 							}
-						l840:
+						l1086:
+							if !_rules[ruleskip]() {
+								goto l1078
+							}
+							depth--
+							add(ruleYEAR, position1079)
+						}
+						goto l919
+					l1078:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1089 := position
+							depth++
 							{
-								position842, tokenIndex842, depth842 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l843
+								position1090, tokenIndex1090, depth1090 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1091
 								}
 								position++
-								goto l842
-							l843:
-								position, tokenIndex, depth = position842, tokenIndex842, depth842
-								if buffer[position] != rune('E') {
-									goto l826
+								goto l1090
+							l1091:
+								position, tokenIndex, depth = position1090, tokenIndex1090, depth1090
+								if buffer[position] != rune('M') {
+									goto l1088
+								}
+								position++
+							}
+						l1090:
+							{
+								position1092, tokenIndex1092, depth1092 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1093
+								}
+								position++
+								goto l1092
+							l1093:
+								position, tokenIndex, depth = position1092, tokenIndex1092, depth1092
+								if buffer[position] != rune('O') {
+									goto l1088
+								}
+								position++
+							}
+						l1092:
+							{
+								position1094, tokenIndex1094, depth1094 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1095
+								}
+								position++
+								goto l1094
+							l1095:
+								position, tokenIndex, depth = position1094, tokenIndex1094, depth1094
+								if buffer[position] != rune('N') {
+									goto l1088
+								}
+								position++
+							}
+						l1094:
+							{
+								position1096, tokenIndex1096, depth1096 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1097
+								}
+								position++
+								goto l1096
+							l1097:
+								position, tokenIndex, depth = position1096, tokenIndex1096, depth1096
+								if buffer[position] != rune('T') {
+									goto l1088
+								}
+								position++
+							}
+						l1096:
+							{
+								position1098, tokenIndex1098, depth1098 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1099
+								}
+								position++
+								goto l1098
+							l1099:
+								position, tokenIndex, depth = position1098, tokenIndex1098, depth1098
+								if buffer[position] != rune('H') {
+									goto l1088
 								}
 								position++
 							}
-						l842:
-							if !rules[ruleskip]() {
-								goto l826
+						l1098:
+							if !_rules[ruleskip]() {
+								goto l1088
 							}
 							depth--
-							add(ruleDATATYPE, position827)
+							add(ruleMONTH, position1089)
 						}
-						goto l807
-					l826:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1088:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position845 := position
+							position1101 := position
 							depth++
 							{
-								position846, tokenIndex846, depth846 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l847
+								position1102, tokenIndex1102, depth1102 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1103
 								}
 								position++
-								goto l846
-							l847:
-								position, tokenIndex, depth = position846, tokenIndex846, depth846
-								if buffer[position] != rune('I') {
-									goto l844
+								goto l1102
+							l1103:
+								position, tokenIndex, depth = position1102, tokenIndex1102, depth1102
+								if buffer[position] != rune('D') {
+									goto l1100
 								}
 								position++
 							}
-						l846:
+						l1102:
 							{
-								position848, tokenIndex848, depth848 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l849
+								position1104, tokenIndex1104, depth1104 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1105
 								}
 								position++
-								goto l848
-							l849:
-								position, tokenIndex, depth = position848, tokenIndex848, depth848
-								if buffer[position] != rune('R') {
-									goto l844
+								goto l1104
+							l1105:
+								position, tokenIndex, depth = position1104, tokenIndex1104, depth1104
+								if buffer[position] != rune('A') {
+									goto l1100
 								}
 								position++
 							}
-						l848:
+						l1104:
 							{
-								position850, tokenIndex850, depth850 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l851
+								position1106, tokenIndex1106, depth1106 := position, tokenIndex, depth
+								if buffer[position] != rune('y') {
+									goto l1107
 								}
 								position++
-								goto l850
-							l851:
-								position, tokenIndex, depth = position850, tokenIndex850, depth850
-								if buffer[position] != rune('I') {
-									goto l844
+								goto l1106
+							l1107:
+								position, tokenIndex, depth = position1106, tokenIndex1106, depth1106
+								if buffer[position] != rune('Y') {
+									goto l1100
 								}
 								position++
 							}
-						l850:
-							if !rules[ruleskip]() {
-								goto l844
+						l1106:
+							if !_rules[ruleskip]() {
+								goto l1100
 							}
 							depth--
-							add(ruleIRI, position845)
+							add(ruleDAY, position1101)
 						}
-						goto l807
-					l844:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1100:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position853 := position
+							position1109 := position
 							depth++
 							{
-								position854, tokenIndex854, depth854 := position, tokenIndex, depth
+								position1110, tokenIndex1110, depth1110 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1111
+								}
+								position++
+								goto l1110
+							l1111:
+								position, tokenIndex, depth = position1110, tokenIndex1110, depth1110
+								if buffer[position] != rune('H') {
+									goto l1108
+								}
+								position++
+							}
+						l1110:
+							{
+								position1112, tokenIndex1112, depth1112 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1113
+								}
+								position++
+								goto l1112
+							l1113:
+								position, tokenIndex, depth = position1112, tokenIndex1112, depth1112
+								if buffer[position] != rune('O') {
+									goto l1108
+								}
+								position++
+							}
+						l1112:
+							{
+								position1114, tokenIndex1114, depth1114 := position, tokenIndex, depth
 								if buffer[position] != rune('u') {
-									goto l855
+									goto l1115
 								}
 								position++
-								goto l854
-							l855:
-								position, tokenIndex, depth = position854, tokenIndex854, depth854
+								goto l1114
+							l1115:
+								position, tokenIndex, depth = position1114, tokenIndex1114, depth1114
 								if buffer[position] != rune('U') {
-									goto l852
+									goto l1108
 								}
 								position++
 							}
-						l854:
+						l1114:
 							{
-								position856, tokenIndex856, depth856 := position, tokenIndex, depth
+								position1116, tokenIndex1116, depth1116 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l857
+									goto l1117
 								}
 								position++
-								goto l856
-							l857:
-								position, tokenIndex, depth = position856, tokenIndex856, depth856
+								goto l1116
+							l1117:
+								position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
 								if buffer[position] != rune('R') {
-									goto l852
+									goto l1108
 								}
 								position++
 							}
-						l856:
+						l1116:
 							{
-								position858, tokenIndex858, depth858 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l859
+								position1118, tokenIndex1118, depth1118 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1119
 								}
 								position++
-								goto l858
-							l859:
-								position, tokenIndex, depth = position858, tokenIndex858, depth858
-								if buffer[position] != rune('I') {
-									goto l852
+								goto l1118
+							l1119:
+								position, tokenIndex, depth = position1118, tokenIndex1118, depth1118
+								if buffer[position] != rune('S') {
+									goto l1108
 								}
 								position++
 							}
-						l858:
-							if !rules[ruleskip]() {
-								goto l852
+						l1118:
+							if !_rules[ruleskip]() {
+								goto l1108
 							}
 							depth--
-							add(ruleURI, position853)
+							add(ruleHOURS, position1109)
 						}
-						goto l807
-					l852:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1108:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position861 := position
+							position1121 := position
 							depth++
 							{
-								position862, tokenIndex862, depth862 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l863
+								position1122, tokenIndex1122, depth1122 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1123
 								}
 								position++
-								goto l862
-							l863:
-								position, tokenIndex, depth = position862, tokenIndex862, depth862
-								if buffer[position] != rune('S') {
-									goto l860
+								goto l1122
+							l1123:
+								position, tokenIndex, depth = position1122, tokenIndex1122, depth1122
+								if buffer[position] != rune('M') {
+									goto l1120
 								}
 								position++
 							}
-						l862:
+						l1122:
 							{
-								position864, tokenIndex864, depth864 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l865
+								position1124, tokenIndex1124, depth1124 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1125
 								}
 								position++
-								goto l864
-							l865:
-								position, tokenIndex, depth = position864, tokenIndex864, depth864
-								if buffer[position] != rune('T') {
-									goto l860
+								goto l1124
+							l1125:
+								position, tokenIndex, depth = position1124, tokenIndex1124, depth1124
+								if buffer[position] != rune('I') {
+									goto l1120
 								}
 								position++
 							}
-						l864:
+						l1124:
 							{
-								position866, tokenIndex866, depth866 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l867
+								position1126, tokenIndex1126, depth1126 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1127
 								}
 								position++
-								goto l866
-							l867:
-								position, tokenIndex, depth = position866, tokenIndex866, depth866
-								if buffer[position] != rune('R') {
-									goto l860
+								goto l1126
+							l1127:
+								position, tokenIndex, depth = position1126, tokenIndex1126, depth1126
+								if buffer[position] != rune('N') {
+									goto l1120
 								}
 								position++
 							}
-						l866:
+						l1126:
 							{
-								position868, tokenIndex868, depth868 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l869
+								position1128, tokenIndex1128, depth1128 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1129
 								}
 								position++
-								goto l868
-							l869:
-								position, tokenIndex, depth = position868, tokenIndex868, depth868
-								if buffer[position] != rune('L') {
-									goto l860
+								goto l1128
+							l1129:
+								position, tokenIndex, depth = position1128, tokenIndex1128, depth1128
+								if buffer[position] != rune('U') {
+									goto l1120
+								}
+								position++
+							}
+						l1128:
+							{
+								position1130, tokenIndex1130, depth1130 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1131
+								}
+								position++
+								goto l1130
+							l1131:
+								position, tokenIndex, depth = position1130, tokenIndex1130, depth1130
+								if buffer[position] != rune('T') {
+									goto l1120
 								}
 								position++
 							}
-						l868:
+						l1130:
 							{
-								position870, tokenIndex870, depth870 := position, tokenIndex, depth
+								position1132, tokenIndex1132, depth1132 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l871
+									goto l1133
 								}
 								position++
-								goto l870
-							l871:
-								position, tokenIndex, depth = position870, tokenIndex870, depth870
+								goto l1132
+							l1133:
+								position, tokenIndex, depth = position1132, tokenIndex1132, depth1132
 								if buffer[position] != rune('E') {
-									goto l860
+									goto l1120
 								}
 								position++
 							}
-						l870:
+						l1132:
 							{
-								position872, tokenIndex872, depth872 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l873
+								position1134, tokenIndex1134, depth1134 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1135
 								}
 								position++
-								goto l872
-							l873:
-								position, tokenIndex, depth = position872, tokenIndex872, depth872
-								if buffer[position] != rune('N') {
-									goto l860
+								goto l1134
+							l1135:
+								position, tokenIndex, depth = position1134, tokenIndex1134, depth1134
+								if buffer[position] != rune('S') {
+									goto l1120
 								}
 								position++
 							}
-						l872:
-							if !rules[ruleskip]() {
-								goto l860
+						l1134:
+							if !_rules[ruleskip]() {
+								goto l1120
 							}
 							depth--
-							add(ruleSTRLEN, position861)
+							add(ruleMINUTES, position1121)
 						}
-						goto l807
-					l860:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1120:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position875 := position
+							position1137 := position
 							depth++
 							{
-								position876, tokenIndex876, depth876 := position, tokenIndex, depth
-								if buffer[position] != rune('m') {
-									goto l877
+								position1138, tokenIndex1138, depth1138 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1139
 								}
 								position++
-								goto l876
-							l877:
-								position, tokenIndex, depth = position876, tokenIndex876, depth876
-								if buffer[position] != rune('M') {
-									goto l874
+								goto l1138
+							l1139:
+								position, tokenIndex, depth = position1138, tokenIndex1138, depth1138
+								if buffer[position] != rune('S') {
+									goto l1136
+								}
+								position++
+							}
+						l1138:
+							{
+								position1140, tokenIndex1140, depth1140 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1141
+								}
+								position++
+								goto l1140
+							l1141:
+								position, tokenIndex, depth = position1140, tokenIndex1140, depth1140
+								if buffer[position] != rune('E') {
+									goto l1136
+								}
+								position++
+							}
+						l1140:
+							{
+								position1142, tokenIndex1142, depth1142 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1143
+								}
+								position++
+								goto l1142
+							l1143:
+								position, tokenIndex, depth = position1142, tokenIndex1142, depth1142
+								if buffer[position] != rune('C') {
+									goto l1136
 								}
 								position++
 							}
-						l876:
+						l1142:
 							{
-								position878, tokenIndex878, depth878 := position, tokenIndex, depth
+								position1144, tokenIndex1144, depth1144 := position, tokenIndex, depth
 								if buffer[position] != rune('o') {
-									goto l879
+									goto l1145
 								}
 								position++
-								goto l878
-							l879:
-								position, tokenIndex, depth = position878, tokenIndex878, depth878
+								goto l1144
+							l1145:
+								position, tokenIndex, depth = position1144, tokenIndex1144, depth1144
 								if buffer[position] != rune('O') {
-									goto l874
+									goto l1136
 								}
 								position++
 							}
-						l878:
+						l1144:
 							{
-								position880, tokenIndex880, depth880 := position, tokenIndex, depth
+								position1146, tokenIndex1146, depth1146 := position, tokenIndex, depth
 								if buffer[position] != rune('n') {
-									goto l881
+									goto l1147
 								}
 								position++
-								goto l880
-							l881:
-								position, tokenIndex, depth = position880, tokenIndex880, depth880
+								goto l1146
+							l1147:
+								position, tokenIndex, depth = position1146, tokenIndex1146, depth1146
 								if buffer[position] != rune('N') {
-									goto l874
+									goto l1136
 								}
 								position++
 							}
-						l880:
+						l1146:
 							{
-								position882, tokenIndex882, depth882 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l883
+								position1148, tokenIndex1148, depth1148 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1149
 								}
 								position++
-								goto l882
-							l883:
-								position, tokenIndex, depth = position882, tokenIndex882, depth882
-								if buffer[position] != rune('T') {
-									goto l874
+								goto l1148
+							l1149:
+								position, tokenIndex, depth = position1148, tokenIndex1148, depth1148
+								if buffer[position] != rune('D') {
+									goto l1136
 								}
 								position++
 							}
-						l882:
+						l1148:
 							{
-								position884, tokenIndex884, depth884 := position, tokenIndex, depth
-								if buffer[position] != rune('h') {
-									goto l885
+								position1150, tokenIndex1150, depth1150 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1151
 								}
 								position++
-								goto l884
-							l885:
-								position, tokenIndex, depth = position884, tokenIndex884, depth884
-								if buffer[position] != rune('H') {
-									goto l874
+								goto l1150
+							l1151:
+								position, tokenIndex, depth = position1150, tokenIndex1150, depth1150
+								if buffer[position] != rune('S') {
+									goto l1136
 								}
 								position++
 							}
-						l884:
-							if !rules[ruleskip]() {
-								goto l874
+						l1150:
+							if !_rules[ruleskip]() {
+								goto l1136
 							}
 							depth--
-							add(ruleMONTH, position875)
+							add(ruleSECONDS, position1137)
 						}
-						goto l807
-					l874:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1136:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position887 := position
+							position1153 := position
 							depth++
 							{
-								position888, tokenIndex888, depth888 := position, tokenIndex, depth
-								if buffer[position] != rune('m') {
-									goto l889
+								position1154, tokenIndex1154, depth1154 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1155
 								}
 								position++
-								goto l888
-							l889:
-								position, tokenIndex, depth = position888, tokenIndex888, depth888
-								if buffer[position] != rune('M') {
-									goto l886
+								goto l1154
+							l1155:
+								position, tokenIndex, depth = position1154, tokenIndex1154, depth1154
+								if buffer[position] != rune('T') {
+									goto l1152
 								}
 								position++
 							}
-						l888:
+						l1154:
 							{
-								position890, tokenIndex890, depth890 := position, tokenIndex, depth
+								position1156, tokenIndex1156, depth1156 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l891
+									goto l1157
 								}
 								position++
-								goto l890
-							l891:
-								position, tokenIndex, depth = position890, tokenIndex890, depth890
+								goto l1156
+							l1157:
+								position, tokenIndex, depth = position1156, tokenIndex1156, depth1156
 								if buffer[position] != rune('I') {
-									goto l886
+									goto l1152
+								}
+								position++
+							}
+						l1156:
+							{
+								position1158, tokenIndex1158, depth1158 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1159
+								}
+								position++
+								goto l1158
+							l1159:
+								position, tokenIndex, depth = position1158, tokenIndex1158, depth1158
+								if buffer[position] != rune('M') {
+									goto l1152
+								}
+								position++
+							}
+						l1158:
+							{
+								position1160, tokenIndex1160, depth1160 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1161
+								}
+								position++
+								goto l1160
+							l1161:
+								position, tokenIndex, depth = position1160, tokenIndex1160, depth1160
+								if buffer[position] != rune('E') {
+									goto l1152
+								}
+								position++
+							}
+						l1160:
+							{
+								position1162, tokenIndex1162, depth1162 := position, tokenIndex, depth
+								if buffer[position] != rune('z') {
+									goto l1163
+								}
+								position++
+								goto l1162
+							l1163:
+								position, tokenIndex, depth = position1162, tokenIndex1162, depth1162
+								if buffer[position] != rune('Z') {
+									goto l1152
+								}
+								position++
+							}
+						l1162:
+							{
+								position1164, tokenIndex1164, depth1164 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1165
+								}
+								position++
+								goto l1164
+							l1165:
+								position, tokenIndex, depth = position1164, tokenIndex1164, depth1164
+								if buffer[position] != rune('O') {
+									goto l1152
 								}
 								position++
 							}
-						l890:
+						l1164:
 							{
-								position892, tokenIndex892, depth892 := position, tokenIndex, depth
+								position1166, tokenIndex1166, depth1166 := position, tokenIndex, depth
 								if buffer[position] != rune('n') {
-									goto l893
+									goto l1167
 								}
 								position++
-								goto l892
-							l893:
-								position, tokenIndex, depth = position892, tokenIndex892, depth892
+								goto l1166
+							l1167:
+								position, tokenIndex, depth = position1166, tokenIndex1166, depth1166
 								if buffer[position] != rune('N') {
-									goto l886
+									goto l1152
 								}
 								position++
 							}
-						l892:
+						l1166:
 							{
-								position894, tokenIndex894, depth894 := position, tokenIndex, depth
-								if buffer[position] != rune('u') {
-									goto l895
+								position1168, tokenIndex1168, depth1168 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1169
 								}
 								position++
-								goto l894
-							l895:
-								position, tokenIndex, depth = position894, tokenIndex894, depth894
-								if buffer[position] != rune('U') {
-									goto l886
+								goto l1168
+							l1169:
+								position, tokenIndex, depth = position1168, tokenIndex1168, depth1168
+								if buffer[position] != rune('E') {
+									goto l1152
 								}
 								position++
 							}
-						l894:
+						l1168:
+							if !_rules[ruleskip]() {
+								goto l1152
+							}
+							depth--
+							add(ruleTIMEZONE, position1153)
+						}
+						goto l919
+					l1152:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1171 := position
+							depth++
 							{
-								position896, tokenIndex896, depth896 := position, tokenIndex, depth
+								position1172, tokenIndex1172, depth1172 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l897
+									goto l1173
 								}
 								position++
-								goto l896
-							l897:
-								position, tokenIndex, depth = position896, tokenIndex896, depth896
+								goto l1172
+							l1173:
+								position, tokenIndex, depth = position1172, tokenIndex1172, depth1172
 								if buffer[position] != rune('T') {
-									goto l886
+									goto l1170
 								}
 								position++
 							}
-						l896:
+						l1172:
 							{
-								position898, tokenIndex898, depth898 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l899
+								position1174, tokenIndex1174, depth1174 := position, tokenIndex, depth
+								if buffer[position] != rune('z') {
+									goto l1175
 								}
 								position++
-								goto l898
-							l899:
-								position, tokenIndex, depth = position898, tokenIndex898, depth898
-								if buffer[position] != rune('E') {
-									goto l886
+								goto l1174
+							l1175:
+								position, tokenIndex, depth = position1174, tokenIndex1174, depth1174
+								if buffer[position] != rune('Z') {
+									goto l1170
 								}
 								position++
 							}
-						l898:
+						l1174:
+							if !_rules[ruleskip]() {
+								goto l1170
+							}
+							depth--
+							add(ruleTZ, position1171)
+						}
+						goto l919
+					l1170:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1177 := position
+							depth++
 							{
-								position900, tokenIndex900, depth900 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l901
+								position1178, tokenIndex1178, depth1178 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1179
 								}
 								position++
-								goto l900
-							l901:
-								position, tokenIndex, depth = position900, tokenIndex900, depth900
-								if buffer[position] != rune('S') {
-									goto l886
+								goto l1178
+							l1179:
+								position, tokenIndex, depth = position1178, tokenIndex1178, depth1178
+								if buffer[position] != rune('M') {
+									goto l1176
+								}
+								position++
+							}
+						l1178:
+							{
+								position1180, tokenIndex1180, depth1180 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1181
+								}
+								position++
+								goto l1180
+							l1181:
+								position, tokenIndex, depth = position1180, tokenIndex1180, depth1180
+								if buffer[position] != rune('D') {
+									goto l1176
 								}
 								position++
 							}
-						l900:
-							if !rules[ruleskip]() {
-								goto l886
+						l1180:
+							if buffer[position] != rune('5') {
+								goto l1176
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1176
 							}
 							depth--
-							add(ruleMINUTES, position887)
+							add(ruleMD5, position1177)
 						}
-						goto l807
-					l886:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1176:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position903 := position
+							position1183 := position
 							depth++
 							{
-								position904, tokenIndex904, depth904 := position, tokenIndex, depth
+								position1184, tokenIndex1184, depth1184 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l905
+									goto l1185
 								}
 								position++
-								goto l904
-							l905:
-								position, tokenIndex, depth = position904, tokenIndex904, depth904
+								goto l1184
+							l1185:
+								position, tokenIndex, depth = position1184, tokenIndex1184, depth1184
 								if buffer[position] != rune('S') {
-									goto l902
+									goto l1182
 								}
 								position++
 							}
-						l904:
+						l1184:
 							{
-								position906, tokenIndex906, depth906 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l907
+								position1186, tokenIndex1186, depth1186 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1187
 								}
 								position++
-								goto l906
-							l907:
-								position, tokenIndex, depth = position906, tokenIndex906, depth906
-								if buffer[position] != rune('E') {
-									goto l902
+								goto l1186
+							l1187:
+								position, tokenIndex, depth = position1186, tokenIndex1186, depth1186
+								if buffer[position] != rune('H') {
+									goto l1182
 								}
 								position++
 							}
-						l906:
+						l1186:
 							{
-								position908, tokenIndex908, depth908 := position, tokenIndex, depth
-								if buffer[position] != rune('c') {
-									goto l909
+								position1188, tokenIndex1188, depth1188 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1189
 								}
 								position++
-								goto l908
-							l909:
-								position, tokenIndex, depth = position908, tokenIndex908, depth908
-								if buffer[position] != rune('C') {
-									goto l902
+								goto l1188
+							l1189:
+								position, tokenIndex, depth = position1188, tokenIndex1188, depth1188
+								if buffer[position] != rune('A') {
+									goto l1182
+								}
+								position++
+							}
+						l1188:
+							if buffer[position] != rune('1') {
+								goto l1182
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1182
+							}
+							depth--
+							add(ruleSHA1, position1183)
+						}
+						goto l919
+					l1182:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1191 := position
+							depth++
+							{
+								position1192, tokenIndex1192, depth1192 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1193
+								}
+								position++
+								goto l1192
+							l1193:
+								position, tokenIndex, depth = position1192, tokenIndex1192, depth1192
+								if buffer[position] != rune('S') {
+									goto l1190
 								}
 								position++
 							}
-						l908:
+						l1192:
 							{
-								position910, tokenIndex910, depth910 := position, tokenIndex, depth
-								if buffer[position] != rune('o') {
-									goto l911
+								position1194, tokenIndex1194, depth1194 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1195
 								}
 								position++
-								goto l910
-							l911:
-								position, tokenIndex, depth = position910, tokenIndex910, depth910
-								if buffer[position] != rune('O') {
-									goto l902
+								goto l1194
+							l1195:
+								position, tokenIndex, depth = position1194, tokenIndex1194, depth1194
+								if buffer[position] != rune('H') {
+									goto l1190
+								}
+								position++
+							}
+						l1194:
+							{
+								position1196, tokenIndex1196, depth1196 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1197
+								}
+								position++
+								goto l1196
+							l1197:
+								position, tokenIndex, depth = position1196, tokenIndex1196, depth1196
+								if buffer[position] != rune('A') {
+									goto l1190
 								}
 								position++
 							}
-						l910:
+						l1196:
+							if buffer[position] != rune('2') {
+								goto l1190
+							}
+							position++
+							if buffer[position] != rune('5') {
+								goto l1190
+							}
+							position++
+							if buffer[position] != rune('6') {
+								goto l1190
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1190
+							}
+							depth--
+							add(ruleSHA256, position1191)
+						}
+						goto l919
+					l1190:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1199 := position
+							depth++
 							{
-								position912, tokenIndex912, depth912 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l913
+								position1200, tokenIndex1200, depth1200 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1201
 								}
 								position++
-								goto l912
-							l913:
-								position, tokenIndex, depth = position912, tokenIndex912, depth912
-								if buffer[position] != rune('N') {
-									goto l902
+								goto l1200
+							l1201:
+								position, tokenIndex, depth = position1200, tokenIndex1200, depth1200
+								if buffer[position] != rune('S') {
+									goto l1198
 								}
 								position++
 							}
-						l912:
+						l1200:
 							{
-								position914, tokenIndex914, depth914 := position, tokenIndex, depth
-								if buffer[position] != rune('d') {
-									goto l915
+								position1202, tokenIndex1202, depth1202 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1203
 								}
 								position++
-								goto l914
-							l915:
-								position, tokenIndex, depth = position914, tokenIndex914, depth914
-								if buffer[position] != rune('D') {
-									goto l902
+								goto l1202
+							l1203:
+								position, tokenIndex, depth = position1202, tokenIndex1202, depth1202
+								if buffer[position] != rune('H') {
+									goto l1198
 								}
 								position++
 							}
-						l914:
+						l1202:
 							{
-								position916, tokenIndex916, depth916 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l917
+								position1204, tokenIndex1204, depth1204 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1205
 								}
 								position++
-								goto l916
-							l917:
-								position, tokenIndex, depth = position916, tokenIndex916, depth916
-								if buffer[position] != rune('S') {
-									goto l902
+								goto l1204
+							l1205:
+								position, tokenIndex, depth = position1204, tokenIndex1204, depth1204
+								if buffer[position] != rune('A') {
+									goto l1198
 								}
 								position++
 							}
-						l916:
-							if !rules[ruleskip]() {
-								goto l902
+						l1204:
+							if buffer[position] != rune('3') {
+								goto l1198
+							}
+							position++
+							if buffer[position] != rune('8') {
+								goto l1198
+							}
+							position++
+							if buffer[position] != rune('4') {
+								goto l1198
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1198
 							}
 							depth--
-							add(ruleSECONDS, position903)
+							add(ruleSHA384, position1199)
 						}
-						goto l807
-					l902:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1198:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position919 := position
+							position1207 := position
 							depth++
 							{
-								position920, tokenIndex920, depth920 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l921
+								position1208, tokenIndex1208, depth1208 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1209
 								}
 								position++
-								goto l920
-							l921:
-								position, tokenIndex, depth = position920, tokenIndex920, depth920
-								if buffer[position] != rune('T') {
-									goto l918
+								goto l1208
+							l1209:
+								position, tokenIndex, depth = position1208, tokenIndex1208, depth1208
+								if buffer[position] != rune('S') {
+									goto l1206
 								}
 								position++
 							}
-						l920:
+						l1208:
 							{
-								position922, tokenIndex922, depth922 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l923
+								position1210, tokenIndex1210, depth1210 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1211
 								}
 								position++
-								goto l922
-							l923:
-								position, tokenIndex, depth = position922, tokenIndex922, depth922
-								if buffer[position] != rune('I') {
-									goto l918
+								goto l1210
+							l1211:
+								position, tokenIndex, depth = position1210, tokenIndex1210, depth1210
+								if buffer[position] != rune('H') {
+									goto l1206
 								}
 								position++
 							}
-						l922:
+						l1210:
 							{
-								position924, tokenIndex924, depth924 := position, tokenIndex, depth
-								if buffer[position] != rune('m') {
-									goto l925
+								position1212, tokenIndex1212, depth1212 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1213
 								}
 								position++
-								goto l924
-							l925:
-								position, tokenIndex, depth = position924, tokenIndex924, depth924
-								if buffer[position] != rune('M') {
-									goto l918
+								goto l1212
+							l1213:
+								position, tokenIndex, depth = position1212, tokenIndex1212, depth1212
+								if buffer[position] != rune('A') {
+									goto l1206
 								}
 								position++
 							}
-						l924:
+						l1212:
+							if buffer[position] != rune('5') {
+								goto l1206
+							}
+							position++
+							if buffer[position] != rune('1') {
+								goto l1206
+							}
+							position++
+							if buffer[position] != rune('2') {
+								goto l1206
+							}
+							position++
+							if !_rules[ruleskip]() {
+								goto l1206
+							}
+							depth--
+							add(ruleSHA512, position1207)
+						}
+						goto l919
+					l1206:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1215 := position
+							depth++
 							{
-								position926, tokenIndex926, depth926 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l927
+								position1216, tokenIndex1216, depth1216 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1217
 								}
 								position++
-								goto l926
-							l927:
-								position, tokenIndex, depth = position926, tokenIndex926, depth926
-								if buffer[position] != rune('E') {
-									goto l918
+								goto l1216
+							l1217:
+								position, tokenIndex, depth = position1216, tokenIndex1216, depth1216
+								if buffer[position] != rune('I') {
+									goto l1214
 								}
 								position++
 							}
-						l926:
+						l1216:
 							{
-								position928, tokenIndex928, depth928 := position, tokenIndex, depth
-								if buffer[position] != rune('z') {
-									goto l929
+								position1218, tokenIndex1218, depth1218 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1219
 								}
 								position++
-								goto l928
-							l929:
-								position, tokenIndex, depth = position928, tokenIndex928, depth928
-								if buffer[position] != rune('Z') {
-									goto l918
+								goto l1218
+							l1219:
+								position, tokenIndex, depth = position1218, tokenIndex1218, depth1218
+								if buffer[position] != rune('S') {
+									goto l1214
 								}
 								position++
 							}
-						l928:
+						l1218:
 							{
-								position930, tokenIndex930, depth930 := position, tokenIndex, depth
-								if buffer[position] != rune('o') {
-									goto l931
+								position1220, tokenIndex1220, depth1220 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1221
 								}
 								position++
-								goto l930
-							l931:
-								position, tokenIndex, depth = position930, tokenIndex930, depth930
-								if buffer[position] != rune('O') {
-									goto l918
+								goto l1220
+							l1221:
+								position, tokenIndex, depth = position1220, tokenIndex1220, depth1220
+								if buffer[position] != rune('I') {
+									goto l1214
 								}
 								position++
 							}
-						l930:
+						l1220:
 							{
-								position932, tokenIndex932, depth932 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l933
+								position1222, tokenIndex1222, depth1222 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1223
 								}
 								position++
-								goto l932
-							l933:
-								position, tokenIndex, depth = position932, tokenIndex932, depth932
-								if buffer[position] != rune('N') {
-									goto l918
+								goto l1222
+							l1223:
+								position, tokenIndex, depth = position1222, tokenIndex1222, depth1222
+								if buffer[position] != rune('R') {
+									goto l1214
 								}
 								position++
 							}
-						l932:
+						l1222:
 							{
-								position934, tokenIndex934, depth934 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l935
+								position1224, tokenIndex1224, depth1224 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1225
 								}
 								position++
-								goto l934
-							l935:
-								position, tokenIndex, depth = position934, tokenIndex934, depth934
-								if buffer[position] != rune('E') {
-									goto l918
+								goto l1224
+							l1225:
+								position, tokenIndex, depth = position1224, tokenIndex1224, depth1224
+								if buffer[position] != rune('I') {
+									goto l1214
 								}
 								position++
 							}
-						l934:
-							if !rules[ruleskip]() {
-								goto l918
+						l1224:
+							if !_rules[ruleskip]() {
+								goto l1214
 							}
 							depth--
-							add(ruleTIMEZONE, position919)
+							add(ruleISIRI, position1215)
 						}
-						goto l807
-					l918:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1214:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position937 := position
+							position1227 := position
 							depth++
 							{
-								position938, tokenIndex938, depth938 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l939
+								position1228, tokenIndex1228, depth1228 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1229
 								}
 								position++
-								goto l938
-							l939:
-								position, tokenIndex, depth = position938, tokenIndex938, depth938
-								if buffer[position] != rune('S') {
-									goto l936
+								goto l1228
+							l1229:
+								position, tokenIndex, depth = position1228, tokenIndex1228, depth1228
+								if buffer[position] != rune('I') {
+									goto l1226
 								}
 								position++
 							}
-						l938:
+						l1228:
 							{
-								position940, tokenIndex940, depth940 := position, tokenIndex, depth
-								if buffer[position] != rune('h') {
-									goto l941
+								position1230, tokenIndex1230, depth1230 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1231
 								}
 								position++
-								goto l940
-							l941:
-								position, tokenIndex, depth = position940, tokenIndex940, depth940
-								if buffer[position] != rune('H') {
-									goto l936
+								goto l1230
+							l1231:
+								position, tokenIndex, depth = position1230, tokenIndex1230, depth1230
+								if buffer[position] != rune('S') {
+									goto l1226
 								}
 								position++
 							}
-						l940:
+						l1230:
 							{
-								position942, tokenIndex942, depth942 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l943
+								position1232, tokenIndex1232, depth1232 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1233
 								}
 								position++
-								goto l942
-							l943:
-								position, tokenIndex, depth = position942, tokenIndex942, depth942
-								if buffer[position] != rune('A') {
-									goto l936
+								goto l1232
+							l1233:
+								position, tokenIndex, depth = position1232, tokenIndex1232, depth1232
+								if buffer[position] != rune('U') {
+									goto l1226
 								}
 								position++
 							}
-						l942:
-							if buffer[position] != rune('1') {
-								goto l936
-							}
-							position++
-							if !rules[ruleskip]() {
-								goto l936
-							}
-							depth--
-							add(ruleSHA1, position937)
-						}
-						goto l807
-					l936:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
-						{
-							position945 := position
-							depth++
+						l1232:
 							{
-								position946, tokenIndex946, depth946 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l947
+								position1234, tokenIndex1234, depth1234 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1235
 								}
 								position++
-								goto l946
-							l947:
-								position, tokenIndex, depth = position946, tokenIndex946, depth946
-								if buffer[position] != rune('S') {
-									goto l944
+								goto l1234
+							l1235:
+								position, tokenIndex, depth = position1234, tokenIndex1234, depth1234
+								if buffer[position] != rune('R') {
+									goto l1226
 								}
 								position++
 							}
-						l946:
+						l1234:
 							{
-								position948, tokenIndex948, depth948 := position, tokenIndex, depth
-								if buffer[position] != rune('h') {
-									goto l949
+								position1236, tokenIndex1236, depth1236 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1237
 								}
 								position++
-								goto l948
-							l949:
-								position, tokenIndex, depth = position948, tokenIndex948, depth948
-								if buffer[position] != rune('H') {
-									goto l944
+								goto l1236
+							l1237:
+								position, tokenIndex, depth = position1236, tokenIndex1236, depth1236
+								if buffer[position] != rune('I') {
+									goto l1226
 								}
 								position++
 							}
-						l948:
+						l1236:
+							if !_rules[ruleskip]() {
+								goto l1226
+							}
+							depth--
+							add(ruleISURI, position1227)
+						}
+						goto l919
+					l1226:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
+						{
+							position1239 := position
+							depth++
 							{
-								position950, tokenIndex950, depth950 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l951
+								position1240, tokenIndex1240, depth1240 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1241
 								}
 								position++
-								goto l950
-							l951:
-								position, tokenIndex, depth = position950, tokenIndex950, depth950
-								if buffer[position] != rune('A') {
-									goto l944
+								goto l1240
+							l1241:
+								position, tokenIndex, depth = position1240, tokenIndex1240, depth1240
+								if buffer[position] != rune('I') {
+									goto l1238
 								}
 								position++
 							}
-						l950:
-							if buffer[position] != rune('2') {
-								goto l944
-							}
-							position++
-							if buffer[position] != rune('5') {
-								goto l944
-							}
-							position++
-							if buffer[position] != rune('6') {
-								goto l944
-							}
-							position++
-							if !rules[ruleskip]() {
-								goto l944
-							}
-							depth--
-							add(ruleSHA256, position945)
-						}
-						goto l807
-					l944:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
-						{
-							position953 := position
-							depth++
+						l1240:
 							{
-								position954, tokenIndex954, depth954 := position, tokenIndex, depth
+								position1242, tokenIndex1242, depth1242 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l955
+									goto l1243
 								}
 								position++
-								goto l954
-							l955:
-								position, tokenIndex, depth = position954, tokenIndex954, depth954
+								goto l1242
+							l1243:
+								position, tokenIndex, depth = position1242, tokenIndex1242, depth1242
 								if buffer[position] != rune('S') {
-									goto l952
+									goto l1238
 								}
 								position++
 							}
-						l954:
+						l1242:
 							{
-								position956, tokenIndex956, depth956 := position, tokenIndex, depth
-								if buffer[position] != rune('h') {
-									goto l957
+								position1244, tokenIndex1244, depth1244 := position, tokenIndex, depth
+								if buffer[position] != rune('b') {
+									goto l1245
 								}
 								position++
-								goto l956
-							l957:
-								position, tokenIndex, depth = position956, tokenIndex956, depth956
-								if buffer[position] != rune('H') {
-									goto l952
+								goto l1244
+							l1245:
+								position, tokenIndex, depth = position1244, tokenIndex1244, depth1244
+								if buffer[position] != rune('B') {
+									goto l1238
+								}
+								position++
+							}
+						l1244:
+							{
+								position1246, tokenIndex1246, depth1246 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1247
+								}
+								position++
+								goto l1246
+							l1247:
+								position, tokenIndex, depth = position1246, tokenIndex1246, depth1246
+								if buffer[position] != rune('L') {
+									goto l1238
 								}
 								position++
 							}
-						l956:
+						l1246:
 							{
-								position958, tokenIndex958, depth958 := position, tokenIndex, depth
+								position1248, tokenIndex1248, depth1248 := position, tokenIndex, depth
 								if buffer[position] != rune('a') {
-									goto l959
+									goto l1249
 								}
 								position++
-								goto l958
-							l959:
-								position, tokenIndex, depth = position958, tokenIndex958, depth958
+								goto l1248
+							l1249:
+								position, tokenIndex, depth = position1248, tokenIndex1248, depth1248
 								if buffer[position] != rune('A') {
-									goto l952
+									goto l1238
 								}
 								position++
 							}
-						l958:
-							if buffer[position] != rune('3') {
-								goto l952
-							}
-							position++
-							if buffer[position] != rune('8') {
-								goto l952
+						l1248:
+							{
+								position1250, tokenIndex1250, depth1250 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1251
+								}
+								position++
+								goto l1250
+							l1251:
+								position, tokenIndex, depth = position1250, tokenIndex1250, depth1250
+								if buffer[position] != rune('N') {
+									goto l1238
+								}
+								position++
 							}
-							position++
-							if buffer[position] != rune('4') {
-								goto l952
+						l1250:
+							{
+								position1252, tokenIndex1252, depth1252 := position, tokenIndex, depth
+								if buffer[position] != rune('k') {
+									goto l1253
+								}
+								position++
+								goto l1252
+							l1253:
+								position, tokenIndex, depth = position1252, tokenIndex1252, depth1252
+								if buffer[position] != rune('K') {
+									goto l1238
+								}
+								position++
 							}
-							position++
-							if !rules[ruleskip]() {
-								goto l952
+						l1252:
+							if !_rules[ruleskip]() {
+								goto l1238
 							}
 							depth--
-							add(ruleSHA384, position953)
+							add(ruleISBLANK, position1239)
 						}
-						goto l807
-					l952:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1238:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position961 := position
+							position1255 := position
 							depth++
 							{
-								position962, tokenIndex962, depth962 := position, tokenIndex, depth
+								position1256, tokenIndex1256, depth1256 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l963
+									goto l1257
 								}
 								position++
-								goto l962
-							l963:
-								position, tokenIndex, depth = position962, tokenIndex962, depth962
+								goto l1256
+							l1257:
+								position, tokenIndex, depth = position1256, tokenIndex1256, depth1256
 								if buffer[position] != rune('I') {
-									goto l960
+									goto l1254
 								}
 								position++
 							}
-						l962:
+						l1256:
 							{
-								position964, tokenIndex964, depth964 := position, tokenIndex, depth
+								position1258, tokenIndex1258, depth1258 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l965
+									goto l1259
 								}
 								position++
-								goto l964
-							l965:
-								position, tokenIndex, depth = position964, tokenIndex964, depth964
+								goto l1258
+							l1259:
+								position, tokenIndex, depth = position1258, tokenIndex1258, depth1258
 								if buffer[position] != rune('S') {
-									goto l960
-								}
-								position++
-							}
-						l964:
-							{
-								position966, tokenIndex966, depth966 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l967
-								}
-								position++
-								goto l966
-							l967:
-								position, tokenIndex, depth = position966, tokenIndex966, depth966
-								if buffer[position] != rune('I') {
-									goto l960
+									goto l1254
 								}
 								position++
 							}
-						l966:
+						l1258:
 							{
-								position968, tokenIndex968, depth968 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l969
+								position1260, tokenIndex1260, depth1260 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1261
 								}
 								position++
-								goto l968
-							l969:
-								position, tokenIndex, depth = position968, tokenIndex968, depth968
-								if buffer[position] != rune('R') {
-									goto l960
+								goto l1260
+							l1261:
+								position, tokenIndex, depth = position1260, tokenIndex1260, depth1260
+								if buffer[position] != rune('L') {
+									goto l1254
 								}
 								position++
 							}
-						l968:
+						l1260:
 							{
-								position970, tokenIndex970, depth970 := position, tokenIndex, depth
+								position1262, tokenIndex1262, depth1262 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l971
+									goto l1263
 								}
 								position++
-								goto l970
-							l971:
-								position, tokenIndex, depth = position970, tokenIndex970, depth970
+								goto l1262
+							l1263:
+								position, tokenIndex, depth = position1262, tokenIndex1262, depth1262
 								if buffer[position] != rune('I') {
-									goto l960
+									goto l1254
 								}
 								position++
 							}
-						l970:
-							if !rules[ruleskip]() {
-								goto l960
-							}
-							depth--
-							add(ruleISIRI, position961)
-						}
-						goto l807
-					l960:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
-						{
-							position973 := position
-							depth++
+						l1262:
 							{
-								position974, tokenIndex974, depth974 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l975
+								position1264, tokenIndex1264, depth1264 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1265
 								}
 								position++
-								goto l974
-							l975:
-								position, tokenIndex, depth = position974, tokenIndex974, depth974
-								if buffer[position] != rune('I') {
-									goto l972
+								goto l1264
+							l1265:
+								position, tokenIndex, depth = position1264, tokenIndex1264, depth1264
+								if buffer[position] != rune('T') {
+									goto l1254
 								}
 								position++
 							}
-						l974:
+						l1264:
 							{
-								position976, tokenIndex976, depth976 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l977
+								position1266, tokenIndex1266, depth1266 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1267
 								}
 								position++
-								goto l976
-							l977:
-								position, tokenIndex, depth = position976, tokenIndex976, depth976
-								if buffer[position] != rune('S') {
-									goto l972
+								goto l1266
+							l1267:
+								position, tokenIndex, depth = position1266, tokenIndex1266, depth1266
+								if buffer[position] != rune('E') {
+									goto l1254
 								}
 								position++
 							}
-						l976:
+						l1266:
 							{
-								position978, tokenIndex978, depth978 := position, tokenIndex, depth
-								if buffer[position] != rune('u') {
-									goto l979
+								position1268, tokenIndex1268, depth1268 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1269
 								}
 								position++
-								goto l978
-							l979:
-								position, tokenIndex, depth = position978, tokenIndex978, depth978
-								if buffer[position] != rune('U') {
-									goto l972
+								goto l1268
+							l1269:
+								position, tokenIndex, depth = position1268, tokenIndex1268, depth1268
+								if buffer[position] != rune('R') {
+									goto l1254
 								}
 								position++
 							}
-						l978:
+						l1268:
 							{
-								position980, tokenIndex980, depth980 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l981
+								position1270, tokenIndex1270, depth1270 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1271
 								}
 								position++
-								goto l980
-							l981:
-								position, tokenIndex, depth = position980, tokenIndex980, depth980
-								if buffer[position] != rune('R') {
-									goto l972
+								goto l1270
+							l1271:
+								position, tokenIndex, depth = position1270, tokenIndex1270, depth1270
+								if buffer[position] != rune('A') {
+									goto l1254
 								}
 								position++
 							}
-						l980:
+						l1270:
 							{
-								position982, tokenIndex982, depth982 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l983
+								position1272, tokenIndex1272, depth1272 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1273
 								}
 								position++
-								goto l982
-							l983:
-								position, tokenIndex, depth = position982, tokenIndex982, depth982
-								if buffer[position] != rune('I') {
-									goto l972
+								goto l1272
+							l1273:
+								position, tokenIndex, depth = position1272, tokenIndex1272, depth1272
+								if buffer[position] != rune('L') {
+									goto l1254
 								}
 								position++
 							}
-						l982:
-							if !rules[ruleskip]() {
-								goto l972
+						l1272:
+							if !_rules[ruleskip]() {
+								goto l1254
 							}
 							depth--
-							add(ruleISURI, position973)
+							add(ruleISLITERAL, position1255)
 						}
-						goto l807
-					l972:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
+						goto l919
+					l1254:
+						position, tokenIndex, depth = position919, tokenIndex919, depth919
 						{
-							position985 := position
+							position1274 := position
 							depth++
 							{
-								position986, tokenIndex986, depth986 := position, tokenIndex, depth
+								position1275, tokenIndex1275, depth1275 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l987
+									goto l1276
 								}
 								position++
-								goto l986
-							l987:
-								position, tokenIndex, depth = position986, tokenIndex986, depth986
+								goto l1275
+							l1276:
+								position, tokenIndex, depth = position1275, tokenIndex1275, depth1275
 								if buffer[position] != rune('I') {
-									goto l984
+									goto l918
 								}
 								position++
 							}
-						l986:
+						l1275:
 							{
-								position988, tokenIndex988, depth988 := position, tokenIndex, depth
+								position1277, tokenIndex1277, depth1277 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l989
+									goto l1278
 								}
 								position++
-								goto l988
-							l989:
-								position, tokenIndex, depth = position988, tokenIndex988, depth988
+								goto l1277
+							l1278:
+								position, tokenIndex, depth = position1277, tokenIndex1277, depth1277
 								if buffer[position] != rune('S') {
-									goto l984
+									goto l918
 								}
 								position++
 							}
-						l988:
+						l1277:
 							{
-								position990, tokenIndex990, depth990 := position, tokenIndex, depth
-								if buffer[position] != rune('b') {
-									goto l991
+								position1279, tokenIndex1279, depth1279 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1280
 								}
 								position++
-								goto l990
-							l991:
-								position, tokenIndex, depth = position990, tokenIndex990, depth990
-								if buffer[position] != rune('B') {
-									goto l984
+								goto l1279
+							l1280:
+								position, tokenIndex, depth = position1279, tokenIndex1279, depth1279
+								if buffer[position] != rune('N') {
+									goto l918
 								}
 								position++
 							}
-						l990:
+						l1279:
 							{
-								position992, tokenIndex992, depth992 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l993
+								position1281, tokenIndex1281, depth1281 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1282
 								}
 								position++
-								goto l992
-							l993:
-								position, tokenIndex, depth = position992, tokenIndex992, depth992
-								if buffer[position] != rune('L') {
-									goto l984
+								goto l1281
+							l1282:
+								position, tokenIndex, depth = position1281, tokenIndex1281, depth1281
+								if buffer[position] != rune('U') {
+									goto l918
 								}
 								position++
 							}
-						l992:
+						l1281:
 							{
-								position994, tokenIndex994, depth994 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l995
+								position1283, tokenIndex1283, depth1283 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1284
 								}
 								position++
-								goto l994
-							l995:
-								position, tokenIndex, depth = position994, tokenIndex994, depth994
-								if buffer[position] != rune('A') {
-									goto l984
+								goto l1283
+							l1284:
+								position, tokenIndex, depth = position1283, tokenIndex1283, depth1283
+								if buffer[position] != rune('M') {
+									goto l918
 								}
 								position++
 							}
-						l994:
+						l1283:
 							{
-								position996, tokenIndex996, depth996 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l997
+								position1285, tokenIndex1285, depth1285 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1286
 								}
 								position++
-								goto l996
-							l997:
-								position, tokenIndex, depth = position996, tokenIndex996, depth996
-								if buffer[position] != rune('N') {
-									goto l984
+								goto l1285
+							l1286:
+								position, tokenIndex, depth = position1285, tokenIndex1285, depth1285
+								if buffer[position] != rune('E') {
+									goto l918
 								}
 								position++
 							}
-						l996:
+						l1285:
 							{
-								position998, tokenIndex998, depth998 := position, tokenIndex, depth
-								if buffer[position] != rune('k') {
-									goto l999
+								position1287, tokenIndex1287, depth1287 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1288
 								}
 								position++
-								goto l998
-							l999:
-								position, tokenIndex, depth = position998, tokenIndex998, depth998
-								if buffer[position] != rune('K') {
-									goto l984
+								goto l1287
+							l1288:
+								position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
+								if buffer[position] != rune('R') {
+									goto l918
 								}
 								position++
 							}
-						l998:
-							if !rules[ruleskip]() {
-								goto l984
-							}
-							depth--
-							add(ruleISBLANK, position985)
-						}
-						goto l807
-					l984:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
-						{
-							position1001 := position
-							depth++
+						l1287:
 							{
-								position1002, tokenIndex1002, depth1002 := position, tokenIndex, depth
+								position1289, tokenIndex1289, depth1289 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l1003
+									goto l1290
 								}
 								position++
-								goto l1002
-							l1003:
-								position, tokenIndex, depth = position1002, tokenIndex1002, depth1002
+								goto l1289
+							l1290:
+								position, tokenIndex, depth = position1289, tokenIndex1289, depth1289
 								if buffer[position] != rune('I') {
-									goto l1000
+									goto l918
 								}
 								position++
 							}
-						l1002:
+						l1289:
 							{
-								position1004, tokenIndex1004, depth1004 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1005
+								position1291, tokenIndex1291, depth1291 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1292
 								}
 								position++
-								goto l1004
-							l1005:
-								position, tokenIndex, depth = position1004, tokenIndex1004, depth1004
-								if buffer[position] != rune('S') {
-									goto l1000
+								goto l1291
+							l1292:
+								position, tokenIndex, depth = position1291, tokenIndex1291, depth1291
+								if buffer[position] != rune('C') {
+									goto l918
 								}
 								position++
 							}
-						l1004:
+						l1291:
+							if !_rules[ruleskip]() {
+								goto l918
+							}
+							depth--
+							add(ruleISNUMERIC, position1274)
+						}
+					}
+				l919:
+					if !_rules[ruleLPAREN]() {
+						goto l918
+					}
+					if !_rules[ruleexpression]() {
+						goto l918
+					}
+					if !_rules[ruleRPAREN]() {
+						goto l918
+					}
+					goto l917
+				l918:
+					position, tokenIndex, depth = position917, tokenIndex917, depth917
+					{
+						position1294, tokenIndex1294, depth1294 := position, tokenIndex, depth
+						{
+							position1296 := position
+							depth++
 							{
-								position1006, tokenIndex1006, depth1006 := position, tokenIndex, depth
+								position1297, tokenIndex1297, depth1297 := position, tokenIndex, depth
 								if buffer[position] != rune('l') {
-									goto l1007
+									goto l1298
 								}
 								position++
-								goto l1006
-							l1007:
-								position, tokenIndex, depth = position1006, tokenIndex1006, depth1006
+								goto l1297
+							l1298:
+								position, tokenIndex, depth = position1297, tokenIndex1297, depth1297
 								if buffer[position] != rune('L') {
-									goto l1000
+									goto l1295
 								}
 								position++
 							}
-						l1006:
+						l1297:
 							{
-								position1008, tokenIndex1008, depth1008 := position, tokenIndex, depth
-								if buffer[position] != rune('i') {
-									goto l1009
+								position1299, tokenIndex1299, depth1299 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1300
 								}
 								position++
-								goto l1008
-							l1009:
-								position, tokenIndex, depth = position1008, tokenIndex1008, depth1008
-								if buffer[position] != rune('I') {
-									goto l1000
+								goto l1299
+							l1300:
+								position, tokenIndex, depth = position1299, tokenIndex1299, depth1299
+								if buffer[position] != rune('A') {
+									goto l1295
 								}
 								position++
 							}
-						l1008:
+						l1299:
 							{
-								position1010, tokenIndex1010, depth1010 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1011
+								position1301, tokenIndex1301, depth1301 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1302
 								}
 								position++
-								goto l1010
-							l1011:
-								position, tokenIndex, depth = position1010, tokenIndex1010, depth1010
-								if buffer[position] != rune('T') {
-									goto l1000
+								goto l1301
+							l1302:
+								position, tokenIndex, depth = position1301, tokenIndex1301, depth1301
+								if buffer[position] != rune('N') {
+									goto l1295
 								}
 								position++
-							}
-						l1010:
-							{
-								position1012, tokenIndex1012, depth1012 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l1013
+							}
+						l1301:
+							{
+								position1303, tokenIndex1303, depth1303 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l1304
 								}
 								position++
-								goto l1012
-							l1013:
-								position, tokenIndex, depth = position1012, tokenIndex1012, depth1012
-								if buffer[position] != rune('E') {
-									goto l1000
+								goto l1303
+							l1304:
+								position, tokenIndex, depth = position1303, tokenIndex1303, depth1303
+								if buffer[position] != rune('G') {
+									goto l1295
 								}
 								position++
 							}
-						l1012:
+						l1303:
 							{
-								position1014, tokenIndex1014, depth1014 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1015
+								position1305, tokenIndex1305, depth1305 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1306
 								}
 								position++
-								goto l1014
-							l1015:
-								position, tokenIndex, depth = position1014, tokenIndex1014, depth1014
-								if buffer[position] != rune('R') {
-									goto l1000
+								goto l1305
+							l1306:
+								position, tokenIndex, depth = position1305, tokenIndex1305, depth1305
+								if buffer[position] != rune('M') {
+									goto l1295
 								}
 								position++
 							}
-						l1014:
+						l1305:
 							{
-								position1016, tokenIndex1016, depth1016 := position, tokenIndex, depth
+								position1307, tokenIndex1307, depth1307 := position, tokenIndex, depth
 								if buffer[position] != rune('a') {
-									goto l1017
+									goto l1308
 								}
 								position++
-								goto l1016
-							l1017:
-								position, tokenIndex, depth = position1016, tokenIndex1016, depth1016
+								goto l1307
+							l1308:
+								position, tokenIndex, depth = position1307, tokenIndex1307, depth1307
 								if buffer[position] != rune('A') {
-									goto l1000
+									goto l1295
 								}
 								position++
 							}
-						l1016:
+						l1307:
 							{
-								position1018, tokenIndex1018, depth1018 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l1019
+								position1309, tokenIndex1309, depth1309 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1310
 								}
 								position++
-								goto l1018
-							l1019:
-								position, tokenIndex, depth = position1018, tokenIndex1018, depth1018
-								if buffer[position] != rune('L') {
-									goto l1000
+								goto l1309
+							l1310:
+								position, tokenIndex, depth = position1309, tokenIndex1309, depth1309
+								if buffer[position] != rune('T') {
+									goto l1295
 								}
 								position++
 							}
-						l1018:
-							if !rules[ruleskip]() {
-								goto l1000
-							}
-							depth--
-							add(ruleISLITERAL, position1001)
-						}
-						goto l807
-					l1000:
-						position, tokenIndex, depth = position807, tokenIndex807, depth807
-						{
-							switch buffer[position] {
-							case 'I', 'i':
-								{
-									position1021 := position
-									depth++
-									{
-										position1022, tokenIndex1022, depth1022 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1023
-										}
-										position++
-										goto l1022
-									l1023:
-										position, tokenIndex, depth = position1022, tokenIndex1022, depth1022
-										if buffer[position] != rune('I') {
-											goto l806
-										}
-										position++
-									}
-								l1022:
-									{
-										position1024, tokenIndex1024, depth1024 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1025
-										}
-										position++
-										goto l1024
-									l1025:
-										position, tokenIndex, depth = position1024, tokenIndex1024, depth1024
-										if buffer[position] != rune('S') {
-											goto l806
-										}
-										position++
-									}
-								l1024:
-									{
-										position1026, tokenIndex1026, depth1026 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1027
-										}
-										position++
-										goto l1026
-									l1027:
-										position, tokenIndex, depth = position1026, tokenIndex1026, depth1026
-										if buffer[position] != rune('N') {
-											goto l806
-										}
-										position++
-									}
-								l1026:
-									{
-										position1028, tokenIndex1028, depth1028 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1029
-										}
-										position++
-										goto l1028
-									l1029:
-										position, tokenIndex, depth = position1028, tokenIndex1028, depth1028
-										if buffer[position] != rune('U') {
-											goto l806
-										}
-										position++
-									}
-								l1028:
-									{
-										position1030, tokenIndex1030, depth1030 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1031
-										}
-										position++
-										goto l1030
-									l1031:
-										position, tokenIndex, depth = position1030, tokenIndex1030, depth1030
-										if buffer[position] != rune('M') {
-											goto l806
-										}
-										position++
-									}
-								l1030:
-									{
-										position1032, tokenIndex1032, depth1032 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1033
-										}
-										position++
-										goto l1032
-									l1033:
-										position, tokenIndex, depth = position1032, tokenIndex1032, depth1032
-										if buffer[position] != rune('E') {
-											goto l806
-										}
-										position++
-									}
-								l1032:
-									{
-										position1034, tokenIndex1034, depth1034 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1035
-										}
-										position++
-										goto l1034
-									l1035:
-										position, tokenIndex, depth = position1034, tokenIndex1034, depth1034
-										if buffer[position] != rune('R') {
-											goto l806
-										}
-										position++
-									}
-								l1034:
-									{
-										position1036, tokenIndex1036, depth1036 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1037
-										}
-										position++
-										goto l1036
-									l1037:
-										position, tokenIndex, depth = position1036, tokenIndex1036, depth1036
-										if buffer[position] != rune('I') {
-											goto l806
-										}
-										position++
-									}
-								l1036:
-									{
-										position1038, tokenIndex1038, depth1038 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1039
-										}
-										position++
-										goto l1038
-									l1039:
-										position, tokenIndex, depth = position1038, tokenIndex1038, depth1038
-										if buffer[position] != rune('C') {
-											goto l806
-										}
-										position++
-									}
-								l1038:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleISNUMERIC, position1021)
-								}
-								break
-							case 'S', 's':
-								{
-									position1040 := position
-									depth++
-									{
-										position1041, tokenIndex1041, depth1041 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1042
-										}
-										position++
-										goto l1041
-									l1042:
-										position, tokenIndex, depth = position1041, tokenIndex1041, depth1041
-										if buffer[position] != rune('S') {
-											goto l806
-										}
-										position++
-									}
-								l1041:
-									{
-										position1043, tokenIndex1043, depth1043 := position, tokenIndex, depth
-										if buffer[position] != rune('h') {
-											goto l1044
-										}
-										position++
-										goto l1043
-									l1044:
-										position, tokenIndex, depth = position1043, tokenIndex1043, depth1043
-										if buffer[position] != rune('H') {
-											goto l806
-										}
-										position++
-									}
-								l1043:
-									{
-										position1045, tokenIndex1045, depth1045 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1046
-										}
-										position++
-										goto l1045
-									l1046:
-										position, tokenIndex, depth = position1045, tokenIndex1045, depth1045
-										if buffer[position] != rune('A') {
-											goto l806
-										}
-										position++
-									}
-								l1045:
-									if buffer[position] != rune('5') {
-										goto l806
-									}
-									position++
-									if buffer[position] != rune('1') {
-										goto l806
-									}
-									position++
-									if buffer[position] != rune('2') {
-										goto l806
-									}
-									position++
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleSHA512, position1040)
-								}
-								break
-							case 'M', 'm':
-								{
-									position1047 := position
-									depth++
-									{
-										position1048, tokenIndex1048, depth1048 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1049
-										}
-										position++
-										goto l1048
-									l1049:
-										position, tokenIndex, depth = position1048, tokenIndex1048, depth1048
-										if buffer[position] != rune('M') {
-											goto l806
-										}
-										position++
-									}
-								l1048:
-									{
-										position1050, tokenIndex1050, depth1050 := position, tokenIndex, depth
-										if buffer[position] != rune('d') {
-											goto l1051
-										}
-										position++
-										goto l1050
-									l1051:
-										position, tokenIndex, depth = position1050, tokenIndex1050, depth1050
-										if buffer[position] != rune('D') {
-											goto l806
-										}
-										position++
-									}
-								l1050:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleMD5, position1047)
+						l1309:
+							{
+								position1311, tokenIndex1311, depth1311 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1312
 								}
-								break
-							case 'T', 't':
-								{
-									position1052 := position
-									depth++
-									{
-										position1053, tokenIndex1053, depth1053 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1054
-										}
-										position++
-										goto l1053
-									l1054:
-										position, tokenIndex, depth = position1053, tokenIndex1053, depth1053
-										if buffer[position] != rune('T') {
-											goto l806
-										}
-										position++
-									}
-								l1053:
-									{
-										position1055, tokenIndex1055, depth1055 := position, tokenIndex, depth
-										if buffer[position] != rune('z') {
-											goto l1056
-										}
-										position++
-										goto l1055
-									l1056:
-										position, tokenIndex, depth = position1055, tokenIndex1055, depth1055
-										if buffer[position] != rune('Z') {
-											goto l806
-										}
-										position++
-									}
-								l1055:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleTZ, position1052)
+								position++
+								goto l1311
+							l1312:
+								position, tokenIndex, depth = position1311, tokenIndex1311, depth1311
+								if buffer[position] != rune('C') {
+									goto l1295
 								}
-								break
-							case 'H', 'h':
-								{
-									position1057 := position
-									depth++
-									{
-										position1058, tokenIndex1058, depth1058 := position, tokenIndex, depth
-										if buffer[position] != rune('h') {
-											goto l1059
-										}
-										position++
-										goto l1058
-									l1059:
-										position, tokenIndex, depth = position1058, tokenIndex1058, depth1058
-										if buffer[position] != rune('H') {
-											goto l806
-										}
-										position++
-									}
-								l1058:
-									{
-										position1060, tokenIndex1060, depth1060 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1061
-										}
-										position++
-										goto l1060
-									l1061:
-										position, tokenIndex, depth = position1060, tokenIndex1060, depth1060
-										if buffer[position] != rune('O') {
-											goto l806
-										}
-										position++
-									}
-								l1060:
-									{
-										position1062, tokenIndex1062, depth1062 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1063
-										}
-										position++
-										goto l1062
-									l1063:
-										position, tokenIndex, depth = position1062, tokenIndex1062, depth1062
-										if buffer[position] != rune('U') {
-											goto l806
-										}
-										position++
-									}
-								l1062:
-									{
-										position1064, tokenIndex1064, depth1064 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1065
-										}
-										position++
-										goto l1064
-									l1065:
-										position, tokenIndex, depth = position1064, tokenIndex1064, depth1064
-										if buffer[position] != rune('R') {
-											goto l806
-										}
-										position++
-									}
-								l1064:
-									{
-										position1066, tokenIndex1066, depth1066 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1067
-										}
-										position++
-										goto l1066
-									l1067:
-										position, tokenIndex, depth = position1066, tokenIndex1066, depth1066
-										if buffer[position] != rune('S') {
-											goto l806
-										}
-										position++
-									}
-								l1066:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleHOURS, position1057)
+								position++
+							}
+						l1311:
+							{
+								position1313, tokenIndex1313, depth1313 := position, tokenIndex, depth
+								if buffer[position] != rune('h') {
+									goto l1314
 								}
-								break
-							case 'D', 'd':
-								{
-									position1068 := position
-									depth++
-									{
-										position1069, tokenIndex1069, depth1069 := position, tokenIndex, depth
-										if buffer[position] != rune('d') {
-											goto l1070
-										}
-										position++
-										goto l1069
-									l1070:
-										position, tokenIndex, depth = position1069, tokenIndex1069, depth1069
-										if buffer[position] != rune('D') {
-											goto l806
-										}
-										position++
-									}
-								l1069:
-									{
-										position1071, tokenIndex1071, depth1071 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1072
-										}
-										position++
-										goto l1071
-									l1072:
-										position, tokenIndex, depth = position1071, tokenIndex1071, depth1071
-										if buffer[position] != rune('A') {
-											goto l806
-										}
-										position++
-									}
-								l1071:
-									{
-										position1073, tokenIndex1073, depth1073 := position, tokenIndex, depth
-										if buffer[position] != rune('y') {
-											goto l1074
-										}
-										position++
-										goto l1073
-									l1074:
-										position, tokenIndex, depth = position1073, tokenIndex1073, depth1073
-										if buffer[position] != rune('Y') {
-											goto l806
-										}
-										position++
-									}
-								l1073:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleDAY, position1068)
+								position++
+								goto l1313
+							l1314:
+								position, tokenIndex, depth = position1313, tokenIndex1313, depth1313
+								if buffer[position] != rune('H') {
+									goto l1295
 								}
-								break
-							case 'Y', 'y':
-								{
-									position1075 := position
-									depth++
-									{
-										position1076, tokenIndex1076, depth1076 := position, tokenIndex, depth
-										if buffer[position] != rune('y') {
-											goto l1077
-										}
-										position++
-										goto l1076
-									l1077:
-										position, tokenIndex, depth = position1076, tokenIndex1076, depth1076
-										if buffer[position] != rune('Y') {
-											goto l806
-										}
-										position++
-									}
-								l1076:
-									{
-										position1078, tokenIndex1078, depth1078 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1079
-										}
-										position++
-										goto l1078
-									l1079:
-										position, tokenIndex, depth = position1078, tokenIndex1078, depth1078
-										if buffer[position] != rune('E') {
-											goto l806
-										}
-										position++
-									}
-								l1078:
-									{
-										position1080, tokenIndex1080, depth1080 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1081
-										}
-										position++
-										goto l1080
-									l1081:
-										position, tokenIndex, depth = position1080, tokenIndex1080, depth1080
-										if buffer[position] != rune('A') {
-											goto l806
-										}
-										position++
-									}
-								l1080:
-									{
-										position1082, tokenIndex1082, depth1082 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1083
-										}
-										position++
-										goto l1082
-									l1083:
-										position, tokenIndex, depth = position1082, tokenIndex1082, depth1082
-										if buffer[position] != rune('R') {
-											goto l806
-										}
-										position++
-									}
-								l1082:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleYEAR, position1075)
+								position++
+							}
+						l1313:
+							{
+								position1315, tokenIndex1315, depth1315 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1316
 								}
-								break
-							case 'E', 'e':
-								{
-									position1084 := position
-									depth++
-									{
-										position1085, tokenIndex1085, depth1085 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1086
-										}
-										position++
-										goto l1085
-									l1086:
-										position, tokenIndex, depth = position1085, tokenIndex1085, depth1085
-										if buffer[position] != rune('E') {
-											goto l806
-										}
-										position++
-									}
-								l1085:
-									{
-										position1087, tokenIndex1087, depth1087 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1088
-										}
-										position++
-										goto l1087
-									l1088:
-										position, tokenIndex, depth = position1087, tokenIndex1087, depth1087
-										if buffer[position] != rune('N') {
-											goto l806
-										}
-										position++
-									}
-								l1087:
-									{
-										position1089, tokenIndex1089, depth1089 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1090
-										}
-										position++
-										goto l1089
-									l1090:
-										position, tokenIndex, depth = position1089, tokenIndex1089, depth1089
-										if buffer[position] != rune('C') {
-											goto l806
-										}
-										position++
-									}
-								l1089:
-									{
-										position1091, tokenIndex1091, depth1091 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1092
-										}
-										position++
-										goto l1091
-									l1092:
-										position, tokenIndex, depth = position1091, tokenIndex1091, depth1091
-										if buffer[position] != rune('O') {
-											goto l806
-										}
-										position++
-									}
-								l1091:
-									{
-										position1093, tokenIndex1093, depth1093 := position, tokenIndex, depth
-										if buffer[position] != rune('d') {
-											goto l1094
-										}
-										position++
-										goto l1093
-									l1094:
-										position, tokenIndex, depth = position1093, tokenIndex1093, depth1093
-										if buffer[position] != rune('D') {
-											goto l806
-										}
-										position++
-									}
-								l1093:
-									{
-										position1095, tokenIndex1095, depth1095 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1096
-										}
-										position++
-										goto l1095
-									l1096:
-										position, tokenIndex, depth = position1095, tokenIndex1095, depth1095
-										if buffer[position] != rune('E') {
-											goto l806
-										}
-										position++
-									}
-								l1095:
-									if buffer[position] != rune('_') {
-										goto l806
-									}
-									position++
-									{
-										position1097, tokenIndex1097, depth1097 := position, tokenIndex, depth
-										if buffer[position] != rune('f') {
-											goto l1098
-										}
-										position++
-										goto l1097
-									l1098:
-										position, tokenIndex, depth = position1097, tokenIndex1097, depth1097
-										if buffer[position] != rune('F') {
-											goto l806
-										}
-										position++
-									}
-								l1097:
-									{
-										position1099, tokenIndex1099, depth1099 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1100
-										}
-										position++
-										goto l1099
-									l1100:
-										position, tokenIndex, depth = position1099, tokenIndex1099, depth1099
-										if buffer[position] != rune('O') {
-											goto l806
-										}
-										position++
-									}
-								l1099:
-									{
-										position1101, tokenIndex1101, depth1101 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1102
-										}
-										position++
-										goto l1101
-									l1102:
-										position, tokenIndex, depth = position1101, tokenIndex1101, depth1101
-										if buffer[position] != rune('R') {
-											goto l806
-										}
-										position++
-									}
-								l1101:
-									if buffer[position] != rune('_') {
-										goto l806
-									}
-									position++
-									{
-										position1103, tokenIndex1103, depth1103 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1104
-										}
-										position++
-										goto l1103
-									l1104:
-										position, tokenIndex, depth = position1103, tokenIndex1103, depth1103
-										if buffer[position] != rune('U') {
-											goto l806
-										}
-										position++
-									}
-								l1103:
-									{
-										position1105, tokenIndex1105, depth1105 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1106
-										}
-										position++
-										goto l1105
-									l1106:
-										position, tokenIndex, depth = position1105, tokenIndex1105, depth1105
-										if buffer[position] != rune('R') {
-											goto l806
-										}
-										position++
-									}
-								l1105:
-									{
-										position1107, tokenIndex1107, depth1107 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1108
-										}
-										position++
-										goto l1107
-									l1108:
-										position, tokenIndex, depth = position1107, tokenIndex1107, depth1107
-										if buffer[position] != rune('I') {
-											goto l806
-										}
-										position++
-									}
-								l1107:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleENCODEFORURI, position1084)
+								position++
+								goto l1315
+							l1316:
+								position, tokenIndex, depth = position1315, tokenIndex1315, depth1315
+								if buffer[position] != rune('E') {
+									goto l1295
 								}
-								break
-							case 'L', 'l':
-								{
-									position1109 := position
-									depth++
-									{
-										position1110, tokenIndex1110, depth1110 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1111
-										}
-										position++
-										goto l1110
-									l1111:
-										position, tokenIndex, depth = position1110, tokenIndex1110, depth1110
-										if buffer[position] != rune('L') {
-											goto l806
-										}
-										position++
-									}
-								l1110:
-									{
-										position1112, tokenIndex1112, depth1112 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1113
-										}
-										position++
-										goto l1112
-									l1113:
-										position, tokenIndex, depth = position1112, tokenIndex1112, depth1112
-										if buffer[position] != rune('C') {
-											goto l806
-										}
-										position++
-									}
-								l1112:
-									{
-										position1114, tokenIndex1114, depth1114 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1115
-										}
-										position++
-										goto l1114
-									l1115:
-										position, tokenIndex, depth = position1114, tokenIndex1114, depth1114
-										if buffer[position] != rune('A') {
-											goto l806
-										}
-										position++
-									}
-								l1114:
-									{
-										position1116, tokenIndex1116, depth1116 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1117
-										}
-										position++
-										goto l1116
-									l1117:
-										position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-										if buffer[position] != rune('S') {
-											goto l806
-										}
-										position++
-									}
-								l1116:
-									{
-										position1118, tokenIndex1118, depth1118 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1119
-										}
-										position++
-										goto l1118
-									l1119:
-										position, tokenIndex, depth = position1118, tokenIndex1118, depth1118
-										if buffer[position] != rune('E') {
-											goto l806
-										}
-										position++
-									}
-								l1118:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleLCASE, position1109)
+								position++
+							}
+						l1315:
+							{
+								position1317, tokenIndex1317, depth1317 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1318
+								}
+								position++
+								goto l1317
+							l1318:
+								position, tokenIndex, depth = position1317, tokenIndex1317, depth1317
+								if buffer[position] != rune('S') {
+									goto l1295
+								}
+								position++
+							}
+						l1317:
+							if !_rules[ruleskip]() {
+								goto l1295
+							}
+							depth--
+							add(ruleLANGMATCHES, position1296)
+						}
+						goto l1294
+					l1295:
+						position, tokenIndex, depth = position1294, tokenIndex1294, depth1294
+						{
+							position1320 := position
+							depth++
+							{
+								position1321, tokenIndex1321, depth1321 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1322
 								}
-								break
-							case 'U', 'u':
-								{
-									position1120 := position
-									depth++
-									{
-										position1121, tokenIndex1121, depth1121 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1122
-										}
-										position++
-										goto l1121
-									l1122:
-										position, tokenIndex, depth = position1121, tokenIndex1121, depth1121
-										if buffer[position] != rune('U') {
-											goto l806
-										}
-										position++
-									}
-								l1121:
-									{
-										position1123, tokenIndex1123, depth1123 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1124
-										}
-										position++
-										goto l1123
-									l1124:
-										position, tokenIndex, depth = position1123, tokenIndex1123, depth1123
-										if buffer[position] != rune('C') {
-											goto l806
-										}
-										position++
-									}
-								l1123:
-									{
-										position1125, tokenIndex1125, depth1125 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1126
-										}
-										position++
-										goto l1125
-									l1126:
-										position, tokenIndex, depth = position1125, tokenIndex1125, depth1125
-										if buffer[position] != rune('A') {
-											goto l806
-										}
-										position++
-									}
-								l1125:
-									{
-										position1127, tokenIndex1127, depth1127 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1128
-										}
-										position++
-										goto l1127
-									l1128:
-										position, tokenIndex, depth = position1127, tokenIndex1127, depth1127
-										if buffer[position] != rune('S') {
-											goto l806
-										}
-										position++
-									}
-								l1127:
-									{
-										position1129, tokenIndex1129, depth1129 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1130
-										}
-										position++
-										goto l1129
-									l1130:
-										position, tokenIndex, depth = position1129, tokenIndex1129, depth1129
-										if buffer[position] != rune('E') {
-											goto l806
-										}
-										position++
-									}
-								l1129:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleUCASE, position1120)
+								position++
+								goto l1321
+							l1322:
+								position, tokenIndex, depth = position1321, tokenIndex1321, depth1321
+								if buffer[position] != rune('C') {
+									goto l1319
 								}
-								break
-							case 'F', 'f':
-								{
-									position1131 := position
-									depth++
-									{
-										position1132, tokenIndex1132, depth1132 := position, tokenIndex, depth
-										if buffer[position] != rune('f') {
-											goto l1133
-										}
-										position++
-										goto l1132
-									l1133:
-										position, tokenIndex, depth = position1132, tokenIndex1132, depth1132
-										if buffer[position] != rune('F') {
-											goto l806
-										}
-										position++
-									}
-								l1132:
-									{
-										position1134, tokenIndex1134, depth1134 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1135
-										}
-										position++
-										goto l1134
-									l1135:
-										position, tokenIndex, depth = position1134, tokenIndex1134, depth1134
-										if buffer[position] != rune('L') {
-											goto l806
-										}
-										position++
-									}
-								l1134:
-									{
-										position1136, tokenIndex1136, depth1136 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1137
-										}
-										position++
-										goto l1136
-									l1137:
-										position, tokenIndex, depth = position1136, tokenIndex1136, depth1136
-										if buffer[position] != rune('O') {
-											goto l806
-										}
-										position++
-									}
-								l1136:
-									{
-										position1138, tokenIndex1138, depth1138 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1139
-										}
-										position++
-										goto l1138
-									l1139:
-										position, tokenIndex, depth = position1138, tokenIndex1138, depth1138
-										if buffer[position] != rune('O') {
-											goto l806
-										}
-										position++
-									}
-								l1138:
-									{
-										position1140, tokenIndex1140, depth1140 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1141
-										}
-										position++
-										goto l1140
-									l1141:
-										position, tokenIndex, depth = position1140, tokenIndex1140, depth1140
-										if buffer[position] != rune('R') {
-											goto l806
-										}
-										position++
-									}
-								l1140:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleFLOOR, position1131)
+								position++
+							}
+						l1321:
+							{
+								position1323, tokenIndex1323, depth1323 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1324
 								}
-								break
-							case 'R', 'r':
-								{
-									position1142 := position
-									depth++
-									{
-										position1143, tokenIndex1143, depth1143 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1144
-										}
-										position++
-										goto l1143
-									l1144:
-										position, tokenIndex, depth = position1143, tokenIndex1143, depth1143
-										if buffer[position] != rune('R') {
-											goto l806
-										}
-										position++
-									}
-								l1143:
-									{
-										position1145, tokenIndex1145, depth1145 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1146
-										}
-										position++
-										goto l1145
-									l1146:
-										position, tokenIndex, depth = position1145, tokenIndex1145, depth1145
-										if buffer[position] != rune('O') {
-											goto l806
-										}
-										position++
-									}
-								l1145:
-									{
-										position1147, tokenIndex1147, depth1147 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1148
-										}
-										position++
-										goto l1147
-									l1148:
-										position, tokenIndex, depth = position1147, tokenIndex1147, depth1147
-										if buffer[position] != rune('U') {
-											goto l806
-										}
-										position++
-									}
-								l1147:
-									{
-										position1149, tokenIndex1149, depth1149 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1150
-										}
-										position++
-										goto l1149
-									l1150:
-										position, tokenIndex, depth = position1149, tokenIndex1149, depth1149
-										if buffer[position] != rune('N') {
-											goto l806
-										}
-										position++
-									}
-								l1149:
-									{
-										position1151, tokenIndex1151, depth1151 := position, tokenIndex, depth
-										if buffer[position] != rune('d') {
-											goto l1152
-										}
-										position++
-										goto l1151
-									l1152:
-										position, tokenIndex, depth = position1151, tokenIndex1151, depth1151
-										if buffer[position] != rune('D') {
-											goto l806
-										}
-										position++
-									}
-								l1151:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleROUND, position1142)
+								position++
+								goto l1323
+							l1324:
+								position, tokenIndex, depth = position1323, tokenIndex1323, depth1323
+								if buffer[position] != rune('O') {
+									goto l1319
 								}
-								break
-							case 'C', 'c':
-								{
-									position1153 := position
-									depth++
-									{
-										position1154, tokenIndex1154, depth1154 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1155
-										}
-										position++
-										goto l1154
-									l1155:
-										position, tokenIndex, depth = position1154, tokenIndex1154, depth1154
-										if buffer[position] != rune('C') {
-											goto l806
-										}
-										position++
-									}
-								l1154:
-									{
-										position1156, tokenIndex1156, depth1156 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1157
-										}
-										position++
-										goto l1156
-									l1157:
-										position, tokenIndex, depth = position1156, tokenIndex1156, depth1156
-										if buffer[position] != rune('E') {
-											goto l806
-										}
-										position++
-									}
-								l1156:
-									{
-										position1158, tokenIndex1158, depth1158 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1159
-										}
-										position++
-										goto l1158
-									l1159:
-										position, tokenIndex, depth = position1158, tokenIndex1158, depth1158
-										if buffer[position] != rune('I') {
-											goto l806
-										}
-										position++
-									}
-								l1158:
-									{
-										position1160, tokenIndex1160, depth1160 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1161
-										}
-										position++
-										goto l1160
-									l1161:
-										position, tokenIndex, depth = position1160, tokenIndex1160, depth1160
-										if buffer[position] != rune('L') {
-											goto l806
-										}
-										position++
-									}
-								l1160:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleCEIL, position1153)
+								position++
+							}
+						l1323:
+							{
+								position1325, tokenIndex1325, depth1325 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1326
 								}
-								break
-							default:
-								{
-									position1162 := position
-									depth++
-									{
-										position1163, tokenIndex1163, depth1163 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1164
-										}
-										position++
-										goto l1163
-									l1164:
-										position, tokenIndex, depth = position1163, tokenIndex1163, depth1163
-										if buffer[position] != rune('A') {
-											goto l806
-										}
-										position++
-									}
-								l1163:
-									{
-										position1165, tokenIndex1165, depth1165 := position, tokenIndex, depth
-										if buffer[position] != rune('b') {
-											goto l1166
-										}
-										position++
-										goto l1165
-									l1166:
-										position, tokenIndex, depth = position1165, tokenIndex1165, depth1165
-										if buffer[position] != rune('B') {
-											goto l806
-										}
-										position++
-									}
-								l1165:
-									{
-										position1167, tokenIndex1167, depth1167 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1168
-										}
-										position++
-										goto l1167
-									l1168:
-										position, tokenIndex, depth = position1167, tokenIndex1167, depth1167
-										if buffer[position] != rune('S') {
-											goto l806
-										}
-										position++
-									}
-								l1167:
-									if !rules[ruleskip]() {
-										goto l806
-									}
-									depth--
-									add(ruleABS, position1162)
+								position++
+								goto l1325
+							l1326:
+								position, tokenIndex, depth = position1325, tokenIndex1325, depth1325
+								if buffer[position] != rune('N') {
+									goto l1319
+								}
+								position++
+							}
+						l1325:
+							{
+								position1327, tokenIndex1327, depth1327 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1328
+								}
+								position++
+								goto l1327
+							l1328:
+								position, tokenIndex, depth = position1327, tokenIndex1327, depth1327
+								if buffer[position] != rune('T') {
+									goto l1319
+								}
+								position++
+							}
+						l1327:
+							{
+								position1329, tokenIndex1329, depth1329 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1330
+								}
+								position++
+								goto l1329
+							l1330:
+								position, tokenIndex, depth = position1329, tokenIndex1329, depth1329
+								if buffer[position] != rune('A') {
+									goto l1319
+								}
+								position++
+							}
+						l1329:
+							{
+								position1331, tokenIndex1331, depth1331 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1332
 								}
-								break
+								position++
+								goto l1331
+							l1332:
+								position, tokenIndex, depth = position1331, tokenIndex1331, depth1331
+								if buffer[position] != rune('I') {
+									goto l1319
+								}
+								position++
+							}
+						l1331:
+							{
+								position1333, tokenIndex1333, depth1333 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1334
+								}
+								position++
+								goto l1333
+							l1334:
+								position, tokenIndex, depth = position1333, tokenIndex1333, depth1333
+								if buffer[position] != rune('N') {
+									goto l1319
+								}
+								position++
+							}
+						l1333:
+							{
+								position1335, tokenIndex1335, depth1335 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1336
+								}
+								position++
+								goto l1335
+							l1336:
+								position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
+								if buffer[position] != rune('S') {
+									goto l1319
+								}
+								position++
+							}
+						l1335:
+							if !_rules[ruleskip]() {
+								goto l1319
 							}
+							depth--
+							add(ruleCONTAINS, position1320)
 						}
-
-					}
-				l807:
-					if !rules[ruleLPAREN]() {
-						goto l806
-					}
-					if !rules[ruleexpression]() {
-						goto l806
-					}
-					if !rules[ruleRPAREN]() {
-						goto l806
-					}
-					goto l805
-				l806:
-					position, tokenIndex, depth = position805, tokenIndex805, depth805
-					{
-						position1170, tokenIndex1170, depth1170 := position, tokenIndex, depth
+						goto l1294
+					l1319:
+						position, tokenIndex, depth = position1294, tokenIndex1294, depth1294
 						{
-							position1172 := position
+							position1338 := position
 							depth++
 							{
-								position1173, tokenIndex1173, depth1173 := position, tokenIndex, depth
+								position1339, tokenIndex1339, depth1339 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1174
+									goto l1340
 								}
 								position++
-								goto l1173
-							l1174:
-								position, tokenIndex, depth = position1173, tokenIndex1173, depth1173
+								goto l1339
+							l1340:
+								position, tokenIndex, depth = position1339, tokenIndex1339, depth1339
 								if buffer[position] != rune('S') {
-									goto l1171
+									goto l1337
 								}
 								position++
 							}
-						l1173:
+						l1339:
 							{
-								position1175, tokenIndex1175, depth1175 := position, tokenIndex, depth
+								position1341, tokenIndex1341, depth1341 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1176
+									goto l1342
 								}
 								position++
-								goto l1175
-							l1176:
-								position, tokenIndex, depth = position1175, tokenIndex1175, depth1175
+								goto l1341
+							l1342:
+								position, tokenIndex, depth = position1341, tokenIndex1341, depth1341
 								if buffer[position] != rune('T') {
-									goto l1171
+									goto l1337
 								}
 								position++
 							}
-						l1175:
+						l1341:
 							{
-								position1177, tokenIndex1177, depth1177 := position, tokenIndex, depth
+								position1343, tokenIndex1343, depth1343 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1178
+									goto l1344
 								}
 								position++
-								goto l1177
-							l1178:
-								position, tokenIndex, depth = position1177, tokenIndex1177, depth1177
+								goto l1343
+							l1344:
+								position, tokenIndex, depth = position1343, tokenIndex1343, depth1343
 								if buffer[position] != rune('R') {
-									goto l1171
+									goto l1337
 								}
 								position++
 							}
-						l1177:
+						l1343:
 							{
-								position1179, tokenIndex1179, depth1179 := position, tokenIndex, depth
+								position1345, tokenIndex1345, depth1345 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1180
+									goto l1346
 								}
 								position++
-								goto l1179
-							l1180:
-								position, tokenIndex, depth = position1179, tokenIndex1179, depth1179
+								goto l1345
+							l1346:
+								position, tokenIndex, depth = position1345, tokenIndex1345, depth1345
 								if buffer[position] != rune('S') {
-									goto l1171
+									goto l1337
 								}
 								position++
 							}
-						l1179:
+						l1345:
 							{
-								position1181, tokenIndex1181, depth1181 := position, tokenIndex, depth
+								position1347, tokenIndex1347, depth1347 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1182
+									goto l1348
 								}
 								position++
-								goto l1181
-							l1182:
-								position, tokenIndex, depth = position1181, tokenIndex1181, depth1181
+								goto l1347
+							l1348:
+								position, tokenIndex, depth = position1347, tokenIndex1347, depth1347
 								if buffer[position] != rune('T') {
-									goto l1171
+									goto l1337
 								}
 								position++
 							}
-						l1181:
+						l1347:
 							{
-								position1183, tokenIndex1183, depth1183 := position, tokenIndex, depth
+								position1349, tokenIndex1349, depth1349 := position, tokenIndex, depth
 								if buffer[position] != rune('a') {
-									goto l1184
+									goto l1350
 								}
 								position++
-								goto l1183
-							l1184:
-								position, tokenIndex, depth = position1183, tokenIndex1183, depth1183
+								goto l1349
+							l1350:
+								position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
 								if buffer[position] != rune('A') {
-									goto l1171
+									goto l1337
 								}
 								position++
 							}
-						l1183:
+						l1349:
 							{
-								position1185, tokenIndex1185, depth1185 := position, tokenIndex, depth
+								position1351, tokenIndex1351, depth1351 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1186
+									goto l1352
 								}
 								position++
-								goto l1185
-							l1186:
-								position, tokenIndex, depth = position1185, tokenIndex1185, depth1185
+								goto l1351
+							l1352:
+								position, tokenIndex, depth = position1351, tokenIndex1351, depth1351
 								if buffer[position] != rune('R') {
-									goto l1171
+									goto l1337
 								}
 								position++
 							}
-						l1185:
+						l1351:
 							{
-								position1187, tokenIndex1187, depth1187 := position, tokenIndex, depth
+								position1353, tokenIndex1353, depth1353 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1188
+									goto l1354
 								}
 								position++
-								goto l1187
-							l1188:
-								position, tokenIndex, depth = position1187, tokenIndex1187, depth1187
+								goto l1353
+							l1354:
+								position, tokenIndex, depth = position1353, tokenIndex1353, depth1353
 								if buffer[position] != rune('T') {
-									goto l1171
+									goto l1337
 								}
 								position++
 							}
-						l1187:
+						l1353:
 							{
-								position1189, tokenIndex1189, depth1189 := position, tokenIndex, depth
+								position1355, tokenIndex1355, depth1355 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1190
+									goto l1356
 								}
 								position++
-								goto l1189
-							l1190:
-								position, tokenIndex, depth = position1189, tokenIndex1189, depth1189
+								goto l1355
+							l1356:
+								position, tokenIndex, depth = position1355, tokenIndex1355, depth1355
 								if buffer[position] != rune('S') {
-									goto l1171
+									goto l1337
 								}
 								position++
 							}
-						l1189:
-							if !rules[ruleskip]() {
-								goto l1171
+						l1355:
+							if !_rules[ruleskip]() {
+								goto l1337
 							}
 							depth--
-							add(ruleSTRSTARTS, position1172)
+							add(ruleSTRSTARTS, position1338)
 						}
-						goto l1170
-					l1171:
-						position, tokenIndex, depth = position1170, tokenIndex1170, depth1170
+						goto l1294
+					l1337:
+						position, tokenIndex, depth = position1294, tokenIndex1294, depth1294
 						{
-							position1192 := position
+							position1358 := position
 							depth++
 							{
-								position1193, tokenIndex1193, depth1193 := position, tokenIndex, depth
+								position1359, tokenIndex1359, depth1359 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1194
+									goto l1360
 								}
 								position++
-								goto l1193
-							l1194:
-								position, tokenIndex, depth = position1193, tokenIndex1193, depth1193
+								goto l1359
+							l1360:
+								position, tokenIndex, depth = position1359, tokenIndex1359, depth1359
 								if buffer[position] != rune('S') {
-									goto l1191
+									goto l1357
 								}
 								position++
 							}
-						l1193:
+						l1359:
 							{
-								position1195, tokenIndex1195, depth1195 := position, tokenIndex, depth
+								position1361, tokenIndex1361, depth1361 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1196
+									goto l1362
 								}
 								position++
-								goto l1195
-							l1196:
-								position, tokenIndex, depth = position1195, tokenIndex1195, depth1195
+								goto l1361
+							l1362:
+								position, tokenIndex, depth = position1361, tokenIndex1361, depth1361
 								if buffer[position] != rune('T') {
-									goto l1191
+									goto l1357
 								}
 								position++
 							}
-						l1195:
+						l1361:
 							{
-								position1197, tokenIndex1197, depth1197 := position, tokenIndex, depth
+								position1363, tokenIndex1363, depth1363 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1198
+									goto l1364
 								}
 								position++
-								goto l1197
-							l1198:
-								position, tokenIndex, depth = position1197, tokenIndex1197, depth1197
+								goto l1363
+							l1364:
+								position, tokenIndex, depth = position1363, tokenIndex1363, depth1363
 								if buffer[position] != rune('R') {
-									goto l1191
+									goto l1357
 								}
 								position++
 							}
-						l1197:
+						l1363:
 							{
-								position1199, tokenIndex1199, depth1199 := position, tokenIndex, depth
+								position1365, tokenIndex1365, depth1365 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l1200
+									goto l1366
 								}
 								position++
-								goto l1199
-							l1200:
-								position, tokenIndex, depth = position1199, tokenIndex1199, depth1199
+								goto l1365
+							l1366:
+								position, tokenIndex, depth = position1365, tokenIndex1365, depth1365
 								if buffer[position] != rune('E') {
-									goto l1191
+									goto l1357
 								}
 								position++
 							}
-						l1199:
+						l1365:
 							{
-								position1201, tokenIndex1201, depth1201 := position, tokenIndex, depth
+								position1367, tokenIndex1367, depth1367 := position, tokenIndex, depth
 								if buffer[position] != rune('n') {
-									goto l1202
+									goto l1368
 								}
 								position++
-								goto l1201
-							l1202:
-								position, tokenIndex, depth = position1201, tokenIndex1201, depth1201
+								goto l1367
+							l1368:
+								position, tokenIndex, depth = position1367, tokenIndex1367, depth1367
 								if buffer[position] != rune('N') {
-									goto l1191
+									goto l1357
 								}
 								position++
 							}
-						l1201:
+						l1367:
 							{
-								position1203, tokenIndex1203, depth1203 := position, tokenIndex, depth
+								position1369, tokenIndex1369, depth1369 := position, tokenIndex, depth
 								if buffer[position] != rune('d') {
-									goto l1204
+									goto l1370
+								}
+								position++
+								goto l1369
+							l1370:
+								position, tokenIndex, depth = position1369, tokenIndex1369, depth1369
+								if buffer[position] != rune('D') {
+									goto l1357
+								}
+								position++
+							}
+						l1369:
+							{
+								position1371, tokenIndex1371, depth1371 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1372
+								}
+								position++
+								goto l1371
+							l1372:
+								position, tokenIndex, depth = position1371, tokenIndex1371, depth1371
+								if buffer[position] != rune('S') {
+									goto l1357
+								}
+								position++
+							}
+						l1371:
+							if !_rules[ruleskip]() {
+								goto l1357
+							}
+							depth--
+							add(ruleSTRENDS, position1358)
+						}
+						goto l1294
+					l1357:
+						position, tokenIndex, depth = position1294, tokenIndex1294, depth1294
+						{
+							position1374 := position
+							depth++
+							{
+								position1375, tokenIndex1375, depth1375 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1376
+								}
+								position++
+								goto l1375
+							l1376:
+								position, tokenIndex, depth = position1375, tokenIndex1375, depth1375
+								if buffer[position] != rune('S') {
+									goto l1373
+								}
+								position++
+							}
+						l1375:
+							{
+								position1377, tokenIndex1377, depth1377 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1378
+								}
+								position++
+								goto l1377
+							l1378:
+								position, tokenIndex, depth = position1377, tokenIndex1377, depth1377
+								if buffer[position] != rune('T') {
+									goto l1373
+								}
+								position++
+							}
+						l1377:
+							{
+								position1379, tokenIndex1379, depth1379 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1380
+								}
+								position++
+								goto l1379
+							l1380:
+								position, tokenIndex, depth = position1379, tokenIndex1379, depth1379
+								if buffer[position] != rune('R') {
+									goto l1373
+								}
+								position++
+							}
+						l1379:
+							{
+								position1381, tokenIndex1381, depth1381 := position, tokenIndex, depth
+								if buffer[position] != rune('b') {
+									goto l1382
+								}
+								position++
+								goto l1381
+							l1382:
+								position, tokenIndex, depth = position1381, tokenIndex1381, depth1381
+								if buffer[position] != rune('B') {
+									goto l1373
+								}
+								position++
+							}
+						l1381:
+							{
+								position1383, tokenIndex1383, depth1383 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1384
+								}
+								position++
+								goto l1383
+							l1384:
+								position, tokenIndex, depth = position1383, tokenIndex1383, depth1383
+								if buffer[position] != rune('E') {
+									goto l1373
+								}
+								position++
+							}
+						l1383:
+							{
+								position1385, tokenIndex1385, depth1385 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1386
+								}
+								position++
+								goto l1385
+							l1386:
+								position, tokenIndex, depth = position1385, tokenIndex1385, depth1385
+								if buffer[position] != rune('F') {
+									goto l1373
+								}
+								position++
+							}
+						l1385:
+							{
+								position1387, tokenIndex1387, depth1387 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1388
+								}
+								position++
+								goto l1387
+							l1388:
+								position, tokenIndex, depth = position1387, tokenIndex1387, depth1387
+								if buffer[position] != rune('O') {
+									goto l1373
+								}
+								position++
+							}
+						l1387:
+							{
+								position1389, tokenIndex1389, depth1389 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1390
+								}
+								position++
+								goto l1389
+							l1390:
+								position, tokenIndex, depth = position1389, tokenIndex1389, depth1389
+								if buffer[position] != rune('R') {
+									goto l1373
+								}
+								position++
+							}
+						l1389:
+							{
+								position1391, tokenIndex1391, depth1391 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1392
+								}
+								position++
+								goto l1391
+							l1392:
+								position, tokenIndex, depth = position1391, tokenIndex1391, depth1391
+								if buffer[position] != rune('E') {
+									goto l1373
+								}
+								position++
+							}
+						l1391:
+							if !_rules[ruleskip]() {
+								goto l1373
+							}
+							depth--
+							add(ruleSTRBEFORE, position1374)
+						}
+						goto l1294
+					l1373:
+						position, tokenIndex, depth = position1294, tokenIndex1294, depth1294
+						{
+							position1394 := position
+							depth++
+							{
+								position1395, tokenIndex1395, depth1395 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1396
+								}
+								position++
+								goto l1395
+							l1396:
+								position, tokenIndex, depth = position1395, tokenIndex1395, depth1395
+								if buffer[position] != rune('S') {
+									goto l1393
+								}
+								position++
+							}
+						l1395:
+							{
+								position1397, tokenIndex1397, depth1397 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1398
+								}
+								position++
+								goto l1397
+							l1398:
+								position, tokenIndex, depth = position1397, tokenIndex1397, depth1397
+								if buffer[position] != rune('T') {
+									goto l1393
+								}
+								position++
+							}
+						l1397:
+							{
+								position1399, tokenIndex1399, depth1399 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1400
+								}
+								position++
+								goto l1399
+							l1400:
+								position, tokenIndex, depth = position1399, tokenIndex1399, depth1399
+								if buffer[position] != rune('R') {
+									goto l1393
+								}
+								position++
+							}
+						l1399:
+							{
+								position1401, tokenIndex1401, depth1401 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1402
+								}
+								position++
+								goto l1401
+							l1402:
+								position, tokenIndex, depth = position1401, tokenIndex1401, depth1401
+								if buffer[position] != rune('A') {
+									goto l1393
+								}
+								position++
+							}
+						l1401:
+							{
+								position1403, tokenIndex1403, depth1403 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1404
+								}
+								position++
+								goto l1403
+							l1404:
+								position, tokenIndex, depth = position1403, tokenIndex1403, depth1403
+								if buffer[position] != rune('F') {
+									goto l1393
+								}
+								position++
+							}
+						l1403:
+							{
+								position1405, tokenIndex1405, depth1405 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1406
+								}
+								position++
+								goto l1405
+							l1406:
+								position, tokenIndex, depth = position1405, tokenIndex1405, depth1405
+								if buffer[position] != rune('T') {
+									goto l1393
+								}
+								position++
+							}
+						l1405:
+							{
+								position1407, tokenIndex1407, depth1407 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1408
+								}
+								position++
+								goto l1407
+							l1408:
+								position, tokenIndex, depth = position1407, tokenIndex1407, depth1407
+								if buffer[position] != rune('E') {
+									goto l1393
+								}
+								position++
+							}
+						l1407:
+							{
+								position1409, tokenIndex1409, depth1409 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1410
+								}
+								position++
+								goto l1409
+							l1410:
+								position, tokenIndex, depth = position1409, tokenIndex1409, depth1409
+								if buffer[position] != rune('R') {
+									goto l1393
+								}
+								position++
+							}
+						l1409:
+							if !_rules[ruleskip]() {
+								goto l1393
+							}
+							depth--
+							add(ruleSTRAFTER, position1394)
+						}
+						goto l1294
+					l1393:
+						position, tokenIndex, depth = position1294, tokenIndex1294, depth1294
+						{
+							position1412 := position
+							depth++
+							{
+								position1413, tokenIndex1413, depth1413 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1414
+								}
+								position++
+								goto l1413
+							l1414:
+								position, tokenIndex, depth = position1413, tokenIndex1413, depth1413
+								if buffer[position] != rune('S') {
+									goto l1411
+								}
+								position++
+							}
+						l1413:
+							{
+								position1415, tokenIndex1415, depth1415 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1416
+								}
+								position++
+								goto l1415
+							l1416:
+								position, tokenIndex, depth = position1415, tokenIndex1415, depth1415
+								if buffer[position] != rune('T') {
+									goto l1411
+								}
+								position++
+							}
+						l1415:
+							{
+								position1417, tokenIndex1417, depth1417 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1418
+								}
+								position++
+								goto l1417
+							l1418:
+								position, tokenIndex, depth = position1417, tokenIndex1417, depth1417
+								if buffer[position] != rune('R') {
+									goto l1411
+								}
+								position++
+							}
+						l1417:
+							{
+								position1419, tokenIndex1419, depth1419 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1420
+								}
+								position++
+								goto l1419
+							l1420:
+								position, tokenIndex, depth = position1419, tokenIndex1419, depth1419
+								if buffer[position] != rune('L') {
+									goto l1411
+								}
+								position++
+							}
+						l1419:
+							{
+								position1421, tokenIndex1421, depth1421 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1422
+								}
+								position++
+								goto l1421
+							l1422:
+								position, tokenIndex, depth = position1421, tokenIndex1421, depth1421
+								if buffer[position] != rune('A') {
+									goto l1411
+								}
+								position++
+							}
+						l1421:
+							{
+								position1423, tokenIndex1423, depth1423 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1424
 								}
 								position++
-								goto l1203
-							l1204:
-								position, tokenIndex, depth = position1203, tokenIndex1203, depth1203
-								if buffer[position] != rune('D') {
-									goto l1191
+								goto l1423
+							l1424:
+								position, tokenIndex, depth = position1423, tokenIndex1423, depth1423
+								if buffer[position] != rune('N') {
+									goto l1411
 								}
 								position++
 							}
-						l1203:
+						l1423:
 							{
-								position1205, tokenIndex1205, depth1205 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1206
+								position1425, tokenIndex1425, depth1425 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l1426
 								}
 								position++
-								goto l1205
-							l1206:
-								position, tokenIndex, depth = position1205, tokenIndex1205, depth1205
-								if buffer[position] != rune('S') {
-									goto l1191
+								goto l1425
+							l1426:
+								position, tokenIndex, depth = position1425, tokenIndex1425, depth1425
+								if buffer[position] != rune('G') {
+									goto l1411
 								}
 								position++
 							}
-						l1205:
-							if !rules[ruleskip]() {
-								goto l1191
+						l1425:
+							if !_rules[ruleskip]() {
+								goto l1411
 							}
 							depth--
-							add(ruleSTRENDS, position1192)
+							add(ruleSTRLANG, position1412)
 						}
-						goto l1170
-					l1191:
-						position, tokenIndex, depth = position1170, tokenIndex1170, depth1170
+						goto l1294
+					l1411:
+						position, tokenIndex, depth = position1294, tokenIndex1294, depth1294
 						{
-							position1208 := position
+							position1428 := position
 							depth++
 							{
-								position1209, tokenIndex1209, depth1209 := position, tokenIndex, depth
+								position1429, tokenIndex1429, depth1429 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1210
+									goto l1430
 								}
 								position++
-								goto l1209
-							l1210:
-								position, tokenIndex, depth = position1209, tokenIndex1209, depth1209
+								goto l1429
+							l1430:
+								position, tokenIndex, depth = position1429, tokenIndex1429, depth1429
 								if buffer[position] != rune('S') {
-									goto l1207
+									goto l1427
 								}
 								position++
 							}
-						l1209:
+						l1429:
 							{
-								position1211, tokenIndex1211, depth1211 := position, tokenIndex, depth
+								position1431, tokenIndex1431, depth1431 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1212
+									goto l1432
 								}
 								position++
-								goto l1211
-							l1212:
-								position, tokenIndex, depth = position1211, tokenIndex1211, depth1211
+								goto l1431
+							l1432:
+								position, tokenIndex, depth = position1431, tokenIndex1431, depth1431
 								if buffer[position] != rune('T') {
-									goto l1207
+									goto l1427
 								}
 								position++
 							}
-						l1211:
+						l1431:
 							{
-								position1213, tokenIndex1213, depth1213 := position, tokenIndex, depth
+								position1433, tokenIndex1433, depth1433 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1214
+									goto l1434
 								}
 								position++
-								goto l1213
-							l1214:
-								position, tokenIndex, depth = position1213, tokenIndex1213, depth1213
+								goto l1433
+							l1434:
+								position, tokenIndex, depth = position1433, tokenIndex1433, depth1433
 								if buffer[position] != rune('R') {
-									goto l1207
+									goto l1427
 								}
 								position++
 							}
-						l1213:
+						l1433:
 							{
-								position1215, tokenIndex1215, depth1215 := position, tokenIndex, depth
-								if buffer[position] != rune('b') {
-									goto l1216
+								position1435, tokenIndex1435, depth1435 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1436
 								}
 								position++
-								goto l1215
-							l1216:
-								position, tokenIndex, depth = position1215, tokenIndex1215, depth1215
-								if buffer[position] != rune('B') {
-									goto l1207
+								goto l1435
+							l1436:
+								position, tokenIndex, depth = position1435, tokenIndex1435, depth1435
+								if buffer[position] != rune('D') {
+									goto l1427
 								}
 								position++
 							}
-						l1215:
+						l1435:
 							{
-								position1217, tokenIndex1217, depth1217 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l1218
+								position1437, tokenIndex1437, depth1437 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1438
 								}
 								position++
-								goto l1217
-							l1218:
-								position, tokenIndex, depth = position1217, tokenIndex1217, depth1217
-								if buffer[position] != rune('E') {
-									goto l1207
+								goto l1437
+							l1438:
+								position, tokenIndex, depth = position1437, tokenIndex1437, depth1437
+								if buffer[position] != rune('T') {
+									goto l1427
 								}
 								position++
 							}
-						l1217:
+						l1437:
+							if !_rules[ruleskip]() {
+								goto l1427
+							}
+							depth--
+							add(ruleSTRDT, position1428)
+						}
+						goto l1294
+					l1427:
+						position, tokenIndex, depth = position1294, tokenIndex1294, depth1294
+						{
+							position1439 := position
+							depth++
 							{
-								position1219, tokenIndex1219, depth1219 := position, tokenIndex, depth
-								if buffer[position] != rune('f') {
-									goto l1220
+								position1440, tokenIndex1440, depth1440 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1441
 								}
 								position++
-								goto l1219
-							l1220:
-								position, tokenIndex, depth = position1219, tokenIndex1219, depth1219
-								if buffer[position] != rune('F') {
-									goto l1207
+								goto l1440
+							l1441:
+								position, tokenIndex, depth = position1440, tokenIndex1440, depth1440
+								if buffer[position] != rune('S') {
+									goto l1293
 								}
 								position++
 							}
-						l1219:
+						l1440:
 							{
-								position1221, tokenIndex1221, depth1221 := position, tokenIndex, depth
-								if buffer[position] != rune('o') {
-									goto l1222
+								position1442, tokenIndex1442, depth1442 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1443
 								}
 								position++
-								goto l1221
-							l1222:
-								position, tokenIndex, depth = position1221, tokenIndex1221, depth1221
-								if buffer[position] != rune('O') {
-									goto l1207
+								goto l1442
+							l1443:
+								position, tokenIndex, depth = position1442, tokenIndex1442, depth1442
+								if buffer[position] != rune('A') {
+									goto l1293
 								}
 								position++
 							}
-						l1221:
+						l1442:
 							{
-								position1223, tokenIndex1223, depth1223 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1224
+								position1444, tokenIndex1444, depth1444 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1445
 								}
 								position++
-								goto l1223
-							l1224:
-								position, tokenIndex, depth = position1223, tokenIndex1223, depth1223
-								if buffer[position] != rune('R') {
-									goto l1207
+								goto l1444
+							l1445:
+								position, tokenIndex, depth = position1444, tokenIndex1444, depth1444
+								if buffer[position] != rune('M') {
+									goto l1293
 								}
 								position++
 							}
-						l1223:
+						l1444:
 							{
-								position1225, tokenIndex1225, depth1225 := position, tokenIndex, depth
+								position1446, tokenIndex1446, depth1446 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l1226
+									goto l1447
 								}
 								position++
-								goto l1225
-							l1226:
-								position, tokenIndex, depth = position1225, tokenIndex1225, depth1225
+								goto l1446
+							l1447:
+								position, tokenIndex, depth = position1446, tokenIndex1446, depth1446
 								if buffer[position] != rune('E') {
-									goto l1207
+									goto l1293
 								}
 								position++
 							}
-						l1225:
-							if !rules[ruleskip]() {
-								goto l1207
-							}
-							depth--
-							add(ruleSTRBEFORE, position1208)
-						}
-						goto l1170
-					l1207:
-						position, tokenIndex, depth = position1170, tokenIndex1170, depth1170
-						{
-							position1228 := position
-							depth++
+						l1446:
 							{
-								position1229, tokenIndex1229, depth1229 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1230
+								position1448, tokenIndex1448, depth1448 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1449
 								}
 								position++
-								goto l1229
-							l1230:
-								position, tokenIndex, depth = position1229, tokenIndex1229, depth1229
-								if buffer[position] != rune('S') {
-									goto l1227
+								goto l1448
+							l1449:
+								position, tokenIndex, depth = position1448, tokenIndex1448, depth1448
+								if buffer[position] != rune('T') {
+									goto l1293
 								}
 								position++
 							}
-						l1229:
+						l1448:
 							{
-								position1231, tokenIndex1231, depth1231 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1232
+								position1450, tokenIndex1450, depth1450 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1451
 								}
 								position++
-								goto l1231
-							l1232:
-								position, tokenIndex, depth = position1231, tokenIndex1231, depth1231
-								if buffer[position] != rune('T') {
-									goto l1227
+								goto l1450
+							l1451:
+								position, tokenIndex, depth = position1450, tokenIndex1450, depth1450
+								if buffer[position] != rune('E') {
+									goto l1293
 								}
 								position++
 							}
-						l1231:
+						l1450:
 							{
-								position1233, tokenIndex1233, depth1233 := position, tokenIndex, depth
+								position1452, tokenIndex1452, depth1452 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1234
+									goto l1453
 								}
 								position++
-								goto l1233
-							l1234:
-								position, tokenIndex, depth = position1233, tokenIndex1233, depth1233
+								goto l1452
+							l1453:
+								position, tokenIndex, depth = position1452, tokenIndex1452, depth1452
 								if buffer[position] != rune('R') {
-									goto l1227
+									goto l1293
 								}
 								position++
 							}
-						l1233:
+						l1452:
 							{
-								position1235, tokenIndex1235, depth1235 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l1236
+								position1454, tokenIndex1454, depth1454 := position, tokenIndex, depth
+								if buffer[position] != rune('m') {
+									goto l1455
 								}
 								position++
-								goto l1235
-							l1236:
-								position, tokenIndex, depth = position1235, tokenIndex1235, depth1235
-								if buffer[position] != rune('A') {
-									goto l1227
+								goto l1454
+							l1455:
+								position, tokenIndex, depth = position1454, tokenIndex1454, depth1454
+								if buffer[position] != rune('M') {
+									goto l1293
 								}
 								position++
 							}
-						l1235:
+						l1454:
+							if !_rules[ruleskip]() {
+								goto l1293
+							}
+							depth--
+							add(ruleSAMETERM, position1439)
+						}
+					}
+				l1294:
+					if !_rules[ruleLPAREN]() {
+						goto l1293
+					}
+					if !_rules[ruleexpression]() {
+						goto l1293
+					}
+					if !_rules[ruleCOMMA]() {
+						goto l1293
+					}
+					if !_rules[ruleexpression]() {
+						goto l1293
+					}
+					if !_rules[ruleRPAREN]() {
+						goto l1293
+					}
+					goto l917
+				l1293:
+					position, tokenIndex, depth = position917, tokenIndex917, depth917
+					{
+						position1457 := position
+						depth++
+						{
+							position1458, tokenIndex1458, depth1458 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1459
+							}
+							position++
+							goto l1458
+						l1459:
+							position, tokenIndex, depth = position1458, tokenIndex1458, depth1458
+							if buffer[position] != rune('B') {
+								goto l1456
+							}
+							position++
+						}
+					l1458:
+						{
+							position1460, tokenIndex1460, depth1460 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1461
+							}
+							position++
+							goto l1460
+						l1461:
+							position, tokenIndex, depth = position1460, tokenIndex1460, depth1460
+							if buffer[position] != rune('O') {
+								goto l1456
+							}
+							position++
+						}
+					l1460:
+						{
+							position1462, tokenIndex1462, depth1462 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1463
+							}
+							position++
+							goto l1462
+						l1463:
+							position, tokenIndex, depth = position1462, tokenIndex1462, depth1462
+							if buffer[position] != rune('U') {
+								goto l1456
+							}
+							position++
+						}
+					l1462:
+						{
+							position1464, tokenIndex1464, depth1464 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1465
+							}
+							position++
+							goto l1464
+						l1465:
+							position, tokenIndex, depth = position1464, tokenIndex1464, depth1464
+							if buffer[position] != rune('N') {
+								goto l1456
+							}
+							position++
+						}
+					l1464:
+						{
+							position1466, tokenIndex1466, depth1466 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1467
+							}
+							position++
+							goto l1466
+						l1467:
+							position, tokenIndex, depth = position1466, tokenIndex1466, depth1466
+							if buffer[position] != rune('D') {
+								goto l1456
+							}
+							position++
+						}
+					l1466:
+						if !_rules[ruleskip]() {
+							goto l1456
+						}
+						depth--
+						add(ruleBOUND, position1457)
+					}
+					if !_rules[ruleLPAREN]() {
+						goto l1456
+					}
+					if !_rules[rulevar]() {
+						goto l1456
+					}
+					if !_rules[ruleRPAREN]() {
+						goto l1456
+					}
+					goto l917
+				l1456:
+					position, tokenIndex, depth = position917, tokenIndex917, depth917
+					{
+						position1469 := position
+						depth++
+						{
+							position1470, tokenIndex1470, depth1470 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1471
+							}
+							position++
+							goto l1470
+						l1471:
+							position, tokenIndex, depth = position1470, tokenIndex1470, depth1470
+							if buffer[position] != rune('B') {
+								goto l1468
+							}
+							position++
+						}
+					l1470:
+						{
+							position1472, tokenIndex1472, depth1472 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1473
+							}
+							position++
+							goto l1472
+						l1473:
+							position, tokenIndex, depth = position1472, tokenIndex1472, depth1472
+							if buffer[position] != rune('N') {
+								goto l1468
+							}
+							position++
+						}
+					l1472:
+						{
+							position1474, tokenIndex1474, depth1474 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1475
+							}
+							position++
+							goto l1474
+						l1475:
+							position, tokenIndex, depth = position1474, tokenIndex1474, depth1474
+							if buffer[position] != rune('O') {
+								goto l1468
+							}
+							position++
+						}
+					l1474:
+						{
+							position1476, tokenIndex1476, depth1476 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1477
+							}
+							position++
+							goto l1476
+						l1477:
+							position, tokenIndex, depth = position1476, tokenIndex1476, depth1476
+							if buffer[position] != rune('D') {
+								goto l1468
+							}
+							position++
+						}
+					l1476:
+						{
+							position1478, tokenIndex1478, depth1478 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1479
+							}
+							position++
+							goto l1478
+						l1479:
+							position, tokenIndex, depth = position1478, tokenIndex1478, depth1478
+							if buffer[position] != rune('E') {
+								goto l1468
+							}
+							position++
+						}
+					l1478:
+						if !_rules[ruleskip]() {
+							goto l1468
+						}
+						depth--
+						add(ruleBNODE, position1469)
+					}
+					{
+						position1480, tokenIndex1480, depth1480 := position, tokenIndex, depth
+						if !_rules[ruleLPAREN]() {
+							goto l1481
+						}
+						if !_rules[ruleexpression]() {
+							goto l1481
+						}
+						if !_rules[ruleRPAREN]() {
+							goto l1481
+						}
+						goto l1480
+					l1481:
+						position, tokenIndex, depth = position1480, tokenIndex1480, depth1480
+						if !_rules[rulenil]() {
+							goto l1468
+						}
+					}
+				l1480:
+					goto l917
+				l1468:
+					position, tokenIndex, depth = position917, tokenIndex917, depth917
+					{
+						position1483, tokenIndex1483, depth1483 := position, tokenIndex, depth
+						{
+							position1485 := position
+							depth++
 							{
-								position1237, tokenIndex1237, depth1237 := position, tokenIndex, depth
-								if buffer[position] != rune('f') {
-									goto l1238
+								position1486, tokenIndex1486, depth1486 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1487
 								}
 								position++
-								goto l1237
-							l1238:
-								position, tokenIndex, depth = position1237, tokenIndex1237, depth1237
-								if buffer[position] != rune('F') {
-									goto l1227
+								goto l1486
+							l1487:
+								position, tokenIndex, depth = position1486, tokenIndex1486, depth1486
+								if buffer[position] != rune('R') {
+									goto l1484
 								}
 								position++
 							}
-						l1237:
+						l1486:
 							{
-								position1239, tokenIndex1239, depth1239 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1240
+								position1488, tokenIndex1488, depth1488 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1489
 								}
 								position++
-								goto l1239
-							l1240:
-								position, tokenIndex, depth = position1239, tokenIndex1239, depth1239
-								if buffer[position] != rune('T') {
-									goto l1227
+								goto l1488
+							l1489:
+								position, tokenIndex, depth = position1488, tokenIndex1488, depth1488
+								if buffer[position] != rune('A') {
+									goto l1484
 								}
 								position++
 							}
-						l1239:
+						l1488:
 							{
-								position1241, tokenIndex1241, depth1241 := position, tokenIndex, depth
-								if buffer[position] != rune('e') {
-									goto l1242
+								position1490, tokenIndex1490, depth1490 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1491
 								}
 								position++
-								goto l1241
-							l1242:
-								position, tokenIndex, depth = position1241, tokenIndex1241, depth1241
-								if buffer[position] != rune('E') {
-									goto l1227
+								goto l1490
+							l1491:
+								position, tokenIndex, depth = position1490, tokenIndex1490, depth1490
+								if buffer[position] != rune('N') {
+									goto l1484
 								}
 								position++
 							}
-						l1241:
+						l1490:
 							{
-								position1243, tokenIndex1243, depth1243 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1244
+								position1492, tokenIndex1492, depth1492 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1493
 								}
 								position++
-								goto l1243
-							l1244:
-								position, tokenIndex, depth = position1243, tokenIndex1243, depth1243
-								if buffer[position] != rune('R') {
-									goto l1227
+								goto l1492
+							l1493:
+								position, tokenIndex, depth = position1492, tokenIndex1492, depth1492
+								if buffer[position] != rune('D') {
+									goto l1484
 								}
 								position++
 							}
-						l1243:
-							if !rules[ruleskip]() {
-								goto l1227
+						l1492:
+							if !_rules[ruleskip]() {
+								goto l1484
 							}
 							depth--
-							add(ruleSTRAFTER, position1228)
+							add(ruleRAND, position1485)
 						}
-						goto l1170
-					l1227:
-						position, tokenIndex, depth = position1170, tokenIndex1170, depth1170
+						goto l1483
+					l1484:
+						position, tokenIndex, depth = position1483, tokenIndex1483, depth1483
 						{
-							position1246 := position
+							position1495 := position
 							depth++
 							{
-								position1247, tokenIndex1247, depth1247 := position, tokenIndex, depth
-								if buffer[position] != rune('s') {
-									goto l1248
+								position1496, tokenIndex1496, depth1496 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1497
 								}
 								position++
-								goto l1247
-							l1248:
-								position, tokenIndex, depth = position1247, tokenIndex1247, depth1247
-								if buffer[position] != rune('S') {
-									goto l1245
+								goto l1496
+							l1497:
+								position, tokenIndex, depth = position1496, tokenIndex1496, depth1496
+								if buffer[position] != rune('N') {
+									goto l1494
 								}
 								position++
 							}
-						l1247:
+						l1496:
 							{
-								position1249, tokenIndex1249, depth1249 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1250
+								position1498, tokenIndex1498, depth1498 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1499
 								}
 								position++
-								goto l1249
-							l1250:
-								position, tokenIndex, depth = position1249, tokenIndex1249, depth1249
-								if buffer[position] != rune('T') {
-									goto l1245
+								goto l1498
+							l1499:
+								position, tokenIndex, depth = position1498, tokenIndex1498, depth1498
+								if buffer[position] != rune('O') {
+									goto l1494
 								}
 								position++
 							}
-						l1249:
+						l1498:
 							{
-								position1251, tokenIndex1251, depth1251 := position, tokenIndex, depth
-								if buffer[position] != rune('r') {
-									goto l1252
+								position1500, tokenIndex1500, depth1500 := position, tokenIndex, depth
+								if buffer[position] != rune('w') {
+									goto l1501
 								}
 								position++
-								goto l1251
-							l1252:
-								position, tokenIndex, depth = position1251, tokenIndex1251, depth1251
-								if buffer[position] != rune('R') {
-									goto l1245
+								goto l1500
+							l1501:
+								position, tokenIndex, depth = position1500, tokenIndex1500, depth1500
+								if buffer[position] != rune('W') {
+									goto l1494
 								}
 								position++
 							}
-						l1251:
+						l1500:
+							if !_rules[ruleskip]() {
+								goto l1494
+							}
+							depth--
+							add(ruleNOW, position1495)
+						}
+						goto l1483
+					l1494:
+						position, tokenIndex, depth = position1483, tokenIndex1483, depth1483
+						{
+							position1503 := position
+							depth++
 							{
-								position1253, tokenIndex1253, depth1253 := position, tokenIndex, depth
-								if buffer[position] != rune('l') {
-									goto l1254
+								position1504, tokenIndex1504, depth1504 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1505
 								}
 								position++
-								goto l1253
-							l1254:
-								position, tokenIndex, depth = position1253, tokenIndex1253, depth1253
-								if buffer[position] != rune('L') {
-									goto l1245
+								goto l1504
+							l1505:
+								position, tokenIndex, depth = position1504, tokenIndex1504, depth1504
+								if buffer[position] != rune('U') {
+									goto l1502
 								}
 								position++
 							}
-						l1253:
+						l1504:
 							{
-								position1255, tokenIndex1255, depth1255 := position, tokenIndex, depth
-								if buffer[position] != rune('a') {
-									goto l1256
+								position1506, tokenIndex1506, depth1506 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1507
 								}
 								position++
-								goto l1255
-							l1256:
-								position, tokenIndex, depth = position1255, tokenIndex1255, depth1255
-								if buffer[position] != rune('A') {
-									goto l1245
+								goto l1506
+							l1507:
+								position, tokenIndex, depth = position1506, tokenIndex1506, depth1506
+								if buffer[position] != rune('U') {
+									goto l1502
 								}
 								position++
 							}
-						l1255:
+						l1506:
 							{
-								position1257, tokenIndex1257, depth1257 := position, tokenIndex, depth
-								if buffer[position] != rune('n') {
-									goto l1258
+								position1508, tokenIndex1508, depth1508 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1509
 								}
 								position++
-								goto l1257
-							l1258:
-								position, tokenIndex, depth = position1257, tokenIndex1257, depth1257
-								if buffer[position] != rune('N') {
-									goto l1245
+								goto l1508
+							l1509:
+								position, tokenIndex, depth = position1508, tokenIndex1508, depth1508
+								if buffer[position] != rune('I') {
+									goto l1502
 								}
 								position++
 							}
-						l1257:
+						l1508:
 							{
-								position1259, tokenIndex1259, depth1259 := position, tokenIndex, depth
-								if buffer[position] != rune('g') {
-									goto l1260
+								position1510, tokenIndex1510, depth1510 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1511
 								}
 								position++
-								goto l1259
-							l1260:
-								position, tokenIndex, depth = position1259, tokenIndex1259, depth1259
-								if buffer[position] != rune('G') {
-									goto l1245
+								goto l1510
+							l1511:
+								position, tokenIndex, depth = position1510, tokenIndex1510, depth1510
+								if buffer[position] != rune('D') {
+									goto l1502
 								}
 								position++
 							}
-						l1259:
-							if !rules[ruleskip]() {
-								goto l1245
+						l1510:
+							if !_rules[ruleskip]() {
+								goto l1502
 							}
 							depth--
-							add(ruleSTRLANG, position1246)
+							add(ruleUUID, position1503)
 						}
-						goto l1170
-					l1245:
-						position, tokenIndex, depth = position1170, tokenIndex1170, depth1170
+						goto l1483
+					l1502:
+						position, tokenIndex, depth = position1483, tokenIndex1483, depth1483
 						{
-							position1262 := position
+							position1512 := position
 							depth++
 							{
-								position1263, tokenIndex1263, depth1263 := position, tokenIndex, depth
+								position1513, tokenIndex1513, depth1513 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l1264
+									goto l1514
 								}
 								position++
-								goto l1263
-							l1264:
-								position, tokenIndex, depth = position1263, tokenIndex1263, depth1263
+								goto l1513
+							l1514:
+								position, tokenIndex, depth = position1513, tokenIndex1513, depth1513
 								if buffer[position] != rune('S') {
-									goto l1261
+									goto l1482
 								}
 								position++
 							}
-						l1263:
+						l1513:
 							{
-								position1265, tokenIndex1265, depth1265 := position, tokenIndex, depth
+								position1515, tokenIndex1515, depth1515 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l1266
+									goto l1516
 								}
 								position++
-								goto l1265
-							l1266:
-								position, tokenIndex, depth = position1265, tokenIndex1265, depth1265
+								goto l1515
+							l1516:
+								position, tokenIndex, depth = position1515, tokenIndex1515, depth1515
 								if buffer[position] != rune('T') {
-									goto l1261
+									goto l1482
 								}
 								position++
 							}
-						l1265:
+						l1515:
 							{
-								position1267, tokenIndex1267, depth1267 := position, tokenIndex, depth
+								position1517, tokenIndex1517, depth1517 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l1268
+									goto l1518
 								}
 								position++
-								goto l1267
-							l1268:
-								position, tokenIndex, depth = position1267, tokenIndex1267, depth1267
+								goto l1517
+							l1518:
+								position, tokenIndex, depth = position1517, tokenIndex1517, depth1517
 								if buffer[position] != rune('R') {
-									goto l1261
+									goto l1482
+								}
+								position++
+							}
+						l1517:
+							{
+								position1519, tokenIndex1519, depth1519 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1520
+								}
+								position++
+								goto l1519
+							l1520:
+								position, tokenIndex, depth = position1519, tokenIndex1519, depth1519
+								if buffer[position] != rune('U') {
+									goto l1482
+								}
+								position++
+							}
+						l1519:
+							{
+								position1521, tokenIndex1521, depth1521 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1522
+								}
+								position++
+								goto l1521
+							l1522:
+								position, tokenIndex, depth = position1521, tokenIndex1521, depth1521
+								if buffer[position] != rune('U') {
+									goto l1482
+								}
+								position++
+							}
+						l1521:
+							{
+								position1523, tokenIndex1523, depth1523 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1524
+								}
+								position++
+								goto l1523
+							l1524:
+								position, tokenIndex, depth = position1523, tokenIndex1523, depth1523
+								if buffer[position] != rune('I') {
+									goto l1482
 								}
 								position++
 							}
-						l1267:
+						l1523:
 							{
-								position1269, tokenIndex1269, depth1269 := position, tokenIndex, depth
+								position1525, tokenIndex1525, depth1525 := position, tokenIndex, depth
 								if buffer[position] != rune('d') {
-									goto l1270
+									goto l1526
 								}
 								position++
-								goto l1269
-							l1270:
-								position, tokenIndex, depth = position1269, tokenIndex1269, depth1269
+								goto l1525
+							l1526:
+								position, tokenIndex, depth = position1525, tokenIndex1525, depth1525
 								if buffer[position] != rune('D') {
-									goto l1261
+									goto l1482
 								}
 								position++
 							}
-						l1269:
+						l1525:
+							if !_rules[ruleskip]() {
+								goto l1482
+							}
+							depth--
+							add(ruleSTRUUID, position1512)
+						}
+					}
+				l1483:
+					if !_rules[rulenil]() {
+						goto l1482
+					}
+					goto l917
+				l1482:
+					position, tokenIndex, depth = position917, tokenIndex917, depth917
+					{
+						position1528, tokenIndex1528, depth1528 := position, tokenIndex, depth
+						{
+							position1530 := position
+							depth++
 							{
-								position1271, tokenIndex1271, depth1271 := position, tokenIndex, depth
-								if buffer[position] != rune('t') {
-									goto l1272
+								position1531, tokenIndex1531, depth1531 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1532
 								}
 								position++
-								goto l1271
-							l1272:
-								position, tokenIndex, depth = position1271, tokenIndex1271, depth1271
-								if buffer[position] != rune('T') {
-									goto l1261
+								goto l1531
+							l1532:
+								position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
+								if buffer[position] != rune('C') {
+									goto l1529
 								}
 								position++
 							}
-						l1271:
-							if !rules[ruleskip]() {
-								goto l1261
-							}
-							depth--
-							add(ruleSTRDT, position1262)
-						}
-						goto l1170
-					l1261:
-						position, tokenIndex, depth = position1170, tokenIndex1170, depth1170
-						{
-							switch buffer[position] {
-							case 'S', 's':
-								{
-									position1274 := position
-									depth++
-									{
-										position1275, tokenIndex1275, depth1275 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1276
-										}
-										position++
-										goto l1275
-									l1276:
-										position, tokenIndex, depth = position1275, tokenIndex1275, depth1275
-										if buffer[position] != rune('S') {
-											goto l1169
-										}
-										position++
-									}
-								l1275:
-									{
-										position1277, tokenIndex1277, depth1277 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1278
-										}
-										position++
-										goto l1277
-									l1278:
-										position, tokenIndex, depth = position1277, tokenIndex1277, depth1277
-										if buffer[position] != rune('A') {
-											goto l1169
-										}
-										position++
-									}
-								l1277:
-									{
-										position1279, tokenIndex1279, depth1279 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1280
-										}
-										position++
-										goto l1279
-									l1280:
-										position, tokenIndex, depth = position1279, tokenIndex1279, depth1279
-										if buffer[position] != rune('M') {
-											goto l1169
-										}
-										position++
-									}
-								l1279:
-									{
-										position1281, tokenIndex1281, depth1281 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1282
-										}
-										position++
-										goto l1281
-									l1282:
-										position, tokenIndex, depth = position1281, tokenIndex1281, depth1281
-										if buffer[position] != rune('E') {
-											goto l1169
-										}
-										position++
-									}
-								l1281:
-									{
-										position1283, tokenIndex1283, depth1283 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1284
-										}
-										position++
-										goto l1283
-									l1284:
-										position, tokenIndex, depth = position1283, tokenIndex1283, depth1283
-										if buffer[position] != rune('T') {
-											goto l1169
-										}
-										position++
-									}
-								l1283:
-									{
-										position1285, tokenIndex1285, depth1285 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1286
-										}
-										position++
-										goto l1285
-									l1286:
-										position, tokenIndex, depth = position1285, tokenIndex1285, depth1285
-										if buffer[position] != rune('E') {
-											goto l1169
-										}
-										position++
-									}
-								l1285:
-									{
-										position1287, tokenIndex1287, depth1287 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1288
-										}
-										position++
-										goto l1287
-									l1288:
-										position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
-										if buffer[position] != rune('R') {
-											goto l1169
-										}
-										position++
-									}
-								l1287:
-									{
-										position1289, tokenIndex1289, depth1289 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1290
-										}
-										position++
-										goto l1289
-									l1290:
-										position, tokenIndex, depth = position1289, tokenIndex1289, depth1289
-										if buffer[position] != rune('M') {
-											goto l1169
-										}
-										position++
-									}
-								l1289:
-									if !rules[ruleskip]() {
-										goto l1169
-									}
-									depth--
-									add(ruleSAMETERM, position1274)
+						l1531:
+							{
+								position1533, tokenIndex1533, depth1533 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1534
 								}
-								break
-							case 'C', 'c':
-								{
-									position1291 := position
-									depth++
-									{
-										position1292, tokenIndex1292, depth1292 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1293
-										}
-										position++
-										goto l1292
-									l1293:
-										position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-										if buffer[position] != rune('C') {
-											goto l1169
-										}
-										position++
-									}
-								l1292:
-									{
-										position1294, tokenIndex1294, depth1294 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1295
-										}
-										position++
-										goto l1294
-									l1295:
-										position, tokenIndex, depth = position1294, tokenIndex1294, depth1294
-										if buffer[position] != rune('O') {
-											goto l1169
-										}
-										position++
-									}
-								l1294:
-									{
-										position1296, tokenIndex1296, depth1296 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1297
-										}
-										position++
-										goto l1296
-									l1297:
-										position, tokenIndex, depth = position1296, tokenIndex1296, depth1296
-										if buffer[position] != rune('N') {
-											goto l1169
-										}
-										position++
-									}
-								l1296:
-									{
-										position1298, tokenIndex1298, depth1298 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1299
-										}
-										position++
-										goto l1298
-									l1299:
-										position, tokenIndex, depth = position1298, tokenIndex1298, depth1298
-										if buffer[position] != rune('T') {
-											goto l1169
-										}
-										position++
-									}
-								l1298:
-									{
-										position1300, tokenIndex1300, depth1300 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1301
-										}
-										position++
-										goto l1300
-									l1301:
-										position, tokenIndex, depth = position1300, tokenIndex1300, depth1300
-										if buffer[position] != rune('A') {
-											goto l1169
-										}
-										position++
-									}
-								l1300:
-									{
-										position1302, tokenIndex1302, depth1302 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1303
-										}
-										position++
-										goto l1302
-									l1303:
-										position, tokenIndex, depth = position1302, tokenIndex1302, depth1302
-										if buffer[position] != rune('I') {
-											goto l1169
-										}
-										position++
-									}
-								l1302:
-									{
-										position1304, tokenIndex1304, depth1304 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1305
-										}
-										position++
-										goto l1304
-									l1305:
-										position, tokenIndex, depth = position1304, tokenIndex1304, depth1304
-										if buffer[position] != rune('N') {
-											goto l1169
-										}
-										position++
-									}
-								l1304:
-									{
-										position1306, tokenIndex1306, depth1306 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1307
-										}
-										position++
-										goto l1306
-									l1307:
-										position, tokenIndex, depth = position1306, tokenIndex1306, depth1306
-										if buffer[position] != rune('S') {
-											goto l1169
-										}
-										position++
-									}
-								l1306:
-									if !rules[ruleskip]() {
-										goto l1169
-									}
-									depth--
-									add(ruleCONTAINS, position1291)
+								position++
+								goto l1533
+							l1534:
+								position, tokenIndex, depth = position1533, tokenIndex1533, depth1533
+								if buffer[position] != rune('O') {
+									goto l1529
 								}
-								break
-							default:
-								{
-									position1308 := position
-									depth++
-									{
-										position1309, tokenIndex1309, depth1309 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1310
-										}
-										position++
-										goto l1309
-									l1310:
-										position, tokenIndex, depth = position1309, tokenIndex1309, depth1309
-										if buffer[position] != rune('L') {
-											goto l1169
-										}
-										position++
-									}
-								l1309:
-									{
-										position1311, tokenIndex1311, depth1311 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1312
-										}
-										position++
-										goto l1311
-									l1312:
-										position, tokenIndex, depth = position1311, tokenIndex1311, depth1311
-										if buffer[position] != rune('A') {
-											goto l1169
-										}
-										position++
-									}
-								l1311:
-									{
-										position1313, tokenIndex1313, depth1313 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1314
-										}
-										position++
-										goto l1313
-									l1314:
-										position, tokenIndex, depth = position1313, tokenIndex1313, depth1313
-										if buffer[position] != rune('N') {
-											goto l1169
-										}
-										position++
-									}
-								l1313:
-									{
-										position1315, tokenIndex1315, depth1315 := position, tokenIndex, depth
-										if buffer[position] != rune('g') {
-											goto l1316
-										}
-										position++
-										goto l1315
-									l1316:
-										position, tokenIndex, depth = position1315, tokenIndex1315, depth1315
-										if buffer[position] != rune('G') {
-											goto l1169
-										}
-										position++
-									}
-								l1315:
-									{
-										position1317, tokenIndex1317, depth1317 := position, tokenIndex, depth
-										if buffer[position] != rune('m') {
-											goto l1318
-										}
-										position++
-										goto l1317
-									l1318:
-										position, tokenIndex, depth = position1317, tokenIndex1317, depth1317
-										if buffer[position] != rune('M') {
-											goto l1169
-										}
-										position++
-									}
-								l1317:
-									{
-										position1319, tokenIndex1319, depth1319 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1320
-										}
-										position++
-										goto l1319
-									l1320:
-										position, tokenIndex, depth = position1319, tokenIndex1319, depth1319
-										if buffer[position] != rune('A') {
-											goto l1169
-										}
-										position++
-									}
-								l1319:
-									{
-										position1321, tokenIndex1321, depth1321 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1322
-										}
-										position++
-										goto l1321
-									l1322:
-										position, tokenIndex, depth = position1321, tokenIndex1321, depth1321
-										if buffer[position] != rune('T') {
-											goto l1169
-										}
-										position++
-									}
-								l1321:
-									{
-										position1323, tokenIndex1323, depth1323 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1324
-										}
-										position++
-										goto l1323
-									l1324:
-										position, tokenIndex, depth = position1323, tokenIndex1323, depth1323
-										if buffer[position] != rune('C') {
-											goto l1169
-										}
-										position++
-									}
-								l1323:
-									{
-										position1325, tokenIndex1325, depth1325 := position, tokenIndex, depth
-										if buffer[position] != rune('h') {
-											goto l1326
-										}
-										position++
-										goto l1325
-									l1326:
-										position, tokenIndex, depth = position1325, tokenIndex1325, depth1325
-										if buffer[position] != rune('H') {
-											goto l1169
-										}
-										position++
-									}
-								l1325:
-									{
-										position1327, tokenIndex1327, depth1327 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1328
-										}
-										position++
-										goto l1327
-									l1328:
-										position, tokenIndex, depth = position1327, tokenIndex1327, depth1327
-										if buffer[position] != rune('E') {
-											goto l1169
-										}
-										position++
-									}
-								l1327:
-									{
-										position1329, tokenIndex1329, depth1329 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1330
-										}
-										position++
-										goto l1329
-									l1330:
-										position, tokenIndex, depth = position1329, tokenIndex1329, depth1329
-										if buffer[position] != rune('S') {
-											goto l1169
-										}
-										position++
-									}
-								l1329:
-									if !rules[ruleskip]() {
-										goto l1169
-									}
-									depth--
-									add(ruleLANGMATCHES, position1308)
+								position++
+							}
+						l1533:
+							{
+								position1535, tokenIndex1535, depth1535 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1536
+								}
+								position++
+								goto l1535
+							l1536:
+								position, tokenIndex, depth = position1535, tokenIndex1535, depth1535
+								if buffer[position] != rune('N') {
+									goto l1529
 								}
-								break
+								position++
 							}
-						}
-
-					}
-				l1170:
-					if !rules[ruleLPAREN]() {
-						goto l1169
-					}
-					if !rules[ruleexpression]() {
-						goto l1169
-					}
-					if !rules[ruleCOMMA]() {
-						goto l1169
-					}
-					if !rules[ruleexpression]() {
-						goto l1169
-					}
-					if !rules[ruleRPAREN]() {
-						goto l1169
-					}
-					goto l805
-				l1169:
-					position, tokenIndex, depth = position805, tokenIndex805, depth805
-					{
-						position1332 := position
-						depth++
-						{
-							position1333, tokenIndex1333, depth1333 := position, tokenIndex, depth
-							if buffer[position] != rune('b') {
-								goto l1334
+						l1535:
+							{
+								position1537, tokenIndex1537, depth1537 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1538
+								}
+								position++
+								goto l1537
+							l1538:
+								position, tokenIndex, depth = position1537, tokenIndex1537, depth1537
+								if buffer[position] != rune('C') {
+									goto l1529
+								}
+								position++
 							}
-							position++
-							goto l1333
-						l1334:
-							position, tokenIndex, depth = position1333, tokenIndex1333, depth1333
-							if buffer[position] != rune('B') {
-								goto l1331
+						l1537:
+							{
+								position1539, tokenIndex1539, depth1539 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1540
+								}
+								position++
+								goto l1539
+							l1540:
+								position, tokenIndex, depth = position1539, tokenIndex1539, depth1539
+								if buffer[position] != rune('A') {
+									goto l1529
+								}
+								position++
 							}
-							position++
-						}
-					l1333:
-						{
-							position1335, tokenIndex1335, depth1335 := position, tokenIndex, depth
-							if buffer[position] != rune('o') {
-								goto l1336
+						l1539:
+							{
+								position1541, tokenIndex1541, depth1541 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1542
+								}
+								position++
+								goto l1541
+							l1542:
+								position, tokenIndex, depth = position1541, tokenIndex1541, depth1541
+								if buffer[position] != rune('T') {
+									goto l1529
+								}
+								position++
 							}
-							position++
-							goto l1335
-						l1336:
-							position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
-							if buffer[position] != rune('O') {
-								goto l1331
+						l1541:
+							if !_rules[ruleskip]() {
+								goto l1529
 							}
-							position++
+							depth--
+							add(ruleCONCAT, position1530)
 						}
-					l1335:
+						goto l1528
+					l1529:
+						position, tokenIndex, depth = position1528, tokenIndex1528, depth1528
 						{
-							position1337, tokenIndex1337, depth1337 := position, tokenIndex, depth
-							if buffer[position] != rune('u') {
-								goto l1338
+							position1543 := position
+							depth++
+							{
+								position1544, tokenIndex1544, depth1544 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1545
+								}
+								position++
+								goto l1544
+							l1545:
+								position, tokenIndex, depth = position1544, tokenIndex1544, depth1544
+								if buffer[position] != rune('C') {
+									goto l1527
+								}
+								position++
 							}
-							position++
-							goto l1337
-						l1338:
-							position, tokenIndex, depth = position1337, tokenIndex1337, depth1337
-							if buffer[position] != rune('U') {
-								goto l1331
+						l1544:
+							{
+								position1546, tokenIndex1546, depth1546 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1547
+								}
+								position++
+								goto l1546
+							l1547:
+								position, tokenIndex, depth = position1546, tokenIndex1546, depth1546
+								if buffer[position] != rune('O') {
+									goto l1527
+								}
+								position++
 							}
-							position++
-						}
-					l1337:
-						{
-							position1339, tokenIndex1339, depth1339 := position, tokenIndex, depth
-							if buffer[position] != rune('n') {
-								goto l1340
+						l1546:
+							{
+								position1548, tokenIndex1548, depth1548 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1549
+								}
+								position++
+								goto l1548
+							l1549:
+								position, tokenIndex, depth = position1548, tokenIndex1548, depth1548
+								if buffer[position] != rune('A') {
+									goto l1527
+								}
+								position++
 							}
-							position++
-							goto l1339
-						l1340:
-							position, tokenIndex, depth = position1339, tokenIndex1339, depth1339
-							if buffer[position] != rune('N') {
-								goto l1331
+						l1548:
+							{
+								position1550, tokenIndex1550, depth1550 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1551
+								}
+								position++
+								goto l1550
+							l1551:
+								position, tokenIndex, depth = position1550, tokenIndex1550, depth1550
+								if buffer[position] != rune('L') {
+									goto l1527
+								}
+								position++
 							}
-							position++
-						}
-					l1339:
-						{
-							position1341, tokenIndex1341, depth1341 := position, tokenIndex, depth
-							if buffer[position] != rune('d') {
-								goto l1342
+						l1550:
+							{
+								position1552, tokenIndex1552, depth1552 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1553
+								}
+								position++
+								goto l1552
+							l1553:
+								position, tokenIndex, depth = position1552, tokenIndex1552, depth1552
+								if buffer[position] != rune('E') {
+									goto l1527
+								}
+								position++
 							}
-							position++
-							goto l1341
-						l1342:
-							position, tokenIndex, depth = position1341, tokenIndex1341, depth1341
-							if buffer[position] != rune('D') {
-								goto l1331
+						l1552:
+							{
+								position1554, tokenIndex1554, depth1554 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1555
+								}
+								position++
+								goto l1554
+							l1555:
+								position, tokenIndex, depth = position1554, tokenIndex1554, depth1554
+								if buffer[position] != rune('S') {
+									goto l1527
+								}
+								position++
 							}
-							position++
-						}
-					l1341:
-						if !rules[ruleskip]() {
-							goto l1331
+						l1554:
+							{
+								position1556, tokenIndex1556, depth1556 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1557
+								}
+								position++
+								goto l1556
+							l1557:
+								position, tokenIndex, depth = position1556, tokenIndex1556, depth1556
+								if buffer[position] != rune('C') {
+									goto l1527
+								}
+								position++
+							}
+						l1556:
+							{
+								position1558, tokenIndex1558, depth1558 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1559
+								}
+								position++
+								goto l1558
+							l1559:
+								position, tokenIndex, depth = position1558, tokenIndex1558, depth1558
+								if buffer[position] != rune('E') {
+									goto l1527
+								}
+								position++
+							}
+						l1558:
+							if !_rules[ruleskip]() {
+								goto l1527
+							}
+							depth--
+							add(ruleCOALESCE, position1543)
 						}
-						depth--
-						add(ruleBOUND, position1332)
 					}
-					if !rules[ruleLPAREN]() {
-						goto l1331
+				l1528:
+					if !_rules[ruleargList]() {
+						goto l1527
 					}
-					if !rules[rulevar]() {
-						goto l1331
-					}
-					if !rules[ruleRPAREN]() {
-						goto l1331
-					}
-					goto l805
-				l1331:
-					position, tokenIndex, depth = position805, tokenIndex805, depth805
+					goto l917
+				l1527:
+					position, tokenIndex, depth = position917, tokenIndex917, depth917
 					{
-						switch buffer[position] {
-						case 'S', 's':
+						position1561, tokenIndex1561, depth1561 := position, tokenIndex, depth
+						{
+							position1563 := position
+							depth++
 							{
-								position1345 := position
-								depth++
-								{
-									position1346, tokenIndex1346, depth1346 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l1347
-									}
-									position++
-									goto l1346
-								l1347:
-									position, tokenIndex, depth = position1346, tokenIndex1346, depth1346
-									if buffer[position] != rune('S') {
-										goto l1343
-									}
-									position++
+								position1564, tokenIndex1564, depth1564 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1565
 								}
-							l1346:
-								{
-									position1348, tokenIndex1348, depth1348 := position, tokenIndex, depth
-									if buffer[position] != rune('t') {
-										goto l1349
-									}
-									position++
-									goto l1348
-								l1349:
-									position, tokenIndex, depth = position1348, tokenIndex1348, depth1348
-									if buffer[position] != rune('T') {
-										goto l1343
-									}
-									position++
+								position++
+								goto l1564
+							l1565:
+								position, tokenIndex, depth = position1564, tokenIndex1564, depth1564
+								if buffer[position] != rune('S') {
+									goto l1562
 								}
-							l1348:
-								{
-									position1350, tokenIndex1350, depth1350 := position, tokenIndex, depth
-									if buffer[position] != rune('r') {
-										goto l1351
-									}
-									position++
-									goto l1350
-								l1351:
-									position, tokenIndex, depth = position1350, tokenIndex1350, depth1350
-									if buffer[position] != rune('R') {
-										goto l1343
-									}
-									position++
+								position++
+							}
+						l1564:
+							{
+								position1566, tokenIndex1566, depth1566 := position, tokenIndex, depth
+								if buffer[position] != rune('u') {
+									goto l1567
 								}
-							l1350:
-								{
-									position1352, tokenIndex1352, depth1352 := position, tokenIndex, depth
-									if buffer[position] != rune('u') {
-										goto l1353
-									}
-									position++
-									goto l1352
-								l1353:
-									position, tokenIndex, depth = position1352, tokenIndex1352, depth1352
-									if buffer[position] != rune('U') {
-										goto l1343
-									}
-									position++
+								position++
+								goto l1566
+							l1567:
+								position, tokenIndex, depth = position1566, tokenIndex1566, depth1566
+								if buffer[position] != rune('U') {
+									goto l1562
 								}
-							l1352:
-								{
-									position1354, tokenIndex1354, depth1354 := position, tokenIndex, depth
-									if buffer[position] != rune('u') {
-										goto l1355
-									}
-									position++
-									goto l1354
-								l1355:
-									position, tokenIndex, depth = position1354, tokenIndex1354, depth1354
-									if buffer[position] != rune('U') {
-										goto l1343
-									}
-									position++
+								position++
+							}
+						l1566:
+							{
+								position1568, tokenIndex1568, depth1568 := position, tokenIndex, depth
+								if buffer[position] != rune('b') {
+									goto l1569
 								}
-							l1354:
-								{
-									position1356, tokenIndex1356, depth1356 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l1357
-									}
-									position++
-									goto l1356
-								l1357:
-									position, tokenIndex, depth = position1356, tokenIndex1356, depth1356
-									if buffer[position] != rune('I') {
-										goto l1343
-									}
-									position++
+								position++
+								goto l1568
+							l1569:
+								position, tokenIndex, depth = position1568, tokenIndex1568, depth1568
+								if buffer[position] != rune('B') {
+									goto l1562
 								}
-							l1356:
-								{
-									position1358, tokenIndex1358, depth1358 := position, tokenIndex, depth
-									if buffer[position] != rune('d') {
-										goto l1359
-									}
-									position++
-									goto l1358
-								l1359:
-									position, tokenIndex, depth = position1358, tokenIndex1358, depth1358
-									if buffer[position] != rune('D') {
-										goto l1343
-									}
-									position++
+								position++
+							}
+						l1568:
+							{
+								position1570, tokenIndex1570, depth1570 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1571
 								}
-							l1358:
-								if !rules[ruleskip]() {
-									goto l1343
+								position++
+								goto l1570
+							l1571:
+								position, tokenIndex, depth = position1570, tokenIndex1570, depth1570
+								if buffer[position] != rune('S') {
+									goto l1562
 								}
-								depth--
-								add(ruleSTRUUID, position1345)
+								position++
 							}
-							break
-						case 'U', 'u':
+						l1570:
 							{
-								position1360 := position
-								depth++
-								{
-									position1361, tokenIndex1361, depth1361 := position, tokenIndex, depth
-									if buffer[position] != rune('u') {
-										goto l1362
-									}
-									position++
-									goto l1361
-								l1362:
-									position, tokenIndex, depth = position1361, tokenIndex1361, depth1361
-									if buffer[position] != rune('U') {
-										goto l1343
-									}
-									position++
+								position1572, tokenIndex1572, depth1572 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1573
 								}
-							l1361:
-								{
-									position1363, tokenIndex1363, depth1363 := position, tokenIndex, depth
-									if buffer[position] != rune('u') {
-										goto l1364
-									}
-									position++
-									goto l1363
-								l1364:
-									position, tokenIndex, depth = position1363, tokenIndex1363, depth1363
-									if buffer[position] != rune('U') {
-										goto l1343
-									}
-									position++
+								position++
+								goto l1572
+							l1573:
+								position, tokenIndex, depth = position1572, tokenIndex1572, depth1572
+								if buffer[position] != rune('T') {
+									goto l1562
 								}
-							l1363:
-								{
-									position1365, tokenIndex1365, depth1365 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l1366
-									}
-									position++
-									goto l1365
-								l1366:
-									position, tokenIndex, depth = position1365, tokenIndex1365, depth1365
-									if buffer[position] != rune('I') {
-										goto l1343
-									}
-									position++
+								position++
+							}
+						l1572:
+							{
+								position1574, tokenIndex1574, depth1574 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1575
+								}
+								position++
+								goto l1574
+							l1575:
+								position, tokenIndex, depth = position1574, tokenIndex1574, depth1574
+								if buffer[position] != rune('R') {
+									goto l1562
+								}
+								position++
+							}
+						l1574:
+							if !_rules[ruleskip]() {
+								goto l1562
+							}
+							depth--
+							add(ruleSUBSTR, position1563)
+						}
+						goto l1561
+					l1562:
+						position, tokenIndex, depth = position1561, tokenIndex1561, depth1561
+						{
+							position1577 := position
+							depth++
+							{
+								position1578, tokenIndex1578, depth1578 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1579
 								}
-							l1365:
-								{
-									position1367, tokenIndex1367, depth1367 := position, tokenIndex, depth
-									if buffer[position] != rune('d') {
-										goto l1368
-									}
-									position++
-									goto l1367
-								l1368:
-									position, tokenIndex, depth = position1367, tokenIndex1367, depth1367
-									if buffer[position] != rune('D') {
-										goto l1343
-									}
-									position++
+								position++
+								goto l1578
+							l1579:
+								position, tokenIndex, depth = position1578, tokenIndex1578, depth1578
+								if buffer[position] != rune('R') {
+									goto l1576
 								}
-							l1367:
-								if !rules[ruleskip]() {
-									goto l1343
+								position++
+							}
+						l1578:
+							{
+								position1580, tokenIndex1580, depth1580 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1581
 								}
-								depth--
-								add(ruleUUID, position1360)
+								position++
+								goto l1580
+							l1581:
+								position, tokenIndex, depth = position1580, tokenIndex1580, depth1580
+								if buffer[position] != rune('E') {
+									goto l1576
+								}
+								position++
 							}
-							break
-						case 'N', 'n':
+						l1580:
 							{
-								position1369 := position
-								depth++
-								{
-									position1370, tokenIndex1370, depth1370 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l1371
-									}
-									position++
-									goto l1370
-								l1371:
-									position, tokenIndex, depth = position1370, tokenIndex1370, depth1370
-									if buffer[position] != rune('N') {
-										goto l1343
-									}
-									position++
+								position1582, tokenIndex1582, depth1582 := position, tokenIndex, depth
+								if buffer[position] != rune('p') {
+									goto l1583
 								}
-							l1370:
-								{
-									position1372, tokenIndex1372, depth1372 := position, tokenIndex, depth
-									if buffer[position] != rune('o') {
-										goto l1373
-									}
-									position++
-									goto l1372
-								l1373:
-									position, tokenIndex, depth = position1372, tokenIndex1372, depth1372
-									if buffer[position] != rune('O') {
-										goto l1343
-									}
-									position++
+								position++
+								goto l1582
+							l1583:
+								position, tokenIndex, depth = position1582, tokenIndex1582, depth1582
+								if buffer[position] != rune('P') {
+									goto l1576
 								}
-							l1372:
-								{
-									position1374, tokenIndex1374, depth1374 := position, tokenIndex, depth
-									if buffer[position] != rune('w') {
-										goto l1375
-									}
-									position++
-									goto l1374
-								l1375:
-									position, tokenIndex, depth = position1374, tokenIndex1374, depth1374
-									if buffer[position] != rune('W') {
-										goto l1343
-									}
-									position++
+								position++
+							}
+						l1582:
+							{
+								position1584, tokenIndex1584, depth1584 := position, tokenIndex, depth
+								if buffer[position] != rune('l') {
+									goto l1585
 								}
-							l1374:
-								if !rules[ruleskip]() {
-									goto l1343
+								position++
+								goto l1584
+							l1585:
+								position, tokenIndex, depth = position1584, tokenIndex1584, depth1584
+								if buffer[position] != rune('L') {
+									goto l1576
 								}
-								depth--
-								add(ruleNOW, position1369)
+								position++
 							}
-							break
-						default:
+						l1584:
 							{
-								position1376 := position
-								depth++
-								{
-									position1377, tokenIndex1377, depth1377 := position, tokenIndex, depth
-									if buffer[position] != rune('r') {
-										goto l1378
-									}
-									position++
-									goto l1377
-								l1378:
-									position, tokenIndex, depth = position1377, tokenIndex1377, depth1377
-									if buffer[position] != rune('R') {
-										goto l1343
-									}
-									position++
+								position1586, tokenIndex1586, depth1586 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1587
 								}
-							l1377:
-								{
-									position1379, tokenIndex1379, depth1379 := position, tokenIndex, depth
-									if buffer[position] != rune('a') {
-										goto l1380
-									}
-									position++
-									goto l1379
-								l1380:
-									position, tokenIndex, depth = position1379, tokenIndex1379, depth1379
-									if buffer[position] != rune('A') {
-										goto l1343
-									}
-									position++
+								position++
+								goto l1586
+							l1587:
+								position, tokenIndex, depth = position1586, tokenIndex1586, depth1586
+								if buffer[position] != rune('A') {
+									goto l1576
 								}
-							l1379:
-								{
-									position1381, tokenIndex1381, depth1381 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l1382
-									}
-									position++
-									goto l1381
-								l1382:
-									position, tokenIndex, depth = position1381, tokenIndex1381, depth1381
-									if buffer[position] != rune('N') {
-										goto l1343
-									}
-									position++
+								position++
+							}
+						l1586:
+							{
+								position1588, tokenIndex1588, depth1588 := position, tokenIndex, depth
+								if buffer[position] != rune('c') {
+									goto l1589
 								}
-							l1381:
-								{
-									position1383, tokenIndex1383, depth1383 := position, tokenIndex, depth
-									if buffer[position] != rune('d') {
-										goto l1384
-									}
-									position++
-									goto l1383
-								l1384:
-									position, tokenIndex, depth = position1383, tokenIndex1383, depth1383
-									if buffer[position] != rune('D') {
-										goto l1343
-									}
-									position++
+								position++
+								goto l1588
+							l1589:
+								position, tokenIndex, depth = position1588, tokenIndex1588, depth1588
+								if buffer[position] != rune('C') {
+									goto l1576
+								}
+								position++
+							}
+						l1588:
+							{
+								position1590, tokenIndex1590, depth1590 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1591
 								}
-							l1383:
-								if !rules[ruleskip]() {
-									goto l1343
+								position++
+								goto l1590
+							l1591:
+								position, tokenIndex, depth = position1590, tokenIndex1590, depth1590
+								if buffer[position] != rune('E') {
+									goto l1576
 								}
-								depth--
-								add(ruleRAND, position1376)
+								position++
+							}
+						l1590:
+							if !_rules[ruleskip]() {
+								goto l1576
 							}
-							break
+							depth--
+							add(ruleREPLACE, position1577)
 						}
-					}
-
-					if !rules[rulenil]() {
-						goto l1343
-					}
-					goto l805
-				l1343:
-					position, tokenIndex, depth = position805, tokenIndex805, depth805
-					{
-						switch buffer[position] {
-						case 'E', 'N', 'e', 'n':
+						goto l1561
+					l1576:
+						position, tokenIndex, depth = position1561, tokenIndex1561, depth1561
+						{
+							position1592 := position
+							depth++
 							{
-								position1386, tokenIndex1386, depth1386 := position, tokenIndex, depth
-								{
-									position1388 := position
-									depth++
-									{
-										position1389, tokenIndex1389, depth1389 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1390
-										}
-										position++
-										goto l1389
-									l1390:
-										position, tokenIndex, depth = position1389, tokenIndex1389, depth1389
-										if buffer[position] != rune('E') {
-											goto l1387
-										}
-										position++
-									}
-								l1389:
-									{
-										position1391, tokenIndex1391, depth1391 := position, tokenIndex, depth
-										if buffer[position] != rune('x') {
-											goto l1392
-										}
-										position++
-										goto l1391
-									l1392:
-										position, tokenIndex, depth = position1391, tokenIndex1391, depth1391
-										if buffer[position] != rune('X') {
-											goto l1387
-										}
-										position++
-									}
-								l1391:
-									{
-										position1393, tokenIndex1393, depth1393 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1394
-										}
-										position++
-										goto l1393
-									l1394:
-										position, tokenIndex, depth = position1393, tokenIndex1393, depth1393
-										if buffer[position] != rune('I') {
-											goto l1387
-										}
-										position++
-									}
-								l1393:
-									{
-										position1395, tokenIndex1395, depth1395 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1396
-										}
-										position++
-										goto l1395
-									l1396:
-										position, tokenIndex, depth = position1395, tokenIndex1395, depth1395
-										if buffer[position] != rune('S') {
-											goto l1387
-										}
-										position++
-									}
-								l1395:
-									{
-										position1397, tokenIndex1397, depth1397 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1398
-										}
-										position++
-										goto l1397
-									l1398:
-										position, tokenIndex, depth = position1397, tokenIndex1397, depth1397
-										if buffer[position] != rune('T') {
-											goto l1387
-										}
-										position++
-									}
-								l1397:
-									{
-										position1399, tokenIndex1399, depth1399 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1400
-										}
-										position++
-										goto l1399
-									l1400:
-										position, tokenIndex, depth = position1399, tokenIndex1399, depth1399
-										if buffer[position] != rune('S') {
-											goto l1387
-										}
-										position++
-									}
-								l1399:
-									if !rules[ruleskip]() {
-										goto l1387
-									}
-									depth--
-									add(ruleEXISTS, position1388)
+								position1593, tokenIndex1593, depth1593 := position, tokenIndex, depth
+								if buffer[position] != rune('r') {
+									goto l1594
 								}
-								goto l1386
-							l1387:
-								position, tokenIndex, depth = position1386, tokenIndex1386, depth1386
-								{
-									position1401 := position
-									depth++
-									{
-										position1402, tokenIndex1402, depth1402 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1403
-										}
-										position++
-										goto l1402
-									l1403:
-										position, tokenIndex, depth = position1402, tokenIndex1402, depth1402
-										if buffer[position] != rune('N') {
-											goto l803
-										}
-										position++
-									}
-								l1402:
-									{
-										position1404, tokenIndex1404, depth1404 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1405
-										}
-										position++
-										goto l1404
-									l1405:
-										position, tokenIndex, depth = position1404, tokenIndex1404, depth1404
-										if buffer[position] != rune('O') {
-											goto l803
-										}
-										position++
-									}
-								l1404:
-									{
-										position1406, tokenIndex1406, depth1406 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1407
-										}
-										position++
-										goto l1406
-									l1407:
-										position, tokenIndex, depth = position1406, tokenIndex1406, depth1406
-										if buffer[position] != rune('T') {
-											goto l803
-										}
-										position++
-									}
-								l1406:
-									if buffer[position] != rune(' ') {
-										goto l803
-									}
-									position++
-									{
-										position1408, tokenIndex1408, depth1408 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1409
-										}
-										position++
-										goto l1408
-									l1409:
-										position, tokenIndex, depth = position1408, tokenIndex1408, depth1408
-										if buffer[position] != rune('E') {
-											goto l803
-										}
-										position++
-									}
-								l1408:
-									{
-										position1410, tokenIndex1410, depth1410 := position, tokenIndex, depth
-										if buffer[position] != rune('x') {
-											goto l1411
-										}
-										position++
-										goto l1410
-									l1411:
-										position, tokenIndex, depth = position1410, tokenIndex1410, depth1410
-										if buffer[position] != rune('X') {
-											goto l803
-										}
-										position++
-									}
-								l1410:
-									{
-										position1412, tokenIndex1412, depth1412 := position, tokenIndex, depth
-										if buffer[position] != rune('i') {
-											goto l1413
-										}
-										position++
-										goto l1412
-									l1413:
-										position, tokenIndex, depth = position1412, tokenIndex1412, depth1412
-										if buffer[position] != rune('I') {
-											goto l803
-										}
-										position++
-									}
-								l1412:
-									{
-										position1414, tokenIndex1414, depth1414 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1415
-										}
-										position++
-										goto l1414
-									l1415:
-										position, tokenIndex, depth = position1414, tokenIndex1414, depth1414
-										if buffer[position] != rune('S') {
-											goto l803
-										}
-										position++
-									}
-								l1414:
-									{
-										position1416, tokenIndex1416, depth1416 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1417
-										}
-										position++
-										goto l1416
-									l1417:
-										position, tokenIndex, depth = position1416, tokenIndex1416, depth1416
-										if buffer[position] != rune('T') {
-											goto l803
-										}
-										position++
-									}
-								l1416:
-									{
-										position1418, tokenIndex1418, depth1418 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1419
-										}
-										position++
-										goto l1418
-									l1419:
-										position, tokenIndex, depth = position1418, tokenIndex1418, depth1418
-										if buffer[position] != rune('S') {
-											goto l803
-										}
-										position++
-									}
-								l1418:
-									if !rules[ruleskip]() {
-										goto l803
-									}
-									depth--
-									add(ruleNOTEXIST, position1401)
+								position++
+								goto l1593
+							l1594:
+								position, tokenIndex, depth = position1593, tokenIndex1593, depth1593
+								if buffer[position] != rune('R') {
+									goto l1560
+								}
+								position++
+							}
+						l1593:
+							{
+								position1595, tokenIndex1595, depth1595 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1596
 								}
+								position++
+								goto l1595
+							l1596:
+								position, tokenIndex, depth = position1595, tokenIndex1595, depth1595
+								if buffer[position] != rune('E') {
+									goto l1560
+								}
+								position++
 							}
-						l1386:
-							if !rules[rulegroupGraphPattern]() {
-								goto l803
+						l1595:
+							{
+								position1597, tokenIndex1597, depth1597 := position, tokenIndex, depth
+								if buffer[position] != rune('g') {
+									goto l1598
+								}
+								position++
+								goto l1597
+							l1598:
+								position, tokenIndex, depth = position1597, tokenIndex1597, depth1597
+								if buffer[position] != rune('G') {
+									goto l1560
+								}
+								position++
 							}
-							break
-						case 'I', 'i':
+						l1597:
 							{
-								position1420 := position
-								depth++
-								{
-									position1421, tokenIndex1421, depth1421 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l1422
-									}
-									position++
-									goto l1421
-								l1422:
-									position, tokenIndex, depth = position1421, tokenIndex1421, depth1421
-									if buffer[position] != rune('I') {
-										goto l803
-									}
-									position++
+								position1599, tokenIndex1599, depth1599 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1600
 								}
-							l1421:
-								{
-									position1423, tokenIndex1423, depth1423 := position, tokenIndex, depth
-									if buffer[position] != rune('f') {
-										goto l1424
-									}
-									position++
-									goto l1423
-								l1424:
-									position, tokenIndex, depth = position1423, tokenIndex1423, depth1423
-									if buffer[position] != rune('F') {
-										goto l803
-									}
-									position++
+								position++
+								goto l1599
+							l1600:
+								position, tokenIndex, depth = position1599, tokenIndex1599, depth1599
+								if buffer[position] != rune('E') {
+									goto l1560
 								}
-							l1423:
-								if !rules[ruleskip]() {
-									goto l803
+								position++
+							}
+						l1599:
+							{
+								position1601, tokenIndex1601, depth1601 := position, tokenIndex, depth
+								if buffer[position] != rune('x') {
+									goto l1602
 								}
-								depth--
-								add(ruleIF, position1420)
+								position++
+								goto l1601
+							l1602:
+								position, tokenIndex, depth = position1601, tokenIndex1601, depth1601
+								if buffer[position] != rune('X') {
+									goto l1560
+								}
+								position++
 							}
-							if !rules[ruleLPAREN]() {
-								goto l803
+						l1601:
+							if !_rules[ruleskip]() {
+								goto l1560
 							}
-							if !rules[ruleexpression]() {
-								goto l803
+							depth--
+							add(ruleREGEX, position1592)
+						}
+					}
+				l1561:
+					if !_rules[ruleLPAREN]() {
+						goto l1560
+					}
+					if !_rules[ruleexpression]() {
+						goto l1560
+					}
+					if !_rules[ruleCOMMA]() {
+						goto l1560
+					}
+					if !_rules[ruleexpression]() {
+						goto l1560
+					}
+					{
+						position1603, tokenIndex1603, depth1603 := position, tokenIndex, depth
+						if !_rules[ruleCOMMA]() {
+							goto l1603
+						}
+						if !_rules[ruleexpression]() {
+							goto l1603
+						}
+						goto l1604
+					l1603:
+						position, tokenIndex, depth = position1603, tokenIndex1603, depth1603
+					}
+				l1604:
+					if !_rules[ruleRPAREN]() {
+						goto l1560
+					}
+					goto l917
+				l1560:
+					position, tokenIndex, depth = position917, tokenIndex917, depth917
+					{
+						position1606 := position
+						depth++
+						{
+							position1607, tokenIndex1607, depth1607 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1608
 							}
-							if !rules[ruleCOMMA]() {
-								goto l803
+							position++
+							goto l1607
+						l1608:
+							position, tokenIndex, depth = position1607, tokenIndex1607, depth1607
+							if buffer[position] != rune('I') {
+								goto l1605
 							}
-							if !rules[ruleexpression]() {
-								goto l803
+							position++
+						}
+					l1607:
+						{
+							position1609, tokenIndex1609, depth1609 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1610
 							}
-							if !rules[ruleCOMMA]() {
-								goto l803
+							position++
+							goto l1609
+						l1610:
+							position, tokenIndex, depth = position1609, tokenIndex1609, depth1609
+							if buffer[position] != rune('F') {
+								goto l1605
 							}
-							if !rules[ruleexpression]() {
-								goto l803
+							position++
+						}
+					l1609:
+						if !_rules[ruleskip]() {
+							goto l1605
+						}
+						depth--
+						add(ruleIF, position1606)
+					}
+					if !_rules[ruleLPAREN]() {
+						goto l1605
+					}
+					if !_rules[ruleexpression]() {
+						goto l1605
+					}
+					if !_rules[ruleCOMMA]() {
+						goto l1605
+					}
+					if !_rules[ruleexpression]() {
+						goto l1605
+					}
+					if !_rules[ruleCOMMA]() {
+						goto l1605
+					}
+					if !_rules[ruleexpression]() {
+						goto l1605
+					}
+					if !_rules[ruleRPAREN]() {
+						goto l1605
+					}
+					goto l917
+				l1605:
+					position, tokenIndex, depth = position917, tokenIndex917, depth917
+					{
+						position1611, tokenIndex1611, depth1611 := position, tokenIndex, depth
+						{
+							position1613 := position
+							depth++
+							{
+								position1614, tokenIndex1614, depth1614 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1615
+								}
+								position++
+								goto l1614
+							l1615:
+								position, tokenIndex, depth = position1614, tokenIndex1614, depth1614
+								if buffer[position] != rune('E') {
+									goto l1612
+								}
+								position++
 							}
-							if !rules[ruleRPAREN]() {
-								goto l803
+						l1614:
+							{
+								position1616, tokenIndex1616, depth1616 := position, tokenIndex, depth
+								if buffer[position] != rune('x') {
+									goto l1617
+								}
+								position++
+								goto l1616
+							l1617:
+								position, tokenIndex, depth = position1616, tokenIndex1616, depth1616
+								if buffer[position] != rune('X') {
+									goto l1612
+								}
+								position++
 							}
-							break
-						case 'C', 'c':
+						l1616:
 							{
-								position1425, tokenIndex1425, depth1425 := position, tokenIndex, depth
-								{
-									position1427 := position
-									depth++
-									{
-										position1428, tokenIndex1428, depth1428 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1429
-										}
-										position++
-										goto l1428
-									l1429:
-										position, tokenIndex, depth = position1428, tokenIndex1428, depth1428
-										if buffer[position] != rune('C') {
-											goto l1426
-										}
-										position++
-									}
-								l1428:
-									{
-										position1430, tokenIndex1430, depth1430 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1431
-										}
-										position++
-										goto l1430
-									l1431:
-										position, tokenIndex, depth = position1430, tokenIndex1430, depth1430
-										if buffer[position] != rune('O') {
-											goto l1426
-										}
-										position++
-									}
-								l1430:
-									{
-										position1432, tokenIndex1432, depth1432 := position, tokenIndex, depth
-										if buffer[position] != rune('n') {
-											goto l1433
-										}
-										position++
-										goto l1432
-									l1433:
-										position, tokenIndex, depth = position1432, tokenIndex1432, depth1432
-										if buffer[position] != rune('N') {
-											goto l1426
-										}
-										position++
-									}
-								l1432:
-									{
-										position1434, tokenIndex1434, depth1434 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1435
-										}
-										position++
-										goto l1434
-									l1435:
-										position, tokenIndex, depth = position1434, tokenIndex1434, depth1434
-										if buffer[position] != rune('C') {
-											goto l1426
-										}
-										position++
-									}
-								l1434:
-									{
-										position1436, tokenIndex1436, depth1436 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1437
-										}
-										position++
-										goto l1436
-									l1437:
-										position, tokenIndex, depth = position1436, tokenIndex1436, depth1436
-										if buffer[position] != rune('A') {
-											goto l1426
-										}
-										position++
-									}
-								l1436:
-									{
-										position1438, tokenIndex1438, depth1438 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1439
-										}
-										position++
-										goto l1438
-									l1439:
-										position, tokenIndex, depth = position1438, tokenIndex1438, depth1438
-										if buffer[position] != rune('T') {
-											goto l1426
-										}
-										position++
-									}
-								l1438:
-									if !rules[ruleskip]() {
-										goto l1426
-									}
-									depth--
-									add(ruleCONCAT, position1427)
+								position1618, tokenIndex1618, depth1618 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1619
 								}
-								goto l1425
-							l1426:
-								position, tokenIndex, depth = position1425, tokenIndex1425, depth1425
-								{
-									position1440 := position
-									depth++
-									{
-										position1441, tokenIndex1441, depth1441 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1442
-										}
-										position++
-										goto l1441
-									l1442:
-										position, tokenIndex, depth = position1441, tokenIndex1441, depth1441
-										if buffer[position] != rune('C') {
-											goto l803
-										}
-										position++
-									}
-								l1441:
-									{
-										position1443, tokenIndex1443, depth1443 := position, tokenIndex, depth
-										if buffer[position] != rune('o') {
-											goto l1444
-										}
-										position++
-										goto l1443
-									l1444:
-										position, tokenIndex, depth = position1443, tokenIndex1443, depth1443
-										if buffer[position] != rune('O') {
-											goto l803
-										}
-										position++
-									}
-								l1443:
-									{
-										position1445, tokenIndex1445, depth1445 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1446
-										}
-										position++
-										goto l1445
-									l1446:
-										position, tokenIndex, depth = position1445, tokenIndex1445, depth1445
-										if buffer[position] != rune('A') {
-											goto l803
-										}
-										position++
-									}
-								l1445:
-									{
-										position1447, tokenIndex1447, depth1447 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1448
-										}
-										position++
-										goto l1447
-									l1448:
-										position, tokenIndex, depth = position1447, tokenIndex1447, depth1447
-										if buffer[position] != rune('L') {
-											goto l803
-										}
-										position++
-									}
-								l1447:
-									{
-										position1449, tokenIndex1449, depth1449 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1450
-										}
-										position++
-										goto l1449
-									l1450:
-										position, tokenIndex, depth = position1449, tokenIndex1449, depth1449
-										if buffer[position] != rune('E') {
-											goto l803
-										}
-										position++
-									}
-								l1449:
-									{
-										position1451, tokenIndex1451, depth1451 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1452
-										}
-										position++
-										goto l1451
-									l1452:
-										position, tokenIndex, depth = position1451, tokenIndex1451, depth1451
-										if buffer[position] != rune('S') {
-											goto l803
-										}
-										position++
-									}
-								l1451:
-									{
-										position1453, tokenIndex1453, depth1453 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1454
-										}
-										position++
-										goto l1453
-									l1454:
-										position, tokenIndex, depth = position1453, tokenIndex1453, depth1453
-										if buffer[position] != rune('C') {
-											goto l803
-										}
-										position++
-									}
-								l1453:
-									{
-										position1455, tokenIndex1455, depth1455 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1456
-										}
-										position++
-										goto l1455
-									l1456:
-										position, tokenIndex, depth = position1455, tokenIndex1455, depth1455
-										if buffer[position] != rune('E') {
-											goto l803
-										}
-										position++
-									}
-								l1455:
-									if !rules[ruleskip]() {
-										goto l803
-									}
-									depth--
-									add(ruleCOALESCE, position1440)
+								position++
+								goto l1618
+							l1619:
+								position, tokenIndex, depth = position1618, tokenIndex1618, depth1618
+								if buffer[position] != rune('I') {
+									goto l1612
+								}
+								position++
+							}
+						l1618:
+							{
+								position1620, tokenIndex1620, depth1620 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1621
+								}
+								position++
+								goto l1620
+							l1621:
+								position, tokenIndex, depth = position1620, tokenIndex1620, depth1620
+								if buffer[position] != rune('S') {
+									goto l1612
+								}
+								position++
+							}
+						l1620:
+							{
+								position1622, tokenIndex1622, depth1622 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1623
+								}
+								position++
+								goto l1622
+							l1623:
+								position, tokenIndex, depth = position1622, tokenIndex1622, depth1622
+								if buffer[position] != rune('T') {
+									goto l1612
+								}
+								position++
+							}
+						l1622:
+							{
+								position1624, tokenIndex1624, depth1624 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1625
+								}
+								position++
+								goto l1624
+							l1625:
+								position, tokenIndex, depth = position1624, tokenIndex1624, depth1624
+								if buffer[position] != rune('S') {
+									goto l1612
 								}
+								position++
 							}
-						l1425:
-							if !rules[ruleargList]() {
-								goto l803
+						l1624:
+							if !_rules[ruleskip]() {
+								goto l1612
 							}
-							break
-						case 'B', 'b':
+							depth--
+							add(ruleEXISTS, position1613)
+						}
+						goto l1611
+					l1612:
+						position, tokenIndex, depth = position1611, tokenIndex1611, depth1611
+						{
+							position1626 := position
+							depth++
 							{
-								position1457 := position
-								depth++
-								{
-									position1458, tokenIndex1458, depth1458 := position, tokenIndex, depth
-									if buffer[position] != rune('b') {
-										goto l1459
-									}
-									position++
-									goto l1458
-								l1459:
-									position, tokenIndex, depth = position1458, tokenIndex1458, depth1458
-									if buffer[position] != rune('B') {
-										goto l803
-									}
-									position++
+								position1627, tokenIndex1627, depth1627 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1628
 								}
-							l1458:
-								{
-									position1460, tokenIndex1460, depth1460 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l1461
-									}
-									position++
-									goto l1460
-								l1461:
-									position, tokenIndex, depth = position1460, tokenIndex1460, depth1460
-									if buffer[position] != rune('N') {
-										goto l803
-									}
-									position++
+								position++
+								goto l1627
+							l1628:
+								position, tokenIndex, depth = position1627, tokenIndex1627, depth1627
+								if buffer[position] != rune('N') {
+									goto l915
 								}
-							l1460:
-								{
-									position1462, tokenIndex1462, depth1462 := position, tokenIndex, depth
-									if buffer[position] != rune('o') {
-										goto l1463
-									}
-									position++
-									goto l1462
-								l1463:
-									position, tokenIndex, depth = position1462, tokenIndex1462, depth1462
-									if buffer[position] != rune('O') {
-										goto l803
-									}
-									position++
+								position++
+							}
+						l1627:
+							{
+								position1629, tokenIndex1629, depth1629 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1630
 								}
-							l1462:
-								{
-									position1464, tokenIndex1464, depth1464 := position, tokenIndex, depth
-									if buffer[position] != rune('d') {
-										goto l1465
-									}
-									position++
-									goto l1464
-								l1465:
-									position, tokenIndex, depth = position1464, tokenIndex1464, depth1464
-									if buffer[position] != rune('D') {
-										goto l803
-									}
-									position++
+								position++
+								goto l1629
+							l1630:
+								position, tokenIndex, depth = position1629, tokenIndex1629, depth1629
+								if buffer[position] != rune('O') {
+									goto l915
 								}
-							l1464:
-								{
-									position1466, tokenIndex1466, depth1466 := position, tokenIndex, depth
-									if buffer[position] != rune('e') {
-										goto l1467
-									}
-									position++
-									goto l1466
-								l1467:
-									position, tokenIndex, depth = position1466, tokenIndex1466, depth1466
-									if buffer[position] != rune('E') {
-										goto l803
-									}
-									position++
+								position++
+							}
+						l1629:
+							{
+								position1631, tokenIndex1631, depth1631 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1632
 								}
-							l1466:
-								if !rules[ruleskip]() {
-									goto l803
+								position++
+								goto l1631
+							l1632:
+								position, tokenIndex, depth = position1631, tokenIndex1631, depth1631
+								if buffer[position] != rune('T') {
+									goto l915
 								}
-								depth--
-								add(ruleBNODE, position1457)
+								position++
 							}
+						l1631:
+							if buffer[position] != rune(' ') {
+								goto l915
+							}
+							position++
 							{
-								position1468, tokenIndex1468, depth1468 := position, tokenIndex, depth
-								if !rules[ruleLPAREN]() {
-									goto l1469
+								position1633, tokenIndex1633, depth1633 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1634
 								}
-								if !rules[ruleexpression]() {
-									goto l1469
+								position++
+								goto l1633
+							l1634:
+								position, tokenIndex, depth = position1633, tokenIndex1633, depth1633
+								if buffer[position] != rune('E') {
+									goto l915
 								}
-								if !rules[ruleRPAREN]() {
-									goto l1469
+								position++
+							}
+						l1633:
+							{
+								position1635, tokenIndex1635, depth1635 := position, tokenIndex, depth
+								if buffer[position] != rune('x') {
+									goto l1636
 								}
-								goto l1468
-							l1469:
-								position, tokenIndex, depth = position1468, tokenIndex1468, depth1468
-								if !rules[rulenil]() {
-									goto l803
+								position++
+								goto l1635
+							l1636:
+								position, tokenIndex, depth = position1635, tokenIndex1635, depth1635
+								if buffer[position] != rune('X') {
+									goto l915
 								}
+								position++
 							}
-						l1468:
-							break
-						default:
+						l1635:
 							{
-								position1470, tokenIndex1470, depth1470 := position, tokenIndex, depth
-								{
-									position1472 := position
-									depth++
-									{
-										position1473, tokenIndex1473, depth1473 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1474
-										}
-										position++
-										goto l1473
-									l1474:
-										position, tokenIndex, depth = position1473, tokenIndex1473, depth1473
-										if buffer[position] != rune('S') {
-											goto l1471
-										}
-										position++
-									}
-								l1473:
-									{
-										position1475, tokenIndex1475, depth1475 := position, tokenIndex, depth
-										if buffer[position] != rune('u') {
-											goto l1476
-										}
-										position++
-										goto l1475
-									l1476:
-										position, tokenIndex, depth = position1475, tokenIndex1475, depth1475
-										if buffer[position] != rune('U') {
-											goto l1471
-										}
-										position++
-									}
-								l1475:
-									{
-										position1477, tokenIndex1477, depth1477 := position, tokenIndex, depth
-										if buffer[position] != rune('b') {
-											goto l1478
-										}
-										position++
-										goto l1477
-									l1478:
-										position, tokenIndex, depth = position1477, tokenIndex1477, depth1477
-										if buffer[position] != rune('B') {
-											goto l1471
-										}
-										position++
-									}
-								l1477:
-									{
-										position1479, tokenIndex1479, depth1479 := position, tokenIndex, depth
-										if buffer[position] != rune('s') {
-											goto l1480
-										}
-										position++
-										goto l1479
-									l1480:
-										position, tokenIndex, depth = position1479, tokenIndex1479, depth1479
-										if buffer[position] != rune('S') {
-											goto l1471
-										}
-										position++
-									}
-								l1479:
-									{
-										position1481, tokenIndex1481, depth1481 := position, tokenIndex, depth
-										if buffer[position] != rune('t') {
-											goto l1482
-										}
-										position++
-										goto l1481
-									l1482:
-										position, tokenIndex, depth = position1481, tokenIndex1481, depth1481
-										if buffer[position] != rune('T') {
-											goto l1471
-										}
-										position++
-									}
-								l1481:
-									{
-										position1483, tokenIndex1483, depth1483 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1484
-										}
-										position++
-										goto l1483
-									l1484:
-										position, tokenIndex, depth = position1483, tokenIndex1483, depth1483
-										if buffer[position] != rune('R') {
-											goto l1471
-										}
-										position++
-									}
-								l1483:
-									if !rules[ruleskip]() {
-										goto l1471
-									}
-									depth--
-									add(ruleSUBSTR, position1472)
+								position1637, tokenIndex1637, depth1637 := position, tokenIndex, depth
+								if buffer[position] != rune('i') {
+									goto l1638
 								}
-								goto l1470
-							l1471:
-								position, tokenIndex, depth = position1470, tokenIndex1470, depth1470
-								{
-									position1486 := position
-									depth++
-									{
-										position1487, tokenIndex1487, depth1487 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1488
-										}
-										position++
-										goto l1487
-									l1488:
-										position, tokenIndex, depth = position1487, tokenIndex1487, depth1487
-										if buffer[position] != rune('R') {
-											goto l1485
-										}
-										position++
-									}
-								l1487:
-									{
-										position1489, tokenIndex1489, depth1489 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1490
-										}
-										position++
-										goto l1489
-									l1490:
-										position, tokenIndex, depth = position1489, tokenIndex1489, depth1489
-										if buffer[position] != rune('E') {
-											goto l1485
-										}
-										position++
-									}
-								l1489:
-									{
-										position1491, tokenIndex1491, depth1491 := position, tokenIndex, depth
-										if buffer[position] != rune('p') {
-											goto l1492
-										}
-										position++
-										goto l1491
-									l1492:
-										position, tokenIndex, depth = position1491, tokenIndex1491, depth1491
-										if buffer[position] != rune('P') {
-											goto l1485
-										}
-										position++
-									}
-								l1491:
-									{
-										position1493, tokenIndex1493, depth1493 := position, tokenIndex, depth
-										if buffer[position] != rune('l') {
-											goto l1494
-										}
-										position++
-										goto l1493
-									l1494:
-										position, tokenIndex, depth = position1493, tokenIndex1493, depth1493
-										if buffer[position] != rune('L') {
-											goto l1485
-										}
-										position++
-									}
-								l1493:
-									{
-										position1495, tokenIndex1495, depth1495 := position, tokenIndex, depth
-										if buffer[position] != rune('a') {
-											goto l1496
-										}
-										position++
-										goto l1495
-									l1496:
-										position, tokenIndex, depth = position1495, tokenIndex1495, depth1495
-										if buffer[position] != rune('A') {
-											goto l1485
-										}
-										position++
-									}
-								l1495:
-									{
-										position1497, tokenIndex1497, depth1497 := position, tokenIndex, depth
-										if buffer[position] != rune('c') {
-											goto l1498
-										}
-										position++
-										goto l1497
-									l1498:
-										position, tokenIndex, depth = position1497, tokenIndex1497, depth1497
-										if buffer[position] != rune('C') {
-											goto l1485
-										}
-										position++
-									}
-								l1497:
-									{
-										position1499, tokenIndex1499, depth1499 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1500
-										}
-										position++
-										goto l1499
-									l1500:
-										position, tokenIndex, depth = position1499, tokenIndex1499, depth1499
-										if buffer[position] != rune('E') {
-											goto l1485
-										}
-										position++
-									}
-								l1499:
-									if !rules[ruleskip]() {
-										goto l1485
-									}
-									depth--
-									add(ruleREPLACE, position1486)
+								position++
+								goto l1637
+							l1638:
+								position, tokenIndex, depth = position1637, tokenIndex1637, depth1637
+								if buffer[position] != rune('I') {
+									goto l915
 								}
-								goto l1470
-							l1485:
-								position, tokenIndex, depth = position1470, tokenIndex1470, depth1470
-								{
-									position1501 := position
-									depth++
-									{
-										position1502, tokenIndex1502, depth1502 := position, tokenIndex, depth
-										if buffer[position] != rune('r') {
-											goto l1503
-										}
-										position++
-										goto l1502
-									l1503:
-										position, tokenIndex, depth = position1502, tokenIndex1502, depth1502
-										if buffer[position] != rune('R') {
-											goto l803
-										}
-										position++
-									}
-								l1502:
-									{
-										position1504, tokenIndex1504, depth1504 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1505
-										}
-										position++
-										goto l1504
-									l1505:
-										position, tokenIndex, depth = position1504, tokenIndex1504, depth1504
-										if buffer[position] != rune('E') {
-											goto l803
-										}
-										position++
-									}
-								l1504:
-									{
-										position1506, tokenIndex1506, depth1506 := position, tokenIndex, depth
-										if buffer[position] != rune('g') {
-											goto l1507
-										}
-										position++
-										goto l1506
-									l1507:
-										position, tokenIndex, depth = position1506, tokenIndex1506, depth1506
-										if buffer[position] != rune('G') {
-											goto l803
-										}
-										position++
-									}
-								l1506:
-									{
-										position1508, tokenIndex1508, depth1508 := position, tokenIndex, depth
-										if buffer[position] != rune('e') {
-											goto l1509
-										}
-										position++
-										goto l1508
-									l1509:
-										position, tokenIndex, depth = position1508, tokenIndex1508, depth1508
-										if buffer[position] != rune('E') {
-											goto l803
-										}
-										position++
-									}
-								l1508:
-									{
-										position1510, tokenIndex1510, depth1510 := position, tokenIndex, depth
-										if buffer[position] != rune('x') {
-											goto l1511
-										}
-										position++
-										goto l1510
-									l1511:
-										position, tokenIndex, depth = position1510, tokenIndex1510, depth1510
-										if buffer[position] != rune('X') {
-											goto l803
-										}
-										position++
-									}
-								l1510:
-									if !rules[ruleskip]() {
-										goto l803
-									}
-									depth--
-									add(ruleREGEX, position1501)
+								position++
+							}
+						l1637:
+							{
+								position1639, tokenIndex1639, depth1639 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1640
+								}
+								position++
+								goto l1639
+							l1640:
+								position, tokenIndex, depth = position1639, tokenIndex1639, depth1639
+								if buffer[position] != rune('S') {
+									goto l915
+								}
+								position++
+							}
+						l1639:
+							{
+								position1641, tokenIndex1641, depth1641 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1642
+								}
+								position++
+								goto l1641
+							l1642:
+								position, tokenIndex, depth = position1641, tokenIndex1641, depth1641
+								if buffer[position] != rune('T') {
+									goto l915
 								}
+								position++
 							}
-						l1470:
-							if !rules[ruleLPAREN]() {
-								goto l803
-							}
-							if !rules[ruleexpression]() {
-								goto l803
-							}
-							if !rules[ruleCOMMA]() {
-								goto l803
-							}
-							if !rules[ruleexpression]() {
-								goto l803
-							}
+						l1641:
 							{
-								position1512, tokenIndex1512, depth1512 := position, tokenIndex, depth
-								if !rules[ruleCOMMA]() {
-									goto l1512
+								position1643, tokenIndex1643, depth1643 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1644
 								}
-								if !rules[ruleexpression]() {
-									goto l1512
+								position++
+								goto l1643
+							l1644:
+								position, tokenIndex, depth = position1643, tokenIndex1643, depth1643
+								if buffer[position] != rune('S') {
+									goto l915
 								}
-								goto l1513
-							l1512:
-								position, tokenIndex, depth = position1512, tokenIndex1512, depth1512
+								position++
 							}
-						l1513:
-							if !rules[ruleRPAREN]() {
-								goto l803
+						l1643:
+							if !_rules[ruleskip]() {
+								goto l915
 							}
-							break
+							depth--
+							add(ruleNOTEXIST, position1626)
 						}
 					}
-
+				l1611:
+					if !_rules[rulegroupGraphPattern]() {
+						goto l915
+					}
 				}
-			l805:
+			l917:
 				depth--
-				add(rulebuiltinCall, position804)
+				add(rulebuiltinCall, position916)
 			}
 			return true
-		l803:
-			position, tokenIndex, depth = position803, tokenIndex803, depth803
+		l915:
+			position, tokenIndex, depth = position915, tokenIndex915, depth915
 			return false
 		},
-		/* 70 var <- <(('?' / '$') VARNAME skip)> */
+		/* 79 var <- <(('?' / '$') VARNAME skip)> */
 		func() bool {
-			position1514, tokenIndex1514, depth1514 := position, tokenIndex, depth
+			position1645, tokenIndex1645, depth1645 := position, tokenIndex, depth
 			{
-				position1515 := position
+				position1646 := position
 				depth++
 				{
-					position1516, tokenIndex1516, depth1516 := position, tokenIndex, depth
+					position1647, tokenIndex1647, depth1647 := position, tokenIndex, depth
 					if buffer[position] != rune('?') {
-						goto l1517
+						goto l1648
 					}
 					position++
-					goto l1516
-				l1517:
-					position, tokenIndex, depth = position1516, tokenIndex1516, depth1516
+					goto l1647
+				l1648:
+					position, tokenIndex, depth = position1647, tokenIndex1647, depth1647
 					if buffer[position] != rune('$') {
-						goto l1514
+						goto l1645
 					}
 					position++
 				}
-			l1516:
+			l1647:
 				{
-					position1518 := position
+					position1649 := position
 					depth++
 					{
-						position1519, tokenIndex1519, depth1519 := position, tokenIndex, depth
-						if !rules[rulepnCharsU]() {
-							goto l1520
+						position1650, tokenIndex1650, depth1650 := position, tokenIndex, depth
+						if !_rules[rulepnCharsU]() {
+							goto l1651
 						}
-						goto l1519
-					l1520:
-						position, tokenIndex, depth = position1519, tokenIndex1519, depth1519
+						goto l1650
+					l1651:
+						position, tokenIndex, depth = position1650, tokenIndex1650, depth1650
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1514
+							goto l1645
 						}
 						position++
 					}
-				l1519:
-				l1521:
+				l1650:
+				l1652:
 					{
-						position1522, tokenIndex1522, depth1522 := position, tokenIndex, depth
+						position1653, tokenIndex1653, depth1653 := position, tokenIndex, depth
 						{
-							position1523, tokenIndex1523, depth1523 := position, tokenIndex, depth
-							if !rules[rulepnCharsU]() {
-								goto l1524
+							position1654, tokenIndex1654, depth1654 := position, tokenIndex, depth
+							if !_rules[rulepnCharsU]() {
+								goto l1655
 							}
-							goto l1523
-						l1524:
-							position, tokenIndex, depth = position1523, tokenIndex1523, depth1523
-							{
-								switch buffer[position] {
-								case 'â':
-									if c := buffer[position]; c < rune('‿') || c > rune('⁀') {
-										goto l1522
-									}
-									position++
-									break
-								case 'Ì', 'Í':
-									if c := buffer[position]; c < rune('̀') || c > rune('ͯ') {
-										goto l1522
-									}
-									position++
-									break
-								case 'Â':
-									if buffer[position] != rune('·') {
-										goto l1522
-									}
-									position++
-									break
-								default:
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l1522
-									}
-									position++
-									break
-								}
+							goto l1654
+						l1655:
+							position, tokenIndex, depth = position1654, tokenIndex1654, depth1654
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1656
 							}
-
+							position++
+							goto l1654
+						l1656:
+							position, tokenIndex, depth = position1654, tokenIndex1654, depth1654
+							if buffer[position] != rune('·') {
+								goto l1657
+							}
+							position++
+							goto l1654
+						l1657:
+							position, tokenIndex, depth = position1654, tokenIndex1654, depth1654
+							if c := buffer[position]; c < rune('̀') || c > rune('ͯ') {
+								goto l1658
+							}
+							position++
+							goto l1654
+						l1658:
+							position, tokenIndex, depth = position1654, tokenIndex1654, depth1654
+							if c := buffer[position]; c < rune('‿') || c > rune('⁀') {
+								goto l1653
+							}
+							position++
 						}
-					l1523:
-						goto l1521
-					l1522:
-						position, tokenIndex, depth = position1522, tokenIndex1522, depth1522
+					l1654:
+						goto l1652
+					l1653:
+						position, tokenIndex, depth = position1653, tokenIndex1653, depth1653
 					}
 					depth--
-					add(ruleVARNAME, position1518)
+					add(ruleVARNAME, position1649)
 				}
-				if !rules[ruleskip]() {
-					goto l1514
+				if !_rules[ruleskip]() {
+					goto l1645
 				}
 				depth--
-				add(rulevar, position1515)
+				add(rulevar, position1646)
 			}
 			return true
-		l1514:
-			position, tokenIndex, depth = position1514, tokenIndex1514, depth1514
+		l1645:
+			position, tokenIndex, depth = position1645, tokenIndex1645, depth1645
 			return false
 		},
-		/* 71 iriref <- <(iri / prefixedName)> */
+		/* 80 iriref <- <(iri / prefixedName)> */
 		func() bool {
-			position1526, tokenIndex1526, depth1526 := position, tokenIndex, depth
+			position1659, tokenIndex1659, depth1659 := position, tokenIndex, depth
 			{
-				position1527 := position
+				position1660 := position
 				depth++
 				{
-					position1528, tokenIndex1528, depth1528 := position, tokenIndex, depth
-					if !rules[ruleiri]() {
-						goto l1529
+					position1661, tokenIndex1661, depth1661 := position, tokenIndex, depth
+					if !_rules[ruleiri]() {
+						goto l1662
 					}
-					goto l1528
-				l1529:
-					position, tokenIndex, depth = position1528, tokenIndex1528, depth1528
+					goto l1661
+				l1662:
+					position, tokenIndex, depth = position1661, tokenIndex1661, depth1661
 					{
-						position1530 := position
+						position1663 := position
 						depth++
 						{
-							position1531, tokenIndex1531, depth1531 := position, tokenIndex, depth
-							if !rules[rulepnPrefix]() {
-								goto l1531
+							position1664, tokenIndex1664, depth1664 := position, tokenIndex, depth
+							if !_rules[rulepnPrefix]() {
+								goto l1664
 							}
-							goto l1532
-						l1531:
-							position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
+							goto l1665
+						l1664:
+							position, tokenIndex, depth = position1664, tokenIndex1664, depth1664
 						}
-					l1532:
+					l1665:
 						if buffer[position] != rune(':') {
-							goto l1526
+							goto l1659
 						}
 						position++
 						{
-							position1533 := position
-							depth++
+							position1666, tokenIndex1666, depth1666 := position, tokenIndex, depth
 							{
-								switch buffer[position] {
-								case '%', '\\':
-									{
-										position1537 := position
-										depth++
-										{
-											position1538, tokenIndex1538, depth1538 := position, tokenIndex, depth
-											{
-												position1540 := position
-												depth++
-												if buffer[position] != rune('%') {
-													goto l1539
-												}
-												position++
-												if !rules[rulehex]() {
-													goto l1539
-												}
-												if !rules[rulehex]() {
-													goto l1539
-												}
-												depth--
-												add(rulepercent, position1540)
-											}
-											goto l1538
-										l1539:
-											position, tokenIndex, depth = position1538, tokenIndex1538, depth1538
-											{
-												position1541 := position
-												depth++
-												if buffer[position] != rune('\\') {
-													goto l1526
-												}
-												position++
-												{
-													switch buffer[position] {
-													case '%':
-														if buffer[position] != rune('%') {
-															goto l1526
-														}
-														position++
-														break
-													case '@':
-														if buffer[position] != rune('@') {
-															goto l1526
-														}
-														position++
-														break
-													case '#':
-														if buffer[position] != rune('#') {
-															goto l1526
-														}
-														position++
-														break
-													case '?':
-														if buffer[position] != rune('?') {
-															goto l1526
-														}
-														position++
-														break
-													case '/':
-														if buffer[position] != rune('/') {
-															goto l1526
-														}
-														position++
-														break
-													case '=':
-														if buffer[position] != rune('=') {
-															goto l1526
-														}
-														position++
-														break
-													case ';':
-														if buffer[position] != rune(';') {
-															goto l1526
-														}
-														position++
-														break
-													case ',':
-														if buffer[position] != rune(',') {
-															goto l1526
-														}
-														position++
-														break
-													case '+':
-														if buffer[position] != rune('+') {
-															goto l1526
-														}
-														position++
-														break
-													case '*':
-														if buffer[position] != rune('*') {
-															goto l1526
-														}
-														position++
-														break
-													case ')':
-														if buffer[position] != rune(')') {
-															goto l1526
-														}
-														position++
-														break
-													case '(':
-														if buffer[position] != rune('(') {
-															goto l1526
-														}
-														position++
-														break
-													case '\'':
-														if buffer[position] != rune('\'') {
-															goto l1526
-														}
-														position++
-														break
-													case '&':
-														if buffer[position] != rune('&') {
-															goto l1526
-														}
-														position++
-														break
-													case '$':
-														if buffer[position] != rune('$') {
-															goto l1526
-														}
-														position++
-														break
-													case '!':
-														if buffer[position] != rune('!') {
-															goto l1526
-														}
-														position++
-														break
-													case '-':
-														if buffer[position] != rune('-') {
-															goto l1526
-														}
-														position++
-														break
-													case '.':
-														if buffer[position] != rune('.') {
-															goto l1526
-														}
-														position++
-														break
-													case '~':
-														if buffer[position] != rune('~') {
-															goto l1526
-														}
-														position++
-														break
-													default:
-														if buffer[position] != rune('_') {
-															goto l1526
-														}
-														position++
-														break
-													}
-												}
-
-												depth--
-												add(rulepnLocalEsc, position1541)
-											}
-										}
-									l1538:
-										depth--
-										add(ruleplx, position1537)
+								position1668 := position
+								depth++
+								{
+									position1669, tokenIndex1669, depth1669 := position, tokenIndex, depth
+									if !_rules[rulepnCharsU]() {
+										goto l1670
 									}
-									break
-								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l1526
+									goto l1669
+								l1670:
+									position, tokenIndex, depth = position1669, tokenIndex1669, depth1669
+									if buffer[position] != rune(':') {
+										goto l1671
 									}
 									position++
-									break
-								case ':':
-									if buffer[position] != rune(':') {
-										goto l1526
+									goto l1669
+								l1671:
+									position, tokenIndex, depth = position1669, tokenIndex1669, depth1669
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l1672
 									}
 									position++
-									break
-								default:
-									if !rules[rulepnCharsU]() {
-										goto l1526
+									goto l1669
+								l1672:
+									position, tokenIndex, depth = position1669, tokenIndex1669, depth1669
+									if !_rules[ruleplx]() {
+										goto l1666
 									}
-									break
 								}
-							}
-
-						l1534:
-							{
-								position1535, tokenIndex1535, depth1535 := position, tokenIndex, depth
+							l1669:
+							l1673:
 								{
-									switch buffer[position] {
-									case '%', '\\':
-										{
-											position1544 := position
-											depth++
-											{
-												position1545, tokenIndex1545, depth1545 := position, tokenIndex, depth
-												{
-													position1547 := position
-													depth++
-													if buffer[position] != rune('%') {
-														goto l1546
-													}
-													position++
-													if !rules[rulehex]() {
-														goto l1546
-													}
-													if !rules[rulehex]() {
-														goto l1546
-													}
-													depth--
-													add(rulepercent, position1547)
-												}
-												goto l1545
-											l1546:
-												position, tokenIndex, depth = position1545, tokenIndex1545, depth1545
-												{
-													position1548 := position
-													depth++
-													if buffer[position] != rune('\\') {
-														goto l1535
-													}
-													position++
-													{
-														switch buffer[position] {
-														case '%':
-															if buffer[position] != rune('%') {
-																goto l1535
-															}
-															position++
-															break
-														case '@':
-															if buffer[position] != rune('@') {
-																goto l1535
-															}
-															position++
-															break
-														case '#':
-															if buffer[position] != rune('#') {
-																goto l1535
-															}
-															position++
-															break
-														case '?':
-															if buffer[position] != rune('?') {
-																goto l1535
-															}
-															position++
-															break
-														case '/':
-															if buffer[position] != rune('/') {
-																goto l1535
-															}
-															position++
-															break
-														case '=':
-															if buffer[position] != rune('=') {
-																goto l1535
-															}
-															position++
-															break
-														case ';':
-															if buffer[position] != rune(';') {
-																goto l1535
-															}
-															position++
-															break
-														case ',':
-															if buffer[position] != rune(',') {
-																goto l1535
-															}
-															position++
-															break
-														case '+':
-															if buffer[position] != rune('+') {
-																goto l1535
-															}
-															position++
-															break
-														case '*':
-															if buffer[position] != rune('*') {
-																goto l1535
-															}
-															position++
-															break
-														case ')':
-															if buffer[position] != rune(')') {
-																goto l1535
-															}
-															position++
-															break
-														case '(':
-															if buffer[position] != rune('(') {
-																goto l1535
-															}
-															position++
-															break
-														case '\'':
-															if buffer[position] != rune('\'') {
-																goto l1535
-															}
-															position++
-															break
-														case '&':
-															if buffer[position] != rune('&') {
-																goto l1535
-															}
-															position++
-															break
-														case '$':
-															if buffer[position] != rune('$') {
-																goto l1535
-															}
-															position++
-															break
-														case '!':
-															if buffer[position] != rune('!') {
-																goto l1535
-															}
-															position++
-															break
-														case '-':
-															if buffer[position] != rune('-') {
-																goto l1535
-															}
-															position++
-															break
-														case '.':
-															if buffer[position] != rune('.') {
-																goto l1535
-															}
-															position++
-															break
-														case '~':
-															if buffer[position] != rune('~') {
-																goto l1535
-															}
-															position++
-															break
-														default:
-															if buffer[position] != rune('_') {
-																goto l1535
-															}
-															position++
-															break
-														}
-													}
-
-													depth--
-													add(rulepnLocalEsc, position1548)
-												}
-											}
-										l1545:
-											depth--
-											add(ruleplx, position1544)
-										}
-										break
-									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l1535
+									position1674, tokenIndex1674, depth1674 := position, tokenIndex, depth
+								l1675:
+									{
+										position1676, tokenIndex1676, depth1676 := position, tokenIndex, depth
+										if buffer[position] != rune('.') {
+											goto l1676
 										}
 										position++
-										break
-									case ':':
+										goto l1675
+									l1676:
+										position, tokenIndex, depth = position1676, tokenIndex1676, depth1676
+									}
+									{
+										position1677, tokenIndex1677, depth1677 := position, tokenIndex, depth
+										if !_rules[rulepnChars]() {
+											goto l1678
+										}
+										goto l1677
+									l1678:
+										position, tokenIndex, depth = position1677, tokenIndex1677, depth1677
 										if buffer[position] != rune(':') {
-											goto l1535
+											goto l1679
 										}
 										position++
-										break
-									default:
-										if !rules[rulepnCharsU]() {
-											goto l1535
+										goto l1677
+									l1679:
+										position, tokenIndex, depth = position1677, tokenIndex1677, depth1677
+										if !_rules[ruleplx]() {
+											goto l1674
 										}
-										break
 									}
+								l1677:
+									goto l1673
+								l1674:
+									position, tokenIndex, depth = position1674, tokenIndex1674, depth1674
 								}
-
-								goto l1534
-							l1535:
-								position, tokenIndex, depth = position1535, tokenIndex1535, depth1535
+								depth--
+								add(rulepnLocal, position1668)
 							}
-							depth--
-							add(rulepnLocal, position1533)
+							goto l1667
+						l1666:
+							position, tokenIndex, depth = position1666, tokenIndex1666, depth1666
 						}
-						if !rules[ruleskip]() {
-							goto l1526
+					l1667:
+						if !_rules[ruleskip]() {
+							goto l1659
 						}
 						depth--
-						add(ruleprefixedName, position1530)
+						add(ruleprefixedName, position1663)
 					}
 				}
-			l1528:
+			l1661:
 				depth--
-				add(ruleiriref, position1527)
+				add(ruleiriref, position1660)
 			}
 			return true
-		l1526:
-			position, tokenIndex, depth = position1526, tokenIndex1526, depth1526
+		l1659:
+			position, tokenIndex, depth = position1659, tokenIndex1659, depth1659
 			return false
 		},
-		/* 72 iri <- <('<' (!'>' .)* '>' skip)> */
+		/* 81 iri <- <('<' ((!('<' / '>' / '"' / '{' / '}' / '|' / '^' / '`' / '\\' / [\x00- ]) .) / uchar)* '>' skip)> */
 		func() bool {
-			position1550, tokenIndex1550, depth1550 := position, tokenIndex, depth
+			position1680, tokenIndex1680, depth1680 := position, tokenIndex, depth
 			{
-				position1551 := position
+				position1681 := position
 				depth++
 				if buffer[position] != rune('<') {
-					goto l1550
+					goto l1680
 				}
 				position++
-			l1552:
+			l1682:
 				{
-					position1553, tokenIndex1553, depth1553 := position, tokenIndex, depth
+					position1683, tokenIndex1683, depth1683 := position, tokenIndex, depth
 					{
-						position1554, tokenIndex1554, depth1554 := position, tokenIndex, depth
-						if buffer[position] != rune('>') {
-							goto l1554
+						position1684, tokenIndex1684, depth1684 := position, tokenIndex, depth
+						{
+							position1686, tokenIndex1686, depth1686 := position, tokenIndex, depth
+							{
+								position1687, tokenIndex1687, depth1687 := position, tokenIndex, depth
+								if buffer[position] != rune('<') {
+									goto l1688
+								}
+								position++
+								goto l1687
+							l1688:
+								position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
+								if buffer[position] != rune('>') {
+									goto l1689
+								}
+								position++
+								goto l1687
+							l1689:
+								position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
+								if buffer[position] != rune('"') {
+									goto l1690
+								}
+								position++
+								goto l1687
+							l1690:
+								position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
+								if buffer[position] != rune('{') {
+									goto l1691
+								}
+								position++
+								goto l1687
+							l1691:
+								position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
+								if buffer[position] != rune('}') {
+									goto l1692
+								}
+								position++
+								goto l1687
+							l1692:
+								position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
+								if buffer[position] != rune('|') {
+									goto l1693
+								}
+								position++
+								goto l1687
+							l1693:
+								position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
+								if buffer[position] != rune('^') {
+									goto l1694
+								}
+								position++
+								goto l1687
+							l1694:
+								position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
+								if buffer[position] != rune('`') {
+									goto l1695
+								}
+								position++
+								goto l1687
+							l1695:
+								position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
+								if buffer[position] != rune('\\') {
+									goto l1696
+								}
+								position++
+								goto l1687
+							l1696:
+								position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
+								if c := buffer[position]; c < rune('\x00') || c > rune(' ') {
+									goto l1686
+								}
+								position++
+							}
+						l1687:
+							goto l1685
+						l1686:
+							position, tokenIndex, depth = position1686, tokenIndex1686, depth1686
+						}
+						if !matchDot() {
+							goto l1685
+						}
+						goto l1684
+					l1685:
+						position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+						if !_rules[ruleuchar]() {
+							goto l1683
 						}
-						position++
-						goto l1553
-					l1554:
-						position, tokenIndex, depth = position1554, tokenIndex1554, depth1554
-					}
-					if !matchDot() {
-						goto l1553
 					}
-					goto l1552
-				l1553:
-					position, tokenIndex, depth = position1553, tokenIndex1553, depth1553
+				l1684:
+					goto l1682
+				l1683:
+					position, tokenIndex, depth = position1683, tokenIndex1683, depth1683
 				}
 				if buffer[position] != rune('>') {
-					goto l1550
+					goto l1680
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1550
+				if !_rules[ruleskip]() {
+					goto l1680
 				}
 				depth--
-				add(ruleiri, position1551)
+				add(ruleiri, position1681)
 			}
 			return true
-		l1550:
-			position, tokenIndex, depth = position1550, tokenIndex1550, depth1550
+		l1680:
+			position, tokenIndex, depth = position1680, tokenIndex1680, depth1680
 			return false
 		},
-		/* 73 prefixedName <- <(pnPrefix? ':' pnLocal skip)> */
+		/* 82 prefixedName <- <(pnPrefix? ':' pnLocal? skip)> */
 		nil,
-		/* 74 literal <- <(string (('@' ([a-z] / [A-Z])+ ('-' ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+)*) / ('^' '^' iriref))? skip)> */
+		/* 83 literal <- <(string (('@' ([a-z] / [A-Z])+ ('-' ([a-z] / [A-Z] / [0-9])+)*) / ('^' '^' iriref))? skip)> */
 		func() bool {
-			position1556, tokenIndex1556, depth1556 := position, tokenIndex, depth
+			position1698, tokenIndex1698, depth1698 := position, tokenIndex, depth
 			{
-				position1557 := position
+				position1699 := position
 				depth++
-				if !rules[rulestring]() {
-					goto l1556
+				if !_rules[rulestring]() {
+					goto l1698
 				}
 				{
-					position1558, tokenIndex1558, depth1558 := position, tokenIndex, depth
+					position1700, tokenIndex1700, depth1700 := position, tokenIndex, depth
 					{
-						position1560, tokenIndex1560, depth1560 := position, tokenIndex, depth
+						position1702, tokenIndex1702, depth1702 := position, tokenIndex, depth
 						if buffer[position] != rune('@') {
-							goto l1561
+							goto l1703
 						}
 						position++
 						{
-							position1564, tokenIndex1564, depth1564 := position, tokenIndex, depth
+							position1706, tokenIndex1706, depth1706 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1565
+								goto l1707
 							}
 							position++
-							goto l1564
-						l1565:
-							position, tokenIndex, depth = position1564, tokenIndex1564, depth1564
+							goto l1706
+						l1707:
+							position, tokenIndex, depth = position1706, tokenIndex1706, depth1706
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1561
+								goto l1703
 							}
 							position++
 						}
-					l1564:
-					l1562:
+					l1706:
+					l1704:
 						{
-							position1563, tokenIndex1563, depth1563 := position, tokenIndex, depth
+							position1705, tokenIndex1705, depth1705 := position, tokenIndex, depth
 							{
-								position1566, tokenIndex1566, depth1566 := position, tokenIndex, depth
+								position1708, tokenIndex1708, depth1708 := position, tokenIndex, depth
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l1567
+									goto l1709
 								}
 								position++
-								goto l1566
-							l1567:
-								position, tokenIndex, depth = position1566, tokenIndex1566, depth1566
+								goto l1708
+							l1709:
+								position, tokenIndex, depth = position1708, tokenIndex1708, depth1708
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l1563
+									goto l1705
 								}
 								position++
 							}
-						l1566:
-							goto l1562
-						l1563:
-							position, tokenIndex, depth = position1563, tokenIndex1563, depth1563
+						l1708:
+							goto l1704
+						l1705:
+							position, tokenIndex, depth = position1705, tokenIndex1705, depth1705
 						}
-					l1568:
+					l1710:
 						{
-							position1569, tokenIndex1569, depth1569 := position, tokenIndex, depth
+							position1711, tokenIndex1711, depth1711 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l1569
+								goto l1711
 							}
 							position++
 							{
-								switch buffer[position] {
-								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l1569
-									}
-									position++
-									break
-								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-									if c := buffer[position]; c < rune('A') || c > rune('Z') {
-										goto l1569
-									}
-									position++
-									break
-								default:
-									if c := buffer[position]; c < rune('a') || c > rune('z') {
-										goto l1569
-									}
-									position++
-									break
-								}
-							}
-
-						l1570:
-							{
-								position1571, tokenIndex1571, depth1571 := position, tokenIndex, depth
-								{
-									switch buffer[position] {
-									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l1571
-										}
-										position++
-										break
-									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-										if c := buffer[position]; c < rune('A') || c > rune('Z') {
-											goto l1571
-										}
-										position++
-										break
-									default:
-										if c := buffer[position]; c < rune('a') || c > rune('z') {
-											goto l1571
-										}
-										position++
-										break
-									}
-								}
-
-								goto l1570
-							l1571:
-								position, tokenIndex, depth = position1571, tokenIndex1571, depth1571
-							}
-							goto l1568
-						l1569:
-							position, tokenIndex, depth = position1569, tokenIndex1569, depth1569
-						}
-						goto l1560
-					l1561:
-						position, tokenIndex, depth = position1560, tokenIndex1560, depth1560
-						if buffer[position] != rune('^') {
-							goto l1558
-						}
-						position++
-						if buffer[position] != rune('^') {
-							goto l1558
-						}
-						position++
-						if !rules[ruleiriref]() {
-							goto l1558
-						}
-					}
-				l1560:
-					goto l1559
-				l1558:
-					position, tokenIndex, depth = position1558, tokenIndex1558, depth1558
-				}
-			l1559:
-				if !rules[ruleskip]() {
-					goto l1556
-				}
-				depth--
-				add(ruleliteral, position1557)
-			}
-			return true
-		l1556:
-			position, tokenIndex, depth = position1556, tokenIndex1556, depth1556
-			return false
-		},
-		/* 75 string <- <(stringLiteralA / stringLiteralB / stringLiteralLongA / stringLiteralLongB)> */
-		func() bool {
-			position1574, tokenIndex1574, depth1574 := position, tokenIndex, depth
-			{
-				position1575 := position
-				depth++
-				{
-					position1576, tokenIndex1576, depth1576 := position, tokenIndex, depth
-					{
-						position1578 := position
-						depth++
-						if buffer[position] != rune('\'') {
-							goto l1577
-						}
-						position++
-					l1579:
-						{
-							position1580, tokenIndex1580, depth1580 := position, tokenIndex, depth
-							{
-								position1581, tokenIndex1581, depth1581 := position, tokenIndex, depth
-								{
-									position1583, tokenIndex1583, depth1583 := position, tokenIndex, depth
-									{
-										switch buffer[position] {
-										case '\r':
-											if buffer[position] != rune('\r') {
-												goto l1583
-											}
-											position++
-											break
-										case '\n':
-											if buffer[position] != rune('\n') {
-												goto l1583
-											}
-											position++
-											break
-										case '\\':
-											if buffer[position] != rune('\\') {
-												goto l1583
-											}
-											position++
-											break
-										default:
-											if buffer[position] != rune('\'') {
-												goto l1583
-											}
-											position++
-											break
-										}
-									}
-
-									goto l1582
-								l1583:
-									position, tokenIndex, depth = position1583, tokenIndex1583, depth1583
-								}
-								if !matchDot() {
-									goto l1582
-								}
-								goto l1581
-							l1582:
-								position, tokenIndex, depth = position1581, tokenIndex1581, depth1581
-								if !rules[ruleechar]() {
-									goto l1580
-								}
-							}
-						l1581:
-							goto l1579
-						l1580:
-							position, tokenIndex, depth = position1580, tokenIndex1580, depth1580
-						}
-						if buffer[position] != rune('\'') {
-							goto l1577
-						}
-						position++
-						depth--
-						add(rulestringLiteralA, position1578)
-					}
-					goto l1576
-				l1577:
-					position, tokenIndex, depth = position1576, tokenIndex1576, depth1576
-					{
-						position1586 := position
-						depth++
-						if buffer[position] != rune('"') {
-							goto l1585
-						}
-						position++
-					l1587:
-						{
-							position1588, tokenIndex1588, depth1588 := position, tokenIndex, depth
-							{
-								position1589, tokenIndex1589, depth1589 := position, tokenIndex, depth
-								{
-									position1591, tokenIndex1591, depth1591 := position, tokenIndex, depth
-									{
-										switch buffer[position] {
-										case '\r':
-											if buffer[position] != rune('\r') {
-												goto l1591
-											}
-											position++
-											break
-										case '\n':
-											if buffer[position] != rune('\n') {
-												goto l1591
-											}
-											position++
-											break
-										case '\\':
-											if buffer[position] != rune('\\') {
-												goto l1591
-											}
-											position++
-											break
-										default:
-											if buffer[position] != rune('"') {
-												goto l1591
-											}
-											position++
-											break
-										}
-									}
-
-									goto l1590
-								l1591:
-									position, tokenIndex, depth = position1591, tokenIndex1591, depth1591
+								position1714, tokenIndex1714, depth1714 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l1715
 								}
-								if !matchDot() {
-									goto l1590
+								position++
+								goto l1714
+							l1715:
+								position, tokenIndex, depth = position1714, tokenIndex1714, depth1714
+								if c := buffer[position]; c < rune('A') || c > rune('Z') {
+									goto l1716
 								}
-								goto l1589
-							l1590:
-								position, tokenIndex, depth = position1589, tokenIndex1589, depth1589
-								if !rules[ruleechar]() {
-									goto l1588
+								position++
+								goto l1714
+							l1716:
+								position, tokenIndex, depth = position1714, tokenIndex1714, depth1714
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1711
 								}
+								position++
 							}
-						l1589:
-							goto l1587
-						l1588:
-							position, tokenIndex, depth = position1588, tokenIndex1588, depth1588
+						l1714:
+						l1712:
+							{
+								position1713, tokenIndex1713, depth1713 := position, tokenIndex, depth
+								{
+									position1717, tokenIndex1717, depth1717 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l1718
+									}
+									position++
+									goto l1717
+								l1718:
+									position, tokenIndex, depth = position1717, tokenIndex1717, depth1717
+									if c := buffer[position]; c < rune('A') || c > rune('Z') {
+										goto l1719
+									}
+									position++
+									goto l1717
+								l1719:
+									position, tokenIndex, depth = position1717, tokenIndex1717, depth1717
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l1713
+									}
+									position++
+								}
+							l1717:
+								goto l1712
+							l1713:
+								position, tokenIndex, depth = position1713, tokenIndex1713, depth1713
+							}
+							goto l1710
+						l1711:
+							position, tokenIndex, depth = position1711, tokenIndex1711, depth1711
 						}
-						if buffer[position] != rune('"') {
-							goto l1585
+						goto l1702
+					l1703:
+						position, tokenIndex, depth = position1702, tokenIndex1702, depth1702
+						if buffer[position] != rune('^') {
+							goto l1700
 						}
 						position++
-						depth--
-						add(rulestringLiteralB, position1586)
+						if buffer[position] != rune('^') {
+							goto l1700
+						}
+						position++
+						if !_rules[ruleiriref]() {
+							goto l1700
+						}
 					}
-					goto l1576
-				l1585:
-					position, tokenIndex, depth = position1576, tokenIndex1576, depth1576
+				l1702:
+					goto l1701
+				l1700:
+					position, tokenIndex, depth = position1700, tokenIndex1700, depth1700
+				}
+			l1701:
+				if !_rules[ruleskip]() {
+					goto l1698
+				}
+				depth--
+				add(ruleliteral, position1699)
+			}
+			return true
+		l1698:
+			position, tokenIndex, depth = position1698, tokenIndex1698, depth1698
+			return false
+		},
+		/* 84 string <- <(stringLiteralLongA / stringLiteralLongB / stringLiteralA / stringLiteralB)> */
+		func() bool {
+			position1720, tokenIndex1720, depth1720 := position, tokenIndex, depth
+			{
+				position1721 := position
+				depth++
+				{
+					position1722, tokenIndex1722, depth1722 := position, tokenIndex, depth
 					{
-						position1594 := position
+						position1724 := position
 						depth++
 						if buffer[position] != rune('\'') {
-							goto l1593
+							goto l1723
 						}
 						position++
 						if buffer[position] != rune('\'') {
-							goto l1593
+							goto l1723
 						}
 						position++
 						if buffer[position] != rune('\'') {
-							goto l1593
+							goto l1723
 						}
 						position++
-					l1595:
+					l1725:
 						{
-							position1596, tokenIndex1596, depth1596 := position, tokenIndex, depth
+							position1726, tokenIndex1726, depth1726 := position, tokenIndex, depth
 							{
-								position1597, tokenIndex1597, depth1597 := position, tokenIndex, depth
+								position1727, tokenIndex1727, depth1727 := position, tokenIndex, depth
 								{
-									position1599, tokenIndex1599, depth1599 := position, tokenIndex, depth
+									position1729, tokenIndex1729, depth1729 := position, tokenIndex, depth
 									if buffer[position] != rune('\'') {
-										goto l1600
+										goto l1730
 									}
 									position++
-									goto l1599
-								l1600:
-									position, tokenIndex, depth = position1599, tokenIndex1599, depth1599
 									if buffer[position] != rune('\'') {
-										goto l1597
+										goto l1730
 									}
 									position++
+									goto l1729
+								l1730:
+									position, tokenIndex, depth = position1729, tokenIndex1729, depth1729
 									if buffer[position] != rune('\'') {
-										goto l1597
+										goto l1727
 									}
 									position++
 								}
-							l1599:
-								goto l1598
-							l1597:
-								position, tokenIndex, depth = position1597, tokenIndex1597, depth1597
+							l1729:
+								goto l1728
+							l1727:
+								position, tokenIndex, depth = position1727, tokenIndex1727, depth1727
 							}
-						l1598:
+						l1728:
 							{
-								position1601, tokenIndex1601, depth1601 := position, tokenIndex, depth
+								position1731, tokenIndex1731, depth1731 := position, tokenIndex, depth
 								{
-									position1603, tokenIndex1603, depth1603 := position, tokenIndex, depth
+									position1733, tokenIndex1733, depth1733 := position, tokenIndex, depth
 									{
-										position1604, tokenIndex1604, depth1604 := position, tokenIndex, depth
+										position1734, tokenIndex1734, depth1734 := position, tokenIndex, depth
 										if buffer[position] != rune('\'') {
-											goto l1605
+											goto l1735
 										}
 										position++
-										goto l1604
-									l1605:
-										position, tokenIndex, depth = position1604, tokenIndex1604, depth1604
+										goto l1734
+									l1735:
+										position, tokenIndex, depth = position1734, tokenIndex1734, depth1734
 										if buffer[position] != rune('\\') {
-											goto l1603
+											goto l1733
 										}
 										position++
 									}
-								l1604:
-									goto l1602
-								l1603:
-									position, tokenIndex, depth = position1603, tokenIndex1603, depth1603
+								l1734:
+									goto l1732
+								l1733:
+									position, tokenIndex, depth = position1733, tokenIndex1733, depth1733
 								}
 								if !matchDot() {
-									goto l1602
+									goto l1732
 								}
-								goto l1601
-							l1602:
-								position, tokenIndex, depth = position1601, tokenIndex1601, depth1601
-								if !rules[ruleechar]() {
-									goto l1596
+								goto l1731
+							l1732:
+								position, tokenIndex, depth = position1731, tokenIndex1731, depth1731
+								if !_rules[ruleechar]() {
+									goto l1736
+								}
+								goto l1731
+							l1736:
+								position, tokenIndex, depth = position1731, tokenIndex1731, depth1731
+								if !_rules[ruleuchar]() {
+									goto l1726
 								}
 							}
-						l1601:
-							goto l1595
-						l1596:
-							position, tokenIndex, depth = position1596, tokenIndex1596, depth1596
+						l1731:
+							goto l1725
+						l1726:
+							position, tokenIndex, depth = position1726, tokenIndex1726, depth1726
 						}
 						if buffer[position] != rune('\'') {
-							goto l1593
+							goto l1723
 						}
 						position++
 						if buffer[position] != rune('\'') {
-							goto l1593
+							goto l1723
 						}
 						position++
 						if buffer[position] != rune('\'') {
-							goto l1593
+							goto l1723
 						}
 						position++
 						depth--
-						add(rulestringLiteralLongA, position1594)
+						add(rulestringLiteralLongA, position1724)
 					}
-					goto l1576
-				l1593:
-					position, tokenIndex, depth = position1576, tokenIndex1576, depth1576
+					goto l1722
+				l1723:
+					position, tokenIndex, depth = position1722, tokenIndex1722, depth1722
 					{
-						position1606 := position
+						position1738 := position
 						depth++
 						if buffer[position] != rune('"') {
-							goto l1574
+							goto l1737
 						}
 						position++
 						if buffer[position] != rune('"') {
-							goto l1574
+							goto l1737
 						}
 						position++
 						if buffer[position] != rune('"') {
-							goto l1574
+							goto l1737
 						}
 						position++
-					l1607:
+					l1739:
 						{
-							position1608, tokenIndex1608, depth1608 := position, tokenIndex, depth
+							position1740, tokenIndex1740, depth1740 := position, tokenIndex, depth
 							{
-								position1609, tokenIndex1609, depth1609 := position, tokenIndex, depth
+								position1741, tokenIndex1741, depth1741 := position, tokenIndex, depth
 								{
-									position1611, tokenIndex1611, depth1611 := position, tokenIndex, depth
+									position1743, tokenIndex1743, depth1743 := position, tokenIndex, depth
 									if buffer[position] != rune('"') {
-										goto l1612
+										goto l1744
 									}
 									position++
-									goto l1611
-								l1612:
-									position, tokenIndex, depth = position1611, tokenIndex1611, depth1611
 									if buffer[position] != rune('"') {
-										goto l1609
+										goto l1744
 									}
 									position++
+									goto l1743
+								l1744:
+									position, tokenIndex, depth = position1743, tokenIndex1743, depth1743
 									if buffer[position] != rune('"') {
-										goto l1609
+										goto l1741
 									}
 									position++
 								}
-							l1611:
-								goto l1610
-							l1609:
-								position, tokenIndex, depth = position1609, tokenIndex1609, depth1609
+							l1743:
+								goto l1742
+							l1741:
+								position, tokenIndex, depth = position1741, tokenIndex1741, depth1741
 							}
-						l1610:
+						l1742:
 							{
-								position1613, tokenIndex1613, depth1613 := position, tokenIndex, depth
+								position1745, tokenIndex1745, depth1745 := position, tokenIndex, depth
 								{
-									position1615, tokenIndex1615, depth1615 := position, tokenIndex, depth
+									position1747, tokenIndex1747, depth1747 := position, tokenIndex, depth
 									{
-										position1616, tokenIndex1616, depth1616 := position, tokenIndex, depth
+										position1748, tokenIndex1748, depth1748 := position, tokenIndex, depth
 										if buffer[position] != rune('"') {
-											goto l1617
+											goto l1749
 										}
 										position++
-										goto l1616
-									l1617:
-										position, tokenIndex, depth = position1616, tokenIndex1616, depth1616
+										goto l1748
+									l1749:
+										position, tokenIndex, depth = position1748, tokenIndex1748, depth1748
 										if buffer[position] != rune('\\') {
-											goto l1615
+											goto l1747
 										}
 										position++
 									}
-								l1616:
-									goto l1614
-								l1615:
-									position, tokenIndex, depth = position1615, tokenIndex1615, depth1615
+								l1748:
+									goto l1746
+								l1747:
+									position, tokenIndex, depth = position1747, tokenIndex1747, depth1747
 								}
 								if !matchDot() {
-									goto l1614
+									goto l1746
 								}
-								goto l1613
-							l1614:
-								position, tokenIndex, depth = position1613, tokenIndex1613, depth1613
-								if !rules[ruleechar]() {
-									goto l1608
+								goto l1745
+							l1746:
+								position, tokenIndex, depth = position1745, tokenIndex1745, depth1745
+								if !_rules[ruleechar]() {
+									goto l1750
+								}
+								goto l1745
+							l1750:
+								position, tokenIndex, depth = position1745, tokenIndex1745, depth1745
+								if !_rules[ruleuchar]() {
+									goto l1740
 								}
 							}
-						l1613:
-							goto l1607
-						l1608:
-							position, tokenIndex, depth = position1608, tokenIndex1608, depth1608
+						l1745:
+							goto l1739
+						l1740:
+							position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
+						}
+						if buffer[position] != rune('"') {
+							goto l1737
+						}
+						position++
+						if buffer[position] != rune('"') {
+							goto l1737
 						}
+						position++
 						if buffer[position] != rune('"') {
-							goto l1574
+							goto l1737
+						}
+						position++
+						depth--
+						add(rulestringLiteralLongB, position1738)
+					}
+					goto l1722
+				l1737:
+					position, tokenIndex, depth = position1722, tokenIndex1722, depth1722
+					{
+						position1752 := position
+						depth++
+						if buffer[position] != rune('\'') {
+							goto l1751
+						}
+						position++
+					l1753:
+						{
+							position1754, tokenIndex1754, depth1754 := position, tokenIndex, depth
+							{
+								position1755, tokenIndex1755, depth1755 := position, tokenIndex, depth
+								{
+									position1757, tokenIndex1757, depth1757 := position, tokenIndex, depth
+									{
+										position1758, tokenIndex1758, depth1758 := position, tokenIndex, depth
+										if buffer[position] != rune('\'') {
+											goto l1759
+										}
+										position++
+										goto l1758
+									l1759:
+										position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+										if buffer[position] != rune('\\') {
+											goto l1760
+										}
+										position++
+										goto l1758
+									l1760:
+										position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+										if buffer[position] != rune('\n') {
+											goto l1761
+										}
+										position++
+										goto l1758
+									l1761:
+										position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
+										if buffer[position] != rune('\r') {
+											goto l1757
+										}
+										position++
+									}
+								l1758:
+									goto l1756
+								l1757:
+									position, tokenIndex, depth = position1757, tokenIndex1757, depth1757
+								}
+								if !matchDot() {
+									goto l1756
+								}
+								goto l1755
+							l1756:
+								position, tokenIndex, depth = position1755, tokenIndex1755, depth1755
+								if !_rules[ruleechar]() {
+									goto l1762
+								}
+								goto l1755
+							l1762:
+								position, tokenIndex, depth = position1755, tokenIndex1755, depth1755
+								if !_rules[ruleuchar]() {
+									goto l1754
+								}
+							}
+						l1755:
+							goto l1753
+						l1754:
+							position, tokenIndex, depth = position1754, tokenIndex1754, depth1754
+						}
+						if buffer[position] != rune('\'') {
+							goto l1751
 						}
 						position++
+						depth--
+						add(rulestringLiteralA, position1752)
+					}
+					goto l1722
+				l1751:
+					position, tokenIndex, depth = position1722, tokenIndex1722, depth1722
+					{
+						position1763 := position
+						depth++
 						if buffer[position] != rune('"') {
-							goto l1574
+							goto l1720
 						}
 						position++
+					l1764:
+						{
+							position1765, tokenIndex1765, depth1765 := position, tokenIndex, depth
+							{
+								position1766, tokenIndex1766, depth1766 := position, tokenIndex, depth
+								{
+									position1768, tokenIndex1768, depth1768 := position, tokenIndex, depth
+									{
+										position1769, tokenIndex1769, depth1769 := position, tokenIndex, depth
+										if buffer[position] != rune('"') {
+											goto l1770
+										}
+										position++
+										goto l1769
+									l1770:
+										position, tokenIndex, depth = position1769, tokenIndex1769, depth1769
+										if buffer[position] != rune('\\') {
+											goto l1771
+										}
+										position++
+										goto l1769
+									l1771:
+										position, tokenIndex, depth = position1769, tokenIndex1769, depth1769
+										if buffer[position] != rune('\n') {
+											goto l1772
+										}
+										position++
+										goto l1769
+									l1772:
+										position, tokenIndex, depth = position1769, tokenIndex1769, depth1769
+										if buffer[position] != rune('\r') {
+											goto l1768
+										}
+										position++
+									}
+								l1769:
+									goto l1767
+								l1768:
+									position, tokenIndex, depth = position1768, tokenIndex1768, depth1768
+								}
+								if !matchDot() {
+									goto l1767
+								}
+								goto l1766
+							l1767:
+								position, tokenIndex, depth = position1766, tokenIndex1766, depth1766
+								if !_rules[ruleechar]() {
+									goto l1773
+								}
+								goto l1766
+							l1773:
+								position, tokenIndex, depth = position1766, tokenIndex1766, depth1766
+								if !_rules[ruleuchar]() {
+									goto l1765
+								}
+							}
+						l1766:
+							goto l1764
+						l1765:
+							position, tokenIndex, depth = position1765, tokenIndex1765, depth1765
+						}
 						if buffer[position] != rune('"') {
-							goto l1574
+							goto l1720
 						}
 						position++
 						depth--
-						add(rulestringLiteralLongB, position1606)
+						add(rulestringLiteralB, position1763)
 					}
 				}
-			l1576:
+			l1722:
 				depth--
-				add(rulestring, position1575)
+				add(rulestring, position1721)
 			}
 			return true
-		l1574:
-			position, tokenIndex, depth = position1574, tokenIndex1574, depth1574
+		l1720:
+			position, tokenIndex, depth = position1720, tokenIndex1720, depth1720
 			return false
 		},
-		/* 76 stringLiteralA <- <('\'' ((!((&('\r') '\r') | (&('\n') '\n') | (&('\\') '\\') | (&('\'') '\'')) .) / echar)* '\'')> */
+		/* 85 stringLiteralA <- <('\'' ((!('\'' / '\\' / '\n' / '\r') .) / echar / uchar)* '\'')> */
 		nil,
-		/* 77 stringLiteralB <- <('"' ((!((&('\r') '\r') | (&('\n') '\n') | (&('\\') '\\') | (&('"') '"')) .) / echar)* '"')> */
+		/* 86 stringLiteralB <- <('"' ((!('"' / '\\' / '\n' / '\r') .) / echar / uchar)* '"')> */
 		nil,
-		/* 78 stringLiteralLongA <- <('\'' '\'' '\'' (('\'' / ('\'' '\''))? ((!('\'' / '\\') .) / echar))* ('\'' '\'' '\''))> */
+		/* 87 stringLiteralLongA <- <('\'' '\'' '\'' ((('\'' '\'') / '\'')? ((!('\'' / '\\') .) / echar / uchar))* ('\'' '\'' '\''))> */
 		nil,
-		/* 79 stringLiteralLongB <- <('"' '"' '"' (('"' / ('"' '"'))? ((!('"' / '\\') .) / echar))* ('"' '"' '"'))> */
+		/* 88 stringLiteralLongB <- <('"' '"' '"' ((('"' '"') / '"')? ((!('"' / '\\') .) / echar / uchar))* ('"' '"' '"'))> */
 		nil,
-		/* 80 echar <- <('\\' ((&('\'') '\'') | (&('"') '"') | (&('\\') '\\') | (&('f') 'f') | (&('r') 'r') | (&('n') 'n') | (&('b') 'b') | (&('t') 't')))> */
+		/* 89 echar <- <('\\' ('t' / 'b' / 'n' / 'r' / 'f' / '\\' / '"' / '\''))> */
 		func() bool {
-			position1622, tokenIndex1622, depth1622 := position, tokenIndex, depth
+			position1778, tokenIndex1778, depth1778 := position, tokenIndex, depth
 			{
-				position1623 := position
+				position1779 := position
 				depth++
 				if buffer[position] != rune('\\') {
-					goto l1622
+					goto l1778
 				}
 				position++
 				{
-					switch buffer[position] {
-					case '\'':
-						if buffer[position] != rune('\'') {
-							goto l1622
+					position1780, tokenIndex1780, depth1780 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l1781
+					}
+					position++
+					goto l1780
+				l1781:
+					position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
+					if buffer[position] != rune('b') {
+						goto l1782
+					}
+					position++
+					goto l1780
+				l1782:
+					position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
+					if buffer[position] != rune('n') {
+						goto l1783
+					}
+					position++
+					goto l1780
+				l1783:
+					position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
+					if buffer[position] != rune('r') {
+						goto l1784
+					}
+					position++
+					goto l1780
+				l1784:
+					position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
+					if buffer[position] != rune('f') {
+						goto l1785
+					}
+					position++
+					goto l1780
+				l1785:
+					position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
+					if buffer[position] != rune('\\') {
+						goto l1786
+					}
+					position++
+					goto l1780
+				l1786:
+					position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
+					if buffer[position] != rune('"') {
+						goto l1787
+					}
+					position++
+					goto l1780
+				l1787:
+					position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
+					if buffer[position] != rune('\'') {
+						goto l1778
+					}
+					position++
+				}
+			l1780:
+				depth--
+				add(ruleechar, position1779)
+			}
+			return true
+		l1778:
+			position, tokenIndex, depth = position1778, tokenIndex1778, depth1778
+			return false
+		},
+		/* 90 uchar <- <(('\\' 'u' hex hex hex hex) / ('\\' 'U' hex hex hex hex hex hex hex hex))> */
+		func() bool {
+			position1788, tokenIndex1788, depth1788 := position, tokenIndex, depth
+			{
+				position1789 := position
+				depth++
+				{
+					position1790, tokenIndex1790, depth1790 := position, tokenIndex, depth
+					if buffer[position] != rune('\\') {
+						goto l1791
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l1791
+					}
+					position++
+					if !_rules[rulehex]() {
+						goto l1791
+					}
+					if !_rules[rulehex]() {
+						goto l1791
+					}
+					if !_rules[rulehex]() {
+						goto l1791
+					}
+					if !_rules[rulehex]() {
+						goto l1791
+					}
+					goto l1790
+				l1791:
+					position, tokenIndex, depth = position1790, tokenIndex1790, depth1790
+					if buffer[position] != rune('\\') {
+						goto l1788
+					}
+					position++
+					if buffer[position] != rune('U') {
+						goto l1788
+					}
+					position++
+					if !_rules[rulehex]() {
+						goto l1788
+					}
+					if !_rules[rulehex]() {
+						goto l1788
+					}
+					if !_rules[rulehex]() {
+						goto l1788
+					}
+					if !_rules[rulehex]() {
+						goto l1788
+					}
+					if !_rules[rulehex]() {
+						goto l1788
+					}
+					if !_rules[rulehex]() {
+						goto l1788
+					}
+					if !_rules[rulehex]() {
+						goto l1788
+					}
+					if !_rules[rulehex]() {
+						goto l1788
+					}
+				}
+			l1790:
+				depth--
+				add(ruleuchar, position1789)
+			}
+			return true
+		l1788:
+			position, tokenIndex, depth = position1788, tokenIndex1788, depth1788
+			return false
+		},
+		/* 91 numericLiteral <- <(('+' / '-')? unsignedNumericLiteral skip)> */
+		func() bool {
+			position1792, tokenIndex1792, depth1792 := position, tokenIndex, depth
+			{
+				position1793 := position
+				depth++
+				{
+					position1794, tokenIndex1794, depth1794 := position, tokenIndex, depth
+					{
+						position1796, tokenIndex1796, depth1796 := position, tokenIndex, depth
+						if buffer[position] != rune('+') {
+							goto l1797
 						}
 						position++
-						break
-					case '"':
-						if buffer[position] != rune('"') {
-							goto l1622
+						goto l1796
+					l1797:
+						position, tokenIndex, depth = position1796, tokenIndex1796, depth1796
+						if buffer[position] != rune('-') {
+							goto l1794
 						}
 						position++
-						break
-					case '\\':
-						if buffer[position] != rune('\\') {
-							goto l1622
+					}
+				l1796:
+					goto l1795
+				l1794:
+					position, tokenIndex, depth = position1794, tokenIndex1794, depth1794
+				}
+			l1795:
+				if !_rules[ruleunsignedNumericLiteral]() {
+					goto l1792
+				}
+				if !_rules[ruleskip]() {
+					goto l1792
+				}
+				depth--
+				add(rulenumericLiteral, position1793)
+			}
+			return true
+		l1792:
+			position, tokenIndex, depth = position1792, tokenIndex1792, depth1792
+			return false
+		},
+		/* 92 signedNumericLiteral <- <(('+' / '-') unsignedNumericLiteral skip)> */
+		nil,
+		/* 93 unsignedNumericLiteral <- <(double / decimal / integer)> */
+		func() bool {
+			position1799, tokenIndex1799, depth1799 := position, tokenIndex, depth
+			{
+				position1800 := position
+				depth++
+				{
+					position1801, tokenIndex1801, depth1801 := position, tokenIndex, depth
+					{
+						position1803 := position
+						depth++
+						{
+							position1804, tokenIndex1804, depth1804 := position, tokenIndex, depth
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1805
+							}
+							position++
+						l1806:
+							{
+								position1807, tokenIndex1807, depth1807 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1807
+								}
+								position++
+								goto l1806
+							l1807:
+								position, tokenIndex, depth = position1807, tokenIndex1807, depth1807
+							}
+							if buffer[position] != rune('.') {
+								goto l1805
+							}
+							position++
+						l1808:
+							{
+								position1809, tokenIndex1809, depth1809 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1809
+								}
+								position++
+								goto l1808
+							l1809:
+								position, tokenIndex, depth = position1809, tokenIndex1809, depth1809
+							}
+							if !_rules[ruleexponent]() {
+								goto l1805
+							}
+							goto l1804
+						l1805:
+							position, tokenIndex, depth = position1804, tokenIndex1804, depth1804
+							if buffer[position] != rune('.') {
+								goto l1810
+							}
+							position++
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1810
+							}
+							position++
+						l1811:
+							{
+								position1812, tokenIndex1812, depth1812 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1812
+								}
+								position++
+								goto l1811
+							l1812:
+								position, tokenIndex, depth = position1812, tokenIndex1812, depth1812
+							}
+							if !_rules[ruleexponent]() {
+								goto l1810
+							}
+							goto l1804
+						l1810:
+							position, tokenIndex, depth = position1804, tokenIndex1804, depth1804
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1802
+							}
+							position++
+						l1813:
+							{
+								position1814, tokenIndex1814, depth1814 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1814
+								}
+								position++
+								goto l1813
+							l1814:
+								position, tokenIndex, depth = position1814, tokenIndex1814, depth1814
+							}
+							if !_rules[ruleexponent]() {
+								goto l1802
+							}
 						}
-						position++
-						break
-					case 'f':
-						if buffer[position] != rune('f') {
-							goto l1622
+					l1804:
+						depth--
+						add(ruledouble, position1803)
+					}
+					goto l1801
+				l1802:
+					position, tokenIndex, depth = position1801, tokenIndex1801, depth1801
+					{
+						position1816 := position
+						depth++
+					l1817:
+						{
+							position1818, tokenIndex1818, depth1818 := position, tokenIndex, depth
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1818
+							}
+							position++
+							goto l1817
+						l1818:
+							position, tokenIndex, depth = position1818, tokenIndex1818, depth1818
 						}
-						position++
-						break
-					case 'r':
-						if buffer[position] != rune('r') {
-							goto l1622
+						if buffer[position] != rune('.') {
+							goto l1815
 						}
 						position++
-						break
-					case 'n':
-						if buffer[position] != rune('n') {
-							goto l1622
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1815
 						}
 						position++
-						break
-					case 'b':
-						if buffer[position] != rune('b') {
-							goto l1622
+					l1819:
+						{
+							position1820, tokenIndex1820, depth1820 := position, tokenIndex, depth
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1820
+							}
+							position++
+							goto l1819
+						l1820:
+							position, tokenIndex, depth = position1820, tokenIndex1820, depth1820
 						}
-						position++
-						break
-					default:
-						if buffer[position] != rune('t') {
-							goto l1622
+						depth--
+						add(ruledecimal, position1816)
+					}
+					goto l1801
+				l1815:
+					position, tokenIndex, depth = position1801, tokenIndex1801, depth1801
+					{
+						position1821 := position
+						depth++
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1799
 						}
 						position++
-						break
+					l1822:
+						{
+							position1823, tokenIndex1823, depth1823 := position, tokenIndex, depth
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1823
+							}
+							position++
+							goto l1822
+						l1823:
+							position, tokenIndex, depth = position1823, tokenIndex1823, depth1823
+						}
+						depth--
+						add(ruleinteger, position1821)
 					}
 				}
-
+			l1801:
 				depth--
-				add(ruleechar, position1623)
+				add(ruleunsignedNumericLiteral, position1800)
 			}
 			return true
-		l1622:
-			position, tokenIndex, depth = position1622, tokenIndex1622, depth1622
+		l1799:
+			position, tokenIndex, depth = position1799, tokenIndex1799, depth1799
 			return false
 		},
-		/* 81 numericLiteral <- <(('+' / '-')? [0-9]+ ('.' [0-9]*)? skip)> */
+		/* 94 double <- <(([0-9]+ '.' [0-9]* exponent) / ('.' [0-9]+ exponent) / ([0-9]+ exponent))> */
+		nil,
+		/* 95 decimal <- <([0-9]* '.' [0-9]+)> */
+		nil,
+		/* 96 integer <- <[0-9]+> */
+		nil,
+		/* 97 exponent <- <(('e' / 'E') ('+' / '-')? [0-9]+)> */
 		func() bool {
-			position1625, tokenIndex1625, depth1625 := position, tokenIndex, depth
+			position1827, tokenIndex1827, depth1827 := position, tokenIndex, depth
 			{
-				position1626 := position
+				position1828 := position
 				depth++
 				{
-					position1627, tokenIndex1627, depth1627 := position, tokenIndex, depth
+					position1829, tokenIndex1829, depth1829 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1830
+					}
+					position++
+					goto l1829
+				l1830:
+					position, tokenIndex, depth = position1829, tokenIndex1829, depth1829
+					if buffer[position] != rune('E') {
+						goto l1827
+					}
+					position++
+				}
+			l1829:
+				{
+					position1831, tokenIndex1831, depth1831 := position, tokenIndex, depth
 					{
-						position1629, tokenIndex1629, depth1629 := position, tokenIndex, depth
+						position1833, tokenIndex1833, depth1833 := position, tokenIndex, depth
 						if buffer[position] != rune('+') {
-							goto l1630
+							goto l1834
 						}
 						position++
-						goto l1629
-					l1630:
-						position, tokenIndex, depth = position1629, tokenIndex1629, depth1629
+						goto l1833
+					l1834:
+						position, tokenIndex, depth = position1833, tokenIndex1833, depth1833
 						if buffer[position] != rune('-') {
-							goto l1627
+							goto l1831
 						}
 						position++
 					}
-				l1629:
-					goto l1628
-				l1627:
-					position, tokenIndex, depth = position1627, tokenIndex1627, depth1627
+				l1833:
+					goto l1832
+				l1831:
+					position, tokenIndex, depth = position1831, tokenIndex1831, depth1831
 				}
-			l1628:
+			l1832:
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l1625
+					goto l1827
 				}
 				position++
-			l1631:
+			l1835:
 				{
-					position1632, tokenIndex1632, depth1632 := position, tokenIndex, depth
+					position1836, tokenIndex1836, depth1836 := position, tokenIndex, depth
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1632
-					}
-					position++
-					goto l1631
-				l1632:
-					position, tokenIndex, depth = position1632, tokenIndex1632, depth1632
-				}
-				{
-					position1633, tokenIndex1633, depth1633 := position, tokenIndex, depth
-					if buffer[position] != rune('.') {
-						goto l1633
+						goto l1836
 					}
 					position++
-				l1635:
-					{
-						position1636, tokenIndex1636, depth1636 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1636
-						}
-						position++
-						goto l1635
-					l1636:
-						position, tokenIndex, depth = position1636, tokenIndex1636, depth1636
-					}
-					goto l1634
-				l1633:
-					position, tokenIndex, depth = position1633, tokenIndex1633, depth1633
-				}
-			l1634:
-				if !rules[ruleskip]() {
-					goto l1625
+					goto l1835
+				l1836:
+					position, tokenIndex, depth = position1836, tokenIndex1836, depth1836
 				}
 				depth--
-				add(rulenumericLiteral, position1626)
+				add(ruleexponent, position1828)
 			}
 			return true
-		l1625:
-			position, tokenIndex, depth = position1625, tokenIndex1625, depth1625
+		l1827:
+			position, tokenIndex, depth = position1827, tokenIndex1827, depth1827
 			return false
 		},
-		/* 82 signedNumericLiteral <- <(('+' / '-') [0-9]+ ('.' [0-9]*)? skip)> */
-		nil,
-		/* 83 booleanLiteral <- <(TRUE / FALSE)> */
+		/* 98 booleanLiteral <- <(TRUE / FALSE)> */
 		func() bool {
-			position1638, tokenIndex1638, depth1638 := position, tokenIndex, depth
+			position1837, tokenIndex1837, depth1837 := position, tokenIndex, depth
 			{
-				position1639 := position
+				position1838 := position
 				depth++
 				{
-					position1640, tokenIndex1640, depth1640 := position, tokenIndex, depth
+					position1839, tokenIndex1839, depth1839 := position, tokenIndex, depth
 					{
-						position1642 := position
+						position1841 := position
 						depth++
 						{
-							position1643, tokenIndex1643, depth1643 := position, tokenIndex, depth
+							position1842, tokenIndex1842, depth1842 := position, tokenIndex, depth
 							if buffer[position] != rune('t') {
-								goto l1644
+								goto l1843
 							}
 							position++
-							goto l1643
-						l1644:
-							position, tokenIndex, depth = position1643, tokenIndex1643, depth1643
+							goto l1842
+						l1843:
+							position, tokenIndex, depth = position1842, tokenIndex1842, depth1842
 							if buffer[position] != rune('T') {
-								goto l1641
+								goto l1840
 							}
 							position++
 						}
-					l1643:
+					l1842:
 						{
-							position1645, tokenIndex1645, depth1645 := position, tokenIndex, depth
+							position1844, tokenIndex1844, depth1844 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l1646
+								goto l1845
 							}
 							position++
-							goto l1645
-						l1646:
-							position, tokenIndex, depth = position1645, tokenIndex1645, depth1645
+							goto l1844
+						l1845:
+							position, tokenIndex, depth = position1844, tokenIndex1844, depth1844
 							if buffer[position] != rune('R') {
-								goto l1641
+								goto l1840
 							}
 							position++
 						}
-					l1645:
+					l1844:
 						{
-							position1647, tokenIndex1647, depth1647 := position, tokenIndex, depth
+							position1846, tokenIndex1846, depth1846 := position, tokenIndex, depth
 							if buffer[position] != rune('u') {
-								goto l1648
+								goto l1847
 							}
 							position++
-							goto l1647
-						l1648:
-							position, tokenIndex, depth = position1647, tokenIndex1647, depth1647
+							goto l1846
+						l1847:
+							position, tokenIndex, depth = position1846, tokenIndex1846, depth1846
 							if buffer[position] != rune('U') {
-								goto l1641
+								goto l1840
 							}
 							position++
 						}
-					l1647:
+					l1846:
 						{
-							position1649, tokenIndex1649, depth1649 := position, tokenIndex, depth
+							position1848, tokenIndex1848, depth1848 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l1650
+								goto l1849
 							}
 							position++
-							goto l1649
-						l1650:
-							position, tokenIndex, depth = position1649, tokenIndex1649, depth1649
+							goto l1848
+						l1849:
+							position, tokenIndex, depth = position1848, tokenIndex1848, depth1848
 							if buffer[position] != rune('E') {
-								goto l1641
+								goto l1840
 							}
 							position++
 						}
-					l1649:
-						if !rules[ruleskip]() {
-							goto l1641
+					l1848:
+						if !_rules[ruleskip]() {
+							goto l1840
 						}
 						depth--
-						add(ruleTRUE, position1642)
+						add(ruleTRUE, position1841)
 					}
-					goto l1640
-				l1641:
-					position, tokenIndex, depth = position1640, tokenIndex1640, depth1640
+					goto l1839
+				l1840:
+					position, tokenIndex, depth = position1839, tokenIndex1839, depth1839
 					{
-						position1651 := position
+						position1850 := position
 						depth++
 						{
-							position1652, tokenIndex1652, depth1652 := position, tokenIndex, depth
+							position1851, tokenIndex1851, depth1851 := position, tokenIndex, depth
 							if buffer[position] != rune('f') {
-								goto l1653
+								goto l1852
 							}
 							position++
-							goto l1652
-						l1653:
-							position, tokenIndex, depth = position1652, tokenIndex1652, depth1652
+							goto l1851
+						l1852:
+							position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
 							if buffer[position] != rune('F') {
-								goto l1638
+								goto l1837
 							}
 							position++
 						}
-					l1652:
+					l1851:
 						{
-							position1654, tokenIndex1654, depth1654 := position, tokenIndex, depth
+							position1853, tokenIndex1853, depth1853 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l1655
+								goto l1854
 							}
 							position++
-							goto l1654
-						l1655:
-							position, tokenIndex, depth = position1654, tokenIndex1654, depth1654
+							goto l1853
+						l1854:
+							position, tokenIndex, depth = position1853, tokenIndex1853, depth1853
 							if buffer[position] != rune('A') {
-								goto l1638
+								goto l1837
 							}
 							position++
 						}
-					l1654:
+					l1853:
 						{
-							position1656, tokenIndex1656, depth1656 := position, tokenIndex, depth
+							position1855, tokenIndex1855, depth1855 := position, tokenIndex, depth
 							if buffer[position] != rune('l') {
-								goto l1657
+								goto l1856
 							}
 							position++
-							goto l1656
-						l1657:
-							position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
+							goto l1855
+						l1856:
+							position, tokenIndex, depth = position1855, tokenIndex1855, depth1855
 							if buffer[position] != rune('L') {
-								goto l1638
+								goto l1837
 							}
 							position++
 						}
-					l1656:
+					l1855:
 						{
-							position1658, tokenIndex1658, depth1658 := position, tokenIndex, depth
+							position1857, tokenIndex1857, depth1857 := position, tokenIndex, depth
 							if buffer[position] != rune('s') {
-								goto l1659
+								goto l1858
 							}
 							position++
-							goto l1658
-						l1659:
-							position, tokenIndex, depth = position1658, tokenIndex1658, depth1658
+							goto l1857
+						l1858:
+							position, tokenIndex, depth = position1857, tokenIndex1857, depth1857
 							if buffer[position] != rune('S') {
-								goto l1638
+								goto l1837
 							}
 							position++
 						}
-					l1658:
+					l1857:
 						{
-							position1660, tokenIndex1660, depth1660 := position, tokenIndex, depth
+							position1859, tokenIndex1859, depth1859 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l1661
+								goto l1860
 							}
 							position++
-							goto l1660
-						l1661:
-							position, tokenIndex, depth = position1660, tokenIndex1660, depth1660
+							goto l1859
+						l1860:
+							position, tokenIndex, depth = position1859, tokenIndex1859, depth1859
 							if buffer[position] != rune('E') {
-								goto l1638
+								goto l1837
 							}
 							position++
 						}
-					l1660:
-						if !rules[ruleskip]() {
-							goto l1638
+					l1859:
+						if !_rules[ruleskip]() {
+							goto l1837
 						}
 						depth--
-						add(ruleFALSE, position1651)
+						add(ruleFALSE, position1850)
 					}
 				}
-			l1640:
+			l1839:
 				depth--
-				add(rulebooleanLiteral, position1639)
+				add(rulebooleanLiteral, position1838)
 			}
 			return true
-		l1638:
-			position, tokenIndex, depth = position1638, tokenIndex1638, depth1638
+		l1837:
+			position, tokenIndex, depth = position1837, tokenIndex1837, depth1837
 			return false
 		},
-		/* 84 blankNode <- <(blankNodeLabel / anon)> */
+		/* 99 blankNode <- <(blankNodeLabel / anon)> */
 		nil,
-		/* 85 blankNodeLabel <- <('_' ':' (pnCharsU / [0-9]) (((pnCharsU / ((&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9])))* pnCharsU) / ([0-9] / '-'))? skip)> */
+		/* 100 blankNodeLabel <- <('_' ':' (pnCharsU / [0-9]) ('.'* pnChars)* skip)> */
 		nil,
-		/* 86 anon <- <('[' ws* ']' skip)> */
+		/* 101 anon <- <('[' ws* ']' skip)> */
 		nil,
-		/* 87 nil <- <('(' ws* ')' skip)> */
+		/* 102 nil <- <('(' ws* ')' skip)> */
 		func() bool {
-			position1665, tokenIndex1665, depth1665 := position, tokenIndex, depth
+			position1864, tokenIndex1864, depth1864 := position, tokenIndex, depth
 			{
-				position1666 := position
+				position1865 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l1665
+					goto l1864
 				}
 				position++
-			l1667:
+			l1866:
 				{
-					position1668, tokenIndex1668, depth1668 := position, tokenIndex, depth
-					if !rules[rulews]() {
-						goto l1668
+					position1867, tokenIndex1867, depth1867 := position, tokenIndex, depth
+					if !_rules[rulews]() {
+						goto l1867
 					}
-					goto l1667
-				l1668:
-					position, tokenIndex, depth = position1668, tokenIndex1668, depth1668
+					goto l1866
+				l1867:
+					position, tokenIndex, depth = position1867, tokenIndex1867, depth1867
 				}
 				if buffer[position] != rune(')') {
-					goto l1665
+					goto l1864
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1665
+				if !_rules[ruleskip]() {
+					goto l1864
 				}
 				depth--
-				add(rulenil, position1666)
+				add(rulenil, position1865)
 			}
 			return true
-		l1665:
-			position, tokenIndex, depth = position1665, tokenIndex1665, depth1665
+		l1864:
+			position, tokenIndex, depth = position1864, tokenIndex1864, depth1864
 			return false
 		},
-		/* 88 VARNAME <- <((pnCharsU / [0-9]) (pnCharsU / ((&('â') [‿-⁀]) | (&('Ì' | 'Í') [̀-ͯ]) | (&('Â') '·') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9])))*)> */
+		/* 103 VARNAME <- <((pnCharsU / [0-9]) (pnCharsU / [0-9] / '·' / [̀-ͯ] / [‿-⁀])*)> */
 		nil,
-		/* 89 pnPrefix <- <(pnCharsBase pnChars*)> */
+		/* 104 pnPrefix <- <(pnCharsBase ('.'* pnChars)*)> */
 		func() bool {
-			position1670, tokenIndex1670, depth1670 := position, tokenIndex, depth
+			position1869, tokenIndex1869, depth1869 := position, tokenIndex, depth
 			{
-				position1671 := position
+				position1870 := position
 				depth++
-				if !rules[rulepnCharsBase]() {
-					goto l1670
+				if !_rules[rulepnCharsBase]() {
+					goto l1869
 				}
-			l1672:
+			l1871:
 				{
-					position1673, tokenIndex1673, depth1673 := position, tokenIndex, depth
+					position1872, tokenIndex1872, depth1872 := position, tokenIndex, depth
+				l1873:
 					{
-						position1674 := position
-						depth++
-						{
-							switch buffer[position] {
-							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l1673
-								}
-								position++
-								break
-							case '-':
-								if buffer[position] != rune('-') {
-									goto l1673
-								}
-								position++
-								break
-							default:
-								if !rules[rulepnCharsU]() {
-									goto l1673
-								}
-								break
-							}
+						position1874, tokenIndex1874, depth1874 := position, tokenIndex, depth
+						if buffer[position] != rune('.') {
+							goto l1874
 						}
-
-						depth--
-						add(rulepnChars, position1674)
+						position++
+						goto l1873
+					l1874:
+						position, tokenIndex, depth = position1874, tokenIndex1874, depth1874
 					}
-					goto l1672
-				l1673:
-					position, tokenIndex, depth = position1673, tokenIndex1673, depth1673
+					if !_rules[rulepnChars]() {
+						goto l1872
+					}
+					goto l1871
+				l1872:
+					position, tokenIndex, depth = position1872, tokenIndex1872, depth1872
 				}
 				depth--
-				add(rulepnPrefix, position1671)
+				add(rulepnPrefix, position1870)
 			}
 			return true
-		l1670:
-			position, tokenIndex, depth = position1670, tokenIndex1670, depth1670
+		l1869:
+			position, tokenIndex, depth = position1869, tokenIndex1869, depth1869
 			return false
 		},
-		/* 90 pnLocal <- <((&('%' | '\\') plx) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&(':') ':') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | 'Ã' | 'Ä' | 'Å' | 'Æ' | 'Ç' | 'È' | 'É' | 'Ê' | 'Ë' | 'Í' | 'Î' | 'Ï' | 'Ð' | 'Ñ' | 'Ò' | 'Ó' | 'Ô' | 'Õ' | 'Ö' | '×' | 'Ø' | 'Ù' | 'Ú' | 'Û' | 'Ü' | 'Ý' | 'Þ' | 'ß' | 'à' | 'á' | 'â' | 'ã' | 'ä' | 'å' | 'æ' | 'ç' | 'è' | 'é' | 'ê' | 'ë' | 'ì' | 'í' | 'ï' | 'ð' | 'ñ' | 'ò' | 'ó') pnCharsU))+> */
-		nil,
-		/* 91 pnChars <- <((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('-') '-') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | 'Ã' | 'Ä' | 'Å' | 'Æ' | 'Ç' | 'È' | 'É' | 'Ê' | 'Ë' | 'Í' | 'Î' | 'Ï' | 'Ð' | 'Ñ' | 'Ò' | 'Ó' | 'Ô' | 'Õ' | 'Ö' | '×' | 'Ø' | 'Ù' | 'Ú' | 'Û' | 'Ü' | 'Ý' | 'Þ' | 'ß' | 'à' | 'á' | 'â' | 'ã' | 'ä' | 'å' | 'æ' | 'ç' | 'è' | 'é' | 'ê' | 'ë' | 'ì' | 'í' | 'ï' | 'ð' | 'ñ' | 'ò' | 'ó') pnCharsU))> */
+		/* 105 pnLocal <- <((pnCharsU / ':' / [0-9] / plx) ('.'* (pnChars / ':' / plx))*)> */
 		nil,
-		/* 92 pnCharsU <- <(pnCharsBase / '_')> */
+		/* 106 pnChars <- <(pnCharsU / '-' / [0-9] / '·' / [̀-ͯ] / [‿-⁀])> */
 		func() bool {
-			position1678, tokenIndex1678, depth1678 := position, tokenIndex, depth
+			position1876, tokenIndex1876, depth1876 := position, tokenIndex, depth
 			{
-				position1679 := position
+				position1877 := position
 				depth++
 				{
-					position1680, tokenIndex1680, depth1680 := position, tokenIndex, depth
-					if !rules[rulepnCharsBase]() {
-						goto l1681
+					position1878, tokenIndex1878, depth1878 := position, tokenIndex, depth
+					if !_rules[rulepnCharsU]() {
+						goto l1879
 					}
-					goto l1680
-				l1681:
-					position, tokenIndex, depth = position1680, tokenIndex1680, depth1680
+					goto l1878
+				l1879:
+					position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+					if buffer[position] != rune('-') {
+						goto l1880
+					}
+					position++
+					goto l1878
+				l1880:
+					position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1881
+					}
+					position++
+					goto l1878
+				l1881:
+					position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+					if buffer[position] != rune('·') {
+						goto l1882
+					}
+					position++
+					goto l1878
+				l1882:
+					position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+					if c := buffer[position]; c < rune('̀') || c > rune('ͯ') {
+						goto l1883
+					}
+					position++
+					goto l1878
+				l1883:
+					position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+					if c := buffer[position]; c < rune('‿') || c > rune('⁀') {
+						goto l1876
+					}
+					position++
+				}
+			l1878:
+				depth--
+				add(rulepnChars, position1877)
+			}
+			return true
+		l1876:
+			position, tokenIndex, depth = position1876, tokenIndex1876, depth1876
+			return false
+		},
+		/* 107 pnCharsU <- <(pnCharsBase / '_')> */
+		func() bool {
+			position1884, tokenIndex1884, depth1884 := position, tokenIndex, depth
+			{
+				position1885 := position
+				depth++
+				{
+					position1886, tokenIndex1886, depth1886 := position, tokenIndex, depth
+					if !_rules[rulepnCharsBase]() {
+						goto l1887
+					}
+					goto l1886
+				l1887:
+					position, tokenIndex, depth = position1886, tokenIndex1886, depth1886
 					if buffer[position] != rune('_') {
-						goto l1678
+						goto l1884
 					}
 					position++
 				}
-			l1680:
+			l1886:
 				depth--
-				add(rulepnCharsU, position1679)
+				add(rulepnCharsU, position1885)
 			}
 			return true
-		l1678:
-			position, tokenIndex, depth = position1678, tokenIndex1678, depth1678
+		l1884:
+			position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
 			return false
 		},
-		/* 93 pnCharsBase <- <([À-Ö] / [Ø-ö] / [Ͱ-ͽ] / [‌-‍] / [⁰-↏] / [豈-﷏] / ((&('ð' | 'ñ' | 'ò' | 'ó') [𐀀-󯿿]) | (&('ï') [ﷰ-�]) | (&('ã' | 'ä' | 'å' | 'æ' | 'ç' | 'è' | 'é' | 'ê' | 'ë' | 'ì' | 'í') [、-퟿]) | (&('â') [Ⰰ-⿯]) | (&('Í' | 'Î' | 'Ï' | 'Ð' | 'Ñ' | 'Ò' | 'Ó' | 'Ô' | 'Õ' | 'Ö' | '×' | 'Ø' | 'Ù' | 'Ú' | 'Û' | 'Ü' | 'Ý' | 'Þ' | 'ß' | 'à' | 'á') [Ϳ-῿]) | (&('Ã' | 'Ä' | 'Å' | 'Æ' | 'Ç' | 'È' | 'É' | 'Ê' | 'Ë') [ø-˿]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])))> */
+		/* 108 pnCharsBase <- <([a-z] / [A-Z] / [À-Ö] / [Ø-ö] / [ø-˿] / [Ͱ-ͽ] / [Ϳ-\u1fff] / [\u200c-\u200d] / [⁰-\u218f] / [Ⰰ-\u2fef] / [、-\ud7ff] / [豈-﷏] / [ﷰ-�] / [𐀀-\U000effff])> */
 		func() bool {
-			position1682, tokenIndex1682, depth1682 := position, tokenIndex, depth
+			position1888, tokenIndex1888, depth1888 := position, tokenIndex, depth
 			{
-				position1683 := position
+				position1889 := position
 				depth++
 				{
-					position1684, tokenIndex1684, depth1684 := position, tokenIndex, depth
+					position1890, tokenIndex1890, depth1890 := position, tokenIndex, depth
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l1891
+					}
+					position++
+					goto l1890
+				l1891:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l1892
+					}
+					position++
+					goto l1890
+				l1892:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
 					if c := buffer[position]; c < rune('À') || c > rune('Ö') {
-						goto l1685
+						goto l1893
 					}
 					position++
-					goto l1684
-				l1685:
-					position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+					goto l1890
+				l1893:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
 					if c := buffer[position]; c < rune('Ø') || c > rune('ö') {
-						goto l1686
+						goto l1894
+					}
+					position++
+					goto l1890
+				l1894:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+					if c := buffer[position]; c < rune('ø') || c > rune('˿') {
+						goto l1895
 					}
 					position++
-					goto l1684
-				l1686:
-					position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+					goto l1890
+				l1895:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
 					if c := buffer[position]; c < rune('Ͱ') || c > rune('ͽ') {
-						goto l1687
+						goto l1896
+					}
+					position++
+					goto l1890
+				l1896:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+					if c := buffer[position]; c < rune('Ϳ') || c > rune('\u1fff') {
+						goto l1897
 					}
 					position++
-					goto l1684
-				l1687:
-					position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+					goto l1890
+				l1897:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
 					if c := buffer[position]; c < rune('\u200c') || c > rune('\u200d') {
-						goto l1688
+						goto l1898
 					}
 					position++
-					goto l1684
-				l1688:
-					position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+					goto l1890
+				l1898:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
 					if c := buffer[position]; c < rune('⁰') || c > rune('\u218f') {
-						goto l1689
+						goto l1899
+					}
+					position++
+					goto l1890
+				l1899:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+					if c := buffer[position]; c < rune('Ⰰ') || c > rune('\u2fef') {
+						goto l1900
+					}
+					position++
+					goto l1890
+				l1900:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+					if c := buffer[position]; c < rune('、') || c > rune('\ud7ff') {
+						goto l1901
+					}
+					position++
+					goto l1890
+				l1901:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+					if c := buffer[position]; c < rune('豈') || c > rune('﷏') {
+						goto l1902
+					}
+					position++
+					goto l1890
+				l1902:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+					if c := buffer[position]; c < rune('ﷰ') || c > rune('�') {
+						goto l1903
 					}
 					position++
-					goto l1684
-				l1689:
-					position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
-					if c := buffer[position]; c < rune('豈') || c > rune('\ufdcf') {
-						goto l1690
+					goto l1890
+				l1903:
+					position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+					if c := buffer[position]; c < rune('𐀀') || c > rune('\U000effff') {
+						goto l1888
 					}
 					position++
-					goto l1684
-				l1690:
-					position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+				}
+			l1890:
+				depth--
+				add(rulepnCharsBase, position1889)
+			}
+			return true
+		l1888:
+			position, tokenIndex, depth = position1888, tokenIndex1888, depth1888
+			return false
+		},
+		/* 109 plx <- <(percent / pnLocalEsc)> */
+		func() bool {
+			position1904, tokenIndex1904, depth1904 := position, tokenIndex, depth
+			{
+				position1905 := position
+				depth++
+				{
+					position1906, tokenIndex1906, depth1906 := position, tokenIndex, depth
+					{
+						position1908 := position
+						depth++
+						if buffer[position] != rune('%') {
+							goto l1907
+						}
+						position++
+						if !_rules[rulehex]() {
+							goto l1907
+						}
+						if !_rules[rulehex]() {
+							goto l1907
+						}
+						depth--
+						add(rulepercent, position1908)
+					}
+					goto l1906
+				l1907:
+					position, tokenIndex, depth = position1906, tokenIndex1906, depth1906
 					{
-						switch buffer[position] {
-						case 'ð', 'ñ', 'ò', 'ó':
-							if c := buffer[position]; c < rune('𐀀') || c > rune('\U000effff') {
-								goto l1682
+						position1909 := position
+						depth++
+						if buffer[position] != rune('\\') {
+							goto l1904
+						}
+						position++
+						{
+							position1910, tokenIndex1910, depth1910 := position, tokenIndex, depth
+							if buffer[position] != rune('_') {
+								goto l1911
 							}
 							position++
-							break
-						case 'ï':
-							if c := buffer[position]; c < rune('ﷰ') || c > rune('�') {
-								goto l1682
+							goto l1910
+						l1911:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('~') {
+								goto l1912
 							}
 							position++
-							break
-						case 'ã', 'ä', 'å', 'æ', 'ç', 'è', 'é', 'ê', 'ë', 'ì', 'í':
-							if c := buffer[position]; c < rune('、') || c > rune('\ud7ff') {
-								goto l1682
+							goto l1910
+						l1912:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('.') {
+								goto l1913
 							}
 							position++
-							break
-						case 'â':
-							if c := buffer[position]; c < rune('Ⰰ') || c > rune('\u2fef') {
-								goto l1682
+							goto l1910
+						l1913:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('-') {
+								goto l1914
 							}
 							position++
-							break
-						case 'Í', 'Î', 'Ï', 'Ð', 'Ñ', 'Ò', 'Ó', 'Ô', 'Õ', 'Ö', '×', 'Ø', 'Ù', 'Ú', 'Û', 'Ü', 'Ý', 'Þ', 'ß', 'à', 'á':
-							if c := buffer[position]; c < rune('\u037f') || c > rune('\u1fff') {
-								goto l1682
+							goto l1910
+						l1914:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('!') {
+								goto l1915
 							}
 							position++
-							break
-						case 'Ã', 'Ä', 'Å', 'Æ', 'Ç', 'È', 'É', 'Ê', 'Ë':
-							if c := buffer[position]; c < rune('ø') || c > rune('˿') {
-								goto l1682
+							goto l1910
+						l1915:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('$') {
+								goto l1916
 							}
 							position++
-							break
-						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1682
+							goto l1910
+						l1916:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('&') {
+								goto l1917
 							}
 							position++
-							break
-						default:
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1682
+							goto l1910
+						l1917:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('\'') {
+								goto l1918
+							}
+							position++
+							goto l1910
+						l1918:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('(') {
+								goto l1919
+							}
+							position++
+							goto l1910
+						l1919:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune(')') {
+								goto l1920
+							}
+							position++
+							goto l1910
+						l1920:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('*') {
+								goto l1921
+							}
+							position++
+							goto l1910
+						l1921:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('+') {
+								goto l1922
+							}
+							position++
+							goto l1910
+						l1922:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune(',') {
+								goto l1923
+							}
+							position++
+							goto l1910
+						l1923:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune(';') {
+								goto l1924
+							}
+							position++
+							goto l1910
+						l1924:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('=') {
+								goto l1925
+							}
+							position++
+							goto l1910
+						l1925:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('/') {
+								goto l1926
+							}
+							position++
+							goto l1910
+						l1926:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('?') {
+								goto l1927
+							}
+							position++
+							goto l1910
+						l1927:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('#') {
+								goto l1928
+							}
+							position++
+							goto l1910
+						l1928:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('@') {
+								goto l1929
+							}
+							position++
+							goto l1910
+						l1929:
+							position, tokenIndex, depth = position1910, tokenIndex1910, depth1910
+							if buffer[position] != rune('%') {
+								goto l1904
 							}
 							position++
-							break
 						}
+					l1910:
+						depth--
+						add(rulepnLocalEsc, position1909)
 					}
-
 				}
-			l1684:
+			l1906:
 				depth--
-				add(rulepnCharsBase, position1683)
+				add(ruleplx, position1905)
 			}
 			return true
-		l1682:
-			position, tokenIndex, depth = position1682, tokenIndex1682, depth1682
+		l1904:
+			position, tokenIndex, depth = position1904, tokenIndex1904, depth1904
 			return false
 		},
-		/* 94 plx <- <(percent / pnLocalEsc)> */
+		/* 110 percent <- <('%' hex hex)> */
 		nil,
-		/* 95 percent <- <('%' hex hex)> */
-		nil,
-		/* 96 hex <- <((&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]))> */
+		/* 111 hex <- <([0-9] / [a-f] / [A-F])> */
 		func() bool {
-			position1694, tokenIndex1694, depth1694 := position, tokenIndex, depth
+			position1931, tokenIndex1931, depth1931 := position, tokenIndex, depth
 			{
-				position1695 := position
+				position1932 := position
 				depth++
 				{
-					switch buffer[position] {
-					case 'a', 'b', 'c', 'd', 'e', 'f':
-						if c := buffer[position]; c < rune('a') || c > rune('f') {
-							goto l1694
-						}
-						position++
-						break
-					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1694
-						}
-						position++
-						break
-					default:
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1694
-						}
-						position++
-						break
+					position1933, tokenIndex1933, depth1933 := position, tokenIndex, depth
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1934
+					}
+					position++
+					goto l1933
+				l1934:
+					position, tokenIndex, depth = position1933, tokenIndex1933, depth1933
+					if c := buffer[position]; c < rune('a') || c > rune('f') {
+						goto l1935
 					}
+					position++
+					goto l1933
+				l1935:
+					position, tokenIndex, depth = position1933, tokenIndex1933, depth1933
+					if c := buffer[position]; c < rune('A') || c > rune('F') {
+						goto l1931
+					}
+					position++
 				}
-
+			l1933:
 				depth--
-				add(rulehex, position1695)
+				add(rulehex, position1932)
 			}
 			return true
-		l1694:
-			position, tokenIndex, depth = position1694, tokenIndex1694, depth1694
+		l1931:
+			position, tokenIndex, depth = position1931, tokenIndex1931, depth1931
 			return false
 		},
-		/* 97 pnLocalEsc <- <('\\' ((&('%') '%') | (&('@') '@') | (&('#') '#') | (&('?') '?') | (&('/') '/') | (&('=') '=') | (&(';') ';') | (&(',') ',') | (&('+') '+') | (&('*') '*') | (&(')') ')') | (&('(') '(') | (&('\'') '\'') | (&('&') '&') | (&('$') '$') | (&('!') '!') | (&('-') '-') | (&('.') '.') | (&('~') '~') | (&('_') '_')))> */
+		/* 112 pnLocalEsc <- <('\\' ('_' / '~' / '.' / '-' / '!' / '$' / '&' / '\'' / '(' / ')' / '*' / '+' / ',' / ';' / '=' / '/' / '?' / '#' / '@' / '%'))> */
 		nil,
-		/* 98 PREFIX <- <(('p' / 'P') ('r' / 'R') ('e' / 'E') ('f' / 'F') ('i' / 'I') ('x' / 'X') skip)> */
+		/* 113 PREFIX <- <(('p' / 'P') ('r' / 'R') ('e' / 'E') ('f' / 'F') ('i' / 'I') ('x' / 'X') skip)> */
 		nil,
-		/* 99 TRUE <- <(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E') skip)> */
+		/* 114 TRUE <- <(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E') skip)> */
 		nil,
-		/* 100 FALSE <- <(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E') skip)> */
+		/* 115 FALSE <- <(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E') skip)> */
 		nil,
-		/* 101 BASE <- <(('b' / 'B') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
+		/* 116 BASE <- <(('b' / 'B') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
 		nil,
-		/* 102 SELECT <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') skip)> */
+		/* 117 SELECT <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') skip)> */
 		nil,
-		/* 103 REDUCED <- <(('r' / 'R') ('e' / 'E') ('d' / 'D') ('u' / 'U') ('c' / 'C') ('e' / 'E') ('d' / 'D') skip)> */
+		/* 118 REDUCED <- <(('r' / 'R') ('e' / 'E') ('d' / 'D') ('u' / 'U') ('c' / 'C') ('e' / 'E') ('d' / 'D') skip)> */
 		nil,
-		/* 104 DISTINCT <- <(('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T') skip)> */
+		/* 119 DISTINCT <- <(('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T') skip)> */
 		func() bool {
-			position1704, tokenIndex1704, depth1704 := position, tokenIndex, depth
+			position1943, tokenIndex1943, depth1943 := position, tokenIndex, depth
 			{
-				position1705 := position
+				position1944 := position
 				depth++
 				{
-					position1706, tokenIndex1706, depth1706 := position, tokenIndex, depth
+					position1945, tokenIndex1945, depth1945 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l1707
+						goto l1946
 					}
 					position++
-					goto l1706
-				l1707:
-					position, tokenIndex, depth = position1706, tokenIndex1706, depth1706
+					goto l1945
+				l1946:
+					position, tokenIndex, depth = position1945, tokenIndex1945, depth1945
 					if buffer[position] != rune('D') {
-						goto l1704
+						goto l1943
 					}
 					position++
 				}
-			l1706:
+			l1945:
 				{
-					position1708, tokenIndex1708, depth1708 := position, tokenIndex, depth
+					position1947, tokenIndex1947, depth1947 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l1709
+						goto l1948
 					}
 					position++
-					goto l1708
-				l1709:
-					position, tokenIndex, depth = position1708, tokenIndex1708, depth1708
+					goto l1947
+				l1948:
+					position, tokenIndex, depth = position1947, tokenIndex1947, depth1947
 					if buffer[position] != rune('I') {
-						goto l1704
+						goto l1943
 					}
 					position++
 				}
-			l1708:
+			l1947:
 				{
-					position1710, tokenIndex1710, depth1710 := position, tokenIndex, depth
+					position1949, tokenIndex1949, depth1949 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l1711
+						goto l1950
 					}
 					position++
-					goto l1710
-				l1711:
-					position, tokenIndex, depth = position1710, tokenIndex1710, depth1710
+					goto l1949
+				l1950:
+					position, tokenIndex, depth = position1949, tokenIndex1949, depth1949
 					if buffer[position] != rune('S') {
-						goto l1704
+						goto l1943
 					}
 					position++
 				}
-			l1710:
+			l1949:
 				{
-					position1712, tokenIndex1712, depth1712 := position, tokenIndex, depth
+					position1951, tokenIndex1951, depth1951 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l1713
+						goto l1952
 					}
 					position++
-					goto l1712
-				l1713:
-					position, tokenIndex, depth = position1712, tokenIndex1712, depth1712
+					goto l1951
+				l1952:
+					position, tokenIndex, depth = position1951, tokenIndex1951, depth1951
 					if buffer[position] != rune('T') {
-						goto l1704
+						goto l1943
 					}
 					position++
 				}
-			l1712:
+			l1951:
 				{
-					position1714, tokenIndex1714, depth1714 := position, tokenIndex, depth
+					position1953, tokenIndex1953, depth1953 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l1715
+						goto l1954
 					}
 					position++
-					goto l1714
-				l1715:
-					position, tokenIndex, depth = position1714, tokenIndex1714, depth1714
+					goto l1953
+				l1954:
+					position, tokenIndex, depth = position1953, tokenIndex1953, depth1953
 					if buffer[position] != rune('I') {
-						goto l1704
+						goto l1943
 					}
 					position++
 				}
-			l1714:
+			l1953:
 				{
-					position1716, tokenIndex1716, depth1716 := position, tokenIndex, depth
+					position1955, tokenIndex1955, depth1955 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l1717
+						goto l1956
 					}
 					position++
-					goto l1716
-				l1717:
-					position, tokenIndex, depth = position1716, tokenIndex1716, depth1716
+					goto l1955
+				l1956:
+					position, tokenIndex, depth = position1955, tokenIndex1955, depth1955
 					if buffer[position] != rune('N') {
-						goto l1704
+						goto l1943
 					}
 					position++
 				}
-			l1716:
+			l1955:
 				{
-					position1718, tokenIndex1718, depth1718 := position, tokenIndex, depth
+					position1957, tokenIndex1957, depth1957 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l1719
+						goto l1958
 					}
 					position++
-					goto l1718
-				l1719:
-					position, tokenIndex, depth = position1718, tokenIndex1718, depth1718
+					goto l1957
+				l1958:
+					position, tokenIndex, depth = position1957, tokenIndex1957, depth1957
 					if buffer[position] != rune('C') {
-						goto l1704
+						goto l1943
 					}
 					position++
 				}
-			l1718:
+			l1957:
 				{
-					position1720, tokenIndex1720, depth1720 := position, tokenIndex, depth
+					position1959, tokenIndex1959, depth1959 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l1721
+						goto l1960
 					}
 					position++
-					goto l1720
-				l1721:
-					position, tokenIndex, depth = position1720, tokenIndex1720, depth1720
+					goto l1959
+				l1960:
+					position, tokenIndex, depth = position1959, tokenIndex1959, depth1959
 					if buffer[position] != rune('T') {
-						goto l1704
+						goto l1943
 					}
 					position++
 				}
-			l1720:
-				if !rules[ruleskip]() {
-					goto l1704
+			l1959:
+				if !_rules[ruleskip]() {
+					goto l1943
 				}
 				depth--
-				add(ruleDISTINCT, position1705)
+				add(ruleDISTINCT, position1944)
 			}
 			return true
-		l1704:
-			position, tokenIndex, depth = position1704, tokenIndex1704, depth1704
+		l1943:
+			position, tokenIndex, depth = position1943, tokenIndex1943, depth1943
 			return false
 		},
-		/* 105 FROM <- <(('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M') skip)> */
+		/* 120 FROM <- <(('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M') skip)> */
 		nil,
-		/* 106 NAMED <- <(('n' / 'N') ('a' / 'A') ('m' / 'M') ('e' / 'E') ('d' / 'D') skip)> */
+		/* 121 NAMED <- <(('n' / 'N') ('a' / 'A') ('m' / 'M') ('e' / 'E') ('d' / 'D') skip)> */
 		nil,
-		/* 107 WHERE <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E') skip)> */
+		/* 122 WHERE <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E') skip)> */
 		nil,
-		/* 108 LBRACE <- <('{' skip)> */
+		/* 123 LBRACE <- <('{' skip)> */
 		func() bool {
-			position1725, tokenIndex1725, depth1725 := position, tokenIndex, depth
+			position1964, tokenIndex1964, depth1964 := position, tokenIndex, depth
 			{
-				position1726 := position
+				position1965 := position
 				depth++
 				if buffer[position] != rune('{') {
-					goto l1725
+					goto l1964
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1725
+				if !_rules[ruleskip]() {
+					goto l1964
 				}
 				depth--
-				add(ruleLBRACE, position1726)
+				add(ruleLBRACE, position1965)
 			}
 			return true
-		l1725:
-			position, tokenIndex, depth = position1725, tokenIndex1725, depth1725
+		l1964:
+			position, tokenIndex, depth = position1964, tokenIndex1964, depth1964
 			return false
 		},
-		/* 109 RBRACE <- <('}' skip)> */
+		/* 124 RBRACE <- <('}' skip)> */
 		func() bool {
-			position1727, tokenIndex1727, depth1727 := position, tokenIndex, depth
+			position1966, tokenIndex1966, depth1966 := position, tokenIndex, depth
 			{
-				position1728 := position
+				position1967 := position
 				depth++
 				if buffer[position] != rune('}') {
-					goto l1727
+					goto l1966
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1727
+				if !_rules[ruleskip]() {
+					goto l1966
 				}
 				depth--
-				add(ruleRBRACE, position1728)
+				add(ruleRBRACE, position1967)
 			}
 			return true
-		l1727:
-			position, tokenIndex, depth = position1727, tokenIndex1727, depth1727
+		l1966:
+			position, tokenIndex, depth = position1966, tokenIndex1966, depth1966
 			return false
 		},
-		/* 110 LBRACK <- <('[' skip)> */
+		/* 125 LBRACK <- <('[' skip)> */
 		nil,
-		/* 111 RBRACK <- <(']' skip)> */
+		/* 126 RBRACK <- <(']' skip)> */
 		nil,
-		/* 112 SEMICOLON <- <(';' skip)> */
+		/* 127 SEMICOLON <- <(';' skip)> */
 		func() bool {
-			position1731, tokenIndex1731, depth1731 := position, tokenIndex, depth
+			position1970, tokenIndex1970, depth1970 := position, tokenIndex, depth
 			{
-				position1732 := position
+				position1971 := position
 				depth++
 				if buffer[position] != rune(';') {
-					goto l1731
+					goto l1970
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1731
+				if !_rules[ruleskip]() {
+					goto l1970
 				}
 				depth--
-				add(ruleSEMICOLON, position1732)
+				add(ruleSEMICOLON, position1971)
 			}
 			return true
-		l1731:
-			position, tokenIndex, depth = position1731, tokenIndex1731, depth1731
+		l1970:
+			position, tokenIndex, depth = position1970, tokenIndex1970, depth1970
 			return false
 		},
-		/* 113 COMMA <- <(',' skip)> */
+		/* 128 COMMA <- <(',' skip)> */
 		func() bool {
-			position1733, tokenIndex1733, depth1733 := position, tokenIndex, depth
+			position1972, tokenIndex1972, depth1972 := position, tokenIndex, depth
 			{
-				position1734 := position
+				position1973 := position
 				depth++
 				if buffer[position] != rune(',') {
-					goto l1733
+					goto l1972
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1733
+				if !_rules[ruleskip]() {
+					goto l1972
 				}
 				depth--
-				add(ruleCOMMA, position1734)
+				add(ruleCOMMA, position1973)
 			}
 			return true
-		l1733:
-			position, tokenIndex, depth = position1733, tokenIndex1733, depth1733
+		l1972:
+			position, tokenIndex, depth = position1972, tokenIndex1972, depth1972
 			return false
 		},
-		/* 114 DOT <- <('.' skip)> */
+		/* 129 DOT <- <('.' skip)> */
 		func() bool {
-			position1735, tokenIndex1735, depth1735 := position, tokenIndex, depth
+			position1974, tokenIndex1974, depth1974 := position, tokenIndex, depth
 			{
-				position1736 := position
+				position1975 := position
 				depth++
 				if buffer[position] != rune('.') {
-					goto l1735
+					goto l1974
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1735
+				if !_rules[ruleskip]() {
+					goto l1974
 				}
 				depth--
-				add(ruleDOT, position1736)
+				add(ruleDOT, position1975)
 			}
 			return true
-		l1735:
-			position, tokenIndex, depth = position1735, tokenIndex1735, depth1735
+		l1974:
+			position, tokenIndex, depth = position1974, tokenIndex1974, depth1974
 			return false
 		},
-		/* 115 COLON <- <(':' skip)> */
+		/* 130 COLON <- <(':' skip)> */
 		nil,
-		/* 116 PIPE <- <('|' skip)> */
+		/* 131 PIPE <- <('|' skip)> */
 		func() bool {
-			position1738, tokenIndex1738, depth1738 := position, tokenIndex, depth
+			position1977, tokenIndex1977, depth1977 := position, tokenIndex, depth
 			{
-				position1739 := position
+				position1978 := position
 				depth++
 				if buffer[position] != rune('|') {
-					goto l1738
+					goto l1977
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1738
+				if !_rules[ruleskip]() {
+					goto l1977
 				}
 				depth--
-				add(rulePIPE, position1739)
+				add(rulePIPE, position1978)
 			}
 			return true
-		l1738:
-			position, tokenIndex, depth = position1738, tokenIndex1738, depth1738
+		l1977:
+			position, tokenIndex, depth = position1977, tokenIndex1977, depth1977
 			return false
 		},
-		/* 117 SLASH <- <('/' skip)> */
+		/* 132 SLASH <- <('/' skip)> */
 		func() bool {
-			position1740, tokenIndex1740, depth1740 := position, tokenIndex, depth
+			position1979, tokenIndex1979, depth1979 := position, tokenIndex, depth
 			{
-				position1741 := position
+				position1980 := position
 				depth++
 				if buffer[position] != rune('/') {
-					goto l1740
+					goto l1979
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1740
+				if !_rules[ruleskip]() {
+					goto l1979
 				}
 				depth--
-				add(ruleSLASH, position1741)
+				add(ruleSLASH, position1980)
 			}
 			return true
-		l1740:
-			position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
+		l1979:
+			position, tokenIndex, depth = position1979, tokenIndex1979, depth1979
 			return false
 		},
-		/* 118 INVERSE <- <('^' skip)> */
+		/* 133 INVERSE <- <('^' skip)> */
 		func() bool {
-			position1742, tokenIndex1742, depth1742 := position, tokenIndex, depth
+			position1981, tokenIndex1981, depth1981 := position, tokenIndex, depth
 			{
-				position1743 := position
+				position1982 := position
 				depth++
 				if buffer[position] != rune('^') {
-					goto l1742
+					goto l1981
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1742
+				if !_rules[ruleskip]() {
+					goto l1981
 				}
 				depth--
-				add(ruleINVERSE, position1743)
+				add(ruleINVERSE, position1982)
 			}
 			return true
-		l1742:
-			position, tokenIndex, depth = position1742, tokenIndex1742, depth1742
+		l1981:
+			position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
 			return false
 		},
-		/* 119 LPAREN <- <('(' skip)> */
+		/* 134 LPAREN <- <('(' skip)> */
 		func() bool {
-			position1744, tokenIndex1744, depth1744 := position, tokenIndex, depth
+			position1983, tokenIndex1983, depth1983 := position, tokenIndex, depth
 			{
-				position1745 := position
+				position1984 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l1744
+					goto l1983
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1744
+				if !_rules[ruleskip]() {
+					goto l1983
 				}
 				depth--
-				add(ruleLPAREN, position1745)
+				add(ruleLPAREN, position1984)
 			}
 			return true
-		l1744:
-			position, tokenIndex, depth = position1744, tokenIndex1744, depth1744
+		l1983:
+			position, tokenIndex, depth = position1983, tokenIndex1983, depth1983
 			return false
 		},
-		/* 120 RPAREN <- <(')' skip)> */
+		/* 135 RPAREN <- <(')' skip)> */
 		func() bool {
-			position1746, tokenIndex1746, depth1746 := position, tokenIndex, depth
+			position1985, tokenIndex1985, depth1985 := position, tokenIndex, depth
 			{
-				position1747 := position
+				position1986 := position
 				depth++
 				if buffer[position] != rune(')') {
-					goto l1746
+					goto l1985
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1746
+				if !_rules[ruleskip]() {
+					goto l1985
 				}
 				depth--
-				add(ruleRPAREN, position1747)
+				add(ruleRPAREN, position1986)
 			}
 			return true
-		l1746:
-			position, tokenIndex, depth = position1746, tokenIndex1746, depth1746
+		l1985:
+			position, tokenIndex, depth = position1985, tokenIndex1985, depth1985
 			return false
 		},
-		/* 121 ISA <- <('a' skip)> */
+		/* 136 ISA <- <('a' skip)> */
 		func() bool {
-			position1748, tokenIndex1748, depth1748 := position, tokenIndex, depth
+			position1987, tokenIndex1987, depth1987 := position, tokenIndex, depth
 			{
-				position1749 := position
+				position1988 := position
 				depth++
 				if buffer[position] != rune('a') {
-					goto l1748
+					goto l1987
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1748
+				if !_rules[ruleskip]() {
+					goto l1987
 				}
 				depth--
-				add(ruleISA, position1749)
+				add(ruleISA, position1988)
 			}
 			return true
-		l1748:
-			position, tokenIndex, depth = position1748, tokenIndex1748, depth1748
+		l1987:
+			position, tokenIndex, depth = position1987, tokenIndex1987, depth1987
 			return false
 		},
-		/* 122 NOT <- <('!' skip)> */
+		/* 137 NOT <- <('!' skip)> */
 		func() bool {
-			position1750, tokenIndex1750, depth1750 := position, tokenIndex, depth
+			position1989, tokenIndex1989, depth1989 := position, tokenIndex, depth
 			{
-				position1751 := position
+				position1990 := position
 				depth++
 				if buffer[position] != rune('!') {
-					goto l1750
+					goto l1989
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1750
+				if !_rules[ruleskip]() {
+					goto l1989
 				}
 				depth--
-				add(ruleNOT, position1751)
+				add(ruleNOT, position1990)
 			}
 			return true
-		l1750:
-			position, tokenIndex, depth = position1750, tokenIndex1750, depth1750
+		l1989:
+			position, tokenIndex, depth = position1989, tokenIndex1989, depth1989
 			return false
 		},
-		/* 123 STAR <- <('*' skip)> */
+		/* 138 STAR <- <('*' skip)> */
 		func() bool {
-			position1752, tokenIndex1752, depth1752 := position, tokenIndex, depth
+			position1991, tokenIndex1991, depth1991 := position, tokenIndex, depth
 			{
-				position1753 := position
+				position1992 := position
 				depth++
 				if buffer[position] != rune('*') {
-					goto l1752
+					goto l1991
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1752
+				if !_rules[ruleskip]() {
+					goto l1991
 				}
 				depth--
-				add(ruleSTAR, position1753)
+				add(ruleSTAR, position1992)
 			}
 			return true
-		l1752:
-			position, tokenIndex, depth = position1752, tokenIndex1752, depth1752
+		l1991:
+			position, tokenIndex, depth = position1991, tokenIndex1991, depth1991
 			return false
 		},
-		/* 124 QUESTION <- <('?' skip)> */
+		/* 139 QUESTION <- <('?' skip)> */
 		nil,
-		/* 125 PLUS <- <('+' skip)> */
+		/* 140 PLUS <- <('+' skip)> */
 		func() bool {
-			position1755, tokenIndex1755, depth1755 := position, tokenIndex, depth
+			position1994, tokenIndex1994, depth1994 := position, tokenIndex, depth
 			{
-				position1756 := position
+				position1995 := position
 				depth++
 				if buffer[position] != rune('+') {
-					goto l1755
+					goto l1994
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1755
+				if !_rules[ruleskip]() {
+					goto l1994
 				}
 				depth--
-				add(rulePLUS, position1756)
+				add(rulePLUS, position1995)
 			}
 			return true
-		l1755:
-			position, tokenIndex, depth = position1755, tokenIndex1755, depth1755
+		l1994:
+			position, tokenIndex, depth = position1994, tokenIndex1994, depth1994
 			return false
 		},
-		/* 126 MINUS <- <('-' skip)> */
+		/* 141 MINUS <- <('-' skip)> */
 		func() bool {
-			position1757, tokenIndex1757, depth1757 := position, tokenIndex, depth
+			position1996, tokenIndex1996, depth1996 := position, tokenIndex, depth
 			{
-				position1758 := position
+				position1997 := position
 				depth++
 				if buffer[position] != rune('-') {
-					goto l1757
+					goto l1996
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1757
+				if !_rules[ruleskip]() {
+					goto l1996
 				}
 				depth--
-				add(ruleMINUS, position1758)
+				add(ruleMINUS, position1997)
 			}
 			return true
-		l1757:
-			position, tokenIndex, depth = position1757, tokenIndex1757, depth1757
+		l1996:
+			position, tokenIndex, depth = position1996, tokenIndex1996, depth1996
 			return false
 		},
-		/* 127 OPTIONAL <- <(('o' / 'O') ('p' / 'P') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') ('a' / 'A') ('l' / 'L') skip)> */
+		/* 142 OPTIONAL <- <(('o' / 'O') ('p' / 'P') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') ('a' / 'A') ('l' / 'L') skip)> */
 		nil,
-		/* 128 UNION <- <(('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N') skip)> */
+		/* 143 UNION <- <(('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N') skip)> */
 		nil,
-		/* 129 LIMIT <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') skip)> */
+		/* 144 LIMIT <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') skip)> */
 		nil,
-		/* 130 OFFSET <- <(('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T') skip)> */
+		/* 145 OFFSET <- <(('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T') skip)> */
 		nil,
-		/* 131 INTEGER <- <([0-9]+ skip)> */
+		/* 146 INTEGER <- <([0-9]+ skip)> */
 		func() bool {
-			position1763, tokenIndex1763, depth1763 := position, tokenIndex, depth
+			position2002, tokenIndex2002, depth2002 := position, tokenIndex, depth
 			{
-				position1764 := position
+				position2003 := position
 				depth++
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l1763
+					goto l2002
 				}
 				position++
-			l1765:
+			l2004:
 				{
-					position1766, tokenIndex1766, depth1766 := position, tokenIndex, depth
+					position2005, tokenIndex2005, depth2005 := position, tokenIndex, depth
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1766
+						goto l2005
 					}
 					position++
-					goto l1765
-				l1766:
-					position, tokenIndex, depth = position1766, tokenIndex1766, depth1766
+					goto l2004
+				l2005:
+					position, tokenIndex, depth = position2005, tokenIndex2005, depth2005
 				}
-				if !rules[ruleskip]() {
-					goto l1763
+				if !_rules[ruleskip]() {
+					goto l2002
 				}
 				depth--
-				add(ruleINTEGER, position1764)
+				add(ruleINTEGER, position2003)
 			}
 			return true
-		l1763:
-			position, tokenIndex, depth = position1763, tokenIndex1763, depth1763
+		l2002:
+			position, tokenIndex, depth = position2002, tokenIndex2002, depth2002
 			return false
 		},
-		/* 132 CONSTRUCT <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('u' / 'U') ('c' / 'C') ('t' / 'T') skip)> */
+		/* 147 CONSTRUCT <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('u' / 'U') ('c' / 'C') ('t' / 'T') skip)> */
 		nil,
-		/* 133 DESCRIBE <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('e' / 'E') skip)> */
+		/* 148 DESCRIBE <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('e' / 'E') skip)> */
 		nil,
-		/* 134 ASK <- <(('a' / 'A') ('s' / 'S') ('k' / 'K') skip)> */
+		/* 149 ASK <- <(('a' / 'A') ('s' / 'S') ('k' / 'K') skip)> */
 		nil,
-		/* 135 OR <- <('|' '|' skip)> */
+		/* 150 OR <- <('|' '|' skip)> */
 		nil,
-		/* 136 AND <- <('&' '&' skip)> */
+		/* 151 AND <- <('&' '&' skip)> */
 		nil,
-		/* 137 EQ <- <('=' skip)> */
+		/* 152 EQ <- <('=' skip)> */
 		func() bool {
-			position1772, tokenIndex1772, depth1772 := position, tokenIndex, depth
+			position2011, tokenIndex2011, depth2011 := position, tokenIndex, depth
 			{
-				position1773 := position
+				position2012 := position
 				depth++
 				if buffer[position] != rune('=') {
-					goto l1772
+					goto l2011
 				}
 				position++
-				if !rules[ruleskip]() {
-					goto l1772
+				if !_rules[ruleskip]() {
+					goto l2011
 				}
 				depth--
-				add(ruleEQ, position1773)
+				add(ruleEQ, position2012)
 			}
 			return true
-		l1772:
-			position, tokenIndex, depth = position1772, tokenIndex1772, depth1772
+		l2011:
+			position, tokenIndex, depth = position2011, tokenIndex2011, depth2011
 			return false
 		},
-		/* 138 NE <- <('!' '=' skip)> */
+		/* 153 NE <- <('!' '=' skip)> */
 		nil,
-		/* 139 GT <- <('>' skip)> */
+		/* 154 GT <- <('>' skip)> */
 		nil,
-		/* 140 LT <- <('<' skip)> */
+		/* 155 LT <- <('<' skip)> */
 		nil,
-		/* 141 LE <- <('<' '=' skip)> */
+		/* 156 LE <- <('<' '=' skip)> */
 		nil,
-		/* 142 GE <- <('>' '=' skip)> */
+		/* 157 GE <- <('>' '=' skip)> */
 		nil,
-		/* 143 IN <- <(('i' / 'I') ('n' / 'N') skip)> */
+		/* 158 IN <- <(('i' / 'I') ('n' / 'N') skip)> */
 		nil,
-		/* 144 NOTIN <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') ' ' ('i' / 'I') ('n' / 'N') skip)> */
+		/* 159 NOTIN <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') ' ' ('i' / 'I') ('n' / 'N') skip)> */
 		nil,
-		/* 145 AS <- <(('a' / 'A') ('s' / 'S') skip)> */
+		/* 160 AS <- <(('a' / 'A') ('s' / 'S') skip)> */
 		func() bool {
-			position1781, tokenIndex1781, depth1781 := position, tokenIndex, depth
+			position2020, tokenIndex2020, depth2020 := position, tokenIndex, depth
 			{
-				position1782 := position
+				position2021 := position
 				depth++
 				{
-					position1783, tokenIndex1783, depth1783 := position, tokenIndex, depth
+					position2022, tokenIndex2022, depth2022 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l1784
+						goto l2023
 					}
 					position++
-					goto l1783
-				l1784:
-					position, tokenIndex, depth = position1783, tokenIndex1783, depth1783
+					goto l2022
+				l2023:
+					position, tokenIndex, depth = position2022, tokenIndex2022, depth2022
 					if buffer[position] != rune('A') {
-						goto l1781
+						goto l2020
 					}
 					position++
 				}
-			l1783:
+			l2022:
 				{
-					position1785, tokenIndex1785, depth1785 := position, tokenIndex, depth
+					position2024, tokenIndex2024, depth2024 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l1786
+						goto l2025
 					}
 					position++
-					goto l1785
-				l1786:
-					position, tokenIndex, depth = position1785, tokenIndex1785, depth1785
+					goto l2024
+				l2025:
+					position, tokenIndex, depth = position2024, tokenIndex2024, depth2024
 					if buffer[position] != rune('S') {
-						goto l1781
+						goto l2020
 					}
 					position++
 				}
-			l1785:
-				if !rules[ruleskip]() {
-					goto l1781
+			l2024:
+				if !_rules[ruleskip]() {
+					goto l2020
 				}
 				depth--
-				add(ruleAS, position1782)
+				add(ruleAS, position2021)
 			}
 			return true
-		l1781:
-			position, tokenIndex, depth = position1781, tokenIndex1781, depth1781
+		l2020:
+			position, tokenIndex, depth = position2020, tokenIndex2020, depth2020
 			return false
 		},
-		/* 146 STR <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') skip)> */
+		/* 161 STR <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') skip)> */
 		nil,
-		/* 147 LANG <- <(('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') skip)> */
+		/* 162 LANG <- <(('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') skip)> */
 		nil,
-		/* 148 DATATYPE <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E') skip)> */
+		/* 163 DATATYPE <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E') skip)> */
 		nil,
-		/* 149 IRI <- <(('i' / 'I') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 164 IRI <- <(('i' / 'I') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 150 URI <- <(('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 165 URI <- <(('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 151 ABS <- <(('a' / 'A') ('b' / 'B') ('s' / 'S') skip)> */
+		/* 166 ABS <- <(('a' / 'A') ('b' / 'B') ('s' / 'S') skip)> */
 		nil,
-		/* 152 CEIL <- <(('c' / 'C') ('e' / 'E') ('i' / 'I') ('l' / 'L') skip)> */
+		/* 167 CEIL <- <(('c' / 'C') ('e' / 'E') ('i' / 'I') ('l' / 'L') skip)> */
 		nil,
-		/* 153 ROUND <- <(('r' / 'R') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('d' / 'D') skip)> */
+		/* 168 ROUND <- <(('r' / 'R') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('d' / 'D') skip)> */
 		nil,
-		/* 154 FLOOR <- <(('f' / 'F') ('l' / 'L') ('o' / 'O') ('o' / 'O') ('r' / 'R') skip)> */
+		/* 169 FLOOR <- <(('f' / 'F') ('l' / 'L') ('o' / 'O') ('o' / 'O') ('r' / 'R') skip)> */
 		nil,
-		/* 155 STRLEN <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('l' / 'L') ('e' / 'E') ('n' / 'N') skip)> */
+		/* 170 STRLEN <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('l' / 'L') ('e' / 'E') ('n' / 'N') skip)> */
 		nil,
-		/* 156 UCASE <- <(('u' / 'U') ('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
+		/* 171 UCASE <- <(('u' / 'U') ('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
 		nil,
-		/* 157 LCASE <- <(('l' / 'L') ('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
+		/* 172 LCASE <- <(('l' / 'L') ('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') skip)> */
 		nil,
-		/* 158 ENCODEFORURI <- <(('e' / 'E') ('n' / 'N') ('c' / 'C') ('o' / 'O') ('d' / 'D') ('e' / 'E') '_' ('f' / 'F') ('o' / 'O') ('r' / 'R') '_' ('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 173 ENCODEFORURI <- <(('e' / 'E') ('n' / 'N') ('c' / 'C') ('o' / 'O') ('d' / 'D') ('e' / 'E') '_' ('f' / 'F') ('o' / 'O') ('r' / 'R') '_' ('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 159 YEAR <- <(('y' / 'Y') ('e' / 'E') ('a' / 'A') ('r' / 'R') skip)> */
+		/* 174 YEAR <- <(('y' / 'Y') ('e' / 'E') ('a' / 'A') ('r' / 'R') skip)> */
 		nil,
-		/* 160 MONTH <- <(('m' / 'M') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('h' / 'H') skip)> */
+		/* 175 MONTH <- <(('m' / 'M') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('h' / 'H') skip)> */
 		nil,
-		/* 161 DAY <- <(('d' / 'D') ('a' / 'A') ('y' / 'Y') skip)> */
+		/* 176 DAY <- <(('d' / 'D') ('a' / 'A') ('y' / 'Y') skip)> */
 		nil,
-		/* 162 HOURS <- <(('h' / 'H') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('s' / 'S') skip)> */
+		/* 177 HOURS <- <(('h' / 'H') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('s' / 'S') skip)> */
 		nil,
-		/* 163 MINUTES <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('s' / 'S') skip)> */
+		/* 178 MINUTES <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('s' / 'S') skip)> */
 		nil,
-		/* 164 SECONDS <- <(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S') skip)> */
+		/* 179 SECONDS <- <(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S') skip)> */
 		nil,
-		/* 165 TIMEZONE <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('z' / 'Z') ('o' / 'O') ('n' / 'N') ('e' / 'E') skip)> */
+		/* 180 TIMEZONE <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('z' / 'Z') ('o' / 'O') ('n' / 'N') ('e' / 'E') skip)> */
 		nil,
-		/* 166 TZ <- <(('t' / 'T') ('z' / 'Z') skip)> */
+		/* 181 TZ <- <(('t' / 'T') ('z' / 'Z') skip)> */
 		nil,
-		/* 167 MD5 <- <(('m' / 'M') ('d' / 'D') skip)> */
+		/* 182 MD5 <- <(('m' / 'M') ('d' / 'D') '5' skip)> */
 		nil,
-		/* 168 SHA1 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '1' skip)> */
+		/* 183 SHA1 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '1' skip)> */
 		nil,
-		/* 169 SHA256 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '2' '5' '6' skip)> */
+		/* 184 SHA256 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '2' '5' '6' skip)> */
 		nil,
-		/* 170 SHA384 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '3' '8' '4' skip)> */
+		/* 185 SHA384 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '3' '8' '4' skip)> */
 		nil,
-		/* 171 SHA512 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '5' '1' '2' skip)> */
+		/* 186 SHA512 <- <(('s' / 'S') ('h' / 'H') ('a' / 'A') '5' '1' '2' skip)> */
 		nil,
-		/* 172 ISIRI <- <(('i' / 'I') ('s' / 'S') ('i' / 'I') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 187 ISIRI <- <(('i' / 'I') ('s' / 'S') ('i' / 'I') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 173 ISURI <- <(('i' / 'I') ('s' / 'S') ('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
+		/* 188 ISURI <- <(('i' / 'I') ('s' / 'S') ('u' / 'U') ('r' / 'R') ('i' / 'I') skip)> */
 		nil,
-		/* 174 ISBLANK <- <(('i' / 'I') ('s' / 'S') ('b' / 'B') ('l' / 'L') ('a' / 'A') ('n' / 'N') ('k' / 'K') skip)> */
+		/* 189 ISBLANK <- <(('i' / 'I') ('s' / 'S') ('b' / 'B') ('l' / 'L') ('a' / 'A') ('n' / 'N') ('k' / 'K') skip)> */
 		nil,
-		/* 175 ISLITERAL <- <(('i' / 'I') ('s' / 'S') ('l' / 'L') ('i' / 'I') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('a' / 'A') ('l' / 'L') skip)> */
+		/* 190 ISLITERAL <- <(('i' / 'I') ('s' / 'S') ('l' / 'L') ('i' / 'I') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('a' / 'A') ('l' / 'L') skip)> */
 		nil,
-		/* 176 ISNUMERIC <- <(('i' / 'I') ('s' / 'S') ('n' / 'N') ('u' / 'U') ('m' / 'M') ('e' / 'E') ('r' / 'R') ('i' / 'I') ('c' / 'C') skip)> */
+		/* 191 ISNUMERIC <- <(('i' / 'I') ('s' / 'S') ('n' / 'N') ('u' / 'U') ('m' / 'M') ('e' / 'E') ('r' / 'R') ('i' / 'I') ('c' / 'C') skip)> */
 		nil,
-		/* 177 LANGMATCHES <- <(('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S') skip)> */
+		/* 192 LANGMATCHES <- <(('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S') skip)> */
 		nil,
-		/* 178 CONTAINS <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S') skip)> */
+		/* 193 CONTAINS <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S') skip)> */
 		nil,
-		/* 179 STRSTARTS <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('s' / 'S') skip)> */
+		/* 194 STRSTARTS <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('s' / 'S') skip)> */
 		nil,
-		/* 180 STRENDS <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('n' / 'N') ('d' / 'D') ('s' / 'S') skip)> */
+		/* 195 STRENDS <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('n' / 'N') ('d' / 'D') ('s' / 'S') skip)> */
 		nil,
-		/* 181 STRBEFORE <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('b' / 'B') ('e' / 'E') ('f' / 'F') ('o' / 'O') ('r' / 'R') ('e' / 'E') skip)> */
+		/* 196 STRBEFORE <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('b' / 'B') ('e' / 'E') ('f' / 'F') ('o' / 'O') ('r' / 'R') ('e' / 'E') skip)> */
 		nil,
-		/* 182 STRAFTER <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('a' / 'A') ('f' / 'F') ('t' / 'T') ('e' / 'E') ('r' / 'R') skip)> */
+		/* 197 STRAFTER <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('a' / 'A') ('f' / 'F') ('t' / 'T') ('e' / 'E') ('r' / 'R') skip)> */
 		nil,
-		/* 183 STRLANG <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') skip)> */
+		/* 198 STRLANG <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('l' / 'L') ('a' / 'A') ('n' / 'N') ('g' / 'G') skip)> */
 		nil,
-		/* 184 STRDT <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('d' / 'D') ('t' / 'T') skip)> */
+		/* 199 STRDT <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('d' / 'D') ('t' / 'T') skip)> */
 		nil,
-		/* 185 SAMETERM <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('e' / 'E') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('m' / 'M') skip)> */
+		/* 200 SAMETERM <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('e' / 'E') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('m' / 'M') skip)> */
 		nil,
-		/* 186 BOUND <- <(('b' / 'B') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('d' / 'D') skip)> */
+		/* 201 BOUND <- <(('b' / 'B') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('d' / 'D') skip)> */
 		nil,
-		/* 187 BNODE <- <(('b' / 'B') ('n' / 'N') ('o' / 'O') ('d' / 'D') ('e' / 'E') skip)> */
+		/* 202 BNODE <- <(('b' / 'B') ('n' / 'N') ('o' / 'O') ('d' / 'D') ('e' / 'E') skip)> */
 		nil,
-		/* 188 RAND <- <(('r' / 'R') ('a' / 'A') ('n' / 'N') ('d' / 'D') skip)> */
+		/* 203 RAND <- <(('r' / 'R') ('a' / 'A') ('n' / 'N') ('d' / 'D') skip)> */
 		nil,
-		/* 189 NOW <- <(('n' / 'N') ('o' / 'O') ('w' / 'W') skip)> */
+		/* 204 NOW <- <(('n' / 'N') ('o' / 'O') ('w' / 'W') skip)> */
 		nil,
-		/* 190 UUID <- <(('u' / 'U') ('u' / 'U') ('i' / 'I') ('d' / 'D') skip)> */
+		/* 205 UUID <- <(('u' / 'U') ('u' / 'U') ('i' / 'I') ('d' / 'D') skip)> */
 		nil,
-		/* 191 STRUUID <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('u' / 'U') ('u' / 'U') ('i' / 'I') ('d' / 'D') skip)> */
+		/* 206 STRUUID <- <(('s' / 'S') ('t' / 'T') ('r' / 'R') ('u' / 'U') ('u' / 'U') ('i' / 'I') ('d' / 'D') skip)> */
 		nil,
-		/* 192 CONCAT <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('c' / 'C') ('a' / 'A') ('t' / 'T') skip)> */
+		/* 207 CONCAT <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('c' / 'C') ('a' / 'A') ('t' / 'T') skip)> */
 		nil,
-		/* 193 SUBSTR <- <(('s' / 'S') ('u' / 'U') ('b' / 'B') ('s' / 'S') ('t' / 'T') ('r' / 'R') skip)> */
+		/* 208 SUBSTR <- <(('s' / 'S') ('u' / 'U') ('b' / 'B') ('s' / 'S') ('t' / 'T') ('r' / 'R') skip)> */
 		nil,
-		/* 194 REPLACE <- <(('r' / 'R') ('e' / 'E') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('c' / 'C') ('e' / 'E') skip)> */
+		/* 209 REPLACE <- <(('r' / 'R') ('e' / 'E') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('c' / 'C') ('e' / 'E') skip)> */
 		nil,
-		/* 195 REGEX <- <(('r' / 'R') ('e' / 'E') ('g' / 'G') ('e' / 'E') ('x' / 'X') skip)> */
+		/* 210 REGEX <- <(('r' / 'R') ('e' / 'E') ('g' / 'G') ('e' / 'E') ('x' / 'X') skip)> */
 		nil,
-		/* 196 IF <- <(('i' / 'I') ('f' / 'F') skip)> */
+		/* 211 IF <- <(('i' / 'I') ('f' / 'F') skip)> */
 		nil,
-		/* 197 EXISTS <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S') skip)> */
+		/* 212 EXISTS <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S') skip)> */
 		nil,
-		/* 198 NOTEXIST <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') ' ' ('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S') skip)> */
+		/* 213 NOTEXIST <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') ' ' ('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S') skip)> */
 		nil,
-		/* 199 COALESCE <- <(('c' / 'C') ('o' / 'O') ('a' / 'A') ('l' / 'L') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('e' / 'E') skip)> */
+		/* 214 COALESCE <- <(('c' / 'C') ('o' / 'O') ('a' / 'A') ('l' / 'L') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('e' / 'E') skip)> */
 		nil,
-		/* 200 FILTER <- <(('f' / 'F') ('i' / 'I') ('l' / 'L') ('t' / 'T') ('e' / 'E') ('r' / 'R') skip)> */
+		/* 215 FILTER <- <(('f' / 'F') ('i' / 'I') ('l' / 'L') ('t' / 'T') ('e' / 'E') ('r' / 'R') skip)> */
 		nil,
-		/* 201 BIND <- <(('b' / 'B') ('i' / 'I') ('n' / 'N') ('d' / 'D') skip)> */
+		/* 216 BIND <- <(('b' / 'B') ('i' / 'I') ('n' / 'N') ('d' / 'D') skip)> */
 		nil,
-		/* 202 SUM <- <(('s' / 'S') ('u' / 'U') ('m' / 'M') skip)> */
+		/* 217 SUM <- <(('s' / 'S') ('u' / 'U') ('m' / 'M') skip)> */
 		nil,
-		/* 203 MIN <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') skip)> */
+		/* 218 MIN <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') skip)> */
 		nil,
-		/* 204 MAX <- <(('m' / 'M') ('a' / 'A') ('x' / 'X') skip)> */
+		/* 219 MAX <- <(('m' / 'M') ('a' / 'A') ('x' / 'X') skip)> */
 		nil,
-		/* 205 AVG <- <(('a' / 'A') ('v' / 'V') ('g' / 'G') skip)> */
+		/* 220 AVG <- <(('a' / 'A') ('v' / 'V') ('g' / 'G') skip)> */
 		nil,
-		/* 206 SAMPLE <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') skip)> */
+		/* 221 SAMPLE <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') skip)> */
 		nil,
-		/* 207 COUNT <- <(('c' / 'C') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('t' / 'T') skip)> */
+		/* 222 COUNT <- <(('c' / 'C') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('t' / 'T') skip)> */
 		nil,
-		/* 208 GROUPCONCAT <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') '_' ('c' / 'C') ('o' / 'O') ('n' / 'N') ('c' / 'C') ('a' / 'A') ('t' / 'T') skip)> */
+		/* 223 GROUPCONCAT <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') '_' ('c' / 'C') ('o' / 'O') ('n' / 'N') ('c' / 'C') ('a' / 'A') ('t' / 'T') skip)> */
 		nil,
-		/* 209 SEPARATOR <- <(('s' / 'S') ('e' / 'E') ('p' / 'P') ('a' / 'A') ('r' / 'R') ('a' / 'A') ('t' / 'T') ('o' / 'O') ('r' / 'R') skip)> */
+		/* 224 SEPARATOR <- <(('s' / 'S') ('e' / 'E') ('p' / 'P') ('a' / 'A') ('r' / 'R') ('a' / 'A') ('t' / 'T') ('o' / 'O') ('r' / 'R') skip)> */
 		nil,
-		/* 210 ASC <- <(('a' / 'A') ('s' / 'S') ('c' / 'C') skip)> */
+		/* 225 ASC <- <(('a' / 'A') ('s' / 'S') ('c' / 'C') skip)> */
 		nil,
-		/* 211 DESC <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') skip)> */
+		/* 226 DESC <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') skip)> */
 		nil,
-		/* 212 ORDER <- <(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') skip)> */
+		/* 227 ORDER <- <(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') skip)> */
 		nil,
-		/* 213 GROUP <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') skip)> */
+		/* 228 GROUP <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') skip)> */
 		nil,
-		/* 214 BY <- <(('b' / 'B') ('y' / 'Y') skip)> */
+		/* 229 BY <- <(('b' / 'B') ('y' / 'Y') skip)> */
 		func() bool {
-			position1855, tokenIndex1855, depth1855 := position, tokenIndex, depth
+			position2094, tokenIndex2094, depth2094 := position, tokenIndex, depth
 			{
-				position1856 := position
+				position2095 := position
 				depth++
 				{
-					position1857, tokenIndex1857, depth1857 := position, tokenIndex, depth
+					position2096, tokenIndex2096, depth2096 := position, tokenIndex, depth
 					if buffer[position] != rune('b') {
-						goto l1858
+						goto l2097
 					}
 					position++
-					goto l1857
-				l1858:
-					position, tokenIndex, depth = position1857, tokenIndex1857, depth1857
+					goto l2096
+				l2097:
+					position, tokenIndex, depth = position2096, tokenIndex2096, depth2096
 					if buffer[position] != rune('B') {
-						goto l1855
+						goto l2094
 					}
 					position++
 				}
-			l1857:
+			l2096:
 				{
-					position1859, tokenIndex1859, depth1859 := position, tokenIndex, depth
+					position2098, tokenIndex2098, depth2098 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l1860
+						goto l2099
 					}
 					position++
-					goto l1859
-				l1860:
-					position, tokenIndex, depth = position1859, tokenIndex1859, depth1859
+					goto l2098
+				l2099:
+					position, tokenIndex, depth = position2098, tokenIndex2098, depth2098
 					if buffer[position] != rune('Y') {
-						goto l1855
+						goto l2094
 					}
 					position++
 				}
-			l1859:
-				if !rules[ruleskip]() {
-					goto l1855
+			l2098:
+				if !_rules[ruleskip]() {
+					goto l2094
 				}
 				depth--
-				add(ruleBY, position1856)
+				add(ruleBY, position2095)
 			}
 			return true
-		l1855:
-			position, tokenIndex, depth = position1855, tokenIndex1855, depth1855
+		l2094:
+			position, tokenIndex, depth = position2094, tokenIndex2094, depth2094
 			return false
 		},
-		/* 215 HAVING <- <(('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G') skip)> */
+		/* 230 HAVING <- <(('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G') skip)> */
+		nil,
+		/* 231 GRAPH <- <(('g' / 'G') ('r' / 'R') ('a' / 'A') ('p' / 'P') ('h' / 'H') skip)> */
+		nil,
+		/* 232 MINUSSETOPER <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('s' / 'S') skip)> */
 		nil,
-		/* 216 GRAPH <- <(('g' / 'G') ('r' / 'R') ('a' / 'A') ('p' / 'P') ('h' / 'H') skip)> */
+		/* 233 SERVICE <- <(('s' / 'S') ('e' / 'E') ('r' / 'R') ('v' / 'V') ('i' / 'I') ('c' / 'C') ('e' / 'E') skip)> */
 		nil,
-		/* 217 MINUSSETOPER <- <(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('s' / 'S') skip)> */
+		/* 234 SILENT <- <(('s' / 'S') ('i' / 'I') ('l' / 'L') ('e' / 'E') ('n' / 'N') ('t' / 'T') skip)> */
 		nil,
-		/* 218 skip <- <(ws / comment)*> */
+		/* 235 VALUES <- <(('v' / 'V') ('a' / 'A') ('l' / 'L') ('u' / 'U') ('e' / 'E') ('s' / 'S') skip)> */
+		nil,
+		/* 236 UNDEF <- <(('u' / 'U') ('n' / 'N') ('d' / 'D') ('e' / 'E') ('f' / 'F') skip)> */
+		nil,
+		/* 237 skip <- <(ws / comment)*> */
 		func() bool {
 			{
-				position1865 := position
+				position2108 := position
 				depth++
-			l1866:
+			l2109:
 				{
-					position1867, tokenIndex1867, depth1867 := position, tokenIndex, depth
+					position2110, tokenIndex2110, depth2110 := position, tokenIndex, depth
 					{
-						position1868, tokenIndex1868, depth1868 := position, tokenIndex, depth
-						if !rules[rulews]() {
-							goto l1869
+						position2111, tokenIndex2111, depth2111 := position, tokenIndex, depth
+						if !_rules[rulews]() {
+							goto l2112
 						}
-						goto l1868
-					l1869:
-						position, tokenIndex, depth = position1868, tokenIndex1868, depth1868
+						goto l2111
+					l2112:
+						position, tokenIndex, depth = position2111, tokenIndex2111, depth2111
 						{
-							position1870 := position
+							position2113 := position
 							depth++
 							if buffer[position] != rune('#') {
-								goto l1867
+								goto l2110
 							}
 							position++
-						l1871:
+						l2114:
 							{
-								position1872, tokenIndex1872, depth1872 := position, tokenIndex, depth
+								position2115, tokenIndex2115, depth2115 := position, tokenIndex, depth
 								{
-									position1873, tokenIndex1873, depth1873 := position, tokenIndex, depth
-									if !rules[ruleendOfLine]() {
-										goto l1873
+									position2116, tokenIndex2116, depth2116 := position, tokenIndex, depth
+									if !_rules[ruleendOfLine]() {
+										goto l2116
 									}
-									goto l1872
-								l1873:
-									position, tokenIndex, depth = position1873, tokenIndex1873, depth1873
+									goto l2115
+								l2116:
+									position, tokenIndex, depth = position2116, tokenIndex2116, depth2116
 								}
 								if !matchDot() {
-									goto l1872
+									goto l2115
 								}
-								goto l1871
-							l1872:
-								position, tokenIndex, depth = position1872, tokenIndex1872, depth1872
+								goto l2114
+							l2115:
+								position, tokenIndex, depth = position2115, tokenIndex2115, depth2115
 							}
-							if !rules[ruleendOfLine]() {
-								goto l1867
+							{
+								position2117, tokenIndex2117, depth2117 := position, tokenIndex, depth
+								if !_rules[ruleendOfLine]() {
+									goto l2118
+								}
+								goto l2117
+							l2118:
+								position, tokenIndex, depth = position2117, tokenIndex2117, depth2117
+								{
+									position2119, tokenIndex2119, depth2119 := position, tokenIndex, depth
+									if !matchDot() {
+										goto l2119
+									}
+									goto l2110
+								l2119:
+									position, tokenIndex, depth = position2119, tokenIndex2119, depth2119
+								}
 							}
+						l2117:
 							depth--
-							add(rulecomment, position1870)
+							add(rulecomment, position2113)
 						}
 					}
-				l1868:
-					goto l1866
-				l1867:
-					position, tokenIndex, depth = position1867, tokenIndex1867, depth1867
+				l2111:
+					goto l2109
+				l2110:
+					position, tokenIndex, depth = position2110, tokenIndex2110, depth2110
 				}
 				depth--
-				add(ruleskip, position1865)
+				add(ruleskip, position2108)
 			}
 			return true
 		},
-		/* 219 ws <- <((&('\v') '\v') | (&('\f') '\f') | (&('\t') '\t') | (&(' ') ' ') | (&('\n' | '\r') endOfLine))> */
+		/* 238 ws <- <(' ' / '\t' / '\f' / '\v' / endOfLine)> */
 		func() bool {
-			position1874, tokenIndex1874, depth1874 := position, tokenIndex, depth
+			position2120, tokenIndex2120, depth2120 := position, tokenIndex, depth
 			{
-				position1875 := position
+				position2121 := position
 				depth++
 				{
-					switch buffer[position] {
-					case '\v':
-						if buffer[position] != rune('\v') {
-							goto l1874
-						}
-						position++
-						break
-					case '\f':
-						if buffer[position] != rune('\f') {
-							goto l1874
-						}
-						position++
-						break
-					case '\t':
-						if buffer[position] != rune('\t') {
-							goto l1874
-						}
-						position++
-						break
-					case ' ':
-						if buffer[position] != rune(' ') {
-							goto l1874
-						}
-						position++
-						break
-					default:
-						if !rules[ruleendOfLine]() {
-							goto l1874
-						}
-						break
+					position2122, tokenIndex2122, depth2122 := position, tokenIndex, depth
+					if buffer[position] != rune(' ') {
+						goto l2123
+					}
+					position++
+					goto l2122
+				l2123:
+					position, tokenIndex, depth = position2122, tokenIndex2122, depth2122
+					if buffer[position] != rune('\t') {
+						goto l2124
+					}
+					position++
+					goto l2122
+				l2124:
+					position, tokenIndex, depth = position2122, tokenIndex2122, depth2122
+					if buffer[position] != rune('\f') {
+						goto l2125
+					}
+					position++
+					goto l2122
+				l2125:
+					position, tokenIndex, depth = position2122, tokenIndex2122, depth2122
+					if buffer[position] != rune('\v') {
+						goto l2126
+					}
+					position++
+					goto l2122
+				l2126:
+					position, tokenIndex, depth = position2122, tokenIndex2122, depth2122
+					if !_rules[ruleendOfLine]() {
+						goto l2120
 					}
 				}
-
+			l2122:
 				depth--
-				add(rulews, position1875)
+				add(rulews, position2121)
 			}
 			return true
-		l1874:
-			position, tokenIndex, depth = position1874, tokenIndex1874, depth1874
+		l2120:
+			position, tokenIndex, depth = position2120, tokenIndex2120, depth2120
 			return false
 		},
-		/* 220 comment <- <('#' (!endOfLine .)* endOfLine)> */
+		/* 239 comment <- <('#' (!endOfLine .)* (endOfLine / !.))> */
 		nil,
-		/* 221 endOfLine <- <(('\r' '\n') / '\n' / '\r')> */
+		/* 240 endOfLine <- <(('\r' '\n') / '\n' / '\r')> */
 		func() bool {
-			position1878, tokenIndex1878, depth1878 := position, tokenIndex, depth
+			position2128, tokenIndex2128, depth2128 := position, tokenIndex, depth
 			{
-				position1879 := position
+				position2129 := position
 				depth++
 				{
-					position1880, tokenIndex1880, depth1880 := position, tokenIndex, depth
+					position2130, tokenIndex2130, depth2130 := position, tokenIndex, depth
 					if buffer[position] != rune('\r') {
-						goto l1881
+						goto l2131
 					}
 					position++
 					if buffer[position] != rune('\n') {
-						goto l1881
+						goto l2131
 					}
 					position++
-					goto l1880
-				l1881:
-					position, tokenIndex, depth = position1880, tokenIndex1880, depth1880
+					goto l2130
+				l2131:
+					position, tokenIndex, depth = position2130, tokenIndex2130, depth2130
 					if buffer[position] != rune('\n') {
-						goto l1882
+						goto l2132
 					}
 					position++
-					goto l1880
-				l1882:
-					position, tokenIndex, depth = position1880, tokenIndex1880, depth1880
+					goto l2130
+				l2132:
+					position, tokenIndex, depth = position2130, tokenIndex2130, depth2130
 					if buffer[position] != rune('\r') {
-						goto l1878
+						goto l2128
 					}
 					position++
 				}
-			l1880:
+			l2130:
 				depth--
-				add(ruleendOfLine, position1879)
+				add(ruleendOfLine, position2129)
 			}
 			return true
-		l1878:
-			position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+		l2128:
+			position, tokenIndex, depth = position2128, tokenIndex2128, depth2128
 			return false
 		},
-		/* 223 SERVICE <- <> */
-		nil,
-		/* 224 SILENT <- <> */
-		nil,
 	}
-	p.rules = rules
+	p.rules = _rules
 }
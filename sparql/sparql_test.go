@@ -425,3 +425,91 @@ func TestGraphTerms(t *testing.T) {
     `)
 }
 
+
+func TestSolutionModifiers(t *testing.T) {
+    parse(t, `
+        SELECT ?x (count(*) as ?c) {
+            ?x ?p ?y
+        }
+        GROUP BY ?x
+        HAVING (count(*) > 1)
+        ORDER BY DESC(?c) ?x
+        LIMIT 10
+        OFFSET 20
+    `)
+}
+
+func TestSolutionModifiersAST(t *testing.T) {
+    s := parse(t, `SELECT ?x { ?x ?p ?y } GROUP BY ?x HAVING (count(*) > 1) ORDER BY ?x LIMIT 10 OFFSET 20`)
+    expected := []struct {
+        rule pegRule
+        text string
+    }{
+        { rulegroupClause, "GROUP BY ?x " },
+        { rulehavingClause, "HAVING (count(*) > 1) " },
+        { ruleorderClause, "ORDER BY ?x " },
+        { rulelimitOffsetClauses, "LIMIT 10 OFFSET 20" },
+    }
+    i := 0
+    for token := range s.Tokens() {
+        switch token.pegRule {
+        case rulegroupClause, rulehavingClause, ruleorderClause, rulelimitOffsetClauses:
+            text := string(s.buffer[token.begin:token.end])
+            if i >= len(expected) || token.pegRule != expected[i].rule || text != expected[i].text {
+                t.Errorf("Unexpected solution modifier %v %q", rul3s[token.pegRule], text)
+            }
+            i++
+        }
+    }
+    if i != len(expected) {
+        t.Errorf("Expected %v solution modifiers but got %v", len(expected), i)
+    }
+    for _,query := range []string{
+        `SELECT * { ?s ?p ?o } LIMIT 1 ORDER BY ?s`,
+        `SELECT * { ?s ?p ?o } ORDER BY ?s GROUP BY ?s`,
+        `SELECT * { ?s ?p ?o } HAVING (?s) GROUP BY ?s`,
+    } {
+        s := &Sparql{ Buffer : query }
+        s.Init()
+        if err := s.Parse(); err == nil {
+            t.Errorf("Expected parse error for the query\n%v", query)
+        }
+    }
+}
+
+func TestValues(t *testing.T) {
+    parse(t, `
+        SELECT * {
+            VALUES ?s { <aaa> :bbb "ccc" 1 UNDEF }
+            ?s ?p ?o
+        }
+    `)
+    parse(t, `
+        SELECT * {
+            ?s ?p ?o
+        }
+        VALUES ( ?s ?o ) { ( <aaa> "bbb" ) ( UNDEF 2 ) () }
+    `)
+}
+
+func TestTree(t *testing.T) {
+    s := parse(t, `SELECT ?s # comment
+        { ?s <p> "o"@en . }`)
+    tree := s.Tree()
+    if tree.Rule != "queryContainer" {
+        t.Errorf("Expected the root to be queryContainer but got %v", tree.Rule)
+    }
+    vars := tree.FindAll("var")
+    if len(vars) != 2 || vars[0].Value() != "?s" || vars[0].Text != "?s # comment\n        " {
+        t.Errorf("Unexpected variables %v", vars)
+    }
+    if tss := tree.Find("triplesSameSubjectPath"); tss == nil || tss.Value() != `?s <p> "o"@en` {
+        t.Errorf("Unexpected triple pattern %v", tss)
+    }
+    if literal := tree.Find("literal"); literal == nil || literal.Begin != 37 || literal.End != 44 {
+        t.Errorf("Unexpected position of the literal %v", literal)
+    }
+    if tree.Find("optionalGraphPattern") != nil {
+        t.Error("Unexpected optional graph pattern")
+    }
+}
@@ -0,0 +1,219 @@
+package sparql_test
+
+import (
+    "fmt"
+    "io"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+    "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/rdf"
+    "github.com/scampi/gosparqled/results"
+    "github.com/scampi/gosparqled/sparql"
+)
+
+// The directories with the manifest.ttl of the syntax tests: the regression
+// tests of the project, and the syntax tests of SPARQL 1.1 Query of the W3C
+const (
+    syntaxTestsDir = "testdata/syntax"
+    w3cSyntaxTestsDir = "testdata/w3c/sparql11/syntax-query"
+)
+
+// A syntax test of a manifest
+type syntaxTest struct {
+    // The name of the test
+    name string
+    // The file with the query
+    action string
+    // True if the query is valid
+    positive bool
+}
+
+// The namespaces of the vocabularies of a manifest
+const (
+    mf = "http://www.w3.org/2001/sw/DataAccess/tests/test-manifest#"
+    rdfNS = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+)
+
+// loadManifest reads the syntax tests listed in the manifest.ttl of the
+// directory, in the order of its mf:entries
+func loadManifest(t *testing.T, dir string) []syntaxTest {
+    f, err := os.Open(filepath.Join(dir, "manifest.ttl"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer f.Close()
+    // the objects of each subject and predicate
+    objects := make(map[results.Term]map[string]results.Term)
+    r := rdf.NewTurtleReader(f, "")
+    for {
+        q, err := r.Read()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        if objects[q.Subject] == nil {
+            objects[q.Subject] = make(map[string]results.Term)
+        }
+        objects[q.Subject][q.Predicate.Value] = q.Object
+    }
+    var tests []syntaxTest
+    for subject,po := range objects {
+        if po[rdfNS + "type"].Value != mf + "Manifest" {
+            continue
+        }
+        for list := po[mf + "entries"]; list.Value != rdfNS + "nil"; list = objects[list][rdfNS + "rest"] {
+            entry, ok := objects[list][rdfNS + "first"]
+            if !ok {
+                t.Fatalf("Malformed list of entries in the manifest %v", subject)
+            }
+            test := syntaxTest{ name : entry.Value }
+            switch objects[entry][rdfNS + "type"].Value {
+            case mf + "PositiveSyntaxTest", mf + "PositiveSyntaxTest11":
+                test.positive = true
+            case mf + "NegativeSyntaxTest", mf + "NegativeSyntaxTest11":
+            default:
+                continue
+            }
+            if name, ok := objects[entry][mf + "name"]; ok {
+                test.name = name.Value
+            }
+            action, ok := objects[entry][mf + "action"]
+            if !ok {
+                t.Fatalf("Missing mf:action in the test %v", test.name)
+            }
+            test.action = filepath.Join(dir, action.Value)
+            tests = append(tests, test)
+        }
+    }
+    if len(tests) == 0 {
+        t.Fatalf("No syntax test found in %v", dir)
+    }
+    return tests
+}
+
+// The grammars under test. A query of the sparql grammar must also pass the
+// static validation.
+var grammars = []struct {
+    name string
+    parse func(query string) error
+}{
+    { "sparql", func(query string) error {
+        s := &sparql.Sparql{ Buffer : query }
+        s.Init()
+        if err := s.Parse(); err != nil {
+            return err
+        }
+        if errs := s.Validate(); len(errs) != 0 {
+            return errs[0]
+        }
+        return nil
+    }},
+    { "autocompletion", func(query string) error {
+        s := &autocompletion.Sparql{ Buffer : query, Scope : autocompletion.NewScope() }
+        s.Init()
+        return s.Parse()
+    }},
+}
+
+// The tests of the project which are known to fail with a grammar, along with
+// the reason. A test that starts passing must be removed from this list.
+var knownFailures = map[string]map[string]string{
+    "sparql" : {
+        "construct-where-01.rq" : "the CONSTRUCT WHERE short form is not supported",
+    },
+    "autocompletion" : {
+        "construct-where-01.rq" : "the CONSTRUCT WHERE short form is not supported",
+        "bad-select-expr-03.rq" : "variable scoping is not checked",
+        "bad-bind-01.rq" : "variable scoping is not checked",
+    },
+}
+
+// The W3C tests which are known to fail with a grammar, along with the reason.
+// A test that starts passing must be removed from this list.
+var w3cKnownFailures = map[string]map[string]string{
+    "sparql" : {
+        "syntax-construct-where-01.rq" : "the CONSTRUCT WHERE short form is not supported",
+        "syntax-construct-where-02.rq" : "the CONSTRUCT WHERE short form is not supported",
+        "syn-bad-06.rq" : "the number of values of a VALUES row is not checked",
+    },
+    "autocompletion" : {
+        "syntax-construct-where-01.rq" : "the CONSTRUCT WHERE short form is not supported",
+        "syntax-construct-where-02.rq" : "the CONSTRUCT WHERE short form is not supported",
+        "syntax-SELECTscope3.rq" : "variable scoping is not checked",
+        "syntax-BINDscope6.rq" : "variable scoping is not checked",
+        "syntax-BINDscope7.rq" : "variable scoping is not checked",
+        "syntax-BINDscope8.rq" : "variable scoping is not checked",
+        "syn-bad-01.rq" : "the grouping is not checked",
+        "syn-bad-02.rq" : "the grouping is not checked",
+        "syn-bad-03.rq" : "the grouping is not checked",
+        "syn-bad-04.rq" : "variable scoping is not checked",
+        "syn-bad-05.rq" : "variable scoping is not checked",
+        "syn-bad-06.rq" : "the number of values of a VALUES row is not checked",
+        "syn-bad-13.rq" : "variable scoping is not checked",
+    },
+}
+
+// Runs the regression syntax tests against each grammar
+func TestSyntaxSuite(t *testing.T) {
+    runSyntaxTests(t, syntaxTestsDir, knownFailures)
+}
+
+// Runs the W3C syntax tests against each grammar
+func TestW3CSyntaxSuite(t *testing.T) {
+    runSyntaxTests(t, w3cSyntaxTestsDir, w3cKnownFailures)
+}
+
+// runSyntaxTests runs the tests of the manifest in the directory against each
+// grammar and logs the pass/fail matrix. A failure is an error unless it is
+// among the known ones.
+func runSyntaxTests(t *testing.T, dir string, knownFailures map[string]map[string]string) {
+    tests := loadManifest(t, dir)
+    header := fmt.Sprintf("%-40v", "test")
+    for _,g := range grammars {
+        header += fmt.Sprintf(" %-15v", g.name)
+    }
+    matrix := []string{ header }
+    passed := make([]int, len(grammars))
+    for _,test := range tests {
+        query, err := ioutil.ReadFile(test.action)
+        if err != nil {
+            t.Fatal(err)
+        }
+        row := fmt.Sprintf("%-40v", test.name)
+        for i,g := range grammars {
+            ok := (g.parse(string(query)) == nil) == test.positive
+            reason, known := knownFailures[g.name][test.name]
+            status := "pass"
+            switch {
+            case ok:
+                passed[i]++
+                if known {
+                    t.Errorf("[%v] %v now passes, remove it from the known failures", g.name, test.name)
+                }
+            case known:
+                status = "FAIL (known)"
+                t.Logf("[%v] %v: %v", g.name, test.name, reason)
+            default:
+                status = "FAIL"
+                if test.positive {
+                    t.Errorf("[%v] %v: failed to parse the valid query\n%s", g.name, test.name, query)
+                } else {
+                    t.Errorf("[%v] %v: parsed the invalid query\n%s", g.name, test.name, query)
+                }
+            }
+            row += fmt.Sprintf(" %-15v", status)
+        }
+        matrix = append(matrix, row)
+    }
+    summary := fmt.Sprintf("%-40v", "passed")
+    for i := range grammars {
+        summary += fmt.Sprintf(" %-15v", fmt.Sprintf("%v/%v", passed[i], len(tests)))
+    }
+    matrix = append(matrix, summary)
+    t.Log("\n" + strings.Join(matrix, "\n"))
+}
@@ -0,0 +1,114 @@
+package sparql
+
+import (
+    "strings"
+)
+
+// Node is a node of the syntax tree of a parsed query. There is one node per
+// grammar rule that matched some text, e.g., "triplesBlock" or "var".
+type Node struct {
+    // The name of the grammar rule
+    Rule string
+    // The position of the matched text in the query, as a number of runes
+    Begin, End int
+    // The matched text, including the trailing whitespaces and comments
+    Text string
+    // The nodes of the rules that are part of this one, in order
+    Children []*Node
+}
+
+// Tree returns the syntax tree of the query. It must be called after a
+// successful Parse.
+func (p *Sparql) Tree() *Node {
+    ast := p.AST()
+    if ast == nil {
+        return nil
+    }
+    return p.newNode(ast)
+}
+
+// newNode converts the peg node and its descendants into a Node
+func (p *Sparql) newNode(n *node32) *Node {
+    node := &Node{
+        Rule : rul3s[n.pegRule],
+        Begin : int(n.begin),
+        End : int(n.end),
+        Text : string(p.buffer[n.begin:n.end]),
+    }
+    for child := n.up; child != nil; child = child.next {
+        node.Children = append(node.Children, p.newNode(child))
+    }
+    return node
+}
+
+// Value returns the matched text without the trailing whitespaces and comments
+func (n *Node) Value() string {
+    end := n.End
+    for last := n; len(last.Children) != 0; {
+        last = last.Children[len(last.Children) - 1]
+        if last.Rule == "skip" {
+            end = last.Begin
+            break
+        }
+    }
+    return strings.TrimSpace(string([]rune(n.Text)[:end - n.Begin]))
+}
+
+// Child returns the first child matched by the rule, or nil if there is none
+func (n *Node) Child(rule string) *Node {
+    for _,c := range n.Children {
+        if c.Rule == rule {
+            return c
+        }
+    }
+    return nil
+}
+
+// ChildrenOf returns the children matched by the rule
+func (n *Node) ChildrenOf(rule string) []*Node {
+    var nodes []*Node
+    for _,c := range n.Children {
+        if c.Rule == rule {
+            nodes = append(nodes, c)
+        }
+    }
+    return nodes
+}
+
+// Find returns the first descendant matched by the rule, in depth-first order,
+// or nil if there is none. The node itself is considered.
+func (n *Node) Find(rule string) *Node {
+    if n.Rule == rule {
+        return n
+    }
+    for _,c := range n.Children {
+        if found := c.Find(rule); found != nil {
+            return found
+        }
+    }
+    return nil
+}
+
+// FindAll returns the descendants matched by the rule, in depth-first order.
+// The descendants of a matched node are not searched.
+func (n *Node) FindAll(rule string) []*Node {
+    if n.Rule == rule {
+        return []*Node{ n }
+    }
+    var nodes []*Node
+    for _,c := range n.Children {
+        nodes = append(nodes, c.FindAll(rule)...)
+    }
+    return nodes
+}
+
+// Walk calls the function on the node and its descendants in depth-first order.
+// The descendants of a node are skipped if the function returns false.
+func (n *Node) Walk(f func(*Node) bool) {
+    if !f(n) {
+        return
+    }
+    for _,c := range n.Children {
+        c.Walk(f)
+    }
+}
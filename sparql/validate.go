@@ -0,0 +1,329 @@
+package sparql
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+)
+
+// ValidationError is a static error of a query that is syntactically valid,
+// e.g., the use of an undeclared prefix
+type ValidationError struct {
+    Message string
+    // The position of the offending text in the query, as a number of runes
+    Begin, End int
+    // The line and the column of the beginning of the offending text,
+    // starting from 1
+    Line, Column int
+}
+
+func (e *ValidationError) Error() string {
+    return fmt.Sprintf("%v (line %v symbol %v)", e.Message, e.Line, e.Column)
+}
+
+// Validate returns the static errors of the query, sorted by position.
+// It must be called after a successful Parse. The checks are:
+//
+//  - the prefixes of the prefixed names are declared;
+//  - in a query with aggregates or with a GROUP BY, the projected variables
+//    are grouped, and the projection is not "*";
+//  - the variable of a BIND is not in-scope in the preceding patterns of
+//    the group;
+//  - the variable of a SELECT expression is neither in-scope in the WHERE
+//    clause nor projected twice.
+func (p *Sparql) Validate() []*ValidationError {
+    v := &validator{ p : p, prefixes : make(map[string]bool) }
+    tree := p.Tree()
+    if prolog := tree.Child("prolog"); prolog != nil {
+        for _,decl := range prolog.ChildrenOf("prefixDecl") {
+            name := ""
+            if prefix := decl.Child("pnPrefix"); prefix != nil {
+                name = prefix.Value()
+            }
+            v.prefixes[name] = true
+        }
+    }
+    tree.Walk(func(n *Node) bool {
+        switch n.Rule {
+        case "prefixedName":
+            v.checkPrefix(n)
+        case "selectQuery", "subSelect":
+            v.checkProjection(n)
+        case "groupGraphPattern", "optionalGraphPattern":
+            if gp := n.Child("graphPattern"); gp != nil {
+                v.checkBinds(gp)
+            }
+        }
+        return true
+    })
+    sort.SliceStable(v.errors, func(i, j int) bool { return v.errors[i].Begin < v.errors[j].Begin })
+    return v.errors
+}
+
+// validator collects the static errors of a query
+type validator struct {
+    p *Sparql
+    // The declared prefixes
+    prefixes map[string]bool
+    errors []*ValidationError
+}
+
+// addError adds an error about the text of the node
+func (v *validator) addError(n *Node, format string, args ...interface{}) {
+    err := &ValidationError{
+        Message : fmt.Sprintf(format, args...),
+        Begin : n.Begin,
+        End : n.Begin + len([]rune(n.Value())),
+        Line : 1,
+        Column : 1,
+    }
+    for _,c := range v.p.buffer[:n.Begin] {
+        if c == '\n' {
+            err.Line++
+            err.Column = 1
+        } else {
+            err.Column++
+        }
+    }
+    v.errors = append(v.errors, err)
+}
+
+// checkPrefix checks that the prefix of the name is declared
+func (v *validator) checkPrefix(n *Node) {
+    name := ""
+    if prefix := n.Child("pnPrefix"); prefix != nil {
+        name = prefix.Value()
+    }
+    if !v.prefixes[name] {
+        v.addError(n, "Undeclared prefix %v: in %v", name, n.Value())
+    }
+}
+
+// checkProjection checks the projection of a SELECT query or sub-query
+func (v *validator) checkProjection(query *Node) {
+    sel := query.Child("select")
+    grouped := false
+    keys := make(map[string]bool)
+    aggregated := false
+    if modifier := query.Child("solutionModifier"); modifier != nil {
+        if clause := modifier.Child("groupClause"); clause != nil {
+            grouped = true
+            for _,c := range clause.ChildrenOf("groupCondition") {
+                if vr := groupKey(c); vr != nil {
+                    keys[varName(vr)] = true
+                }
+            }
+        }
+        for _,clause := range []string{ "havingClause", "orderClause" } {
+            if c := modifier.Child(clause); c != nil && len(expressionNodes(c, "aggregate")) != 0 {
+                aggregated = true
+            }
+        }
+    }
+    if len(expressionNodes(sel, "aggregate")) != 0 {
+        aggregated = true
+    }
+    if modifier := query.Child("solutionModifier"); modifier != nil && (grouped || aggregated) {
+        if having := modifier.Child("havingClause"); having != nil {
+            for _,used := range groupedNodes(having, "var") {
+                if !keys[varName(used)] {
+                    v.addError(used, "Variable %v is not grouped", varName(used))
+                }
+            }
+        }
+    }
+    if sel.Child("STAR") != nil {
+        if grouped || aggregated {
+            v.addError(sel.Child("STAR"), "SELECT * is not allowed with GROUP BY or aggregates")
+        }
+        return
+    }
+
+    scope := make(map[string]bool)
+    if where := query.Child("whereClause"); where != nil {
+        inScope(where.Child("groupGraphPattern"), scope)
+    }
+    projected := make(map[string]bool)
+    aliases := make(map[string]bool)
+    for _,elem := range sel.ChildrenOf("projectionElem") {
+        vr := elem.Child("var")
+        name := varName(vr)
+        expr := elem.Child("expression")
+        if expr == nil {
+            if aliases[name] {
+                v.addError(vr, "Variable %v is projected twice", name)
+            }
+            if (grouped || aggregated) && !keys[name] {
+                v.addError(vr, "Variable %v is not grouped", name)
+            }
+            projected[name] = true
+            continue
+        }
+        if grouped || aggregated {
+            for _,used := range groupedNodes(expr, "var") {
+                if !keys[varName(used)] && !aliases[varName(used)] {
+                    v.addError(used, "Variable %v is not grouped", varName(used))
+                }
+            }
+        }
+        switch {
+        case projected[name]:
+            v.addError(vr, "Variable %v is projected twice", name)
+        case scope[name]:
+            v.addError(vr, "Variable %v of the SELECT expression is already in-scope", name)
+        }
+        projected[name] = true
+        aliases[name] = true
+    }
+}
+
+// groupKey returns the variable the group condition binds, i.e., a variable,
+// the variable of an expression with AS, or a variable in brackets, or nil
+func groupKey(c *Node) *Node {
+    if vr := c.Child("var"); vr != nil {
+        return vr
+    }
+    if expr := c.Child("expression"); expr != nil {
+        if vars := groupedNodes(expr, "var"); len(vars) == 1 && strings.TrimSpace(expr.Value()) == strings.TrimSpace(vars[0].Value()) {
+            return vars[0]
+        }
+    }
+    return nil
+}
+
+// checkBinds checks that the variable of each BIND of the group is not
+// in-scope in the preceding patterns
+func (v *validator) checkBinds(gp *Node) {
+    scope := make(map[string]bool)
+    for _,elem := range groupElements(gp) {
+        if elem.Rule == "filterOrBind" && elem.Child("BIND") != nil {
+            vr := elem.Child("var")
+            if scope[varName(vr)] {
+                v.addError(vr, "Variable %v of the BIND is already in-scope", varName(vr))
+            }
+        }
+        elementScope(elem, scope)
+    }
+}
+
+// varName returns the name of the variable, with the '?' prefix
+func varName(n *Node) string {
+    return "?" + n.Value()[1:]
+}
+
+// groupElements returns the elements of the group, i.e., the blocks of triples,
+// the filters and binds, and the other patterns, in order
+func groupElements(gp *Node) []*Node {
+    var elems []*Node
+    for _,c := range gp.Children {
+        switch c.Rule {
+        case "basicGraphPattern", "graphPattern":
+            elems = append(elems, groupElements(c)...)
+        case "graphPatternNotTriples":
+            elems = append(elems, c.Children[0])
+        case "triplesBlock", "filterOrBind":
+            elems = append(elems, c)
+        }
+    }
+    return elems
+}
+
+// expressionNodes returns the nodes matched by the rule within the
+// expressions of the node. The patterns of EXISTS are not searched.
+func expressionNodes(n *Node, rule string) []*Node {
+    var nodes []*Node
+    n.Walk(func(c *Node) bool {
+        if c.Rule == rule {
+            nodes = append(nodes, c)
+            return false
+        }
+        return c.Rule != "groupGraphPattern"
+    })
+    return nodes
+}
+
+// groupedNodes returns the nodes matched by the rule within the expression,
+// outside of its aggregates
+func groupedNodes(expr *Node, rule string) []*Node {
+    var nodes []*Node
+    expr.Walk(func(c *Node) bool {
+        switch c.Rule {
+        case rule:
+            nodes = append(nodes, c)
+            return false
+        case "aggregate", "groupGraphPattern":
+            return false
+        }
+        return true
+    })
+    return nodes
+}
+
+// InScope returns the in-scope variables of the group graph pattern, i.e., the
+// variables that its solutions may bind, with the '?' prefix. It accepts any
+// node with the same children as a group graph pattern, e.g., the pattern of
+// an OPTIONAL. It is exported so that the analyses of other packages, e.g.,
+// the linter, follow the same scoping rules as the validation.
+func InScope(ggp *Node) map[string]bool {
+    scope := make(map[string]bool)
+    inScope(ggp, scope)
+    return scope
+}
+
+// inScope adds the in-scope variables of the group to the set
+func inScope(ggp *Node, scope map[string]bool) {
+    if sub := ggp.Child("subSelect"); sub != nil {
+        projectionScope(sub, scope)
+        return
+    }
+    if gp := ggp.Child("graphPattern"); gp != nil {
+        for _,elem := range groupElements(gp) {
+            elementScope(elem, scope)
+        }
+    }
+}
+
+// projectionScope adds the variables projected by the sub-query to the set
+func projectionScope(sub *Node, scope map[string]bool) {
+    sel := sub.Child("select")
+    if sel.Child("STAR") != nil {
+        inScope(sub.Child("whereClause").Child("groupGraphPattern"), scope)
+        return
+    }
+    for _,elem := range sel.ChildrenOf("projectionElem") {
+        scope[varName(elem.Child("var"))] = true
+    }
+}
+
+// elementScope adds the in-scope variables of an element of a group to the set
+func elementScope(elem *Node, scope map[string]bool) {
+    switch elem.Rule {
+    case "triplesBlock", "inlineData":
+        for _,vr := range elem.FindAll("var") {
+            scope[varName(vr)] = true
+        }
+    case "filterOrBind":
+        if elem.Child("BIND") != nil {
+            scope[varName(elem.Child("var"))] = true
+        }
+    case "optionalGraphPattern":
+        if sub := elem.Child("subSelect"); sub != nil {
+            projectionScope(sub, scope)
+        } else if gp := elem.Child("graphPattern"); gp != nil {
+            for _,e := range groupElements(gp) {
+                elementScope(e, scope)
+            }
+        }
+    case "groupOrUnionGraphPattern":
+        // only the branches of the union, not the groups nested in a branch
+        // or in the EXISTS of a FILTER
+        for union := elem; union != nil; union = union.Child("groupOrUnionGraphPattern") {
+            inScope(union.Child("groupGraphPattern"), scope)
+        }
+    case "graphGraphPattern", "serviceGraphPattern":
+        if vr := elem.Child("var"); vr != nil {
+            scope[varName(vr)] = true
+        }
+        inScope(elem.Child("groupGraphPattern"), scope)
+    }
+}
@@ -0,0 +1,122 @@
+package sparql
+
+import (
+    "testing"
+)
+
+// validate returns the static errors of the query
+func validate(t *testing.T, query string) []*ValidationError {
+    return parse(t, query).Validate()
+}
+
+func TestValidateValidQueries(t *testing.T) {
+    queries := []string{
+        `PREFIX : <http://example.org/> SELECT ?s { ?s :p ?o }`,
+        `SELECT ?s (COUNT(?o) AS ?c) { ?s <p> ?o } GROUP BY ?s HAVING (COUNT(?o) > 1)`,
+        `SELECT (COUNT(*) AS ?c) (?c + 1 AS ?d) { ?s <p> ?o }`,
+        `SELECT ?k (SAMPLE(?o) AS ?x) { ?s <p> ?o } GROUP BY (STR(?s) AS ?k)`,
+        // a variable in brackets is a key of the groups
+        `SELECT ?s (COUNT(?o) AS ?c) { ?s <p> ?o } GROUP BY (?s)`,
+        `SELECT ?s { ?s <p> ?o } GROUP BY ?s HAVING (?s != <a> && COUNT(?o) > 1)`,
+        `SELECT ?s ?z { ?s <p> ?o BIND (?o + 1 AS ?z) }`,
+        `SELECT ?s { ?s <p> ?o MINUS { ?s <q> ?z } BIND (1 AS ?z) }`,
+        `SELECT ?s { { SELECT ?s { ?s <p> ?o } } BIND (1 AS ?o) }`,
+        `SELECT ?s { ?s <p> ?o FILTER (?z > 1) BIND (1 AS ?z) }`,
+        `SELECT * { ?s <p> ?o }`,
+        // the variables of an EXISTS or of a sub-query are not in-scope
+        `SELECT ?s { { ?s <p> ?o FILTER EXISTS { ?o <q> ?z } } UNION { ?s <r> ?o } BIND (1 AS ?z) }`,
+        `SELECT ?s { { ?s <p> ?o FILTER NOT EXISTS { ?o <q> ?z } } BIND (1 AS ?z) }`,
+        `SELECT ?s { { SELECT ?s { ?s <p> ?z } } UNION { ?s <r> ?o } BIND (1 AS ?z) }`,
+    }
+    for _,query := range queries {
+        if errs := validate(t, query); len(errs) != 0 {
+            t.Errorf("Unexpected errors %v in\n%v", errs, query)
+        }
+    }
+}
+
+func TestValidateErrors(t *testing.T) {
+    tests := []struct {
+        query string
+        errors []string
+    }{
+        {
+            `PREFIX a: <http://a/> SELECT * { ?s a:p ?o ; b:p ?o2 ; :p [] }`,
+            []string{ "Undeclared prefix b: in b:p", "Undeclared prefix : in :p" },
+        },
+        {
+            `SELECT ?s (COUNT(?o) AS ?c) { ?s <p> ?o }`,
+            []string{ "Variable ?s is not grouped" },
+        },
+        {
+            `SELECT (?s AS ?x) (MAX(?o) AS ?m) { ?s <p> ?o } GROUP BY ?o`,
+            []string{ "Variable ?s is not grouped" },
+        },
+        {
+            `SELECT ?s (COUNT(?o) AS ?c) { ?s <p> ?o } GROUP BY (STR(?s))`,
+            []string{ "Variable ?s is not grouped" },
+        },
+        {
+            `SELECT ?s { ?s <p> ?o } GROUP BY ?s HAVING (?o > 1)`,
+            []string{ "Variable ?o is not grouped" },
+        },
+        {
+            `SELECT (COUNT(?o) AS ?c) { ?s <p> ?o } HAVING (?s != <a> && SUM(?o) > 1)`,
+            []string{ "Variable ?s is not grouped" },
+        },
+        {
+            `SELECT * { ?s <p> ?o } GROUP BY ?s`,
+            []string{ "SELECT * is not allowed with GROUP BY or aggregates" },
+        },
+        {
+            `SELECT ?s { ?s <p> ?o OPTIONAL { ?o <q> ?z } BIND (1 AS ?z) }`,
+            []string{ "Variable ?z of the BIND is already in-scope" },
+        },
+        {
+            `SELECT ?s { { ?s <p> ?o } UNION { ?s <q> ?z } BIND (1 AS ?z) }`,
+            []string{ "Variable ?z of the BIND is already in-scope" },
+        },
+        {
+            `SELECT ?s { ?s <p> ?o BIND (1 AS ?x) BIND (2 AS ?x) }`,
+            []string{ "Variable ?x of the BIND is already in-scope" },
+        },
+        {
+            `SELECT (1 AS ?x) (2 AS ?x) ?x {}`,
+            []string{ "Variable ?x is projected twice", "Variable ?x is projected twice" },
+        },
+        {
+            `SELECT (1 AS ?o) { ?s <p> ?o }`,
+            []string{ "Variable ?o of the SELECT expression is already in-scope" },
+        },
+        {
+            `SELECT ?s { { SELECT (1 AS ?s) { ?s <p> ?o } } }`,
+            []string{ "Variable ?s of the SELECT expression is already in-scope" },
+        },
+    }
+    for _,test := range tests {
+        errs := validate(t, test.query)
+        if len(errs) != len(test.errors) {
+            t.Errorf("Expected errors %v but got %v in\n%v", test.errors, errs, test.query)
+            continue
+        }
+        for i,err := range errs {
+            if err.Message != test.errors[i] {
+                t.Errorf("Expected error [%v] but got [%v] in\n%v", test.errors[i], err.Message, test.query)
+            }
+        }
+    }
+}
+
+func TestValidatePositions(t *testing.T) {
+    errs := validate(t, "SELECT ?s\nWHERE {\n  ?s ex:p ?o\n}")
+    if len(errs) != 1 {
+        t.Fatalf("Expected one error but got %v", errs)
+    }
+    err := errs[0]
+    if err.Begin != 23 || err.End != 27 || err.Line != 3 || err.Column != 6 {
+        t.Errorf("Unexpected position %v-%v (line %v column %v)", err.Begin, err.End, err.Line, err.Column)
+    }
+    if err.Error() != "Undeclared prefix ex: in ex:p (line 3 symbol 6)" {
+        t.Errorf("Unexpected message %v", err.Error())
+    }
+}
@@ -0,0 +1,668 @@
+package store
+
+import (
+    "fmt"
+    "regexp"
+    "sort"
+    "strings"
+    "github.com/scampi/gosparqled/algebra"
+    "github.com/scampi/gosparqled/results"
+)
+
+// solution maps a variable, with its leading '?', to the id of its term
+type solution map[string]id
+
+// evaluator holds the state of the evaluation of a query. The terms computed
+// by the query, e.g., with concat, are given ids after those of the store.
+type evaluator struct {
+    store *Store
+    terms []results.Term
+    ids map[results.Term]id
+    // The constants of the query, and the compiled regular expressions
+    constants map[string]results.Term
+    regexps map[string]*regexp.Regexp
+    // The solution substituted in the pattern of an EXISTS being evaluated
+    bindings solution
+}
+
+// Query evaluates the SELECT or ASK query over the triples of the store. The
+// dataset clauses of the query are ignored.
+func (st *Store) Query(query string) (*results.Results, error) {
+    a, err := algebra.Parse(query)
+    if err != nil {
+        return nil, err
+    }
+    if a.Form != "select" && a.Form != "ask" {
+        return nil, fmt.Errorf("Unsupported query form [%v]", a.Form)
+    }
+    if err := check(a.Op); err != nil {
+        return nil, err
+    }
+    st.mu.RLock()
+    defer st.mu.RUnlock()
+    e := &evaluator{
+        store : st,
+        ids : make(map[results.Term]id),
+        constants : make(map[string]results.Term),
+        regexps : make(map[string]*regexp.Regexp),
+    }
+    solutions, err := e.op(a.Op)
+    if err != nil {
+        return nil, err
+    }
+    if a.Form == "ask" {
+        return &results.Results{ Ask : true, Boolean : len(solutions) != 0 }, nil
+    }
+    res := &results.Results{ Vars : []string{}, Solutions : []results.Solution{} }
+    for _,v := range projection(a.Op) {
+        res.Vars = append(res.Vars, v[1:])
+    }
+    for _,s := range solutions {
+        solution := results.Solution{}
+        for _,v := range res.Vars {
+            if i, ok := s["?" + v]; ok {
+                solution[v] = e.term(i)
+            }
+        }
+        res.Solutions = append(res.Solutions, solution)
+    }
+    return res, nil
+}
+
+// check returns an error if the query uses an operator or a function that is
+// not supported
+func check(op algebra.Op) error {
+    var err error
+    algebra.Walk(op, func(op algebra.Op) bool {
+        switch o := op.(type) {
+        case *algebra.PathOp:
+            err = fmt.Errorf("Unsupported property path [%v]", algebra.Print(o))
+        case *algebra.Graph:
+            err = fmt.Errorf("Unsupported GRAPH [%v]", o.Name)
+        case *algebra.Service:
+            err = fmt.Errorf("Unsupported SERVICE [%v]", o.Name)
+        case *algebra.Filter:
+            err = checkExprs(o.Exprs...)
+        case *algebra.LeftJoin:
+            err = checkExprs(o.Exprs...)
+        case *algebra.Extend:
+            for _,b := range o.Bindings {
+                if err == nil {
+                    err = checkExprs(b.Expr)
+                }
+            }
+        case *algebra.Group:
+            for _,b := range o.Keys {
+                if err == nil {
+                    err = checkExprs(b.Expr)
+                }
+            }
+            for _,a := range o.Aggregations {
+                if _, ok := aggregates[a.Aggregate.Name]; !ok && err == nil {
+                    err = fmt.Errorf("Unsupported aggregate [%v]", a.Aggregate.Name)
+                }
+                if a.Aggregate.Expr != nil && err == nil {
+                    err = checkExprs(a.Aggregate.Expr)
+                }
+            }
+        case *algebra.OrderBy:
+            for _,c := range o.Conditions {
+                if err == nil {
+                    err = checkExprs(c.Expr)
+                }
+            }
+        }
+        return err == nil
+    })
+    return err
+}
+
+// checkExprs returns an error if an expression calls a function that is not
+// supported
+func checkExprs(exprs ...algebra.Expr) error {
+    for _,expr := range exprs {
+        if call, ok := expr.(*algebra.Call); ok {
+            if _, ok := functions[call.Name]; !ok && !special[call.Name] {
+                return fmt.Errorf("Unsupported function [%v]", call.Name)
+            }
+            if err := checkExprs(call.Args...); err != nil {
+                return err
+            }
+        }
+    }
+    return nil
+}
+
+// projection returns the variables of the results, i.e., the projected ones,
+// or those of the patterns for SELECT *
+func projection(op algebra.Op) []string {
+    for {
+        switch o := op.(type) {
+        case *algebra.Project:
+            return o.Vars
+        case *algebra.Slice:
+            op = o.Op
+            continue
+        case *algebra.Distinct:
+            op = o.Op
+            continue
+        case *algebra.Reduced:
+            op = o.Op
+            continue
+        case *algebra.OrderBy:
+            op = o.Op
+            continue
+        }
+        break
+    }
+    var vars []string
+    seen := make(map[string]bool)
+    add := func(v string) {
+        if strings.HasPrefix(v, "?") && !strings.HasPrefix(v, "??") && !strings.HasPrefix(v, "?.") && !seen[v] {
+            seen[v] = true
+            vars = append(vars, v)
+        }
+    }
+    algebra.Walk(op, func(op algebra.Op) bool {
+        switch o := op.(type) {
+        case *algebra.BGP:
+            for _,t := range o.Triples {
+                add(t.S)
+                add(t.P)
+                add(t.O)
+            }
+        case *algebra.Table:
+            for _,v := range o.Vars {
+                add(v)
+            }
+        case *algebra.Extend:
+            for _,b := range o.Bindings {
+                add(b.Var)
+            }
+        case *algebra.Project:
+            for _,v := range o.Vars {
+                add(v)
+            }
+            return false
+        }
+        return true
+    })
+    return vars
+}
+
+// term returns the term of the id
+func (e *evaluator) term(i id) results.Term {
+    if int(i) < len(e.store.terms) {
+        return e.store.terms[i]
+    }
+    return e.terms[int(i) - len(e.store.terms)]
+}
+
+// intern returns the id of the term, which is added to the terms of the
+// query if it is not in the store
+func (e *evaluator) intern(t results.Term) id {
+    t = normalize(t)
+    if i, ok := e.store.ids[t]; ok {
+        return i
+    }
+    if i, ok := e.ids[t]; ok {
+        return i
+    }
+    i := id(len(e.store.terms) + len(e.terms))
+    e.terms = append(e.terms, t)
+    e.ids[t] = i
+    return i
+}
+
+// constant returns the term of a constant of the query
+func (e *evaluator) constant(c string) (results.Term, error) {
+    if t, ok := e.constants[c]; ok {
+        return t, nil
+    }
+    t, err := results.ParseTerm(c)
+    if err != nil {
+        return t, err
+    }
+    t = normalize(t)
+    e.constants[c] = t
+    return t, nil
+}
+
+// op returns the solutions of the operator
+func (e *evaluator) op(op algebra.Op) ([]solution, error) {
+    switch o := op.(type) {
+    case *algebra.BGP:
+        return e.bgp(o.Triples)
+    case *algebra.Table:
+        return e.table(o)
+    case *algebra.Sequence:
+        acc := []solution{ {} }
+        for _,child := range o.Ops {
+            right, err := e.op(child)
+            if err != nil {
+                return nil, err
+            }
+            acc = join(acc, right)
+        }
+        return acc, nil
+    case *algebra.Join:
+        left, right, err := e.both(o.Left, o.Right)
+        if err != nil {
+            return nil, err
+        }
+        return join(left, right), nil
+    case *algebra.LeftJoin:
+        left, right, err := e.both(o.Left, o.Right)
+        if err != nil {
+            return nil, err
+        }
+        return e.leftJoin(left, right, o.Exprs), nil
+    case *algebra.Union:
+        left, right, err := e.both(o.Left, o.Right)
+        if err != nil {
+            return nil, err
+        }
+        return append(left, right...), nil
+    case *algebra.Minus:
+        left, right, err := e.both(o.Left, o.Right)
+        if err != nil {
+            return nil, err
+        }
+        return minus(left, right), nil
+    }
+    // the operators over the solutions of a single pattern
+    children := op.Children()
+    if len(children) != 1 {
+        return nil, fmt.Errorf("Unsupported operator [%T]", op)
+    }
+    in, err := e.op(children[0])
+    if err != nil {
+        return nil, err
+    }
+    switch o := op.(type) {
+    case *algebra.Filter:
+        var out []solution
+        for _,s := range in {
+            if e.filter(o.Exprs, s) {
+                out = append(out, s)
+            }
+        }
+        return out, nil
+    case *algebra.Extend:
+        for _,s := range in {
+            for _,b := range o.Bindings {
+                if _, ok := s[b.Var]; ok {
+                    continue
+                }
+                if t, err := e.expr(b.Expr, s); err == nil {
+                    s[b.Var] = e.intern(t)
+                }
+            }
+        }
+        return in, nil
+    case *algebra.Group:
+        return e.group(o, in), nil
+    case *algebra.OrderBy:
+        e.orderBy(o.Conditions, in)
+        return in, nil
+    case *algebra.Project:
+        out := make([]solution, len(in))
+        for i,s := range in {
+            out[i] = solution{}
+            for _,v := range o.Vars {
+                if t, ok := s[v]; ok {
+                    out[i][v] = t
+                }
+            }
+        }
+        return out, nil
+    case *algebra.Distinct:
+        return distinct(in), nil
+    case *algebra.Reduced:
+        return distinct(in), nil
+    case *algebra.Slice:
+        if o.Offset > 0 {
+            if o.Offset >= int64(len(in)) {
+                return nil, nil
+            }
+            in = in[o.Offset:]
+        }
+        if o.Limit >= 0 && o.Limit < int64(len(in)) {
+            in = in[:o.Limit]
+        }
+        return in, nil
+    }
+    return nil, fmt.Errorf("Unsupported operator [%T]", op)
+}
+
+// both returns the solutions of the two operators
+func (e *evaluator) both(left, right algebra.Op) ([]solution, []solution, error) {
+    l, err := e.op(left)
+    if err != nil {
+        return nil, nil, err
+    }
+    r, err := e.op(right)
+    if err != nil {
+        return nil, nil, err
+    }
+    return l, r, nil
+}
+
+// bgp returns the solutions of the triple patterns, which extend the bindings
+// of an enclosing EXISTS if any. The pattern with the most bound terms is
+// matched first.
+func (e *evaluator) bgp(triples []algebra.Triple) ([]solution, error) {
+    solutions := []solution{ merge(e.bindings, nil) }
+    todo := append([]algebra.Triple{}, triples...)
+    bound := make(map[string]bool)
+    for len(todo) != 0 {
+        best, bestScore := 0, -1
+        for i,t := range todo {
+            score := 0
+            for _,x := range []string{ t.S, t.P, t.O } {
+                if !strings.HasPrefix(x, "?") || bound[x] {
+                    score++
+                }
+            }
+            if score > bestScore {
+                best, bestScore = i, score
+            }
+        }
+        t := todo[best]
+        todo = append(todo[:best], todo[best + 1:]...)
+        var err error
+        if solutions, err = e.triple(t, solutions); err != nil || len(solutions) == 0 {
+            return nil, err
+        }
+        for _,x := range []string{ t.S, t.P, t.O } {
+            bound[x] = true
+        }
+    }
+    return solutions, nil
+}
+
+// triple returns the solutions extended with the matches of the triple pattern
+func (e *evaluator) triple(t algebra.Triple, in []solution) ([]solution, error) {
+    pattern := []string{ t.S, t.P, t.O }
+    // the ids of the constants
+    var constants [3]id
+    for i,x := range pattern {
+        if strings.HasPrefix(x, "?") {
+            continue
+        }
+        term, err := e.constant(x)
+        if err != nil {
+            return nil, err
+        }
+        var ok bool
+        if constants[i], ok = e.store.ids[term]; !ok {
+            return nil, nil
+        }
+    }
+    var out []solution
+    for _,s := range in {
+        var ids [3]id
+        for i,x := range pattern {
+            if constants[i] != 0 {
+                ids[i] = constants[i]
+            } else {
+                ids[i] = s[x]
+            }
+        }
+        e.store.match(ids[0], ids[1], ids[2], func(si, pi, oi id) {
+            match := []id{ si, pi, oi }
+            extended := solution{}
+            for k,v := range s {
+                extended[k] = v
+            }
+            for i,x := range pattern {
+                if constants[i] != 0 {
+                    continue
+                }
+                // the same variable may occur twice in the pattern
+                if prev, ok := extended[x]; ok && prev != match[i] {
+                    return
+                }
+                extended[x] = match[i]
+            }
+            out = append(out, extended)
+        })
+    }
+    return out, nil
+}
+
+// table returns the solutions of inline data
+func (e *evaluator) table(t *algebra.Table) ([]solution, error) {
+    var out []solution
+    for _,row := range t.Rows {
+        s := solution{}
+        for i,value := range row {
+            if value == "" {
+                continue
+            }
+            term, err := e.constant(value)
+            if err != nil {
+                return nil, err
+            }
+            s[t.Vars[i]] = e.intern(term)
+        }
+        out = append(out, s)
+    }
+    return out, nil
+}
+
+// compatible returns true if the solutions agree on their shared variables
+func compatible(a, b solution) bool {
+    for k,v := range a {
+        if w, ok := b[k]; ok && w != v {
+            return false
+        }
+    }
+    return true
+}
+
+// merge returns the union of two compatible solutions
+func merge(a, b solution) solution {
+    s := make(solution, len(a) + len(b))
+    for k,v := range a {
+        s[k] = v
+    }
+    for k,v := range b {
+        s[k] = v
+    }
+    return s
+}
+
+// join returns the merge of the compatible solutions
+func join(left, right []solution) []solution {
+    var out []solution
+    for _,l := range left {
+        for _,r := range right {
+            if compatible(l, r) {
+                out = append(out, merge(l, r))
+            }
+        }
+    }
+    return out
+}
+
+// leftJoin returns the join of the solutions satisfying the filters, and the
+// left solutions that have none
+func (e *evaluator) leftJoin(left, right []solution, exprs []algebra.Expr) []solution {
+    var out []solution
+    for _,l := range left {
+        matched := false
+        for _,r := range right {
+            if !compatible(l, r) {
+                continue
+            }
+            if m := merge(l, r); e.filter(exprs, m) {
+                out = append(out, m)
+                matched = true
+            }
+        }
+        if !matched {
+            out = append(out, l)
+        }
+    }
+    return out
+}
+
+// minus returns the left solutions which are not compatible with a right one
+// sharing a variable
+func minus(left, right []solution) []solution {
+    var out []solution
+    for _,l := range left {
+        removed := false
+        for _,r := range right {
+            shared := false
+            for k := range r {
+                if _, ok := l[k]; ok {
+                    shared = true
+                    break
+                }
+            }
+            if shared && compatible(l, r) {
+                removed = true
+                break
+            }
+        }
+        if !removed {
+            out = append(out, l)
+        }
+    }
+    return out
+}
+
+// key returns a string identifying the solution
+func key(s solution) string {
+    vars := make([]string, 0, len(s))
+    for v := range s {
+        vars = append(vars, v)
+    }
+    sort.Strings(vars)
+    var b strings.Builder
+    for _,v := range vars {
+        fmt.Fprintf(&b, "%v=%v ", v, s[v])
+    }
+    return b.String()
+}
+
+// distinct removes the duplicate solutions, keeping the first
+func distinct(in []solution) []solution {
+    var out []solution
+    seen := make(map[string]bool)
+    for _,s := range in {
+        if k := key(s); !seen[k] {
+            seen[k] = true
+            out = append(out, s)
+        }
+    }
+    return out
+}
+
+// filter returns true if the effective boolean value of all the expressions
+// is true for the solution
+func (e *evaluator) filter(exprs []algebra.Expr, s solution) bool {
+    for _,expr := range exprs {
+        t, err := e.expr(expr, s)
+        if err != nil {
+            return false
+        }
+        if b, err := ebv(t); err != nil || !b {
+            return false
+        }
+    }
+    return true
+}
+
+// orderBy sorts the solutions by the conditions
+func (e *evaluator) orderBy(conditions []algebra.OrderCondition, in []solution) {
+    // the values of the conditions are computed once
+    values := make([][]*results.Term, len(in))
+    for i,s := range in {
+        values[i] = make([]*results.Term, len(conditions))
+        for j,c := range conditions {
+            if t, err := e.expr(c.Expr, s); err == nil {
+                values[i][j] = &t
+            }
+        }
+    }
+    index := make([]int, len(in))
+    for i := range index {
+        index[i] = i
+    }
+    sort.SliceStable(index, func(a, b int) bool {
+        for j,c := range conditions {
+            cmp := order(values[index[a]][j], values[index[b]][j])
+            if c.Direction == "desc" {
+                cmp = -cmp
+            }
+            if cmp != 0 {
+                return cmp < 0
+            }
+        }
+        return false
+    })
+    sorted := make([]solution, len(in))
+    for i,k := range index {
+        sorted[i] = in[k]
+    }
+    copy(in, sorted)
+}
+
+// group returns a solution per group of solutions with the same keys, with
+// the values of the aggregations over the group
+func (e *evaluator) group(g *algebra.Group, in []solution) []solution {
+    type partition struct {
+        keys solution
+        members []solution
+    }
+    var groups []*partition
+    byKey := make(map[string]*partition)
+    for _,s := range in {
+        keys := solution{}
+        // the solutions are partitioned on the values of all the keys, even
+        // those of an expression without a variable, e.g., GROUP BY (str(?o))
+        var k strings.Builder
+        for _,b := range g.Keys {
+            t, err := e.expr(b.Expr, s)
+            if err != nil {
+                k.WriteString("unbound ")
+                continue
+            }
+            i := e.intern(t)
+            fmt.Fprintf(&k, "%v ", i)
+            // a key which is a variable keeps its value
+            v := b.Var
+            if t, ok := b.Expr.(algebra.Term); ok && v == "" {
+                v = string(t)
+            }
+            if v != "" {
+                keys[v] = i
+            }
+        }
+        p, ok := byKey[k.String()]
+        if !ok {
+            p = &partition{ keys : keys }
+            byKey[k.String()] = p
+            groups = append(groups, p)
+        }
+        p.members = append(p.members, s)
+    }
+    // the aggregates over no solution form a single group
+    if len(g.Keys) == 0 && len(groups) == 0 {
+        groups = append(groups, &partition{ keys : solution{} })
+    }
+    var out []solution
+    for _,p := range groups {
+        s := p.keys
+        for _,a := range g.Aggregations {
+            if t, err := e.aggregate(a.Aggregate, p.members); err == nil {
+                s[a.Var] = e.intern(t)
+            }
+        }
+        out = append(out, s)
+    }
+    return out
+}
@@ -0,0 +1,563 @@
+package store
+
+import (
+    "errors"
+    "fmt"
+    "math"
+    "regexp"
+    "strconv"
+    "strings"
+    "github.com/scampi/gosparqled/algebra"
+    "github.com/scampi/gosparqled/results"
+)
+
+// errType is the error of an expression applied to terms of the wrong kind,
+// or to an unbound variable
+var errType = errors.New("Type error")
+
+// The boolean literals
+var (
+    trueTerm = results.Term{ Kind : results.LITERAL, Value : "true", Datatype : xsd + "boolean" }
+    falseTerm = results.Term{ Kind : results.LITERAL, Value : "false", Datatype : xsd + "boolean" }
+)
+
+func boolean(b bool) results.Term {
+    if b {
+        return trueTerm
+    }
+    return falseTerm
+}
+
+// integer returns the xsd:integer literal
+func integer(n int) results.Term {
+    return results.Term{ Kind : results.LITERAL, Value : strconv.Itoa(n), Datatype : xsd + "integer" }
+}
+
+// The functions whose arguments are not all evaluated first
+var special = map[string]bool{
+    "||" : true,
+    "&&" : true,
+    "bound" : true,
+    "if" : true,
+    "coalesce" : true,
+    "in" : true,
+    "notin" : true,
+}
+
+// The functions over the values of their arguments
+var functions = map[string]func(e *evaluator, args []results.Term) (results.Term, error){
+    "!" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        b, err := ebv(args[0])
+        return boolean(!b), err
+    },
+    "=" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        eq, err := equal(args[0], args[1])
+        return boolean(eq), err
+    },
+    "!=" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        eq, err := equal(args[0], args[1])
+        return boolean(!eq), err
+    },
+    "<" : comparison(func(cmp int) bool { return cmp < 0 }),
+    "<=" : comparison(func(cmp int) bool { return cmp <= 0 }),
+    ">" : comparison(func(cmp int) bool { return cmp > 0 }),
+    ">=" : comparison(func(cmp int) bool { return cmp >= 0 }),
+    "+" : arithmetic(func(a, b float64) float64 { return a + b }, false),
+    "-" : arithmetic(func(a, b float64) float64 { return a - b }, false),
+    "*" : arithmetic(func(a, b float64) float64 { return a * b }, false),
+    "/" : arithmetic(func(a, b float64) float64 { return a / b }, true),
+    "sameTerm" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        return boolean(args[0] == args[1]), nil
+    },
+    "str" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        if args[0].Kind == results.BLANK {
+            return results.Term{}, errType
+        }
+        return results.NewLiteral(args[0].Value), nil
+    },
+    "lang" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        if args[0].Kind != results.LITERAL {
+            return results.Term{}, errType
+        }
+        return results.NewLiteral(args[0].Lang), nil
+    },
+    "langMatches" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        tag, r := strings.ToLower(args[0].Value), strings.ToLower(args[1].Value)
+        if r == "*" {
+            return boolean(tag != ""), nil
+        }
+        return boolean(tag == r || strings.HasPrefix(tag, r + "-")), nil
+    },
+    "datatype" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        if args[0].Kind != results.LITERAL {
+            return results.Term{}, errType
+        }
+        return results.NewIRI(datatype(args[0])), nil
+    },
+    "isIRI" : kind(results.IRI),
+    "isURI" : kind(results.IRI),
+    "isBlank" : kind(results.BLANK),
+    "isLiteral" : kind(results.LITERAL),
+    "isNumeric" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        _, ok := number(args[0])
+        return boolean(ok), nil
+    },
+    "regex" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        flags := ""
+        if len(args) > 2 {
+            flags = args[2].Value
+        }
+        re, err := e.regexp(args[1].Value, flags)
+        if err != nil || args[0].Kind == results.BLANK {
+            return results.Term{}, errType
+        }
+        // the IRIs are matched as strings, as most endpoints do
+        return boolean(re.MatchString(args[0].Value)), nil
+    },
+    "contains" : strings2(strings.Contains),
+    "strstarts" : strings2(strings.HasPrefix),
+    "strends" : strings2(strings.HasSuffix),
+    "strlen" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        if args[0].Kind != results.LITERAL {
+            return results.Term{}, errType
+        }
+        return integer(len([]rune(args[0].Value))), nil
+    },
+    "lcase" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        t := args[0]
+        t.Value = strings.ToLower(t.Value)
+        return t, nil
+    },
+    "ucase" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        t := args[0]
+        t.Value = strings.ToUpper(t.Value)
+        return t, nil
+    },
+    "concat" : func(e *evaluator, args []results.Term) (results.Term, error) {
+        var b strings.Builder
+        // the IRIs are concatenated as strings, e.g., for the paths
+        for _,arg := range args {
+            if arg.Kind == results.BLANK {
+                return results.Term{}, errType
+            }
+            b.WriteString(arg.Value)
+        }
+        return results.NewLiteral(b.String()), nil
+    },
+}
+
+// comparison returns the function comparing its two arguments
+func comparison(test func(int) bool) func(*evaluator, []results.Term) (results.Term, error) {
+    return func(e *evaluator, args []results.Term) (results.Term, error) {
+        cmp, err := compare(args[0], args[1])
+        return boolean(err == nil && test(cmp)), err
+    }
+}
+
+// arithmetic returns the function of the binary operator over numbers, or of
+// the unary operator for the minus and plus signs. The division of integers is
+// a decimal.
+func arithmetic(op func(a, b float64) float64, division bool) func(*evaluator, []results.Term) (results.Term, error) {
+    return func(e *evaluator, args []results.Term) (results.Term, error) {
+        if len(args) == 1 {
+            args = []results.Term{ integer(0), args[0] }
+        }
+        a, ok := number(args[0])
+        b, ok2 := number(args[1])
+        if !ok || !ok2 {
+            return results.Term{}, errType
+        }
+        value := op(a, b)
+        if math.IsInf(value, 0) || math.IsNaN(value) {
+            return results.Term{}, errType
+        }
+        switch dt := widest(datatype(args[0]), datatype(args[1])); {
+        case dt == xsd + "integer" && !division:
+            return results.Term{ Kind : results.LITERAL, Value : strconv.FormatInt(int64(value), 10), Datatype : dt }, nil
+        case dt == xsd + "integer", dt == xsd + "decimal":
+            return decimal(value), nil
+        default:
+            return results.Term{ Kind : results.LITERAL, Value : strconv.FormatFloat(value, 'E', -1, 64), Datatype : dt }, nil
+        }
+    }
+}
+
+// decimal returns the xsd:decimal literal of the number
+func decimal(value float64) results.Term {
+    lexical := strconv.FormatFloat(value, 'f', -1, 64)
+    if !strings.Contains(lexical, ".") {
+        lexical += ".0"
+    }
+    return results.Term{ Kind : results.LITERAL, Value : lexical, Datatype : xsd + "decimal" }
+}
+
+// kind returns the function testing the kind of its argument
+func kind(k results.Kind) func(*evaluator, []results.Term) (results.Term, error) {
+    return func(e *evaluator, args []results.Term) (results.Term, error) {
+        return boolean(args[0].Kind == k), nil
+    }
+}
+
+// strings2 returns the function testing its two string arguments
+func strings2(test func(s, substr string) bool) func(*evaluator, []results.Term) (results.Term, error) {
+    return func(e *evaluator, args []results.Term) (results.Term, error) {
+        if args[0].Kind == results.BLANK || args[1].Kind != results.LITERAL {
+            return results.Term{}, errType
+        }
+        return boolean(test(args[0].Value, args[1].Value)), nil
+    }
+}
+
+// regexp returns the compiled regular expression with the flags of the regex
+// function
+func (e *evaluator) regexp(pattern string, flags string) (*regexp.Regexp, error) {
+    k := flags + "/" + pattern
+    if re, ok := e.regexps[k]; ok {
+        return re, nil
+    }
+    if strings.Trim(flags, "ism") != "" {
+        return nil, fmt.Errorf("Unsupported regex flags [%v]", flags)
+    }
+    if flags != "" {
+        pattern = "(?" + flags + ")" + pattern
+    }
+    re, err := regexp.Compile(pattern)
+    if err != nil {
+        return nil, err
+    }
+    e.regexps[k] = re
+    return re, nil
+}
+
+// expr returns the value of the expression for the solution
+func (e *evaluator) expr(expr algebra.Expr, s solution) (results.Term, error) {
+    switch x := expr.(type) {
+    case algebra.Term:
+        if strings.HasPrefix(string(x), "?") {
+            i, ok := s[string(x)]
+            if !ok {
+                if i, ok = e.bindings[string(x)]; !ok {
+                    return results.Term{}, errType
+                }
+            }
+            return e.term(i), nil
+        }
+        return e.constant(string(x))
+    case *algebra.Exists:
+        // the variables of the solution are substituted in the pattern
+        outer := e.bindings
+        e.bindings = merge(outer, s)
+        right, err := e.op(x.Op)
+        e.bindings = outer
+        if err != nil {
+            return results.Term{}, err
+        }
+        exists := len(join([]solution{ s }, right)) != 0
+        return boolean(exists != x.Not), nil
+    case *algebra.Call:
+        if special[x.Name] {
+            return e.special(x, s)
+        }
+        args := make([]results.Term, len(x.Args))
+        for i,arg := range x.Args {
+            t, err := e.expr(arg, s)
+            if err != nil {
+                return t, err
+            }
+            args[i] = t
+        }
+        return functions[x.Name](e, args)
+    }
+    return results.Term{}, fmt.Errorf("Unsupported expression [%v]", algebra.PrintExpr(expr))
+}
+
+// special returns the value of a function whose arguments are evaluated lazily
+func (e *evaluator) special(x *algebra.Call, s solution) (results.Term, error) {
+    switch x.Name {
+    case "bound":
+        _, ok := s[string(x.Args[0].(algebra.Term))]
+        return boolean(ok), nil
+    case "||", "&&":
+        // an error is ignored if the other operand decides the value
+        a, errA := e.bool(x.Args[0], s)
+        b, errB := e.bool(x.Args[1], s)
+        decisive := x.Name == "||"
+        if (errA == nil && a == decisive) || (errB == nil && b == decisive) {
+            return boolean(decisive), nil
+        }
+        if errA != nil {
+            return results.Term{}, errA
+        }
+        if errB != nil {
+            return results.Term{}, errB
+        }
+        return boolean(!decisive), nil
+    case "if":
+        cond, err := e.bool(x.Args[0], s)
+        if err != nil {
+            return results.Term{}, err
+        }
+        if cond {
+            return e.expr(x.Args[1], s)
+        }
+        return e.expr(x.Args[2], s)
+    case "coalesce":
+        for _,arg := range x.Args {
+            if t, err := e.expr(arg, s); err == nil {
+                return t, nil
+            }
+        }
+        return results.Term{}, errType
+    case "in", "notin":
+        value, err := e.expr(x.Args[0], s)
+        if err != nil {
+            return results.Term{}, err
+        }
+        for _,arg := range x.Args[1:] {
+            t, err := e.expr(arg, s)
+            if err != nil {
+                continue
+            }
+            if eq, _ := equal(value, t); eq {
+                return boolean(x.Name == "in"), nil
+            }
+        }
+        return boolean(x.Name == "notin"), nil
+    }
+    return results.Term{}, fmt.Errorf("Unsupported function [%v]", x.Name)
+}
+
+// bool returns the effective boolean value of the expression
+func (e *evaluator) bool(expr algebra.Expr, s solution) (bool, error) {
+    t, err := e.expr(expr, s)
+    if err != nil {
+        return false, err
+    }
+    return ebv(t)
+}
+
+// ebv returns the effective boolean value of the term
+func ebv(t results.Term) (bool, error) {
+    if t.Kind != results.LITERAL {
+        return false, errType
+    }
+    if t.Datatype == xsd + "boolean" {
+        return t.Value == "true" || t.Value == "1", nil
+    }
+    if n, ok := number(t); ok {
+        return n != 0 && !math.IsNaN(n), nil
+    }
+    if t.Datatype == "" {
+        return t.Value != "", nil
+    }
+    return false, errType
+}
+
+// The numeric datatypes, by the rank of their type promotion
+var numerics = map[string]int{
+    xsd + "integer" : 0,
+    xsd + "int" : 0,
+    xsd + "long" : 0,
+    xsd + "short" : 0,
+    xsd + "byte" : 0,
+    xsd + "nonNegativeInteger" : 0,
+    xsd + "positiveInteger" : 0,
+    xsd + "nonPositiveInteger" : 0,
+    xsd + "negativeInteger" : 0,
+    xsd + "unsignedInt" : 0,
+    xsd + "unsignedLong" : 0,
+    xsd + "decimal" : 1,
+    xsd + "float" : 2,
+    xsd + "double" : 3,
+}
+
+// widest returns the datatype of an operation over numbers of the datatypes
+func widest(a, b string) string {
+    rank := numerics[a]
+    if numerics[b] > rank {
+        rank = numerics[b]
+    }
+    return []string{ xsd + "integer", xsd + "decimal", xsd + "float", xsd + "double" }[rank]
+}
+
+// datatype returns the datatype of the literal, where a simple literal is a
+// xsd:string, and a literal with a language tag a rdf:langString
+func datatype(t results.Term) string {
+    switch {
+    case t.Lang != "":
+        return "http://www.w3.org/1999/02/22-rdf-syntax-ns#langString"
+    case t.Datatype == "":
+        return xsd + "string"
+    }
+    return t.Datatype
+}
+
+// number returns the value of a numeric literal
+func number(t results.Term) (float64, bool) {
+    if _, ok := numerics[t.Datatype]; !ok || t.Kind != results.LITERAL {
+        return 0, false
+    }
+    n, err := strconv.ParseFloat(strings.TrimSpace(t.Value), 64)
+    return n, err == nil
+}
+
+// equal returns true if the terms are equal, comparing numbers by value
+func equal(a, b results.Term) (bool, error) {
+    if a == b {
+        return true, nil
+    }
+    if x, ok := number(a); ok {
+        if y, ok := number(b); ok {
+            return x == y, nil
+        }
+    }
+    // literals of unknown datatypes cannot be told unequal
+    if a.Kind == results.LITERAL && b.Kind == results.LITERAL && a.Datatype != b.Datatype && a.Lang == "" && b.Lang == "" {
+        if _, ok := numerics[a.Datatype]; !ok && a.Datatype != "" && a.Datatype != xsd + "boolean" {
+            return false, errType
+        }
+    }
+    return false, nil
+}
+
+// compare compares two literals of comparable datatypes
+func compare(a, b results.Term) (int, error) {
+    if x, ok := number(a); ok {
+        if y, ok := number(b); ok {
+            switch {
+            case x < y:
+                return -1, nil
+            case x > y:
+                return 1, nil
+            }
+            return 0, nil
+        }
+        return 0, errType
+    }
+    if a.Kind != results.LITERAL || b.Kind != results.LITERAL || a.Datatype != b.Datatype || a.Lang != b.Lang {
+        return 0, errType
+    }
+    return strings.Compare(a.Value, b.Value), nil
+}
+
+// order compares two values of an ORDER BY condition, where nil is an error or
+// an unbound variable. Unbound comes first, then blank nodes, IRIs and
+// literals.
+func order(a, b *results.Term) int {
+    rank := func(t *results.Term) int {
+        if t == nil {
+            return 0
+        }
+        return []int{ 2, 3, 1 }[t.Kind]
+    }
+    if ra, rb := rank(a), rank(b); ra != rb || ra == 0 {
+        return ra - rb
+    }
+    if cmp, err := compare(*a, *b); err == nil {
+        return cmp
+    }
+    if cmp := strings.Compare(a.Value, b.Value); cmp != 0 {
+        return cmp
+    }
+    return strings.Compare(a.Datatype + "@" + a.Lang, b.Datatype + "@" + b.Lang)
+}
+
+// The aggregates over the solutions of a group
+var aggregates = map[string]func(values []results.Term, separator string) (results.Term, error){
+    "count" : func(values []results.Term, separator string) (results.Term, error) {
+        return integer(len(values)), nil
+    },
+    "sum" : func(values []results.Term, separator string) (results.Term, error) {
+        return sum(values)
+    },
+    "avg" : func(values []results.Term, separator string) (results.Term, error) {
+        if len(values) == 0 {
+            return integer(0), nil
+        }
+        total, err := sum(values)
+        if err != nil {
+            return total, err
+        }
+        return functions["/"](nil, []results.Term{ total, integer(len(values)) })
+    },
+    "min" : extremum(-1),
+    "max" : extremum(1),
+    "sample" : func(values []results.Term, separator string) (results.Term, error) {
+        if len(values) == 0 {
+            return results.Term{}, errType
+        }
+        return values[0], nil
+    },
+    "group_concat" : func(values []results.Term, separator string) (results.Term, error) {
+        strs := make([]string, len(values))
+        for i,v := range values {
+            strs[i] = v.Value
+        }
+        return results.NewLiteral(strings.Join(strs, separator)), nil
+    },
+}
+
+// sum returns the sum of the numbers
+func sum(values []results.Term) (results.Term, error) {
+    total := integer(0)
+    for _,v := range values {
+        var err error
+        if total, err = functions["+"](nil, []results.Term{ total, v }); err != nil {
+            return total, err
+        }
+    }
+    return total, nil
+}
+
+// extremum returns the aggregate of the lowest value if sign is -1, or of the
+// greatest if sign is 1
+func extremum(sign int) func([]results.Term, string) (results.Term, error) {
+    return func(values []results.Term, separator string) (results.Term, error) {
+        if len(values) == 0 {
+            return results.Term{}, errType
+        }
+        best := values[0]
+        for _,v := range values[1:] {
+            if order(&v, &best) * sign > 0 {
+                best = v
+            }
+        }
+        return best, nil
+    }
+}
+
+// aggregate returns the value of the aggregate over the solutions of a group
+func (e *evaluator) aggregate(a *algebra.Aggregate, group []solution) (results.Term, error) {
+    var values []results.Term
+    seen := make(map[interface{}]bool)
+    for _,s := range group {
+        var v results.Term
+        var k interface{}
+        if a.Expr == nil {
+            // COUNT(*) counts the solutions
+            k = key(s)
+        } else {
+            var err error
+            if v, err = e.expr(a.Expr, s); err != nil {
+                continue
+            }
+            k = v
+        }
+        if a.Distinct {
+            if seen[k] {
+                continue
+            }
+            seen[k] = true
+        }
+        values = append(values, v)
+    }
+    separator := " "
+    if a.Separator != "" {
+        sep, err := results.ParseTerm(a.Separator)
+        if err != nil {
+            return sep, err
+        }
+        separator = sep.Value
+    }
+    return aggregates[a.Name](values, separator)
+}
@@ -0,0 +1,251 @@
+/*
+ Package store is an in-memory RDF store, which answers recommendation queries
+ without an endpoint, e.g., in tests or in offline demos.
+
+    st := store.New()
+    st.Add(results.NewIRI("http://example.org/alice"), results.NewIRI(rdfType), results.NewIRI("http://example.org/Person"))
+    query, err := s.RecommendationQuery()
+    if err != nil {
+        ...
+    }
+    res, err := st.Query(query)
+    if err != nil {
+        ...
+    }
+    for _,solution := range res.Solutions {
+        fmt.Println(solution["POF"].Value)
+    }
+
+ The triples are indexed by subject, by predicate and by object. The queries
+ are evaluated over the algebra, and the subset of SPARQL generated by the
+ templates of recommendation queries is supported: basic graph patterns,
+ OPTIONAL, UNION, MINUS, FILTER, BIND, VALUES, sub-queries, GROUP BY with the
+ aggregates, ORDER BY, DISTINCT and LIMIT, along with the usual functions on
+ strings, e.g., regex, contains or concat. Property paths, named graphs and
+ SERVICE are not supported, and a query using them is rejected.
+
+ The store also answers queries with the SPARQL 1.1 Protocol, so that it can
+ stand in for an endpoint:
+
+    server := httptest.NewServer(st)
+*/
+package store
+
+import (
+    "fmt"
+    "io"
+    "io/ioutil"
+    "net/http"
+    "strings"
+    "sync"
+    "github.com/scampi/gosparqled/rdf"
+    "github.com/scampi/gosparqled/results"
+)
+
+// The namespace of the XML Schema datatypes
+const xsd = "http://www.w3.org/2001/XMLSchema#"
+
+// id identifies a term of the store. The zero id is no term.
+type id uint32
+
+// node is a level of an index, which maps a term to the next level. The keys
+// are kept in insertion order, so that the solutions of a query are always in
+// the same order.
+type node struct {
+    keys []id
+    children map[id]*node
+}
+
+func newNode() *node {
+    return &node{ children : make(map[id]*node) }
+}
+
+// child returns the next level of the key, which is added if missing
+func (n *node) child(k id) *node {
+    c, ok := n.children[k]
+    if !ok {
+        c = newNode()
+        n.children[k] = c
+        n.keys = append(n.keys, k)
+    }
+    return c
+}
+
+// add adds the key to the last level of an index, and returns true if it was
+// missing
+func (n *node) add(k id) bool {
+    if _, ok := n.children[k]; ok {
+        return false
+    }
+    n.children[k] = nil
+    n.keys = append(n.keys, k)
+    return true
+}
+
+// Store is a set of RDF triples. It is safe for concurrent use.
+type Store struct {
+    mu sync.RWMutex
+    // The terms, by their id
+    terms []results.Term
+    ids map[results.Term]id
+    // The triples by subject, predicate and object; by predicate, object and
+    // subject; and by object, subject and predicate
+    spo, pos, osp *node
+    size int
+    // The number of loaded documents
+    loads int
+}
+
+// New returns an empty store
+func New() *Store {
+    return &Store{
+        terms : []results.Term{ {} },
+        ids : make(map[results.Term]id),
+        spo : newNode(),
+        pos : newNode(),
+        osp : newNode(),
+    }
+}
+
+// normalize returns the term with the xsd:string datatype removed, which is the
+// same literal as the simple literal
+func normalize(t results.Term) results.Term {
+    if t.Datatype == xsd + "string" {
+        t.Datatype = ""
+    }
+    return t
+}
+
+// intern returns the id of the term, which is added if missing
+func (st *Store) intern(t results.Term) id {
+    t = normalize(t)
+    if i, ok := st.ids[t]; ok {
+        return i
+    }
+    i := id(len(st.terms))
+    st.terms = append(st.terms, t)
+    st.ids[t] = i
+    return i
+}
+
+// Add adds the triple to the store. The subject must be an IRI or a blank
+// node, and the predicate an IRI.
+func (st *Store) Add(s, p, o results.Term) error {
+    if s.Kind == results.LITERAL || p.Kind != results.IRI {
+        return fmt.Errorf("Invalid triple [%v %v %v]", s, p, o)
+    }
+    st.mu.Lock()
+    defer st.mu.Unlock()
+    si, pi, oi := st.intern(s), st.intern(p), st.intern(o)
+    if st.spo.child(si).child(pi).add(oi) {
+        st.pos.child(pi).child(oi).add(si)
+        st.osp.child(oi).child(si).add(pi)
+        st.size++
+    }
+    return nil
+}
+
+// Load adds the statements of the document, and returns the number of
+// statements read. The graphs of the statements are ignored. The blank nodes
+// are labelled after the document, so that the nodes of two documents are
+// distinct.
+func (st *Store) Load(r rdf.Reader) (int, error) {
+    st.mu.Lock()
+    st.loads++
+    prefix := fmt.Sprintf("l%v_", st.loads)
+    st.mu.Unlock()
+    scope := func(t results.Term) results.Term {
+        if t.Kind == results.BLANK {
+            t.Value = prefix + t.Value
+        }
+        return t
+    }
+    n := 0
+    for {
+        q, err := r.Read()
+        if err == io.EOF {
+            return n, nil
+        }
+        if err != nil {
+            return n, err
+        }
+        if err := st.Add(scope(q.Subject), q.Predicate, scope(q.Object)); err != nil {
+            return n, err
+        }
+        n++
+    }
+}
+
+// Len returns the number of triples
+func (st *Store) Len() int {
+    st.mu.RLock()
+    defer st.mu.RUnlock()
+    return st.size
+}
+
+// match calls the function with the triples matching the pattern, where the
+// zero id matches any term. The index is chosen after the bound positions.
+func (st *Store) match(s, p, o id, f func(s, p, o id)) {
+    switch {
+    case s != 0:
+        scan(st.spo, s, p, o, f)
+    case p != 0:
+        scan(st.pos, p, o, 0, func(p, o, s id) { f(s, p, o) })
+    case o != 0:
+        scan(st.osp, o, 0, 0, func(o, s, p id) { f(s, p, o) })
+    default:
+        for _,s := range st.spo.keys {
+            scan(st.spo, s, 0, 0, f)
+        }
+    }
+}
+
+// scan calls the function with the entries of the index starting with a, and
+// then with b and c if not zero
+func scan(index *node, a, b, c id, f func(a, b, c id)) {
+    first := index.children[a]
+    if first == nil {
+        return
+    }
+    for _,bk := range first.keys {
+        if b != 0 && bk != b {
+            continue
+        }
+        second := first.children[bk]
+        if c != 0 {
+            if _, ok := second.children[c]; ok {
+                f(a, bk, c)
+            }
+            continue
+        }
+        for _,ck := range second.keys {
+            f(a, bk, ck)
+        }
+    }
+}
+
+// ServeHTTP answers a query sent with the SPARQL 1.1 Protocol, either as the
+// query parameter of a GET or a form, or as the body of a POST. The results
+// are written in the SPARQL JSON format.
+func (st *Store) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+    query := r.FormValue("query")
+    if r.Method == "POST" && strings.HasPrefix(r.Header.Get("Content-Type"), "application/sparql-query") {
+        body, err := ioutil.ReadAll(r.Body)
+        if err != nil {
+            http.Error(w, err.Error(), http.StatusBadRequest)
+            return
+        }
+        query = string(body)
+    }
+    if query == "" {
+        http.Error(w, "Missing query", http.StatusBadRequest)
+        return
+    }
+    res, err := st.Query(query)
+    if err != nil {
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    w.Header().Set("Content-Type", results.JSON.MediaType())
+    res.Write(w, results.JSON)
+}
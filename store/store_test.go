@@ -0,0 +1,312 @@
+package store
+
+import (
+    "context"
+    "net/http/httptest"
+    "reflect"
+    "sort"
+    "strings"
+    "testing"
+    "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/endpoint"
+    "github.com/scampi/gosparqled/rdf"
+    "github.com/scampi/gosparqled/results"
+)
+
+const ex = "http://example.org/"
+
+// sample returns a store of people and places
+func sample(t *testing.T) *Store {
+    iri := func(local string) results.Term { return results.NewIRI(ex + local) }
+    rdfType := results.NewIRI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type")
+    label := results.NewIRI("http://www.w3.org/2000/01/rdf-schema#label")
+    triples := [][3]results.Term{
+        { iri("alice"), rdfType, iri("Person") },
+        { iri("alice"), iri("name"), results.NewLiteral("Alice") },
+        { iri("alice"), iri("birthPlace"), iri("paris") },
+        { iri("alice"), iri("knows"), iri("bob") },
+        { iri("bob"), rdfType, iri("Person") },
+        { iri("bob"), iri("name"), results.NewLiteral("Bob") },
+        { iri("bob"), iri("birthDate"), results.Term{ Kind : results.LITERAL, Value : "1990-01-01", Datatype : xsd + "date" } },
+        { iri("paris"), rdfType, iri("City") },
+        { iri("paris"), label, results.Term{ Kind : results.LITERAL, Value : "Paris", Lang : "en" } },
+        { iri("paris"), iri("population"), results.Term{ Kind : results.LITERAL, Value : "2000000", Datatype : xsd + "integer" } },
+        { iri("birthPlace"), label, results.Term{ Kind : results.LITERAL, Value : "place of birth", Lang : "en" } },
+        // a duplicate
+        { iri("alice"), rdfType, iri("Person") },
+    }
+    st := New()
+    for _,tr := range triples {
+        if err := st.Add(tr[0], tr[1], tr[2]); err != nil {
+            t.Fatal(err)
+        }
+    }
+    return st
+}
+
+// values returns the values of the variable in the solutions of the query
+func values(t *testing.T, st *Store, query string, v string) []string {
+    res, err := st.Query(query)
+    if err != nil {
+        t.Fatalf("Failed to evaluate the query\n%v\n%v", query, err)
+    }
+    out := []string{}
+    for _,s := range res.Solutions {
+        out = append(out, s[v].Value)
+    }
+    return out
+}
+
+// recommend returns the recommended items of the template for the query
+func recommend(t *testing.T, st *Store, template string, query string) []string {
+    scope, err := autocompletion.NewScopeByName(template)
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := &autocompletion.Sparql{ Buffer : "PREFIX ex: <" + ex + "> " + query, Scope : scope }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    return values(t, st, recommendationQuery(t, s), "POF")
+}
+
+// recommendationQuery returns the recommendation query of the processed query
+func recommendationQuery(t *testing.T, s *autocompletion.Sparql) string {
+    query, err := s.RecommendationQuery()
+    if err != nil {
+        t.Fatal(err)
+    }
+    return query
+}
+
+func sorted(values []string) []string {
+    sort.Strings(values)
+    return values
+}
+
+func TestAdd(t *testing.T) {
+    st := sample(t)
+    if st.Len() != 11 {
+        t.Errorf("Expected 11 triples but got %v", st.Len())
+    }
+    if err := st.Add(results.NewLiteral("s"), results.NewIRI(ex + "p"), results.NewIRI(ex + "o")); err == nil {
+        t.Error("Expected an error for a literal subject")
+    }
+}
+
+func TestLoad(t *testing.T) {
+    doc := `@prefix ex: <http://example.org/> .
+ex:alice ex:knows [ ex:name "Bob" ] .`
+    st := New()
+    for i := 0; i < 2; i++ {
+        if n, err := st.Load(rdf.NewTurtleReader(strings.NewReader(doc), "")); err != nil || n != 2 {
+            t.Fatalf("Expected 2 statements but got %v %v", n, err)
+        }
+    }
+    // the blank nodes of the two documents are distinct
+    if st.Len() != 4 {
+        t.Errorf("Expected 4 triples but got %v", st.Len())
+    }
+    if _, err := st.Load(rdf.NewNTriplesReader(strings.NewReader("<" + ex + "a> <" + ex + "b>\n"))); err == nil {
+        t.Error("Expected a syntax error")
+    }
+}
+
+func TestRecommendations(t *testing.T) {
+    st := sample(t)
+    tests := []struct {
+        template, query string
+        expected []string
+    }{
+        { "default", "SELECT * { ?s a ex:Person ; < }", []string{
+            "http://www.w3.org/1999/02/22-rdf-syntax-ns#type", ex + "birthDate", ex + "birthPlace", ex + "knows", ex + "name",
+        } },
+        { "default", "SELECT * { ?s a ex:Person ; BIRTH< }", []string{ ex + "birthDate", ex + "birthPlace" } },
+        { "default", "SELECT * { ?s ex:birthPlace ?o . ?o a < }", []string{ ex + "City" } },
+        { "default", "SELECT * { ?s a ex:< }", []string{ ex + "City", ex + "Person" } },
+        { "label", "SELECT * { ?s a ex:Person ; place< }", []string{ ex + "birthPlace" } },
+        { "default", "SELECT * { ?s 2/< ?o }", []string{
+            "<" + ex + "birthPlace> / <http://www.w3.org/1999/02/22-rdf-syntax-ns#type>",
+            "<" + ex + "birthPlace> / <" + ex + "population>",
+            "<" + ex + "birthPlace> / <http://www.w3.org/2000/01/rdf-schema#label>",
+            "<" + ex + "knows> / <http://www.w3.org/1999/02/22-rdf-syntax-ns#type>",
+            "<" + ex + "knows> / <" + ex + "birthDate>",
+            "<" + ex + "knows> / <" + ex + "name>",
+        } },
+    }
+    for _,test := range tests {
+        if actual := sorted(recommend(t, st, test.template, test.query)); !reflect.DeepEqual(actual, sorted(test.expected)) {
+            t.Errorf("Expected %v but got %v for %v", test.expected, actual, test.query)
+        }
+    }
+}
+
+// The label scope evaluated end to end; the shape of its label patterns is
+// tested in autocompletion
+func TestLabelRecommendations(t *testing.T) {
+    st := New()
+    label := results.NewIRI("http://www.w3.org/2000/01/rdf-schema#label")
+    en := func(value string, lang string) results.Term { return results.Term{ Kind : results.LITERAL, Value : value, Lang : lang } }
+    triples := [][3]results.Term{
+        { results.NewIRI(ex + "alice"), results.NewIRI(ex + "name"), results.NewLiteral("Alice") },
+        { results.NewIRI(ex + "alice"), results.NewIRI(ex + "knows"), results.NewIRI(ex + "bob") },
+        // two labels in the same language
+        { results.NewIRI(ex + "name"), label, en("name", "en") },
+        { results.NewIRI(ex + "name"), label, en("full name", "en") },
+        { results.NewIRI(ex + "name"), label, en("nom", "fr") },
+        // the same label with two regions of the language
+        { results.NewIRI(ex + "knows"), label, en("knows", "en-US") },
+        { results.NewIRI(ex + "knows"), label, en("knows", "en-GB") },
+    }
+    for _,tr := range triples {
+        if err := st.Add(tr[0], tr[1], tr[2]); err != nil {
+            t.Fatal(err)
+        }
+    }
+    for _,ranked := range []bool{ false, true } {
+        scope, _ := autocompletion.NewScopeByName("label")
+        scope.Ranked = ranked
+        s := &autocompletion.Sparql{ Buffer : "SELECT * { <" + ex + "alice> < ?o }", Scope : scope }
+        s.Init()
+        if err := s.Parse(); err != nil {
+            t.Fatal(err)
+        }
+        s.Execute()
+        res, err := st.Query(recommendationQuery(t, s))
+        if err != nil {
+            t.Fatal(err)
+        }
+        labels := map[string]string{}
+        for _,solution := range res.Solutions {
+            rec := autocompletion.NewRecommendation(map[string]string{
+                "POF" : solution["POF"].Value, "POFLabel" : solution["POFLabel"].Value, "count" : solution["count"].Value,
+            })
+            if _, ok := labels[rec.Item]; ok {
+                t.Errorf("Expected %v to be recommended once but got %v", rec.Item, res.Solutions)
+            }
+            if ranked && rec.Count != 1 {
+                t.Errorf("Expected a count of 1 for %v but got %v", rec.Item, rec.Count)
+            }
+            labels[rec.Item] = rec.Label
+        }
+        expected := map[string]string{ ex + "name" : "full name", ex + "knows" : "knows" }
+        if !reflect.DeepEqual(labels, expected) {
+            t.Errorf("Expected the labels %v but got %v", expected, labels)
+        }
+    }
+}
+
+func TestRanked(t *testing.T) {
+    st := sample(t)
+    res, err := st.Query(`
+        SELECT ?POF (COUNT(*) AS ?count) { ?s ?POF ?o }
+        GROUP BY ?POF ORDER BY DESC(?count) ?POF LIMIT 2
+    `)
+    if err != nil {
+        t.Fatal(err)
+    }
+    expected := []results.Solution{
+        { "POF" : results.NewIRI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type"), "count" : integer(3) },
+        { "POF" : results.NewIRI(ex + "name"), "count" : integer(2) },
+    }
+    if !reflect.DeepEqual(res.Solutions, expected) || !reflect.DeepEqual(res.Vars, []string{ "POF", "count" }) {
+        t.Errorf("Expected %v but got %v %v", expected, res.Vars, res.Solutions)
+    }
+    // the count template
+    scope, _ := autocompletion.NewScopeByName("count")
+    s := &autocompletion.Sparql{ Buffer : "SELECT * { ?s a <" + ex + "Person> ; < }", Scope : scope }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    if count := values(t, st, recommendationQuery(t, s), "count"); !reflect.DeepEqual(count, []string{ "5" }) {
+        t.Errorf("Expected 5 predicates but got %v", count)
+    }
+    if count := values(t, st, "SELECT (COUNT(DISTINCT ?s) AS ?n) { ?s a ?c }", "n"); !reflect.DeepEqual(count, []string{ "3" }) {
+        t.Errorf("Expected 3 subjects but got %v", count)
+    }
+}
+
+func TestQueries(t *testing.T) {
+    st := sample(t)
+    tests := []struct {
+        query, v string
+        expected []string
+    }{
+        // VALUES
+        { "SELECT ?n { VALUES ?s { <" + ex + "alice> <" + ex + "carol> } ?s <" + ex + "name> ?n }", "n", []string{ "Alice" } },
+        // a projected expression over the VALUES of the query
+        { "SELECT (lcase(?x) AS ?l) { <" + ex + "bob> <" + ex + "name> ?n } VALUES ?x { \"BOB\" }", "l", []string{ "bob" } },
+        // OPTIONAL
+        { "SELECT ?d { ?s <" + ex + "name> ?n OPTIONAL { ?s <" + ex + "birthDate> ?d } } ORDER BY ?n", "d", []string{ "", "1990-01-01" } },
+        // CONTAINS, STR and the boolean operators
+        { "SELECT ?s { ?s ?p ?o FILTER (contains(str(?s), \"ali\") && !isLiteral(?o) || ?o = \"Bob\") }", "s", []string{
+            ex + "alice", ex + "alice", ex + "alice", ex + "bob",
+        } },
+        // numbers
+        { "SELECT ?s { ?s <" + ex + "population> ?p FILTER (?p / 2 > 999999.5) }", "s", []string{ ex + "paris" } },
+        // UNION and MINUS
+        { "SELECT ?x { { ?x a <" + ex + "City> } UNION { ?x a <" + ex + "Person> MINUS { ?x <" + ex + "knows> ?y } } }", "x", []string{
+            ex + "paris", ex + "bob",
+        } },
+        // BIND and ORDER BY
+        { "SELECT ?l { ?s <" + ex + "name> ?n BIND (lcase(?n) AS ?l) } ORDER BY DESC(?l)", "l", []string{ "bob", "alice" } },
+        // GROUP_CONCAT
+        { "SELECT (GROUP_CONCAT(?n ; SEPARATOR = \",\") AS ?all) { ?s <" + ex + "name> ?n }", "all", []string{ "Alice,Bob" } },
+        // GROUP BY an expression without a variable
+        { "SELECT (COUNT(*) AS ?c) { ?s a ?o } GROUP BY (str(?o)) ORDER BY DESC(?c)", "c", []string{ "2", "1" } },
+        // sub-query and OFFSET
+        { "SELECT ?s { { SELECT DISTINCT ?s { ?s a ?c } ORDER BY ?s OFFSET 1 } }", "s", []string{ ex + "bob", ex + "paris" } },
+        // FILTER EXISTS
+        { "SELECT ?s { ?s a <" + ex + "Person> FILTER NOT EXISTS { ?s <" + ex + "knows> ?o } }", "s", []string{ ex + "bob" } },
+        // the variables of the solution in the FILTER of an EXISTS
+        { "SELECT ?n { ?s <" + ex + "name> ?n FILTER NOT EXISTS { ?t <" + ex + "name> ?m FILTER (?m < ?n) } }", "n", []string{ "Alice" } },
+    }
+    for _,test := range tests {
+        if actual := values(t, st, test.query, test.v); !reflect.DeepEqual(actual, test.expected) {
+            t.Errorf("Expected %v but got %v for %v", test.expected, actual, test.query)
+        }
+    }
+    if res, err := st.Query("ASK { ?s a <" + ex + "City> }"); err != nil || !res.Ask || !res.Boolean {
+        t.Errorf("Expected true but got %v %v", res, err)
+    }
+}
+
+func TestUnsupported(t *testing.T) {
+    st := sample(t)
+    for _,query := range []string{
+        "SELECT * { ?s <" + ex + "knows>+ ?o }",
+        "SELECT * { GRAPH ?g { ?s ?p ?o } }",
+        "SELECT * { ?s ?p ?o FILTER (<" + ex + "f>(?o)) }",
+        "CONSTRUCT { ?s ?p ?o } { ?s ?p ?o }",
+    } {
+        if _, err := st.Query(query); err == nil {
+            t.Errorf("Expected an error for %v", query)
+        }
+    }
+}
+
+func TestServeHTTP(t *testing.T) {
+    server := httptest.NewServer(sample(t))
+    defer server.Close()
+    for _,method := range []endpoint.Method{ endpoint.GET, endpoint.POST_FORM, endpoint.POST_DIRECT } {
+        c := endpoint.NewClient(server.URL)
+        c.Method = method
+        resp, err := c.Query(context.Background(), "SELECT ?n { ?s <" + ex + "name> ?n }")
+        if err != nil {
+            t.Fatal(err)
+        }
+        res, err := results.Read(resp.Body, results.JSON)
+        resp.Body.Close()
+        if err != nil || len(res.Solutions) != 2 {
+            t.Errorf("Expected 2 solutions with %v but got %v %v", method, res, err)
+        }
+    }
+    c := endpoint.NewClient(server.URL)
+    if _, err := c.Query(context.Background(), "SELECT * {"); err == nil {
+        t.Error("Expected an error for an invalid query")
+    }
+}
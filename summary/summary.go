@@ -0,0 +1,385 @@
+/*
+ Package summary builds the summary of an RDF graph, which recommendations can
+ be retrieved from instead of the data, as in the paper behind this project.
+
+    b := summary.NewBuilder(summary.CLASSES)
+    r, _ := rdf.NewReader(dump, rdf.NTRIPLES, "")
+    if _, err := b.Load(r); err != nil {
+        ...
+    }
+    s := b.Summary()
+    s.WriteNTriples(os.Stdout)
+
+ The entities of the graph are grouped into the nodes of the summary, either by
+ their set of classes, or by their set of predicates. The literals are grouped
+ by datatype. An edge of the summary links two nodes with a predicate, and
+ counts the triples it stands for.
+
+ The summary is written with the analytics vocabulary:
+
+    <node1> a an:Node ; an:label <Person> ; an:cardinality 2 .
+    <node2> a an:Node ; an:label <City> ; an:cardinality 1 .
+    <edge1> a an:Edge ; an:source <node1> ; an:label <birthPlace> ;
+        an:target <node2> ; an:cardinality 2 .
+
+ A node also has the predicates of its entities if they are grouped by
+ predicates, or the datatype of its literals, with an:predicate and
+ an:datatype.
+
+ Once loaded into an endpoint or into the in-memory store, the summary is
+ queried with the "summary" template of the autocompletion package.
+*/
+package summary
+
+import (
+    "fmt"
+    "io"
+    "sort"
+    "strconv"
+    "strings"
+    "github.com/scampi/gosparqled/rdf"
+    "github.com/scampi/gosparqled/results"
+)
+
+// The namespace of the analytics vocabulary the summary is written with
+const Vocabulary = "http://vocab.sindice.net/analytics#"
+
+// The namespace of the nodes and edges of a summary, by default
+const DefaultNamespace = "http://vocab.sindice.net/summary/"
+
+// The namespaces of the RDF vocabulary and of the XML Schema datatypes
+const (
+    rdfNS = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+    xsd = "http://www.w3.org/2001/XMLSchema#"
+)
+
+// Grouping is the way the entities are grouped into the nodes of the summary
+type Grouping uint
+
+const (
+    // By the set of classes. The entities without a class are grouped by
+    // their set of predicates.
+    CLASSES Grouping = iota
+    // By the set of predicates
+    PREDICATES
+)
+
+var groupingNames = []string{ "classes", "predicates" }
+
+func (g Grouping) String() string {
+    return groupingNames[g]
+}
+
+// GroupingOf returns the grouping with the name
+func GroupingOf(name string) (Grouping, error) {
+    for g,n := range groupingNames {
+        if n == name {
+            return Grouping(g), nil
+        }
+    }
+    return 0, fmt.Errorf("Unknown grouping [%v], expected one of %v", name, strings.Join(groupingNames, ", "))
+}
+
+// Node is a node of the summary, which stands for a group of entities or of
+// literals
+type Node struct {
+    // The IRI of the node
+    IRI string
+    // The sorted classes of the entities
+    Classes []string
+    // The sorted predicates of the entities, if they are grouped by predicates
+    Predicates []string
+    // The datatype of the literals, if the node stands for literals
+    Datatype string
+    // The number of entities, or of occurrences of literals
+    Cardinality int
+}
+
+// Edge is an edge of the summary, which stands for the triples with a
+// predicate between the entities of two nodes
+type Edge struct {
+    // The IRI of the edge
+    IRI string
+    Source, Target *Node
+    Predicate string
+    // The number of triples
+    Cardinality int
+}
+
+// Summary is the summary of a graph
+type Summary struct {
+    Nodes []*Node
+    Edges []*Edge
+}
+
+// id identifies a term of the graph
+type id uint32
+
+// triple is a triple of the graph, whose object is a datatype if literal
+type triple struct {
+    s, p, o id
+    literal bool
+}
+
+// Builder collects the triples of a graph and builds its summary
+type Builder struct {
+    Grouping Grouping
+    // The namespace of the nodes and edges of the summary
+    Namespace string
+    terms []string
+    ids map[results.Term]id
+    // The classes and the predicates of the entities
+    classes map[id]map[id]bool
+    predicates map[id]map[id]bool
+    triples []triple
+}
+
+// NewBuilder returns a builder of a summary with the grouping
+func NewBuilder(g Grouping) *Builder {
+    return &Builder{
+        Grouping : g,
+        Namespace : DefaultNamespace,
+        terms : []string{ "" },
+        ids : make(map[results.Term]id),
+        classes : make(map[id]map[id]bool),
+        predicates : make(map[id]map[id]bool),
+    }
+}
+
+// intern returns the id of the term
+func (b *Builder) intern(t results.Term) id {
+    if i, ok := b.ids[t]; ok {
+        return i
+    }
+    i := id(len(b.terms))
+    b.terms = append(b.terms, t.Value)
+    b.ids[t] = i
+    return i
+}
+
+// datatype returns the datatype of the literal
+func datatype(t results.Term) string {
+    switch {
+    case t.Lang != "":
+        return rdfNS + "langString"
+    case t.Datatype == "":
+        return xsd + "string"
+    }
+    return t.Datatype
+}
+
+// add adds the value to the set of the entity
+func add(sets map[id]map[id]bool, entity id, value id) {
+    if sets[entity] == nil {
+        sets[entity] = make(map[id]bool)
+    }
+    sets[entity][value] = true
+}
+
+// Add adds the statement to the graph. The graph of the statement is ignored.
+func (b *Builder) Add(q rdf.Quad) {
+    s, p := b.intern(q.Subject), b.intern(q.Predicate)
+    if _, ok := b.classes[s]; !ok {
+        b.classes[s] = nil
+    }
+    if q.Predicate.Value == rdfNS + "type" && q.Object.Kind != results.LITERAL {
+        add(b.classes, s, b.intern(results.NewIRI(q.Object.Value)))
+        return
+    }
+    add(b.predicates, s, p)
+    if q.Object.Kind == results.LITERAL {
+        b.triples = append(b.triples, triple{ s, p, b.intern(results.NewIRI(datatype(q.Object))), true })
+        return
+    }
+    o := b.intern(q.Object)
+    if _, ok := b.classes[o]; !ok {
+        b.classes[o] = nil
+    }
+    b.triples = append(b.triples, triple{ s, p, o, false })
+}
+
+// Load adds the statements of the document, and returns the number of
+// statements read
+func (b *Builder) Load(r rdf.Reader) (int, error) {
+    n := 0
+    for {
+        q, err := r.Read()
+        if err == io.EOF {
+            return n, nil
+        }
+        if err != nil {
+            return n, err
+        }
+        b.Add(q)
+        n++
+    }
+}
+
+// values returns the sorted values of the set
+func (b *Builder) values(set map[id]bool) []string {
+    values := make([]string, 0, len(set))
+    for i := range set {
+        values = append(values, b.terms[i])
+    }
+    sort.Strings(values)
+    return values
+}
+
+// node returns the node of the entity, without its IRI. If grouped by
+// predicates, the classes of the node are added afterwards.
+func (b *Builder) node(entity id) *Node {
+    if b.Grouping == PREDICATES {
+        return &Node{ Predicates : b.values(b.predicates[entity]) }
+    }
+    n := &Node{ Classes : b.values(b.classes[entity]) }
+    if len(n.Classes) == 0 {
+        n.Predicates = b.values(b.predicates[entity])
+    }
+    return n
+}
+
+// key returns the key of the group of the node
+func (n *Node) key() string {
+    return n.Datatype + "\x00" + strings.Join(n.Classes, " ") + "\x00" + strings.Join(n.Predicates, " ")
+}
+
+// Summary returns the summary of the graph. The nodes and the edges are sorted
+// so that the same graph always gets the same summary.
+func (b *Builder) Summary() *Summary {
+    nodes := make(map[string]*Node)
+    group := func(n *Node) *Node {
+        k := n.key()
+        if g, ok := nodes[k]; ok {
+            return g
+        }
+        nodes[k] = n
+        return n
+    }
+    entities := make(map[id]*Node, len(b.classes))
+    // the classes of the entities grouped by predicates
+    classes := make(map[*Node]map[id]bool)
+    for entity := range b.classes {
+        n := group(b.node(entity))
+        n.Cardinality++
+        entities[entity] = n
+        if b.Grouping == PREDICATES {
+            if classes[n] == nil {
+                classes[n] = make(map[id]bool)
+            }
+            for c := range b.classes[entity] {
+                classes[n][c] = true
+            }
+        }
+    }
+    for n,set := range classes {
+        n.Classes = b.values(set)
+    }
+    type edgeKey struct {
+        source, target *Node
+        p id
+    }
+    edges := make(map[edgeKey]*Edge)
+    for _,t := range b.triples {
+        var target *Node
+        if t.literal {
+            target = group(&Node{ Datatype : b.terms[t.o] })
+            target.Cardinality++
+        } else {
+            target = entities[t.o]
+        }
+        k := edgeKey{ entities[t.s], target, t.p }
+        e, ok := edges[k]
+        if !ok {
+            e = &Edge{ Source : k.source, Target : target, Predicate : b.terms[t.p] }
+            edges[k] = e
+        }
+        e.Cardinality++
+    }
+    s := &Summary{}
+    for _,n := range nodes {
+        s.Nodes = append(s.Nodes, n)
+    }
+    sort.Slice(s.Nodes, func(i, j int) bool { return s.Nodes[i].key() < s.Nodes[j].key() })
+    for i,n := range s.Nodes {
+        n.IRI = b.Namespace + "node" + strconv.Itoa(i + 1)
+    }
+    for _,e := range edges {
+        s.Edges = append(s.Edges, e)
+    }
+    sort.Slice(s.Edges, func(i, j int) bool {
+        x, y := s.Edges[i], s.Edges[j]
+        if x.Source != y.Source {
+            return x.Source.IRI < y.Source.IRI
+        }
+        if x.Predicate != y.Predicate {
+            return x.Predicate < y.Predicate
+        }
+        return x.Target.IRI < y.Target.IRI
+    })
+    for i,e := range s.Edges {
+        e.IRI = b.Namespace + "edge" + strconv.Itoa(i + 1)
+    }
+    return s
+}
+
+// Quads returns the statements of the summary, in the default graph
+func (s *Summary) Quads() []rdf.Quad {
+    var quads []rdf.Quad
+    add := func(subject string, property string, object results.Term) {
+        quads = append(quads, rdf.Quad{ Subject : results.NewIRI(subject), Predicate : results.NewIRI(Vocabulary + property), Object : object })
+    }
+    cardinality := func(n int) results.Term {
+        return results.Term{ Kind : results.LITERAL, Value : strconv.Itoa(n), Datatype : xsd + "integer" }
+    }
+    for _,n := range s.Nodes {
+        quads = append(quads, rdf.Quad{ Subject : results.NewIRI(n.IRI), Predicate : results.NewIRI(rdfNS + "type"), Object : results.NewIRI(Vocabulary + "Node") })
+        for _,c := range n.Classes {
+            add(n.IRI, "label", results.NewIRI(c))
+        }
+        for _,p := range n.Predicates {
+            add(n.IRI, "predicate", results.NewIRI(p))
+        }
+        if n.Datatype != "" {
+            add(n.IRI, "datatype", results.NewIRI(n.Datatype))
+        }
+        add(n.IRI, "cardinality", cardinality(n.Cardinality))
+    }
+    for _,e := range s.Edges {
+        quads = append(quads, rdf.Quad{ Subject : results.NewIRI(e.IRI), Predicate : results.NewIRI(rdfNS + "type"), Object : results.NewIRI(Vocabulary + "Edge") })
+        add(e.IRI, "source", results.NewIRI(e.Source.IRI))
+        add(e.IRI, "label", results.NewIRI(e.Predicate))
+        add(e.IRI, "target", results.NewIRI(e.Target.IRI))
+        add(e.IRI, "cardinality", cardinality(e.Cardinality))
+    }
+    return quads
+}
+
+// quadReader reads a list of statements
+type quadReader struct {
+    quads []rdf.Quad
+}
+
+func (r *quadReader) Read() (rdf.Quad, error) {
+    if len(r.quads) == 0 {
+        return rdf.Quad{}, io.EOF
+    }
+    q := r.quads[0]
+    r.quads = r.quads[1:]
+    return q, nil
+}
+
+// Reader returns a reader of the statements of the summary, e.g., to load it
+// into the in-memory store
+func (s *Summary) Reader() rdf.Reader {
+    return &quadReader{ quads : s.Quads() }
+}
+
+// WriteNTriples writes the statements of the summary as N-Triples
+func (s *Summary) WriteNTriples(w io.Writer) error {
+    for _,q := range s.Quads() {
+        if _, err := fmt.Fprintln(w, q); err != nil {
+            return err
+        }
+    }
+    return nil
+}
@@ -0,0 +1,162 @@
+package summary
+
+import (
+    "bytes"
+    "reflect"
+    "sort"
+    "strings"
+    "testing"
+    "github.com/scampi/gosparqled/autocompletion"
+    "github.com/scampi/gosparqled/rdf"
+    "github.com/scampi/gosparqled/store"
+)
+
+const ex = "http://example.org/"
+
+// A graph of people and places
+const data = `@prefix ex: <http://example.org/> .
+ex:alice a ex:Person ; ex:name "Alice" ; ex:birthPlace ex:paris ; ex:knows ex:bob .
+ex:bob a ex:Person ; ex:name "Bob" ; ex:birthPlace ex:paris .
+ex:carol a ex:Person, ex:Artist ; ex:name "Carol"@fr .
+ex:paris a ex:City ; ex:population 2000000 .
+ex:note ex:about ex:paris .
+`
+
+// build returns the summary of the data
+func build(t *testing.T, g Grouping) *Summary {
+    b := NewBuilder(g)
+    b.Namespace = ex + "summary/"
+    if n, err := b.Load(rdf.NewTurtleReader(strings.NewReader(data), "")); err != nil || n != 13 {
+        t.Fatalf("Expected 13 statements but got %v %v", n, err)
+    }
+    return b.Summary()
+}
+
+// describe returns the nodes and the edges of the summary in a readable form
+func describe(s *Summary) []string {
+    name := func(n *Node) string {
+        switch {
+        case n.Datatype != "":
+            return n.Datatype[strings.Index(n.Datatype, "#") + 1:]
+        case len(n.Classes) != 0:
+            return strings.Replace(strings.Join(n.Classes, "+"), ex, "", -1)
+        }
+        return "[" + strings.Replace(strings.Join(n.Predicates, " "), ex, "", -1) + "]"
+    }
+    var out []string
+    for _,n := range s.Nodes {
+        out = append(out, name(n) + " " + strings.Repeat("*", n.Cardinality))
+    }
+    for _,e := range s.Edges {
+        out = append(out, name(e.Source) + " " + e.Predicate[len(ex):] + " " + name(e.Target) + " " + strings.Repeat("*", e.Cardinality))
+    }
+    sort.Strings(out)
+    return out
+}
+
+func TestSummary(t *testing.T) {
+    expected := []string{
+        "Artist+Person *",
+        "Artist+Person name langString *",
+        "City *",
+        "City population integer *",
+        "Person **",
+        "Person birthPlace City **",
+        "Person knows Person *",
+        "Person name string **",
+        "[about] *",
+        "[about] about City *",
+        "integer *",
+        "langString *",
+        "string **",
+    }
+    if actual := describe(build(t, CLASSES)); !reflect.DeepEqual(actual, expected) {
+        t.Errorf("Expected\n%v\nbut got\n%v", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+    }
+    // by predicates, carol is alone with only a name
+    s := build(t, PREDICATES)
+    for _,n := range s.Nodes {
+        if reflect.DeepEqual(n.Predicates, []string{ ex + "name" }) && !reflect.DeepEqual(n.Classes, []string{ ex + "Artist", ex + "Person" }) {
+            t.Errorf("Expected the classes of carol but got %v", n.Classes)
+        }
+    }
+    if len(s.Nodes) != 8 {
+        t.Errorf("Expected 8 nodes but got %v", len(s.Nodes))
+    }
+}
+
+func TestWriteNTriples(t *testing.T) {
+    var out bytes.Buffer
+    if err := build(t, CLASSES).WriteNTriples(&out); err != nil {
+        t.Fatal(err)
+    }
+    b := NewBuilder(CLASSES)
+    n, err := b.Load(rdf.NewNTriplesReader(&out))
+    if err != nil {
+        t.Fatal(err)
+    }
+    // 7 nodes with their type and cardinality, 4 classes, 1 predicate and 3
+    // datatypes, and 6 edges of 5 statements
+    if expected := 7 * 2 + 4 + 1 + 3 + 6 * 5; n != expected {
+        t.Errorf("Expected %v statements but got %v", expected, n)
+    }
+}
+
+// recommend returns the recommended items of the summary template for the query
+func recommend(t *testing.T, st *store.Store, query string) []string {
+    scope, err := autocompletion.NewScopeByName("summary")
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := &autocompletion.Sparql{ Buffer : "PREFIX ex: <" + ex + "> " + query, Scope : scope }
+    s.Init()
+    if err := s.Parse(); err != nil {
+        t.Fatal(err)
+    }
+    s.Execute()
+    query, err = s.RecommendationQuery()
+    if err != nil {
+        t.Fatal(err)
+    }
+    res, err := st.Query(query)
+    if err != nil {
+        t.Fatalf("Failed to evaluate\n%v\n%v", query, err)
+    }
+    var items []string
+    for _,solution := range res.Solutions {
+        items = append(items, solution["POF"].Value + " " + solution["count"].Value)
+    }
+    return items
+}
+
+func TestSummaryTemplate(t *testing.T) {
+    st := store.New()
+    if _, err := st.Load(build(t, CLASSES).Reader()); err != nil {
+        t.Fatal(err)
+    }
+    tests := []struct {
+        query string
+        expected []string
+    }{
+        { "SELECT * { ?s a ex:Person ; < }", []string{ ex + "name 3", ex + "birthPlace 2", ex + "knows 1" } },
+        { "SELECT * { ?s a ex:Person ; BIRTH< }", []string{ ex + "birthPlace 2" } },
+        { "SELECT * { ?s ex:birthPlace ?o . ?o a < }", []string{ ex + "City 1" } },
+        { "SELECT * { ?s a < }", []string{ ex + "Person 3", ex + "Artist 1", ex + "City 1" } },
+        { "SELECT * { ex:note ex:about ?o . ?o < ?x }", []string{ ex + "population 1" } },
+        { "SELECT * { ?s a ex:Artist ; 2/< ?o }", nil },
+        // the counts are those of the last edge, and the paths are those of
+        // the groups: bob is known but knows no one
+        { "SELECT * { ?s a ex:Person ; 2/< ?o }", []string{
+            "<" + ex + "knows> / <" + ex + "birthPlace> 2",
+            "<" + ex + "knows> / <" + ex + "name> 2",
+            "<" + ex + "birthPlace> / <" + ex + "population> 1",
+            "<" + ex + "knows> / <" + ex + "knows> 1",
+        } },
+        { "SELECT * { ?s ex:knows < }", nil },
+    }
+    for _,test := range tests {
+        if actual := recommend(t, st, test.query); !reflect.DeepEqual(actual, test.expected) {
+            t.Errorf("Expected %v but got %v for %v", test.expected, actual, test.query)
+        }
+    }
+}